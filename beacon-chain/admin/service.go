@@ -0,0 +1,343 @@
+// Package admin defines a local control interface of the beacon node, served on a unix socket,
+// which lets operators script maintenance operations without exposing them on the network RPC.
+//
+// Each line written to the socket is a JSON encoded Request, answered by a line holding a JSON
+// encoded Response, for instance:
+//
+//	$ echo '{"command": "set-log-level", "args": ["debug"]}' | nc -U beacon-admin.sock
+//	{"result":"log level set to debug"}
+package admin
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoiceaudit"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "admin")
+
+// Request is a command sent on the admin socket.
+type Request struct {
+	Command string   `json:"command"`
+	Args    []string `json:"args,omitempty"`
+}
+
+// Response is the outcome of a command. Error is only set if the command failed.
+type Response struct {
+	Result string `json:"result,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Snapshotter takes database backups.
+type Snapshotter interface {
+	Snapshot(reason string) (string, error)
+}
+
+// PeerManager lists and disconnects the peers of the node.
+type PeerManager interface {
+	Peers() []peer.ID
+	Disconnect(peer.ID) error
+}
+
+// Resyncer requests the blocks the node is missing from its peers. Only the peers whose last
+// reported head is more than an epoch ahead of the node are asked, the blocks of the others are
+// expected to arrive through regular sync.
+type Resyncer interface {
+	ForceResync(ctx context.Context, pids []peer.ID) (int, error)
+}
+
+// ForkChoiceAuditor reports the latest head changes and the fork choice decisions behind them.
+type ForkChoiceAuditor interface {
+	ForkChoiceAudit(count int) []*forkchoiceaudit.Decision
+}
+
+// Config options for the admin service. Operations whose dependency is nil are reported as
+// unsupported.
+type Config struct {
+	// SocketPath is the path of the unix socket the service listens on.
+	SocketPath string
+	DB         Snapshotter
+	Peers      PeerManager
+	Sync       Resyncer
+	ForkChoice ForkChoiceAuditor
+}
+
+type command func(ctx context.Context, args []string) (string, error)
+
+// Service serves the admin commands on a unix socket.
+type Service struct {
+	ctx        context.Context
+	cancel     context.CancelFunc
+	cfg        *Config
+	commands   map[string]command
+	listener   net.Listener
+	conns      map[net.Conn]bool
+	connsLock  sync.Mutex
+	failStatus error
+}
+
+// NewService creates an admin service for the given configuration.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+		conns:  make(map[net.Conn]bool),
+	}
+	s.commands = map[string]command{
+		"trigger-backup":    s.triggerBackup,
+		"set-log-level":     s.setLogLevel,
+		"drop-peer":         s.dropPeer,
+		"force-resync":      s.forceResync,
+		"dump-goroutines":   s.dumpGoroutines,
+		"fork-choice-audit": s.forkChoiceAudit,
+	}
+	return s
+}
+
+// Start listens on the admin socket. A socket file left behind by a previous run is replaced.
+func (s *Service) Start() {
+	if err := os.Remove(s.cfg.SocketPath); err != nil && !os.IsNotExist(err) {
+		log.WithError(err).Error("Could not remove stale admin socket")
+		s.failStatus = err
+		return
+	}
+	listener, err := listenPrivate(s.cfg.SocketPath)
+	if err != nil {
+		log.WithError(err).Error("Could not listen on admin socket")
+		s.failStatus = err
+		return
+	}
+	s.listener = listener
+	log.WithField("path", s.cfg.SocketPath).Info("Listening on admin socket")
+	go s.acceptConnections()
+}
+
+// listenPrivate listens on a unix socket at the given path which only the user running the node
+// may connect to. Changing the permissions of a socket once it listens at its path would let
+// other users connect in between, so the socket is created in a directory only this user can
+// access, and moved to its path once its permissions are restricted.
+func listenPrivate(socketPath string) (net.Listener, error) {
+	dir, err := ioutil.TempDir(filepath.Dir(socketPath), ".admin")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create private socket directory")
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			log.WithError(err).Error("Could not remove private socket directory")
+		}
+	}()
+	tmpPath := filepath.Join(dir, "admin.sock")
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: tmpPath, Net: "unix"})
+	if err != nil {
+		return nil, err
+	}
+	// The socket file is removed by Stop, at its final path.
+	listener.SetUnlinkOnClose(false)
+	if err := os.Chmod(tmpPath, 0600); err != nil {
+		if err := listener.Close(); err != nil {
+			log.WithError(err).Error("Could not close admin socket")
+		}
+		return nil, errors.Wrap(err, "could not restrict admin socket permissions")
+	}
+	if err := os.Rename(tmpPath, socketPath); err != nil {
+		if err := listener.Close(); err != nil {
+			log.WithError(err).Error("Could not close admin socket")
+		}
+		return nil, errors.Wrap(err, "could not move admin socket")
+	}
+	return listener, nil
+}
+
+// Stop closes the admin socket and the open connections.
+func (s *Service) Stop() error {
+	s.cancel()
+	if s.listener == nil {
+		return nil
+	}
+	s.connsLock.Lock()
+	for conn := range s.conns {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Debug("Could not close admin connection")
+		}
+	}
+	s.connsLock.Unlock()
+	if err := s.listener.Close(); err != nil {
+		return err
+	}
+	return os.Remove(s.cfg.SocketPath)
+}
+
+// Status returns an error if the admin socket could not be opened.
+func (s *Service) Status() error {
+	return s.failStatus
+}
+
+func (s *Service) acceptConnections() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if s.ctx.Err() == nil {
+				log.WithError(err).Error("Could not accept admin connection")
+			}
+			return
+		}
+		s.connsLock.Lock()
+		s.conns[conn] = true
+		s.connsLock.Unlock()
+		go s.serve(conn)
+	}
+}
+
+// serve answers the requests of a connection until it is closed.
+func (s *Service) serve(conn net.Conn) {
+	defer func() {
+		s.connsLock.Lock()
+		delete(s.conns, conn)
+		s.connsLock.Unlock()
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Debug("Could not close admin connection")
+		}
+	}()
+
+	scanner := bufio.NewScanner(conn)
+	encoder := json.NewEncoder(conn)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if err := encoder.Encode(s.handle(line)); err != nil {
+			log.WithError(err).Debug("Could not write admin response")
+			return
+		}
+	}
+}
+
+// handle runs the command of an encoded request.
+func (s *Service) handle(line []byte) *Response {
+	req := &Request{}
+	if err := json.Unmarshal(line, req); err != nil {
+		return &Response{Error: fmt.Sprintf("could not decode request: %v", err)}
+	}
+	cmd, ok := s.commands[req.Command]
+	if !ok {
+		names := make([]string, 0, len(s.commands))
+		for name := range s.commands {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return &Response{Error: fmt.Sprintf("unknown command %q, expected one of %s", req.Command, strings.Join(names, ", "))}
+	}
+	log.WithFields(logrus.Fields{
+		"command": req.Command,
+		"args":    req.Args,
+	}).Info("Running admin command")
+	result, err := cmd(s.ctx, req.Args)
+	if err != nil {
+		return &Response{Error: err.Error()}
+	}
+	return &Response{Result: result}
+}
+
+func (s *Service) triggerBackup(_ context.Context, _ []string) (string, error) {
+	if s.cfg.DB == nil {
+		return "", errors.New("backups are not supported by this database")
+	}
+	path, err := s.cfg.DB.Snapshot("admin")
+	if err != nil {
+		return "", errors.Wrap(err, "could not back up database")
+	}
+	return path, nil
+}
+
+func (s *Service) setLogLevel(_ context.Context, args []string) (string, error) {
+	if len(args) != 1 {
+		return "", errors.New("expected the log level as only argument")
+	}
+	level, err := logrus.ParseLevel(args[0])
+	if err != nil {
+		return "", err
+	}
+	logrus.SetLevel(level)
+	return fmt.Sprintf("log level set to %s", level), nil
+}
+
+func (s *Service) dropPeer(_ context.Context, args []string) (string, error) {
+	if s.cfg.Peers == nil {
+		return "", errors.New("peer management is not supported")
+	}
+	if len(args) != 1 {
+		return "", errors.New("expected the peer ID as only argument")
+	}
+	pid, err := peer.IDB58Decode(args[0])
+	if err != nil {
+		return "", errors.Wrap(err, "could not decode peer ID")
+	}
+	if err := s.cfg.Peers.Disconnect(pid); err != nil {
+		return "", errors.Wrap(err, "could not disconnect peer")
+	}
+	return fmt.Sprintf("disconnected peer %s", pid.Pretty()), nil
+}
+
+func (s *Service) forceResync(ctx context.Context, _ []string) (string, error) {
+	if s.cfg.Sync == nil || s.cfg.Peers == nil {
+		return "", errors.New("resync is not supported by this sync service")
+	}
+	requested, err := s.cfg.Sync.ForceResync(ctx, s.cfg.Peers.Peers())
+	if err != nil {
+		return "", errors.Wrap(err, "could not resync")
+	}
+	return fmt.Sprintf("requested blocks from %d peers more than an epoch ahead", requested), nil
+}
+
+func (s *Service) dumpGoroutines(_ context.Context, _ []string) (string, error) {
+	var buf bytes.Buffer
+	if err := pprof.Lookup("goroutine").WriteTo(&buf, 2); err != nil {
+		return "", errors.Wrap(err, "could not dump goroutines")
+	}
+	return buf.String(), nil
+}
+
+func (s *Service) forkChoiceAudit(_ context.Context, args []string) (string, error) {
+	if s.cfg.ForkChoice == nil {
+		return "", errors.New("fork choice audit is not supported by this blockchain service")
+	}
+	if len(args) > 1 {
+		return "", errors.New("expected at most the number of head changes as argument")
+	}
+	count := 0
+	if len(args) == 1 {
+		var err error
+		count, err = strconv.Atoi(args[0])
+		if err != nil || count < 0 {
+			return "", fmt.Errorf("invalid number of head changes %q", args[0])
+		}
+	}
+	decisions := s.cfg.ForkChoice.ForkChoiceAudit(count)
+	if decisions == nil {
+		return "", errors.New("fork choice audit log is disabled, run the node with --fork-choice-audit-size")
+	}
+	enc, err := json.MarshalIndent(decisions, "", "  ")
+	if err != nil {
+		return "", errors.Wrap(err, "could not encode fork choice audit")
+	}
+	return string(enc), nil
+}
@@ -0,0 +1,228 @@
+package admin
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoiceaudit"
+	"github.com/sirupsen/logrus"
+)
+
+type mockSnapshotter struct {
+	reason string
+}
+
+func (m *mockSnapshotter) Snapshot(reason string) (string, error) {
+	m.reason = reason
+	return "/tmp/snapshot.db", nil
+}
+
+type mockPeerManager struct {
+	peers        []peer.ID
+	disconnected []peer.ID
+}
+
+func (m *mockPeerManager) Peers() []peer.ID {
+	return m.peers
+}
+
+func (m *mockPeerManager) Disconnect(pid peer.ID) error {
+	m.disconnected = append(m.disconnected, pid)
+	return nil
+}
+
+type mockResyncer struct {
+	pids []peer.ID
+}
+
+func (m *mockResyncer) ForceResync(_ context.Context, pids []peer.ID) (int, error) {
+	m.pids = pids
+	if len(pids) == 0 {
+		return 0, errors.New("no peers")
+	}
+	return len(pids), nil
+}
+
+type mockForkChoiceAuditor struct {
+	decisions []*forkchoiceaudit.Decision
+	count     int
+}
+
+func (m *mockForkChoiceAuditor) ForkChoiceAudit(count int) []*forkchoiceaudit.Decision {
+	m.count = count
+	return m.decisions
+}
+
+// startService starts an admin service on a temporary socket, and returns a function sending
+// requests on the socket, and a function stopping the service.
+func startService(t *testing.T, cfg *Config) (func(req *Request) *Response, func()) {
+	dir, err := ioutil.TempDir("", "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.SocketPath = filepath.Join(dir, "admin.sock")
+	s := NewService(context.Background(), cfg)
+	s.Start()
+	if err := s.Status(); err != nil {
+		t.Fatal(err)
+	}
+	conn, err := net.Dial("unix", cfg.SocketPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader := bufio.NewReader(conn)
+	send := func(req *Request) *Response {
+		if err := json.NewEncoder(conn).Encode(req); err != nil {
+			t.Fatal(err)
+		}
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := &Response{}
+		if err := json.Unmarshal(line, res); err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+	stop := func() {
+		if err := s.Stop(); err != nil {
+			t.Error(err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			t.Error(err)
+		}
+	}
+	return send, stop
+}
+
+func TestService_Commands(t *testing.T) {
+	pid := peer.ID("peer")
+	db := &mockSnapshotter{}
+	peers := &mockPeerManager{peers: []peer.ID{pid}}
+	sync := &mockResyncer{}
+	forkChoice := &mockForkChoiceAuditor{
+		decisions: []*forkchoiceaudit.Decision{{NewHead: "0x01", NewSlot: 7, Reorg: true}},
+	}
+	send, stop := startService(t, &Config{DB: db, Peers: peers, Sync: sync, ForkChoice: forkChoice})
+	defer stop()
+
+	if res := send(&Request{Command: "trigger-backup"}); res.Error != "" || res.Result != "/tmp/snapshot.db" {
+		t.Errorf("Unexpected backup response %+v", res)
+	}
+	if db.reason != "admin" {
+		t.Errorf("Wanted snapshot reason admin, got %s", db.reason)
+	}
+
+	level := logrus.GetLevel()
+	defer logrus.SetLevel(level)
+	logrus.SetLevel(logrus.InfoLevel)
+	if res := send(&Request{Command: "set-log-level", Args: []string{"debug"}}); res.Error != "" {
+		t.Errorf("Unexpected error %s", res.Error)
+	}
+	if logrus.GetLevel() != logrus.DebugLevel {
+		t.Errorf("Wanted log level debug, got %s", logrus.GetLevel())
+	}
+
+	if res := send(&Request{Command: "drop-peer", Args: []string{pid.Pretty()}}); res.Error != "" {
+		t.Errorf("Unexpected error %s", res.Error)
+	}
+	if len(peers.disconnected) != 1 || peers.disconnected[0] != pid {
+		t.Errorf("Wanted peer %s disconnected, got %v", pid.Pretty(), peers.disconnected)
+	}
+
+	if res := send(&Request{Command: "force-resync"}); res.Result != "requested blocks from 1 peers more than an epoch ahead" {
+		t.Errorf("Unexpected resync response %+v", res)
+	}
+	if len(sync.pids) != 1 || sync.pids[0] != pid {
+		t.Errorf("Wanted resync from peer %s, got %v", pid.Pretty(), sync.pids)
+	}
+
+	res := send(&Request{Command: "dump-goroutines"})
+	if res.Error != "" || !strings.Contains(res.Result, "goroutine") {
+		t.Errorf("Unexpected goroutine dump %+v", res)
+	}
+
+	res = send(&Request{Command: "fork-choice-audit", Args: []string{"5"}})
+	var decisions []*forkchoiceaudit.Decision
+	if err := json.Unmarshal([]byte(res.Result), &decisions); err != nil {
+		t.Fatalf("Could not decode fork choice audit %+v: %v", res, err)
+	}
+	if forkChoice.count != 5 || len(decisions) != 1 || decisions[0].NewSlot != 7 || !decisions[0].Reorg {
+		t.Errorf("Unexpected fork choice audit %+v for count %d", res, forkChoice.count)
+	}
+}
+
+func TestService_Errors(t *testing.T) {
+	send, stop := startService(t, &Config{})
+	defer stop()
+
+	tests := []struct {
+		req  *Request
+		want string
+	}{
+		{req: &Request{Command: "unknown"}, want: "unknown command"},
+		{req: &Request{Command: "trigger-backup"}, want: "not supported"},
+		{req: &Request{Command: "set-log-level", Args: []string{"loud"}}, want: "not a valid logrus Level"},
+		{req: &Request{Command: "set-log-level"}, want: "expected the log level"},
+		{req: &Request{Command: "drop-peer", Args: []string{"peer"}}, want: "not supported"},
+		{req: &Request{Command: "force-resync"}, want: "not supported"},
+		{req: &Request{Command: "fork-choice-audit"}, want: "not supported"},
+	}
+	for _, tt := range tests {
+		res := send(tt.req)
+		if !strings.Contains(res.Error, tt.want) {
+			t.Errorf("Wanted error containing %q for %+v, got %q", tt.want, tt.req, res.Error)
+		}
+	}
+}
+
+func TestService_ReplacesStaleSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "admin.sock")
+	if err := ioutil.WriteFile(path, []byte{}, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewService(context.Background(), &Config{SocketPath: path})
+	s.Start()
+	defer func() {
+		if err := s.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+	if err := s.Status(); err != nil {
+		t.Fatalf("Could not listen on socket: %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode()&os.ModeSocket == 0 {
+		t.Errorf("Wanted a socket at %s, got mode %s", path, info.Mode())
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Errorf("Wanted socket permissions %s, got %s", os.FileMode(0600), perm)
+	}
+	// The private directory the socket was created in is removed.
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Errorf("Wanted only the socket in %s, got %d files", dir, len(files))
+	}
+}
@@ -25,6 +25,7 @@ var log = logrus.WithField("prefix", "attestation")
 type TargetHandler interface {
 	LatestAttestationTarget(state *pb.BeaconState, validatorIndex uint64) (*pb.AttestationTarget, error)
 	BatchUpdateLatestAttestations(ctx context.Context, atts []*ethpb.Attestation) error
+	PruneLatestAttestations(blockRoots [][32]byte)
 }
 
 type attestationStore struct {
@@ -223,6 +224,26 @@ func (a *Service) InsertAttestationIntoStore(pubkey [48]byte, att *ethpb.Attesta
 	a.store.m[pubkey] = att
 }
 
+// PruneLatestAttestations removes the latest attestations which vote for any of the
+// given block roots. This is used once those blocks have been pruned from the db, so
+// no validator's latest vote keeps pointing at a block which no longer exists.
+func (a *Service) PruneLatestAttestations(blockRoots [][32]byte) {
+	if len(blockRoots) == 0 {
+		return
+	}
+	pruned := make(map[[32]byte]bool, len(blockRoots))
+	for _, root := range blockRoots {
+		pruned[root] = true
+	}
+	a.store.Lock()
+	defer a.store.Unlock()
+	for pubkey, att := range a.store.m {
+		if att == nil || pruned[bytesutil.ToBytes32(att.Data.BeaconBlockRoot)] {
+			delete(a.store.m, pubkey)
+		}
+	}
+}
+
 func (a *Service) updateAttestation(beaconState *pb.BeaconState, attestation *ethpb.Attestation) error {
 	totalAttestationSeen.Inc()
 
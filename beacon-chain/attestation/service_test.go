@@ -214,6 +214,28 @@ func TestLatestAttestationTarget_ReturnsLatestAttestedBlock(t *testing.T) {
 	}
 }
 
+func TestPruneLatestAttestations_RemovesVotesForPrunedBlocks(t *testing.T) {
+	service := NewAttestationService(context.Background(), &Config{})
+
+	prunedRoot := [32]byte{'a'}
+	keptRoot := [32]byte{'b'}
+	service.InsertAttestationIntoStore([48]byte{'A'}, &ethpb.Attestation{
+		Data: &ethpb.AttestationData{BeaconBlockRoot: prunedRoot[:]},
+	})
+	service.InsertAttestationIntoStore([48]byte{'B'}, &ethpb.Attestation{
+		Data: &ethpb.AttestationData{BeaconBlockRoot: keptRoot[:]},
+	})
+
+	service.PruneLatestAttestations([][32]byte{prunedRoot})
+
+	if _, ok := service.store.m[[48]byte{'A'}]; ok {
+		t.Error("Expected latest attestation voting for a pruned block to be removed")
+	}
+	if _, ok := service.store.m[[48]byte{'B'}]; !ok {
+		t.Error("Expected latest attestation voting for a kept block to remain")
+	}
+}
+
 func TestUpdateLatestAttestation_InvalidIndex(t *testing.T) {
 	beaconDB := internal.SetupDBDeprecated(t)
 	hook := logTest.NewGlobal()
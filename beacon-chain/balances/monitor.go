@@ -0,0 +1,202 @@
+// Package balances compares the validator balances of consecutive epoch boundary states, to
+// give operators an early warning when the network penalizes a large share of the validators,
+// or when it enters an inactivity leak because it stopped finalizing.
+package balances
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "balances")
+
+// DefaultPenalizedFraction is the share of the active validators losing balance over an epoch
+// past which mass penalties are reported. Beyond a third of the validators, finality is at risk.
+const DefaultPenalizedFraction = 1.0 / 3
+
+// The kinds of anomalies reported.
+const (
+	anomalyMassPenalties  = "mass_penalties"
+	anomalyInactivityLeak = "inactivity_leak"
+)
+
+var (
+	penalizedValidators = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "balances_penalized_validators",
+		Help: "The number of active validators whose balance decreased over the last epoch.",
+	})
+	rewardedValidators = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "balances_rewarded_validators",
+		Help: "The number of active validators whose balance increased over the last epoch.",
+	})
+	netBalanceChange = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "balances_net_change_gwei",
+		Help: "The sum of the balance changes of the active validators over the last epoch, in Gwei.",
+	})
+	inactivityLeak = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "balances_inactivity_leak",
+		Help: "1 while the network is in an inactivity leak, 0 otherwise.",
+	})
+	anomalies = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "balances_anomalies_total",
+		Help: "The number of balance anomalies reported, by kind.",
+	}, []string{"kind"})
+)
+
+// Config options for the balance monitor.
+type Config struct {
+	// PenalizedFraction is the share of the active validators losing balance over an epoch past
+	// which mass penalties are reported, DefaultPenalizedFraction when 0. Across a gap, it is
+	// multiplied by the number of epochs since the last state observed.
+	PenalizedFraction float64
+}
+
+// Diff summarizes the balance changes of the validators active since the last epoch observed.
+type Diff struct {
+	Epoch             uint64
+	Since             uint64 // Epoch of the state compared with, earlier than Epoch-1 after a gap.
+	FinalityDelay     uint64 // Epochs since the finalized checkpoint.
+	ActiveValidators  int
+	Penalized         int
+	Rewarded          int
+	PenalizedFraction float64
+	PenaltyThreshold  float64 // PenalizedFraction past which mass penalties are reported.
+	NetChange         int64   // In Gwei.
+	LargestPenalty    uint64
+	MassPenalties     bool
+	InInactivityLeak  bool
+	EnteredLeak       bool
+	ExitedLeak        bool
+}
+
+// Monitor keeps the balances of the last state observed, to diff them with the state of a
+// later epoch. A nil monitor ignores every state.
+type Monitor struct {
+	cfg      *Config
+	lock     sync.Mutex
+	epoch    uint64
+	balances []uint64
+	active   []bool
+	observed bool
+	inLeak   bool
+}
+
+// NewMonitor creates a balance monitor, which reports anomalies from the second state observed on.
+func NewMonitor(cfg *Config) *Monitor {
+	if cfg.PenalizedFraction == 0 {
+		cfg.PenalizedFraction = DefaultPenalizedFraction
+	}
+	return &Monitor{cfg: cfg}
+}
+
+// Observe the first state of an epoch processed, which is past the epoch boundary when the
+// first slots of the epoch were skipped. The balance changes since the last state observed,
+// even if epochs were missed in between, are logged, exported as metrics, and anomalies are
+// reported. It returns the diff, nil for the first state observed. States of an epoch already
+// observed, such as the states of competing forks, are ignored.
+func (m *Monitor) Observe(state *pb.BeaconState) *Diff {
+	if m == nil {
+		return nil
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	epoch := helpers.CurrentEpoch(state)
+	if m.observed && epoch <= m.epoch {
+		return nil
+	}
+	var diff *Diff
+	if m.observed {
+		diff = m.diff(state, epoch)
+	}
+	m.epoch = epoch
+	m.observed = true
+	m.balances = append(m.balances[:0], state.Balances...)
+	m.active = m.active[:0]
+	for _, v := range state.Validators {
+		m.active = append(m.active, helpers.IsActiveValidator(v, epoch))
+	}
+	if diff != nil {
+		report(diff)
+	}
+	return diff
+}
+
+func (m *Monitor) diff(state *pb.BeaconState, epoch uint64) *Diff {
+	d := &Diff{Epoch: epoch, Since: m.epoch}
+	for i, v := range state.Validators {
+		if i >= len(m.balances) || i >= len(state.Balances) || !m.active[i] || !helpers.IsActiveValidator(v, epoch) {
+			continue
+		}
+		d.ActiveValidators++
+		before, after := m.balances[i], state.Balances[i]
+		switch {
+		case after < before:
+			d.Penalized++
+			d.NetChange -= int64(before - after)
+			if before-after > d.LargestPenalty {
+				d.LargestPenalty = before - after
+			}
+		case after > before:
+			d.Rewarded++
+			d.NetChange += int64(after - before)
+		}
+	}
+	if d.ActiveValidators > 0 {
+		d.PenalizedFraction = float64(d.Penalized) / float64(d.ActiveValidators)
+	}
+	d.PenaltyThreshold = m.cfg.PenalizedFraction * float64(epoch-m.epoch)
+	d.MassPenalties = d.PenalizedFraction > d.PenaltyThreshold
+
+	// The spec penalizes inactivity once finality is delayed by more than
+	// MIN_EPOCHS_TO_INACTIVITY_PENALTY epochs.
+	if state.FinalizedCheckpoint != nil && epoch > state.FinalizedCheckpoint.Epoch {
+		d.FinalityDelay = epoch - state.FinalizedCheckpoint.Epoch
+	}
+	d.InInactivityLeak = d.FinalityDelay > params.BeaconConfig().MinEpochsToInactivityPenalty
+	d.EnteredLeak = d.InInactivityLeak && !m.inLeak
+	d.ExitedLeak = !d.InInactivityLeak && m.inLeak
+	m.inLeak = d.InInactivityLeak
+	return d
+}
+
+func report(d *Diff) {
+	penalizedValidators.Set(float64(d.Penalized))
+	rewardedValidators.Set(float64(d.Rewarded))
+	netBalanceChange.Set(float64(d.NetChange))
+	if d.InInactivityLeak {
+		inactivityLeak.Set(1)
+	} else {
+		inactivityLeak.Set(0)
+	}
+
+	lFields := logrus.Fields{
+		"epoch":              d.Epoch,
+		"sinceEpoch":         d.Since,
+		"activeValidators":   d.ActiveValidators,
+		"penalized":          d.Penalized,
+		"rewarded":           d.Rewarded,
+		"netChangeGwei":      d.NetChange,
+		"largestPenaltyGwei": d.LargestPenalty,
+		"penaltyThreshold":   d.PenaltyThreshold,
+		"finalityDelay":      d.FinalityDelay,
+	}
+	log.WithFields(lFields).Debug("Validator balance changes since the last epoch observed")
+	if d.MassPenalties {
+		anomalies.WithLabelValues(anomalyMassPenalties).Inc()
+		log.WithFields(lFields).Warnf("%.0f%% of the active validators were penalized since epoch %d", d.PenalizedFraction*100, d.Since)
+	}
+	if d.EnteredLeak {
+		anomalies.WithLabelValues(anomalyInactivityLeak).Inc()
+		log.WithFields(lFields).Warn("The network entered an inactivity leak, inactive validators are losing balance until finality resumes")
+	}
+	if d.ExitedLeak {
+		log.WithFields(lFields).Info("The network left the inactivity leak")
+	}
+}
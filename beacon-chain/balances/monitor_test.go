@@ -0,0 +1,98 @@
+package balances
+
+import (
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func epochState(epoch uint64, finalizedEpoch uint64, balances []uint64) *pb.BeaconState {
+	validators := make([]*ethpb.Validator, len(balances))
+	for i := range validators {
+		validators[i] = &ethpb.Validator{ExitEpoch: params.BeaconConfig().FarFutureEpoch}
+	}
+	return &pb.BeaconState{
+		Slot:                epoch * params.BeaconConfig().SlotsPerEpoch,
+		Validators:          validators,
+		Balances:            balances,
+		FinalizedCheckpoint: &ethpb.Checkpoint{Epoch: finalizedEpoch},
+	}
+}
+
+func TestMonitor_ReportsMassPenalties(t *testing.T) {
+	hook := logTest.NewGlobal()
+	m := NewMonitor(&Config{})
+
+	if diff := m.Observe(epochState(1, 0, []uint64{100, 100, 100, 100})); diff != nil {
+		t.Errorf("Wanted no diff for the first state, got %v", diff)
+	}
+	diff := m.Observe(epochState(2, 1, []uint64{90, 95, 100, 103}))
+	if diff == nil {
+		t.Fatal("Wanted a diff for consecutive epochs")
+	}
+	if diff.Penalized != 2 || diff.Rewarded != 1 || diff.NetChange != -12 || diff.LargestPenalty != 10 {
+		t.Errorf("Wanted 2 penalized, 1 rewarded, -12 net and 10 largest penalty, got %+v", diff)
+	}
+	if !diff.MassPenalties {
+		t.Error("Expected half of the validators penalized to be mass penalties")
+	}
+	testutil.AssertLogsContain(t, hook, "50% of the active validators were penalized since epoch 1")
+
+	// A state of an epoch already observed, from a competing fork, is ignored.
+	if diff := m.Observe(epochState(2, 1, []uint64{0, 0, 0, 0})); diff != nil {
+		t.Errorf("Wanted no diff for an epoch already observed, got %v", diff)
+	}
+	// After a gap, the state is compared with the last one observed.
+	diff = m.Observe(epochState(4, 3, []uint64{90, 90, 100, 104}))
+	if diff == nil {
+		t.Fatal("Wanted a diff across a gap")
+	}
+	if diff.Since != 2 || diff.Penalized != 1 || diff.Rewarded != 1 || diff.NetChange != -4 {
+		t.Errorf("Wanted a diff since epoch 2 with 1 penalized, 1 rewarded and -4 net, got %+v", diff)
+	}
+}
+
+func TestMonitor_ReportsInactivityLeak(t *testing.T) {
+	hook := logTest.NewGlobal()
+	m := NewMonitor(&Config{})
+	leakEpoch := params.BeaconConfig().MinEpochsToInactivityPenalty + 1
+
+	balances := []uint64{100, 100, 100}
+	m.Observe(epochState(leakEpoch-1, 0, balances))
+	diff := m.Observe(epochState(leakEpoch, 0, balances))
+	if !diff.EnteredLeak || !diff.InInactivityLeak {
+		t.Errorf("Wanted the leak to start at epoch %d, got %+v", leakEpoch, diff)
+	}
+	testutil.AssertLogsContain(t, hook, "The network entered an inactivity leak")
+
+	diff = m.Observe(epochState(leakEpoch+1, 0, balances))
+	if diff.EnteredLeak || !diff.InInactivityLeak {
+		t.Errorf("Wanted the leak to be reported once, got %+v", diff)
+	}
+	diff = m.Observe(epochState(leakEpoch+2, leakEpoch, balances))
+	if !diff.ExitedLeak {
+		t.Errorf("Wanted the leak to end once finality resumed, got %+v", diff)
+	}
+}
+
+func TestMonitor_ScalesPenaltyThresholdAcrossGaps(t *testing.T) {
+	m := NewMonitor(&Config{PenalizedFraction: 0.2})
+
+	m.Observe(epochState(1, 0, []uint64{100, 100, 100, 100}))
+	diff := m.Observe(epochState(2, 1, []uint64{90, 100, 100, 100}))
+	if !diff.MassPenalties {
+		t.Errorf("Wanted a quarter of the validators penalized over an epoch to exceed the configured fraction, got %+v", diff)
+	}
+	// Over 2 epochs, the same share is judged against twice the configured fraction.
+	diff = m.Observe(epochState(4, 3, []uint64{90, 90, 100, 100}))
+	if diff.PenaltyThreshold != 0.4 {
+		t.Errorf("Wanted a threshold of 0.4 over 2 epochs, got %v", diff.PenaltyThreshold)
+	}
+	if diff.MassPenalties {
+		t.Errorf("Wanted a quarter of the validators penalized over 2 epochs not to be mass penalties, got %+v", diff)
+	}
+}
@@ -3,6 +3,7 @@ package blockchain
 import (
 	"time"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoiceaudit"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 )
 
@@ -64,3 +65,9 @@ func (c *ChainService) CanonicalRoot(slot uint64) []byte {
 func (c *ChainService) GenesisTime() time.Time {
 	return c.genesisTime
 }
+
+// ForkChoiceAudit returns up to count of the latest head changes with the fork choice decisions
+// behind them, oldest first. It returns nil when the audit log is disabled.
+func (c *ChainService) ForkChoiceAudit(count int) []*forkchoiceaudit.Decision {
+	return c.forkChoiceStore.ForkChoiceAudit(count)
+}
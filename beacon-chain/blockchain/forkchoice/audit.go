@@ -0,0 +1,116 @@
+package forkchoice
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoiceaudit"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// SetForkChoiceAudit sets the log the head changes are recorded in, nil to disable it.
+func (s *Store) SetForkChoiceAudit(l *forkchoiceaudit.Log) {
+	s.audit = l
+}
+
+// ForkChoiceAudit returns up to count of the latest head changes with the candidates the fork
+// choice rule weighed to make them, oldest first, all of the retained ones if count is 0. Only
+// the steps with several candidates are recorded. It returns nil when the audit log is disabled.
+func (s *Store) ForkChoiceAudit(count int) []*forkchoiceaudit.Decision {
+	return s.audit.Latest(count)
+}
+
+// auditStep records the children of a block weighed by their attesting balance.
+func (s *Store) auditStep(ctx context.Context, parent []byte, children [][]byte, balances []uint64, chosen []byte) (*forkchoiceaudit.Step, error) {
+	candidates := make([]*forkchoiceaudit.Candidate, len(children))
+	for i, child := range children {
+		slot, err := s.blockSlot(ctx, child)
+		if err != nil {
+			return nil, err
+		}
+		candidates[i] = &forkchoiceaudit.Candidate{
+			Root:   forkchoiceaudit.Root(bytesutil.ToBytes32(child)),
+			Slot:   slot,
+			Weight: balances[i],
+		}
+	}
+	return forkchoiceaudit.NewStep(bytesutil.ToBytes32(parent), candidates, bytesutil.ToBytes32(chosen)), nil
+}
+
+// keepSteps keeps the steps of the last head found by the fork choice rule, until the head is
+// applied.
+func (s *Store) keepSteps(head []byte, steps []*forkchoiceaudit.Step) {
+	s.auditLock.Lock()
+	defer s.auditLock.Unlock()
+	s.foundHead = head
+	s.foundSteps = steps
+}
+
+// AuditHead records the head applied by the chain service if it differs from the previous one,
+// with the steps of the fork choice rule if it is the last head found. The first head applied is
+// only the base of the next decision.
+func (s *Store) AuditHead(ctx context.Context, head []byte) error {
+	if s.audit == nil {
+		return nil
+	}
+	s.auditLock.Lock()
+	defer s.auditLock.Unlock()
+	previous := s.auditedHead
+	if bytes.Equal(previous, head) {
+		return nil
+	}
+	s.auditedHead = head
+	if previous == nil {
+		return nil
+	}
+	var steps []*forkchoiceaudit.Step
+	if bytes.Equal(s.foundHead, head) {
+		steps = s.foundSteps
+	}
+
+	previousSlot, err := s.blockSlot(ctx, previous)
+	if err != nil {
+		return err
+	}
+	headSlot, err := s.blockSlot(ctx, head)
+	if err != nil {
+		return err
+	}
+	justifiedSlot, err := s.blockSlot(ctx, s.justifiedCheckpt.Root)
+	if err != nil {
+		return err
+	}
+	ancestor, err := s.ancestor(ctx, head, previousSlot)
+	if err != nil {
+		return errors.Wrap(err, "could not get ancestor of new head")
+	}
+	s.audit.Record(&forkchoiceaudit.Decision{
+		Time:          time.Now(),
+		JustifiedRoot: forkchoiceaudit.Root(bytesutil.ToBytes32(s.justifiedCheckpt.Root)),
+		JustifiedSlot: justifiedSlot,
+		PreviousHead:  forkchoiceaudit.Root(bytesutil.ToBytes32(previous)),
+		PreviousSlot:  previousSlot,
+		NewHead:       forkchoiceaudit.Root(bytesutil.ToBytes32(head)),
+		NewSlot:       headSlot,
+		Reorg:         !bytes.Equal(ancestor, previous),
+		Steps:         steps,
+	})
+	return nil
+}
+
+// blockSlot returns the slot of a block, from the in memory tree if the block is in it.
+func (s *Store) blockSlot(ctx context.Context, root []byte) (uint64, error) {
+	if n := s.node(bytesutil.ToBytes32(root)); n != nil {
+		return n.slot, nil
+	}
+	b, err := s.db.Block(ctx, bytesutil.ToBytes32(root))
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get block")
+	}
+	if b == nil {
+		return 0, errors.Errorf("block %#x is not in db", bytesutil.Trunc(root))
+	}
+	return b.Slot, nil
+}
@@ -0,0 +1,52 @@
+package forkchoice
+
+import (
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+)
+
+// MarkEquivocating records validators known to have equivocated, such as by a slashing seen
+// on the network. The latest messages of equivocating validators no longer count toward the
+// weight of any block, so that a validator voting for several forks can't tip the head. The
+// set is kept in memory only, after a restart the validators slashed in the justified state
+// remain excluded.
+func (s *Store) MarkEquivocating(indices ...uint64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, i := range indices {
+		s.equivocating[i] = true
+	}
+	equivocatingValidators.Set(float64(len(s.equivocating)))
+}
+
+// IsEquivocating returns true if the validator is known to have equivocated.
+func (s *Store) IsEquivocating(index uint64) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.equivocating[index]
+}
+
+// markSlashedInBlock marks the validators slashed by the proposer and attester slashings of
+// a block as equivocating.
+func (s *Store) markSlashedInBlock(b *ethpb.BeaconBlock) {
+	if b.Body == nil {
+		return
+	}
+	var indices []uint64
+	for _, slashing := range b.Body.ProposerSlashings {
+		indices = append(indices, slashing.ProposerIndex)
+	}
+	for _, slashing := range b.Body.AttesterSlashings {
+		att1, att2 := slashing.Attestation_1, slashing.Attestation_2
+		if att1 == nil || att2 == nil {
+			continue
+		}
+		indices = append(indices, sliceutil.IntersectionUint64(
+			append(att1.CustodyBit_0Indices, att1.CustodyBit_1Indices...),
+			append(att2.CustodyBit_0Indices, att2.CustodyBit_1Indices...),
+		)...)
+	}
+	if len(indices) > 0 {
+		s.MarkEquivocating(indices...)
+	}
+}
@@ -0,0 +1,93 @@
+package forkchoice
+
+import (
+	"context"
+	"testing"
+
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+func TestStore_LatestAttestingBalance_IgnoresEquivocatingValidators(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	store := NewForkChoiceService(ctx, db)
+
+	roots, err := blockTree1(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	validators := make([]*ethpb.Validator, 10)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &ethpb.Validator{ExitEpoch: 2, EffectiveBalance: 1e9}
+	}
+	// Validator 9 was already slashed in the justified state.
+	validators[9].Slashed = true
+	if err := store.GenesisStore(ctx, &pb.BeaconState{Validators: validators}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < len(validators); i++ {
+		if err := store.db.SaveValidatorLatestVote(ctx, uint64(i), &pb.ValidatorLatestVote{Root: roots[1]}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	store.MarkEquivocating(0, 1)
+	got, err := store.latestAttestingBalance(ctx, roots[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := uint64(7 * 1e9); got != want {
+		t.Errorf("Wanted balance %d, got %d", want, got)
+	}
+}
+
+func TestStore_UpdateAttVotes_MarksDoubleVotes(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	store := NewForkChoiceService(ctx, db)
+	indexedAtt := &ethpb.IndexedAttestation{CustodyBit_0Indices: []uint64{1, 2}}
+	if err := store.updateAttVotes(ctx, indexedAtt, []byte{'a'}, 1); err != nil {
+		t.Fatal(err)
+	}
+	// The same vote again is not an equivocation.
+	if err := store.updateAttVotes(ctx, indexedAtt, []byte{'a'}, 1); err != nil {
+		t.Fatal(err)
+	}
+	if store.IsEquivocating(1) {
+		t.Error("Expected a repeated vote not to be an equivocation")
+	}
+
+	if err := store.updateAttVotes(ctx, &ethpb.IndexedAttestation{CustodyBit_0Indices: []uint64{2}}, []byte{'b'}, 1); err != nil {
+		t.Fatal(err)
+	}
+	if store.IsEquivocating(1) || !store.IsEquivocating(2) {
+		t.Error("Expected only validator 2 to be equivocating")
+	}
+}
+
+func TestStore_MarkSlashedInBlock(t *testing.T) {
+	store := NewForkChoiceService(context.Background(), nil)
+	store.markSlashedInBlock(&ethpb.BeaconBlock{
+		Body: &ethpb.BeaconBlockBody{
+			ProposerSlashings: []*ethpb.ProposerSlashing{{ProposerIndex: 3}},
+			AttesterSlashings: []*ethpb.AttesterSlashing{
+				{
+					Attestation_1: &ethpb.IndexedAttestation{CustodyBit_0Indices: []uint64{4, 5}},
+					Attestation_2: &ethpb.IndexedAttestation{CustodyBit_0Indices: []uint64{5, 6}},
+				},
+			},
+		},
+	})
+	for i, want := range map[uint64]bool{3: true, 4: false, 5: true, 6: false} {
+		if store.IsEquivocating(i) != want {
+			t.Errorf("Wanted validator %d equivocating %v, got %v", i, want, !want)
+		}
+	}
+}
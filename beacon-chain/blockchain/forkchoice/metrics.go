@@ -1,3 +1,23 @@
 package forkchoice
 
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
 // TODO(3219): Add forking metrics.
+
+var maxDepthExceeded = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "forkchoice_max_depth_exceeded",
+	Help: "The number of fork choice walks given up on for exceeding the maximum depth",
+}, []string{"walk"})
+
+var equivocatingValidators = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "forkchoice_equivocating_validators",
+	Help: "The number of validators whose latest messages are ignored by fork choice for equivocating",
+})
+
+var rejectedBlocks = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "forkchoice_rejected_blocks",
+	Help: "The number of blocks rejected by the fork choice store, by reason",
+}, []string{"reason"})
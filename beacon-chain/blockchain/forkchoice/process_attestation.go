@@ -1,6 +1,7 @@
 package forkchoice
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"time"
@@ -162,12 +163,17 @@ func (s *Store) updateAttVotes(
 			return errors.Wrapf(err, "could not get latest vote for validator %d", i)
 		}
 		if !s.db.HasValidatorLatestVote(ctx, i) || tgtEpoch > vote.Epoch {
-			if err := s.db.SaveValidatorLatestVote(ctx, i, &pb.ValidatorLatestVote{
+			newVote := &pb.ValidatorLatestVote{
 				Epoch: tgtEpoch,
 				Root:  tgtRoot,
-			}); err != nil {
+			}
+			if err := s.db.SaveValidatorLatestVote(ctx, i, newVote); err != nil {
 				return errors.Wrapf(err, "could not save latest vote for validator %d", i)
 			}
+			s.setLatestVote(i, newVote)
+		} else if tgtEpoch == vote.Epoch && !bytes.Equal(tgtRoot, vote.Root) {
+			// Two votes for different targets in the same epoch are a slashable double vote.
+			s.MarkEquivocating(i)
 		}
 	}
 	return nil
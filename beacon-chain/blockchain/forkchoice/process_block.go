@@ -17,7 +17,8 @@ import (
 )
 
 // OnBlock is called whenever a block is received. It runs state transition on the block and
-// update fork choice store struct.
+// update fork choice store struct. Invalid blocks are rejected with a *BlockRejectedError
+// giving the reason.
 //
 // Spec pseudocode definition:
 //   def on_block(store: Store, block: BeaconBlock) -> None:
@@ -73,11 +74,21 @@ func (s *Store) OnBlock(ctx context.Context, b *ethpb.BeaconBlock) error {
 		return err
 	}
 
+	// The state transition updates the pre-state in place.
+	preStateEpoch := helpers.SlotToEpoch(preState.Slot)
+
+	// Not a rejection, the block is processed once the skip slots before it are, a chunk per
+	// attempt at the block.
+	preState, err = s.skipSlots.Advance(ctx, preState, b)
+	if err != nil {
+		return errors.Wrapf(err, "block from slot %d is not ready for processing", b.Slot)
+	}
+
 	// Apply new state transition for the block to the store.
-	// Make block root as bad to reject in sync.
+	// The rejection records the failing operation, if any, for sync to report to peers.
 	postState, err := state.ExecuteStateTransition(ctx, preState, b)
 	if err != nil {
-		return errors.Wrap(err, "could not execute state transition")
+		return rejectBlock(RejectInvalidStateTransition, b.Slot, errors.Wrap(err, "could not execute state transition"))
 	}
 
 	if err := s.db.SaveBlock(ctx, b); err != nil {
@@ -86,6 +97,8 @@ func (s *Store) OnBlock(ctx context.Context, b *ethpb.BeaconBlock) error {
 	if err := s.db.SaveState(ctx, postState, root); err != nil {
 		return errors.Wrap(err, "could not save state")
 	}
+	s.insertNode(root, b)
+	s.markSlashedInBlock(b)
 
 	// Update justified check point.
 	if postState.CurrentJustifiedCheckpoint.Epoch > s.justifiedCheckpt.Epoch {
@@ -96,12 +109,17 @@ func (s *Store) OnBlock(ctx context.Context, b *ethpb.BeaconBlock) error {
 	if postState.FinalizedCheckpoint.Epoch > s.finalizedCheckpt.Epoch {
 		helpers.ClearAllCaches()
 		s.finalizedCheckpt.Epoch = postState.FinalizedCheckpoint.Epoch
+		s.pruneNodes(postState.FinalizedCheckpoint.Root)
 	}
 
 	// Log epoch summary before the next epoch.
 	if helpers.IsEpochStart(postState.Slot) {
 		logEpochData(postState)
 	}
+	// The block may be past the start of the epoch, after skip slots.
+	if preStateEpoch < helpers.CurrentEpoch(postState) {
+		s.balanceMonitor.Observe(postState)
+	}
 	return nil
 }
 
@@ -112,7 +130,7 @@ func (s *Store) verifyBlkPreState(ctx context.Context, b *ethpb.BeaconBlock) (*p
 		return nil, errors.Wrapf(err, "could not get pre state for slot %d", b.Slot)
 	}
 	if preState == nil {
-		return nil, fmt.Errorf("pre state of slot %d does not exist", b.Slot)
+		return nil, rejectBlock(RejectUnknownParent, b.Slot, fmt.Errorf("pre state of slot %d does not exist", b.Slot))
 	}
 	return preState, nil
 }
@@ -127,10 +145,10 @@ func (s *Store) verifyBlkDescendant(ctx context.Context, root [32]byte, slot uin
 
 	bFinalizedRoot, err := s.ancestor(ctx, root[:], finalizedBlk.Slot)
 	if err != nil {
-		return errors.Wrap(err, "could not get finalized block root")
+		return errors.Wrapf(err, "could not get finalized ancestor of block from slot %d", slot)
 	}
 	if !bytes.Equal(bFinalizedRoot, s.finalizedCheckpt.Root) {
-		return fmt.Errorf("block from slot %d is not a descendent of the current finalized block", slot)
+		return rejectBlock(RejectPreFinalized, slot, fmt.Errorf("block from slot %d is not a descendent of the current finalized block", slot))
 	}
 	return nil
 }
@@ -140,7 +158,7 @@ func (s *Store) verifyBlkDescendant(ctx context.Context, root [32]byte, slot uin
 func (s *Store) verifyBlkFinalizedSlot(b *ethpb.BeaconBlock) error {
 	finalizedSlot := helpers.StartSlot(s.finalizedCheckpt.Epoch)
 	if finalizedSlot >= b.Slot {
-		return fmt.Errorf("block is equal or earlier than finalized block, slot %d < slot %d", b.Slot, finalizedSlot)
+		return rejectBlock(RejectPreFinalized, b.Slot, fmt.Errorf("block is equal or earlier than finalized block, slot %d < slot %d", b.Slot, finalizedSlot))
 	}
 	return nil
 }
@@ -150,7 +168,7 @@ func verifyBlkSlotTime(gensisTime uint64, blkSlot uint64) error {
 	slotTime := gensisTime + blkSlot*params.BeaconConfig().SecondsPerSlot
 	currentTime := uint64(time.Now().Unix())
 	if slotTime > currentTime {
-		return fmt.Errorf("could not process block from the future, slot time %d > current time %d", slotTime, currentTime)
+		return rejectBlock(RejectFutureSlot, blkSlot, fmt.Errorf("could not process block from the future, slot time %d > current time %d", slotTime, currentTime))
 	}
 	return nil
 }
@@ -5,11 +5,15 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
 
 func TestStore_OnBlock(t *testing.T) {
@@ -39,30 +43,35 @@ func TestStore_OnBlock(t *testing.T) {
 		s             *pb.BeaconState
 		time          uint64
 		wantErrString string
+		wantReason    BlockRejectionReason
 	}{
 		{
 			name:          "parent block root does not have a state",
 			blk:           &ethpb.BeaconBlock{},
 			s:             &pb.BeaconState{},
 			wantErrString: "pre state of slot 0 does not exist",
+			wantReason:    RejectUnknownParent,
 		},
 		{
 			name:          "block is from the feature",
 			blk:           &ethpb.BeaconBlock{ParentRoot: randaomParentRoot, Slot: params.BeaconConfig().FarFutureEpoch},
 			s:             &pb.BeaconState{},
 			wantErrString: "could not process block from the future",
+			wantReason:    RejectFutureSlot,
 		},
 		{
 			name:          "could not get finalized block",
 			blk:           &ethpb.BeaconBlock{ParentRoot: randaomParentRoot},
 			s:             &pb.BeaconState{},
 			wantErrString: "block from slot 0 is not a descendent of the current finalized block",
+			wantReason:    RejectPreFinalized,
 		},
 		{
 			name:          "same slot as finalized block",
 			blk:           &ethpb.BeaconBlock{Slot: 0, ParentRoot: validGenesisRoot},
 			s:             &pb.BeaconState{},
 			wantErrString: "block is equal or earlier than finalized block, slot 0 < slot 0",
+			wantReason:    RejectPreFinalized,
 		},
 	}
 
@@ -77,6 +86,57 @@ func TestStore_OnBlock(t *testing.T) {
 			if !strings.Contains(err.Error(), tt.wantErrString) {
 				t.Errorf("Store.OnBlock() error = %v, wantErr = %v", err, tt.wantErrString)
 			}
+			rejection := BlockRejection(errors.Wrap(err, "could not process block"))
+			if rejection == nil || rejection.Reason != tt.wantReason {
+				t.Errorf("Wanted rejection reason %s, got %v", tt.wantReason, rejection)
+			}
 		})
 	}
 }
+
+func TestStore_OnBlock_TooManySkipSlots(t *testing.T) {
+	helpers.ClearAllCaches()
+	c := params.BeaconConfig()
+	prevMax := c.MaxSkipSlotsPerBlock
+	c.MaxSkipSlotsPerBlock = 3
+	params.OverrideBeaconConfig(c)
+	defer func() {
+		c.MaxSkipSlotsPerBlock = prevMax
+		params.OverrideBeaconConfig(c)
+	}()
+
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	store := NewForkChoiceService(ctx, db)
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 16)
+	genesis, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.GenesisStore(ctx, genesis); err != nil {
+		t.Fatal(err)
+	}
+	b := &ethpb.BeaconBlock{Slot: 8, ParentRoot: store.finalizedCheckpt.Root}
+	// The ancestry of the block is looked up in the DB.
+	if err := db.SaveBlock(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+
+	// Every attempt processes a chunk of the skip slots, and doesn't reject the block.
+	for i := 0; i < 2; i++ {
+		err := store.OnBlock(ctx, b)
+		if errors.Cause(err) != state.ErrTooManySkipSlots {
+			t.Fatalf("Wanted %v, got %v", state.ErrTooManySkipSlots, err)
+		}
+		if rejection := BlockRejection(err); rejection != nil {
+			t.Errorf("Wanted no rejection, got %v", rejection)
+		}
+	}
+	// The skip slots are now within the limit, the invalid block is processed and rejected.
+	err = store.OnBlock(ctx, b)
+	if rejection := BlockRejection(err); rejection == nil || rejection.Reason != RejectInvalidStateTransition {
+		t.Errorf("Wanted rejection reason %s, got %v", RejectInvalidStateTransition, err)
+	}
+}
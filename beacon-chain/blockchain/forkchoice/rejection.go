@@ -0,0 +1,101 @@
+package forkchoice
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+)
+
+// BlockRejectionReason identifies why OnBlock rejected a block.
+type BlockRejectionReason string
+
+const (
+	// RejectFutureSlot is the reason for blocks whose slot time hasn't come yet.
+	RejectFutureSlot BlockRejectionReason = "future_slot"
+	// RejectUnknownParent is the reason for blocks whose parent state isn't in the DB.
+	RejectUnknownParent BlockRejectionReason = "unknown_parent"
+	// RejectPreFinalized is the reason for blocks which don't descend from the finalized block,
+	// or which are not later than the finalized epoch start slot.
+	RejectPreFinalized BlockRejectionReason = "pre_finalized"
+	// RejectInvalidStateTransition is the reason for blocks failing the state transition.
+	RejectInvalidStateTransition BlockRejectionReason = "invalid_state_transition"
+)
+
+// Recoverable returns true if a block rejected for this reason may become valid later, once
+// its slot time comes or its parent is received. Such blocks should be ignored rather than held
+// against the peer which sent them.
+func (r BlockRejectionReason) Recoverable() bool {
+	return r == RejectFutureSlot || r == RejectUnknownParent
+}
+
+// BlockRejectedError is returned by OnBlock when a block is invalid for the store.
+type BlockRejectedError struct {
+	Reason BlockRejectionReason
+	Slot   uint64
+	// Operation and OperationIndex identify the block body operation which failed the state
+	// transition, if any. OperationIndex is -1 otherwise.
+	Operation      string
+	OperationIndex int
+	err            error
+}
+
+// Error returns the message of the underlying error, or the reason if there is none.
+func (e *BlockRejectedError) Error() string {
+	if e.err == nil {
+		return string(e.Reason)
+	}
+	return e.err.Error()
+}
+
+// Cause returns the underlying error, so that errors.Cause keeps finding the root cause.
+func (e *BlockRejectedError) Cause() error {
+	return e.err
+}
+
+// BlockRejection returns the rejection of a block from an error returned by OnBlock, or any
+// error wrapping it. It returns nil if the block wasn't rejected, but failed to process for an
+// internal reason such as a DB error.
+func BlockRejection(err error) *BlockRejectedError {
+	for err != nil {
+		if rejection, ok := err.(*BlockRejectedError); ok {
+			return rejection
+		}
+		cause, ok := err.(interface{ Cause() error })
+		if !ok {
+			return nil
+		}
+		err = cause.Cause()
+	}
+	return nil
+}
+
+// rejectBlock records the rejection of a block in metrics and returns the typed error.
+func rejectBlock(reason BlockRejectionReason, slot uint64, err error) error {
+	rejection := &BlockRejectedError{
+		Reason:         reason,
+		Slot:           slot,
+		OperationIndex: -1,
+		err:            err,
+	}
+	if reason == RejectInvalidStateTransition {
+		if opErr := operationError(err); opErr != nil {
+			rejection.Operation = opErr.Operation
+			rejection.OperationIndex = opErr.Index
+		}
+	}
+	rejectedBlocks.WithLabelValues(string(reason)).Inc()
+	return rejection
+}
+
+// operationError returns the failing operation of a state transition error, if any.
+func operationError(err error) *blocks.OperationError {
+	for err != nil {
+		if opErr, ok := err.(*blocks.OperationError); ok {
+			return opErr
+		}
+		cause, ok := err.(interface{ Cause() error })
+		if !ok {
+			return nil
+		}
+		err = cause.Cause()
+	}
+	return nil
+}
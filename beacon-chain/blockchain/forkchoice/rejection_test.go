@@ -0,0 +1,51 @@
+package forkchoice
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestRejectBlock_InvalidStateTransitionOperation(t *testing.T) {
+	body := &ethpb.BeaconBlockBody{
+		ProposerSlashings: []*ethpb.ProposerSlashing{
+			{
+				ProposerIndex: 1,
+				Header_1:      &ethpb.BeaconBlockHeader{Slot: params.BeaconConfig().SlotsPerEpoch + 1},
+				Header_2:      &ethpb.BeaconBlockHeader{Slot: 0},
+			},
+		},
+	}
+	_, err := blocks.ProcessProposerSlashings(&pb.BeaconState{Validators: make([]*ethpb.Validator, 2)}, body)
+	if err == nil {
+		t.Fatal("Wanted proposer slashing to fail")
+	}
+	err = rejectBlock(RejectInvalidStateTransition, 5, errors.Wrap(err, "could not process block operation"))
+
+	rejection := BlockRejection(errors.Wrap(err, "could not process block"))
+	if rejection == nil {
+		t.Fatalf("Wanted block rejection, got %v", err)
+	}
+	if rejection.Reason != RejectInvalidStateTransition || rejection.Slot != 5 {
+		t.Errorf("Wanted invalid state transition at slot 5, got %s at slot %d", rejection.Reason, rejection.Slot)
+	}
+	if rejection.Operation != "proposer_slashing" || rejection.OperationIndex != 0 {
+		t.Errorf("Wanted proposer_slashing 0, got %s %d", rejection.Operation, rejection.OperationIndex)
+	}
+	if rejection.Reason.Recoverable() {
+		t.Error("Wanted invalid state transition not to be recoverable")
+	}
+}
+
+func TestBlockRejection_InternalError(t *testing.T) {
+	if rejection := BlockRejection(errors.New("could not save block")); rejection != nil {
+		t.Errorf("Wanted no rejection, got %v", rejection)
+	}
+	if rejection := BlockRejection(nil); rejection != nil {
+		t.Errorf("Wanted no rejection, got %v", rejection)
+	}
+}
@@ -0,0 +1,209 @@
+package forkchoice
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// blockNode is the in memory record of a block above the finalized checkpoint.
+type blockNode struct {
+	slot       uint64
+	parentRoot [32]byte
+	children   [][]byte
+}
+
+// RestoreFromDB rebuilds the store from the DB when the node restarts on an existing chain.
+// Blocks from the finalized block onwards are scanned once, in slot order, into an in memory
+// block tree, and the latest votes of the validators of the justified state are loaded, so
+// that the first Head calls after a restart don't walk the DB block by block. A zero checkpoint
+// root, as found in states before the first justification, stands for the genesis block.
+func (s *Store) RestoreFromDB(ctx context.Context, justified *ethpb.Checkpoint, finalized *ethpb.Checkpoint) error {
+	start := time.Now()
+	justified = proto.Clone(justified).(*ethpb.Checkpoint)
+	finalized = proto.Clone(finalized).(*ethpb.Checkpoint)
+
+	// Blocks are scanned from the finalized block on. A slot range starting at slot 0 matches
+	// no block in the DB, so every block is scanned until the first finalization.
+	var f *filters.QueryFilter
+	if bytesutil.ToBytes32(finalized.Root) != params.BeaconConfig().ZeroHash {
+		finalizedBlk, err := s.db.Block(ctx, bytesutil.ToBytes32(finalized.Root))
+		if err != nil {
+			return errors.Wrap(err, "could not get finalized block")
+		}
+		if finalizedBlk == nil {
+			return errors.Errorf("finalized block %#x is not in db", bytesutil.Trunc(finalized.Root))
+		}
+		if finalizedBlk.Slot > 0 {
+			f = filters.NewFilter().SetStartSlot(finalizedBlk.Slot)
+		}
+	}
+	blks, err := s.db.Blocks(ctx, f)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve blocks from finalized block")
+	}
+	sort.SliceStable(blks, func(i, j int) bool {
+		return blks[i].Slot < blks[j].Slot
+	})
+
+	zeroHash := params.BeaconConfig().ZeroHash
+	nodes := make(map[[32]byte]*blockNode)
+	for _, b := range blks {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		root, err := ssz.SigningRoot(b)
+		if err != nil {
+			return errors.Wrapf(err, "could not get signing root of block %d", b.Slot)
+		}
+		parentRoot := bytesutil.ToBytes32(b.ParentRoot)
+		parent, ok := nodes[parentRoot]
+		switch {
+		case ok:
+			parent.children = append(parent.children, root[:])
+		case len(nodes) == 0 && b.Slot == 0 && bytesutil.ToBytes32(finalized.Root) == zeroHash:
+			finalized.Root = root[:]
+		case root != bytesutil.ToBytes32(finalized.Root):
+			// The block is on a fork pruned by finality.
+			continue
+		}
+		nodes[root] = &blockNode{slot: b.Slot, parentRoot: parentRoot}
+	}
+	if _, ok := nodes[bytesutil.ToBytes32(finalized.Root)]; !ok {
+		return errors.Errorf("finalized block %#x is not in db", bytesutil.Trunc(finalized.Root))
+	}
+	if bytesutil.ToBytes32(justified.Root) == zeroHash {
+		justified.Root = finalized.Root
+	}
+
+	justifiedState, err := s.db.State(ctx, bytesutil.ToBytes32(justified.Root))
+	if err != nil {
+		return errors.Wrap(err, "could not get justified state")
+	}
+	if justifiedState == nil {
+		return errors.Errorf("justified state at epoch %d is not in db", justified.Epoch)
+	}
+	votes := make(map[uint64]*pb.ValidatorLatestVote)
+	for i := range justifiedState.Validators {
+		vote, err := s.db.ValidatorLatestVote(ctx, uint64(i))
+		if err != nil {
+			return errors.Wrapf(err, "could not get latest vote for validator %d", i)
+		}
+		if vote != nil {
+			votes[uint64(i)] = vote
+		}
+	}
+
+	h, err := hashutil.HashProto(justified)
+	if err != nil {
+		return errors.Wrap(err, "could not hash proto justified checkpoint")
+	}
+	s.lock.Lock()
+	s.justifiedCheckpt = justified
+	s.finalizedCheckpt = finalized
+	s.checkptBlkRoot[h] = bytesutil.ToBytes32(justified.Root)
+	s.lock.Unlock()
+
+	s.nodesLock.Lock()
+	s.nodes = nodes
+	s.latestVotes = votes
+	s.nodesLock.Unlock()
+
+	log.WithFields(logrus.Fields{
+		"blocks":         len(nodes),
+		"votes":          len(votes),
+		"justifiedEpoch": justified.Epoch,
+		"finalizedEpoch": finalized.Epoch,
+		"duration":       time.Since(start),
+	}).Info("Restored fork choice store from db")
+	return nil
+}
+
+// node returns the in memory record of a block, or nil if the block isn't in the tree.
+func (s *Store) node(root [32]byte) *blockNode {
+	s.nodesLock.RLock()
+	defer s.nodesLock.RUnlock()
+	return s.nodes[root]
+}
+
+// children returns the roots of the children of a block from the in memory tree, starting
+// from the given slot. The last return value is false if the block isn't in the tree.
+func (s *Store) children(root []byte, startSlot uint64) ([][]byte, bool) {
+	s.nodesLock.RLock()
+	defer s.nodesLock.RUnlock()
+	n, ok := s.nodes[bytesutil.ToBytes32(root)]
+	if !ok {
+		return nil, false
+	}
+	var children [][]byte
+	for _, c := range n.children {
+		if s.nodes[bytesutil.ToBytes32(c)].slot >= startSlot {
+			children = append(children, c)
+		}
+	}
+	return children, true
+}
+
+// insertNode adds a processed block to the in memory tree, once it has been restored.
+func (s *Store) insertNode(root [32]byte, b *ethpb.BeaconBlock) {
+	s.nodesLock.Lock()
+	defer s.nodesLock.Unlock()
+	if s.nodes == nil {
+		return
+	}
+	if _, ok := s.nodes[root]; ok {
+		return
+	}
+	parentRoot := bytesutil.ToBytes32(b.ParentRoot)
+	if parent, ok := s.nodes[parentRoot]; ok {
+		parent.children = append(parent.children, root[:])
+	}
+	s.nodes[root] = &blockNode{slot: b.Slot, parentRoot: parentRoot}
+}
+
+// pruneNodes removes the blocks older than the finalized block from the in memory tree.
+func (s *Store) pruneNodes(finalizedRoot []byte) {
+	s.nodesLock.Lock()
+	defer s.nodesLock.Unlock()
+	finalized, ok := s.nodes[bytesutil.ToBytes32(finalizedRoot)]
+	if !ok {
+		return
+	}
+	for root, n := range s.nodes {
+		if n.slot < finalized.slot {
+			delete(s.nodes, root)
+		}
+	}
+}
+
+// latestVote returns a validator's latest vote, from memory once the store has been restored.
+func (s *Store) latestVote(ctx context.Context, validatorIdx uint64) (*pb.ValidatorLatestVote, error) {
+	s.nodesLock.RLock()
+	votes := s.latestVotes
+	vote := votes[validatorIdx]
+	s.nodesLock.RUnlock()
+	if votes != nil {
+		return vote, nil
+	}
+	return s.db.ValidatorLatestVote(ctx, validatorIdx)
+}
+
+// setLatestVote records a validator's latest vote in memory, once the store has been restored.
+func (s *Store) setLatestVote(validatorIdx uint64, vote *pb.ValidatorLatestVote) {
+	s.nodesLock.Lock()
+	defer s.nodesLock.Unlock()
+	if s.latestVotes != nil {
+		s.latestVotes[validatorIdx] = vote
+	}
+}
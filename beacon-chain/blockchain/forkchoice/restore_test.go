@@ -0,0 +1,123 @@
+package forkchoice
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestStore_RestoreFromDB(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	store := NewForkChoiceService(ctx, db)
+
+	roots, err := blockTree1(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validators := make([]*ethpb.Validator, 100)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &ethpb.Validator{ExitEpoch: 2, EffectiveBalance: 1e9}
+	}
+	if err := db.SaveState(ctx, &pb.BeaconState{Validators: validators}, bytesutil.ToBytes32(roots[0])); err != nil {
+		t.Fatal(err)
+	}
+
+	//    /- B1 (33 votes)
+	// B0           /- B5 - B7 (33 votes)
+	//    \- B3 - B4 - B6 - B8 (34 votes)
+	for i := 0; i < len(validators); i++ {
+		vote := &pb.ValidatorLatestVote{Root: roots[8]}
+		switch {
+		case i < 33:
+			vote.Root = roots[1]
+		case i > 66:
+			vote.Root = roots[7]
+		}
+		if err := db.SaveValidatorLatestVote(ctx, uint64(i), vote); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Checkpoints of a state before the first justification have a zero root.
+	zeroHash := params.BeaconConfig().ZeroHash
+	checkpt := &ethpb.Checkpoint{Root: zeroHash[:]}
+	if err := store.RestoreFromDB(ctx, checkpt, checkpt); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(store.FinalizedCheckpt().Root, roots[0]) {
+		t.Errorf("Wanted finalized root %#x, got %#x", roots[0], store.FinalizedCheckpt().Root)
+	}
+	if len(store.nodes) != 8 {
+		t.Errorf("Wanted 8 blocks in tree, got %d", len(store.nodes))
+	}
+	if len(store.latestVotes) != len(validators) {
+		t.Errorf("Wanted %d votes, got %d", len(validators), len(store.latestVotes))
+	}
+
+	head, err := store.Head(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(head, roots[8]) {
+		t.Errorf("Wanted head %#x, got %#x", roots[8], head)
+	}
+
+	// Once restored, votes are read from memory.
+	store.setLatestVote(50, &pb.ValidatorLatestVote{Root: roots[7]})
+	head, err = store.Head(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(head, roots[7]) {
+		t.Errorf("Wanted head %#x, got %#x", roots[7], head)
+	}
+}
+
+func TestStore_InsertAndPruneNodes(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	store := NewForkChoiceService(ctx, db)
+
+	roots, err := blockTree1(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Inserting is a no-op until the store is restored.
+	r9 := [32]byte{'a'}
+	store.insertNode(r9, &ethpb.BeaconBlock{Slot: 9, ParentRoot: roots[8]})
+	if store.nodes != nil {
+		t.Error("Wanted no tree before restoring")
+	}
+
+	checkpt := &ethpb.Checkpoint{Root: roots[0]}
+	if err := store.RestoreFromDB(ctx, checkpt, checkpt); err != nil {
+		t.Fatal(err)
+	}
+	store.insertNode(r9, &ethpb.BeaconBlock{Slot: 9, ParentRoot: roots[8]})
+	children, ok := store.children(roots[8], 0)
+	if !ok || len(children) != 1 || !bytes.Equal(children[0], r9[:]) {
+		t.Errorf("Wanted inserted block as child of B8, got %#x", children)
+	}
+
+	// Finalizing B4 drops B0, B1 and B3.
+	store.pruneNodes(roots[4])
+	for _, r := range [][]byte{roots[0], roots[1], roots[3]} {
+		if store.node(bytesutil.ToBytes32(r)) != nil {
+			t.Errorf("Wanted block %#x to be pruned", r)
+		}
+	}
+	if len(store.nodes) != 6 {
+		t.Errorf("Wanted 6 blocks in tree, got %d", len(store.nodes))
+	}
+}
@@ -7,10 +7,13 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/balances"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoiceaudit"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -18,6 +21,14 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
+// DefaultMaxDepth is the number of blocks fork choice walks through, when looking for an
+// ancestor or for the head, before giving up on the chain as pathological. It is 0 for no
+// limit, since a long period without finality makes for legitimately long walks.
+const DefaultMaxDepth = 0
+
+// ErrMaxDepthExceeded is returned when fork choice walks through more blocks than allowed.
+var ErrMaxDepthExceeded = errors.New("exceeded maximum fork choice depth")
+
 // Store represents a service struct that handles the forkchoice
 // logic of managing the full PoS beacon chain.
 type Store struct {
@@ -28,6 +39,21 @@ type Store struct {
 	finalizedCheckpt *ethpb.Checkpoint
 	lock             sync.RWMutex
 	checkptBlkRoot   map[[32]byte][32]byte
+	maxDepth         uint64
+	equivocating     map[uint64]bool // Validators whose latest messages are ignored.
+	balanceMonitor   *balances.Monitor
+	skipSlots        state.SkipSlots
+	// audit records the head changes, along with the last head applied and the steps of the last
+	// head found, when enabled.
+	audit       *forkchoiceaudit.Log
+	auditedHead []byte
+	foundHead   []byte
+	foundSteps  []*forkchoiceaudit.Step
+	auditLock   sync.Mutex
+	// nodes and latestVotes are only set once the store is restored from the DB.
+	nodes       map[[32]byte]*blockNode
+	latestVotes map[uint64]*pb.ValidatorLatestVote
+	nodesLock   sync.RWMutex
 }
 
 // NewForkChoiceService instantiates a new service instance that will
@@ -39,9 +65,22 @@ func NewForkChoiceService(ctx context.Context, db db.Database) *Store {
 		cancel:         cancel,
 		db:             db,
 		checkptBlkRoot: make(map[[32]byte][32]byte),
+		maxDepth:       DefaultMaxDepth,
+		equivocating:   make(map[uint64]bool),
 	}
 }
 
+// SetMaxDepth sets the number of blocks walked through when looking for an ancestor or for
+// the head, past which an error is returned instead, 0 for no limit.
+func (s *Store) SetMaxDepth(depth uint64) {
+	s.maxDepth = depth
+}
+
+// SetBalanceMonitor sets the monitor the epoch boundary states are analyzed by.
+func (s *Store) SetBalanceMonitor(m *balances.Monitor) {
+	s.balanceMonitor = m
+}
+
 // GenesisStore initializes the store struct before beacon chain
 // starts to advance.
 //
@@ -101,25 +140,45 @@ func (s *Store) GenesisStore(ctx context.Context, genesisState *pb.BeaconState)
 //    assert block.slot >= slot
 //    return root if block.slot == slot else get_ancestor(store, block.parent_root, slot)
 func (s *Store) ancestor(ctx context.Context, root []byte, slot uint64) ([]byte, error) {
-	b, err := s.db.Block(ctx, bytesutil.ToBytes32(root))
-	if err != nil {
-		return nil, errors.Wrap(err, "could not get ancestor block")
-	}
+	// The spec recursion is unrolled, so that a long chain costs no stack.
+	for depth := uint64(0); s.maxDepth == 0 || depth <= s.maxDepth; depth++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if n := s.node(bytesutil.ToBytes32(root)); n != nil {
+			if n.slot < slot {
+				return nil, nil
+			}
+			if n.slot == slot {
+				return root, nil
+			}
+			root = n.parentRoot[:]
+			continue
+		}
+		b, err := s.db.Block(ctx, bytesutil.ToBytes32(root))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get ancestor block")
+		}
 
-	// If we dont have the ancestor in the DB, simply return nil so rest of fork choice
-	// operation can proceed. This is not an error condition.
-	if b == nil || b.Slot < slot {
-		return nil, nil
-	}
+		// If we dont have the ancestor in the DB, simply return nil so rest of fork choice
+		// operation can proceed. This is not an error condition.
+		if b == nil || b.Slot < slot {
+			return nil, nil
+		}
 
-	if b.Slot == slot {
-		return root, nil
-	}
+		if b.Slot == slot {
+			return root, nil
+		}
 
-	return s.ancestor(ctx, b.ParentRoot, slot)
+		root = b.ParentRoot
+	}
+	maxDepthExceeded.WithLabelValues("ancestor").Inc()
+	return nil, errors.Wrapf(ErrMaxDepthExceeded, "no ancestor at slot %d within %d blocks", slot, s.maxDepth)
 }
 
 // latestAttestingBalance returns the staked balance of a block from the input block root.
+// Unlike the spec, the balances of slashed and equivocating validators are left out, since
+// their latest messages can't be trusted to be their only ones.
 //
 // Spec pseudocode definition:
 //   def get_latest_attesting_balance(store: Store, root: Hash) -> Gwei:
@@ -153,14 +212,23 @@ func (s *Store) latestAttestingBalance(ctx context.Context, root []byte) (uint64
 		return 0, errors.Wrap(err, "could not get active indices for last justified checkpoint")
 	}
 
-	wantedBlk, err := s.db.Block(ctx, bytesutil.ToBytes32(root))
-	if err != nil {
-		return 0, errors.Wrap(err, "could not get target block")
+	var wantedSlot uint64
+	if n := s.node(bytesutil.ToBytes32(root)); n != nil {
+		wantedSlot = n.slot
+	} else {
+		wantedBlk, err := s.db.Block(ctx, bytesutil.ToBytes32(root))
+		if err != nil {
+			return 0, errors.Wrap(err, "could not get target block")
+		}
+		wantedSlot = wantedBlk.Slot
 	}
 
 	balances := uint64(0)
 	for _, i := range activeIndices {
-		vote, err := s.db.ValidatorLatestVote(ctx, i)
+		if s.equivocating[i] || lastJustifiedState.Validators[i].Slashed {
+			continue
+		}
+		vote, err := s.latestVote(ctx, i)
 		if err != nil {
 			return 0, errors.Wrapf(err, "could not get validator %d's latest vote", i)
 		}
@@ -168,9 +236,9 @@ func (s *Store) latestAttestingBalance(ctx context.Context, root []byte) (uint64
 			continue
 		}
 
-		wantedRoot, err := s.ancestor(ctx, vote.Root, wantedBlk.Slot)
+		wantedRoot, err := s.ancestor(ctx, vote.Root, wantedSlot)
 		if err != nil {
-			return 0, errors.Wrapf(err, "could not get ancestor root for slot %d", wantedBlk.Slot)
+			return 0, errors.Wrapf(err, "could not get ancestor root for slot %d", wantedSlot)
 		}
 		if bytes.Equal(wantedRoot, root) {
 			balances += lastJustifiedState.Validators[i].EffectiveBalance
@@ -197,32 +265,50 @@ func (s *Store) latestAttestingBalance(ctx context.Context, root []byte) (uint64
 //        head = max(children, key=lambda root: (get_latest_attesting_balance(store, root), root))
 func (s *Store) Head(ctx context.Context) ([]byte, error) {
 	head := s.justifiedCheckpt.Root
+	var steps []*forkchoiceaudit.Step
 
-	for {
+	for depth := uint64(0); ; depth++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		startSlot := s.justifiedCheckpt.Epoch * params.BeaconConfig().SlotsPerEpoch
-		filter := filters.NewFilter().SetParentRoot(head).SetStartSlot(startSlot)
-		children, err := s.db.BlockRoots(ctx, filter)
-		if err != nil {
-			return nil, errors.Wrap(err, "could not retrieve children info")
+		children, ok := s.children(head, startSlot)
+		if !ok {
+			filter := filters.NewFilter().SetParentRoot(head).SetStartSlot(startSlot)
+			var err error
+			children, err = s.db.BlockRoots(ctx, filter)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not retrieve children info")
+			}
 		}
 
 		if len(children) == 0 {
+			if s.audit != nil {
+				s.keepSteps(head, steps)
+			}
 			return head, nil
 		}
+		if s.maxDepth != 0 && depth == s.maxDepth {
+			maxDepthExceeded.WithLabelValues("head").Inc()
+			return nil, errors.Wrapf(ErrMaxDepthExceeded, "no head within %d blocks of the justified block", s.maxDepth)
+		}
 
 		// if a block has one child, then we don't have to lookup anything to
 		// know that this child will be the best child.
+		parent := head
 		head = children[0]
 		if len(children) > 1 {
 			highest, err := s.latestAttestingBalance(ctx, head)
 			if err != nil {
 				return nil, errors.Wrap(err, "could not get latest balance")
 			}
+			balances := []uint64{highest}
 			for _, child := range children[1:] {
 				balance, err := s.latestAttestingBalance(ctx, child)
 				if err != nil {
 					return nil, errors.Wrap(err, "could not get latest balance")
 				}
+				balances = append(balances, balance)
 				// When there's a tie, it's broken lexicographically to favor the higher one.
 				if balance > highest ||
 					balance == highest && bytes.Compare(child, head) > 0 {
@@ -230,6 +316,13 @@ func (s *Store) Head(ctx context.Context) ([]byte, error) {
 					head = child
 				}
 			}
+			if s.audit != nil {
+				step, err := s.auditStep(ctx, parent, children, balances, head)
+				if err != nil {
+					return nil, errors.Wrap(err, "could not audit fork choice step")
+				}
+				steps = append(steps, step)
+			}
 		}
 	}
 }
@@ -7,10 +7,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoiceaudit"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -239,6 +241,7 @@ func TestStore_GetHead(t *testing.T) {
 	defer testDB.TeardownDB(t, db)
 
 	store := NewForkChoiceService(ctx, db)
+	store.SetForkChoiceAudit(forkchoiceaudit.NewLog(4))
 
 	roots, err := blockTree1(db)
 	if err != nil {
@@ -292,6 +295,9 @@ func TestStore_GetHead(t *testing.T) {
 	if !bytes.Equal(head, roots[8]) {
 		t.Error("Incorrect head")
 	}
+	if err := store.AuditHead(ctx, head); err != nil {
+		t.Fatal(err)
+	}
 
 	// 1 validator switches vote to B7 to gain 34%, enough to switch head
 	if err := store.db.SaveValidatorLatestVote(ctx, 50, &pb.ValidatorLatestVote{Root: roots[7]}); err != nil {
@@ -304,6 +310,13 @@ func TestStore_GetHead(t *testing.T) {
 	if !bytes.Equal(head, roots[7]) {
 		t.Error("Incorrect head")
 	}
+	// Finding the head records nothing until it is applied.
+	if decisions := store.ForkChoiceAudit(0); len(decisions) != 0 {
+		t.Errorf("Wanted no head change before the head is applied, got %d", len(decisions))
+	}
+	if err := store.AuditHead(ctx, head); err != nil {
+		t.Fatal(err)
+	}
 
 	// 18 validators switches vote to B1 to gain 51%, enough to switch head
 	for i := 0; i < 18; i++ {
@@ -320,4 +333,160 @@ func TestStore_GetHead(t *testing.T) {
 		t.Log(head)
 		t.Error("Incorrect head")
 	}
+	if err := store.AuditHead(ctx, head); err != nil {
+		t.Fatal(err)
+	}
+
+	// Both head changes are reorgs, the first head found is not a change.
+	decisions := store.ForkChoiceAudit(0)
+	if len(decisions) != 2 {
+		t.Fatalf("Wanted 2 head changes, got %d", len(decisions))
+	}
+	first := decisions[0]
+	if first.PreviousHead != forkchoiceaudit.Root(bytesutil.ToBytes32(roots[8])) ||
+		first.NewHead != forkchoiceaudit.Root(bytesutil.ToBytes32(roots[7])) || !first.Reorg {
+		t.Errorf("Wanted a reorg from B8 to B7, got %+v", first)
+	}
+	if len(first.Steps) == 0 || first.Steps[0].Parent != forkchoiceaudit.Root(bytesutil.ToBytes32(roots[0])) {
+		t.Fatalf("Wanted the first step to weigh the children of B0, got %+v", first.Steps)
+	}
+	if step := first.Steps[0]; len(step.Candidates) != 2 || step.Chosen != forkchoiceaudit.Root(bytesutil.ToBytes32(roots[3])) {
+		t.Errorf("Wanted B3 chosen among 2 candidates, got %+v", step)
+	}
+	if second := decisions[1]; second.NewHead != forkchoiceaudit.Root(bytesutil.ToBytes32(roots[1])) || !second.Reorg {
+		t.Errorf("Wanted a reorg from B7 to B1, got %+v", second)
+	}
+}
+
+func TestStore_AncestorMaxDepth(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	store := NewForkChoiceService(ctx, db)
+
+	roots, err := blockTree1(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// B0 is 4 blocks behind B8.
+	store.SetMaxDepth(3)
+	if _, err := store.ancestor(ctx, roots[8], 0); errors.Cause(err) != ErrMaxDepthExceeded {
+		t.Errorf("Wanted error %v, got %v", ErrMaxDepthExceeded, err)
+	}
+
+	store.SetMaxDepth(4)
+	root, err := store.ancestor(ctx, roots[8], 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(root, roots[0]) {
+		t.Errorf("Wanted ancestor %#x, got %#x", roots[0], root)
+	}
+}
+
+func TestStore_AncestorNoMaxDepthByDefault(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	store := NewForkChoiceService(ctx, db)
+
+	// A chain far longer than any depth limit would reasonably be set to in tests.
+	var roots [][]byte
+	parentRoot := []byte{'g'}
+	for i := uint64(0); i < 64; i++ {
+		b := &ethpb.BeaconBlock{Slot: i, ParentRoot: parentRoot}
+		if err := db.SaveBlock(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+		r, err := ssz.SigningRoot(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		roots = append(roots, r[:])
+		parentRoot = r[:]
+	}
+
+	root, err := store.ancestor(ctx, roots[63], 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(root, roots[0]) {
+		t.Errorf("Wanted ancestor %#x, got %#x", roots[0], root)
+	}
+	store.justifiedCheckpt = &ethpb.Checkpoint{Root: roots[0]}
+	head, err := store.Head(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(head, roots[63]) {
+		t.Errorf("Wanted head %#x, got %#x", roots[63], head)
+	}
+}
+
+func TestStore_HeadMaxDepth(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	store := NewForkChoiceService(ctx, db)
+
+	// A chain of 5 blocks, with many skipped slots in between.
+	var roots [][]byte
+	parentRoot := []byte{'g'}
+	for i := uint64(0); i < 5; i++ {
+		b := &ethpb.BeaconBlock{Slot: i * 100, ParentRoot: parentRoot}
+		if err := db.SaveBlock(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+		r, err := ssz.SigningRoot(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		roots = append(roots, r[:])
+		parentRoot = r[:]
+	}
+	store.justifiedCheckpt = &ethpb.Checkpoint{Root: roots[0]}
+
+	store.SetMaxDepth(3)
+	if _, err := store.Head(ctx); errors.Cause(err) != ErrMaxDepthExceeded {
+		t.Errorf("Wanted error %v, got %v", ErrMaxDepthExceeded, err)
+	}
+
+	store.SetMaxDepth(4)
+	head, err := store.Head(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(head, roots[4]) {
+		t.Errorf("Wanted head %#x, got %#x", roots[4], head)
+	}
+}
+
+func TestStore_HeadDBFailure(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	faultyDB := testDB.NewFaultyDB(db)
+
+	store := NewForkChoiceService(ctx, faultyDB)
+	if err := store.GenesisStore(ctx, &pb.BeaconState{}); err != nil {
+		t.Fatal(err)
+	}
+
+	faultyDB.Inject("BlockRoots", &testDB.Fault{})
+	if _, err := store.Head(ctx); errors.Cause(err) != testDB.ErrInjected {
+		t.Errorf("Wanted error %v, got %v", testDB.ErrInjected, err)
+	}
+
+	faultyDB.Heal()
+	head, err := store.Head(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(head, store.justifiedCheckpt.Root) {
+		t.Errorf("Wanted head %#x, got %#x", store.justifiedCheckpt.Root, head)
+	}
 }
@@ -183,6 +183,9 @@ func (c *ChainService) saveHead(ctx context.Context, b *ethpb.BeaconBlock, r [32
 	if err := c.beaconDB.SaveHeadBlockRoot(ctx, r); err != nil {
 		return errors.Wrap(err, "could not save head root in DB")
 	}
+	if err := c.forkChoiceStore.AuditHead(ctx, r[:]); err != nil {
+		log.WithError(err).Warn("Could not audit head change")
+	}
 	log.WithFields(logrus.Fields{
 		"slots": b.Slot,
 		"root":  hex.EncodeToString(r[:]),
@@ -11,14 +11,19 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/balances"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoiceaudit"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -61,6 +66,13 @@ type Config struct {
 	OpsPoolService operations.OperationFeeds
 	P2p            p2p.Broadcaster
 	MaxRoutines    int64
+	// ForkChoiceMaxDepth overrides forkchoice.DefaultMaxDepth when set.
+	ForkChoiceMaxDepth uint64
+	// BalanceMonitor analyzes the epoch boundary states, if set.
+	BalanceMonitor *balances.Monitor
+	// ForkChoiceAuditSize is the number of head changes whose fork choice decisions are kept in
+	// memory for inspection. 0 disables the audit log.
+	ForkChoiceAuditSize uint64
 }
 
 // NewChainService instantiates a new service instance that will
@@ -68,6 +80,11 @@ type Config struct {
 func NewChainService(ctx context.Context, cfg *Config) (*ChainService, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	store := forkchoice.NewForkChoiceService(ctx, cfg.BeaconDB)
+	if cfg.ForkChoiceMaxDepth != 0 {
+		store.SetMaxDepth(cfg.ForkChoiceMaxDepth)
+	}
+	store.SetBalanceMonitor(cfg.BalanceMonitor)
+	store.SetForkChoiceAudit(forkchoiceaudit.NewLog(cfg.ForkChoiceAuditSize))
 	return &ChainService{
 		ctx:                  ctx,
 		cancel:               cancel,
@@ -94,6 +111,9 @@ func (c *ChainService) Start() {
 	if beaconState != nil {
 		log.Info("Beacon chain data already exists, starting service")
 		c.genesisTime = time.Unix(int64(beaconState.GenesisTime), 0)
+		if err := c.restoreChainInfo(c.ctx, beaconState); err != nil {
+			log.WithError(err).Error("Could not restore chain info from db")
+		}
 	} else {
 		log.Info("Waiting for ChainStart log from the Validator Deposit Contract to start the beacon chain...")
 		if c.web3Service == nil {
@@ -144,6 +164,115 @@ func (c *ChainService) initializeBeaconChain(genesisTime time.Time, deposits []*
 	return nil
 }
 
+// restoreChainInfo rebuilds the fork choice store from the DB, using the checkpoints of the
+// head state, and runs fork choice once so the head is up to date before any block arrives.
+// A persisted head which isn't a descendant of the finalized block is rolled back to it.
+func (c *ChainService) restoreChainInfo(ctx context.Context, headState *pb.BeaconState) error {
+	if err := c.forkChoiceStore.RestoreFromDB(
+		ctx,
+		headState.CurrentJustifiedCheckpoint,
+		headState.FinalizedCheckpoint,
+	); err != nil {
+		return errors.Wrap(err, "could not restore fork choice store")
+	}
+	consistent, err := c.persistedHeadConsistent(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not audit persisted head")
+	}
+	if !consistent {
+		return c.rollbackHeadToFinalized(ctx)
+	}
+	headRoot, err := c.forkChoiceStore.Head(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head from fork choice service")
+	}
+	headBlk, err := c.beaconDB.Block(ctx, bytesutil.ToBytes32(headRoot))
+	if err != nil {
+		return errors.Wrap(err, "could not get head block")
+	}
+	if headBlk == nil {
+		return fmt.Errorf("head block %#x is not in db", bytesutil.Trunc(headRoot))
+	}
+	return c.saveHead(ctx, headBlk, bytesutil.ToBytes32(headRoot))
+}
+
+// persistedHeadConsistent returns true if the head block persisted in the DB is the finalized
+// block or one of its descendants, walking its ancestors through the stored blocks. A head
+// missing from the DB, or whose ancestry is broken, as after an unclean shutdown, isn't.
+func (c *ChainService) persistedHeadConsistent(ctx context.Context) (bool, error) {
+	finalized := c.forkChoiceStore.FinalizedCheckpt()
+	finalizedRoot := bytesutil.ToBytes32(finalized.Root)
+	finalizedBlk, err := c.beaconDB.Block(ctx, finalizedRoot)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get finalized block")
+	}
+	if finalizedBlk == nil {
+		return false, fmt.Errorf("finalized block %#x is not in db", bytesutil.Trunc(finalized.Root))
+	}
+	blk, err := c.beaconDB.HeadBlock(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get persisted head block")
+	}
+	if blk == nil {
+		log.Warn("No head block persisted in db")
+		return false, nil
+	}
+	root, err := ssz.SigningRoot(blk)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get signing root of persisted head block")
+	}
+	headRoot, headSlot := root, blk.Slot
+	for root != finalizedRoot {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		if blk.Slot <= finalizedBlk.Slot {
+			log.WithFields(logrus.Fields{
+				"headSlot": headSlot,
+				"headRoot": fmt.Sprintf("%#x", bytesutil.Trunc(headRoot[:])),
+			}).Warn("Persisted head is not a descendant of the finalized block")
+			return false, nil
+		}
+		root = bytesutil.ToBytes32(blk.ParentRoot)
+		blk, err = c.beaconDB.Block(ctx, root)
+		if err != nil {
+			return false, errors.Wrapf(err, "could not get ancestor %#x of persisted head", bytesutil.Trunc(root[:]))
+		}
+		if blk == nil {
+			log.WithFields(logrus.Fields{
+				"headSlot":    headSlot,
+				"headRoot":    fmt.Sprintf("%#x", bytesutil.Trunc(headRoot[:])),
+				"missingRoot": fmt.Sprintf("%#x", bytesutil.Trunc(root[:])),
+			}).Warn("Persisted head is not reachable from the stored blocks")
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// rollbackHeadToFinalized saves the finalized block as the head, so an inconsistent head
+// persisted before an unclean shutdown is never served.
+func (c *ChainService) rollbackHeadToFinalized(ctx context.Context) error {
+	finalized := c.forkChoiceStore.FinalizedCheckpt()
+	finalizedRoot := bytesutil.ToBytes32(finalized.Root)
+	finalizedBlk, err := c.beaconDB.Block(ctx, finalizedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized block")
+	}
+	if finalizedBlk == nil {
+		return fmt.Errorf("finalized block %#x is not in db", bytesutil.Trunc(finalized.Root))
+	}
+	if err := c.saveHead(ctx, finalizedBlk, finalizedRoot); err != nil {
+		return errors.Wrap(err, "could not roll head back to the finalized block")
+	}
+	log.WithFields(logrus.Fields{
+		"finalizedEpoch": finalized.Epoch,
+		"finalizedSlot":  finalizedBlk.Slot,
+		"finalizedRoot":  fmt.Sprintf("%#x", bytesutil.Trunc(finalized.Root)),
+	}).Warn("Repaired inconsistent chain head, rolled head back to the finalized checkpoint")
+	return nil
+}
+
 // Stop the blockchain service's main event loop and associated goroutines.
 func (c *ChainService) Stop() error {
 	defer c.cancel()
@@ -16,11 +16,15 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/sirupsen/logrus"
@@ -262,3 +266,95 @@ func TestChainStartStop_Initialized(t *testing.T) {
 	}
 	testutil.AssertLogsContain(t, hook, "Beacon chain data already exists, starting service")
 }
+
+// setupRestoredGenesis saves a genesis block and state to the DB, as the only finalized and
+// justified block a restored fork choice store starts from.
+func setupRestoredGenesis(t *testing.T, cs *ChainService) (*pb.BeaconState, *ethpb.BeaconBlock, [32]byte) {
+	ctx := context.Background()
+	deposits, _ := testutil.SetupInitialDeposits(t, 8)
+	beaconState, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stateRoot, err := ssz.HashTreeRoot(beaconState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := b.NewGenesisBlock(stateRoot[:])
+	if err := cs.beaconDB.SaveBlock(ctx, genesis); err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := ssz.SigningRoot(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cs.beaconDB.SaveState(ctx, beaconState, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+	return beaconState, genesis, genesisRoot
+}
+
+func TestRestoreChainInfo_RollsBackUnreachableHead(t *testing.T) {
+	hook := logTest.NewGlobal()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	ctx := context.Background()
+
+	chainService := setupBeaconChain(t, db)
+	headState, genesis, _ := setupRestoredGenesis(t, chainService)
+
+	// The parent of the persisted head was never written, as after an unclean shutdown.
+	missingRoot := bytesutil.ToBytes32([]byte("missing parent"))
+	orphan := &ethpb.BeaconBlock{Slot: 5, ParentRoot: missingRoot[:]}
+	if err := db.SaveBlock(ctx, orphan); err != nil {
+		t.Fatal(err)
+	}
+	orphanRoot, err := ssz.SigningRoot(orphan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveHeadBlockRoot(ctx, orphanRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := chainService.restoreChainInfo(ctx, headState); err != nil {
+		t.Fatalf("Could not restore chain info: %v", err)
+	}
+	headBlock, err := db.HeadBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(headBlock, genesis) {
+		t.Errorf("Wanted head rolled back to %v, got %v", genesis, headBlock)
+	}
+	if chainService.HeadSlot() != 0 {
+		t.Errorf("Wanted head slot 0, got %d", chainService.HeadSlot())
+	}
+	testutil.AssertLogsContain(t, hook, "Persisted head is not reachable from the stored blocks")
+	testutil.AssertLogsContain(t, hook, "rolled head back to the finalized checkpoint")
+}
+
+func TestRestoreChainInfo_KeepsConsistentHead(t *testing.T) {
+	hook := logTest.NewGlobal()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	ctx := context.Background()
+
+	chainService := setupBeaconChain(t, db)
+	headState, genesis, genesisRoot := setupRestoredGenesis(t, chainService)
+	if err := db.SaveHeadBlockRoot(ctx, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := chainService.restoreChainInfo(ctx, headState); err != nil {
+		t.Fatalf("Could not restore chain info: %v", err)
+	}
+	headBlock, err := db.HeadBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(headBlock, genesis) {
+		t.Errorf("Wanted head %v, got %v", genesis, headBlock)
+	}
+	testutil.AssertLogsDoNotContain(t, hook, "rolled head back to the finalized checkpoint")
+}
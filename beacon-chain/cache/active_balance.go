@@ -3,13 +3,11 @@ package cache
 import (
 	"errors"
 	"strconv"
-	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
-	"k8s.io/client-go/tools/cache"
 )
 
 var (
@@ -20,14 +18,14 @@ var (
 	// maxActiveBalanceListSize defines the max number of active balance can cache.
 	maxActiveBalanceListSize = 1000
 
-	// Metrics.
+	// Metrics, deprecated in favour of the metrics of the active_balance store.
 	activeBalanceCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "active_balance_cache_miss",
-		Help: "The number of active balance requests that aren't present in the cache.",
+		Help: "The number of active balance requests that aren't present in the cache. Deprecated, use cache_misses_total{cache=\"active_balance\"}.",
 	})
 	activeBalanceCacheHit = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "active_balance_cache_hit",
-		Help: "The number of active balance requests that are present in the cache.",
+		Help: "The number of active balance requests that are present in the cache. Deprecated, use cache_hits_total{cache=\"active_balance\"}.",
 	})
 )
 
@@ -39,8 +37,7 @@ type ActiveBalanceByEpoch struct {
 
 // ActiveBalanceCache is a struct with 1 queue for looking up active balance by epoch.
 type ActiveBalanceCache struct {
-	activeBalanceCache *cache.FIFO
-	lock               sync.RWMutex
+	activeBalanceCache *Store
 }
 
 // activeBalanceKeyFn takes the epoch as the key for the active balance of a given epoch.
@@ -56,7 +53,15 @@ func activeBalanceKeyFn(obj interface{}) (string, error) {
 // NewActiveBalanceCache creates a new active balance cache for storing/accessing active validator balance.
 func NewActiveBalanceCache() *ActiveBalanceCache {
 	return &ActiveBalanceCache{
-		activeBalanceCache: cache.NewFIFO(activeBalanceKeyFn),
+		activeBalanceCache: NewStore(StoreConfig{
+			Name:         "active_balance",
+			MaxSize:      maxActiveBalanceListSize,
+			StateDerived: true,
+			Legacy: &LegacyMetrics{
+				Hits:   activeBalanceCacheHit,
+				Misses: activeBalanceCacheMiss,
+			},
+		}),
 	}
 }
 
@@ -69,17 +74,8 @@ func (c *ActiveBalanceCache) ActiveBalanceInEpoch(epoch uint64) (uint64, error)
 		return params.BeaconConfig().FarFutureEpoch, nil
 	}
 
-	c.lock.RLock()
-	defer c.lock.RUnlock()
-	obj, exists, err := c.activeBalanceCache.GetByKey(strconv.Itoa(int(epoch)))
-	if err != nil {
-		return params.BeaconConfig().FarFutureEpoch, err
-	}
-
-	if exists {
-		activeBalanceCacheHit.Inc()
-	} else {
-		activeBalanceCacheMiss.Inc()
+	obj, exists := c.activeBalanceCache.Get(strconv.Itoa(int(epoch)))
+	if !exists {
 		return params.BeaconConfig().FarFutureEpoch, nil
 	}
 
@@ -98,12 +94,15 @@ func (c *ActiveBalanceCache) AddActiveBalance(activeBalance *ActiveBalanceByEpoc
 		return nil
 	}
 
-	c.lock.Lock()
-	defer c.lock.Unlock()
-	if err := c.activeBalanceCache.AddIfNotPresent(activeBalance); err != nil {
+	key, err := activeBalanceKeyFn(activeBalance)
+	if err != nil {
 		return err
 	}
-
-	trim(c.activeBalanceCache, maxActiveBalanceListSize)
+	c.activeBalanceCache.SetIfAbsent(key, activeBalance)
 	return nil
 }
+
+// Clear removes the cached active balances.
+func (c *ActiveBalanceCache) Clear() {
+	c.activeBalanceCache.Clear()
+}
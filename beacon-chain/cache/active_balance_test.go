@@ -73,11 +73,11 @@ func TestActiveBalance_MaxSize(t *testing.T) {
 		}
 	}
 
-	if len(cache.activeBalanceCache.ListKeys()) != maxActiveBalanceListSize {
+	if cache.activeBalanceCache.Len() != maxActiveBalanceListSize {
 		t.Errorf(
 			"Expected hash cache key size to be %d, got %d",
 			maxActiveBalanceListSize,
-			len(cache.activeBalanceCache.ListKeys()),
+			cache.activeBalanceCache.Len(),
 		)
 	}
 }
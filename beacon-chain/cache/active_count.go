@@ -3,12 +3,10 @@ package cache
 import (
 	"errors"
 	"strconv"
-	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/shared/params"
-	"k8s.io/client-go/tools/cache"
 )
 
 var (
@@ -19,14 +17,14 @@ var (
 	// maxActiveCountListSize defines the max number of active count can cache.
 	maxActiveCountListSize = 1000
 
-	// Metrics.
+	// Metrics, deprecated in favour of the metrics of the active_validator_count store.
 	activeCountCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "active_validator_count_cache_miss",
-		Help: "The number of active validator count requests that aren't present in the cache.",
+		Help: "The number of active validator count requests that aren't present in the cache. Deprecated, use cache_misses_total{cache=\"active_validator_count\"}.",
 	})
 	activeCountCacheHit = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "active_validator_count_cache_hit",
-		Help: "The number of active validator count requests that are present in the cache.",
+		Help: "The number of active validator count requests that are present in the cache. Deprecated, use cache_hits_total{cache=\"active_validator_count\"}.",
 	})
 )
 
@@ -38,8 +36,7 @@ type ActiveCountByEpoch struct {
 
 // ActiveCountCache is a struct with 1 queue for looking up active count by epoch.
 type ActiveCountCache struct {
-	activeCountCache *cache.FIFO
-	lock             sync.RWMutex
+	activeCountCache *Store
 }
 
 // activeCountKeyFn takes the epoch as the key for the active count of a given epoch.
@@ -55,24 +52,23 @@ func activeCountKeyFn(obj interface{}) (string, error) {
 // NewActiveCountCache creates a new active count cache for storing/accessing active validator count.
 func NewActiveCountCache() *ActiveCountCache {
 	return &ActiveCountCache{
-		activeCountCache: cache.NewFIFO(activeCountKeyFn),
+		activeCountCache: NewStore(StoreConfig{
+			Name:         "active_validator_count",
+			MaxSize:      maxActiveCountListSize,
+			StateDerived: true,
+			Legacy: &LegacyMetrics{
+				Hits:   activeCountCacheHit,
+				Misses: activeCountCacheMiss,
+			},
+		}),
 	}
 }
 
 // ActiveCountInEpoch fetches ActiveCountByEpoch by epoch. Returns true with a
 // reference to the ActiveCountInEpoch info, if exists. Otherwise returns false, nil.
 func (c *ActiveCountCache) ActiveCountInEpoch(epoch uint64) (uint64, error) {
-	c.lock.RLock()
-	defer c.lock.RUnlock()
-	obj, exists, err := c.activeCountCache.GetByKey(strconv.Itoa(int(epoch)))
-	if err != nil {
-		return params.BeaconConfig().FarFutureEpoch, err
-	}
-
-	if exists {
-		activeCountCacheHit.Inc()
-	} else {
-		activeCountCacheMiss.Inc()
+	obj, exists := c.activeCountCache.Get(strconv.Itoa(int(epoch)))
+	if !exists {
 		return params.BeaconConfig().FarFutureEpoch, nil
 	}
 
@@ -87,12 +83,15 @@ func (c *ActiveCountCache) ActiveCountInEpoch(epoch uint64) (uint64, error) {
 // AddActiveCount adds ActiveCountByEpoch object to the cache. This method also trims the least
 // recently added ActiveCountByEpoch object if the cache size has ready the max cache size limit.
 func (c *ActiveCountCache) AddActiveCount(activeCount *ActiveCountByEpoch) error {
-	c.lock.Lock()
-	defer c.lock.Unlock()
-	if err := c.activeCountCache.AddIfNotPresent(activeCount); err != nil {
+	key, err := activeCountKeyFn(activeCount)
+	if err != nil {
 		return err
 	}
-
-	trim(c.activeCountCache, maxActiveCountListSize)
+	c.activeCountCache.SetIfAbsent(key, activeCount)
 	return nil
 }
+
+// Clear removes the cached active validator counts.
+func (c *ActiveCountCache) Clear() {
+	c.activeCountCache.Clear()
+}
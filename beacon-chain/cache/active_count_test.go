@@ -73,11 +73,11 @@ func TestActiveCount_MaxSize(t *testing.T) {
 		}
 	}
 
-	if len(cache.activeCountCache.ListKeys()) != maxActiveCountListSize {
+	if cache.activeCountCache.Len() != maxActiveCountListSize {
 		t.Errorf(
 			"Expected hash cache key size to be %d, got %d",
 			maxActiveCountListSize,
-			len(cache.activeCountCache.ListKeys()),
+			cache.activeCountCache.Len(),
 		)
 	}
 }
@@ -3,11 +3,9 @@ package cache
 import (
 	"errors"
 	"strconv"
-	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
-	"k8s.io/client-go/tools/cache"
 )
 
 var (
@@ -18,14 +16,14 @@ var (
 	// maxActiveIndicesListSize defines the max number of active indices can cache.
 	maxActiveIndicesListSize = 4
 
-	// Metrics.
+	// Metrics, deprecated in favour of the metrics of the active_validator_indices store.
 	activeIndicesCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "active_validator_indices_cache_miss",
-		Help: "The number of active validator indices requests that aren't present in the cache.",
+		Help: "The number of active validator indices requests that aren't present in the cache. Deprecated, use cache_misses_total{cache=\"active_validator_indices\"}.",
 	})
 	activeIndicesCacheHit = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "active_validator_indices_cache_hit",
-		Help: "The number of active validator indices requests that are present in the cache.",
+		Help: "The number of active validator indices requests that are present in the cache. Deprecated, use cache_hits_total{cache=\"active_validator_indices\"}.",
 	})
 )
 
@@ -37,8 +35,7 @@ type ActiveIndicesByEpoch struct {
 
 // ActiveIndicesCache is a struct with 1 queue for looking up active indices by epoch.
 type ActiveIndicesCache struct {
-	activeIndicesCache *cache.FIFO
-	lock               sync.RWMutex
+	activeIndicesCache *Store
 }
 
 // activeIndicesKeyFn takes the epoch as the key for the active indices of a given epoch.
@@ -54,24 +51,23 @@ func activeIndicesKeyFn(obj interface{}) (string, error) {
 // NewActiveIndicesCache creates a new active indices cache for storing/accessing active validator indices.
 func NewActiveIndicesCache() *ActiveIndicesCache {
 	return &ActiveIndicesCache{
-		activeIndicesCache: cache.NewFIFO(activeIndicesKeyFn),
+		activeIndicesCache: NewStore(StoreConfig{
+			Name:         "active_validator_indices",
+			MaxSize:      maxActiveIndicesListSize,
+			StateDerived: true,
+			Legacy: &LegacyMetrics{
+				Hits:   activeIndicesCacheHit,
+				Misses: activeIndicesCacheMiss,
+			},
+		}),
 	}
 }
 
 // ActiveIndicesInEpoch fetches ActiveIndicesByEpoch by epoch. Returns true with a
 // reference to the ActiveIndicesInEpoch info, if exists. Otherwise returns false, nil.
 func (c *ActiveIndicesCache) ActiveIndicesInEpoch(epoch uint64) ([]uint64, error) {
-	c.lock.RLock()
-	defer c.lock.RUnlock()
-	obj, exists, err := c.activeIndicesCache.GetByKey(strconv.Itoa(int(epoch)))
-	if err != nil {
-		return nil, err
-	}
-
-	if exists {
-		activeIndicesCacheHit.Inc()
-	} else {
-		activeIndicesCacheMiss.Inc()
+	obj, exists := c.activeIndicesCache.Get(strconv.Itoa(int(epoch)))
+	if !exists {
 		return nil, nil
 	}
 
@@ -86,17 +82,20 @@ func (c *ActiveIndicesCache) ActiveIndicesInEpoch(epoch uint64) ([]uint64, error
 // AddActiveIndicesList adds ActiveIndicesByEpoch object to the cache. This method also trims the least
 // recently added ActiveIndicesByEpoch object if the cache size has ready the max cache size limit.
 func (c *ActiveIndicesCache) AddActiveIndicesList(activeIndices *ActiveIndicesByEpoch) error {
-	c.lock.Lock()
-	defer c.lock.Unlock()
-	if err := c.activeIndicesCache.AddIfNotPresent(activeIndices); err != nil {
+	key, err := activeIndicesKeyFn(activeIndices)
+	if err != nil {
 		return err
 	}
-
-	trim(c.activeIndicesCache, maxActiveIndicesListSize)
+	c.activeIndicesCache.SetIfAbsent(key, activeIndices)
 	return nil
 }
 
 // ActiveIndicesKeys returns the keys of the active indices cache.
 func (c *ActiveIndicesCache) ActiveIndicesKeys() []string {
-	return c.activeIndicesCache.ListKeys()
+	return c.activeIndicesCache.Keys()
+}
+
+// Clear removes the cached active validator indices.
+func (c *ActiveIndicesCache) Clear() {
+	c.activeIndicesCache.Clear()
 }
@@ -72,11 +72,11 @@ func TestActiveIndices_MaxSize(t *testing.T) {
 		}
 	}
 
-	if len(cache.activeIndicesCache.ListKeys()) != maxActiveIndicesListSize {
+	if cache.activeIndicesCache.Len() != maxActiveIndicesListSize {
 		t.Errorf(
 			"Expected hash cache key size to be %d, got %d",
 			maxActiveIndicesListSize,
-			len(cache.activeIndicesCache.ListKeys()),
+			cache.activeIndicesCache.Len(),
 		)
 	}
 }
@@ -13,7 +13,6 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
-	"k8s.io/client-go/tools/cache"
 )
 
 var (
@@ -22,18 +21,18 @@ var (
 	maxDelay    = float64(100000000) // 0.1 second
 	delayFactor = 1.1
 
-	// Metrics
+	// Metrics, deprecated in favour of the metrics of the attestation_data store.
 	attestationCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "attestation_cache_miss",
-		Help: "The number of attestation data requests that aren't present in the cache.",
+		Help: "The number of attestation data requests that aren't present in the cache. Deprecated, use cache_misses_total{cache=\"attestation_data\"}.",
 	})
 	attestationCacheHit = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "attestation_cache_hit",
-		Help: "The number of attestation data requests that are present in the cache.",
+		Help: "The number of attestation data requests that are present in the cache. Deprecated, use cache_hits_total{cache=\"attestation_data\"}.",
 	})
 	attestationCacheSize = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "attestation_cache_size",
-		Help: "The number of attestation data in the attestations cache",
+		Help: "The number of attestation data in the attestations cache. Deprecated, use cache_size{cache=\"attestation_data\"}.",
 	})
 )
 
@@ -44,7 +43,7 @@ var ErrAlreadyInProgress = errors.New("already in progress")
 
 // AttestationCache is used to store the cached results of an AttestationData request.
 type AttestationCache struct {
-	cache      *cache.FIFO
+	cache      *Store
 	lock       sync.RWMutex
 	inProgress map[string]bool
 }
@@ -52,7 +51,15 @@ type AttestationCache struct {
 // NewAttestationCache initializes the map and underlying cache.
 func NewAttestationCache() *AttestationCache {
 	return &AttestationCache{
-		cache:      cache.NewFIFO(wrapperToKey),
+		cache: NewStore(StoreConfig{
+			Name:    "attestation_data",
+			MaxSize: maxCacheSize,
+			Legacy: &LegacyMetrics{
+				Hits:   attestationCacheHit,
+				Misses: attestationCacheMiss,
+				Size:   attestationCacheSize,
+			},
+		}),
 		inProgress: make(map[string]bool),
 	}
 }
@@ -98,16 +105,10 @@ func (c *AttestationCache) Get(ctx context.Context, req *pb.AttestationRequest)
 		delay = math.Min(delay, maxDelay)
 	}
 
-	item, exists, err := c.cache.GetByKey(s)
-	if err != nil {
-		return nil, err
-	}
-
+	item, exists := c.cache.Get(s)
 	if exists && item != nil && item.(*attestationReqResWrapper).res != nil {
-		attestationCacheHit.Inc()
 		return item.(*attestationReqResWrapper).res, nil
 	}
-	attestationCacheMiss.Inc()
 	return nil, nil
 }
 
@@ -160,12 +161,11 @@ func (c *AttestationCache) Put(ctx context.Context, req *pb.AttestationRequest,
 		req,
 		res,
 	}
-	if err := c.cache.AddIfNotPresent(data); err != nil {
+	key, err := wrapperToKey(data)
+	if err != nil {
 		return err
 	}
-	trim(c.cache, maxCacheSize)
-
-	attestationCacheSize.Set(float64(len(c.cache.List())))
+	c.cache.SetIfAbsent(key, data)
 	return nil
 }
 
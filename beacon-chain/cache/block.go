@@ -3,31 +3,30 @@ package cache
 import (
 	"errors"
 	"strconv"
-	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
-	"k8s.io/client-go/tools/cache"
 )
 
 var (
 	// ErrNotAncestorCacheObj will be returned when a cache object is not a pointer to
 	// block ancestor cache obj.
 	ErrNotAncestorCacheObj = errors.New("object is not an ancestor object for cache")
-	// Metrics
+
+	// Metrics, deprecated in favour of the metrics of the ancestor_block store.
 	ancestorBlockCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "ancestor_block_cache_miss",
-		Help: "The number of ancestor block requests that aren't present in the cache.",
+		Help: "The number of ancestor block requests that aren't present in the cache. Deprecated, use cache_misses_total{cache=\"ancestor_block\"}.",
 	})
 	ancestorBlockCacheHit = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "ancestor_block_cache_hit",
-		Help: "The number of ancestor block requests that are present in the cache.",
+		Help: "The number of ancestor block requests that are present in the cache. Deprecated, use cache_hits_total{cache=\"ancestor_block\"}.",
 	})
 	ancestorBlockCacheSize = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "ancestor_block_cache_size",
-		Help: "The number of ancestor blocks in the ancestorBlock cache",
+		Help: "The number of ancestor blocks in the ancestorBlock cache. Deprecated, use cache_size{cache=\"ancestor_block\"}.",
 	})
 )
 
@@ -40,8 +39,7 @@ type AncestorInfo struct {
 
 // AncestorBlockCache structs with 1 queue for looking up block ancestor by height.
 type AncestorBlockCache struct {
-	ancestorBlockCache *cache.FIFO
-	lock               sync.RWMutex
+	ancestorBlockCache *Store
 }
 
 // heightKeyFn takes the string representation of the block hash + height as the key
@@ -59,7 +57,15 @@ func heightKeyFn(obj interface{}) (string, error) {
 // from memory.
 func NewBlockAncestorCache() *AncestorBlockCache {
 	return &AncestorBlockCache{
-		ancestorBlockCache: cache.NewFIFO(heightKeyFn),
+		ancestorBlockCache: NewStore(StoreConfig{
+			Name:    "ancestor_block",
+			MaxSize: maxCacheSize,
+			Legacy: &LegacyMetrics{
+				Hits:   ancestorBlockCacheHit,
+				Misses: ancestorBlockCacheMiss,
+				Size:   ancestorBlockCacheSize,
+			},
+		}),
 	}
 }
 
@@ -72,18 +78,8 @@ func (a *AncestorBlockCache) AncestorBySlot(blockHash []byte, height uint64) (*A
 		return nil, nil
 	}
 
-	a.lock.RLock()
-	defer a.lock.RUnlock()
-
-	obj, exists, err := a.ancestorBlockCache.GetByKey(string(blockHash) + strconv.Itoa(int(height)))
-	if err != nil {
-		return nil, err
-	}
-
-	if exists {
-		ancestorBlockCacheHit.Inc()
-	} else {
-		ancestorBlockCacheMiss.Inc()
+	obj, exists := a.ancestorBlockCache.Get(string(blockHash) + strconv.Itoa(int(height)))
+	if !exists {
 		return nil, nil
 	}
 
@@ -102,14 +98,10 @@ func (a *AncestorBlockCache) AddBlockAncestor(ancestorInfo *AncestorInfo) error
 		return nil
 	}
 
-	a.lock.Lock()
-	defer a.lock.Unlock()
-
-	if err := a.ancestorBlockCache.AddIfNotPresent(ancestorInfo); err != nil {
+	key, err := heightKeyFn(ancestorInfo)
+	if err != nil {
 		return err
 	}
-
-	trim(a.ancestorBlockCache, maxCacheSize)
-	ancestorBlockCacheSize.Set(float64(len(a.ancestorBlockCache.ListKeys())))
+	a.ancestorBlockCache.SetIfAbsent(key, ancestorInfo)
 	return nil
 }
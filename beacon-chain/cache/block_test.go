@@ -101,11 +101,11 @@ func TestBlockAncestor_maxSize(t *testing.T) {
 		}
 	}
 
-	if len(cache.ancestorBlockCache.ListKeys()) != maxCacheSize {
+	if cache.ancestorBlockCache.Len() != maxCacheSize {
 		t.Errorf(
 			"Expected hash cache key size to be %d, got %d",
 			maxCacheSize,
-			len(cache.ancestorBlockCache.ListKeys()),
+			cache.ancestorBlockCache.Len(),
 		)
 	}
 }
@@ -2,7 +2,6 @@ package cache
 
 import (
 	"github.com/prysmaticlabs/prysm/shared/params"
-	"k8s.io/client-go/tools/cache"
 )
 
 var (
@@ -10,16 +9,3 @@ var (
 	// Requests should be only accessing committees within defined epoch length.
 	maxCacheSize = int(4 * params.BeaconConfig().SlotsPerEpoch)
 )
-
-// trim the FIFO queue to the maxSize.
-func trim(queue *cache.FIFO, maxSize int) {
-	for s := len(queue.ListKeys()); s > maxSize; s-- {
-		// #nosec G104 popProcessNoopFunc never returns an error
-		_, _ = queue.Pop(popProcessNoopFunc)
-	}
-}
-
-// popProcessNoopFunc is a no-op function that never returns an error.
-func popProcessNoopFunc(obj interface{}) error {
-	return nil
-}
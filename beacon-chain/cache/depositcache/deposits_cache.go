@@ -148,3 +148,17 @@ func (dc *DepositCache) DepositByPubkey(ctx context.Context, pubKey []byte) (*et
 	}
 	return deposit, blockNum
 }
+
+// DepositsFrom returns the containers of the deposits whose index is at least the given index,
+// in index order.
+func (dc *DepositCache) DepositsFrom(ctx context.Context, index int) []*DepositContainer {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DepositsFrom")
+	defer span.End()
+	dc.depositsLock.RLock()
+	defer dc.depositsLock.RUnlock()
+
+	idx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Index >= index })
+	ctnrs := make([]*DepositContainer, len(dc.deposits)-idx)
+	copy(ctnrs, dc.deposits[idx:])
+	return ctnrs
+}
@@ -284,3 +284,23 @@ func TestBeaconDB_DepositByPubkey_ReturnsFirstMatchingDeposit(t *testing.T) {
 		t.Errorf("Returned wrong block number %v", blkNum)
 	}
 }
+
+func TestBeaconDB_DepositsFrom_ReturnsDepositsFromIndex(t *testing.T) {
+	dc := DepositCache{}
+	for _, index := range []int{2, 0, 3, 1} {
+		dc.InsertDeposit(context.Background(), &ethpb.Deposit{}, big.NewInt(int64(index)), index, [32]byte{})
+	}
+
+	ctnrs := dc.DepositsFrom(context.Background(), 2)
+	if len(ctnrs) != 2 {
+		t.Fatalf("Wanted 2 deposits, got %d", len(ctnrs))
+	}
+	for i, ctnr := range ctnrs {
+		if ctnr.Index != i+2 {
+			t.Errorf("Wanted deposit %d, got %d", i+2, ctnr.Index)
+		}
+	}
+	if ctnrs := dc.DepositsFrom(context.Background(), 4); len(ctnrs) != 0 {
+		t.Errorf("Wanted no deposits, got %d", len(ctnrs))
+	}
+}
@@ -1,6 +1,7 @@
 package depositcache
 
 import (
+	"bytes"
 	"context"
 	"math/big"
 	"sort"
@@ -88,6 +89,30 @@ func (dc *DepositCache) PendingContainers(ctx context.Context, beforeBlk *big.In
 	return depositCntrs
 }
 
+// PendingDepositsByPubkey returns the pending deposit containers, sorted by
+// Merkle index, whose deposit data contains the given public key. These are
+// deposits which have been observed on the proof of work chain but not yet
+// included in the beacon state.
+func (dc *DepositCache) PendingDepositsByPubkey(ctx context.Context, pubKey []byte) []*DepositContainer {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PendingDepositsByPubkey")
+	defer span.End()
+	dc.depositsLock.RLock()
+	defer dc.depositsLock.RUnlock()
+
+	var depositCntrs []*DepositContainer
+	for _, ctnr := range dc.pendingDeposits {
+		if ctnr.Deposit.Data != nil && bytes.Equal(ctnr.Deposit.Data.PublicKey, pubKey) {
+			depositCntrs = append(depositCntrs, ctnr)
+		}
+	}
+	// Sort the deposits by Merkle index.
+	sort.SliceStable(depositCntrs, func(i, j int) bool {
+		return depositCntrs[i].Index < depositCntrs[j].Index
+	})
+
+	return depositCntrs
+}
+
 // RemovePendingDeposit from the database. The deposit is indexed by the
 // Index. This method does nothing if deposit ptr is nil.
 func (dc *DepositCache) RemovePendingDeposit(ctx context.Context, d *ethpb.Deposit) {
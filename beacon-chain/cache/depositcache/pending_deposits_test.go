@@ -87,6 +87,28 @@ func TestPendingDeposits_OK(t *testing.T) {
 	}
 }
 
+func TestPendingDepositsByPubkey_OK(t *testing.T) {
+	dc := DepositCache{}
+
+	dc.pendingDeposits = []*DepositContainer{
+		{Block: big.NewInt(6), Index: 6, Deposit: &ethpb.Deposit{Data: &ethpb.Deposit_Data{PublicKey: []byte("A")}}},
+		{Block: big.NewInt(4), Index: 4, Deposit: &ethpb.Deposit{Data: &ethpb.Deposit_Data{PublicKey: []byte("B")}}},
+		{Block: big.NewInt(2), Index: 2, Deposit: &ethpb.Deposit{Data: &ethpb.Deposit_Data{PublicKey: []byte("A")}}},
+	}
+
+	ctnrs := dc.PendingDepositsByPubkey(context.Background(), []byte("A"))
+	if len(ctnrs) != 2 {
+		t.Fatalf("Expected 2 pending deposits, received %d", len(ctnrs))
+	}
+	if ctnrs[0].Index != 2 || ctnrs[1].Index != 6 {
+		t.Errorf("Expected deposits sorted by index, received %d and %d", ctnrs[0].Index, ctnrs[1].Index)
+	}
+
+	if ctnrs := dc.PendingDepositsByPubkey(context.Background(), []byte("C")); len(ctnrs) != 0 {
+		t.Errorf("Expected no pending deposits for unknown key, received %d", len(ctnrs))
+	}
+}
+
 func TestPrunePendingDeposits_ZeroMerkleIndex(t *testing.T) {
 	dc := DepositCache{}
 
@@ -7,7 +7,6 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
-	"k8s.io/client-go/tools/cache"
 )
 
 var (
@@ -18,14 +17,14 @@ var (
 	// maxEth1DataVoteSize defines the max number of eth1 data votes can cache.
 	maxEth1DataVoteSize = 1000
 
-	// Metrics.
+	// Metrics, deprecated in favour of the metrics of the eth1_data_vote store.
 	eth1DataVoteCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "eth1_data_vote_cache_miss",
-		Help: "The number of eth1 data vote count requests that aren't present in the cache.",
+		Help: "The number of eth1 data vote count requests that aren't present in the cache. Deprecated, use cache_misses_total{cache=\"eth1_data_vote\"}.",
 	})
 	eth1DataVoteCacheHit = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "eth1_data_vote_cache_hit",
-		Help: "The number of eth1 data vote count requests that are present in the cache.",
+		Help: "The number of eth1 data vote count requests that are present in the cache. Deprecated, use cache_hits_total{cache=\"eth1_data_vote\"}.",
 	})
 )
 
@@ -37,8 +36,8 @@ type Eth1DataVote struct {
 
 // Eth1DataVoteCache is a struct with 1 queue for looking up eth1 data vote count by deposit root.
 type Eth1DataVoteCache struct {
-	eth1DataVoteCache *cache.FIFO
-	lock              sync.RWMutex
+	eth1DataVoteCache *Store
+	lock              sync.Mutex
 }
 
 // eth1DataVoteKeyFn takes the eth1data hash as the key for the eth1 data vote count of a given eth1data object.
@@ -54,7 +53,15 @@ func eth1DataVoteKeyFn(obj interface{}) (string, error) {
 // NewEth1DataVoteCache creates a new eth1 data vote count cache for storing/accessing Eth1DataVote.
 func NewEth1DataVoteCache() *Eth1DataVoteCache {
 	return &Eth1DataVoteCache{
-		eth1DataVoteCache: cache.NewFIFO(eth1DataVoteKeyFn),
+		eth1DataVoteCache: NewStore(StoreConfig{
+			Name:         "eth1_data_vote",
+			MaxSize:      maxEth1DataVoteSize,
+			StateDerived: true,
+			Legacy: &LegacyMetrics{
+				Hits:   eth1DataVoteCacheHit,
+				Misses: eth1DataVoteCacheMiss,
+			},
+		}),
 	}
 }
 
@@ -67,17 +74,10 @@ func (c *Eth1DataVoteCache) Eth1DataVote(eth1DataHash [32]byte) (uint64, error)
 		return 0, nil
 	}
 
-	c.lock.RLock()
-	defer c.lock.RUnlock()
-	obj, exists, err := c.eth1DataVoteCache.GetByKey(string(eth1DataHash[:]))
-	if err != nil {
-		return 0, err
-	}
-
-	if exists {
-		eth1DataVoteCacheHit.Inc()
-	} else {
-		eth1DataVoteCacheMiss.Inc()
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	obj, exists := c.eth1DataVoteCache.Get(string(eth1DataHash[:]))
+	if !exists {
 		return 0, nil
 	}
 
@@ -96,13 +96,13 @@ func (c *Eth1DataVoteCache) AddEth1DataVote(eth1DataVote *Eth1DataVote) error {
 		return nil
 	}
 
-	c.lock.Lock()
-	defer c.lock.Unlock()
-	if err := c.eth1DataVoteCache.Add(eth1DataVote); err != nil {
+	key, err := eth1DataVoteKeyFn(eth1DataVote)
+	if err != nil {
 		return err
 	}
-
-	trim(c.eth1DataVoteCache, maxEth1DataVoteSize)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.eth1DataVoteCache.Set(key, eth1DataVote)
 	return nil
 }
 
@@ -113,24 +113,22 @@ func (c *Eth1DataVoteCache) IncrementEth1DataVote(eth1DataHash [32]byte) (uint64
 		return 0, nil
 	}
 
-	c.lock.RLock()
-	defer c.lock.RUnlock()
-	obj, exists, err := c.eth1DataVoteCache.GetByKey(string(eth1DataHash[:]))
-	if err != nil {
-		return 0, err
-	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	obj, exists := c.eth1DataVoteCache.Get(string(eth1DataHash[:]))
 	if !exists {
 		return 0, errors.New("eth1 data vote object does not exist")
 	}
 
-	eth1DataVoteCacheHit.Inc()
-
-	eInfo, _ := obj.(*Eth1DataVote)
-	eInfo.VoteCount++
-
-	if err := c.eth1DataVoteCache.Add(eInfo); err != nil {
-		return 0, err
+	eInfo, ok := obj.(*Eth1DataVote)
+	if !ok {
+		return 0, ErrNotEth1DataVote
 	}
-
+	eInfo.VoteCount++
 	return eInfo.VoteCount, nil
 }
+
+// Clear removes the cached eth1 data votes.
+func (c *Eth1DataVoteCache) Clear() {
+	c.eth1DataVoteCache.Clear()
+}
@@ -100,11 +100,11 @@ func TestEth1Data_MaxSize(t *testing.T) {
 		}
 	}
 
-	if len(cache.eth1DataVoteCache.ListKeys()) != maxEth1DataVoteSize {
+	if cache.eth1DataVoteCache.Len() != maxEth1DataVoteSize {
 		t.Errorf(
 			"Expected hash cache key size to be %d, got %d",
 			maxEth1DataVoteSize,
-			len(cache.eth1DataVoteCache.ListKeys()),
+			cache.eth1DataVoteCache.Len(),
 		)
 	}
 }
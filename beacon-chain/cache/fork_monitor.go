@@ -0,0 +1,259 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+var (
+	// forkMonitorEpochs is the number of epochs before the highest observed head for which
+	// competing heads are tracked.
+	forkMonitorEpochs = uint64(2)
+
+	// Metrics
+	forkMonitorContestedSlots = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "fork_monitor_contested_slots",
+		Help: "The number of recent slots at which competing heads were reported or relayed",
+	})
+	forkMonitorCompetingHeads = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "fork_monitor_competing_heads",
+		Help: "The number of distinct heads observed on competing branches",
+	})
+	forkMonitorMinorityWeight = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "fork_monitor_minority_weight",
+		Help: "The number of peers following a competing head other than the heaviest one",
+	})
+)
+
+// ObservedHead is a head block observed on the network, weighted by the number of peers whose
+// last reported or relayed head it is.
+type ObservedHead struct {
+	Slot   uint64
+	Root   [32]byte
+	Weight uint64
+}
+
+// BlockReader reads the blocks the node holds, to walk the ancestry of the observed heads.
+type BlockReader interface {
+	Block(ctx context.Context, blockRoot [32]byte) (*ethpb.BeaconBlock, error)
+}
+
+// ForkMonitor tracks the distinct heads peers report in their statuses and relay by gossip, so
+// that a network split shows up as competing heads before finality stalls. Two heads compete when
+// they are at the same slot, or when the lower one is not an ancestor of the higher one. A nil
+// monitor records nothing.
+type ForkMonitor struct {
+	lock        sync.RWMutex
+	blocks      BlockReader
+	heads       map[[32]byte]*ObservedHead
+	peerHeads   map[string][32]byte
+	ancestors   map[[32]byte]map[[32]byte]bool
+	highestSlot uint64
+}
+
+// NewForkMonitor creates a fork monitor shared by the sync services and the RPC servers. Without
+// a block reader, only the heads at the same slot are compared.
+func NewForkMonitor(blocks BlockReader) *ForkMonitor {
+	return &ForkMonitor{
+		blocks:    blocks,
+		heads:     make(map[[32]byte]*ObservedHead),
+		peerHeads: make(map[string][32]byte),
+		ancestors: make(map[[32]byte]map[[32]byte]bool),
+	}
+}
+
+// ObserveHead records the head of a peer, from its status or from a block it relayed. The
+// weight of the previous head of the peer moves to the new one.
+func (m *ForkMonitor) ObserveHead(pid string, slot uint64, root [32]byte) {
+	if m == nil {
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if previous, ok := m.peerHeads[pid]; ok {
+		if previous == root {
+			return
+		}
+		if h, ok := m.heads[previous]; ok {
+			h.Weight--
+			if h.Weight == 0 {
+				delete(m.heads, previous)
+				delete(m.ancestors, previous)
+			}
+		}
+	}
+	h, ok := m.heads[root]
+	if !ok {
+		h = &ObservedHead{Slot: slot, Root: root}
+		m.heads[root] = h
+	}
+	h.Weight++
+	m.peerHeads[pid] = root
+	if slot > m.highestSlot {
+		m.highestSlot = slot
+	}
+	m.prune()
+	m.updateMetrics()
+}
+
+// RemovePeer forgets the head of a disconnected peer.
+func (m *ForkMonitor) RemovePeer(pid string) {
+	if m == nil {
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	root, ok := m.peerHeads[pid]
+	if !ok {
+		return
+	}
+	delete(m.peerHeads, pid)
+	if h, ok := m.heads[root]; ok {
+		h.Weight--
+		if h.Weight == 0 {
+			delete(m.heads, root)
+		}
+	}
+	m.updateMetrics()
+}
+
+// CompetingHeads returns the heads competing with at least one other observed head, sorted by
+// slot and then by decreasing weight, and the number of slots these heads are at.
+func (m *ForkMonitor) CompetingHeads() ([]*ObservedHead, uint64) {
+	if m == nil {
+		return nil, 0
+	}
+	// The ancestry of the heads is memoized as they are compared.
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.competingHeads()
+}
+
+func (m *ForkMonitor) competingHeads() ([]*ObservedHead, uint64) {
+	heads := make([]*ObservedHead, 0, len(m.heads))
+	for _, h := range m.heads {
+		heads = append(heads, h)
+	}
+	isCompeting := make(map[[32]byte]bool)
+	for i := 0; i < len(heads); i++ {
+		for j := i + 1; j < len(heads); j++ {
+			if m.compete(heads[i], heads[j]) {
+				isCompeting[heads[i].Root] = true
+				isCompeting[heads[j].Root] = true
+			}
+		}
+	}
+	var competing []*ObservedHead
+	slots := make(map[uint64]bool)
+	for _, h := range heads {
+		if !isCompeting[h.Root] {
+			continue
+		}
+		slots[h.Slot] = true
+		competing = append(competing, &ObservedHead{Slot: h.Slot, Root: h.Root, Weight: h.Weight})
+	}
+	sort.Slice(competing, func(i, j int) bool {
+		if competing[i].Slot != competing[j].Slot {
+			return competing[i].Slot < competing[j].Slot
+		}
+		if competing[i].Weight != competing[j].Weight {
+			return competing[i].Weight > competing[j].Weight
+		}
+		return bytes.Compare(competing[i].Root[:], competing[j].Root[:]) < 0
+	})
+	return competing, uint64(len(slots))
+}
+
+// compete reports whether two distinct heads are on different branches. Heads at different slots
+// are only reported once the ancestry of the higher one is known down to the lower one.
+func (m *ForkMonitor) compete(a *ObservedHead, b *ObservedHead) bool {
+	if a.Slot == b.Slot {
+		return true
+	}
+	if a.Slot > b.Slot {
+		a, b = b, a
+	}
+	ancestors, ok := m.ancestry(b.Root)
+	if !ok {
+		return false
+	}
+	return !ancestors[a.Root]
+}
+
+// ancestry returns the roots of the ancestors of a head down to the oldest slot tracked by the
+// monitor. It returns false if a block of the branch is missing from the node, in which case the
+// ancestry is looked up again at the next comparison.
+func (m *ForkMonitor) ancestry(head [32]byte) (map[[32]byte]bool, bool) {
+	if ancestors, ok := m.ancestors[head]; ok {
+		return ancestors, true
+	}
+	if m.blocks == nil {
+		return nil, false
+	}
+	oldest := m.oldestSlot()
+	ancestors := make(map[[32]byte]bool)
+	root := head
+	for {
+		blk, err := m.blocks.Block(context.Background(), root)
+		if err != nil || blk == nil {
+			return nil, false
+		}
+		if blk.Slot <= oldest {
+			break
+		}
+		root = bytesutil.ToBytes32(blk.ParentRoot)
+		ancestors[root] = true
+	}
+	m.ancestors[head] = ancestors
+	return ancestors, true
+}
+
+func (m *ForkMonitor) oldestSlot() uint64 {
+	window := forkMonitorEpochs * params.BeaconConfig().SlotsPerEpoch
+	if m.highestSlot < window {
+		return 0
+	}
+	return m.highestSlot - window
+}
+
+// prune drops the heads too old to tell about the current state of the network, with the peers
+// which have not reported anything newer since.
+func (m *ForkMonitor) prune() {
+	oldest := m.oldestSlot()
+	for root, h := range m.heads {
+		if h.Slot < oldest {
+			delete(m.heads, root)
+			delete(m.ancestors, root)
+		}
+	}
+	for pid, root := range m.peerHeads {
+		if _, ok := m.heads[root]; !ok {
+			delete(m.peerHeads, pid)
+		}
+	}
+}
+
+func (m *ForkMonitor) updateMetrics() {
+	competing, contestedSlots := m.competingHeads()
+	var totalWeight, heaviest uint64
+	for _, h := range competing {
+		totalWeight += h.Weight
+		if h.Weight > heaviest {
+			heaviest = h.Weight
+		}
+	}
+	minorityWeight := totalWeight - heaviest
+	forkMonitorContestedSlots.Set(float64(contestedSlots))
+	forkMonitorCompetingHeads.Set(float64(len(competing)))
+	forkMonitorMinorityWeight.Set(float64(minorityWeight))
+}
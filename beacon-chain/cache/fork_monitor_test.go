@@ -0,0 +1,115 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+type mockBlockReader map[[32]byte]*ethpb.BeaconBlock
+
+func (r mockBlockReader) Block(_ context.Context, blockRoot [32]byte) (*ethpb.BeaconBlock, error) {
+	return r[blockRoot], nil
+}
+
+func TestForkMonitor_CompetingHeads(t *testing.T) {
+	m := NewForkMonitor(nil)
+	m.ObserveHead("a", 5, [32]byte{'a'})
+	m.ObserveHead("b", 5, [32]byte{'b'})
+	m.ObserveHead("c", 5, [32]byte{'b'})
+	m.ObserveHead("d", 6, [32]byte{'c'})
+
+	heads, contested := m.CompetingHeads()
+	if contested != 1 {
+		t.Errorf("Wanted 1 contested slot, got %d", contested)
+	}
+	if len(heads) != 2 {
+		t.Fatalf("Wanted 2 competing heads, got %d", len(heads))
+	}
+	if heads[0].Root != [32]byte{'b'} || heads[0].Weight != 2 {
+		t.Errorf("Wanted the heaviest head first, got %#x with weight %d", heads[0].Root, heads[0].Weight)
+	}
+	if heads[1].Root != [32]byte{'a'} || heads[1].Weight != 1 {
+		t.Errorf("Wanted %#x with weight 1, got %#x with weight %d", [32]byte{'a'}, heads[1].Root, heads[1].Weight)
+	}
+}
+
+func TestForkMonitor_ComparesAncestry(t *testing.T) {
+	// a <- b <- c and a <- d: c extends b, d competes with both.
+	blocks := mockBlockReader{
+		[32]byte{'a'}: {Slot: 0},
+		[32]byte{'b'}: {Slot: 5, ParentRoot: []byte{'a'}},
+		[32]byte{'c'}: {Slot: 7, ParentRoot: []byte{'b'}},
+		[32]byte{'d'}: {Slot: 6, ParentRoot: []byte{'a'}},
+	}
+	m := NewForkMonitor(blocks)
+	m.ObserveHead("a", 5, [32]byte{'b'})
+	m.ObserveHead("b", 7, [32]byte{'c'})
+	m.ObserveHead("c", 7, [32]byte{'c'})
+	if heads, contested := m.CompetingHeads(); contested != 0 || len(heads) != 0 {
+		t.Fatalf("Wanted no competing heads on a single branch, got %d heads at %d slots", len(heads), contested)
+	}
+
+	m.ObserveHead("d", 6, [32]byte{'d'})
+	heads, contested := m.CompetingHeads()
+	if contested != 3 {
+		t.Errorf("Wanted 3 contested slots, got %d", contested)
+	}
+	if len(heads) != 3 {
+		t.Fatalf("Wanted 3 competing heads, got %d", len(heads))
+	}
+	if heads[1].Root != [32]byte{'d'} {
+		t.Errorf("Wanted the head at slot 6 to compete, got %#x", heads[1].Root)
+	}
+
+	// Without the block of the higher head, heads at different slots are not compared.
+	m = NewForkMonitor(mockBlockReader{})
+	m.ObserveHead("a", 5, [32]byte{'b'})
+	m.ObserveHead("b", 6, [32]byte{'d'})
+	if heads, contested := m.CompetingHeads(); contested != 0 || len(heads) != 0 {
+		t.Errorf("Wanted no competing heads with an unknown ancestry, got %d heads at %d slots", len(heads), contested)
+	}
+}
+
+func TestForkMonitor_PeerMovesToNewHead(t *testing.T) {
+	m := NewForkMonitor(nil)
+	m.ObserveHead("a", 5, [32]byte{'a'})
+	m.ObserveHead("b", 5, [32]byte{'b'})
+	// Peer a joins the head of peer b, the slot is no longer contested.
+	m.ObserveHead("a", 5, [32]byte{'b'})
+
+	if heads, contested := m.CompetingHeads(); contested != 0 || len(heads) != 0 {
+		t.Errorf("Wanted no competing heads, got %d heads at %d slots", len(heads), contested)
+	}
+
+	m.ObserveHead("c", 5, [32]byte{'c'})
+	m.RemovePeer("c")
+	if _, contested := m.CompetingHeads(); contested != 0 {
+		t.Errorf("Wanted no contested slot once the peer is removed, got %d", contested)
+	}
+}
+
+func TestForkMonitor_PrunesOldHeads(t *testing.T) {
+	m := NewForkMonitor(nil)
+	m.ObserveHead("a", 1, [32]byte{'a'})
+	m.ObserveHead("b", 1, [32]byte{'b'})
+	if _, contested := m.CompetingHeads(); contested != 1 {
+		t.Fatalf("Wanted 1 contested slot, got %d", contested)
+	}
+
+	m.ObserveHead("c", 2+forkMonitorEpochs*params.BeaconConfig().SlotsPerEpoch, [32]byte{'c'})
+	if _, contested := m.CompetingHeads(); contested != 0 {
+		t.Errorf("Wanted old heads to be pruned, got %d contested slots", contested)
+	}
+}
+
+func TestForkMonitor_Nil(t *testing.T) {
+	var m *ForkMonitor
+	m.ObserveHead("a", 1, [32]byte{'a'})
+	m.RemovePeer("a")
+	if heads, contested := m.CompetingHeads(); heads != nil || contested != 0 {
+		t.Error("Expected a nil monitor to report nothing")
+	}
+}
@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// recentlyProcessedSize is the number of roots remembered. It covers a few epochs of
+	// blocks and unaggregated attestations on a small network.
+	recentlyProcessedSize = 8192
+
+	// Metrics
+	recentlyProcessedDuplicates = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "recently_processed_duplicates_suppressed",
+		Help: "The number of blocks and attestations not processed again because they were recently processed",
+	}, []string{"kind", "source"})
+	recentlyProcessedCacheSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "recently_processed_cache_size",
+		Help: "The number of roots in the recently processed cache. Deprecated, use cache_size{cache=\"recently_processed\"}.",
+	})
+)
+
+// Kinds and sources of the objects marked in the recently processed cache, used as metric labels.
+const (
+	KindBlock       = "block"
+	KindAttestation = "attestation"
+	SourceRPC       = "rpc"
+	SourceGossip    = "gossip"
+)
+
+// RecentlyProcessedCache remembers the roots of the blocks and attestations recently processed
+// by the node, so that an object submitted over RPC and received back by gossip, or the other
+// way around, is only processed once. A nil cache never reports a duplicate.
+type RecentlyProcessedCache struct {
+	roots *Store
+}
+
+// NewRecentlyProcessedCache creates a cache shared by the RPC servers and the sync service.
+func NewRecentlyProcessedCache() *RecentlyProcessedCache {
+	return &RecentlyProcessedCache{
+		roots: NewStore(StoreConfig{
+			Name:    "recently_processed",
+			MaxSize: recentlyProcessedSize,
+			Legacy:  &LegacyMetrics{Size: recentlyProcessedCacheSize},
+		}),
+	}
+}
+
+// MarkProcessed records the root of an object about to be processed. It returns false, and
+// counts a suppressed duplicate of the given kind from the given source, if the root was
+// already recorded, in which case the object should not be processed again.
+func (c *RecentlyProcessedCache) MarkProcessed(root [32]byte, kind string, source string) (bool, error) {
+	if c == nil {
+		return true, nil
+	}
+	if !c.roots.SetIfAbsent(string(root[:]), true) {
+		recentlyProcessedDuplicates.WithLabelValues(kind, source).Inc()
+		return false, nil
+	}
+	return true, nil
+}
+
+// Seen returns true if the root was recently marked as processed.
+func (c *RecentlyProcessedCache) Seen(root [32]byte) (bool, error) {
+	if c == nil {
+		return false, nil
+	}
+	_, exists := c.roots.Get(string(root[:]))
+	return exists, nil
+}
+
+// Forget removes a root from the cache, so that an object which failed processing can be
+// processed again when it is received another way.
+func (c *RecentlyProcessedCache) Forget(root [32]byte) error {
+	if c == nil {
+		return nil
+	}
+	c.roots.Delete(string(root[:]))
+	return nil
+}
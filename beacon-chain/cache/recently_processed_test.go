@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"testing"
+)
+
+func TestRecentlyProcessedCache_MarkProcessedOnce(t *testing.T) {
+	c := NewRecentlyProcessedCache()
+	root := [32]byte{'a'}
+
+	first, err := c.MarkProcessed(root, KindBlock, SourceRPC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !first {
+		t.Error("Expected first mark to succeed")
+	}
+	second, err := c.MarkProcessed(root, KindBlock, SourceGossip)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second {
+		t.Error("Expected duplicate mark to be suppressed")
+	}
+}
+
+func TestRecentlyProcessedCache_Forget(t *testing.T) {
+	c := NewRecentlyProcessedCache()
+	root := [32]byte{'a'}
+
+	if _, err := c.MarkProcessed(root, KindAttestation, SourceGossip); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Forget(root); err != nil {
+		t.Fatal(err)
+	}
+	seen, err := c.Seen(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seen {
+		t.Error("Expected forgotten root to not be seen")
+	}
+	marked, err := c.MarkProcessed(root, KindAttestation, SourceRPC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !marked {
+		t.Error("Expected forgotten root to be processed again")
+	}
+}
+
+func TestRecentlyProcessedCache_EvictsOldest(t *testing.T) {
+	c := NewRecentlyProcessedCache()
+	for i := 0; i <= recentlyProcessedSize; i++ {
+		root := [32]byte{byte(i), byte(i >> 8)}
+		if _, err := c.MarkProcessed(root, KindAttestation, SourceGossip); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if seen, _ := c.Seen([32]byte{0, 0}); seen {
+		t.Error("Expected oldest root to be evicted")
+	}
+	last := recentlyProcessedSize
+	if seen, _ := c.Seen([32]byte{byte(last), byte(last >> 8)}); !seen {
+		t.Error("Expected latest root to be cached")
+	}
+	if n := c.roots.Len(); n != recentlyProcessedSize {
+		t.Errorf("Wanted %d cached roots, got %d", recentlyProcessedSize, n)
+	}
+}
@@ -3,12 +3,10 @@ package cache
 import (
 	"errors"
 	"strconv"
-	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
-	"k8s.io/client-go/tools/cache"
 )
 
 var (
@@ -19,14 +17,14 @@ var (
 	// maxSeedListSize defines the max number of seed can cache.
 	maxSeedListSize = 1000
 
-	// Metrics.
+	// Metrics, deprecated in favour of the metrics of the seed store.
 	seedCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "seed_cache_miss",
-		Help: "The number of seed requests that aren't present in the cache.",
+		Help: "The number of seed requests that aren't present in the cache. Deprecated, use cache_misses_total{cache=\"seed\"}.",
 	})
 	seedCacheHit = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "seed_cache_hit",
-		Help: "The number of seed requests that are present in the cache.",
+		Help: "The number of seed requests that are present in the cache. Deprecated, use cache_hits_total{cache=\"seed\"}.",
 	})
 )
 
@@ -38,8 +36,7 @@ type SeedByEpoch struct {
 
 // SeedCache is a struct with 1 queue for looking up seed by epoch.
 type SeedCache struct {
-	seedCache *cache.FIFO
-	lock      sync.RWMutex
+	seedCache *Store
 }
 
 // seedKeyFn takes the epoch as the key for the seed of a given epoch.
@@ -55,7 +52,15 @@ func seedKeyFn(obj interface{}) (string, error) {
 // NewSeedCache creates a new seed cache for storing/accessing seed.
 func NewSeedCache() *SeedCache {
 	return &SeedCache{
-		seedCache: cache.NewFIFO(seedKeyFn),
+		seedCache: NewStore(StoreConfig{
+			Name:         "seed",
+			MaxSize:      maxSeedListSize,
+			StateDerived: true,
+			Legacy: &LegacyMetrics{
+				Hits:   seedCacheHit,
+				Misses: seedCacheMiss,
+			},
+		}),
 	}
 }
 
@@ -68,17 +73,8 @@ func (c *SeedCache) SeedInEpoch(epoch uint64) ([]byte, error) {
 		return nil, nil
 	}
 
-	c.lock.RLock()
-	defer c.lock.RUnlock()
-	obj, exists, err := c.seedCache.GetByKey(strconv.Itoa(int(epoch)))
-	if err != nil {
-		return nil, err
-	}
-
-	if exists {
-		seedCacheHit.Inc()
-	} else {
-		seedCacheMiss.Inc()
+	obj, exists := c.seedCache.Get(strconv.Itoa(int(epoch)))
+	if !exists {
 		return nil, nil
 	}
 
@@ -97,12 +93,15 @@ func (c *SeedCache) AddSeed(seed *SeedByEpoch) error {
 		return nil
 	}
 
-	c.lock.Lock()
-	defer c.lock.Unlock()
-	if err := c.seedCache.AddIfNotPresent(seed); err != nil {
+	key, err := seedKeyFn(seed)
+	if err != nil {
 		return err
 	}
-
-	trim(c.seedCache, maxSeedListSize)
+	c.seedCache.SetIfAbsent(key, seed)
 	return nil
 }
+
+// Clear removes the cached seeds.
+func (c *SeedCache) Clear() {
+	c.seedCache.Clear()
+}
@@ -73,11 +73,11 @@ func TestSeed_MaxSize(t *testing.T) {
 		}
 	}
 
-	if len(cache.seedCache.ListKeys()) != maxSeedListSize {
+	if cache.seedCache.Len() != maxSeedListSize {
 		t.Errorf(
 			"Expected hash cache key size to be %d, got %d",
 			maxSeedListSize,
-			len(cache.seedCache.ListKeys()),
+			cache.seedCache.Len(),
 		)
 	}
 }
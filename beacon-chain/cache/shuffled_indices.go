@@ -3,11 +3,9 @@ package cache
 import (
 	"errors"
 	"strconv"
-	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
-	"k8s.io/client-go/tools/cache"
 )
 
 var (
@@ -18,14 +16,14 @@ var (
 	// maxShuffledListSize defines the max number of shuffled list can cache.
 	maxShuffledListSize = 1000
 
-	// Metrics.
+	// Metrics, deprecated in favour of the metrics of the shuffled_validators store.
 	shuffledIndicesCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "shuffled_validators_cache_miss",
-		Help: "The number of shuffled validators requests that aren't present in the cache.",
+		Help: "The number of shuffled validators requests that aren't present in the cache. Deprecated, use cache_misses_total{cache=\"shuffled_validators\"}.",
 	})
 	shuffledIndicesCacheHit = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "shuffled_validators_cache_hit",
-		Help: "The number of shuffled validators requests that are present in the cache.",
+		Help: "The number of shuffled validators requests that are present in the cache. Deprecated, use cache_hits_total{cache=\"shuffled_validators\"}.",
 	})
 )
 
@@ -38,8 +36,7 @@ type IndicesByIndexSeed struct {
 
 // ShuffledIndicesCache is a struct with 1 queue for looking up shuffled validators by seed.
 type ShuffledIndicesCache struct {
-	shuffledIndicesCache *cache.FIFO
-	lock                 sync.RWMutex
+	shuffledIndicesCache *Store
 }
 
 // slotKeyFn takes the randao seed as the key for the shuffled validators of a given epoch.
@@ -55,25 +52,23 @@ func shuffleKeyFn(obj interface{}) (string, error) {
 // NewShuffledIndicesCache creates a new shuffled validators cache for storing/accessing shuffled validator indices
 func NewShuffledIndicesCache() *ShuffledIndicesCache {
 	return &ShuffledIndicesCache{
-		shuffledIndicesCache: cache.NewFIFO(shuffleKeyFn),
+		shuffledIndicesCache: NewStore(StoreConfig{
+			Name:         "shuffled_validators",
+			MaxSize:      maxShuffledListSize,
+			StateDerived: true,
+			Legacy: &LegacyMetrics{
+				Hits:   shuffledIndicesCacheHit,
+				Misses: shuffledIndicesCacheMiss,
+			},
+		}),
 	}
 }
 
 // IndicesByIndexSeed fetches IndicesByIndexSeed by epoch and seed. Returns true with a
 // reference to the ShuffledIndicesInEpoch info, if exists. Otherwise returns false, nil.
 func (c *ShuffledIndicesCache) IndicesByIndexSeed(index uint64, seed []byte) ([]uint64, error) {
-	c.lock.RLock()
-	defer c.lock.RUnlock()
-	key := string(seed) + strconv.Itoa(int(index))
-	obj, exists, err := c.shuffledIndicesCache.GetByKey(key)
-	if err != nil {
-		return nil, err
-	}
-
-	if exists {
-		shuffledIndicesCacheHit.Inc()
-	} else {
-		shuffledIndicesCacheMiss.Inc()
+	obj, exists := c.shuffledIndicesCache.Get(string(seed) + strconv.Itoa(int(index)))
+	if !exists {
 		return nil, nil
 	}
 
@@ -88,12 +83,15 @@ func (c *ShuffledIndicesCache) IndicesByIndexSeed(index uint64, seed []byte) ([]
 // AddShuffledValidatorList adds IndicesByIndexSeed object to the cache. This method also trims the least
 // recently added IndicesByIndexSeed object if the cache size has ready the max cache size limit.
 func (c *ShuffledIndicesCache) AddShuffledValidatorList(shuffledIndices *IndicesByIndexSeed) error {
-	c.lock.Lock()
-	defer c.lock.Unlock()
-	if err := c.shuffledIndicesCache.AddIfNotPresent(shuffledIndices); err != nil {
+	key, err := shuffleKeyFn(shuffledIndices)
+	if err != nil {
 		return err
 	}
-
-	trim(c.shuffledIndicesCache, maxShuffledListSize)
+	c.shuffledIndicesCache.SetIfAbsent(key, shuffledIndices)
 	return nil
 }
+
+// Clear removes the cached shuffled validator lists.
+func (c *ShuffledIndicesCache) Clear() {
+	c.shuffledIndicesCache.Clear()
+}
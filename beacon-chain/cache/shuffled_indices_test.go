@@ -75,11 +75,11 @@ func TestShuffledIndices_MaxSize(t *testing.T) {
 		}
 	}
 
-	if len(cache.shuffledIndicesCache.ListKeys()) != maxShuffledListSize {
+	if cache.shuffledIndicesCache.Len() != maxShuffledListSize {
 		t.Errorf(
 			"Expected hash cache key size to be %d, got %d",
 			maxShuffledListSize,
-			len(cache.shuffledIndicesCache.ListKeys()),
+			cache.shuffledIndicesCache.Len(),
 		)
 	}
 }
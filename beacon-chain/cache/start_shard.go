@@ -3,13 +3,11 @@ package cache
 import (
 	"errors"
 	"strconv"
-	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
-	"k8s.io/client-go/tools/cache"
 )
 
 var (
@@ -20,14 +18,14 @@ var (
 	// maxStartShardListSize defines the max number of start shard can cache.
 	maxStartShardListSize = int(params.BeaconConfig().ShardCount)
 
-	// Metrics.
+	// Metrics, deprecated in favour of the metrics of the start_shard store.
 	startShardCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "start_shard_cache_miss",
-		Help: "The number of start shard requests that aren't present in the cache.",
+		Help: "The number of start shard requests that aren't present in the cache. Deprecated, use cache_misses_total{cache=\"start_shard\"}.",
 	})
 	startShardCacheHit = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "start_shard_cache_hit",
-		Help: "The number of start shard requests that are present in the cache.",
+		Help: "The number of start shard requests that are present in the cache. Deprecated, use cache_hits_total{cache=\"start_shard\"}.",
 	})
 )
 
@@ -39,8 +37,7 @@ type StartShardByEpoch struct {
 
 // StartShardCache is a struct with 1 queue for looking up start shard by epoch.
 type StartShardCache struct {
-	startShardCache *cache.FIFO
-	lock            sync.RWMutex
+	startShardCache *Store
 }
 
 // startShardKeyFn takes the epoch as the key for the start shard of a given epoch.
@@ -56,7 +53,15 @@ func startShardKeyFn(obj interface{}) (string, error) {
 // NewStartShardCache creates a new start shard cache for storing/accessing start shard.
 func NewStartShardCache() *StartShardCache {
 	return &StartShardCache{
-		startShardCache: cache.NewFIFO(startShardKeyFn),
+		startShardCache: NewStore(StoreConfig{
+			Name:         "start_shard",
+			MaxSize:      maxStartShardListSize,
+			StateDerived: true,
+			Legacy: &LegacyMetrics{
+				Hits:   startShardCacheHit,
+				Misses: startShardCacheMiss,
+			},
+		}),
 	}
 }
 
@@ -69,17 +74,8 @@ func (c *StartShardCache) StartShardInEpoch(epoch uint64) (uint64, error) {
 		return params.BeaconConfig().FarFutureEpoch, nil
 	}
 
-	c.lock.RLock()
-	defer c.lock.RUnlock()
-	obj, exists, err := c.startShardCache.GetByKey(strconv.Itoa(int(epoch)))
-	if err != nil {
-		return params.BeaconConfig().FarFutureEpoch, err
-	}
-
-	if exists {
-		startShardCacheHit.Inc()
-	} else {
-		startShardCacheMiss.Inc()
+	obj, exists := c.startShardCache.Get(strconv.Itoa(int(epoch)))
+	if !exists {
 		return params.BeaconConfig().FarFutureEpoch, nil
 	}
 
@@ -98,12 +94,15 @@ func (c *StartShardCache) AddStartShard(startShard *StartShardByEpoch) error {
 		return nil
 	}
 
-	c.lock.Lock()
-	defer c.lock.Unlock()
-	if err := c.startShardCache.AddIfNotPresent(startShard); err != nil {
+	key, err := startShardKeyFn(startShard)
+	if err != nil {
 		return err
 	}
-
-	trim(c.startShardCache, maxStartShardListSize)
+	c.startShardCache.SetIfAbsent(key, startShard)
 	return nil
 }
+
+// Clear removes the cached start shards.
+func (c *StartShardCache) Clear() {
+	c.startShardCache.Clear()
+}
@@ -73,11 +73,11 @@ func TestStartShard_MaxSize(t *testing.T) {
 		}
 	}
 
-	if len(cache.startShardCache.ListKeys()) != maxStartShardListSize {
+	if cache.startShardCache.Len() != maxStartShardListSize {
 		t.Errorf(
 			"Expected hash cache key size to be %d, got %d",
 			maxStartShardListSize,
-			len(cache.startShardCache.ListKeys()),
+			cache.startShardCache.Len(),
 		)
 	}
 }
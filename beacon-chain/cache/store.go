@@ -0,0 +1,231 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// Metrics, labeled by the name of the store.
+	storeHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cache_hits_total",
+		Help: "The number of cache lookups which found an entry.",
+	}, []string{"cache"})
+	storeMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cache_misses_total",
+		Help: "The number of cache lookups which found no entry, or an expired one.",
+	}, []string{"cache"})
+	storeEvictions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cache_evictions_total",
+		Help: "The number of cache entries evicted to stay within the size limit of the cache.",
+	}, []string{"cache"})
+	storeSize = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cache_size",
+		Help: "The number of entries in the cache.",
+	}, []string{"cache"})
+
+	// stateDerivedStores are the stores emptied by ClearAll.
+	stateDerivedStores     = make(map[*Store]bool)
+	stateDerivedStoresLock sync.Mutex
+)
+
+// StoreConfig defines the limits and the metrics label of a store.
+type StoreConfig struct {
+	// Name labels the metrics of the store.
+	Name string
+	// MaxSize is the number of entries past which entries are evicted, 0 for no limit.
+	MaxSize int
+	// TTL is how long an entry is kept after it was set, 0 for entries which never expire.
+	TTL time.Duration
+	// StateDerived stores hold values computed from beacon states, which ClearAll empties.
+	StateDerived bool
+	// LRU stores move the entries looked up to the back of the eviction order, so that the least
+	// recently used entries are evicted first rather than the first set.
+	LRU bool
+	// Legacy are the metrics the cache reported before being built on a store. They are updated
+	// along with the metrics of the store, so existing dashboards and alerts keep working.
+	Legacy *LegacyMetrics
+}
+
+// LegacyMetrics are the unlabeled metrics of a cache, any of which may be nil.
+type LegacyMetrics struct {
+	Hits   prometheus.Counter
+	Misses prometheus.Counter
+	Size   prometheus.Gauge
+}
+
+type storeEntry struct {
+	key     string
+	value   interface{}
+	expires time.Time
+}
+
+// Store is the key value cache the caches of the beacon node are built on. It is safe for
+// concurrent use, evicts the entries first set first, or least recently used first for LRU
+// stores, and reports its hits, misses, evictions and size labeled by its name.
+type Store struct {
+	cfg     StoreConfig
+	lock    sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List // Next entry to evict at the front.
+}
+
+// NewStore creates a store with the given limits.
+func NewStore(cfg StoreConfig) *Store {
+	s := &Store{
+		cfg:     cfg,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+	if cfg.StateDerived {
+		stateDerivedStoresLock.Lock()
+		stateDerivedStores[s] = true
+		stateDerivedStoresLock.Unlock()
+	}
+	return s
+}
+
+// ClearAll empties every state derived store, which is needed whenever cached values may no
+// longer match the states they were computed from, such as on finality or between tests.
+func ClearAll() {
+	stateDerivedStoresLock.Lock()
+	defer stateDerivedStoresLock.Unlock()
+	for s := range stateDerivedStores {
+		s.Clear()
+	}
+}
+
+// Get returns the value set for the key, if any and not expired.
+func (s *Store) Get(key string) (interface{}, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	entry, ok := s.lookup(key)
+	if !ok {
+		storeMisses.WithLabelValues(s.cfg.Name).Inc()
+		if s.cfg.Legacy != nil && s.cfg.Legacy.Misses != nil {
+			s.cfg.Legacy.Misses.Inc()
+		}
+		return nil, false
+	}
+	storeHits.WithLabelValues(s.cfg.Name).Inc()
+	if s.cfg.Legacy != nil && s.cfg.Legacy.Hits != nil {
+		s.cfg.Legacy.Hits.Inc()
+	}
+	if s.cfg.LRU {
+		s.order.MoveToBack(s.entries[key])
+	}
+	return entry.value, true
+}
+
+// Set the value of the key. Setting an existing key replaces its value and restarts its TTL,
+// but only postpones its eviction in LRU stores.
+func (s *Store) Set(key string, value interface{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if entry, ok := s.lookup(key); ok {
+		entry.value = value
+		entry.expires = s.expiry()
+		if s.cfg.LRU {
+			s.order.MoveToBack(s.entries[key])
+		}
+		return
+	}
+	s.add(key, value)
+}
+
+// SetIfAbsent sets the value of the key unless it is already set. It returns false when the
+// key was already set.
+func (s *Store) SetIfAbsent(key string, value interface{}) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.lookup(key); ok {
+		return false
+	}
+	s.add(key, value)
+	return true
+}
+
+// Delete the key from the store.
+func (s *Store) Delete(key string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if elem, ok := s.entries[key]; ok {
+		s.remove(elem)
+		s.reportSize()
+	}
+}
+
+// Keys returns the keys of the store, next to be evicted first.
+func (s *Store) Keys() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	keys := make([]string, 0, s.order.Len())
+	for elem := s.order.Front(); elem != nil; elem = elem.Next() {
+		keys = append(keys, elem.Value.(*storeEntry).key)
+	}
+	return keys
+}
+
+// Len returns the number of entries in the store, expired entries included until they are
+// looked up or evicted.
+func (s *Store) Len() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.order.Len()
+}
+
+// Clear removes every entry from the store.
+func (s *Store) Clear() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.entries = make(map[string]*list.Element)
+	s.order.Init()
+	s.reportSize()
+}
+
+// lookup returns the entry of the key, removing it if it expired.
+func (s *Store) lookup(key string) (*storeEntry, bool) {
+	elem, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*storeEntry)
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		s.remove(elem)
+		s.reportSize()
+		return nil, false
+	}
+	return entry, true
+}
+
+func (s *Store) add(key string, value interface{}) {
+	s.entries[key] = s.order.PushBack(&storeEntry{key: key, value: value, expires: s.expiry()})
+	for s.cfg.MaxSize > 0 && s.order.Len() > s.cfg.MaxSize {
+		s.remove(s.order.Front())
+		storeEvictions.WithLabelValues(s.cfg.Name).Inc()
+	}
+	s.reportSize()
+}
+
+func (s *Store) remove(elem *list.Element) {
+	s.order.Remove(elem)
+	delete(s.entries, elem.Value.(*storeEntry).key)
+}
+
+func (s *Store) reportSize() {
+	storeSize.WithLabelValues(s.cfg.Name).Set(float64(s.order.Len()))
+	if s.cfg.Legacy != nil && s.cfg.Legacy.Size != nil {
+		s.cfg.Legacy.Size.Set(float64(s.order.Len()))
+	}
+}
+
+func (s *Store) expiry() time.Time {
+	if s.cfg.TTL == 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(s.cfg.TTL)
+}
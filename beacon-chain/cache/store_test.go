@@ -0,0 +1,105 @@
+package cache
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestStore_EvictsOldestFirst(t *testing.T) {
+	s := NewStore(StoreConfig{Name: "test_evict", MaxSize: 2})
+	s.Set("a", 1)
+	s.Set("b", 2)
+	// Replacing a value doesn't postpone its eviction.
+	s.Set("a", 3)
+	s.Set("c", 4)
+
+	if _, ok := s.Get("a"); ok {
+		t.Error("Expected oldest key to be evicted")
+	}
+	if keys := s.Keys(); !reflect.DeepEqual(keys, []string{"b", "c"}) {
+		t.Errorf("Wanted keys %v, got %v", []string{"b", "c"}, keys)
+	}
+}
+
+func TestStore_LRUPromotesOnGet(t *testing.T) {
+	s := NewStore(StoreConfig{Name: "test_lru", MaxSize: 2, LRU: true})
+	s.Set("a", 1)
+	s.Set("b", 2)
+	s.Get("a")
+	s.Set("c", 3)
+
+	if _, ok := s.Get("b"); ok {
+		t.Error("Expected least recently used key to be evicted")
+	}
+	if keys := s.Keys(); !reflect.DeepEqual(keys, []string{"a", "c"}) {
+		t.Errorf("Wanted keys %v, got %v", []string{"a", "c"}, keys)
+	}
+}
+
+func TestStore_SetIfAbsent(t *testing.T) {
+	s := NewStore(StoreConfig{Name: "test_set_if_absent"})
+	if !s.SetIfAbsent("a", 1) {
+		t.Error("Expected first set to succeed")
+	}
+	if s.SetIfAbsent("a", 2) {
+		t.Error("Expected second set to be refused")
+	}
+	if v, _ := s.Get("a"); v != 1 {
+		t.Errorf("Wanted value 1, got %v", v)
+	}
+	s.Delete("a")
+	if !s.SetIfAbsent("a", 2) {
+		t.Error("Expected set after delete to succeed")
+	}
+}
+
+func TestStore_ExpiresEntries(t *testing.T) {
+	s := NewStore(StoreConfig{Name: "test_ttl", TTL: 10 * time.Millisecond})
+	s.Set("a", 1)
+	if _, ok := s.Get("a"); !ok {
+		t.Fatal("Expected entry before its TTL")
+	}
+	time.Sleep(20 * time.Millisecond)
+	if _, ok := s.Get("a"); ok {
+		t.Error("Expected entry to expire after its TTL")
+	}
+	if s.Len() != 0 {
+		t.Errorf("Wanted expired entry to be removed, got %d entries", s.Len())
+	}
+}
+
+func TestClearAll_ClearsStateDerivedStores(t *testing.T) {
+	derived := NewStore(StoreConfig{Name: "test_derived", StateDerived: true})
+	other := NewStore(StoreConfig{Name: "test_other"})
+	derived.Set("a", 1)
+	other.Set("a", 1)
+
+	ClearAll()
+
+	if derived.Len() != 0 {
+		t.Errorf("Wanted state derived store to be cleared, got %d entries", derived.Len())
+	}
+	if other.Len() != 1 {
+		t.Errorf("Wanted other store to be left untouched, got %d entries", other.Len())
+	}
+}
+
+func TestNewCaches_KeepLegacyMetrics(t *testing.T) {
+	stores := []*Store{
+		NewActiveBalanceCache().activeBalanceCache,
+		NewActiveCountCache().activeCountCache,
+		NewActiveIndicesCache().activeIndicesCache,
+		NewBlockAncestorCache().ancestorBlockCache,
+		NewEth1DataVoteCache().eth1DataVoteCache,
+		NewSeedCache().seedCache,
+		NewShuffledIndicesCache().shuffledIndicesCache,
+		NewStartShardCache().startShardCache,
+		NewTotalBalanceCache().totalBalanceCache,
+	}
+	for _, s := range stores {
+		if s.cfg.Legacy == nil || s.cfg.Legacy.Hits == nil || s.cfg.Legacy.Misses == nil {
+			t.Errorf("Wanted the %s cache to keep reporting its legacy hit and miss metrics", s.cfg.Name)
+		}
+	}
+}
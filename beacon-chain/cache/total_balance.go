@@ -3,13 +3,11 @@ package cache
 import (
 	"errors"
 	"strconv"
-	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
-	"k8s.io/client-go/tools/cache"
 )
 
 var (
@@ -20,14 +18,14 @@ var (
 	// maxTotalBalanceListSize defines the max number of total balance can cache.
 	maxTotalBalanceListSize = 1000
 
-	// Metrics.
+	// Metrics, deprecated in favour of the metrics of the total_balance store.
 	totalBalanceCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "total_balance_cache_miss",
-		Help: "The number of total balance requests that aren't present in the cache.",
+		Help: "The number of total balance requests that aren't present in the cache. Deprecated, use cache_misses_total{cache=\"total_balance\"}.",
 	})
 	totalBalanceCacheHit = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "total_balance_cache_hit",
-		Help: "The number of total balance requests that are present in the cache.",
+		Help: "The number of total balance requests that are present in the cache. Deprecated, use cache_hits_total{cache=\"total_balance\"}.",
 	})
 )
 
@@ -39,8 +37,7 @@ type TotalBalanceByEpoch struct {
 
 // TotalBalanceCache is a struct with 1 queue for looking up total balance by epoch.
 type TotalBalanceCache struct {
-	totalBalanceCache *cache.FIFO
-	lock              sync.RWMutex
+	totalBalanceCache *Store
 }
 
 // totalBalanceKeyFn takes the epoch as the key for the total balance of a given epoch.
@@ -56,7 +53,15 @@ func totalBalanceKeyFn(obj interface{}) (string, error) {
 // NewTotalBalanceCache creates a new total balance cache for storing/accessing total validator balance.
 func NewTotalBalanceCache() *TotalBalanceCache {
 	return &TotalBalanceCache{
-		totalBalanceCache: cache.NewFIFO(totalBalanceKeyFn),
+		totalBalanceCache: NewStore(StoreConfig{
+			Name:         "total_balance",
+			MaxSize:      maxTotalBalanceListSize,
+			StateDerived: true,
+			Legacy: &LegacyMetrics{
+				Hits:   totalBalanceCacheHit,
+				Misses: totalBalanceCacheMiss,
+			},
+		}),
 	}
 }
 
@@ -69,17 +74,8 @@ func (c *TotalBalanceCache) TotalBalanceInEpoch(epoch uint64) (uint64, error) {
 		return params.BeaconConfig().FarFutureEpoch, nil
 	}
 
-	c.lock.RLock()
-	defer c.lock.RUnlock()
-	obj, exists, err := c.totalBalanceCache.GetByKey(strconv.Itoa(int(epoch)))
-	if err != nil {
-		return params.BeaconConfig().FarFutureEpoch, err
-	}
-
-	if exists {
-		totalBalanceCacheHit.Inc()
-	} else {
-		totalBalanceCacheMiss.Inc()
+	obj, exists := c.totalBalanceCache.Get(strconv.Itoa(int(epoch)))
+	if !exists {
 		return params.BeaconConfig().FarFutureEpoch, nil
 	}
 
@@ -98,12 +94,15 @@ func (c *TotalBalanceCache) AddTotalBalance(totalBalance *TotalBalanceByEpoch) e
 		return nil
 	}
 
-	c.lock.Lock()
-	defer c.lock.Unlock()
-	if err := c.totalBalanceCache.AddIfNotPresent(totalBalance); err != nil {
+	key, err := totalBalanceKeyFn(totalBalance)
+	if err != nil {
 		return err
 	}
-
-	trim(c.totalBalanceCache, maxTotalBalanceListSize)
+	c.totalBalanceCache.SetIfAbsent(key, totalBalance)
 	return nil
 }
+
+// Clear removes the cached total balances.
+func (c *TotalBalanceCache) Clear() {
+	c.totalBalanceCache.Clear()
+}
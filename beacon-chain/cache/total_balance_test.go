@@ -73,11 +73,11 @@ func TestTotalBalance_MaxSize(t *testing.T) {
 		}
 	}
 
-	if len(cache.totalBalanceCache.ListKeys()) != maxTotalBalanceListSize {
+	if cache.totalBalanceCache.Len() != maxTotalBalanceListSize {
 		t.Errorf(
 			"Expected hash cache key size to be %d, got %d",
 			maxTotalBalanceListSize,
-			len(cache.totalBalanceCache.ListKeys()),
+			cache.totalBalanceCache.Len(),
 		)
 	}
 }
@@ -29,6 +29,33 @@ var log = logrus.WithField("prefix", "blocks")
 
 var eth1DataCache = cache.NewEth1DataVoteCache()
 
+// ErrSigFailedToVerify is returned when a signature of a block object(ie attestation, slashing, exit... etc)
+// failed to verify.
+var ErrSigFailedToVerify = errors.New("signature did not verify")
+
+// OperationError is returned when an operation of a block body fails to process. It records the
+// kind of operation and its index in the block body, so that callers can tell peers exactly
+// which operation made a block invalid.
+type OperationError struct {
+	Operation string
+	Index     int
+	err       error
+}
+
+// Error returns the message of the underlying error.
+func (e *OperationError) Error() string {
+	return e.err.Error()
+}
+
+// Cause returns the underlying error, so that errors.Cause keeps finding the root cause.
+func (e *OperationError) Cause() error {
+	return e.err
+}
+
+func operationError(operation string, idx int, err error) error {
+	return &OperationError{Operation: operation, Index: idx, err: err}
+}
+
 func verifySigningRoot(obj interface{}, pub []byte, signature []byte, domain uint64) error {
 	publicKey, err := bls.PublicKeyFromBytes(pub)
 	if err != nil {
@@ -43,7 +70,7 @@ func verifySigningRoot(obj interface{}, pub []byte, signature []byte, domain uin
 		return errors.Wrap(err, "could not get signing root")
 	}
 	if !sig.Verify(root[:], publicKey, domain) {
-		return fmt.Errorf("signature did not verify")
+		return ErrSigFailedToVerify
 	}
 	return nil
 }
@@ -343,7 +370,7 @@ func ProcessProposerSlashings(
 			return nil, fmt.Errorf("invalid proposer index given in slashing %d", slashing.ProposerIndex)
 		}
 		if err = VerifyProposerSlashing(beaconState, slashing); err != nil {
-			return nil, errors.Wrapf(err, "could not verify proposer slashing %d", idx)
+			return nil, operationError("proposer_slashing", idx, errors.Wrapf(err, "could not verify proposer slashing %d", idx))
 		}
 		beaconState, err = v.SlashValidator(
 			beaconState, slashing.ProposerIndex, 0, /* proposer is whistleblower */
@@ -413,7 +440,7 @@ func ProcessAttesterSlashings(
 ) (*pb.BeaconState, error) {
 	for idx, slashing := range body.AttesterSlashings {
 		if err := VerifyAttesterSlashing(beaconState, slashing); err != nil {
-			return nil, errors.Wrapf(err, "could not verify attester slashing %d", idx)
+			return nil, operationError("attester_slashing", idx, errors.Wrapf(err, "could not verify attester slashing %d", idx))
 		}
 		slashableIndices := slashableAttesterIndices(slashing)
 		sort.SliceStable(slashableIndices, func(i, j int) bool {
@@ -495,7 +522,7 @@ func ProcessAttestations(
 	for idx, attestation := range body.Attestations {
 		beaconState, err = ProcessAttestation(beaconState, attestation)
 		if err != nil {
-			return nil, errors.Wrapf(err, "could not verify attestation at index %d in block", idx)
+			return nil, operationError("attestation", idx, errors.Wrapf(err, "could not verify attestation at index %d in block", idx))
 		}
 	}
 	return beaconState, nil
@@ -511,7 +538,7 @@ func ProcessAttestationsNoVerify(
 	for idx, attestation := range body.Attestations {
 		beaconState, err = ProcessAttestationNoVerify(beaconState, attestation)
 		if err != nil {
-			return nil, errors.Wrapf(err, "could not verify attestation at index %d in block", idx)
+			return nil, operationError("attestation", idx, errors.Wrapf(err, "could not verify attestation at index %d in block", idx))
 		}
 	}
 	return beaconState, nil
@@ -856,7 +883,7 @@ func VerifyIndexedAttestation(beaconState *pb.BeaconState, indexedAtt *ethpb.Ind
 	hasVotes := len(custodyBit0Indices) > 0 || len(custodyBit1Indices) > 0
 
 	if hasVotes && !sig.VerifyAggregate(pubkeys, msgs, domain) {
-		return fmt.Errorf("attestation aggregation signature did not verify")
+		return errors.Wrap(ErrSigFailedToVerify, "attestation aggregation")
 	}
 	return nil
 }
@@ -886,10 +913,10 @@ func ProcessDeposits(
 	deposits := body.Deposits
 
 	valIndexMap := stateutils.ValidatorIndexMap(beaconState)
-	for _, deposit := range deposits {
+	for idx, deposit := range deposits {
 		beaconState, err = ProcessDeposit(beaconState, deposit, valIndexMap)
 		if err != nil {
-			return nil, errors.Wrapf(err, "could not process deposit from %#x", bytesutil.Trunc(deposit.Data.PublicKey))
+			return nil, operationError("deposit", idx, errors.Wrapf(err, "could not process deposit from %#x", bytesutil.Trunc(deposit.Data.PublicKey)))
 		}
 	}
 	return beaconState, nil
@@ -1032,7 +1059,7 @@ func ProcessVoluntaryExits(
 
 	for idx, exit := range exits {
 		if err := VerifyExit(beaconState, exit); err != nil {
-			return nil, errors.Wrapf(err, "could not verify exit %d", idx)
+			return nil, operationError("voluntary_exit", idx, errors.Wrapf(err, "could not verify exit %d", idx))
 		}
 		beaconState, err = v.InitiateValidatorExit(beaconState, exit.ValidatorIndex)
 		if err != nil {
@@ -1140,7 +1167,7 @@ func ProcessTransfers(
 
 	for idx, transfer := range transfers {
 		if err := verifyTransfer(beaconState, transfer); err != nil {
-			return nil, errors.Wrapf(err, "could not verify transfer %d", idx)
+			return nil, operationError("transfer", idx, errors.Wrapf(err, "could not verify transfer %d", idx))
 		}
 		// Process the transfer between accounts.
 		beaconState = helpers.DecreaseBalance(beaconState, transfer.SenderIndex, transfer.Amount+transfer.Fee)
@@ -1223,5 +1250,5 @@ func verifyTransfer(beaconState *pb.BeaconState, transfer *ethpb.Transfer) error
 
 // ClearEth1DataVoteCache clears the eth1 data vote count cache.
 func ClearEth1DataVoteCache() {
-	eth1DataCache = cache.NewEth1DataVoteCache()
+	eth1DataCache.Clear()
 }
@@ -453,6 +453,34 @@ func TestProcessProposerSlashings_UnmatchedHeaderEpochs(t *testing.T) {
 	}
 }
 
+func TestProcessProposerSlashings_OperationError(t *testing.T) {
+	slashings := []*ethpb.ProposerSlashing{
+		{
+			ProposerIndex: 1,
+			Header_1: &ethpb.BeaconBlockHeader{
+				Slot: params.BeaconConfig().SlotsPerEpoch + 1,
+			},
+			Header_2: &ethpb.BeaconBlockHeader{
+				Slot: 0,
+			},
+		},
+	}
+	beaconState := &pb.BeaconState{
+		Validators: make([]*ethpb.Validator, 2),
+	}
+	_, err := blocks.ProcessProposerSlashings(beaconState, &ethpb.BeaconBlockBody{ProposerSlashings: slashings})
+	opErr, ok := err.(*blocks.OperationError)
+	if !ok {
+		t.Fatalf("Wanted operation error, got %v", err)
+	}
+	if opErr.Operation != "proposer_slashing" || opErr.Index != 0 {
+		t.Errorf("Wanted proposer_slashing 0, got %s %d", opErr.Operation, opErr.Index)
+	}
+	if !strings.Contains(opErr.Error(), "mismatched header epochs") {
+		t.Errorf("Wanted underlying error message, got %v", opErr)
+	}
+}
+
 func TestProcessProposerSlashings_SameHeaders(t *testing.T) {
 	registry := make([]*ethpb.Validator, 2)
 	currentSlot := uint64(0)
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -88,7 +87,7 @@ func TestEth1DataHasEnoughSupport(t *testing.T) {
 
 	for i, tt := range tests {
 		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
-			eth1DataCache = cache.NewEth1DataVoteCache()
+			ClearEth1DataVoteCache()
 
 			c := params.BeaconConfig()
 			c.SlotsPerEth1VotingPeriod = tt.votingPeriodLength
@@ -6,32 +6,37 @@ import (
 
 // ClearShuffledValidatorCache clears the shuffled indices cache from scratch.
 func ClearShuffledValidatorCache() {
-	shuffledIndicesCache = cache.NewShuffledIndicesCache()
+	shuffledIndicesCache.Clear()
 }
 
 // ClearStartShardCache clears the start shard cache from scratch.
 func ClearStartShardCache() {
-	startShardCache = cache.NewStartShardCache()
+	startShardCache.Clear()
 }
 
 // ClearTotalActiveBalanceCache restarts the total active validator balance cache from scratch.
 func ClearTotalActiveBalanceCache() {
-	totalActiveBalanceCache = cache.NewActiveBalanceCache()
+	totalActiveBalanceCache.Clear()
 }
 
 // ClearCurrentEpochSeed clears the current epoch seed.
 func ClearCurrentEpochSeed() {
-	currentEpochSeed = cache.NewSeedCache()
+	currentEpochSeed.Clear()
 }
 
 // ClearActiveCountCache restarts the active validator count cache from scratch.
 func ClearActiveCountCache() {
-	activeCountCache = cache.NewActiveCountCache()
+	activeCountCache.Clear()
 }
 
 // ClearActiveIndicesCache restarts the active validator indices cache from scratch.
 func ClearActiveIndicesCache() {
-	activeIndicesCache = cache.NewActiveIndicesCache()
+	activeIndicesCache.Clear()
+}
+
+// ClearDomainCache clears the computed signature domains.
+func ClearDomainCache() {
+	domainCache.Clear()
 }
 
 // ActiveIndicesKeys returns the keys of the active indices cache.
@@ -39,12 +44,8 @@ func ActiveIndicesKeys() []string {
 	return activeIndicesCache.ActiveIndicesKeys()
 }
 
-// ClearAllCaches clears all the helpers caches from scratch.
+// ClearAllCaches clears all the state derived caches from scratch, the helpers caches among
+// them.
 func ClearAllCaches() {
-	ClearActiveIndicesCache()
-	ClearActiveCountCache()
-	ClearStartShardCache()
-	ClearShuffledValidatorCache()
-	ClearTotalActiveBalanceCache()
-	ClearCurrentEpochSeed()
+	cache.ClearAll()
 }
@@ -93,6 +93,35 @@ func CrosslinkCommittee(state *pb.BeaconState, epoch uint64, shard uint64) ([]ui
 	return ComputeCommittee(indices, seed, currentShard, committeeCount)
 }
 
+// CommitteeIndex returns the index of the shard's crosslink committee among the
+// committees of the epoch, as computed in get_crosslink_committee.
+func CommitteeIndex(state *pb.BeaconState, epoch uint64, shard uint64) (uint64, error) {
+	startShard, err := StartShard(state, epoch)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get start shard")
+	}
+	shardCount := params.BeaconConfig().ShardCount
+	return (shard + shardCount - startShard) % shardCount, nil
+}
+
+// CommitteeShard returns the shard of the crosslink committee at the given index among
+// the committees of the epoch. It is the inverse of CommitteeIndex.
+func CommitteeShard(state *pb.BeaconState, epoch uint64, committeeIndex uint64) (uint64, error) {
+	committeeCount, err := CommitteeCount(state, epoch)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get committee count")
+	}
+	if committeeIndex >= committeeCount {
+		return 0, fmt.Errorf("committee index %d is out of range, epoch %d has %d committees",
+			committeeIndex, epoch, committeeCount)
+	}
+	startShard, err := StartShard(state, epoch)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get start shard")
+	}
+	return (startShard + committeeIndex) % params.BeaconConfig().ShardCount, nil
+}
+
 // ComputeCommittee returns the requested shuffled committee out of the total committees using
 // validator indices and seed.
 //
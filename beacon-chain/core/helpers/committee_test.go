@@ -422,6 +422,46 @@ func TestCommitteeAssignment_CanRetrieve(t *testing.T) {
 	}
 }
 
+func TestCommitteeShard_InverseOfCommitteeIndex(t *testing.T) {
+	ClearAllCaches()
+	validators := make([]*ethpb.Validator, 2*params.BeaconConfig().SlotsPerEpoch)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &ethpb.Validator{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	state := &pb.BeaconState{
+		Validators:       validators,
+		Slot:             params.BeaconConfig().SlotsPerEpoch,
+		StartShard:       10,
+		RandaoMixes:      make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+		ActiveIndexRoots: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+	}
+	epoch := CurrentEpoch(state)
+	committeeCount, err := CommitteeCount(state, epoch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for committeeIndex := uint64(0); committeeIndex < committeeCount; committeeIndex++ {
+		shard, err := CommitteeShard(state, epoch, committeeIndex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		index, err := CommitteeIndex(state, epoch, shard)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if index != committeeIndex {
+			t.Errorf("Wanted committee index %d for shard %d, got %d", committeeIndex, shard, index)
+		}
+	}
+
+	if _, err := CommitteeShard(state, epoch, committeeCount); err == nil {
+		t.Error("Expected an error for a committee index out of range")
+	}
+}
+
 func TestCommitteeAssignment_EveryValidatorShouldPropose(t *testing.T) {
 	// Initialize 64 validators with 64 slots per epoch. Every validator
 	// in the epoch should be a proposer.
@@ -0,0 +1,42 @@
+package helpers
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+// domainCache maps the concatenation of a 4 byte domain type and a 4 byte fork
+// version to its computed signature domain.
+var domainCache = cache.NewStore(cache.StoreConfig{
+	Name:         "signature_domain",
+	MaxSize:      64,
+	StateDerived: true,
+})
+
+// DomainAtEpoch returns the signature domain for the given domain type at the given epoch,
+// computed from the fork schedule alone rather than from a full beacon state. This lets
+// callers which only know the fork (validators, pool validation) sign and verify messages
+// with the correct domain across fork boundaries.
+//
+// Spec pseudocode definition:
+//    fork_version = fork.previous_version if epoch < fork.epoch else fork.current_version
+//    return compute_domain(domain_type, fork_version)
+func DomainAtEpoch(fork *pb.Fork, epoch uint64, domainType []byte) uint64 {
+	forkVersion := fork.CurrentVersion
+	if epoch < fork.Epoch {
+		forkVersion = fork.PreviousVersion
+	}
+
+	var key [8]byte
+	copy(key[:4], domainType)
+	copy(key[4:], forkVersion)
+
+	if domain, ok := domainCache.Get(string(key[:])); ok {
+		return domain.(uint64)
+	}
+
+	domain := bls.Domain(domainType, forkVersion)
+	domainCache.Set(string(key[:]), domain)
+	return domain
+}
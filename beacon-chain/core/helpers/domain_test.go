@@ -0,0 +1,38 @@
+package helpers
+
+import (
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+func TestDomainAtEpoch_AcrossForkBoundary(t *testing.T) {
+	ClearDomainCache()
+	fork := &pb.Fork{
+		Epoch:           3,
+		PreviousVersion: []byte{0, 0, 0, 2},
+		CurrentVersion:  []byte{0, 0, 0, 3},
+	}
+	tests := []struct {
+		epoch      uint64
+		domainType uint64
+		domain     uint64
+	}{
+		{epoch: 2, domainType: 4, domain: 144115188075855876},
+		{epoch: 3, domainType: 4, domain: 216172782113783812},
+		{epoch: 2, domainType: 5, domain: 144115188075855877},
+		{epoch: 3, domainType: 5, domain: 216172782113783813},
+	}
+	for _, tt := range tests {
+		// Query twice to exercise both the computed and the cached path.
+		for i := 0; i < 2; i++ {
+			if d := DomainAtEpoch(fork, tt.epoch, bytesutil.Bytes4(tt.domainType)); d != tt.domain {
+				t.Errorf("Wanted domain %d at epoch %d, received %d", tt.domain, tt.epoch, d)
+			}
+		}
+	}
+	if domainCache.Len() != 4 {
+		t.Errorf("Wanted %d cached domains, received %d", 4, domainCache.Len())
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
-	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -219,6 +218,29 @@ func BeaconProposerIndex(state *pb.BeaconState) (uint64, error) {
 	}
 }
 
+// ProposerIndices returns the proposer index of every slot of the given epoch, which can be the
+// current or the next epoch of the state. The proposers of the next epoch are computed from the
+// current effective balances, so they change if the effective balance of a proposer candidate is
+// updated at the epoch transition.
+func ProposerIndices(state *pb.BeaconState, epoch uint64) ([]uint64, error) {
+	if epoch > NextEpoch(state) {
+		return nil, fmt.Errorf("epoch %d can't be greater than next epoch %d", epoch, NextEpoch(state))
+	}
+	// Only the slot of the copy is modified, so the validators and other fields are shared.
+	s := *state
+	startSlot := StartSlot(epoch)
+	indices := make([]uint64, params.BeaconConfig().SlotsPerEpoch)
+	for i := range indices {
+		s.Slot = startSlot + uint64(i)
+		idx, err := BeaconProposerIndex(&s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get proposer index at slot %d", s.Slot)
+		}
+		indices[i] = idx
+	}
+	return indices, nil
+}
+
 // Domain returns the domain version for BLS private key to sign and verify.
 //
 // Spec pseudocode definition:
@@ -232,11 +254,5 @@ func BeaconProposerIndex(state *pb.BeaconState) (uint64, error) {
 //    fork_version = state.fork.previous_version if epoch < state.fork.epoch else state.fork.current_version
 //    return bls_domain(domain_type, fork_version)
 func Domain(state *pb.BeaconState, epoch uint64, domainType []byte) uint64 {
-	var forkVersion []byte
-	if epoch < state.Fork.Epoch {
-		forkVersion = state.Fork.PreviousVersion
-	} else {
-		forkVersion = state.Fork.CurrentVersion
-	}
-	return bls.Domain(domainType, forkVersion)
+	return DomainAtEpoch(state.Fork, epoch, domainType)
 }
@@ -227,6 +227,52 @@ func TestBeaconProposerIndex_EmptyCommittee(t *testing.T) {
 	}
 }
 
+func TestProposerIndices_OK(t *testing.T) {
+	ClearAllCaches()
+
+	validators := make([]*ethpb.Validator, params.BeaconConfig().MinGenesisActiveValidatorCount/8)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &ethpb.Validator{
+			ExitEpoch:        params.BeaconConfig().FarFutureEpoch,
+			EffectiveBalance: params.BeaconConfig().MaxEffectiveBalance,
+		}
+	}
+	state := &pb.BeaconState{
+		Validators:       validators,
+		Slot:             3,
+		RandaoMixes:      make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+		ActiveIndexRoots: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+	}
+
+	for _, epoch := range []uint64{0, 1} {
+		indices, err := ProposerIndices(state, epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if uint64(len(indices)) != params.BeaconConfig().SlotsPerEpoch {
+			t.Fatalf("Wanted %d proposers, got %d", params.BeaconConfig().SlotsPerEpoch, len(indices))
+		}
+		for i, idx := range indices {
+			s := *state
+			s.Slot = StartSlot(epoch) + uint64(i)
+			want, err := BeaconProposerIndex(&s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if idx != want {
+				t.Errorf("Wanted proposer %d at slot %d, got %d", want, s.Slot, idx)
+			}
+		}
+	}
+	if state.Slot != 3 {
+		t.Errorf("Wanted state slot to be left at 3, got %d", state.Slot)
+	}
+
+	if _, err := ProposerIndices(state, 2); err == nil {
+		t.Error("Wanted error for epoch after the next epoch")
+	}
+}
+
 func TestDelayedActivationExitEpoch_OK(t *testing.T) {
 	epoch := uint64(9999)
 	got := DelayedActivationExitEpoch(epoch)
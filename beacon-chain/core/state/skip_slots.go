@@ -0,0 +1,50 @@
+package state
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// SkipSlots keeps the pre-state of the latest block too far ahead of its parent, advanced by a
+// chunk of MaxSkipSlotsPerBlock slots at every attempt at a block with that parent. It is safe
+// for concurrent use.
+type SkipSlots struct {
+	lock  sync.Mutex
+	root  [32]byte
+	state *pb.BeaconState
+}
+
+// Advance returns the pre-state of the block when it is within MaxSkipSlotsPerBlock of the block
+// slot, resuming from the state saved by the previous attempts at a block with the same parent.
+// Otherwise it processes a chunk of the skip slots, saves the resulting state for the next
+// attempt and returns ErrTooManySkipSlots, so that a long run of empty slots doesn't pin a CPU
+// core for minutes on a single block. The given state is left untouched.
+func (s *SkipSlots) Advance(ctx context.Context, beaconState *pb.BeaconState, block *ethpb.BeaconBlock) (*pb.BeaconState, error) {
+	limit := params.BeaconConfig().MaxSkipSlotsPerBlock
+	if limit == 0 || block.Slot <= beaconState.Slot+limit {
+		return beaconState, nil
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	parentRoot := bytesutil.ToBytes32(block.ParentRoot)
+	if s.state != nil && s.root == parentRoot && s.state.Slot > beaconState.Slot && s.state.Slot <= block.Slot {
+		beaconState = proto.Clone(s.state).(*pb.BeaconState)
+	}
+	if block.Slot <= beaconState.Slot+limit {
+		return beaconState, nil
+	}
+	advanced, err := ProcessSlotsInChunk(ctx, proto.Clone(beaconState).(*pb.BeaconState), block.Slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process skip slots")
+	}
+	s.root = parentRoot
+	s.state = advanced
+	return nil, errors.Wrapf(ErrTooManySkipSlots, "processed skip slots up to slot %d of %d", advanced.Slot, block.Slot)
+}
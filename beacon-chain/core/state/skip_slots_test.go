@@ -0,0 +1,55 @@
+package state_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestSkipSlots_AdvancesChunkPerAttempt(t *testing.T) {
+	helpers.ClearAllCaches()
+	c := params.BeaconConfig()
+	prevMax := c.MaxSkipSlotsPerBlock
+	c.MaxSkipSlotsPerBlock = 3
+	params.OverrideBeaconConfig(c)
+	defer func() {
+		c.MaxSkipSlotsPerBlock = prevMax
+		params.OverrideBeaconConfig(c)
+	}()
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 16)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	skipSlots := &state.SkipSlots{}
+	block := &ethpb.BeaconBlock{Slot: 8, ParentRoot: []byte{'A'}}
+
+	for i := 0; i < 2; i++ {
+		if _, err := skipSlots.Advance(context.Background(), beaconState, block); errors.Cause(err) != state.ErrTooManySkipSlots {
+			t.Fatalf("Wanted %v, got %v", state.ErrTooManySkipSlots, err)
+		}
+	}
+	if beaconState.Slot != 0 {
+		t.Errorf("Wanted the parent state to be left untouched, got slot %d", beaconState.Slot)
+	}
+	preState, err := skipSlots.Advance(context.Background(), beaconState, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if preState.Slot != 6 {
+		t.Errorf("Wanted pre-state resumed at slot 6, got %d", preState.Slot)
+	}
+
+	// A block with another parent starts over.
+	other := &ethpb.BeaconBlock{Slot: 8, ParentRoot: []byte{'B'}}
+	if _, err := skipSlots.Advance(context.Background(), beaconState, other); errors.Cause(err) != state.ErrTooManySkipSlots {
+		t.Errorf("Wanted %v, got %v", state.ErrTooManySkipSlots, err)
+	}
+}
@@ -22,6 +22,11 @@ import (
 	"go.opencensus.io/trace"
 )
 
+// ErrTooManySkipSlots is returned when a block is further ahead of its pre-state than the
+// MaxSkipSlotsPerBlock configuration allows. The pre-state can be advanced towards the block
+// with ProcessSlotsInChunk until it is within the limit.
+var ErrTooManySkipSlots = errors.New("too many skip slots to process for block")
+
 // ExecuteStateTransition defines the procedure for a state transition function.
 //
 // Spec pseudocode definition:
@@ -47,6 +52,12 @@ func ExecuteStateTransition(
 	b.ClearEth1DataVoteCache()
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.ExecuteStateTransition")
 	defer span.End()
+	// Processing the skip slots of a block comes before verifying its signature, a block claiming
+	// a slot far ahead of its parent must not keep the node busy for minutes.
+	if limit := params.BeaconConfig().MaxSkipSlotsPerBlock; limit > 0 && block.Slot > state.Slot+limit {
+		return nil, errors.Wrapf(ErrTooManySkipSlots, "block slot %d is %d slots ahead of state slot %d, max %d",
+			block.Slot, block.Slot-state.Slot, state.Slot, limit)
+	}
 	var err error
 	// Execute per slots transition.
 	state, err = ProcessSlots(ctx, state, block.Slot)
@@ -197,6 +208,16 @@ func ProcessSlots(ctx context.Context, state *pb.BeaconState, slot uint64) (*pb.
 	return state, nil
 }
 
+// ProcessSlotsInChunk processes the slots of the state towards the given slot, at most
+// MaxSkipSlotsPerBlock of them, so that a long run of empty slots can be processed over several
+// calls. It returns the state once it reaches the slot, or the last slot processed.
+func ProcessSlotsInChunk(ctx context.Context, state *pb.BeaconState, slot uint64) (*pb.BeaconState, error) {
+	if limit := params.BeaconConfig().MaxSkipSlotsPerBlock; limit > 0 && slot > state.Slot+limit {
+		slot = state.Slot + limit
+	}
+	return ProcessSlots(ctx, state, slot)
+}
+
 // ProcessBlock creates a new, modified beacon state by applying block operation
 // transformations as defined in the Ethereum Serenity specification, including processing proposer slashings,
 // processing block attestations, and more.
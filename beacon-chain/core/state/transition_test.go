@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
@@ -37,6 +38,58 @@ func TestExecuteStateTransition_IncorrectSlot(t *testing.T) {
 	}
 }
 
+func TestExecuteStateTransition_TooManySkipSlots(t *testing.T) {
+	c := params.BeaconConfig()
+	prevMax := c.MaxSkipSlotsPerBlock
+	c.MaxSkipSlotsPerBlock = 10
+	params.OverrideBeaconConfig(c)
+	defer func() {
+		c.MaxSkipSlotsPerBlock = prevMax
+		params.OverrideBeaconConfig(c)
+	}()
+
+	beaconState := &pb.BeaconState{Slot: 5}
+	block := &ethpb.BeaconBlock{Slot: 16}
+	if _, err := state.ExecuteStateTransition(context.Background(), beaconState, block); errors.Cause(err) != state.ErrTooManySkipSlots {
+		t.Errorf("Wanted %v, got %v", state.ErrTooManySkipSlots, err)
+	}
+	if beaconState.Slot != 5 {
+		t.Errorf("Wanted no slot processed, got state at slot %d", beaconState.Slot)
+	}
+}
+
+func TestProcessSlotsInChunk_StopsAtLimit(t *testing.T) {
+	helpers.ClearAllCaches()
+	c := params.BeaconConfig()
+	prevMax := c.MaxSkipSlotsPerBlock
+	c.MaxSkipSlotsPerBlock = 3
+	params.OverrideBeaconConfig(c)
+	defer func() {
+		c.MaxSkipSlotsPerBlock = prevMax
+		params.OverrideBeaconConfig(c)
+	}()
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 16)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState, err = state.ProcessSlotsInChunk(context.Background(), beaconState, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beaconState.Slot != 3 {
+		t.Errorf("Wanted slot 3 after the first chunk, got %d", beaconState.Slot)
+	}
+	beaconState, err = state.ProcessSlotsInChunk(context.Background(), beaconState, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beaconState.Slot != 5 {
+		t.Errorf("Wanted slot 5 after the second chunk, got %d", beaconState.Slot)
+	}
+}
+
 func TestExecuteStateTransition_FullProcess(t *testing.T) {
 	helpers.ClearAllCaches()
 	deposits, privKeys := testutil.SetupInitialDeposits(t, 100)
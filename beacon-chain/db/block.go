@@ -172,17 +172,31 @@ func (db *BeaconDB) SaveBlock(_ context.Context, block *ethpb.BeaconBlock) error
 // SaveBlockDeprecated accepts a block and writes it to disk.
 // DEPRECATED: Use SaveBlock.
 func (db *BeaconDB) SaveBlockDeprecated(block *ethpb.BeaconBlock) error {
+	_, err := db.saveBlock(block, nil)
+	return err
+}
+
+// SaveProcessedBlock saves a block about to be processed, and counts it in the blocks processed
+// statistic in the same transaction. It returns the new values of the statistics, none if the
+// block was already saved.
+func (db *BeaconDB) SaveProcessedBlock(ctx context.Context, block *ethpb.BeaconBlock) (map[ChainStat]uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveProcessedBlock")
+	defer span.End()
+	return db.saveBlock(block, map[ChainStat]int64{BlocksProcessedStat: 1})
+}
+
+func (db *BeaconDB) saveBlock(block *ethpb.BeaconBlock, stats map[ChainStat]int64) (map[ChainStat]uint64, error) {
 	db.blocksLock.Lock()
 	defer db.blocksLock.Unlock()
 
 	signingRoot, err := ssz.SigningRoot(block)
 	if err != nil {
-		return errors.Wrap(err, "failed to tree hash header")
+		return nil, errors.Wrap(err, "failed to tree hash header")
 	}
 
 	// Skip saving block to DB if it exists in the cache.
 	if blk, exists := db.blocks[signingRoot]; exists && blk != nil {
-		return nil
+		return nil, nil
 	}
 	// Save it to the cache if it's not in the cache.
 	db.blocks[signingRoot] = block
@@ -190,7 +204,7 @@ func (db *BeaconDB) SaveBlockDeprecated(block *ethpb.BeaconBlock) error {
 
 	enc, err := proto.Marshal(block)
 	if err != nil {
-		return errors.Wrap(err, "failed to encode block")
+		return nil, errors.Wrap(err, "failed to encode block")
 	}
 	slotRootBinary := encodeSlotNumberRoot(block.Slot, signingRoot)
 
@@ -198,13 +212,19 @@ func (db *BeaconDB) SaveBlockDeprecated(block *ethpb.BeaconBlock) error {
 		db.highestBlockSlot = block.Slot
 	}
 
-	return db.update(func(tx *bolt.Tx) error {
+	var counts map[ChainStat]uint64
+	err = db.update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(blockBucket)
 		if err := bucket.Put(slotRootBinary, enc); err != nil {
 			return errors.Wrap(err, "failed to include the block in the main chain bucket")
 		}
-		return bucket.Put(signingRoot[:], enc)
+		if err := bucket.Put(signingRoot[:], enc); err != nil {
+			return err
+		}
+		counts, err = addChainStats(tx, stats)
+		return err
 	})
+	return counts, err
 }
 
 // DeleteBlock deletes a block using the slot and its root as keys in their respective buckets.
@@ -217,12 +237,26 @@ func (db *BeaconDB) DeleteBlock(_ context.Context, hash [32]byte) error {
 
 // DeleteBlockDeprecated deletes a block using the slot and its root as keys in their respective buckets.
 func (db *BeaconDB) DeleteBlockDeprecated(block *ethpb.BeaconBlock) error {
+	_, err := db.deleteBlock(block, nil)
+	return err
+}
+
+// DeleteInvalidBlock deletes a block saved with SaveProcessedBlock which failed the state
+// transition, and moves it from the blocks processed to the invalid blocks statistic in the same
+// transaction. It returns the new values of the statistics.
+func (db *BeaconDB) DeleteInvalidBlock(ctx context.Context, block *ethpb.BeaconBlock) (map[ChainStat]uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteInvalidBlock")
+	defer span.End()
+	return db.deleteBlock(block, map[ChainStat]int64{BlocksProcessedStat: -1, InvalidBlocksStat: 1})
+}
+
+func (db *BeaconDB) deleteBlock(block *ethpb.BeaconBlock, stats map[ChainStat]int64) (map[ChainStat]uint64, error) {
 	db.blocksLock.Lock()
 	defer db.blocksLock.Unlock()
 
 	signingRoot, err := ssz.SigningRoot(block)
 	if err != nil {
-		return errors.Wrap(err, "failed to tree hash block")
+		return nil, errors.Wrap(err, "failed to tree hash block")
 	}
 
 	// Delete the block from the cache.
@@ -231,13 +265,22 @@ func (db *BeaconDB) DeleteBlockDeprecated(block *ethpb.BeaconBlock) error {
 
 	slotRootBinary := encodeSlotNumberRoot(block.Slot, signingRoot)
 
-	return db.update(func(tx *bolt.Tx) error {
+	var counts map[ChainStat]uint64
+	err = db.update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(blockBucket)
 		if err := bucket.Delete(slotRootBinary); err != nil {
 			return errors.Wrap(err, "failed to include the block in the main chain bucket")
 		}
-		return bucket.Delete(signingRoot[:])
+		if err := tx.Bucket(attestationTargetBucket).Delete(signingRoot[:]); err != nil {
+			return errors.Wrap(err, "failed to delete the block's attestation target")
+		}
+		if err := bucket.Delete(signingRoot[:]); err != nil {
+			return err
+		}
+		counts, err = addChainStats(tx, stats)
+		return err
 	})
+	return counts, err
 }
 
 // SaveJustifiedBlock saves the last justified block from canonical chain to DB.
@@ -378,6 +421,18 @@ func (db *BeaconDB) UpdateChainHead(ctx context.Context, block *ethpb.BeaconBloc
 	})
 }
 
+// IndexCanonicalBlock records a saved block as the canonical block of its slot in the main chain
+// index, without updating the head, for blocks known to be finalized.
+func (db *BeaconDB) IndexCanonicalBlock(block *ethpb.BeaconBlock) error {
+	blockEnc, err := proto.Marshal(block)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(mainChainBucket).Put(encodeSlotNumber(block.Slot), blockEnc)
+	})
+}
+
 // CanonicalBlockBySlot accepts a slot number and returns the corresponding canonical block.
 func (db *BeaconDB) CanonicalBlockBySlot(ctx context.Context, slot uint64) (*ethpb.BeaconBlock, error) {
 	_, span := trace.StartSpan(ctx, "BeaconDB.CanonicalBlockBySlot")
@@ -47,6 +47,18 @@ func (db *BeaconDB) AttesterSlashing(ctx context.Context, slashingRoot [32]byte)
 	return nil, errors.New("unimplemented")
 }
 
+// ProposerSlashings retrieval from the db.
+// DEPRECATED: Use the kv store in beacon-chain/db/kv instead.
+func (db *BeaconDB) ProposerSlashings(ctx context.Context) ([]*ethpb.ProposerSlashing, error) {
+	return nil, errors.New("unimplemented")
+}
+
+// AttesterSlashings retrieval from the db.
+// DEPRECATED: Use the kv store in beacon-chain/db/kv instead.
+func (db *BeaconDB) AttesterSlashings(ctx context.Context) ([]*ethpb.AttesterSlashing, error) {
+	return nil, errors.New("unimplemented")
+}
+
 // SaveProposerSlashing to the db.
 // DEPRECATED: Use the kv store in beacon-chain/db/kv instead.
 func (db *BeaconDB) SaveProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) error {
@@ -102,6 +114,25 @@ func (db *BeaconDB) SaveExit(ctx context.Context, exit *ethpb.VoluntaryExit) err
 	})
 }
 
+// Exits retrieves every exit request from the beacon chain db.
+func (db *BeaconDB) Exits(ctx context.Context) ([]*ethpb.VoluntaryExit, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconDB.Exits")
+	defer span.End()
+
+	var exits []*ethpb.VoluntaryExit
+	err := db.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(blockOperationsBucket).ForEach(func(_, enc []byte) error {
+			exit := &ethpb.VoluntaryExit{}
+			if err := proto.Unmarshal(enc, exit); err != nil {
+				return err
+			}
+			exits = append(exits, exit)
+			return nil
+		})
+	})
+	return exits, err
+}
+
 // HasExit checks if the exit request exists.
 func (db *BeaconDB) HasExit(hash [32]byte) bool {
 	exists := false
@@ -114,3 +145,14 @@ func (db *BeaconDB) HasExit(hash [32]byte) bool {
 	}
 	return exists
 }
+
+// DeleteExit removes the exit request from the beacon chain db.
+func (db *BeaconDB) DeleteExit(ctx context.Context, hash [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "beaconDB.DeleteExit")
+	defer span.End()
+
+	return db.update(func(tx *bolt.Tx) error {
+		a := tx.Bucket(blockOperationsBucket)
+		return a.Delete(hash[:])
+	})
+}
@@ -31,3 +31,25 @@ func TestBeaconDB_HasExit(t *testing.T) {
 		t.Fatal("Expected HasExit to return true")
 	}
 }
+
+func TestBeaconDB_DeleteExit(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	d := &ethpb.VoluntaryExit{
+		Epoch: 100,
+	}
+	hash, err := hashutil.HashProto(d)
+	if err != nil {
+		t.Fatalf("could not hash exit request: %v", err)
+	}
+	if err := db.SaveExit(context.Background(), d); err != nil {
+		t.Fatalf("Failed to save exit request: %v", err)
+	}
+	if err := db.DeleteExit(context.Background(), hash); err != nil {
+		t.Fatalf("Failed to delete exit request: %v", err)
+	}
+	if db.HasExit(hash) {
+		t.Fatal("Expected HasExit to return false")
+	}
+}
@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// ChainStat names a cumulative statistic of the chain processed by the node. Statistics are
+// persisted so that they count over the lifetime of the node rather than since its last restart.
+type ChainStat string
+
+const (
+	// BlocksProcessedStat counts the blocks saved for processing which were not rejected by the
+	// state transition.
+	BlocksProcessedStat ChainStat = "blocks-processed"
+	// ReorgsStat counts the chain reorganizations.
+	ReorgsStat ChainStat = "reorgs"
+	// InvalidBlocksStat counts the blocks rejected for failing the state transition.
+	InvalidBlocksStat ChainStat = "invalid-blocks"
+)
+
+// ChainStats lists every chain statistic.
+var ChainStats = []ChainStat{BlocksProcessedStat, ReorgsStat, InvalidBlocksStat}
+
+// IncrementChainStat adds one to a chain statistic and returns its new value. The statistics of
+// the blocks are updated with the blocks instead, see SaveProcessedBlock.
+func (db *BeaconDB) IncrementChainStat(ctx context.Context, stat ChainStat) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.IncrementChainStat")
+	defer span.End()
+
+	var count uint64
+	err := db.update(func(tx *bolt.Tx) error {
+		counts, err := addChainStats(tx, map[ChainStat]int64{stat: 1})
+		count = counts[stat]
+		return err
+	})
+	return count, err
+}
+
+// addChainStats adds the deltas to the chain statistics within a transaction, never below 0, and
+// returns their new values.
+func addChainStats(tx *bolt.Tx, deltas map[ChainStat]int64) (map[ChainStat]uint64, error) {
+	bkt := tx.Bucket(chainStatsBucket)
+	counts := make(map[ChainStat]uint64, len(deltas))
+	for stat, delta := range deltas {
+		var count uint64
+		if enc := bkt.Get([]byte(stat)); enc != nil {
+			count = bytesutil.FromBytes8(enc)
+		}
+		if delta < 0 && uint64(-delta) > count {
+			count = 0
+		} else {
+			count = uint64(int64(count) + delta)
+		}
+		if err := bkt.Put([]byte(stat), bytesutil.Bytes8(count)); err != nil {
+			return nil, err
+		}
+		counts[stat] = count
+	}
+	return counts, nil
+}
+
+// ChainStat returns the value of a chain statistic, 0 if it was never incremented.
+func (db *BeaconDB) ChainStat(ctx context.Context, stat ChainStat) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ChainStat")
+	defer span.End()
+
+	var count uint64
+	err := db.view(func(tx *bolt.Tx) error {
+		if enc := tx.Bucket(chainStatsBucket).Get([]byte(stat)); enc != nil {
+			count = bytesutil.FromBytes8(enc)
+		}
+		return nil
+	})
+	return count, err
+}
@@ -0,0 +1,94 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+func TestChainStats_PersistAcrossRestarts(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	count, err := db.ChainStat(ctx, ReorgsStat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("Wanted 0 reorgs before any is recorded, got %d", count)
+	}
+	for i := uint64(1); i <= 3; i++ {
+		count, err := db.IncrementChainStat(ctx, ReorgsStat)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != i {
+			t.Errorf("Wanted %d reorgs, got %d", i, count)
+		}
+	}
+	if _, err := db.IncrementChainStat(ctx, InvalidBlocksStat); err != nil {
+		t.Fatal(err)
+	}
+
+	path := db.DatabasePath()
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	db, err = NewDBDeprecated(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[ChainStat]uint64{BlocksProcessedStat: 0, ReorgsStat: 3, InvalidBlocksStat: 1}
+	for stat, wanted := range want {
+		count, err := db.ChainStat(ctx, stat)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != wanted {
+			t.Errorf("Wanted %d for %s after restart, got %d", wanted, stat, count)
+		}
+	}
+}
+
+func TestChainStats_RecordedWithBlocks(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	valid := &ethpb.BeaconBlock{Slot: 1}
+	invalid := &ethpb.BeaconBlock{Slot: 2}
+	for _, blk := range []*ethpb.BeaconBlock{valid, invalid} {
+		if _, err := db.SaveProcessedBlock(ctx, blk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A block saved again is not counted again.
+	counts, err := db.SaveProcessedBlock(ctx, valid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(counts) != 0 {
+		t.Errorf("Wanted no statistic updated for a block already saved, got %v", counts)
+	}
+
+	counts, err = db.DeleteInvalidBlock(ctx, invalid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[ChainStat]uint64{BlocksProcessedStat: 1, InvalidBlocksStat: 1}
+	for stat, wanted := range want {
+		if counts[stat] != wanted {
+			t.Errorf("Wanted %d for %s, got %d", wanted, stat, counts[stat])
+		}
+		count, err := db.ChainStat(ctx, stat)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != wanted {
+			t.Errorf("Wanted %d for %s persisted, got %d", wanted, stat, count)
+		}
+	}
+}
@@ -59,6 +59,8 @@ type Database interface {
 	// Slashing operations.
 	ProposerSlashing(ctx context.Context, slashingRoot [32]byte) (*ethpb.ProposerSlashing, error)
 	AttesterSlashing(ctx context.Context, slashingRoot [32]byte) (*ethpb.AttesterSlashing, error)
+	ProposerSlashings(ctx context.Context) ([]*ethpb.ProposerSlashing, error)
+	AttesterSlashings(ctx context.Context) ([]*ethpb.AttesterSlashing, error)
 	SaveProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) error
 	SaveAttesterSlashing(ctx context.Context, slashing *ethpb.AttesterSlashing) error
 	HasProposerSlashing(ctx context.Context, slashingRoot [32]byte) bool
@@ -86,7 +88,7 @@ var _ = Database(&BeaconDB{})
 type BeaconDB struct {
 	// state objects and caches
 	stateLock         sync.RWMutex
-	serializedState   []byte
+	states            *stateCache
 	stateHash         [32]byte
 	validatorRegistry []*ethpb.Validator
 	validatorBalances []uint64
@@ -100,10 +102,19 @@ type BeaconDB struct {
 	badBlocksLock  sync.RWMutex
 	blocks         map[[32]byte]*ethpb.BeaconBlock
 	blocksLock     sync.RWMutex
+
+	// Snapshots taken before risky operations.
+	snapshots *kv.Snapshots
+
+	// Policy applied to historical states when a new finalized state is saved.
+	stateRetention       StateRetentionPolicy
+	stateRetentionEpochs uint64
+	stateRetentionDryRun bool
 }
 
-// Close closes the underlying boltdb database.
+// Close closes the underlying boltdb database, once the snapshot being written, if any, is done.
 func (db *BeaconDB) Close() error {
+	db.snapshots.Wait()
 	return db.db.Close()
 }
 
@@ -144,10 +155,14 @@ func NewDBDeprecated(dirPath string) (*BeaconDB, error) {
 
 	db := &BeaconDB{db: boltDB, databasePath: dirPath}
 	db.blocks = make(map[[32]byte]*ethpb.BeaconBlock)
+	db.states = newStateCache(stateCacheSize)
+	db.snapshots = kv.NewSnapshots(boltDB, dirPath)
+	db.stateRetention = KeepFinalizedStates
 
 	if err := db.update(func(tx *bolt.Tx) error {
 		return createBuckets(tx, blockBucket, attestationBucket, attestationTargetBucket, mainChainBucket,
-			histStateBucket, chainInfoBucket, cleanupHistoryBucket, blockOperationsBucket, validatorBucket)
+			histStateBucket, chainInfoBucket, cleanupHistoryBucket, blockOperationsBucket, validatorBucket,
+			peerStatusBucket, chainStatsBucket)
 	}); err != nil {
 		return nil, err
 	}
@@ -27,11 +27,10 @@ func setupDB(t testing.TB) *BeaconDB {
 		t.Fatalf("Failed to remove directory: %v", err)
 	}
 	db, err := NewDBDeprecated(path)
-	db.blocks = make(map[[32]byte]*ethpb.BeaconBlock)
-
 	if err != nil {
 		t.Fatalf("Failed to instantiate DB: %v", err)
 	}
+	db.blocks = make(map[[32]byte]*ethpb.BeaconBlock)
 	return db
 }
 
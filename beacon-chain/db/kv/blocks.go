@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
@@ -21,8 +20,8 @@ func (k *Store) Block(ctx context.Context, blockRoot [32]byte) (*ethpb.BeaconBlo
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.Block")
 	defer span.End()
 	// Return block from cache if it exists.
-	if v := k.blockCache.Get(string(blockRoot[:])); v != nil {
-		return v.Value().(*ethpb.BeaconBlock), nil
+	if v, ok := k.blockCache.Get(string(blockRoot[:])); ok {
+		return v.(*ethpb.BeaconBlock), nil
 	}
 	var block *ethpb.BeaconBlock
 	err := k.db.View(func(tx *bolt.Tx) error {
@@ -43,7 +42,7 @@ func (k *Store) HeadBlock(ctx context.Context) (*ethpb.BeaconBlock, error) {
 	defer span.End()
 	var headBlock *ethpb.BeaconBlock
 	err := k.db.View(func(tx *bolt.Tx) error {
-		bkt := tx.Bucket(validatorsBucket)
+		bkt := tx.Bucket(blocksBucket)
 		headRoot := bkt.Get(headBlockRootKey)
 		if headRoot == nil {
 			return nil
@@ -150,7 +149,7 @@ func (k *Store) BlockRoots(ctx context.Context, f *filters.QueryFilter) ([][]byt
 func (k *Store) HasBlock(ctx context.Context, blockRoot [32]byte) bool {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.HasBlock")
 	defer span.End()
-	if v := k.blockCache.Get(string(blockRoot[:])); v != nil {
+	if _, ok := k.blockCache.Get(string(blockRoot[:])); ok {
 		return true
 	}
 	exists := false
@@ -196,7 +195,7 @@ func (k *Store) SaveBlock(ctx context.Context, block *ethpb.BeaconBlock) error {
 		return err
 	}
 
-	if v := k.blockCache.Get(string(blockRoot[:])); v != nil {
+	if _, ok := k.blockCache.Get(string(blockRoot[:])); ok {
 		return nil
 	}
 
@@ -210,7 +209,7 @@ func (k *Store) SaveBlock(ctx context.Context, block *ethpb.BeaconBlock) error {
 		if err := updateValueForIndices(indicesByBucket, blockRoot[:], tx); err != nil {
 			return errors.Wrap(err, "could not update DB indices")
 		}
-		k.blockCache.Set(string(blockRoot[:]), block, time.Hour)
+		k.blockCache.Set(string(blockRoot[:]), block)
 		return bkt.Put(blockRoot[:], enc)
 	})
 }
@@ -240,7 +239,7 @@ func (k *Store) SaveBlocks(ctx context.Context, blocks []*ethpb.BeaconBlock) err
 			if err := updateValueForIndices(indicesByBucket, keys[i], tx); err != nil {
 				return errors.Wrap(err, "could not update DB indices")
 			}
-			k.blockCache.Set(string(keys[i]), blocks[i], time.Hour)
+			k.blockCache.Set(string(keys[i]), blocks[i])
 			if err := bucket.Put(keys[i], encodedValues[i]); err != nil {
 				return err
 			}
@@ -170,3 +170,37 @@ func TestStore_Blocks_RetrieveRange(t *testing.T) {
 		t.Errorf("Wanted %d, received %d", want, len(retrieved))
 	}
 }
+
+func TestStore_SaveHeadBlockRoot(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	headBlock, err := db.HeadBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if headBlock != nil {
+		t.Errorf("Expected nil head block, received %v", headBlock)
+	}
+	block := &ethpb.BeaconBlock{
+		Slot:       20,
+		ParentRoot: []byte{1, 2, 3},
+	}
+	blockRoot, err := ssz.SigningRoot(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBlock(ctx, block); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveHeadBlockRoot(ctx, blockRoot); err != nil {
+		t.Fatal(err)
+	}
+	headBlock, err = db.HeadBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(block, headBlock) {
+		t.Errorf("Wanted %v, received %v", block, headBlock)
+	}
+}
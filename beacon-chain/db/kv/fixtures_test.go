@@ -0,0 +1,224 @@
+package kv
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-bitfield"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+var updateFixtures = flag.Bool("update-fixtures", false, "Overwrite the DB fixtures in testdata with the encoding of the current code")
+
+// dbFixture is an object as stored in the DB, checked against its encoding by previous releases.
+type dbFixture struct {
+	name string
+	obj  proto.Message
+	// empty returns a message to decode the fixture into.
+	empty func() proto.Message
+}
+
+// fixtureBytes returns n times the byte b, so that every field of a fixture is distinguishable.
+func fixtureBytes(n int, b byte) []byte {
+	return bytes.Repeat([]byte{b}, n)
+}
+
+func fixtureHeader(slot uint64, b byte) *ethpb.BeaconBlockHeader {
+	return &ethpb.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: fixtureBytes(32, b),
+		StateRoot:  fixtureBytes(32, b+1),
+		BodyRoot:   fixtureBytes(32, b+2),
+		Signature:  fixtureBytes(96, b+3),
+	}
+}
+
+func fixtureAttestationData() *ethpb.AttestationData {
+	return &ethpb.AttestationData{
+		BeaconBlockRoot: fixtureBytes(32, 8),
+		Source:          &ethpb.Checkpoint{Epoch: 3, Root: fixtureBytes(32, 9)},
+		Target:          &ethpb.Checkpoint{Epoch: 4, Root: fixtureBytes(32, 10)},
+		Crosslink:       fixtureCrosslink(),
+	}
+}
+
+func fixtureCrosslink() *ethpb.Crosslink {
+	return &ethpb.Crosslink{
+		Shard:      5,
+		ParentRoot: fixtureBytes(32, 11),
+		StartEpoch: 3,
+		EndEpoch:   4,
+		DataRoot:   fixtureBytes(32, 0),
+	}
+}
+
+func fixtureAttestation() *ethpb.Attestation {
+	return &ethpb.Attestation{
+		AggregationBits: bitfield.Bitlist{0x0b},
+		Data:            fixtureAttestationData(),
+		CustodyBits:     bitfield.Bitlist{0x08},
+		Signature:       fixtureBytes(96, 12),
+	}
+}
+
+func fixtureVoluntaryExit() *ethpb.VoluntaryExit {
+	return &ethpb.VoluntaryExit{
+		Epoch:          5,
+		ValidatorIndex: 7,
+		Signature:      fixtureBytes(96, 13),
+	}
+}
+
+func fixtureEth1Data() *ethpb.Eth1Data {
+	return &ethpb.Eth1Data{
+		DepositRoot:  fixtureBytes(32, 4),
+		DepositCount: 16,
+		BlockHash:    fixtureBytes(32, 5),
+	}
+}
+
+func dbFixtures() []*dbFixture {
+	return []*dbFixture{
+		{
+			name: "block",
+			obj: &ethpb.BeaconBlock{
+				Slot:       42,
+				ParentRoot: fixtureBytes(32, 1),
+				StateRoot:  fixtureBytes(32, 2),
+				Body: &ethpb.BeaconBlockBody{
+					RandaoReveal:   fixtureBytes(96, 3),
+					Eth1Data:       fixtureEth1Data(),
+					Graffiti:       fixtureBytes(32, 6),
+					Attestations:   []*ethpb.Attestation{fixtureAttestation()},
+					VoluntaryExits: []*ethpb.VoluntaryExit{fixtureVoluntaryExit()},
+				},
+				Signature: fixtureBytes(96, 7),
+			},
+			empty: func() proto.Message { return &ethpb.BeaconBlock{} },
+		},
+		{
+			name:  "attestation",
+			obj:   fixtureAttestation(),
+			empty: func() proto.Message { return &ethpb.Attestation{} },
+		},
+		{
+			name:  "voluntary_exit",
+			obj:   fixtureVoluntaryExit(),
+			empty: func() proto.Message { return &ethpb.VoluntaryExit{} },
+		},
+		{
+			name: "proposer_slashing",
+			obj: &ethpb.ProposerSlashing{
+				ProposerIndex: 7,
+				Header_1:      fixtureHeader(40, 14),
+				Header_2:      fixtureHeader(40, 18),
+			},
+			empty: func() proto.Message { return &ethpb.ProposerSlashing{} },
+		},
+		{
+			name: "attester_slashing",
+			obj: &ethpb.AttesterSlashing{
+				Attestation_1: &ethpb.IndexedAttestation{
+					CustodyBit_0Indices: []uint64{1, 2, 300},
+					Data:                fixtureAttestationData(),
+					Signature:           fixtureBytes(96, 22),
+				},
+				Attestation_2: &ethpb.IndexedAttestation{
+					CustodyBit_0Indices: []uint64{1, 300},
+					CustodyBit_1Indices: []uint64{2},
+					Data:                fixtureAttestationData(),
+					Signature:           fixtureBytes(96, 23),
+				},
+			},
+			empty: func() proto.Message { return &ethpb.AttesterSlashing{} },
+		},
+		{
+			name:  "validator_latest_vote",
+			obj:   &pb.ValidatorLatestVote{Epoch: 4, Root: fixtureBytes(32, 10)},
+			empty: func() proto.Message { return &pb.ValidatorLatestVote{} },
+		},
+		{
+			name: "state",
+			obj: &pb.BeaconState{
+				GenesisTime: 1567000000,
+				Slot:        42,
+				Fork: &pb.Fork{
+					PreviousVersion: []byte{0, 0, 0, 0},
+					CurrentVersion:  []byte{0, 0, 0, 1},
+					Epoch:           1,
+				},
+				LatestBlockHeader: fixtureHeader(41, 24),
+				BlockRoots:        [][]byte{fixtureBytes(32, 1), fixtureBytes(32, 2)},
+				Eth1Data:          fixtureEth1Data(),
+				Eth1DepositIndex:  16,
+				Validators: []*ethpb.Validator{
+					{
+						PublicKey:             fixtureBytes(48, 28),
+						WithdrawalCredentials: fixtureBytes(32, 29),
+						EffectiveBalance:      32000000000,
+						ExitEpoch:             18446744073709551615,
+						WithdrawableEpoch:     18446744073709551615,
+					},
+				},
+				Balances:    []uint64{32000000000},
+				RandaoMixes: [][]byte{fixtureBytes(32, 30)},
+				Slashings:   []uint64{0, 1},
+				CurrentEpochAttestations: []*pb.PendingAttestation{
+					{
+						AggregationBits: bitfield.Bitlist{0x0b},
+						Data:            fixtureAttestationData(),
+						InclusionDelay:  1,
+					},
+				},
+				CurrentCrosslinks:           []*ethpb.Crosslink{fixtureCrosslink()},
+				JustificationBits:           bitfield.Bitvector4{0x03},
+				PreviousJustifiedCheckpoint: &ethpb.Checkpoint{Epoch: 3, Root: fixtureBytes(32, 9)},
+				CurrentJustifiedCheckpoint:  &ethpb.Checkpoint{Epoch: 4, Root: fixtureBytes(32, 10)},
+				FinalizedCheckpoint:         &ethpb.Checkpoint{Epoch: 3, Root: fixtureBytes(32, 9)},
+			},
+			empty: func() proto.Message { return &pb.BeaconState{} },
+		},
+	}
+}
+
+// TestDBFixtures guards existing databases against incompatible changes to the stored objects,
+// such as renumbered proto fields. Each fixture in testdata holds an object as encoded by a
+// previous release, which must decode to the same object with the current code, and match the
+// current encoding byte for byte. Run the test with -update-fixtures to rewrite the fixtures
+// after an intended schema change.
+func TestDBFixtures(t *testing.T) {
+	for _, f := range dbFixtures() {
+		t.Run(f.name, func(t *testing.T) {
+			path := filepath.Join("testdata", f.name+".pb")
+			enc, err := proto.Marshal(f.obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *updateFixtures {
+				if err := ioutil.WriteFile(path, enc, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			fixture, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			decoded := f.empty()
+			if err := proto.Unmarshal(fixture, decoded); err != nil {
+				t.Fatalf("Could not decode fixture: %v", err)
+			}
+			if !proto.Equal(decoded, f.obj) {
+				t.Errorf("Wanted fixture to decode to %v, got %v", f.obj, decoded)
+			}
+			if !bytes.Equal(enc, fixture) {
+				t.Errorf("Wanted current encoding to match fixture, got %#x, wanted %#x", enc, fixture)
+			}
+		})
+	}
+}
@@ -6,8 +6,8 @@ import (
 	"time"
 
 	"github.com/boltdb/bolt"
-	"github.com/karlseguin/ccache"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 )
 
 // BlockCacheSize specifies 4 epochs worth of blocks cached.
@@ -21,8 +21,9 @@ const VotesCacheSize = 1000000
 type Store struct {
 	db           *bolt.DB
 	databasePath string
-	blockCache   *ccache.Cache
-	votesCache   *ccache.Cache
+	blockCache   *cache.Store
+	votesCache   *cache.Store
+	snapshots    *Snapshots
 }
 
 // NewKVStore initializes a new boltDB key-value store at the directory
@@ -44,8 +45,19 @@ func NewKVStore(dirPath string) (*Store, error) {
 	kv := &Store{
 		db:           boltDB,
 		databasePath: dirPath,
-		blockCache:   ccache.New(ccache.Configure().MaxSize(BlockCacheSize)),
-		votesCache:   ccache.New(ccache.Configure().MaxSize(VotesCacheSize)),
+		blockCache: cache.NewStore(cache.StoreConfig{
+			Name:    "db_block",
+			MaxSize: BlockCacheSize,
+			TTL:     time.Hour,
+			LRU:     true,
+		}),
+		votesCache: cache.NewStore(cache.StoreConfig{
+			Name:    "db_latest_vote",
+			MaxSize: VotesCacheSize,
+			TTL:     time.Hour,
+			LRU:     true,
+		}),
+		snapshots: NewSnapshots(boltDB, dirPath),
 	}
 
 	if err := kv.db.Update(func(tx *bolt.Tx) error {
@@ -82,11 +94,30 @@ func (k *Store) ClearDB() error {
 	return os.RemoveAll(k.databasePath)
 }
 
-// Close closes the underlying BoltDB database.
+// Close closes the underlying BoltDB database, once the snapshot being written, if any, is done.
 func (k *Store) Close() error {
+	k.snapshots.Wait()
 	return k.db.Close()
 }
 
+// EnableSnapshots snapshots the database once in the background, keeping the given number of
+// snapshots on disk. The store runs no destructive operation of its own, so none is held off
+// until the snapshot is written.
+func (k *Store) EnableSnapshots(retention int) {
+	k.snapshots.Enable(retention)
+	k.snapshots.Start()
+}
+
+// Snapshot writes a copy of the database to the snapshots directory and returns its path.
+func (k *Store) Snapshot(reason string) (string, error) {
+	return k.snapshots.Take(reason)
+}
+
+// Snapshots returns the paths of the snapshots on disk, oldest first.
+func (k *Store) Snapshots() ([]string, error) {
+	return k.snapshots.List()
+}
+
 // DatabasePath at which this database writes files.
 func (k *Store) DatabasePath() string {
 	return k.databasePath
@@ -69,6 +69,24 @@ func (k *Store) DeleteProposerSlashing(ctx context.Context, slashingRoot [32]byt
 	})
 }
 
+// ProposerSlashings retrieves every proposer slashing stored in the db.
+func (k *Store) ProposerSlashings(ctx context.Context) ([]*ethpb.ProposerSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ProposerSlashings")
+	defer span.End()
+	var slashings []*ethpb.ProposerSlashing
+	err := k.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(proposerSlashingsBucket).ForEach(func(_, enc []byte) error {
+			slashing := &ethpb.ProposerSlashing{}
+			if err := proto.Unmarshal(enc, slashing); err != nil {
+				return err
+			}
+			slashings = append(slashings, slashing)
+			return nil
+		})
+	})
+	return slashings, err
+}
+
 // AttesterSlashing retrieval by hash tree root.
 func (k *Store) AttesterSlashing(ctx context.Context, slashingRoot [32]byte) (*ethpb.AttesterSlashing, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.AttesterSlashing")
@@ -127,3 +145,21 @@ func (k *Store) DeleteAttesterSlashing(ctx context.Context, slashingRoot [32]byt
 		return bucket.Delete(slashingRoot[:])
 	})
 }
+
+// AttesterSlashings retrieves every attester slashing stored in the db.
+func (k *Store) AttesterSlashings(ctx context.Context) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.AttesterSlashings")
+	defer span.End()
+	var slashings []*ethpb.AttesterSlashing
+	err := k.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(attesterSlashingsBucket).ForEach(func(_, enc []byte) error {
+			slashing := &ethpb.AttesterSlashing{}
+			if err := proto.Unmarshal(enc, slashing); err != nil {
+				return err
+			}
+			slashings = append(slashings, slashing)
+			return nil
+		})
+	})
+	return slashings, err
+}
@@ -0,0 +1,202 @@
+package kv
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// snapshotDirName is the directory, inside the database directory, which holds snapshots.
+	snapshotDirName = "snapshots"
+	// DefaultSnapshotRetention is the number of snapshots kept unless configured otherwise.
+	DefaultSnapshotRetention = 3
+)
+
+var log = logrus.WithField("prefix", "beacondb")
+
+// ErrSnapshotPending is returned by destructive operations while the database snapshot which must
+// precede them is being written.
+var ErrSnapshotPending = errors.New("database snapshot is still being written")
+
+// Snapshots writes copies of a bolt database to the snapshots directory next to it, keeping the
+// latest ones. Snapshots are disabled until enabled: writing one copies the whole database in a
+// single read transaction, which holds back the growth of the database file until it is done.
+type Snapshots struct {
+	db        *bolt.DB
+	dir       string
+	lock      sync.Mutex
+	enabled   bool
+	retention int
+	running   bool
+	taken     bool
+	wg        sync.WaitGroup
+}
+
+// NewSnapshots creates the snapshots of a bolt database stored in the given directory.
+func NewSnapshots(db *bolt.DB, databasePath string) *Snapshots {
+	return &Snapshots{
+		db:        db,
+		dir:       path.Join(databasePath, snapshotDirName),
+		retention: DefaultSnapshotRetention,
+	}
+}
+
+// Enable snapshots the database once, at the first call to Start or Before, and keeps the given
+// number of snapshots on disk.
+func (s *Snapshots) Enable(retention int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.enabled = true
+	s.retention = retention
+}
+
+// Take writes a consistent copy of the database to the snapshots directory and deletes the oldest
+// snapshots beyond the configured retention. The returned path can be copied over
+// beaconchain.db, while the node is stopped, to roll back to the snapshot. Snapshots can be taken
+// on demand even when they are not enabled.
+func (s *Snapshots) Take(reason string) (string, error) {
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return "", err
+	}
+	// Snapshot names start with a fixed width UTC timestamp so they sort by age.
+	name := fmt.Sprintf("%s-%s.db", time.Now().UTC().Format("20060102T150405.000000000"), reason)
+	snapshotPath := path.Join(s.dir, name)
+	tmpPath := snapshotPath + ".tmp"
+
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", err
+	}
+	err = s.db.View(func(tx *bolt.Tx) error {
+		_, err := tx.WriteTo(f)
+		return err
+	})
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return "", errors.Wrap(err, "could not write snapshot")
+	}
+	if err := os.Rename(tmpPath, snapshotPath); err != nil {
+		return "", err
+	}
+
+	s.lock.Lock()
+	retention := s.retention
+	s.lock.Unlock()
+	if err := pruneSnapshots(s.dir, retention); err != nil {
+		return "", errors.Wrap(err, "could not delete old snapshots")
+	}
+	return snapshotPath, nil
+}
+
+// List returns the paths of the snapshots on disk, oldest first.
+func (s *Snapshots) List() ([]string, error) {
+	names, err := snapshotNames(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, len(names))
+	for i, name := range names {
+		paths[i] = path.Join(s.dir, name)
+	}
+	return paths, nil
+}
+
+// Start starts writing a snapshot in the background, if snapshots are enabled and none was taken
+// since the node started. Destructive operations don't go ahead until it is written, so it should
+// be called at startup to have the snapshot ready by the time the first of them runs.
+func (s *Snapshots) Start() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.start()
+}
+
+// start must be called with the lock held.
+func (s *Snapshots) start() {
+	if !s.enabled || s.taken || s.running {
+		return
+	}
+	s.running = true
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		snapshotPath, err := s.Take("startup")
+
+		s.lock.Lock()
+		s.running = false
+		s.taken = err == nil
+		s.lock.Unlock()
+		if err != nil {
+			// The next destructive operation tries again.
+			log.WithError(err).Error("Could not snapshot database")
+			return
+		}
+		log.WithField("path", snapshotPath).Info("Took database snapshot")
+	}()
+}
+
+// Before checks that the database was snapshot, since the node started, before the given
+// destructive operation goes ahead. If not, the snapshot is started in the background and
+// ErrSnapshotPending is returned: the operation must not go ahead, and is expected to be tried
+// again later. It always returns nil when snapshots are not enabled.
+func (s *Snapshots) Before(operation string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.enabled || s.taken {
+		return nil
+	}
+	s.start()
+	return errors.Wrapf(ErrSnapshotPending, "could not %s", operation)
+}
+
+// Wait waits for the snapshot being written, if any.
+func (s *Snapshots) Wait() {
+	s.wg.Wait()
+}
+
+func snapshotNames(dir string) ([]string, error) {
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, f := range files {
+		if !f.IsDir() && strings.HasSuffix(f.Name(), ".db") {
+			names = append(names, f.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func pruneSnapshots(dir string, retention int) error {
+	names, err := snapshotNames(dir)
+	if err != nil {
+		return err
+	}
+	if retention < 1 {
+		retention = 1
+	}
+	for len(names) > retention {
+		if err := os.Remove(path.Join(dir, names[0])); err != nil {
+			return err
+		}
+		names = names[1:]
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/boltdb/bolt"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+func TestStore_EnableSnapshots(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	if err := db.SaveBlock(context.Background(), &ethpb.BeaconBlock{Slot: 5}); err != nil {
+		t.Fatal(err)
+	}
+	if snapshots, err := db.Snapshots(); err != nil || len(snapshots) != 0 {
+		t.Fatalf("Wanted no snapshot before they are enabled, got %v (%v)", snapshots, err)
+	}
+
+	db.EnableSnapshots(DefaultSnapshotRetention)
+	db.snapshots.Wait()
+	snapshots, err := db.Snapshots()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 1 {
+		t.Fatalf("Wanted 1 snapshot, got %d", len(snapshots))
+	}
+	snapshot, err := bolt.Open(snapshots[0], 0600, nil)
+	if err != nil {
+		t.Fatalf("Could not open snapshot: %v", err)
+	}
+	defer snapshot.Close()
+	if err := snapshot.View(func(tx *bolt.Tx) error {
+		if n := tx.Bucket(blocksBucket).Stats().KeyN; n != 1 {
+			t.Errorf("Wanted 1 block in snapshot, got %d", n)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSnapshots_BeforeWaitsUntilEnabledSnapshotIsTaken(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	s := NewSnapshots(db.db, db.DatabasePath())
+	if err := s.Before("prune"); err != nil {
+		t.Fatalf("Wanted no wait with snapshots disabled, got %v", err)
+	}
+
+	s.Enable(1)
+	if err := s.Before("prune"); err == nil {
+		t.Fatal("Wanted the operation to wait for the snapshot")
+	}
+	s.Wait()
+	if err := s.Before("prune"); err != nil {
+		t.Fatalf("Wanted no wait once the snapshot is taken, got %v", err)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
-	"time"
 
 	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
@@ -18,8 +17,8 @@ func (k *Store) ValidatorLatestVote(ctx context.Context, validatorIdx uint64) (*
 	defer span.End()
 
 	// Return latest vote from cache if it exists.
-	if v := k.votesCache.Get(string(validatorIdx)); v != nil {
-		return v.Value().(*pb.ValidatorLatestVote), nil
+	if v, ok := k.votesCache.Get(string(validatorIdx)); ok {
+		return v.(*pb.ValidatorLatestVote), nil
 	}
 
 	buf := uint64ToBytes(validatorIdx)
@@ -41,7 +40,7 @@ func (k *Store) HasValidatorLatestVote(ctx context.Context, validatorIdx uint64)
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.HasValidatorLatestVote")
 	defer span.End()
 
-	if v := k.votesCache.Get(string(validatorIdx)); v != nil {
+	if _, ok := k.votesCache.Get(string(validatorIdx)); ok {
 		return true
 	}
 
@@ -67,7 +66,7 @@ func (k *Store) SaveValidatorLatestVote(ctx context.Context, validatorIdx uint64
 	}
 	return k.db.Update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(validatorsBucket)
-		k.votesCache.Set(string(validatorIdx), vote, time.Hour)
+		k.votesCache.Set(string(validatorIdx), vote)
 		return bucket.Put(buf, enc)
 	})
 }
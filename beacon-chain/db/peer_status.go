@@ -0,0 +1,350 @@
+package db
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// Each peer gets its own nested bucket within the peer status bucket, keyed by peer ID,
+// which holds the last status it reported, its batch request outcomes, the number of
+// blocks and attestations it relayed long after their slot, the distribution of the delays
+// of the messages it relayed first, and when it was last updated.
+var (
+	peerStatusKey       = []byte("status")
+	peerBatchSuccessKey = []byte("batch-success")
+	peerBatchFailureKey = []byte("batch-failure")
+	peerLateMessagesKey = []byte("late-messages")
+	peerDelaysKey       = []byte("propagation-delays")
+	peerLastSeenKey     = []byte("last-seen")
+)
+
+var (
+	// maxPeerStatuses caps the number of peers kept in the peer status bucket, the peers
+	// updated least recently are pruned first.
+	maxPeerStatuses = 1024
+	// peerStatusExpiry is how long the status of a peer we no longer hear from is kept.
+	peerStatusExpiry = 7 * 24 * time.Hour
+)
+
+// SavePeerStatus records the last chain status reported by a peer.
+func (db *BeaconDB) SavePeerStatus(ctx context.Context, pid peer.ID, status *pb.Hello) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SavePeerStatus")
+	defer span.End()
+
+	return db.SavePeerStatuses(ctx, map[peer.ID]*pb.Hello{pid: status})
+}
+
+// SavePeerStatuses records the last chain status reported by several peers in a single
+// transaction.
+func (db *BeaconDB) SavePeerStatuses(ctx context.Context, statuses map[peer.ID]*pb.Hello) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SavePeerStatuses")
+	defer span.End()
+
+	return db.savePeerStatuses(statuses, false /* onlyNewer */)
+}
+
+// SaveNewerPeerStatuses records the chain status of several peers in a single transaction, like
+// SavePeerStatuses, but keeps the status already recorded for a peer if its head slot is higher.
+// Statuses buffered before being written can't overwrite the ones saved in the meantime.
+func (db *BeaconDB) SaveNewerPeerStatuses(ctx context.Context, statuses map[peer.ID]*pb.Hello) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveNewerPeerStatuses")
+	defer span.End()
+
+	return db.savePeerStatuses(statuses, true /* onlyNewer */)
+}
+
+func (db *BeaconDB) savePeerStatuses(statuses map[peer.ID]*pb.Hello, onlyNewer bool) error {
+	encs := make(map[peer.ID][]byte, len(statuses))
+	for pid, status := range statuses {
+		enc, err := proto.Marshal(status)
+		if err != nil {
+			return errors.Wrap(err, "failed to encode peer status")
+		}
+		encs[pid] = enc
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		for pid, enc := range encs {
+			bkt, err := peerBucket(tx, pid)
+			if err != nil {
+				return err
+			}
+			if onlyNewer {
+				if saved := bkt.Get(peerStatusKey); saved != nil {
+					savedStatus := &pb.Hello{}
+					if err := proto.Unmarshal(saved, savedStatus); err != nil {
+						return errors.Wrap(err, "failed to decode peer status")
+					}
+					if savedStatus.HeadSlot > statuses[pid].HeadSlot {
+						continue
+					}
+				}
+			}
+			if err := bkt.Put(peerStatusKey, enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// peerBucket returns the bucket of a peer, creating it if needed, and marks the peer as
+// seen now.
+func peerBucket(tx *bolt.Tx, pid peer.ID) (*bolt.Bucket, error) {
+	bkt, err := tx.Bucket(peerStatusBucket).CreateBucketIfNotExists([]byte(pid))
+	if err != nil {
+		return nil, err
+	}
+	if err := bkt.Put(peerLastSeenKey, bytesutil.Bytes8(uint64(time.Now().Unix()))); err != nil {
+		return nil, err
+	}
+	return bkt, nil
+}
+
+// PrunePeerStatuses deletes the peers not updated for longer than the expiry, then the
+// peers updated least recently until at most maxPeerStatuses remain. It returns the number
+// of peers deleted.
+func (db *BeaconDB) PrunePeerStatuses(ctx context.Context) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PrunePeerStatuses")
+	defer span.End()
+
+	type peerSeen struct {
+		key      []byte
+		lastSeen uint64
+	}
+	expiry := uint64(time.Now().Add(-peerStatusExpiry).Unix())
+	pruned := 0
+	err := db.update(func(tx *bolt.Tx) error {
+		statusBkt := tx.Bucket(peerStatusBucket)
+		var stale [][]byte
+		var kept []peerSeen
+		if err := statusBkt.ForEach(func(k, v []byte) error {
+			// Peers are nested buckets, which have no value.
+			if v != nil {
+				return nil
+			}
+			var lastSeen uint64
+			if enc := statusBkt.Bucket(k).Get(peerLastSeenKey); enc != nil {
+				lastSeen = bytesutil.FromBytes8(enc)
+			}
+			key := append([]byte{}, k...)
+			if lastSeen < expiry {
+				stale = append(stale, key)
+				return nil
+			}
+			kept = append(kept, peerSeen{key: key, lastSeen: lastSeen})
+			return nil
+		}); err != nil {
+			return err
+		}
+		if len(kept) > maxPeerStatuses {
+			sort.Slice(kept, func(i, j int) bool {
+				return kept[i].lastSeen < kept[j].lastSeen
+			})
+			for _, p := range kept[:len(kept)-maxPeerStatuses] {
+				stale = append(stale, p.key)
+			}
+		}
+		for _, k := range stale {
+			if err := statusBkt.DeleteBucket(k); err != nil {
+				return err
+			}
+		}
+		pruned = len(stale)
+		return nil
+	})
+	return pruned, err
+}
+
+// PeerStatus retrieves the last chain status reported by a peer. Returns nil if the
+// peer never reported its status.
+func (db *BeaconDB) PeerStatus(ctx context.Context, pid peer.ID) (*pb.Hello, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PeerStatus")
+	defer span.End()
+
+	var status *pb.Hello
+	err := db.view(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(peerStatusBucket).Bucket([]byte(pid))
+		if bkt == nil {
+			return nil
+		}
+		enc := bkt.Get(peerStatusKey)
+		if enc == nil {
+			return nil
+		}
+		status = &pb.Hello{}
+		return proto.Unmarshal(enc, status)
+	})
+	return status, err
+}
+
+// RecordPeerBatch records the outcome of a batched block request made to a peer.
+func (db *BeaconDB) RecordPeerBatch(ctx context.Context, pid peer.ID, success bool) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.RecordPeerBatch")
+	defer span.End()
+
+	key := peerBatchFailureKey
+	if success {
+		key = peerBatchSuccessKey
+	}
+	return db.incrementPeerCounter(pid, key)
+}
+
+// RecordPeerLateMessage records that a peer was the first to relay a block or attestation
+// to us, long after the start of its slot.
+func (db *BeaconDB) RecordPeerLateMessage(ctx context.Context, pid peer.ID) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.RecordPeerLateMessage")
+	defer span.End()
+
+	return db.RecordPeerLateMessages(ctx, map[peer.ID]uint64{pid: 1})
+}
+
+// RecordPeerLateMessages adds to the number of late blocks and attestations relayed by
+// several peers in a single transaction.
+func (db *BeaconDB) RecordPeerLateMessages(ctx context.Context, counts map[peer.ID]uint64) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.RecordPeerLateMessages")
+	defer span.End()
+
+	return db.update(func(tx *bolt.Tx) error {
+		for pid, count := range counts {
+			if err := addPeerCounter(tx, pid, peerLateMessagesKey, count); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// PeerLateMessages returns the number of blocks and attestations a peer relayed to us
+// long after the start of their slot.
+func (db *BeaconDB) PeerLateMessages(ctx context.Context, pid peer.ID) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PeerLateMessages")
+	defer span.End()
+
+	var count uint64
+	err := db.view(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(peerStatusBucket).Bucket([]byte(pid))
+		if bkt == nil {
+			return nil
+		}
+		if enc := bkt.Get(peerLateMessagesKey); enc != nil {
+			count = bytesutil.FromBytes8(enc)
+		}
+		return nil
+	})
+	return count, err
+}
+
+// RecordPeerPropagationDelays adds to the number of blocks and attestations several peers
+// relayed first in each propagation delay bucket, in a single transaction. The buckets are
+// defined by the caller, and must keep the same order across calls.
+func (db *BeaconDB) RecordPeerPropagationDelays(ctx context.Context, counts map[peer.ID][]uint64) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.RecordPeerPropagationDelays")
+	defer span.End()
+
+	return db.update(func(tx *bolt.Tx) error {
+		for pid, buckets := range counts {
+			bkt, err := peerBucket(tx, pid)
+			if err != nil {
+				return err
+			}
+			totals := decodePeerDelays(bkt.Get(peerDelaysKey))
+			for len(totals) < len(buckets) {
+				totals = append(totals, 0)
+			}
+			enc := make([]byte, 0, 8*len(totals))
+			for i := range totals {
+				if i < len(buckets) {
+					totals[i] += buckets[i]
+				}
+				enc = append(enc, bytesutil.Bytes8(totals[i])...)
+			}
+			if err := bkt.Put(peerDelaysKey, enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// PeerPropagationDelays returns the number of blocks and attestations a peer relayed first
+// in each propagation delay bucket. Returns nil if the peer never relayed any.
+func (db *BeaconDB) PeerPropagationDelays(ctx context.Context, pid peer.ID) ([]uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PeerPropagationDelays")
+	defer span.End()
+
+	var counts []uint64
+	err := db.view(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(peerStatusBucket).Bucket([]byte(pid))
+		if bkt == nil {
+			return nil
+		}
+		counts = decodePeerDelays(bkt.Get(peerDelaysKey))
+		return nil
+	})
+	return counts, err
+}
+
+func decodePeerDelays(enc []byte) []uint64 {
+	var counts []uint64
+	for i := 0; i+8 <= len(enc); i += 8 {
+		counts = append(counts, bytesutil.FromBytes8(enc[i:i+8]))
+	}
+	return counts
+}
+
+func (db *BeaconDB) incrementPeerCounter(pid peer.ID, key []byte) error {
+	return db.update(func(tx *bolt.Tx) error {
+		return addPeerCounter(tx, pid, key, 1)
+	})
+}
+
+func addPeerCounter(tx *bolt.Tx, pid peer.ID, key []byte, delta uint64) error {
+	bkt, err := peerBucket(tx, pid)
+	if err != nil {
+		return err
+	}
+	var count uint64
+	if enc := bkt.Get(key); enc != nil {
+		count = bytesutil.FromBytes8(enc)
+	}
+	return bkt.Put(key, bytesutil.Bytes8(count+delta))
+}
+
+// PeerBatchSuccessRate returns the fraction of batched block requests made to a peer
+// which succeeded. A peer we never requested a batch from is given a rate of 1, so that
+// new peers are not ranked below peers which have already failed us.
+func (db *BeaconDB) PeerBatchSuccessRate(ctx context.Context, pid peer.ID) (float64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PeerBatchSuccessRate")
+	defer span.End()
+
+	var successes, failures uint64
+	err := db.view(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(peerStatusBucket).Bucket([]byte(pid))
+		if bkt == nil {
+			return nil
+		}
+		if enc := bkt.Get(peerBatchSuccessKey); enc != nil {
+			successes = bytesutil.FromBytes8(enc)
+		}
+		if enc := bkt.Get(peerBatchFailureKey); enc != nil {
+			failures = bytesutil.FromBytes8(enc)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if successes+failures == 0 {
+		return 1, nil
+	}
+	return float64(successes) / float64(successes+failures), nil
+}
@@ -0,0 +1,201 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestPeerStatus_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	pid := peer.ID("peer-a")
+
+	status, err := db.PeerStatus(ctx, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != nil {
+		t.Errorf("Expected no status for unknown peer, received %v", status)
+	}
+
+	want := &pb.Hello{
+		ForkVersion:    []byte{0, 0, 0, 0},
+		FinalizedRoot:  []byte{'a'},
+		FinalizedEpoch: 2,
+		HeadRoot:       []byte{'b'},
+		HeadSlot:       100,
+	}
+	if err := db.SavePeerStatus(ctx, pid, want); err != nil {
+		t.Fatal(err)
+	}
+	status, err = db.PeerStatus(ctx, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(status, want) {
+		t.Errorf("Wanted %v, received %v", want, status)
+	}
+}
+
+func TestPeerBatchSuccessRate_RecordsOutcomes(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	pid := peer.ID("peer-a")
+
+	rate, err := db.PeerBatchSuccessRate(ctx, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rate != 1 {
+		t.Errorf("Expected rate 1 for peer without history, received %f", rate)
+	}
+
+	for _, success := range []bool{true, true, true, false} {
+		if err := db.RecordPeerBatch(ctx, pid, success); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rate, err = db.PeerBatchSuccessRate(ctx, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rate != 0.75 {
+		t.Errorf("Wanted rate 0.75, received %f", rate)
+	}
+}
+
+func TestPeerLateMessages_RecordsCount(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	pid := peer.ID("peer-a")
+
+	for i := 0; i < 3; i++ {
+		if err := db.RecordPeerLateMessage(ctx, pid); err != nil {
+			t.Fatal(err)
+		}
+	}
+	count, err := db.PeerLateMessages(ctx, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Errorf("Wanted 3 late messages, received %d", count)
+	}
+	count, err = db.PeerLateMessages(ctx, peer.ID("peer-b"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("Wanted no late messages for unknown peer, received %d", count)
+	}
+}
+
+func TestPeerPropagationDelays_AddsBuckets(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	a, b := peer.ID("peer-a"), peer.ID("peer-b")
+
+	if err := db.RecordPeerPropagationDelays(ctx, map[peer.ID][]uint64{a: {1, 0, 2}, b: {0, 1, 0}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.RecordPeerPropagationDelays(ctx, map[peer.ID][]uint64{a: {3, 1, 0}}); err != nil {
+		t.Fatal(err)
+	}
+	for pid, want := range map[peer.ID][]uint64{a: {4, 1, 2}, b: {0, 1, 0}, peer.ID("peer-c"): nil} {
+		counts, err := db.PeerPropagationDelays(ctx, pid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(counts, want) {
+			t.Errorf("Wanted delays %v for %s, received %v", want, pid, counts)
+		}
+	}
+}
+
+func TestPrunePeerStatuses_ExpiresAndCaps(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	defer func(max int, expiry time.Duration) {
+		maxPeerStatuses = max
+		peerStatusExpiry = expiry
+	}(maxPeerStatuses, peerStatusExpiry)
+	maxPeerStatuses = 2
+
+	statuses := make(map[peer.ID]*pb.Hello)
+	for i := 0; i < 3; i++ {
+		statuses[peer.ID(fmt.Sprintf("peer-%d", i))] = &pb.Hello{HeadSlot: uint64(i)}
+	}
+	if err := db.SavePeerStatuses(ctx, statuses); err != nil {
+		t.Fatal(err)
+	}
+	pruned, err := db.PrunePeerStatuses(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pruned != 1 {
+		t.Errorf("Wanted 1 peer pruned above the cap, got %d", pruned)
+	}
+
+	// Peers not updated within the expiry are all pruned.
+	peerStatusExpiry = -time.Hour
+	pruned, err = db.PrunePeerStatuses(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pruned != 2 {
+		t.Errorf("Wanted 2 expired peers pruned, got %d", pruned)
+	}
+	for pid := range statuses {
+		status, err := db.PeerStatus(ctx, pid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status != nil {
+			t.Errorf("Wanted no status left for %s, got %v", pid, status)
+		}
+	}
+}
+
+func TestSaveNewerPeerStatuses_KeepsHigherHead(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	saved := &pb.Hello{HeadRoot: []byte{'a'}, HeadSlot: 100}
+	if err := db.SavePeerStatus(ctx, peer.ID("peer-a"), saved); err != nil {
+		t.Fatal(err)
+	}
+	buffered := map[peer.ID]*pb.Hello{
+		peer.ID("peer-a"): {HeadRoot: []byte{'b'}, HeadSlot: 90},
+		peer.ID("peer-b"): {HeadRoot: []byte{'c'}, HeadSlot: 90},
+	}
+	if err := db.SaveNewerPeerStatuses(ctx, buffered); err != nil {
+		t.Fatal(err)
+	}
+	status, err := db.PeerStatus(ctx, peer.ID("peer-a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(status, saved) {
+		t.Errorf("Wanted the higher head saved before to be kept, received %v", status)
+	}
+	status, err = db.PeerStatus(ctx, peer.ID("peer-b"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(status, buffered[peer.ID("peer-b")]) {
+		t.Errorf("Wanted %v, received %v", buffered[peer.ID("peer-b")], status)
+	}
+}
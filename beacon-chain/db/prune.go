@@ -0,0 +1,131 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// PruneForksBeforeFinalized deletes every block up to the given finalized block which is not
+// one of its ancestors, and returns the signing roots of the pruned blocks. In the same
+// transaction, the attestation targets of the pruned blocks are deleted and the main chain slot
+// index up to the finalized slot is rewritten to only reference finalized ancestors, so that no
+// reference to a pruned or reverted block is left for lookups such as IsAttCanonical to trip over.
+// Only the slots since the previously pruned finalized block are visited, so the cost of a call
+// doesn't grow with the length of the chain.
+// With snapshots enabled, nothing is pruned, and ErrSnapshotPending is returned, until the
+// database is snapshot.
+func (db *BeaconDB) PruneForksBeforeFinalized(ctx context.Context, finalizedRoot [32]byte) ([][32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneForksBeforeFinalized")
+	defer span.End()
+
+	if err := db.snapshotBefore("prune forks"); err != nil {
+		return nil, err
+	}
+
+	db.blocksLock.Lock()
+	defer db.blocksLock.Unlock()
+
+	var pruned [][32]byte
+	err := db.update(func(tx *bolt.Tx) error {
+		blockBkt := tx.Bucket(blockBucket)
+		mainChainBkt := tx.Bucket(mainChainBucket)
+		attTgtBkt := tx.Bucket(attestationTargetBucket)
+		chainInfo := tx.Bucket(chainInfoBucket)
+
+		finalizedEnc := blockBkt.Get(finalizedRoot[:])
+		if finalizedEnc == nil {
+			return fmt.Errorf("finalized block %#x not found", finalizedRoot)
+		}
+		finalizedBlk, err := createBlock(finalizedEnc)
+		if err != nil {
+			return err
+		}
+		var lastPrunedSlot uint64
+		if enc := chainInfo.Get(lastPrunedSlotKey); enc != nil {
+			lastPrunedSlot = decodeToSlotNumber(enc)
+		}
+		if finalizedBlk.Slot <= lastPrunedSlot {
+			return nil
+		}
+
+		// Walk back the finalized chain down to the last pruned slot, as far as we have it, to
+		// find the canonical block of every slot. Anything below the lowest ancestor we know of
+		// is left alone.
+		canonicalRoots := make(map[[32]byte]bool)
+		canonicalBySlot := make(map[uint64][]byte)
+		lowestSlot := finalizedBlk.Slot
+		root, enc := finalizedRoot, finalizedEnc
+		for enc != nil {
+			blk, err := createBlock(enc)
+			if err != nil {
+				return err
+			}
+			if blk.Slot <= lastPrunedSlot {
+				lowestSlot = lastPrunedSlot
+				break
+			}
+			canonicalRoots[root] = true
+			canonicalBySlot[blk.Slot] = enc
+			lowestSlot = blk.Slot
+			if blk.Slot == 0 {
+				break
+			}
+			root = bytesutil.ToBytes32(blk.ParentRoot)
+			enc = blockBkt.Get(root[:])
+		}
+
+		// Blocks are keyed both by root and by slot + root, only the latter lets us
+		// find the blocks of a slot without decoding every block.
+		var slotKeys [][]byte
+		c := blockBkt.Cursor()
+		for slot := lowestSlot + 1; slot <= finalizedBlk.Slot; slot++ {
+			prefix := encodeSlotNumber(slot)
+			for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+				if len(k) != 8+32 || canonicalRoots[bytesutil.ToBytes32(k[8:])] {
+					continue
+				}
+				slotKeys = append(slotKeys, append([]byte{}, k...))
+			}
+		}
+		for _, k := range slotKeys {
+			root := bytesutil.ToBytes32(k[8:])
+			if err := blockBkt.Delete(k); err != nil {
+				return err
+			}
+			if err := blockBkt.Delete(root[:]); err != nil {
+				return err
+			}
+			if err := attTgtBkt.Delete(root[:]); err != nil {
+				return err
+			}
+			pruned = append(pruned, root)
+		}
+
+		for slot := lowestSlot + 1; slot <= finalizedBlk.Slot; slot++ {
+			if enc := canonicalBySlot[slot]; enc != nil {
+				if err := mainChainBkt.Put(encodeSlotNumber(slot), enc); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := mainChainBkt.Delete(encodeSlotNumber(slot)); err != nil {
+				return err
+			}
+		}
+		return chainInfo.Put(lastPrunedSlotKey, encodeSlotNumber(finalizedBlk.Slot))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, root := range pruned {
+		delete(db.blocks, root)
+	}
+	blockCacheSize.Set(float64(len(db.blocks)))
+	return pruned, nil
+}
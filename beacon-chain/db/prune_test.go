@@ -0,0 +1,172 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func saveBlockWithTarget(t *testing.T, db *BeaconDB, slot uint64, parentRoot [32]byte, fork byte) (*ethpb.BeaconBlock, [32]byte) {
+	block := &ethpb.BeaconBlock{
+		Slot:       slot,
+		ParentRoot: parentRoot[:],
+		StateRoot:  bytesutil.ToBytes(uint64(fork), 32),
+	}
+	root, err := ssz.SigningRoot(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBlockDeprecated(block); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveAttestationTarget(context.Background(), &pb.AttestationTarget{
+		Slot:            slot,
+		BeaconBlockRoot: root[:],
+		ParentRoot:      parentRoot[:],
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return block, root
+}
+
+func TestPruneForksBeforeFinalized_NoDanglingReferences(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 10)
+	if err := db.InitializeState(ctx, uint64(time.Now().Unix()), deposits, &ethpb.Eth1Data{}); err != nil {
+		t.Fatalf("failed to initialize state: %v", err)
+	}
+	genesis, err := db.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := ssz.SigningRoot(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState, err := db.HeadState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The fork B1 <- B2 was the head before a reorg onto A1 <- A2 <- A3, which
+	// left its blocks in the main chain slot index.
+	fork1, forkRoot1 := saveBlockWithTarget(t, db, 1, genesisRoot, 'b')
+	fork2, forkRoot2 := saveBlockWithTarget(t, db, 2, forkRoot1, 'b')
+	_, root1 := saveBlockWithTarget(t, db, 1, genesisRoot, 'a')
+	_, root2 := saveBlockWithTarget(t, db, 2, root1, 'a')
+	block3, root3 := saveBlockWithTarget(t, db, 3, root2, 'a')
+	for _, head := range []*ethpb.BeaconBlock{fork1, fork2, block3} {
+		if err := db.UpdateChainHead(ctx, head, beaconState); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A fork block above the finalized slot must survive pruning.
+	_, forkRoot4 := saveBlockWithTarget(t, db, 4, forkRoot2, 'b')
+
+	pruned, err := db.PruneForksBeforeFinalized(ctx, root3)
+	if err != nil {
+		t.Fatalf("could not prune forks: %v", err)
+	}
+	if len(pruned) != 2 {
+		t.Errorf("Expected 2 pruned blocks, received %d", len(pruned))
+	}
+
+	for _, root := range [][32]byte{forkRoot1, forkRoot2} {
+		if db.HasBlockDeprecated(root) {
+			t.Errorf("Expected pruned block %#x to be deleted", root)
+		}
+		target, err := db.AttestationTarget(root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if target != nil {
+			t.Errorf("Expected attestation target of pruned block %#x to be deleted", root)
+		}
+	}
+	for _, root := range [][32]byte{genesisRoot, root1, root2, root3, forkRoot4} {
+		if !db.HasBlockDeprecated(root) {
+			t.Errorf("Expected block %#x to be kept", root)
+		}
+	}
+
+	// Every slot index entry below the finalized block must reference a finalized ancestor.
+	for slot, want := range map[uint64][32]byte{1: root1, 2: root2} {
+		blocks, err := db.BlocksBySlot(ctx, slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(blocks) != 1 {
+			t.Fatalf("Expected 1 block at slot %d, received %d", slot, len(blocks))
+		}
+		canonical, err := db.CanonicalBlockBySlot(ctx, slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if canonical == nil {
+			t.Fatalf("Expected canonical block at slot %d", slot)
+		}
+		root, err := ssz.SigningRoot(canonical)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != want {
+			t.Errorf("Expected canonical root %#x at slot %d, received %#x", want, slot, root)
+		}
+	}
+}
+
+func TestPruneForksBeforeFinalized_UnknownFinalizedBlock(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	if _, err := db.PruneForksBeforeFinalized(context.Background(), [32]byte{'a'}); err == nil {
+		t.Error("Expected error when pruning from an unknown finalized block")
+	}
+}
+
+func TestPruneForksBeforeFinalized_StartsFromLastPrunedSlot(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	genesisRoot := [32]byte{}
+	_, root1 := saveBlockWithTarget(t, db, 1, genesisRoot, 'a')
+	_, root2 := saveBlockWithTarget(t, db, 2, root1, 'a')
+	if _, err := db.PruneForksBeforeFinalized(ctx, root2); err != nil {
+		t.Fatalf("could not prune forks: %v", err)
+	}
+
+	// A fork block at or below the last pruned slot is not visited anymore, while a fork block
+	// at the newly finalized slot is pruned.
+	_, lateForkRoot := saveBlockWithTarget(t, db, 2, root1, 'b')
+	_, forkRoot := saveBlockWithTarget(t, db, 3, root1, 'b')
+	_, root3 := saveBlockWithTarget(t, db, 3, root2, 'a')
+	pruned, err := db.PruneForksBeforeFinalized(ctx, root3)
+	if err != nil {
+		t.Fatalf("could not prune forks: %v", err)
+	}
+	if len(pruned) != 1 || pruned[0] != forkRoot {
+		t.Errorf("Expected only %#x to be pruned, received %#x", forkRoot, pruned)
+	}
+	if !db.HasBlockDeprecated(lateForkRoot) {
+		t.Error("Expected the fork block below the last pruned slot to be left alone")
+	}
+
+	// Finalizing an older block again is a no-op.
+	pruned, err = db.PruneForksBeforeFinalized(ctx, root2)
+	if err != nil {
+		t.Fatalf("could not prune forks: %v", err)
+	}
+	if len(pruned) != 0 {
+		t.Errorf("Expected no pruned block, received %d", len(pruned))
+	}
+}
@@ -23,6 +23,8 @@ var (
 	histStateBucket         = []byte("historical-state-bucket")
 	chainInfoBucket         = []byte("chain-info")
 	validatorBucket         = []byte("validator")
+	peerStatusBucket        = []byte("peer-status-bucket")
+	chainStatsBucket        = []byte("chain-stats-bucket")
 
 	mainChainHeightKey      = []byte("chain-height")
 	canonicalHeadKey        = []byte("canonical-head")
@@ -31,6 +33,7 @@ var (
 	justifiedStateLookupKey = []byte("justified-state")
 	finalizedBlockLookupKey = []byte("finalized-block")
 	justifiedBlockLookupKey = []byte("justified-block")
+	lastPrunedSlotKey       = []byte("last-pruned-slot")
 
 	// DB internal use
 	cleanupHistoryBucket = []byte("cleanup-history-bucket")
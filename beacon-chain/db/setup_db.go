@@ -21,5 +21,9 @@ func SetupDB() (*BeaconDB, error) {
 	if err := os.RemoveAll(path); err != nil {
 		return nil, errors.Wrap(err, "failed to remove directory")
 	}
-	return NewDBDeprecated(path)
+	db, err := NewDBDeprecated(path)
+	if err != nil {
+		return nil, err
+	}
+	return db, nil
 }
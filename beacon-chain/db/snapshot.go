@@ -0,0 +1,47 @@
+package db
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+)
+
+// DefaultSnapshotRetention is the number of snapshots kept unless configured otherwise.
+const DefaultSnapshotRetention = kv.DefaultSnapshotRetention
+
+// ErrSnapshotPending is returned by destructive operations while the database snapshot which must
+// precede them is being written.
+var ErrSnapshotPending = kv.ErrSnapshotPending
+
+// EnableSnapshots holds off risky operations such as pruning until the database is snapshot once
+// since the node started, and keeps the given number of snapshots on disk. Snapshots are disabled
+// by default, as each one is a full copy of the database.
+func (db *BeaconDB) EnableSnapshots(retention int) {
+	db.snapshots.Enable(retention)
+}
+
+// Snapshot writes a consistent copy of the database to the snapshots directory and deletes
+// the oldest snapshots beyond the configured retention. The returned path can be copied over
+// beaconchain.db, while the node is stopped, to roll back to the snapshot.
+func (db *BeaconDB) Snapshot(reason string) (string, error) {
+	return db.snapshots.Take(reason)
+}
+
+// Snapshots returns the paths of the snapshots on disk, oldest first.
+func (db *BeaconDB) Snapshots() ([]string, error) {
+	return db.snapshots.List()
+}
+
+// StartSnapshot starts writing a snapshot of the database in the background, if snapshots are
+// enabled and none was taken since the node started. Destructive operations don't go ahead until
+// it is written, so it should be called at startup to have the snapshot ready by the time the
+// first of them runs.
+func (db *BeaconDB) StartSnapshot() {
+	db.snapshots.Start()
+}
+
+// snapshotBefore checks that the database was snapshot, since the node started, before the given
+// destructive operation goes ahead. If not, the snapshot is started in the background and
+// ErrSnapshotPending is returned: the operation must not go ahead, and is expected to be tried
+// again later.
+func (db *BeaconDB) snapshotBefore(operation string) error {
+	return db.snapshots.Before(operation)
+}
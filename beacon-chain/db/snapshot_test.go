@@ -0,0 +1,128 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+func TestSnapshot_KeepsLatestSnapshots(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	db.EnableSnapshots(2)
+
+	var taken []string
+	for i := 0; i < 3; i++ {
+		snapshotPath, err := db.Snapshot("test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		taken = append(taken, snapshotPath)
+	}
+
+	snapshots, err := db.Snapshots()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 2 || snapshots[0] != taken[1] || snapshots[1] != taken[2] {
+		t.Errorf("Wanted snapshots %v, got %v", taken[1:], snapshots)
+	}
+}
+
+func TestSnapshot_CanBeOpened(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	block := &ethpb.BeaconBlock{Slot: 5}
+	if err := db.SaveBlockDeprecated(block); err != nil {
+		t.Fatal(err)
+	}
+	snapshotPath, err := db.Snapshot("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot, err := bolt.Open(snapshotPath, 0600, nil)
+	if err != nil {
+		t.Fatalf("Could not open snapshot: %v", err)
+	}
+	defer snapshot.Close()
+	if err := snapshot.View(func(tx *bolt.Tx) error {
+		if n := tx.Bucket(blockBucket).Stats().KeyN; n != 1 {
+			t.Errorf("Wanted 1 block in snapshot, got %d", n)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPruneForksBeforeFinalized_WaitsForSnapshot(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	db.EnableSnapshots(DefaultSnapshotRetention)
+	ctx := context.Background()
+
+	_, finalizedRoot := saveBlockWithTarget(t, db, 1, [32]byte{}, 0)
+	if _, err := db.PruneForksBeforeFinalized(ctx, finalizedRoot); errors.Cause(err) != ErrSnapshotPending {
+		t.Fatalf("Wanted %v, got %v", ErrSnapshotPending, err)
+	}
+	db.snapshots.Wait()
+	for i := 0; i < 2; i++ {
+		if _, err := db.PruneForksBeforeFinalized(ctx, finalizedRoot); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	snapshots, err := db.Snapshots()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 1 {
+		t.Errorf("Wanted 1 snapshot, got %d", len(snapshots))
+	}
+}
+
+func TestStartSnapshot_SnapshotsOnce(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	db.EnableSnapshots(DefaultSnapshotRetention)
+
+	db.StartSnapshot()
+	db.StartSnapshot()
+	db.snapshots.Wait()
+	db.StartSnapshot()
+	db.snapshots.Wait()
+	if _, err := db.PruneStaleForks(context.Background(), 0); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshots, err := db.Snapshots()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 1 {
+		t.Errorf("Wanted 1 snapshot, got %d", len(snapshots))
+	}
+}
+
+func TestPruneForksBeforeFinalized_SnapshotsDisabled(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	_, finalizedRoot := saveBlockWithTarget(t, db, 1, [32]byte{}, 0)
+	if _, err := db.PruneForksBeforeFinalized(context.Background(), finalizedRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshots, err := db.Snapshots()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 0 {
+		t.Errorf("Wanted no snapshot, got %d", len(snapshots))
+	}
+}
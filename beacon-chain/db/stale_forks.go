@@ -0,0 +1,250 @@
+package db
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// StaleForks reports what PruneStaleForks deleted.
+type StaleForks struct {
+	// BlockRoots are the signing roots of the deleted blocks.
+	BlockRoots [][32]byte
+	// States is the number of deleted historical states.
+	States int
+	// Bytes is the size of the deleted block and state encodings.
+	Bytes int
+}
+
+// staleForksBatchSize is the number of stale blocks, with their states, deleted per transaction,
+// so that pruning a long partition doesn't hold the write lock of the database for long.
+var staleForksBatchSize = 256
+
+// staleBlock is a side fork block to delete, with the keys referencing it.
+type staleBlock struct {
+	root      [32]byte
+	key       []byte
+	size      int
+	stateKeys [][]byte
+}
+
+// PruneStaleForks deletes the side forks whose tips are below minTipSlot: every block which is
+// not an ancestor of the chain head, and has no descendant at or above minTipSlot. Their slot
+// indices, attestation targets and historical states are deleted along with them. Unlike
+// PruneForksBeforeFinalized, it doesn't depend on a new checkpoint being finalized, which lets
+// nodes reclaim the space of forks left behind by long partitions. Blocks below the oldest
+// ancestor of the head known to the DB are left alone. With snapshots enabled, nothing is pruned,
+// and ErrSnapshotPending is returned, until the database is snapshot.
+//
+// The stale blocks are looked up in a read transaction, then deleted in batches of
+// staleForksBatchSize blocks. Pruning stops early, and is picked up by the next call, if the
+// context is canceled or the head changes between batches.
+func (db *BeaconDB) PruneStaleForks(ctx context.Context, minTipSlot uint64) (*StaleForks, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneStaleForks")
+	defer span.End()
+
+	if err := db.snapshotBefore("prune stale forks"); err != nil {
+		return nil, err
+	}
+
+	var headRoot []byte
+	var stale []*staleBlock
+	if err := db.view(func(tx *bolt.Tx) error {
+		headRoot = append([]byte{}, tx.Bucket(chainInfoBucket).Get(canonicalHeadKey)...)
+		var err error
+		stale, err = staleBlocks(tx, headRoot, minTipSlot)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	pruned := &StaleForks{}
+	for len(stale) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n := staleForksBatchSize
+		if n > len(stale) {
+			n = len(stale)
+		}
+		headChanged, err := db.deleteStaleBlocks(headRoot, stale[:n], pruned)
+		if err != nil {
+			return nil, err
+		}
+		if headChanged {
+			log.Debug("Head changed while pruning stale forks, stopping until the next run")
+			break
+		}
+		stale = stale[n:]
+	}
+	return pruned, nil
+}
+
+// staleBlocks returns the side fork blocks whose tips are below minTipSlot, along with the keys
+// of their historical states.
+func staleBlocks(tx *bolt.Tx, headRoot []byte, minTipSlot uint64) ([]*staleBlock, error) {
+	if len(headRoot) == 0 {
+		return nil, nil
+	}
+	blockBkt := tx.Bucket(blockBucket)
+	histState := tx.Bucket(histStateBucket)
+
+	// Walk back the canonical chain, as far as we have it.
+	canonicalRoots := make(map[[32]byte]bool)
+	var lowestSlot uint64
+	root := bytesutil.ToBytes32(headRoot)
+	for enc := blockBkt.Get(root[:]); enc != nil; enc = blockBkt.Get(root[:]) {
+		blk, err := createBlock(enc)
+		if err != nil {
+			return nil, err
+		}
+		canonicalRoots[root] = true
+		lowestSlot = blk.Slot
+		if blk.Slot == 0 {
+			break
+		}
+		root = bytesutil.ToBytes32(blk.ParentRoot)
+	}
+
+	// Map every side fork block to its parent. Blocks are keyed both by root and by
+	// slot + root, only the latter lets us filter by slot without decoding every block.
+	type forkBlock struct {
+		staleBlock
+		slot   uint64
+		parent [32]byte
+	}
+	forkBlocks := make(map[[32]byte]*forkBlock)
+	if err := blockBkt.ForEach(func(k, v []byte) error {
+		if len(k) != 8+32 {
+			return nil
+		}
+		slot := decodeToSlotNumber(k[:8])
+		root := bytesutil.ToBytes32(k[8:])
+		if slot <= lowestSlot || canonicalRoots[root] {
+			return nil
+		}
+		blk, err := createBlock(v)
+		if err != nil {
+			return err
+		}
+		forkBlocks[root] = &forkBlock{
+			staleBlock: staleBlock{
+				root: root,
+				key:  append([]byte{}, k...),
+				size: len(v),
+			},
+			slot:   slot,
+			parent: bytesutil.ToBytes32(blk.ParentRoot),
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// The ancestors of recent side fork blocks are kept, up to the canonical chain.
+	kept := make(map[[32]byte]bool)
+	for root, blk := range forkBlocks {
+		if blk.slot < minTipSlot {
+			continue
+		}
+		for !kept[root] {
+			b, ok := forkBlocks[root]
+			if !ok {
+				break
+			}
+			kept[root] = true
+			root = b.parent
+		}
+	}
+	for root := range kept {
+		delete(forkBlocks, root)
+	}
+	if len(forkBlocks) == 0 {
+		return nil, nil
+	}
+
+	// Historical states are keyed by the slot and root of their block.
+	if err := histState.ForEach(func(k, _ []byte) error {
+		if len(k) != 8+32 {
+			return nil
+		}
+		if blk, ok := forkBlocks[bytesutil.ToBytes32(k[8:])]; ok {
+			blk.stateKeys = append(blk.stateKeys, append([]byte{}, k...))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	stale := make([]*staleBlock, 0, len(forkBlocks))
+	for _, blk := range forkBlocks {
+		stale = append(stale, &blk.staleBlock)
+	}
+	return stale, nil
+}
+
+// deleteStaleBlocks deletes a batch of stale blocks and their states in one transaction, and adds
+// them to pruned. Nothing is deleted if the head is no longer headRoot, since a stale block may
+// have become an ancestor of the new head.
+func (db *BeaconDB) deleteStaleBlocks(headRoot []byte, batch []*staleBlock, pruned *StaleForks) (bool, error) {
+	db.blocksLock.Lock()
+	defer db.blocksLock.Unlock()
+
+	headChanged := false
+	var prunedRoots, prunedStates [][32]byte
+	size := 0
+	err := db.update(func(tx *bolt.Tx) error {
+		blockBkt := tx.Bucket(blockBucket)
+		chainInfo := tx.Bucket(chainInfoBucket)
+		attTgtBkt := tx.Bucket(attestationTargetBucket)
+		histState := tx.Bucket(histStateBucket)
+
+		if !bytes.Equal(chainInfo.Get(canonicalHeadKey), headRoot) {
+			headChanged = true
+			return nil
+		}
+		for _, blk := range batch {
+			if err := blockBkt.Delete(blk.key); err != nil {
+				return err
+			}
+			if err := blockBkt.Delete(blk.root[:]); err != nil {
+				return err
+			}
+			if err := attTgtBkt.Delete(blk.root[:]); err != nil {
+				return err
+			}
+			prunedRoots = append(prunedRoots, blk.root)
+			size += 2 * blk.size
+			for _, k := range blk.stateKeys {
+				stateHash := bytesutil.ToBytes32(histState.Get(k))
+				size += len(chainInfo.Get(stateHash[:]))
+				if err := chainInfo.Delete(stateHash[:]); err != nil {
+					return err
+				}
+				if err := histState.Delete(k); err != nil {
+					return err
+				}
+				prunedStates = append(prunedStates, stateHash)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, root := range prunedRoots {
+		delete(db.blocks, root)
+	}
+	for _, stateHash := range prunedStates {
+		db.states.remove(stateHash)
+	}
+	blockCacheSize.Set(float64(len(db.blocks)))
+	pruned.BlockRoots = append(pruned.BlockRoots, prunedRoots...)
+	pruned.States += len(prunedStates)
+	pruned.Bytes += size
+	return headChanged, nil
+}
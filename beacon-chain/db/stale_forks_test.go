@@ -0,0 +1,163 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/go-ssz"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestPruneStaleForks_KeepsRecentForks(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 10)
+	if err := db.InitializeState(ctx, uint64(time.Now().Unix()), deposits, &ethpb.Eth1Data{}); err != nil {
+		t.Fatalf("failed to initialize state: %v", err)
+	}
+	genesis, err := db.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := ssz.SigningRoot(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState, err := db.HeadState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The canonical chain A1 <- A2 <- A3, a stale fork B1 <- B2 and a fork C1 <- C4 whose tip
+	// is recent.
+	_, root1 := saveBlockWithTarget(t, db, 1, genesisRoot, 'a')
+	_, root2 := saveBlockWithTarget(t, db, 2, root1, 'a')
+	block3, root3 := saveBlockWithTarget(t, db, 3, root2, 'a')
+	if err := db.UpdateChainHead(ctx, block3, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	_, staleRoot1 := saveBlockWithTarget(t, db, 1, genesisRoot, 'b')
+	_, staleRoot2 := saveBlockWithTarget(t, db, 2, staleRoot1, 'b')
+	_, recentRoot1 := saveBlockWithTarget(t, db, 1, genesisRoot, 'c')
+	_, recentRoot4 := saveBlockWithTarget(t, db, 4, recentRoot1, 'c')
+
+	staleState := *beaconState
+	staleState.Slot = 2
+	if err := db.SaveHistoricalState(ctx, &staleState, staleRoot2); err != nil {
+		t.Fatal(err)
+	}
+
+	pruned, err := db.PruneStaleForks(ctx, 3)
+	if err != nil {
+		t.Fatalf("could not prune stale forks: %v", err)
+	}
+	if len(pruned.BlockRoots) != 2 {
+		t.Errorf("Expected 2 pruned blocks, received %d", len(pruned.BlockRoots))
+	}
+	if pruned.States != 1 {
+		t.Errorf("Expected 1 pruned state, received %d", pruned.States)
+	}
+	if pruned.Bytes == 0 {
+		t.Error("Expected reclaimed bytes to be reported")
+	}
+
+	for _, root := range [][32]byte{staleRoot1, staleRoot2} {
+		if db.HasBlockDeprecated(root) {
+			t.Errorf("Expected stale block %#x to be deleted", root)
+		}
+		target, err := db.AttestationTarget(root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if target != nil {
+			t.Errorf("Expected attestation target of stale block %#x to be deleted", root)
+		}
+	}
+	for _, root := range [][32]byte{genesisRoot, root1, root2, root3, recentRoot1, recentRoot4} {
+		if !db.HasBlockDeprecated(root) {
+			t.Errorf("Expected block %#x to be kept", root)
+		}
+	}
+	if err := db.view(func(tx *bolt.Tx) error {
+		if tx.Bucket(histStateBucket).Get(encodeSlotNumberRoot(2, staleRoot2)) != nil {
+			t.Error("Expected historical state of stale block to be deleted")
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPruneStaleForks_NoHead(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	pruned, err := db.PruneStaleForks(context.Background(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pruned.BlockRoots) != 0 {
+		t.Errorf("Expected no pruned blocks, received %d", len(pruned.BlockRoots))
+	}
+}
+
+func TestPruneStaleForks_Batches(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	prev := staleForksBatchSize
+	staleForksBatchSize = 1
+	defer func() { staleForksBatchSize = prev }()
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 10)
+	if err := db.InitializeState(ctx, uint64(time.Now().Unix()), deposits, &ethpb.Eth1Data{}); err != nil {
+		t.Fatalf("failed to initialize state: %v", err)
+	}
+	genesis, err := db.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := ssz.SigningRoot(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState, err := db.HeadState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, root1 := saveBlockWithTarget(t, db, 1, genesisRoot, 'a')
+	block2, _ := saveBlockWithTarget(t, db, 2, root1, 'a')
+	if err := db.UpdateChainHead(ctx, block2, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	_, staleRoot1 := saveBlockWithTarget(t, db, 1, genesisRoot, 'b')
+	_, staleRoot2 := saveBlockWithTarget(t, db, 2, staleRoot1, 'b')
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := db.PruneStaleForks(canceled, 3); err != context.Canceled {
+		t.Errorf("Wanted %v, got %v", context.Canceled, err)
+	}
+	if !db.HasBlockDeprecated(staleRoot1) || !db.HasBlockDeprecated(staleRoot2) {
+		t.Error("Expected no block to be deleted with a canceled context")
+	}
+
+	pruned, err := db.PruneStaleForks(ctx, 3)
+	if err != nil {
+		t.Fatalf("could not prune stale forks: %v", err)
+	}
+	if len(pruned.BlockRoots) != 2 {
+		t.Errorf("Expected 2 pruned blocks, received %d", len(pruned.BlockRoots))
+	}
+	for _, root := range [][32]byte{staleRoot1, staleRoot2} {
+		if db.HasBlockDeprecated(root) {
+			t.Errorf("Expected stale block %#x to be deleted", root)
+		}
+	}
+}
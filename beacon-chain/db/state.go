@@ -16,8 +16,9 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
-	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 )
 
@@ -49,7 +50,6 @@ func (db *BeaconDB) InitializeState(ctx context.Context, genesisTime uint64, dep
 	blockEnc, _ := proto.Marshal(genesisBlock)
 	zeroBinary := encodeSlotNumberRoot(0, blockRoot)
 
-	db.serializedState = stateEnc
 	db.stateHash = stateHash
 
 	if err := db.SaveStateDeprecated(ctx, beaconState); err != nil {
@@ -116,18 +116,15 @@ func (db *BeaconDB) HeadState(ctx context.Context) (*pb.BeaconState, error) {
 	lockSpan.End()
 
 	// Return in-memory cached state, if available.
-	if db.serializedState != nil {
+	if enc, _, ok := db.states.head(); ok {
 		_, span := trace.StartSpan(ctx, "proto.Marshal")
 		defer span.End()
-		newState := &pb.BeaconState{}
 		// For each READ we unmarshal the serialized state into a new state struct and return that.
-		if err := proto.Unmarshal(db.serializedState, newState); err != nil {
-			return nil, err
-		}
-		return newState, nil
+		return createState(enc)
 	}
 
 	var beaconState *pb.BeaconState
+	var stateEnc []byte
 	err := db.view(func(tx *bolt.Tx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		enc := chainInfo.Get(stateLookupKey)
@@ -137,17 +134,21 @@ func (db *BeaconDB) HeadState(ctx context.Context) (*pb.BeaconState, error) {
 
 		var err error
 		beaconState, err = createState(enc)
-
-		if beaconState != nil && beaconState.Slot > db.highestBlockSlot {
-			db.highestBlockSlot = beaconState.Slot
-		}
-		db.serializedState = enc
-		db.stateHash = hashutil.Hash(enc)
-
+		// The encoding is only valid for the life of the transaction.
+		stateEnc = append([]byte{}, enc...)
 		return err
 	})
+	if err != nil || stateEnc == nil {
+		return beaconState, err
+	}
 
-	return beaconState, err
+	if beaconState.Slot > db.highestBlockSlot {
+		db.highestBlockSlot = beaconState.Slot
+	}
+	db.stateHash = hashutil.Hash(stateEnc)
+	db.states.setHead(db.stateHash, stateEnc)
+
+	return beaconState, nil
 }
 
 // HeadStateRoot returns the root of the current state from the db.
@@ -182,8 +183,8 @@ func (db *BeaconDB) SaveStateDeprecated(ctx context.Context, beaconState *pb.Bea
 
 	copy(db.validatorBalances, beaconState.Balances)
 	db.validatorRegistry = proto.Clone(tempState).(*pb.BeaconState).Validators
-	db.serializedState = enc
 	db.stateHash = stateHash
+	db.states.setHead(stateHash, enc)
 
 	if beaconState.LatestBlockHeader != nil {
 		blockRoot, err := ssz.SigningRoot(beaconState.LatestBlockHeader)
@@ -220,9 +221,13 @@ func (db *BeaconDB) SaveJustifiedState(beaconState *pb.BeaconState) error {
 // SaveFinalizedState saves the last finalized state in the db.
 func (db *BeaconDB) SaveFinalizedState(beaconState *pb.BeaconState) error {
 
-	// Delete historical states if we are saving a new finalized state.
+	// Delete historical states if we are saving a new finalized state. The states left behind
+	// while the database is being snapshot are deleted along with a later finalized state.
 	if err := db.deleteHistoricalStates(beaconState.Slot); err != nil {
-		return err
+		if errors.Cause(err) != ErrSnapshotPending {
+			return err
+		}
+		log.WithError(err).Warn("Skipped deleting historical states")
 	}
 	return db.update(func(tx *bolt.Tx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
@@ -240,23 +245,24 @@ func (db *BeaconDB) SaveHistoricalState(ctx context.Context, beaconState *pb.Bea
 	defer span.End()
 
 	slotRootBinary := encodeSlotNumberRoot(beaconState.Slot, blockRoot)
-	stateHash, err := hashutil.HashProto(beaconState)
+	beaconStateEnc, err := proto.Marshal(beaconState)
 	if err != nil {
 		return err
 	}
+	stateHash := hashutil.Hash(beaconStateEnc)
 
-	return db.update(func(tx *bolt.Tx) error {
+	if err := db.update(func(tx *bolt.Tx) error {
 		histState := tx.Bucket(histStateBucket)
 		chainInfo := tx.Bucket(chainInfoBucket)
 		if err := histState.Put(slotRootBinary, stateHash[:]); err != nil {
 			return err
 		}
-		beaconStateEnc, err := proto.Marshal(beaconState)
-		if err != nil {
-			return err
-		}
 		return chainInfo.Put(stateHash[:], beaconStateEnc)
-	})
+	}); err != nil {
+		return err
+	}
+	db.states.put(stateHash, beaconStateEnc)
+	return nil
 }
 
 // JustifiedState retrieves the justified state from the db.
@@ -344,17 +350,178 @@ func (db *BeaconDB) HistoricalStateFromSlot(ctx context.Context, slot uint64, bl
 			}
 		}
 
-		// If historical state exists, retrieve and decode it.
+		// If historical state exists, retrieve and decode it, preferring the cached encoding.
+		stateRoot := bytesutil.ToBytes32(histStateKey)
+		if encState, ok := db.states.get(stateRoot); ok {
+			beaconState, err = createState(encState)
+			return err
+		}
 		encState := chainInfo.Get(histStateKey)
 		if encState == nil {
 			return errors.New("no historical state saved")
 		}
 		beaconState, err = createState(encState)
-		return err
+		if err != nil {
+			return err
+		}
+		db.states.put(stateRoot, append([]byte{}, encState...))
+		return nil
 	})
 	return beaconState, err
 }
 
+// ValidatorBalanceHistory retrieves a validator's balance at the end of each epoch from
+// startEpoch to endEpoch, included, from the latest historical state of every epoch. Epochs
+// without a historical state are skipped. Ranges with more epochs than maxPoints are downsampled
+// before any state is decoded, and sampled epochs whose state doesn't include the validator yet
+// are skipped. A maxPoints of zero keeps every epoch.
+func (db *BeaconDB) ValidatorBalanceHistory(ctx context.Context, index uint64, startEpoch uint64, endEpoch uint64, maxPoints int) ([]uint64, []uint64, error) {
+	if ctx.Err() != nil {
+		return nil, nil, ctx.Err()
+	}
+	_, span := trace.StartSpan(ctx, "BeaconDB.ValidatorBalanceHistory")
+	defer span.End()
+	span.AddAttributes(
+		trace.Int64Attribute("index", int64(index)),
+		trace.Int64Attribute("startEpoch", int64(startEpoch)),
+		trace.Int64Attribute("endEpoch", int64(endEpoch)),
+		trace.Int64Attribute("maxPoints", int64(maxPoints)),
+	)
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	var epochs []uint64
+	var balances []uint64
+	err := db.view(func(tx *bolt.Tx) error {
+		chainInfo := tx.Bucket(chainInfoBucket)
+
+		// Historical states are sorted by slot, so the last state seen in an epoch is the
+		// latest one.
+		latest := make(map[uint64]*historicalState)
+		var order []uint64
+		for _, s := range historicalStates(tx.Bucket(histStateBucket)) {
+			e := s.slot / slotsPerEpoch
+			if e < startEpoch || e > endEpoch {
+				continue
+			}
+			if _, ok := latest[e]; !ok {
+				order = append(order, e)
+			}
+			latest[e] = s
+		}
+
+		for _, e := range sampleEpochs(order, maxPoints) {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			// The states of a long range would evict the states read around the head from the
+			// cache, so they are only read from it.
+			encState, ok := db.states.get(bytesutil.ToBytes32(latest[e].stateHash))
+			if !ok {
+				encState = chainInfo.Get(latest[e].stateHash)
+				if encState == nil {
+					continue
+				}
+			}
+			beaconState, err := createState(encState)
+			if err != nil {
+				return err
+			}
+			if index >= uint64(len(beaconState.Balances)) {
+				continue
+			}
+			epochs = append(epochs, e)
+			balances = append(balances, beaconState.Balances[index])
+		}
+		return nil
+	})
+	return epochs, balances, err
+}
+
+// sampleEpochs keeps at most maxPoints evenly spaced epochs, always including the first and the
+// last ones. A maxPoints of zero keeps every epoch.
+func sampleEpochs(epochs []uint64, maxPoints int) []uint64 {
+	n := len(epochs)
+	if maxPoints <= 0 || n <= maxPoints {
+		return epochs
+	}
+	if maxPoints == 1 {
+		return epochs[n-1:]
+	}
+	sampled := make([]uint64, maxPoints)
+	for i := 0; i < maxPoints; i++ {
+		sampled[i] = epochs[i*(n-1)/(maxPoints-1)]
+	}
+	return sampled
+}
+
+// HistoricalStateForEpoch retrieves the earliest canonical historical state from which the
+// committees of the given epoch can be computed, that is the first state of the main chain saved
+// from the epoch before it on. With the archive retention policy, it is the archived state closest
+// to the epoch. It returns nil if no such state is saved. The states of side forks are skipped, as
+// their committees differ from the ones of the main chain.
+func (db *BeaconDB) HistoricalStateForEpoch(ctx context.Context, epoch uint64) (*pb.BeaconState, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	_, span := trace.StartSpan(ctx, "BeaconDB.HistoricalStateForEpoch")
+	defer span.End()
+	span.AddAttributes(trace.Int64Attribute("epoch", int64(epoch)))
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	var beaconState *pb.BeaconState
+	err := db.view(func(tx *bolt.Tx) error {
+		mainChain := tx.Bucket(mainChainBucket)
+		for _, s := range historicalStates(tx.Bucket(histStateBucket)) {
+			// Committees can be computed up to the epoch after the one of the state.
+			if s.slot/slotsPerEpoch+1 < epoch {
+				continue
+			}
+			canonical, err := onMainChain(mainChain, s)
+			if err != nil {
+				return err
+			}
+			if !canonical {
+				continue
+			}
+			// Old states would evict the states read around the head from the cache, so they
+			// are only read from it.
+			encState, ok := db.states.get(bytesutil.ToBytes32(s.stateHash))
+			if !ok {
+				encState = tx.Bucket(chainInfoBucket).Get(s.stateHash)
+				if encState == nil {
+					continue
+				}
+			}
+			beaconState, err = createState(encState)
+			return err
+		}
+		return nil
+	})
+	return beaconState, err
+}
+
+// onMainChain reports whether the block of a historical state is the main chain block of its slot.
+func onMainChain(mainChain *bolt.Bucket, s *historicalState) (bool, error) {
+	// The genesis block is keyed by its slot and root, like historical states, and the other
+	// main chain blocks by their slot only.
+	if mainChain.Get(s.key) != nil {
+		return true, nil
+	}
+	enc := mainChain.Get(s.key[:8])
+	if enc == nil {
+		return false, nil
+	}
+	blk, err := createBlock(enc)
+	if err != nil {
+		return false, err
+	}
+	root, err := ssz.SigningRoot(blk)
+	if err != nil {
+		return false, errors.Wrap(err, "could not hash main chain block")
+	}
+	return bytes.Equal(root[:], s.key[8:]), nil
+}
+
 // Validators fetches the current validator registry stored in state.
 func (db *BeaconDB) Validators(ctx context.Context) ([]*ethpb.Validator, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.Validators")
@@ -483,28 +650,3 @@ func createState(enc []byte) (*pb.BeaconState, error) {
 	}
 	return protoState, nil
 }
-
-func (db *BeaconDB) deleteHistoricalStates(slot uint64) error {
-	if featureconfig.FeatureConfig().DisableHistoricalStatePruning {
-		return nil
-	}
-	return db.update(func(tx *bolt.Tx) error {
-		histState := tx.Bucket(histStateBucket)
-		chainInfo := tx.Bucket(chainInfoBucket)
-		hsCursor := histState.Cursor()
-
-		for k, v := hsCursor.First(); k != nil; k, v = hsCursor.Next() {
-			slotBinary := k[:8]
-			keySlotNumber := decodeToSlotNumber(slotBinary)
-			if keySlotNumber < slot {
-				if err := histState.Delete(k); err != nil {
-					return err
-				}
-				if err := chainInfo.Delete(v); err != nil {
-					return err
-				}
-			}
-		}
-		return nil
-	})
-}
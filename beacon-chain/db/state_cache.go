@@ -0,0 +1,129 @@
+package db
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// stateCacheSize is the number of serialized states kept in memory. States are large,
+// so only the head state and the few states recently read around it are kept.
+const stateCacheSize = 8
+
+var (
+	stateCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "beacondb_state_cache_hit",
+		Help: "The number of state requests served from the serialized state cache",
+	})
+	stateCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "beacondb_state_cache_miss",
+		Help: "The number of state requests which had to be read from disk",
+	})
+)
+
+type stateCacheEntry struct {
+	root [32]byte
+	enc  []byte
+}
+
+// stateCache keeps the serialized form of the most recently accessed states, keyed by
+// state root. The head state is never evicted, other states are evicted least recently
+// used first. Callers must copy out of bolt transactions before putting an encoding in
+// the cache, and must not modify an encoding returned by the cache.
+type stateCache struct {
+	lock     sync.Mutex
+	capacity int
+	entries  map[[32]byte]*list.Element
+	lru      *list.List
+	headRoot [32]byte
+	hasHead  bool
+}
+
+func newStateCache(capacity int) *stateCache {
+	return &stateCache{
+		capacity: capacity,
+		entries:  make(map[[32]byte]*list.Element),
+		lru:      list.New(),
+	}
+}
+
+// get returns the serialized state with the given root, if it is cached.
+func (c *stateCache) get(root [32]byte) ([]byte, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	elem, ok := c.entries[root]
+	if !ok {
+		stateCacheMiss.Inc()
+		return nil, false
+	}
+	stateCacheHit.Inc()
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*stateCacheEntry).enc, true
+}
+
+// head returns the serialized head state and its root, if it is cached.
+func (c *stateCache) head() ([]byte, [32]byte, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if !c.hasHead {
+		stateCacheMiss.Inc()
+		return nil, [32]byte{}, false
+	}
+	elem := c.entries[c.headRoot]
+	stateCacheHit.Inc()
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*stateCacheEntry).enc, c.headRoot, true
+}
+
+// put caches a serialized state under the given root.
+func (c *stateCache) put(root [32]byte, enc []byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.add(root, enc)
+}
+
+// setHead caches a serialized state under the given root and marks it as the head state.
+func (c *stateCache) setHead(root [32]byte, enc []byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.headRoot = root
+	c.hasHead = true
+	c.add(root, enc)
+}
+
+// remove drops the state with the given root from the cache, unless it is the head state.
+func (c *stateCache) remove(root [32]byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.hasHead && root == c.headRoot {
+		return
+	}
+	if elem, ok := c.entries[root]; ok {
+		c.lru.Remove(elem)
+		delete(c.entries, root)
+	}
+}
+
+func (c *stateCache) add(root [32]byte, enc []byte) {
+	if elem, ok := c.entries[root]; ok {
+		elem.Value.(*stateCacheEntry).enc = enc
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[root] = c.lru.PushFront(&stateCacheEntry{root: root, enc: enc})
+
+	for elem := c.lru.Back(); c.lru.Len() > c.capacity && elem != nil; {
+		prev := elem.Prev()
+		entry := elem.Value.(*stateCacheEntry)
+		if !c.hasHead || entry.root != c.headRoot {
+			c.lru.Remove(elem)
+			delete(c.entries, entry.root)
+		}
+		elem = prev
+	}
+}
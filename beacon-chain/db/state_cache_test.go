@@ -0,0 +1,79 @@
+package db
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestStateCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := newStateCache(2)
+	c.put([32]byte{'a'}, []byte("a"))
+	c.put([32]byte{'b'}, []byte("b"))
+
+	// Reading a makes b the least recently used state.
+	if _, ok := c.get([32]byte{'a'}); !ok {
+		t.Fatal("Expected state a to be cached")
+	}
+	c.put([32]byte{'c'}, []byte("c"))
+
+	if _, ok := c.get([32]byte{'b'}); ok {
+		t.Error("Expected state b to be evicted")
+	}
+	for _, root := range [][32]byte{{'a'}, {'c'}} {
+		if _, ok := c.get(root); !ok {
+			t.Errorf("Expected state %v to be cached", root)
+		}
+	}
+}
+
+func TestStateCache_HeadIsNeverEvicted(t *testing.T) {
+	c := newStateCache(2)
+	c.setHead([32]byte{'h'}, []byte("head"))
+	for _, root := range [][32]byte{{'a'}, {'b'}, {'c'}} {
+		c.put(root, root[:1])
+	}
+
+	enc, root, ok := c.head()
+	if !ok {
+		t.Fatal("Expected head state to be cached")
+	}
+	if root != [32]byte{'h'} || !bytes.Equal(enc, []byte("head")) {
+		t.Errorf("Wanted head %v, got %v", [32]byte{'h'}, root)
+	}
+	if len(c.entries) != 2 || c.lru.Len() != 2 {
+		t.Errorf("Wanted 2 cached states, got %d", len(c.entries))
+	}
+	if _, ok := c.get([32]byte{'c'}); !ok {
+		t.Error("Expected most recent state to be cached")
+	}
+}
+
+func TestStateCache_SetHeadReplacesHead(t *testing.T) {
+	c := newStateCache(1)
+	c.setHead([32]byte{'a'}, []byte("a"))
+	c.setHead([32]byte{'b'}, []byte("b"))
+
+	_, root, ok := c.head()
+	if !ok || root != [32]byte{'b'} {
+		t.Errorf("Wanted head %v, got %v", [32]byte{'b'}, root)
+	}
+	if _, ok := c.get([32]byte{'a'}); ok {
+		t.Error("Expected previous head state to be evicted")
+	}
+}
+
+func TestStateCache_RemoveKeepsHead(t *testing.T) {
+	c := newStateCache(4)
+	c.setHead([32]byte{'h'}, []byte("head"))
+	c.put([32]byte{'a'}, []byte("a"))
+
+	c.remove([32]byte{'a'})
+	c.remove([32]byte{'h'})
+
+	if _, ok := c.get([32]byte{'a'}); ok {
+		t.Error("Expected state a to be removed")
+	}
+	if _, _, ok := c.head(); !ok {
+		t.Error("Expected head state to not be removed")
+	}
+}
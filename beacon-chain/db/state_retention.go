@@ -0,0 +1,169 @@
+package db
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// StateRetentionPolicy decides which historical states are deleted each time a new finalized
+// state is saved.
+type StateRetentionPolicy string
+
+const (
+	// KeepAllStates never deletes historical states.
+	KeepAllStates StateRetentionPolicy = "keep-all"
+	// KeepFinalizedStates deletes the historical states older than the finalized state.
+	KeepFinalizedStates StateRetentionPolicy = "keep-finalized-only"
+	// KeepEpochsOfStates keeps the historical states of the configured number of epochs before
+	// the finalized state, and deletes older ones.
+	KeepEpochsOfStates StateRetentionPolicy = "keep-epochs"
+	// ArchiveStates keeps the first historical state of every period of the configured number of
+	// epochs, and deletes the other states older than the finalized state.
+	ArchiveStates StateRetentionPolicy = "archive"
+)
+
+// stateRetentionPolicies lists the policies in the order they are reported by dry runs.
+var stateRetentionPolicies = []StateRetentionPolicy{
+	KeepAllStates,
+	KeepFinalizedStates,
+	KeepEpochsOfStates,
+	ArchiveStates,
+}
+
+// historicalState is an entry of the historical state bucket.
+type historicalState struct {
+	key       []byte
+	slot      uint64
+	stateHash []byte
+}
+
+// ConfigureStateRetention sets the policy applied to historical states when a new finalized state
+// is saved. Epochs is the retention window of KeepEpochsOfStates and the archive period of
+// ArchiveStates. With dryRun set, no state is deleted, and the number of states and bytes every
+// policy would delete are logged instead.
+func (db *BeaconDB) ConfigureStateRetention(policy StateRetentionPolicy, epochs uint64, dryRun bool) error {
+	switch policy {
+	case KeepAllStates, KeepFinalizedStates:
+	case KeepEpochsOfStates, ArchiveStates:
+		if epochs == 0 {
+			return fmt.Errorf("state retention policy %s requires a number of epochs", policy)
+		}
+	default:
+		return fmt.Errorf("unknown state retention policy %q", policy)
+	}
+	db.stateRetention = policy
+	db.stateRetentionEpochs = epochs
+	db.stateRetentionDryRun = dryRun
+	return nil
+}
+
+// deleteHistoricalStates deletes the historical states the retention policy doesn't keep, given the
+// slot of the new finalized state. With snapshots enabled, nothing is deleted, and
+// ErrSnapshotPending is returned, until the database is snapshot.
+func (db *BeaconDB) deleteHistoricalStates(finalizedSlot uint64) error {
+	if db.stateRetentionDryRun {
+		return db.reportStateRetention(finalizedSlot)
+	}
+	if db.stateRetention == KeepAllStates {
+		return nil
+	}
+	if err := db.snapshotBefore("delete historical states"); err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		histState := tx.Bucket(histStateBucket)
+		chainInfo := tx.Bucket(chainInfoBucket)
+
+		prunable := prunableStates(historicalStates(histState), db.stateRetention, db.stateRetentionEpochs, finalizedSlot)
+		for _, s := range prunable {
+			if err := histState.Delete(s.key); err != nil {
+				return err
+			}
+			if err := chainInfo.Delete(s.stateHash); err != nil {
+				return err
+			}
+			db.states.remove(bytesutil.ToBytes32(s.stateHash))
+		}
+		return nil
+	})
+}
+
+// reportStateRetention logs how many historical states, and how many bytes, each retention policy
+// would delete given the slot of the new finalized state.
+func (db *BeaconDB) reportStateRetention(finalizedSlot uint64) error {
+	return db.view(func(tx *bolt.Tx) error {
+		chainInfo := tx.Bucket(chainInfoBucket)
+		states := historicalStates(tx.Bucket(histStateBucket))
+		for _, policy := range stateRetentionPolicies {
+			epochs := db.stateRetentionEpochs
+			if epochs == 0 {
+				epochs = 1
+			}
+			prunable := prunableStates(states, policy, epochs, finalizedSlot)
+			size := 0
+			for _, s := range prunable {
+				size += len(chainInfo.Get(s.stateHash))
+			}
+			log.WithFields(logrus.Fields{
+				"policy":        policy,
+				"epochs":        epochs,
+				"finalizedSlot": finalizedSlot,
+				"states":        len(prunable),
+				"bytes":         size,
+			}).Info("Dry run of historical state retention policy")
+		}
+		return nil
+	})
+}
+
+// historicalStates returns the entries of the historical state bucket, sorted by slot.
+func historicalStates(histState *bolt.Bucket) []*historicalState {
+	var states []*historicalState
+	c := histState.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		states = append(states, &historicalState{
+			key:       append([]byte{}, k...),
+			slot:      decodeToSlotNumber(k[:8]),
+			stateHash: append([]byte{}, v...),
+		})
+	}
+	sort.SliceStable(states, func(i, j int) bool {
+		return states[i].slot < states[j].slot
+	})
+	return states
+}
+
+// prunableStates returns the historical states, sorted by slot, which a retention policy deletes
+// given the slot of the new finalized state.
+func prunableStates(states []*historicalState, policy StateRetentionPolicy, epochs uint64, finalizedSlot uint64) []*historicalState {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	var prunable []*historicalState
+	archivedPeriods := make(map[uint64]bool)
+	for _, s := range states {
+		switch policy {
+		case KeepFinalizedStates:
+			if s.slot < finalizedSlot {
+				prunable = append(prunable, s)
+			}
+		case KeepEpochsOfStates:
+			if s.slot+epochs*slotsPerEpoch < finalizedSlot {
+				prunable = append(prunable, s)
+			}
+		case ArchiveStates:
+			period := s.slot / (epochs * slotsPerEpoch)
+			if !archivedPeriods[period] {
+				archivedPeriods[period] = true
+				continue
+			}
+			if s.slot < finalizedSlot {
+				prunable = append(prunable, s)
+			}
+		}
+	}
+	return prunable
+}
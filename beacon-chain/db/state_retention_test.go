@@ -0,0 +1,110 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/boltdb/bolt"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestPrunableStates(t *testing.T) {
+	defer params.OverrideBeaconConfig(params.BeaconConfig())
+	c := *params.BeaconConfig()
+	c.SlotsPerEpoch = 64
+	params.OverrideBeaconConfig(&c)
+
+	// A 2 epoch period holds 128 slots.
+	var states []*historicalState
+	for _, slot := range []uint64{0, 32, 64, 100, 128, 200, 256} {
+		states = append(states, &historicalState{slot: slot})
+	}
+	tests := []struct {
+		policy StateRetentionPolicy
+		pruned []uint64
+	}{
+		{policy: KeepAllStates, pruned: nil},
+		{policy: KeepFinalizedStates, pruned: []uint64{0, 32, 64, 100, 128, 200}},
+		{policy: KeepEpochsOfStates, pruned: []uint64{0, 32, 64, 100}},
+		{policy: ArchiveStates, pruned: []uint64{32, 64, 100, 200}},
+	}
+	for _, tt := range tests {
+		prunable := prunableStates(states, tt.policy, 2, 256)
+		var pruned []uint64
+		for _, s := range prunable {
+			pruned = append(pruned, s.slot)
+		}
+		if len(pruned) != len(tt.pruned) {
+			t.Errorf("%s: wanted pruned slots %v, got %v", tt.policy, tt.pruned, pruned)
+			continue
+		}
+		for i := range pruned {
+			if pruned[i] != tt.pruned[i] {
+				t.Errorf("%s: wanted pruned slots %v, got %v", tt.policy, tt.pruned, pruned)
+				break
+			}
+		}
+	}
+}
+
+func TestConfigureStateRetention_Invalid(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	if err := db.ConfigureStateRetention("keep-some", 1, false); err == nil {
+		t.Error("Wanted error for unknown policy")
+	}
+	if err := db.ConfigureStateRetention(KeepEpochsOfStates, 0, false); err == nil {
+		t.Error("Wanted error for keep-epochs without a number of epochs")
+	}
+	if err := db.ConfigureStateRetention(ArchiveStates, 0, false); err == nil {
+		t.Error("Wanted error for archive without a number of epochs")
+	}
+	if err := db.ConfigureStateRetention(KeepAllStates, 0, false); err != nil {
+		t.Errorf("Could not configure keep-all policy: %v", err)
+	}
+}
+
+func TestDeleteHistoricalStates_DryRun(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	for i, slot := range []uint64{10, 20} {
+		if err := db.SaveHistoricalState(ctx, &pb.BeaconState{Slot: slot}, [32]byte{byte(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := db.ConfigureStateRetention(KeepFinalizedStates, 0, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.deleteHistoricalStates(20); err != nil {
+		t.Fatal(err)
+	}
+	if n := countHistoricalStates(t, db); n != 2 {
+		t.Errorf("Wanted 2 historical states after a dry run, got %d", n)
+	}
+
+	if err := db.ConfigureStateRetention(KeepFinalizedStates, 0, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.deleteHistoricalStates(20); err != nil {
+		t.Fatal(err)
+	}
+	if n := countHistoricalStates(t, db); n != 1 {
+		t.Errorf("Wanted 1 historical state after pruning, got %d", n)
+	}
+}
+
+func countHistoricalStates(t *testing.T, db *BeaconDB) int {
+	n := 0
+	if err := db.view(func(tx *bolt.Tx) error {
+		n = tx.Bucket(histStateBucket).Stats().KeyN
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
@@ -3,24 +3,21 @@ package db
 import (
 	"bytes"
 	"context"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
-	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
 
-func init() {
-	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{
-		DisableHistoricalStatePruning: false,
-	})
-}
-
 func TestInitializeState_OK(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
@@ -115,22 +112,75 @@ func BenchmarkState_ReadingFromCache(b *testing.B) {
 		b.Fatalf("Could not save beacon state to cache from DB: %v", err)
 	}
 
+	enc, _, ok := db.states.head()
+	if !ok {
+		b.Fatal("cache should be prepared on state after saving to DB")
+	}
 	savedState := &pb.BeaconState{}
-	savedState.Unmarshal(db.serializedState)
+	savedState.Unmarshal(enc)
 
 	if savedState.Slot != 1 {
 		b.Fatal("cache should be prepared on state after saving to DB")
 	}
 
-	b.ReportAllocs()
-	b.ResetTimer()
+	// Save twice as many historical states as the cache holds, each under its own block root.
+	blockRoots := make([][32]byte, 2*stateCacheSize)
+	for i := range blockRoots {
+		state.Slot++
+		blockRoots[i] = [32]byte{byte(i + 1)}
+		if err := db.SaveHistoricalState(ctx, state, blockRoots[i]); err != nil {
+			b.Fatalf("Could not save historical state: %v", err)
+		}
+	}
+	firstSlot := state.Slot - uint64(len(blockRoots)) + 1
 
-	for i := 0; i < b.N; i++ {
-		_, err := db.HeadState(ctx)
-		if err != nil {
-			b.Fatalf("Could not read beacon state from cache: %v", err)
+	b.Run("HeadState", func(b *testing.B) {
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, err := db.HeadState(ctx)
+			if err != nil {
+				b.Fatalf("Could not read beacon state from cache: %v", err)
+			}
+		}
+	})
+
+	readRoundRobin := func(b *testing.B, roots int) {
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			j := i % roots
+			_, err := db.HistoricalStateFromSlot(ctx, firstSlot+uint64(j), blockRoots[j])
+			if err != nil {
+				b.Fatalf("Could not read historical state: %v", err)
+			}
 		}
 	}
+
+	// The head state takes one slot in the cache, so these roots all fit alongside it.
+	b.Run("MultiRoot_FitsInCache", func(b *testing.B) {
+		readRoundRobin(b, stateCacheSize-1)
+	})
+
+	// Reading more roots than the cache holds in round robin evicts each state before it is read again.
+	b.Run("MultiRoot_ExceedsCache", func(b *testing.B) {
+		readRoundRobin(b, len(blockRoots))
+	})
+
+	b.Run("MultiRoot_InterleavedWithHead", func(b *testing.B) {
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			j := i % (stateCacheSize - 1)
+			if _, err := db.HeadState(ctx); err != nil {
+				b.Fatalf("Could not read beacon state from cache: %v", err)
+			}
+			_, err := db.HistoricalStateFromSlot(ctx, firstSlot+uint64(j), blockRoots[j])
+			if err != nil {
+				b.Fatalf("Could not read historical state: %v", err)
+			}
+		}
+	})
 }
 
 func TestFinalizedState_NoneExists(t *testing.T) {
@@ -358,3 +408,154 @@ func TestHistoricalState_Pruning(t *testing.T) {
 
 	}
 }
+
+func TestValidatorBalanceHistory_LatestStatePerEpoch(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	states := []*pb.BeaconState{
+		{Slot: 0, Balances: []uint64{10}},
+		{Slot: slotsPerEpoch, Balances: []uint64{20, 1}},
+		{Slot: slotsPerEpoch + 1, Balances: []uint64{21, 2}},
+		{Slot: 3 * slotsPerEpoch, Balances: []uint64{40, 3}},
+		{Slot: 5 * slotsPerEpoch, Balances: []uint64{60, 4}},
+	}
+	for i, s := range states {
+		if err := db.SaveHistoricalState(ctx, s, [32]byte{byte(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	db.states = newStateCache(stateCacheSize)
+
+	epochs, balances, err := db.ValidatorBalanceHistory(ctx, 1, 0, 3, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(db.states.entries); n != 0 {
+		t.Errorf("Wanted no state cached by the balance history, got %d", n)
+	}
+	// Validator 1 isn't in the state of epoch 0, and epoch 2 has no state.
+	wantEpochs := []uint64{1, 3}
+	wantBalances := []uint64{2, 3}
+	if !reflect.DeepEqual(epochs, wantEpochs) {
+		t.Errorf("Wanted epochs %v, got %v", wantEpochs, epochs)
+	}
+	if !reflect.DeepEqual(balances, wantBalances) {
+		t.Errorf("Wanted balances %v, got %v", wantBalances, balances)
+	}
+}
+
+func TestValidatorBalanceHistory_OnlyDecodesSampledStates(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	var hashes [][32]byte
+	for e := uint64(0); e < 5; e++ {
+		s := &pb.BeaconState{Slot: e * slotsPerEpoch, Balances: []uint64{e * 10}}
+		if err := db.SaveHistoricalState(ctx, s, [32]byte{byte(e)}); err != nil {
+			t.Fatal(err)
+		}
+		enc, err := proto.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hashes = append(hashes, hashutil.Hash(enc))
+	}
+	// The states of the epochs left out of the samples can't be decoded.
+	db.states = newStateCache(stateCacheSize)
+	if err := db.update(func(tx *bolt.Tx) error {
+		for _, e := range []int{1, 3} {
+			if err := tx.Bucket(chainInfoBucket).Put(hashes[e][:], []byte("not a state")); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	epochs, balances, err := db.ValidatorBalanceHistory(ctx, 0, 0, 4, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantEpochs := []uint64{0, 2, 4}
+	wantBalances := []uint64{0, 20, 40}
+	if !reflect.DeepEqual(epochs, wantEpochs) {
+		t.Errorf("Wanted epochs %v, got %v", wantEpochs, epochs)
+	}
+	if !reflect.DeepEqual(balances, wantBalances) {
+		t.Errorf("Wanted balances %v, got %v", wantBalances, balances)
+	}
+}
+
+func TestHistoricalStateForEpoch(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	// States of the main chain archived every 4 epochs.
+	for _, e := range []uint64{0, 4, 8} {
+		blk := &ethpb.BeaconBlock{Slot: e * slotsPerEpoch, ParentRoot: []byte{'a'}}
+		root, err := ssz.SigningRoot(blk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		enc, err := proto.Marshal(blk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.update(func(tx *bolt.Tx) error {
+			return tx.Bucket(mainChainBucket).Put(encodeSlotNumber(blk.Slot), enc)
+		}); err != nil {
+			t.Fatal(err)
+		}
+		s := &pb.BeaconState{Slot: blk.Slot}
+		if err := db.SaveHistoricalState(ctx, s, root); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The state of a side fork, which comes first among the states of its slot.
+	fork := &pb.BeaconState{Slot: 2 * slotsPerEpoch}
+	if err := db.SaveHistoricalState(ctx, fork, [32]byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	db.states = newStateCache(stateCacheSize)
+
+	tests := []struct {
+		epoch     uint64
+		wantSlot  uint64
+		wantState bool
+	}{
+		{epoch: 0, wantSlot: 0, wantState: true},
+		{epoch: 1, wantSlot: 0, wantState: true},
+		{epoch: 2, wantSlot: 4 * slotsPerEpoch, wantState: true},
+		{epoch: 5, wantSlot: 4 * slotsPerEpoch, wantState: true},
+		{epoch: 9, wantSlot: 8 * slotsPerEpoch, wantState: true},
+		{epoch: 10, wantState: false},
+	}
+	for _, tt := range tests {
+		s, err := db.HistoricalStateForEpoch(ctx, tt.epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !tt.wantState {
+			if s != nil {
+				t.Errorf("Wanted no state for epoch %d, got state at slot %d", tt.epoch, s.Slot)
+			}
+			continue
+		}
+		if s == nil || s.Slot != tt.wantSlot {
+			t.Errorf("Wanted state at slot %d for epoch %d, got %v", tt.wantSlot, tt.epoch, s)
+		}
+	}
+	if n := len(db.states.entries); n != 0 {
+		t.Errorf("Wanted no state cached by the historical state lookups, got %d", n)
+	}
+}
@@ -0,0 +1,494 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+// ErrInjected is the error returned by faulty calls when their fault sets no error.
+var ErrInjected = errors.New("injected database failure")
+
+var _ = db.Database(&FaultyDB{})
+
+// Fault is the misbehavior injected into the calls of a database method.
+type Fault struct {
+	// Latency delays every call of the method, faulty or not. A call whose context is done
+	// before the delay is over returns the context error.
+	Latency time.Duration
+	// Err is returned by the faulty calls, ErrInjected if nil. Methods returning a bool return
+	// false instead, as if the item wasn't in the database.
+	Err error
+	// After is the number of calls let through before the calls start failing.
+	After int
+	// Times is the number of calls failing once they started to, 0 for every call.
+	Times int
+	// Partial is the number of items the faulty calls of the batch save methods, SaveBlocks and
+	// SaveAttestations, save before failing.
+	Partial int
+}
+
+// FaultyDB is a database for tests which delegates to another database, usually the one
+// returned by SetupDB, but injects latency and failures into the calls of chosen methods,
+// so that failure paths can be tested.
+type FaultyDB struct {
+	db     db.Database
+	lock   sync.Mutex
+	faults map[string]*Fault
+	calls  map[string]int
+	failed map[string]int
+}
+
+// NewFaultyDB wraps the database, without any fault injected yet.
+func NewFaultyDB(d db.Database) *FaultyDB {
+	return &FaultyDB{
+		db:     d,
+		faults: make(map[string]*Fault),
+		calls:  make(map[string]int),
+		failed: make(map[string]int),
+	}
+}
+
+// Inject the fault into the calls of the method with the given name, such as "SaveBlock",
+// replacing any fault previously injected into the method. It panics if the database has no
+// method of that name.
+func (fdb *FaultyDB) Inject(method string, fault *Fault) {
+	if _, ok := reflect.TypeOf((*db.Database)(nil)).Elem().MethodByName(method); !ok {
+		panic(fmt.Sprintf("database has no method %s", method))
+	}
+	fdb.lock.Lock()
+	defer fdb.lock.Unlock()
+	fdb.faults[method] = fault
+	fdb.calls[method] = 0
+	fdb.failed[method] = 0
+}
+
+// Heal removes every fault injected.
+func (fdb *FaultyDB) Heal() {
+	fdb.lock.Lock()
+	defer fdb.lock.Unlock()
+	fdb.faults = make(map[string]*Fault)
+}
+
+// Calls returns the number of calls of the method since its fault was injected, or since the
+// database was created.
+func (fdb *FaultyDB) Calls(method string) int {
+	fdb.lock.Lock()
+	defer fdb.lock.Unlock()
+	return fdb.calls[method]
+}
+
+// call applies the fault of the method to a call, returning the error the call fails with,
+// if any. For faulty batch saves, it also returns the number of items to save.
+func (fdb *FaultyDB) call(ctx context.Context, method string) (int, error) {
+	fdb.lock.Lock()
+	fault := fdb.faults[method]
+	fdb.calls[method]++
+	faulty := fault != nil && fdb.calls[method] > fault.After && (fault.Times == 0 || fdb.failed[method] < fault.Times)
+	if faulty {
+		fdb.failed[method]++
+	}
+	fdb.lock.Unlock()
+
+	if fault == nil {
+		return 0, nil
+	}
+	if fault.Latency > 0 {
+		select {
+		case <-time.After(fault.Latency):
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+	if !faulty {
+		return 0, nil
+	}
+	if fault.Err != nil {
+		return fault.Partial, fault.Err
+	}
+	return fault.Partial, ErrInjected
+}
+
+// Close closes the wrapped database.
+func (fdb *FaultyDB) Close() error {
+	if _, err := fdb.call(context.Background(), "Close"); err != nil {
+		return err
+	}
+	return fdb.db.Close()
+}
+
+// DatabasePath returns the path of the wrapped database.
+func (fdb *FaultyDB) DatabasePath() string {
+	return fdb.db.DatabasePath()
+}
+
+// ClearDB clears the wrapped database.
+func (fdb *FaultyDB) ClearDB() error {
+	if _, err := fdb.call(context.Background(), "ClearDB"); err != nil {
+		return err
+	}
+	return fdb.db.ClearDB()
+}
+
+// SaveAttestations saves the attestations, or the first ones when a partial failure is injected.
+func (fdb *FaultyDB) SaveAttestations(ctx context.Context, atts []*ethpb.Attestation) error {
+	partial, err := fdb.call(ctx, "SaveAttestations")
+	if err != nil {
+		if partial > len(atts) {
+			partial = len(atts)
+		}
+		if partial > 0 {
+			if saveErr := fdb.db.SaveAttestations(ctx, atts[:partial]); saveErr != nil {
+				return saveErr
+			}
+		}
+		return err
+	}
+	return fdb.db.SaveAttestations(ctx, atts)
+}
+
+// SaveBlocks saves the blocks, or the first ones when a partial failure is injected.
+func (fdb *FaultyDB) SaveBlocks(ctx context.Context, blocks []*ethpb.BeaconBlock) error {
+	partial, err := fdb.call(ctx, "SaveBlocks")
+	if err != nil {
+		if partial > len(blocks) {
+			partial = len(blocks)
+		}
+		if partial > 0 {
+			if saveErr := fdb.db.SaveBlocks(ctx, blocks[:partial]); saveErr != nil {
+				return saveErr
+			}
+		}
+		return err
+	}
+	return fdb.db.SaveBlocks(ctx, blocks)
+}
+
+// Attestation calls Attestation of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) Attestation(ctx context.Context, attRoot [32]byte) (*ethpb.Attestation, error) {
+	if _, err := fdb.call(ctx, "Attestation"); err != nil {
+		return nil, err
+	}
+	return fdb.db.Attestation(ctx, attRoot)
+}
+
+// Attestations calls Attestations of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) Attestations(ctx context.Context, f *filters.QueryFilter) ([]*ethpb.Attestation, error) {
+	if _, err := fdb.call(ctx, "Attestations"); err != nil {
+		return nil, err
+	}
+	return fdb.db.Attestations(ctx, f)
+}
+
+// HasAttestation calls HasAttestation of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) HasAttestation(ctx context.Context, attRoot [32]byte) bool {
+	if _, err := fdb.call(ctx, "HasAttestation"); err != nil {
+		return false
+	}
+	return fdb.db.HasAttestation(ctx, attRoot)
+}
+
+// DeleteAttestation calls DeleteAttestation of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) DeleteAttestation(ctx context.Context, attRoot [32]byte) error {
+	if _, err := fdb.call(ctx, "DeleteAttestation"); err != nil {
+		return err
+	}
+	return fdb.db.DeleteAttestation(ctx, attRoot)
+}
+
+// SaveAttestation calls SaveAttestation of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) SaveAttestation(ctx context.Context, att *ethpb.Attestation) error {
+	if _, err := fdb.call(ctx, "SaveAttestation"); err != nil {
+		return err
+	}
+	return fdb.db.SaveAttestation(ctx, att)
+}
+
+// Block calls Block of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) Block(ctx context.Context, blockRoot [32]byte) (*ethpb.BeaconBlock, error) {
+	if _, err := fdb.call(ctx, "Block"); err != nil {
+		return nil, err
+	}
+	return fdb.db.Block(ctx, blockRoot)
+}
+
+// HeadBlock calls HeadBlock of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) HeadBlock(ctx context.Context) (*ethpb.BeaconBlock, error) {
+	if _, err := fdb.call(ctx, "HeadBlock"); err != nil {
+		return nil, err
+	}
+	return fdb.db.HeadBlock(ctx)
+}
+
+// Blocks calls Blocks of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) Blocks(ctx context.Context, f *filters.QueryFilter) ([]*ethpb.BeaconBlock, error) {
+	if _, err := fdb.call(ctx, "Blocks"); err != nil {
+		return nil, err
+	}
+	return fdb.db.Blocks(ctx, f)
+}
+
+// BlockRoots calls BlockRoots of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) BlockRoots(ctx context.Context, f *filters.QueryFilter) ([][]byte, error) {
+	if _, err := fdb.call(ctx, "BlockRoots"); err != nil {
+		return nil, err
+	}
+	return fdb.db.BlockRoots(ctx, f)
+}
+
+// HasBlock calls HasBlock of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) HasBlock(ctx context.Context, blockRoot [32]byte) bool {
+	if _, err := fdb.call(ctx, "HasBlock"); err != nil {
+		return false
+	}
+	return fdb.db.HasBlock(ctx, blockRoot)
+}
+
+// DeleteBlock calls DeleteBlock of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) DeleteBlock(ctx context.Context, blockRoot [32]byte) error {
+	if _, err := fdb.call(ctx, "DeleteBlock"); err != nil {
+		return err
+	}
+	return fdb.db.DeleteBlock(ctx, blockRoot)
+}
+
+// SaveBlock calls SaveBlock of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) SaveBlock(ctx context.Context, block *ethpb.BeaconBlock) error {
+	if _, err := fdb.call(ctx, "SaveBlock"); err != nil {
+		return err
+	}
+	return fdb.db.SaveBlock(ctx, block)
+}
+
+// SaveHeadBlockRoot calls SaveHeadBlockRoot of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) SaveHeadBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	if _, err := fdb.call(ctx, "SaveHeadBlockRoot"); err != nil {
+		return err
+	}
+	return fdb.db.SaveHeadBlockRoot(ctx, blockRoot)
+}
+
+// ValidatorLatestVote calls ValidatorLatestVote of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) ValidatorLatestVote(ctx context.Context, validatorIdx uint64) (*pb.ValidatorLatestVote, error) {
+	if _, err := fdb.call(ctx, "ValidatorLatestVote"); err != nil {
+		return nil, err
+	}
+	return fdb.db.ValidatorLatestVote(ctx, validatorIdx)
+}
+
+// HasValidatorLatestVote calls HasValidatorLatestVote of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) HasValidatorLatestVote(ctx context.Context, validatorIdx uint64) bool {
+	if _, err := fdb.call(ctx, "HasValidatorLatestVote"); err != nil {
+		return false
+	}
+	return fdb.db.HasValidatorLatestVote(ctx, validatorIdx)
+}
+
+// DeleteValidatorLatestVote calls DeleteValidatorLatestVote of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) DeleteValidatorLatestVote(ctx context.Context, validatorIdx uint64) error {
+	if _, err := fdb.call(ctx, "DeleteValidatorLatestVote"); err != nil {
+		return err
+	}
+	return fdb.db.DeleteValidatorLatestVote(ctx, validatorIdx)
+}
+
+// SaveValidatorLatestVote calls SaveValidatorLatestVote of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) SaveValidatorLatestVote(ctx context.Context, validatorIdx uint64, vote *pb.ValidatorLatestVote) error {
+	if _, err := fdb.call(ctx, "SaveValidatorLatestVote"); err != nil {
+		return err
+	}
+	return fdb.db.SaveValidatorLatestVote(ctx, validatorIdx, vote)
+}
+
+// ValidatorIndex calls ValidatorIndex of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) ValidatorIndex(ctx context.Context, publicKey [48]byte) (uint64, bool, error) {
+	if _, err := fdb.call(ctx, "ValidatorIndex"); err != nil {
+		return 0, false, err
+	}
+	return fdb.db.ValidatorIndex(ctx, publicKey)
+}
+
+// HasValidatorIndex calls HasValidatorIndex of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) HasValidatorIndex(ctx context.Context, publicKey [48]byte) bool {
+	if _, err := fdb.call(ctx, "HasValidatorIndex"); err != nil {
+		return false
+	}
+	return fdb.db.HasValidatorIndex(ctx, publicKey)
+}
+
+// DeleteValidatorIndex calls DeleteValidatorIndex of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) DeleteValidatorIndex(ctx context.Context, publicKey [48]byte) error {
+	if _, err := fdb.call(ctx, "DeleteValidatorIndex"); err != nil {
+		return err
+	}
+	return fdb.db.DeleteValidatorIndex(ctx, publicKey)
+}
+
+// SaveValidatorIndex calls SaveValidatorIndex of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) SaveValidatorIndex(ctx context.Context, publicKey [48]byte, validatorIdx uint64) error {
+	if _, err := fdb.call(ctx, "SaveValidatorIndex"); err != nil {
+		return err
+	}
+	return fdb.db.SaveValidatorIndex(ctx, publicKey, validatorIdx)
+}
+
+// State calls State of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) State(ctx context.Context, blockRoot [32]byte) (*pb.BeaconState, error) {
+	if _, err := fdb.call(ctx, "State"); err != nil {
+		return nil, err
+	}
+	return fdb.db.State(ctx, blockRoot)
+}
+
+// HeadState calls HeadState of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) HeadState(ctx context.Context) (*pb.BeaconState, error) {
+	if _, err := fdb.call(ctx, "HeadState"); err != nil {
+		return nil, err
+	}
+	return fdb.db.HeadState(ctx)
+}
+
+// SaveState calls SaveState of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) SaveState(ctx context.Context, state *pb.BeaconState, blockRoot [32]byte) error {
+	if _, err := fdb.call(ctx, "SaveState"); err != nil {
+		return err
+	}
+	return fdb.db.SaveState(ctx, state, blockRoot)
+}
+
+// ProposerSlashing calls ProposerSlashing of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) ProposerSlashing(ctx context.Context, slashingRoot [32]byte) (*ethpb.ProposerSlashing, error) {
+	if _, err := fdb.call(ctx, "ProposerSlashing"); err != nil {
+		return nil, err
+	}
+	return fdb.db.ProposerSlashing(ctx, slashingRoot)
+}
+
+// AttesterSlashing calls AttesterSlashing of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) AttesterSlashing(ctx context.Context, slashingRoot [32]byte) (*ethpb.AttesterSlashing, error) {
+	if _, err := fdb.call(ctx, "AttesterSlashing"); err != nil {
+		return nil, err
+	}
+	return fdb.db.AttesterSlashing(ctx, slashingRoot)
+}
+
+// ProposerSlashings calls ProposerSlashings of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) ProposerSlashings(ctx context.Context) ([]*ethpb.ProposerSlashing, error) {
+	if _, err := fdb.call(ctx, "ProposerSlashings"); err != nil {
+		return nil, err
+	}
+	return fdb.db.ProposerSlashings(ctx)
+}
+
+// AttesterSlashings calls AttesterSlashings of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) AttesterSlashings(ctx context.Context) ([]*ethpb.AttesterSlashing, error) {
+	if _, err := fdb.call(ctx, "AttesterSlashings"); err != nil {
+		return nil, err
+	}
+	return fdb.db.AttesterSlashings(ctx)
+}
+
+// SaveProposerSlashing calls SaveProposerSlashing of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) SaveProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) error {
+	if _, err := fdb.call(ctx, "SaveProposerSlashing"); err != nil {
+		return err
+	}
+	return fdb.db.SaveProposerSlashing(ctx, slashing)
+}
+
+// SaveAttesterSlashing calls SaveAttesterSlashing of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) SaveAttesterSlashing(ctx context.Context, slashing *ethpb.AttesterSlashing) error {
+	if _, err := fdb.call(ctx, "SaveAttesterSlashing"); err != nil {
+		return err
+	}
+	return fdb.db.SaveAttesterSlashing(ctx, slashing)
+}
+
+// HasProposerSlashing calls HasProposerSlashing of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) HasProposerSlashing(ctx context.Context, slashingRoot [32]byte) bool {
+	if _, err := fdb.call(ctx, "HasProposerSlashing"); err != nil {
+		return false
+	}
+	return fdb.db.HasProposerSlashing(ctx, slashingRoot)
+}
+
+// HasAttesterSlashing calls HasAttesterSlashing of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) HasAttesterSlashing(ctx context.Context, slashingRoot [32]byte) bool {
+	if _, err := fdb.call(ctx, "HasAttesterSlashing"); err != nil {
+		return false
+	}
+	return fdb.db.HasAttesterSlashing(ctx, slashingRoot)
+}
+
+// DeleteProposerSlashing calls DeleteProposerSlashing of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) DeleteProposerSlashing(ctx context.Context, slashingRoot [32]byte) error {
+	if _, err := fdb.call(ctx, "DeleteProposerSlashing"); err != nil {
+		return err
+	}
+	return fdb.db.DeleteProposerSlashing(ctx, slashingRoot)
+}
+
+// DeleteAttesterSlashing calls DeleteAttesterSlashing of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) DeleteAttesterSlashing(ctx context.Context, slashingRoot [32]byte) error {
+	if _, err := fdb.call(ctx, "DeleteAttesterSlashing"); err != nil {
+		return err
+	}
+	return fdb.db.DeleteAttesterSlashing(ctx, slashingRoot)
+}
+
+// VoluntaryExit calls VoluntaryExit of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) VoluntaryExit(ctx context.Context, exitRoot [32]byte) (*ethpb.VoluntaryExit, error) {
+	if _, err := fdb.call(ctx, "VoluntaryExit"); err != nil {
+		return nil, err
+	}
+	return fdb.db.VoluntaryExit(ctx, exitRoot)
+}
+
+// SaveVoluntaryExit calls SaveVoluntaryExit of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) SaveVoluntaryExit(ctx context.Context, exit *ethpb.VoluntaryExit) error {
+	if _, err := fdb.call(ctx, "SaveVoluntaryExit"); err != nil {
+		return err
+	}
+	return fdb.db.SaveVoluntaryExit(ctx, exit)
+}
+
+// HasVoluntaryExit calls HasVoluntaryExit of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) HasVoluntaryExit(ctx context.Context, exitRoot [32]byte) bool {
+	if _, err := fdb.call(ctx, "HasVoluntaryExit"); err != nil {
+		return false
+	}
+	return fdb.db.HasVoluntaryExit(ctx, exitRoot)
+}
+
+// DeleteVoluntaryExit calls DeleteVoluntaryExit of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) DeleteVoluntaryExit(ctx context.Context, exitRoot [32]byte) error {
+	if _, err := fdb.call(ctx, "DeleteVoluntaryExit"); err != nil {
+		return err
+	}
+	return fdb.db.DeleteVoluntaryExit(ctx, exitRoot)
+}
+
+// DepositContractAddress calls DepositContractAddress of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) DepositContractAddress(ctx context.Context) ([]byte, error) {
+	if _, err := fdb.call(ctx, "DepositContractAddress"); err != nil {
+		return nil, err
+	}
+	return fdb.db.DepositContractAddress(ctx)
+}
+
+// SaveDepositContractAddress calls SaveDepositContractAddress of the wrapped database, unless a fault is injected.
+func (fdb *FaultyDB) SaveDepositContractAddress(ctx context.Context, addr common.Address) error {
+	if _, err := fdb.call(ctx, "SaveDepositContractAddress"); err != nil {
+		return err
+	}
+	return fdb.db.SaveDepositContractAddress(ctx, addr)
+}
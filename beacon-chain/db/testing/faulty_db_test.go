@@ -0,0 +1,86 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+func TestFaultyDB_FailsAfterCalls(t *testing.T) {
+	d := SetupDB(t)
+	defer TeardownDB(t, d)
+	fdb := NewFaultyDB(d)
+	ctx := context.Background()
+
+	wanted := errors.New("disk full")
+	fdb.Inject("SaveBlock", &Fault{Err: wanted, After: 1, Times: 1})
+	for i, want := range []error{nil, wanted, nil} {
+		if err := fdb.SaveBlock(ctx, &ethpb.BeaconBlock{Slot: uint64(i)}); err != want {
+			t.Errorf("Call %d: wanted error %v, got %v", i, want, err)
+		}
+	}
+	if fdb.Calls("SaveBlock") != 3 {
+		t.Errorf("Wanted 3 calls, got %d", fdb.Calls("SaveBlock"))
+	}
+
+	fdb.Inject("HasBlock", &Fault{})
+	root, err := ssz.SigningRoot(&ethpb.BeaconBlock{Slot: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fdb.HasBlock(ctx, root) {
+		t.Error("Expected a faulty HasBlock to return false")
+	}
+	fdb.Heal()
+	if !fdb.HasBlock(ctx, root) {
+		t.Error("Expected HasBlock to find the block once healed")
+	}
+}
+
+func TestFaultyDB_PartialSave(t *testing.T) {
+	d := SetupDB(t)
+	defer TeardownDB(t, d)
+	fdb := NewFaultyDB(d)
+	ctx := context.Background()
+
+	blocks := []*ethpb.BeaconBlock{{Slot: 1}, {Slot: 2}, {Slot: 3}}
+	fdb.Inject("SaveBlocks", &Fault{Partial: 2})
+	if err := fdb.SaveBlocks(ctx, blocks); err != ErrInjected {
+		t.Errorf("Wanted error %v, got %v", ErrInjected, err)
+	}
+	for i, want := range []bool{true, true, false} {
+		root, err := ssz.SigningRoot(blocks[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fdb.HasBlock(ctx, root) != want {
+			t.Errorf("Wanted block %d saved %v, got %v", i, want, !want)
+		}
+	}
+}
+
+func TestFaultyDB_LatencyHonorsContext(t *testing.T) {
+	d := SetupDB(t)
+	defer TeardownDB(t, d)
+	fdb := NewFaultyDB(d)
+
+	fdb.Inject("HeadBlock", &Fault{Latency: time.Minute, After: 1 << 30})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := fdb.HeadBlock(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Wanted error %v, got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestFaultyDB_InjectUnknownMethod(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected a panic for an unknown method")
+		}
+	}()
+	NewFaultyDB(nil).Inject("SaveEverything", &Fault{})
+}
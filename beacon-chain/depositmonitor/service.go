@@ -0,0 +1,264 @@
+// Package depositmonitor follows every deposit made to the eth1 deposit contract until it is
+// included in the beacon chain, to measure the inclusion latency of deposits and report those
+// stuck for longer than expected. Stuck deposits usually point at eth1 data votes failing to
+// reach a majority, or at a follow distance problem with the eth1 node.
+package depositmonitor
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "depositmonitor")
+
+// eth1BlockTime is the average time between two eth1 blocks, used to estimate how long the
+// follow distance holds deposits back.
+const eth1BlockTime = 14 * time.Second
+
+var (
+	inclusionLatency = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "deposit_inclusion_latency_seconds",
+		Help:    "The time from the eth1 block holding a deposit to the inclusion of the deposit in the beacon chain.",
+		Buckets: prometheus.ExponentialBuckets(60, 2, 12),
+	})
+	pendingDeposits = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "deposit_inclusion_pending",
+		Help: "The number of deposits seen in the eth1 deposit contract and not yet included in the beacon chain.",
+	})
+	stuckDeposits = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "deposit_inclusion_stuck",
+		Help: "The number of pending deposits made longer ago than the expected inclusion window.",
+	})
+)
+
+// HeadStateFetcher retrieves the head state of the beacon chain.
+type HeadStateFetcher interface {
+	HeadState(ctx context.Context) (*pb.BeaconState, error)
+}
+
+// BlockTimeFetcher retrieves the timestamp of an eth1 block.
+type BlockTimeFetcher interface {
+	BlockTimeByHeight(ctx context.Context, height *big.Int) (uint64, error)
+}
+
+// Config options for the deposit monitor.
+type Config struct {
+	DepositCache *depositcache.DepositCache
+	BeaconDB     HeadStateFetcher
+	// Eth1Blocks dates the deposits with the time of their eth1 block. Without it, or when the
+	// block can't be fetched, deposits are dated when the monitor first sees them.
+	Eth1Blocks BlockTimeFetcher
+	// InclusionWindow is the time after which a pending deposit is reported as stuck,
+	// ExpectedInclusionWindow() when 0.
+	InclusionWindow time.Duration
+	// PollInterval is the time between two comparisons of the deposits with the head state,
+	// a slot when 0.
+	PollInterval time.Duration
+}
+
+// Deposit is a deposit seen in the eth1 deposit contract and not yet included in the beacon
+// chain.
+type Deposit struct {
+	Index     uint64
+	PublicKey []byte
+	// Eth1Block is the number of the eth1 block holding the deposit.
+	Eth1Block uint64
+	// Seen is the time of the eth1 block holding the deposit, which latencies are measured from,
+	// or the time the monitor first saw the deposit when the block time is unknown.
+	Seen time.Time
+}
+
+// Service compares the deposits of the deposit cache with the deposit index of the head state.
+type Service struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	cfg      *Config
+	lock     sync.RWMutex
+	pending  map[uint64]*Deposit
+	next     int
+	included uint64
+	started  bool
+	now      func() time.Time
+}
+
+// ExpectedInclusionWindow returns how long a deposit may take to be included in the beacon
+// chain: the time the follow distance holds it back, and two eth1 voting periods in case it
+// just missed one.
+func ExpectedInclusionWindow() time.Duration {
+	cfg := params.BeaconConfig()
+	followDistance := time.Duration(cfg.Eth1FollowDistance) * eth1BlockTime
+	votingPeriod := time.Duration(cfg.SlotsPerEth1VotingPeriod*cfg.SecondsPerSlot) * time.Second
+	return followDistance + 2*votingPeriod
+}
+
+// NewService creates a deposit monitor for the given configuration.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	if cfg.InclusionWindow == 0 {
+		cfg.InclusionWindow = ExpectedInclusionWindow()
+	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	}
+	return &Service{
+		ctx:     ctx,
+		cancel:  cancel,
+		cfg:     cfg,
+		pending: make(map[uint64]*Deposit),
+		now:     time.Now,
+	}
+}
+
+// Start polls the deposits and the head state until the service stops.
+func (s *Service) Start() {
+	log.WithField("inclusionWindow", s.cfg.InclusionWindow).Info("Monitoring deposit inclusion")
+	go s.run()
+}
+
+// Stop the deposit monitor.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status always returns nil.
+func (s *Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.update(s.ctx); err != nil {
+				log.WithError(err).Error("Could not update deposit inclusion")
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// update tracks the deposits added to the cache since the last update, and records the
+// inclusion of the pending deposits below the deposit index of the head state. Deposits already
+// included the first time the monitor runs are not tracked.
+func (s *Service) update(ctx context.Context) error {
+	headState, err := s.cfg.BeaconDB.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	if headState == nil {
+		return nil
+	}
+	now := s.now()
+
+	// The eth1 blocks of the new deposits are fetched without holding the lock.
+	s.lock.RLock()
+	next, started := s.next, s.started
+	s.lock.RUnlock()
+	var added []*Deposit
+	blockTimes := make(map[uint64]time.Time)
+	for _, ctnr := range s.cfg.DepositCache.DepositsFrom(ctx, next) {
+		next = ctnr.Index + 1
+		index := uint64(ctnr.Index)
+		if !started && index < headState.Eth1DepositIndex {
+			continue
+		}
+		d := &Deposit{
+			Index: index,
+			Seen:  now,
+		}
+		if ctnr.Block != nil {
+			d.Eth1Block = ctnr.Block.Uint64()
+			d.Seen = s.blockTime(ctx, ctnr.Block, blockTimes, now)
+		}
+		if ctnr.Deposit != nil && ctnr.Deposit.Data != nil {
+			d.PublicKey = ctnr.Deposit.Data.PublicKey
+		}
+		added = append(added, d)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.included = headState.Eth1DepositIndex
+	s.next = next
+	s.started = true
+	for _, d := range added {
+		s.pending[d.Index] = d
+	}
+
+	stuck := 0
+	for index, d := range s.pending {
+		if index < s.included {
+			latency := now.Sub(d.Seen)
+			inclusionLatency.Observe(latency.Seconds())
+			log.WithFields(logrus.Fields{
+				"index":   index,
+				"latency": latency,
+			}).Debug("Deposit included")
+			delete(s.pending, index)
+			continue
+		}
+		if now.Sub(d.Seen) > s.cfg.InclusionWindow {
+			stuck++
+		}
+	}
+	pendingDeposits.Set(float64(len(s.pending)))
+	stuckDeposits.Set(float64(stuck))
+	return nil
+}
+
+// blockTime returns the time of the given eth1 block, cached in blockTimes, or now if it can't be
+// fetched.
+func (s *Service) blockTime(ctx context.Context, block *big.Int, blockTimes map[uint64]time.Time, now time.Time) time.Time {
+	if s.cfg.Eth1Blocks == nil {
+		return now
+	}
+	if t, ok := blockTimes[block.Uint64()]; ok {
+		return t
+	}
+	timestamp, err := s.cfg.Eth1Blocks.BlockTimeByHeight(ctx, block)
+	if err != nil {
+		log.WithError(err).WithField("block", block).Debug("Could not fetch eth1 block time, dating the deposit when it is seen")
+		return now
+	}
+	t := time.Unix(int64(timestamp), 0)
+	blockTimes[block.Uint64()] = t
+	return t
+}
+
+// StuckDeposits returns the pending deposits made longer ago than the inclusion window, in index
+// order, along with the deposit index of the head state.
+func (s *Service) StuckDeposits() ([]*Deposit, uint64) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	now := s.now()
+	var stuck []*Deposit
+	for _, d := range s.pending {
+		if now.Sub(d.Seen) > s.cfg.InclusionWindow {
+			stuck = append(stuck, d)
+		}
+	}
+	sort.Slice(stuck, func(i, j int) bool {
+		return stuck[i].Index < stuck[j].Index
+	})
+	return stuck, s.included
+}
+
+// InclusionWindow returns the time after which a pending deposit is reported as stuck.
+func (s *Service) InclusionWindow() time.Duration {
+	return s.cfg.InclusionWindow
+}
@@ -0,0 +1,117 @@
+package depositmonitor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+type fakeHeadState struct {
+	state *pb.BeaconState
+}
+
+func (f *fakeHeadState) HeadState(_ context.Context) (*pb.BeaconState, error) {
+	return f.state, nil
+}
+
+type fakeBlockTimes map[int64]uint64
+
+func (f fakeBlockTimes) BlockTimeByHeight(_ context.Context, height *big.Int) (uint64, error) {
+	t, ok := f[height.Int64()]
+	if !ok {
+		return 0, fmt.Errorf("unknown block %d", height)
+	}
+	return t, nil
+}
+
+func insertDeposits(dc *depositcache.DepositCache, from int, to int) {
+	for i := from; i < to; i++ {
+		dc.InsertDeposit(context.Background(), &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{PublicKey: []byte{byte(i)}},
+		}, big.NewInt(int64(100+i)), i, [32]byte{})
+	}
+}
+
+func TestService_StuckDeposits(t *testing.T) {
+	ctx := context.Background()
+	dc := depositcache.NewDepositCache()
+	head := &fakeHeadState{state: &pb.BeaconState{Eth1DepositIndex: 2}}
+	s := NewService(ctx, &Config{
+		DepositCache:    dc,
+		BeaconDB:        head,
+		InclusionWindow: time.Hour,
+	})
+	now := time.Unix(1000000, 0)
+	s.now = func() time.Time { return now }
+
+	// Deposits 0 and 1 were included before the monitor started.
+	insertDeposits(dc, 0, 4)
+	if err := s.update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.pending) != 2 {
+		t.Fatalf("Wanted 2 pending deposits, got %d", len(s.pending))
+	}
+
+	now = now.Add(30 * time.Minute)
+	insertDeposits(dc, 4, 6)
+	head.state = &pb.BeaconState{Eth1DepositIndex: 3}
+	if err := s.update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if stuck, _ := s.StuckDeposits(); len(stuck) != 0 {
+		t.Errorf("Wanted no stuck deposits, got %d", len(stuck))
+	}
+
+	now = now.Add(45 * time.Minute)
+	stuck, included := s.StuckDeposits()
+	if included != 3 {
+		t.Errorf("Wanted deposit index 3, got %d", included)
+	}
+	if len(stuck) != 1 {
+		t.Fatalf("Wanted 1 stuck deposit, got %d", len(stuck))
+	}
+	if stuck[0].Index != 3 || stuck[0].Eth1Block != 103 || stuck[0].PublicKey[0] != 3 {
+		t.Errorf("Wanted deposit 3 from eth1 block 103 stuck, got %v", stuck[0])
+	}
+}
+
+func TestService_DatesDepositsWithEth1BlockTime(t *testing.T) {
+	ctx := context.Background()
+	dc := depositcache.NewDepositCache()
+	insertDeposits(dc, 0, 2)
+	now := time.Unix(1000000, 0)
+	// The block of deposit 1 is unknown, it is dated when seen.
+	blocks := fakeBlockTimes{100: uint64(now.Add(-2 * time.Hour).Unix())}
+	s := NewService(ctx, &Config{
+		DepositCache:    dc,
+		BeaconDB:        &fakeHeadState{state: &pb.BeaconState{}},
+		Eth1Blocks:      blocks,
+		InclusionWindow: time.Hour,
+	})
+	s.now = func() time.Time { return now }
+
+	if err := s.update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// Deposit 0 was made before the monitor started, it is stuck right away.
+	stuck, _ := s.StuckDeposits()
+	if len(stuck) != 1 || stuck[0].Index != 0 {
+		t.Fatalf("Wanted deposit 0 stuck, got %v", stuck)
+	}
+	if !s.pending[1].Seen.Equal(now) {
+		t.Errorf("Wanted deposit 1 dated %v, got %v", now, s.pending[1].Seen)
+	}
+}
+
+func TestExpectedInclusionWindow(t *testing.T) {
+	if ExpectedInclusionWindow() <= eth1BlockTime {
+		t.Errorf("Wanted an inclusion window longer than an eth1 block, got %v", ExpectedInclusionWindow())
+	}
+}
@@ -0,0 +1,54 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+)
+
+// chainStats is a gauge, not a counter, as it is set to the persisted values and the blocks
+// processed decrease when a block fails the state transition.
+var chainStats = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "chain_stats_lifetime",
+	Help: "Cumulative statistics of the chain processed over the lifetime of the node, persisted across restarts",
+}, []string{"stat"})
+
+// loadChainStats sets the metrics of the chain statistics persisted before the node started.
+func (c *ChainService) loadChainStats(ctx context.Context) {
+	beaconDB, ok := c.beaconDB.(*db.BeaconDB)
+	if !ok {
+		return
+	}
+	for _, stat := range db.ChainStats {
+		count, err := beaconDB.ChainStat(ctx, stat)
+		if err != nil {
+			log.WithError(err).WithField("stat", stat).Error("Could not load chain statistic")
+			continue
+		}
+		chainStats.WithLabelValues(string(stat)).Set(float64(count))
+	}
+}
+
+// recordChainStat persists one more occurrence of a chain statistic and updates its metric.
+// Failing to record a statistic is logged, it never fails the processing of a block.
+func (c *ChainService) recordChainStat(ctx context.Context, stat db.ChainStat) {
+	beaconDB, ok := c.beaconDB.(*db.BeaconDB)
+	if !ok {
+		return
+	}
+	count, err := beaconDB.IncrementChainStat(ctx, stat)
+	if err != nil {
+		log.WithError(err).WithField("stat", stat).Error("Could not record chain statistic")
+		return
+	}
+	chainStats.WithLabelValues(string(stat)).Set(float64(count))
+}
+
+// setChainStats updates the metrics of the chain statistics recorded with a block.
+func setChainStats(counts map[db.ChainStat]uint64) {
+	for stat, count := range counts {
+		chainStats.WithLabelValues(string(stat)).Set(float64(count))
+	}
+}
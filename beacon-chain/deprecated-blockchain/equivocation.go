@@ -0,0 +1,50 @@
+package blockchain
+
+import (
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+)
+
+// MarkEquivocating records validators known to have equivocated, such as by a slashing included
+// in a received block. Their latest attestation targets no longer count toward the votes of any
+// block, so that a validator voting for several forks can't tip the head. The set is kept in
+// memory only, after a restart the validators slashed in the justified state remain excluded.
+func (c *ChainService) MarkEquivocating(indices ...uint64) {
+	c.equivocatingLock.Lock()
+	defer c.equivocatingLock.Unlock()
+	for _, i := range indices {
+		c.equivocating[i] = true
+	}
+}
+
+// IsEquivocating returns true if the validator is known to have equivocated.
+func (c *ChainService) IsEquivocating(index uint64) bool {
+	c.equivocatingLock.RLock()
+	defer c.equivocatingLock.RUnlock()
+	return c.equivocating[index]
+}
+
+// markSlashedInBlock marks the validators slashed by the proposer and attester slashings of a
+// block as equivocating.
+func (c *ChainService) markSlashedInBlock(b *ethpb.BeaconBlock) {
+	if b.Body == nil {
+		return
+	}
+	var indices []uint64
+	for _, slashing := range b.Body.ProposerSlashings {
+		indices = append(indices, slashing.ProposerIndex)
+	}
+	for _, slashing := range b.Body.AttesterSlashings {
+		att1, att2 := slashing.Attestation_1, slashing.Attestation_2
+		if att1 == nil || att2 == nil {
+			continue
+		}
+		indices = append(indices, sliceutil.IntersectionUint64(
+			append(att1.CustodyBit_0Indices, att1.CustodyBit_1Indices...),
+			append(att2.CustodyBit_0Indices, att2.CustodyBit_1Indices...),
+		)...)
+	}
+	if len(indices) > 0 {
+		c.MarkEquivocating(indices...)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
@@ -13,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoiceaudit"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -157,7 +159,7 @@ func (c *ChainService) ApplyForkChoiceRuleDeprecated(
 		return errors.Wrap(err, "could not retrieve justified head")
 	}
 
-	newHead, err := c.lmdGhost(ctx, justifiedHead, justifiedState, attestationTargets)
+	newHead, steps, err := c.lmdGhost(ctx, justifiedHead, justifiedState, attestationTargets)
 	if err != nil {
 		return errors.Wrap(err, "could not run fork choice")
 	}
@@ -183,6 +185,24 @@ func (c *ChainService) ApplyForkChoiceRuleDeprecated(
 		return errors.Wrap(err, "could not check if block is descendant")
 	}
 
+	if c.forkChoiceAudit != nil && currentHeadRoot != newHeadRoot {
+		justifiedRoot, err := ssz.SigningRoot(justifiedHead)
+		if err != nil {
+			return errors.Wrap(err, "could not hash justified block")
+		}
+		c.forkChoiceAudit.Record(&forkchoiceaudit.Decision{
+			Time:          time.Now(),
+			JustifiedRoot: forkchoiceaudit.Root(justifiedRoot),
+			JustifiedSlot: justifiedHead.Slot,
+			PreviousHead:  forkchoiceaudit.Root(currentHeadRoot),
+			PreviousSlot:  currentHead.Slot,
+			NewHead:       forkchoiceaudit.Root(newHeadRoot),
+			NewSlot:       newHead.Slot,
+			Reorg:         !isDescendant,
+			Steps:         steps,
+		})
+	}
+
 	newState := postState
 	if !isDescendant && !proto.Equal(currentHead, newHead) {
 		log.WithFields(logrus.Fields{
@@ -201,6 +221,7 @@ func (c *ChainService) ApplyForkChoiceRuleDeprecated(
 			delete(c.canonicalRoots, revertedSlot)
 		}
 		reorgCount.Inc()
+		c.recordChainStat(ctx, db.ReorgsStat)
 	}
 
 	if proto.Equal(currentHead, newHead) {
@@ -262,50 +283,95 @@ func (c *ChainService) ApplyForkChoiceRuleDeprecated(
 //        if len(children) == 0:
 //            return head
 //        head = max(children, key=get_vote_count)
+//
+// When the fork choice audit log is enabled, the candidates weighed at every step are returned
+// along with the head.
 func (c *ChainService) lmdGhost(
 	ctx context.Context,
 	startBlock *ethpb.BeaconBlock,
 	startState *pb.BeaconState,
 	voteTargets map[uint64]*pb.AttestationTarget,
-) (*ethpb.BeaconBlock, error) {
+) (*ethpb.BeaconBlock, []*forkchoiceaudit.Step, error) {
 	highestSlot := c.beaconDB.(*db.BeaconDB).HighestBlockSlot()
 	head := startBlock
+	var steps []*forkchoiceaudit.Step
 	for {
 		children, err := c.BlockChildren(ctx, head, highestSlot)
 		if err != nil {
-			return nil, errors.Wrap(err, "could not fetch block children")
+			return nil, nil, errors.Wrap(err, "could not fetch block children")
 		}
 		if len(children) == 0 {
-			return head, nil
+			return head, steps, nil
 		}
 		maxChild := children[0]
 
 		maxChildVotes, err := VoteCount(maxChild, startState, voteTargets, c.beaconDB.(*db.BeaconDB))
 		if err != nil {
-			return nil, errors.Wrap(err, "unable to determine vote count for block")
+			return nil, nil, errors.Wrap(err, "unable to determine vote count for block")
 		}
+		votes := []int{maxChildVotes}
 		for i := 1; i < len(children); i++ {
 			candidateChildVotes, err := VoteCount(children[i], startState, voteTargets, c.beaconDB.(*db.BeaconDB))
 			if err != nil {
-				return nil, errors.Wrap(err, "unable to determine vote count for block")
+				return nil, nil, errors.Wrap(err, "unable to determine vote count for block")
 			}
+			votes = append(votes, candidateChildVotes)
 			maxChildRoot, err := ssz.SigningRoot(maxChild)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			candidateChildRoot, err := ssz.SigningRoot(children[i])
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			if candidateChildVotes > maxChildVotes ||
 				(candidateChildVotes == maxChildVotes && bytesutil.LowerThan(maxChildRoot[:], candidateChildRoot[:])) {
 				maxChild = children[i]
 			}
 		}
+		if c.forkChoiceAudit != nil {
+			step, err := forkChoiceStep(head, children, votes, maxChild)
+			if err != nil {
+				return nil, nil, err
+			}
+			steps = append(steps, step)
+		}
 		head = maxChild
 	}
 }
 
+// forkChoiceStep records the candidates weighed to choose the heaviest child of a block.
+func forkChoiceStep(parent *ethpb.BeaconBlock, children []*ethpb.BeaconBlock, votes []int, chosen *ethpb.BeaconBlock) (*forkchoiceaudit.Step, error) {
+	parentRoot, err := ssz.SigningRoot(parent)
+	if err != nil {
+		return nil, err
+	}
+	chosenRoot, err := ssz.SigningRoot(chosen)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]*forkchoiceaudit.Candidate, len(children))
+	for i, child := range children {
+		root, err := ssz.SigningRoot(child)
+		if err != nil {
+			return nil, err
+		}
+		candidates[i] = &forkchoiceaudit.Candidate{
+			Root:   forkchoiceaudit.Root(root),
+			Slot:   child.Slot,
+			Weight: uint64(votes[i]),
+		}
+	}
+	return forkchoiceaudit.NewStep(parentRoot, candidates, chosenRoot), nil
+}
+
+// ForkChoiceAudit returns up to count of the latest head changes with the candidates the fork
+// choice rule weighed to make them, oldest first, all of the retained ones if count is 0. It
+// returns nil when the audit log is disabled.
+func (c *ChainService) ForkChoiceAudit(count int) []*forkchoiceaudit.Decision {
+	return c.forkChoiceAudit.Latest(count)
+}
+
 // BlockChildren returns the child blocks of the given block up to a given
 // highest slot.
 //
@@ -366,7 +432,8 @@ func (c *ChainService) isDescendant(currentHead *ethpb.BeaconBlock, newHead *eth
 
 // AttestationTargets retrieves the list of attestation targets since last finalized epoch,
 // each attestation target consists of validator index and its attestation target (i.e. the block
-// which the validator attested to)
+// which the validator attested to). The targets of slashed and equivocating validators are left
+// out, since their latest attestations can't be trusted to be their only ones.
 func (c *ChainService) AttestationTargets(state *pb.BeaconState) (map[uint64]*pb.AttestationTarget, error) {
 	indices, err := helpers.ActiveValidatorIndices(state, helpers.CurrentEpoch(state))
 	if err != nil {
@@ -375,6 +442,9 @@ func (c *ChainService) AttestationTargets(state *pb.BeaconState) (map[uint64]*pb
 
 	attestationTargets := make(map[uint64]*pb.AttestationTarget)
 	for i, index := range indices {
+		if state.Validators[index].Slashed || c.IsEquivocating(index) {
+			continue
+		}
 		target, err := c.attsService.LatestAttestationTarget(state, index)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not retrieve attestation target")
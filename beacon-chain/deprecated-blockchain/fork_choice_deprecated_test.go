@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	db2 "github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoiceaudit"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -279,6 +280,29 @@ func TestAttestationTargets_RetrieveWorks(t *testing.T) {
 	if attestationTargets[0].Slot != block.Slot {
 		t.Errorf("Wanted attested slot %d, got %d", block.Slot, attestationTargets[0].Slot)
 	}
+
+	// The targets of equivocating and slashed validators are ignored.
+	chainService.markSlashedInBlock(&ethpb.BeaconBlock{
+		Body: &ethpb.BeaconBlockBody{
+			ProposerSlashings: []*ethpb.ProposerSlashing{{ProposerIndex: 0}},
+		},
+	})
+	attestationTargets, err = chainService.AttestationTargets(beaconState)
+	if err != nil {
+		t.Fatalf("Could not get attestation targets: %v", err)
+	}
+	if len(attestationTargets) != 0 {
+		t.Errorf("Wanted the target of an equivocating validator ignored, got %v", attestationTargets)
+	}
+	chainService.equivocating = make(map[uint64]bool)
+	beaconState.Validators[0].Slashed = true
+	attestationTargets, err = chainService.AttestationTargets(beaconState)
+	if err != nil {
+		t.Fatalf("Could not get attestation targets: %v", err)
+	}
+	if len(attestationTargets) != 0 {
+		t.Errorf("Wanted the target of a slashed validator ignored, got %v", attestationTargets)
+	}
 }
 
 func TestBlockChildren_2InARow(t *testing.T) {
@@ -550,7 +574,7 @@ func TestLMDGhost_TrivialHeadUpdate(t *testing.T) {
 	}
 
 	// LMDGhost should pick block 2.
-	head, err := chainService.lmdGhost(ctx, block1, beaconState, voteTargets)
+	head, _, err := chainService.lmdGhost(ctx, block1, beaconState, voteTargets)
 	if err != nil {
 		t.Fatalf("Could not run LMD GHOST: %v", err)
 	}
@@ -668,7 +692,7 @@ func TestLMDGhost_3WayChainSplitsSameHeight(t *testing.T) {
 		ParentRoot:      block4.ParentRoot,
 	}
 	// LMDGhost should pick block 4.
-	head, err := chainService.lmdGhost(ctx, block1, beaconState, voteTargets)
+	head, _, err := chainService.lmdGhost(ctx, block1, beaconState, voteTargets)
 	if err != nil {
 		t.Fatalf("Could not run LMD GHOST: %v", err)
 	}
@@ -898,7 +922,7 @@ func TestLMDGhost_2WayChainSplitsDiffHeight(t *testing.T) {
 		ParentRoot:      block5.ParentRoot,
 	}
 	// LMDGhost should pick block 5.
-	head, err := chainService.lmdGhost(ctx, block1, beaconState, voteTargets)
+	head, _, err := chainService.lmdGhost(ctx, block1, beaconState, voteTargets)
 	if err != nil {
 		t.Fatalf("Could not run LMD GHOST: %v", err)
 	}
@@ -979,7 +1003,7 @@ func BenchmarkLMDGhost_8Slots_8Validators(b *testing.B) {
 	}
 
 	for i := 0; i < b.N; i++ {
-		_, err := chainService.lmdGhost(ctx, genesis, beaconState, voteTargets)
+		_, _, err := chainService.lmdGhost(ctx, genesis, beaconState, voteTargets)
 		if err != nil {
 			b.Fatalf("Could not run LMD GHOST: %v", err)
 		}
@@ -1060,7 +1084,7 @@ func BenchmarkLMDGhost_32Slots_8Validators(b *testing.B) {
 	}
 
 	for i := 0; i < b.N; i++ {
-		_, err := chainService.lmdGhost(ctx, genesis, beaconState, voteTargets)
+		_, _, err := chainService.lmdGhost(ctx, genesis, beaconState, voteTargets)
 		if err != nil {
 			b.Fatalf("Could not run LMD GHOST: %v", err)
 		}
@@ -1139,7 +1163,7 @@ func BenchmarkLMDGhost_32Slots_64Validators(b *testing.B) {
 	}
 
 	for i := 0; i < b.N; i++ {
-		_, err := chainService.lmdGhost(ctx, genesis, beaconState, voteTargets)
+		_, _, err := chainService.lmdGhost(ctx, genesis, beaconState, voteTargets)
 		if err != nil {
 			b.Fatalf("Could not run LMD GHOST: %v", err)
 		}
@@ -1218,7 +1242,7 @@ func BenchmarkLMDGhost_64Slots_16384Validators(b *testing.B) {
 	}
 
 	for i := 0; i < b.N; i++ {
-		_, err := chainService.lmdGhost(ctx, genesis, beaconState, voteTargets)
+		_, _, err := chainService.lmdGhost(ctx, genesis, beaconState, voteTargets)
 		if err != nil {
 			b.Fatalf("Could not run LMD GHOST: %v", err)
 		}
@@ -1716,3 +1740,26 @@ func TestVoteCount_CacheEnabledAndHit(t *testing.T) {
 		t.Errorf("Expected total balances 2e9, received %d", count)
 	}
 }
+
+func TestForkChoiceStep_RecordsVotes(t *testing.T) {
+	parent := &ethpb.BeaconBlock{Slot: 1}
+	children := []*ethpb.BeaconBlock{
+		{Slot: 2, ParentRoot: []byte{'a'}},
+		{Slot: 3, ParentRoot: []byte{'b'}},
+	}
+	chosenRoot, err := ssz.SigningRoot(children[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	step, err := forkChoiceStep(parent, children, []int{2, 5}, children[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if step.Chosen != forkchoiceaudit.Root(chosenRoot) {
+		t.Errorf("Wanted chosen root %#x, got %s", chosenRoot, step.Chosen)
+	}
+	if len(step.Candidates) != 2 || step.Candidates[1].Weight != 5 || step.Candidates[1].Slot != 3 || step.TieBreak {
+		t.Errorf("Unexpected step %+v", step)
+	}
+}
@@ -28,6 +28,8 @@ func (m *mockAttestationHandler) BatchUpdateLatestAttestations(ctx context.Conte
 	return nil
 }
 
+func (m *mockAttestationHandler) PruneLatestAttestations(blockRoots [][32]byte) {}
+
 func TestApplyForkChoice_ChainSplitReorg(t *testing.T) {
 	// TODO(#2307): Fix test once v0.6 is merged.
 	t.Skip()
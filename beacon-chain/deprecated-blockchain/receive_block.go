@@ -37,6 +37,9 @@ type BlockProcessor interface {
 	CleanupBlockOperations(ctx context.Context, block *ethpb.BeaconBlock) error
 }
 
+// ErrParentDoesNotExist is returned when a received block's parent has not been processed.
+var ErrParentDoesNotExist = errors.New("parent does not exist in DB")
+
 // BlockFailedProcessingErr represents a block failing a state transition function.
 type BlockFailedProcessingErr struct {
 	err error
@@ -46,6 +49,11 @@ func (b *BlockFailedProcessingErr) Error() string {
 	return fmt.Sprintf("block failed processing: %v", b.err)
 }
 
+// Cause returns the underlying state transition error.
+func (b *BlockFailedProcessingErr) Cause() error {
+	return b.err
+}
+
 // ReceiveBlockDeprecated is a function that defines the operations that are preformed on
 // any block that is received from p2p layer or rpc. It performs the following actions: It checks the block to see
 // 1. Verify a block passes pre-processing conditions
@@ -59,20 +67,20 @@ func (c *ChainService) ReceiveBlockDeprecated(ctx context.Context, block *ethpb.
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.ReceiveBlock")
 	defer span.End()
 	// TODO(3219): Fix with new fork choice service.
-	db, isLegacyDB := c.beaconDB.(*db.BeaconDB)
+	beaconDB, isLegacyDB := c.beaconDB.(*db.BeaconDB)
 	if !isLegacyDB {
 		panic("Deprecated receive block only works with deprecated database impl.")
 	}
 
 	parentRoot := bytesutil.ToBytes32(block.ParentRoot)
-	parent, err := db.BlockDeprecated(parentRoot)
+	parent, err := beaconDB.BlockDeprecated(parentRoot)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get parent block")
 	}
 	if parent == nil {
-		return nil, errors.New("parent does not exist in DB")
+		return nil, ErrParentDoesNotExist
 	}
-	beaconState, err := db.HistoricalStateFromSlot(ctx, parent.Slot, parentRoot)
+	beaconState, err := beaconDB.HistoricalStateFromSlot(ctx, parent.Slot, parentRoot)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not retrieve beacon state")
 	}
@@ -86,6 +94,14 @@ func (c *ChainService) ReceiveBlockDeprecated(ctx context.Context, block *ethpb.
 		return beaconState, errors.Wrapf(err, "block with slot %d is not ready for processing", block.Slot)
 	}
 
+	// Blocks too far ahead of their parent are not saved nor broadcast until the skip slots are
+	// processed, a chunk per attempt at the block.
+	advancedState, err := c.skipSlots.Advance(ctx, beaconState, block)
+	if err != nil {
+		return beaconState, errors.Wrapf(err, "block with slot %d is not ready for processing", block.Slot)
+	}
+	beaconState = advancedState
+
 	// We save the block to the DB and broadcast it to our peers.
 	if err := c.SaveAndBroadcastBlock(ctx, block); err != nil {
 		return beaconState, fmt.Errorf(
@@ -102,10 +118,12 @@ func (c *ChainService) ReceiveBlockDeprecated(ctx context.Context, block *ethpb.
 		switch err.(type) {
 		case *BlockFailedProcessingErr:
 			// If the block fails processing, we mark it as blacklisted and delete it from our DB.
-			db.MarkEvilBlockHash(blockRoot)
-			if err := db.DeleteBlockDeprecated(block); err != nil {
-				return nil, errors.Wrap(err, "could not delete bad block from db")
+			beaconDB.MarkEvilBlockHash(blockRoot)
+			counts, deleteErr := beaconDB.DeleteInvalidBlock(ctx, block)
+			if deleteErr != nil {
+				return nil, errors.Wrap(deleteErr, "could not delete bad block from db")
 			}
+			setChainStats(counts)
 			return beaconState, err
 		default:
 			return beaconState, errors.Wrap(err, "could not apply block state transition")
@@ -116,6 +134,7 @@ func (c *ChainService) ReceiveBlockDeprecated(ctx context.Context, block *ethpb.
 		"slot":  block.Slot,
 		"epoch": helpers.SlotToEpoch(block.Slot),
 	}).Info("State transition complete")
+	c.markSlashedInBlock(block)
 
 	// We process the block's contained deposits, attestations, and other operations
 	// and that may need to be stored or deleted from the beacon node's persistent storage.
@@ -163,11 +182,19 @@ func (c *ChainService) SaveAndBroadcastBlock(ctx context.Context, block *ethpb.B
 	if err != nil {
 		return errors.Wrap(err, "could not tree hash incoming block")
 	}
-	if err := c.beaconDB.SaveBlock(ctx, block); err != nil {
-		return errors.Wrap(err, "failed to save block")
-	}
 	// TODO(3219): Update after new fork choice service.
 	db, isLegacyDB := c.beaconDB.(*db.BeaconDB)
+	if isLegacyDB {
+		// The block is counted as processed with its save, and moved to the invalid blocks if
+		// it fails the state transition.
+		counts, err := db.SaveProcessedBlock(ctx, block)
+		if err != nil {
+			return errors.Wrap(err, "failed to save block")
+		}
+		setChainStats(counts)
+	} else if err := c.beaconDB.SaveBlock(ctx, block); err != nil {
+		return errors.Wrap(err, "failed to save block")
+	}
 	if isLegacyDB {
 		if err := db.SaveAttestationTarget(ctx, &pb.AttestationTarget{
 			Slot:            block.Slot,
@@ -215,6 +242,13 @@ func (c *ChainService) AdvanceStateDeprecated(
 	block *ethpb.BeaconBlock,
 ) (*pb.BeaconState, error) {
 	finalizedEpoch := beaconState.FinalizedCheckpoint.Epoch
+	preStateEpoch := helpers.SlotToEpoch(beaconState.Slot)
+	// Not a processing failure, the block is processed once the skip slots are.
+	advancedState, err := c.skipSlots.Advance(ctx, beaconState, block)
+	if err != nil {
+		return beaconState, err
+	}
+	beaconState = advancedState
 	newState, err := state.ExecuteStateTransition(
 		ctx,
 		beaconState,
@@ -227,6 +261,9 @@ func (c *ChainService) AdvanceStateDeprecated(
 	if newState.FinalizedCheckpoint.Epoch > finalizedEpoch {
 		helpers.ClearAllCaches()
 		c.beaconDB.(*db.BeaconDB).ClearBlockCache()
+		if err := c.pruneFinalizedForks(ctx, newState.FinalizedCheckpoint); err != nil {
+			log.WithError(err).Warn("Could not prune forks before finalized checkpoint")
+		}
 	}
 
 	log.WithField(
@@ -263,9 +300,57 @@ func (c *ChainService) AdvanceStateDeprecated(
 		}
 		logEpochData(newState)
 	}
+	// The block may be past the start of the epoch, after skip slots.
+	if preStateEpoch < helpers.CurrentEpoch(newState) {
+		c.balanceMonitor.Observe(newState)
+	}
 	return newState, nil
 }
 
+// pruneFinalizedForks deletes the blocks which can no longer become canonical once the
+// given checkpoint is finalized, along with every reference to them: their attestation
+// targets and slot indices in the DB, the latest votes for them in the attestation store
+// and their entries in the canonical roots mapping.
+func (c *ChainService) pruneFinalizedForks(ctx context.Context, finalized *ethpb.Checkpoint) error {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.pruneFinalizedForks")
+	defer span.End()
+
+	prunedRoots, err := c.beaconDB.(*db.BeaconDB).PruneForksBeforeFinalized(ctx, bytesutil.ToBytes32(finalized.Root))
+	if errors.Cause(err) == db.ErrSnapshotPending {
+		// The forks are pruned along with a later finalized checkpoint.
+		log.WithError(err).Warn("Skipped pruning forks before finalized checkpoint")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not prune blocks")
+	}
+	c.forgetPrunedBlocks(prunedRoots)
+
+	log.WithFields(logrus.Fields{
+		"finalizedEpoch": finalized.Epoch,
+		"prunedBlocks":   len(prunedRoots),
+	}).Debug("Pruned forks before finalized checkpoint")
+	return nil
+}
+
+// forgetPrunedBlocks removes the references to pruned blocks kept outside of the DB: the latest
+// votes for them in the attestation store and their entries in the canonical roots mapping.
+func (c *ChainService) forgetPrunedBlocks(prunedRoots [][32]byte) {
+	c.attsService.PruneLatestAttestations(prunedRoots)
+
+	pruned := make(map[[32]byte]bool, len(prunedRoots))
+	for _, root := range prunedRoots {
+		pruned[root] = true
+	}
+	c.canonicalRootsLock.Lock()
+	defer c.canonicalRootsLock.Unlock()
+	for slot, root := range c.canonicalRoots {
+		if pruned[bytesutil.ToBytes32(root)] {
+			delete(c.canonicalRoots, slot)
+		}
+	}
+}
+
 // saveValidatorIdx saves the validators public key to index mapping in DB, these
 // validators were activated from current epoch. After it saves, current epoch key
 // is deleted from ActivatedValidators mapping.
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
@@ -1083,3 +1084,42 @@ func TestSaveValidatorIdx_IdxNotInState(t *testing.T) {
 		t.Error("Did not get wanted validator from activation queue")
 	}
 }
+
+func TestAdvanceStateDeprecated_TooManySkipSlots(t *testing.T) {
+	helpers.ClearAllCaches()
+	c := params.BeaconConfig()
+	prevMax := c.MaxSkipSlotsPerBlock
+	c.MaxSkipSlotsPerBlock = 3
+	params.OverrideBeaconConfig(c)
+	defer func() {
+		c.MaxSkipSlotsPerBlock = prevMax
+		params.OverrideBeaconConfig(c)
+	}()
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 16)
+	beaconState, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainService := &ChainService{}
+	block := &ethpb.BeaconBlock{Slot: 8, ParentRoot: []byte{'A'}}
+
+	// Every attempt processes a chunk of the skip slots, and isn't a processing failure.
+	for i := 0; i < 2; i++ {
+		_, err := chainService.AdvanceStateDeprecated(context.Background(), beaconState, block)
+		if errors.Cause(err) != state.ErrTooManySkipSlots {
+			t.Fatalf("Wanted %v, got %v", state.ErrTooManySkipSlots, err)
+		}
+		if _, ok := err.(*BlockFailedProcessingErr); ok {
+			t.Error("Wanted the skip slots not to fail the processing of the block")
+		}
+	}
+	if beaconState.Slot != 0 {
+		t.Errorf("Wanted the parent state to be left untouched, got slot %d", beaconState.Slot)
+	}
+	// The skip slots are now within the limit, the invalid block is processed and fails.
+	_, err = chainService.AdvanceStateDeprecated(context.Background(), beaconState, block)
+	if _, ok := err.(*BlockFailedProcessingErr); !ok {
+		t.Errorf("Wanted the block to be processed and fail, got %v", err)
+	}
+}
@@ -14,14 +14,18 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/balances"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoiceaudit"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -56,6 +60,13 @@ type ChainService struct {
 	receiveBlockLock     sync.Mutex
 	maxRoutines          int64
 	headSlot             uint64
+	staleForkDepth       uint64
+	staleForkInterval    time.Duration
+	balanceMonitor       *balances.Monitor
+	forkChoiceAudit      *forkchoiceaudit.Log
+	skipSlots            state.SkipSlots
+	equivocating         map[uint64]bool // Validators whose attestation targets are ignored.
+	equivocatingLock     sync.RWMutex
 }
 
 // Config options for the service.
@@ -69,6 +80,16 @@ type Config struct {
 	DevMode        bool
 	P2p            p2p.Broadcaster
 	MaxRoutines    int64
+	// StaleForkDepth is the number of epochs behind the finalized checkpoint past which the
+	// tips of side forks are deleted by a periodic cleanup job. 0 disables the job.
+	StaleForkDepth uint64
+	// StaleForkCleanupInterval is the period of the stale fork cleanup job.
+	StaleForkCleanupInterval time.Duration
+	// BalanceMonitor analyzes the epoch boundary states, if set.
+	BalanceMonitor *balances.Monitor
+	// ForkChoiceAuditSize is the number of head changes whose fork choice decisions are kept in
+	// memory for inspection. 0 disables the audit log.
+	ForkChoiceAuditSize uint64
 }
 
 // NewChainService instantiates a new service instance that will
@@ -89,6 +110,11 @@ func NewChainService(ctx context.Context, cfg *Config) (*ChainService, error) {
 		p2p:                  cfg.P2p,
 		canonicalRoots:       make(map[uint64][]byte),
 		maxRoutines:          cfg.MaxRoutines,
+		staleForkDepth:       cfg.StaleForkDepth,
+		staleForkInterval:    cfg.StaleForkCleanupInterval,
+		balanceMonitor:       cfg.BalanceMonitor,
+		forkChoiceAudit:      forkchoiceaudit.NewLog(cfg.ForkChoiceAuditSize),
+		equivocating:         make(map[uint64]bool),
 	}, nil
 }
 
@@ -98,11 +124,15 @@ func (c *ChainService) Start() {
 	if err != nil {
 		log.Fatalf("Could not fetch beacon state: %v", err)
 	}
+	c.loadChainStats(c.ctx)
 	// If the chain has already been initialized, simply start the block processing routine.
 	if beaconState != nil {
 		log.Info("Beacon chain data already exists, starting service")
 		c.genesisTime = time.Unix(int64(beaconState.GenesisTime), 0)
 		c.finalizedEpoch = beaconState.FinalizedCheckpoint.Epoch
+		if err := c.auditChainHead(c.ctx); err != nil {
+			log.WithError(err).Error("Could not audit persisted chain head")
+		}
 	} else {
 		log.Info("Waiting for ChainStart log from the Validator Deposit Contract to start the beacon chain...")
 		if c.web3Service == nil {
@@ -116,6 +146,91 @@ func (c *ChainService) Start() {
 			return
 		}()
 	}
+	if c.staleForkDepth > 0 && c.staleForkInterval > 0 {
+		go c.runStaleForkCleanup(c.staleForkInterval)
+	}
+}
+
+// auditChainHead rolls the head persisted in the DB back to the finalized block if it isn't the
+// finalized block or one of its descendants, walking its ancestors through the stored blocks. A
+// head missing from the DB, or whose ancestry is broken, as after an unclean shutdown, isn't.
+func (c *ChainService) auditChainHead(ctx context.Context) error {
+	beaconDB, isLegacyDB := c.beaconDB.(*db.BeaconDB)
+	if !isLegacyDB {
+		return nil
+	}
+	finalizedBlk, err := beaconDB.FinalizedBlock()
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized block")
+	}
+	finalizedRoot, err := ssz.SigningRoot(finalizedBlk)
+	if err != nil {
+		return errors.Wrap(err, "could not hash finalized block")
+	}
+	consistent, err := headDescendsFrom(ctx, beaconDB, finalizedBlk, finalizedRoot)
+	if err != nil {
+		return err
+	}
+	if consistent {
+		return nil
+	}
+
+	finalizedState, err := beaconDB.FinalizedState()
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized state")
+	}
+	if err := beaconDB.UpdateChainHead(ctx, finalizedBlk, finalizedState); err != nil {
+		return errors.Wrap(err, "could not roll head back to the finalized block")
+	}
+	c.UpdateCanonicalRoots(finalizedBlk, finalizedRoot)
+	log.WithFields(logrus.Fields{
+		"finalizedEpoch": finalizedState.FinalizedCheckpoint.Epoch,
+		"finalizedSlot":  finalizedBlk.Slot,
+		"finalizedRoot":  fmt.Sprintf("%#x", bytesutil.Trunc(finalizedRoot[:])),
+	}).Warn("Repaired inconsistent chain head, rolled head back to the finalized checkpoint")
+	return nil
+}
+
+// headDescendsFrom returns true if the persisted head is the finalized block or one of its
+// descendants.
+func headDescendsFrom(ctx context.Context, beaconDB *db.BeaconDB, finalizedBlk *ethpb.BeaconBlock, finalizedRoot [32]byte) (bool, error) {
+	blk, err := beaconDB.ChainHead()
+	if err != nil {
+		// The head root is recorded, but not its block.
+		log.WithError(err).Warn("Persisted head is not in db")
+		return false, nil
+	}
+	root, err := ssz.SigningRoot(blk)
+	if err != nil {
+		return false, errors.Wrap(err, "could not hash persisted head block")
+	}
+	headRoot, headSlot := root, blk.Slot
+	for root != finalizedRoot {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		if blk.Slot <= finalizedBlk.Slot {
+			log.WithFields(logrus.Fields{
+				"headSlot": headSlot,
+				"headRoot": fmt.Sprintf("%#x", bytesutil.Trunc(headRoot[:])),
+			}).Warn("Persisted head is not a descendant of the finalized block")
+			return false, nil
+		}
+		root = bytesutil.ToBytes32(blk.ParentRoot)
+		blk, err = beaconDB.BlockDeprecated(root)
+		if err != nil {
+			return false, errors.Wrapf(err, "could not get ancestor %#x of persisted head", bytesutil.Trunc(root[:]))
+		}
+		if blk == nil {
+			log.WithFields(logrus.Fields{
+				"headSlot":    headSlot,
+				"headRoot":    fmt.Sprintf("%#x", bytesutil.Trunc(headRoot[:])),
+				"missingRoot": fmt.Sprintf("%#x", bytesutil.Trunc(root[:])),
+			}).Warn("Persisted head is not reachable from the stored blocks")
+			return false, nil
+		}
+	}
+	return true, nil
 }
 
 // processChainStartTime initializes a series of deposits from the ChainStart deposits in the eth1
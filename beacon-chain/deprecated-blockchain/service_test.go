@@ -22,6 +22,7 @@ import (
 	p2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/sirupsen/logrus"
@@ -274,3 +275,81 @@ func TestChainStartStop_Initialized(t *testing.T) {
 	}
 	testutil.AssertLogsContain(t, hook, "Beacon chain data already exists, starting service")
 }
+
+func TestAuditChainHead_RollsBackUnreachableHead(t *testing.T) {
+	hook := logTest.NewGlobal()
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	chainService := setupBeaconChain(t, db, nil)
+	deposits, _ := testutil.SetupInitialDeposits(t, 8)
+	beaconState, err := chainService.initializeBeaconChain(time.Unix(0, 0), deposits, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis, err := db.FinalizedBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The parent of the persisted head was never written, as after an unclean shutdown.
+	missingRoot := bytesutil.ToBytes32([]byte("missing parent"))
+	orphan := &ethpb.BeaconBlock{Slot: 5, ParentRoot: missingRoot[:]}
+	if err := db.SaveBlock(ctx, orphan); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, orphan, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := chainService.auditChainHead(ctx); err != nil {
+		t.Fatalf("Could not audit chain head: %v", err)
+	}
+	head, err := db.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(head, genesis) {
+		t.Errorf("Wanted head rolled back to %v, got %v", genesis, head)
+	}
+	testutil.AssertLogsContain(t, hook, "Persisted head is not reachable from the stored blocks")
+	testutil.AssertLogsContain(t, hook, "rolled head back to the finalized checkpoint")
+}
+
+func TestAuditChainHead_KeepsConsistentHead(t *testing.T) {
+	hook := logTest.NewGlobal()
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	chainService := setupBeaconChain(t, db, nil)
+	deposits, _ := testutil.SetupInitialDeposits(t, 8)
+	beaconState, err := chainService.initializeBeaconChain(time.Unix(0, 0), deposits, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := chainService.ChainHeadRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	child := &ethpb.BeaconBlock{Slot: 1, ParentRoot: genesisRoot[:]}
+	if err := db.SaveBlock(ctx, child); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, child, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := chainService.auditChainHead(ctx); err != nil {
+		t.Fatalf("Could not audit chain head: %v", err)
+	}
+	head, err := db.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(head, child) {
+		t.Errorf("Wanted head %v, got %v", child, head)
+	}
+	testutil.AssertLogsDoNotContain(t, hook, "rolled head back to the finalized checkpoint")
+}
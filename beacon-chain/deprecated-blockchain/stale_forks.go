@@ -0,0 +1,89 @@
+package blockchain
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var (
+	staleForkBlocksPruned = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "stale_fork_blocks_pruned_total",
+		Help: "Number of blocks of stale side forks deleted by the cleanup job",
+	})
+	staleForkStatesPruned = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "stale_fork_states_pruned_total",
+		Help: "Number of historical states of stale side forks deleted by the cleanup job",
+	})
+	staleForkBytesReclaimed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "stale_fork_bytes_reclaimed_total",
+		Help: "Size of the blocks and states of stale side forks deleted by the cleanup job",
+	})
+)
+
+// runStaleForkCleanup prunes the stale side forks every interval until the service stops.
+func (c *ChainService) runStaleForkCleanup(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := c.pruneStaleForks(c.ctx); err != nil {
+				log.WithError(err).Error("Could not prune stale forks")
+			}
+		case <-c.ctx.Done():
+			return
+		}
+	}
+}
+
+// pruneStaleForks deletes the side forks whose tips are more than staleForkDepth epochs behind
+// the finalized checkpoint of the head state, along with every reference to them.
+func (c *ChainService) pruneStaleForks(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.pruneStaleForks")
+	defer span.End()
+
+	beaconDB, ok := c.beaconDB.(*db.BeaconDB)
+	if !ok {
+		return nil
+	}
+	headState, err := beaconDB.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	if headState == nil || headState.FinalizedCheckpoint.Epoch <= c.staleForkDepth {
+		return nil
+	}
+	minTipEpoch := headState.FinalizedCheckpoint.Epoch - c.staleForkDepth
+
+	pruned, err := beaconDB.PruneStaleForks(ctx, helpers.StartSlot(minTipEpoch))
+	if errors.Cause(err) == db.ErrSnapshotPending {
+		log.WithError(err).Warn("Skipped pruning stale forks")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not prune stale forks")
+	}
+	if len(pruned.BlockRoots) == 0 {
+		return nil
+	}
+	c.forgetPrunedBlocks(pruned.BlockRoots)
+
+	staleForkBlocksPruned.Add(float64(len(pruned.BlockRoots)))
+	staleForkStatesPruned.Add(float64(pruned.States))
+	staleForkBytesReclaimed.Add(float64(pruned.Bytes))
+	log.WithFields(logrus.Fields{
+		"minTipEpoch":    minTipEpoch,
+		"prunedBlocks":   len(pruned.BlockRoots),
+		"prunedStates":   pruned.States,
+		"reclaimedBytes": pruned.Bytes,
+	}).Info("Pruned stale forks")
+	return nil
+}
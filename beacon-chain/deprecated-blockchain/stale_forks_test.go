@@ -0,0 +1,134 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestPruneStaleForks_DeletesForksBehindFinalized(t *testing.T) {
+	beaconDB := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, beaconDB)
+	ctx := context.Background()
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 10)
+	if err := beaconDB.InitializeState(ctx, uint64(time.Now().Unix()), deposits, &ethpb.Eth1Data{}); err != nil {
+		t.Fatalf("failed to initialize state: %v", err)
+	}
+	genesis, err := beaconDB.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := ssz.SigningRoot(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	saveBlock := func(slot uint64, parentRoot [32]byte, fork byte) (*ethpb.BeaconBlock, [32]byte) {
+		block := &ethpb.BeaconBlock{
+			Slot:       slot,
+			ParentRoot: parentRoot[:],
+			StateRoot:  bytesutil.ToBytes(uint64(fork), 32),
+		}
+		root, err := ssz.SigningRoot(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := beaconDB.SaveBlockDeprecated(block); err != nil {
+			t.Fatal(err)
+		}
+		return block, root
+	}
+
+	// The fork B1 <- B2 is left 3 epochs behind the finalized checkpoint of the head.
+	headSlot := 4 * params.BeaconConfig().SlotsPerEpoch
+	_, staleRoot1 := saveBlock(1, genesisRoot, 'b')
+	_, staleRoot2 := saveBlock(2, staleRoot1, 'b')
+	head, headRoot := saveBlock(headSlot, genesisRoot, 'a')
+	headState, err := beaconDB.HeadState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	headState.Slot = headSlot
+	headState.FinalizedCheckpoint = &ethpb.Checkpoint{Epoch: 3}
+	if err := beaconDB.UpdateChainHead(ctx, head, headState); err != nil {
+		t.Fatal(err)
+	}
+
+	chainService, err := NewChainService(ctx, &Config{
+		BeaconDB:       beaconDB,
+		AttsService:    &mockAttestationHandler{},
+		StaleForkDepth: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainService.canonicalRoots[2] = staleRoot2[:]
+
+	if err := chainService.pruneStaleForks(ctx); err != nil {
+		t.Fatalf("Could not prune stale forks: %v", err)
+	}
+	for _, root := range [][32]byte{staleRoot1, staleRoot2} {
+		if beaconDB.HasBlockDeprecated(root) {
+			t.Errorf("Expected stale block %#x to be deleted", root)
+		}
+	}
+	for _, root := range [][32]byte{genesisRoot, headRoot} {
+		if !beaconDB.HasBlockDeprecated(root) {
+			t.Errorf("Expected block %#x to be kept", root)
+		}
+	}
+	if _, ok := chainService.canonicalRoots[2]; ok {
+		t.Error("Expected canonical root of a stale block to be removed")
+	}
+}
+
+func TestPruneStaleForks_KeepsForksWithinDepth(t *testing.T) {
+	beaconDB := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, beaconDB)
+	ctx := context.Background()
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 10)
+	if err := beaconDB.InitializeState(ctx, uint64(time.Now().Unix()), deposits, &ethpb.Eth1Data{}); err != nil {
+		t.Fatalf("failed to initialize state: %v", err)
+	}
+	genesis, err := beaconDB.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := ssz.SigningRoot(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fork := &ethpb.BeaconBlock{Slot: 1, ParentRoot: genesisRoot[:], StateRoot: bytesutil.ToBytes('b', 32)}
+	forkRoot, err := ssz.SigningRoot(fork)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveBlockDeprecated(fork); err != nil {
+		t.Fatal(err)
+	}
+
+	// The genesis state is finalized at epoch 0, so no fork is more than 1 epoch behind.
+	chainService, err := NewChainService(ctx, &Config{
+		BeaconDB:       beaconDB,
+		AttsService:    &mockAttestationHandler{},
+		StaleForkDepth: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := chainService.pruneStaleForks(ctx); err != nil {
+		t.Fatalf("Could not prune stale forks: %v", err)
+	}
+	if !beaconDB.HasBlockDeprecated(forkRoot) {
+		t.Error("Expected fork within the stale fork depth to be kept")
+	}
+}
@@ -243,9 +243,35 @@ func (s *InitialSync) run(chainHeadResponses map[peer.ID]*pb.ChainHeadResponse)
 		peers = append(peers, k)
 	}
 
+	// Peers which served us failed batches before, and then peers which relayed
+	// blocks and attestations long after their slot, are tried last among peers
+	// at the same canonical slot.
+	successRates := make(map[peer.ID]float64, len(peers))
+	lateMessages := make(map[peer.ID]uint64, len(peers))
+	for _, pid := range peers {
+		rate, err := s.db.PeerBatchSuccessRate(ctx, pid)
+		if err != nil {
+			log.WithError(err).WithField("peer", pid.Pretty()).Error("Could not retrieve peer batch success rate")
+		}
+		successRates[pid] = rate
+		late, err := s.db.PeerLateMessages(ctx, pid)
+		if err != nil {
+			log.WithError(err).WithField("peer", pid.Pretty()).Error("Could not retrieve peer late messages")
+		}
+		lateMessages[pid] = late
+	}
+
 	// Sort peers in descending order based on their canonical slot.
 	sort.Slice(peers, func(i, j int) bool {
-		return chainHeadResponses[peers[i]].CanonicalSlot > chainHeadResponses[peers[j]].CanonicalSlot
+		slotI := chainHeadResponses[peers[i]].CanonicalSlot
+		slotJ := chainHeadResponses[peers[j]].CanonicalSlot
+		if slotI != slotJ {
+			return slotI > slotJ
+		}
+		if successRates[peers[i]] != successRates[peers[j]] {
+			return successRates[peers[i]] > successRates[peers[j]]
+		}
+		return lateMessages[peers[i]] < lateMessages[peers[j]]
 	})
 
 	for _, peer := range peers {
@@ -294,8 +320,10 @@ func (s *InitialSync) syncToPeer(ctx context.Context, chainHeadResponse *pb.Chai
 			log.WithFields(fields).Info("Received batched blocks from peer")
 			if err := s.processBatchedBlocks(msg, chainHeadResponse); err != nil {
 				log.WithError(err).WithField("peer", peer).Error("Failed to sync with peer.")
+				s.recordPeerBatch(peer, false)
 				continue
 			}
+			s.recordPeerBatch(peer, s.nodeIsSynced)
 			if !s.nodeIsSynced {
 				return errors.New("node still not in sync after receiving batch blocks")
 			}
@@ -303,3 +331,11 @@ func (s *InitialSync) syncToPeer(ctx context.Context, chainHeadResponse *pb.Chai
 		}
 	}
 }
+
+// recordPeerBatch persists the outcome of a batched block request, so that
+// future syncs prefer peers which served us reliably.
+func (s *InitialSync) recordPeerBatch(pid peer.ID, success bool) {
+	if err := s.db.RecordPeerBatch(s.ctx, pid, success); err != nil {
+		log.WithError(err).WithField("peer", pid.Pretty()).Error("Could not record peer batch result")
+	}
+}
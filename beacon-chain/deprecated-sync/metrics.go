@@ -76,4 +76,13 @@ var (
 		Name: "regsync_chain_head_sent",
 		Help: "The number of sent chain head responses",
 	})
+	propagationDelay = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "regsync_propagation_delay_seconds",
+		Help:    "The time between the start of the slot of a block or attestation and its first receipt",
+		Buckets: prometheus.ExponentialBuckets(0.25, 2, 10),
+	}, []string{"kind"})
+	lateMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "regsync_late_messages",
+		Help: "The number of blocks and attestations first received more than an epoch after their slot",
+	}, []string{"kind"})
 )
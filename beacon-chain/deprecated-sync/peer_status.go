@@ -0,0 +1,171 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	deprecatedp2p "github.com/prysmaticlabs/prysm/shared/deprecated-p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+var (
+	// peerStatusFlushInterval is how often the peer heads, late messages and propagation delays
+	// observed on gossip are written to the DB, in a single transaction.
+	peerStatusFlushInterval = time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	// peerStatusPruneInterval is how often the statuses of peers we no longer hear from are
+	// pruned from the DB.
+	peerStatusPruneInterval = time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+	// peerStatusRefreshInterval is how often the peers are requested their chain head, so that
+	// their statuses follow their finalized checkpoint and not only the blocks they relay.
+	peerStatusRefreshInterval = time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+)
+
+// peerLister is implemented by the p2p servers listing the peers they are connected to.
+type peerLister interface {
+	Peers() []peer.ID
+}
+
+// pendingPeerUpdates buffers the peer status updates observed on gossip until the next flush,
+// so that receiving a block does not cost a DB write.
+type pendingPeerUpdates struct {
+	lock         sync.Mutex
+	heads        map[peer.ID]*pb.Hello
+	lateMessages map[peer.ID]uint64
+	delays       map[peer.ID][]uint64
+}
+
+func newPendingPeerUpdates() *pendingPeerUpdates {
+	return &pendingPeerUpdates{
+		heads:        make(map[peer.ID]*pb.Hello),
+		lateMessages: make(map[peer.ID]uint64),
+		delays:       make(map[peer.ID][]uint64),
+	}
+}
+
+// peerStatus returns the status of a peer, including the head updates not flushed yet.
+func (rs *RegularSync) peerStatus(ctx context.Context, pid peer.ID) (*pb.Hello, error) {
+	rs.pendingPeers.lock.Lock()
+	status, ok := rs.pendingPeers.heads[pid]
+	rs.pendingPeers.lock.Unlock()
+	if ok {
+		return status, nil
+	}
+	return rs.db.PeerStatus(ctx, pid)
+}
+
+// chainHeadStatus converts the chain head reported by a peer to its status. The response does not
+// carry the finalized epoch, we can only derive it when we already have the peer's finalized
+// block. The status is returned along with the error of looking that block up.
+func chainHeadStatus(beaconDB *db.BeaconDB, response *pb.ChainHeadResponse) (*pb.Hello, error) {
+	status := &pb.Hello{
+		ForkVersion:   params.BeaconConfig().GenesisForkVersion,
+		FinalizedRoot: response.FinalizedBlockRoot,
+		HeadRoot:      response.CanonicalBlockRoot,
+		HeadSlot:      response.CanonicalSlot,
+	}
+	finalizedBlock, err := beaconDB.BlockDeprecated(bytesutil.ToBytes32(response.FinalizedBlockRoot))
+	if err != nil {
+		return status, err
+	}
+	if finalizedBlock != nil {
+		status.FinalizedEpoch = helpers.SlotToEpoch(finalizedBlock.Slot)
+	}
+	return status, nil
+}
+
+// requestPeerHeads broadcasts a chain head request, the responses of the peers are recorded as
+// their statuses by receiveChainHeadResponse.
+func (rs *RegularSync) requestPeerHeads(ctx context.Context) {
+	if err := rs.p2p.Broadcast(ctx, &pb.ChainHeadRequest{}); err != nil {
+		log.WithError(err).Error("Could not request the chain head of peers")
+	}
+}
+
+// receiveChainHeadResponse records the chain head a peer responded with as its status, in place
+// of the one it reported before. The status is written to the DB with the next flush.
+func (rs *RegularSync) receiveChainHeadResponse(msg deprecatedp2p.Message) error {
+	response, ok := msg.Data.(*pb.ChainHeadResponse)
+	if !ok {
+		return errors.New("incoming message is not *pb.ChainHeadResponse")
+	}
+	status, err := chainHeadStatus(rs.db, response)
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve peer finalized block")
+	}
+	rs.forkMonitor.ObserveHead(msg.Peer.Pretty(), response.CanonicalSlot, bytesutil.ToBytes32(response.CanonicalBlockRoot))
+
+	rs.pendingPeers.lock.Lock()
+	defer rs.pendingPeers.lock.Unlock()
+	rs.pendingPeers.heads[msg.Peer] = status
+	return nil
+}
+
+// PeerForks groups the connected peers by the fork version and finalized checkpoint of the last
+// chain head they reported, sorted by decreasing number of peers. Peers which never reported
+// their chain head are not counted.
+func (rs *RegularSync) PeerForks() []*prysmsync.PeerFork {
+	lister, ok := rs.p2p.(peerLister)
+	if !ok {
+		return nil
+	}
+	var statuses []*pb.Hello
+	for _, pid := range lister.Peers() {
+		status, err := rs.peerStatus(rs.ctx, pid)
+		if err != nil {
+			log.WithError(err).WithField("peer", pid.Pretty()).Error("Could not retrieve peer status")
+			continue
+		}
+		statuses = append(statuses, status)
+	}
+	return prysmsync.GroupPeerForks(statuses)
+}
+
+// flushPeerUpdates writes the buffered peer status updates to the DB. A buffered head doesn't
+// replace a higher one saved since it was buffered, as by the querier.
+func (rs *RegularSync) flushPeerUpdates(ctx context.Context) {
+	rs.pendingPeers.lock.Lock()
+	heads := rs.pendingPeers.heads
+	lateMessages := rs.pendingPeers.lateMessages
+	delays := rs.pendingPeers.delays
+	rs.pendingPeers.heads = make(map[peer.ID]*pb.Hello)
+	rs.pendingPeers.lateMessages = make(map[peer.ID]uint64)
+	rs.pendingPeers.delays = make(map[peer.ID][]uint64)
+	rs.pendingPeers.lock.Unlock()
+
+	if len(heads) > 0 {
+		if err := rs.db.SaveNewerPeerStatuses(ctx, heads); err != nil {
+			log.WithError(err).Error("Could not save peer statuses")
+		}
+	}
+	if len(lateMessages) > 0 {
+		if err := rs.db.RecordPeerLateMessages(ctx, lateMessages); err != nil {
+			log.WithError(err).Error("Could not record late messages")
+		}
+	}
+	if len(delays) > 0 {
+		if err := rs.db.RecordPeerPropagationDelays(ctx, delays); err != nil {
+			log.WithError(err).Error("Could not record propagation delays")
+		}
+	}
+}
+
+// prunePeerStatuses deletes the statuses of peers we have not heard from in a long time,
+// keeping the peer status bucket bounded.
+func (rs *RegularSync) prunePeerStatuses(ctx context.Context) {
+	pruned, err := rs.db.PrunePeerStatuses(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not prune peer statuses")
+		return
+	}
+	if pruned > 0 {
+		log.WithField("peers", pruned).Debug("Pruned stale peer statuses")
+	}
+}
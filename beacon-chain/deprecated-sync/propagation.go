@@ -0,0 +1,126 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/sirupsen/logrus"
+)
+
+// Kinds of relayed messages, used as metric labels.
+const (
+	blockMessage       = "block"
+	attestationMessage = "attestation"
+)
+
+// Propagation delay buckets counted for each relaying peer: messages received within their slot,
+// within an epoch, and later.
+const (
+	delayWithinSlot = iota
+	delayWithinEpoch
+	delayLate
+	delayBuckets
+)
+
+// blockAnnouncements remember when and from which peer the announcement of a block was first
+// received, until the block is processed. The slot of an announcement is chosen by the peer, so
+// the delay of a block is only recorded once its slot is verified.
+var blockAnnouncements = cache.NewStore(cache.StoreConfig{
+	Name:    "regsync_block_announcements",
+	MaxSize: 1024,
+	TTL:     time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second,
+})
+
+type blockAnnouncement struct {
+	peer     peer.ID
+	received time.Time
+}
+
+// recordBlockAnnouncement remembers the first announcement of a block.
+func recordBlockAnnouncement(blockRoot [32]byte, pid peer.ID) {
+	blockAnnouncements.SetIfAbsent(string(blockRoot[:]), &blockAnnouncement{peer: pid, received: roughtime.Now()})
+}
+
+// recordBlockPropagation records the delay of a processed block against the peer which
+// announced it first, if it was announced.
+func (rs *RegularSync) recordBlockPropagation(ctx context.Context, blockRoot [32]byte, slot uint64) {
+	key := string(blockRoot[:])
+	v, ok := blockAnnouncements.Get(key)
+	if !ok {
+		return
+	}
+	blockAnnouncements.Delete(key)
+	genesisTime, err := rs.genesisTime(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve genesis time")
+		return
+	}
+	if genesisTime == 0 {
+		return
+	}
+	announcement := v.(*blockAnnouncement)
+	rs.recordPropagation(ctx, blockMessage, announcement.peer, genesisTime, slot, announcement.received)
+}
+
+// genesisTime returns the genesis time of the chain, which is read once from the head state.
+func (rs *RegularSync) genesisTime(ctx context.Context) (uint64, error) {
+	rs.genesisTimeLock.Lock()
+	defer rs.genesisTimeLock.Unlock()
+	if rs.genesisTimeCache != 0 {
+		return rs.genesisTimeCache, nil
+	}
+	headState, err := rs.db.HeadState(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if headState != nil {
+		rs.genesisTimeCache = headState.GenesisTime
+	}
+	return rs.genesisTimeCache, nil
+}
+
+// recordPropagation records how long after the start of its slot a block or attestation
+// was first received, in the delay buckets of the peer which relayed it. A peer relaying a
+// message more than an epoch after its slot is also recorded as late, which ranks it below
+// timely peers when selecting a peer to sync from. Peers are kept out of the metric labels,
+// which would otherwise grow with peer churn, and their buckets are written to the DB with the
+// next flush of the peer statuses.
+func (rs *RegularSync) recordPropagation(ctx context.Context, kind string, pid peer.ID, genesisTime uint64, slot uint64, received time.Time) {
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	slotStart := time.Unix(int64(genesisTime), 0).Add(time.Duration(slot) * slotDuration)
+	delay := received.Sub(slotStart)
+	// Messages for a slot may be seen just before it starts, due to clock disparity.
+	if delay < 0 {
+		delay = 0
+	}
+	propagationDelay.WithLabelValues(kind).Observe(delay.Seconds())
+
+	bucket := delayLate
+	switch {
+	case delay <= slotDuration:
+		bucket = delayWithinSlot
+	case delay <= time.Duration(params.BeaconConfig().SlotsPerEpoch)*slotDuration:
+		bucket = delayWithinEpoch
+	}
+	rs.pendingPeers.lock.Lock()
+	defer rs.pendingPeers.lock.Unlock()
+	if rs.pendingPeers.delays[pid] == nil {
+		rs.pendingPeers.delays[pid] = make([]uint64, delayBuckets)
+	}
+	rs.pendingPeers.delays[pid][bucket]++
+	if bucket != delayLate {
+		return
+	}
+	lateMessages.WithLabelValues(kind).Inc()
+	log.WithFields(logrus.Fields{
+		"peer":  pid.Pretty(),
+		"kind":  kind,
+		"slot":  slot,
+		"delay": delay,
+	}).Debug("Received a message long after its slot")
+	rs.pendingPeers.lateMessages[pid]++
+}
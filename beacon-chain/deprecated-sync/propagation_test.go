@@ -0,0 +1,77 @@
+package sync
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+)
+
+func TestRecordPropagation_RecordsLatePeers(t *testing.T) {
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+	rs := setupService(db)
+
+	currentSlot := uint64(100)
+	genesisTime := uint64(roughtime.Now().Unix()) - currentSlot*params.BeaconConfig().SecondsPerSlot
+	timely := peer.ID("peer-a")
+	late := peer.ID("peer-b")
+
+	now := roughtime.Now()
+	rs.recordPropagation(ctx, blockMessage, timely, genesisTime, currentSlot, now)
+	rs.recordPropagation(ctx, attestationMessage, late, genesisTime, currentSlot-params.BeaconConfig().SlotsPerEpoch-1, now)
+	rs.recordPropagation(ctx, attestationMessage, late, genesisTime, currentSlot-2, now)
+	rs.flushPeerUpdates(ctx)
+
+	for pid, want := range map[peer.ID]uint64{timely: 0, late: 1} {
+		count, err := db.PeerLateMessages(ctx, pid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != want {
+			t.Errorf("Wanted %d late messages for %s, got %d", want, pid, count)
+		}
+	}
+	for pid, want := range map[peer.ID][]uint64{timely: {1, 0, 0}, late: {0, 1, 1}} {
+		delays, err := db.PeerPropagationDelays(ctx, pid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(delays, want) {
+			t.Errorf("Wanted delay buckets %v for %s, got %v", want, pid, delays)
+		}
+	}
+}
+
+func TestRecordBlockPropagation_UsesProcessedBlockSlot(t *testing.T) {
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+	rs := setupService(db)
+
+	currentSlot := uint64(100)
+	rs.genesisTimeCache = uint64(roughtime.Now().Unix()) - currentSlot*params.BeaconConfig().SecondsPerSlot
+	pid := peer.ID("peer-a")
+	root := [32]byte{'a'}
+
+	// The slot announced by the peer is not used, the delay is recorded once the block is
+	// processed, from the time of its announcement.
+	recordBlockAnnouncement(root, pid)
+	rs.recordBlockPropagation(ctx, [32]byte{'b'}, currentSlot)
+	rs.recordBlockPropagation(ctx, root, currentSlot-params.BeaconConfig().SlotsPerEpoch-1)
+	rs.recordBlockPropagation(ctx, root, currentSlot)
+	rs.flushPeerUpdates(ctx)
+
+	delays, err := db.PeerPropagationDelays(ctx, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []uint64{0, 0, 1}; !reflect.DeepEqual(delays, want) {
+		t.Errorf("Wanted delay buckets %v, got %v", want, delays)
+	}
+}
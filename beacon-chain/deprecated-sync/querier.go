@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -34,6 +35,7 @@ type QuerierConfig struct {
 	PowChain           powChainService
 	CurrentHeadSlot    uint64
 	ChainService       chainService
+	ForkMonitor        *cache.ForkMonitor
 }
 
 // DefaultQuerierConfig provides the default configuration for a sync service.
@@ -64,6 +66,7 @@ type Querier struct {
 	chainHeadResponses        map[peer.ID]*pb.ChainHeadResponse
 	canonicalBlockRoot        []byte
 	finalizedBlockRoot        []byte
+	forkMonitor               *cache.ForkMonitor
 }
 
 // NewQuerierService constructs a new Sync Querier Service.
@@ -88,6 +91,7 @@ func NewQuerierService(ctx context.Context,
 		powchain:           cfg.PowChain,
 		chainStartBuf:      make(chan time.Time, 1),
 		chainHeadResponses: make(map[peer.ID]*pb.ChainHeadResponse),
+		forkMonitor:        cfg.ForkMonitor,
 	}
 }
 
@@ -192,6 +196,7 @@ func (q *Querier) run() {
 				}).Info("Received chain head from peer")
 				q.chainHeadResponses[msg.Peer] = response
 			}
+			q.savePeerStatus(msg.Peer, response)
 			if response.CanonicalSlot > q.currentHeadSlot {
 				q.bestPeer = msg.Peer
 				q.currentHeadSlot = response.CanonicalSlot
@@ -204,6 +209,19 @@ func (q *Querier) run() {
 	}
 }
 
+// savePeerStatus persists the chain head reported by a peer, so that sync can
+// rank peers and detect when it has fallen behind without querying them again.
+func (q *Querier) savePeerStatus(pid peer.ID, response *pb.ChainHeadResponse) {
+	q.forkMonitor.ObserveHead(pid.Pretty(), response.CanonicalSlot, bytesutil.ToBytes32(response.CanonicalBlockRoot))
+	status, err := chainHeadStatus(q.db, response)
+	if err != nil {
+		queryLog.WithError(err).Error("Could not retrieve peer finalized block")
+	}
+	if err := q.db.SavePeerStatus(q.ctx, pid, status); err != nil {
+		queryLog.WithError(err).WithField("peerID", pid.Pretty()).Error("Could not save peer status")
+	}
+}
+
 func (q *Querier) waitForAllDepositsToBeProcessed() {
 	for {
 		processed, err := q.powchain.AreAllDepositsProcessed()
@@ -3,8 +3,13 @@ package sync
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/gogo/protobuf/proto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
@@ -15,6 +20,10 @@ import (
 	"go.opencensus.io/trace"
 )
 
+// catchUpRequestTimeout is how long we wait for a peer to answer a catch up request
+// before asking it again.
+var catchUpRequestTimeout = 30 * time.Second
+
 // receiveBlockAnnounce accepts a block hash, determines if we do not contain
 // the block in our local DB, and then request the full block data.
 func (rs *RegularSync) receiveBlockAnnounce(msg p2p.Message) error {
@@ -50,6 +59,17 @@ func (rs *RegularSync) receiveBlockAnnounce(msg p2p.Message) error {
 		return nil
 	}
 
+	recentlyProcessed, err := rs.recentlyProcessed.Seen(h)
+	if err != nil {
+		return err
+	}
+	if recentlyProcessed {
+		log.WithField("blockRoot", fmt.Sprintf("%#x", bytesutil.Trunc(h[:]))).Debug("Already processing")
+		return nil
+	}
+
+	recordBlockAnnouncement(h, msg.Peer)
+
 	log.WithField("blockRoot", fmt.Sprintf("%#x", bytesutil.Trunc(h[:]))).Debug("Received incoming block root, requesting full block data from sender")
 	// Request the full block data from peer that sent the block hash.
 	if err := rs.p2p.Send(ctx, &pb.BeaconBlockRequest{Hash: h[:]}, msg.Peer); err != nil {
@@ -157,6 +177,9 @@ func (rs *RegularSync) validateAndProcessBlock(
 	if !hasParent {
 		// If we do not have the parent, we insert it into a pending block's map.
 		rs.insertPendingBlock(ctx, parentRoot, blockMsg)
+		// A missing parent may mean we fell behind, in which case we fetch the
+		// whole range at once rather than walking back one parent at a time.
+		rs.requestCatchUp(ctx, blockMsg.Peer, beaconState)
 		// We update the last observed slot to the received canonical block's slot.
 		if block.Slot > rs.highestObservedSlot {
 			rs.highestObservedSlot = block.Slot
@@ -164,12 +187,26 @@ func (rs *RegularSync) validateAndProcessBlock(
 		return nil, nil, false, nil
 	}
 
+	// The block may have been proposed over RPC, and be processing already.
+	firstSeen, err := rs.recentlyProcessed.MarkProcessed(blockRoot, cache.KindBlock, cache.SourceGossip)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	if !firstSeen {
+		log.WithField("blockRoot", fmt.Sprintf("%#x", bytesutil.Trunc(blockRoot[:]))).Debug(
+			"Received a recently processed block")
+		return nil, nil, false, nil
+	}
+
 	log.WithField("blockRoot", fmt.Sprintf("%#x", bytesutil.Trunc(blockRoot[:]))).Debug(
 		"Sending newly received block to chain service")
 	// We then process the block by passing it through the ChainService and running
 	// a fork choice rule.
 	beaconState, err = rs.chainService.ReceiveBlockDeprecated(ctx, block)
 	if err != nil {
+		if forgetErr := rs.recentlyProcessed.Forget(blockRoot); forgetErr != nil {
+			log.WithError(forgetErr).Error("Could not forget failed block")
+		}
 		log.Errorf("Could not process beacon block: %v", err)
 		span.AddAttributes(trace.BoolAttribute("invalidBlock", true))
 		return nil, nil, false, err
@@ -205,14 +242,145 @@ func (rs *RegularSync) validateAndProcessBlock(
 		return nil, nil, false, err
 	}
 	sentBlocks.Inc()
+	rs.recordBlockPropagation(ctx, blockRoot, block.Slot)
 	// We update the last observed slot to the received canonical block's slot.
 	if block.Slot > rs.highestObservedSlot {
 		rs.highestObservedSlot = block.Slot
 	}
 	span.AddAttributes(trace.Int64Attribute("highestObservedSlot", int64(rs.highestObservedSlot)))
+	rs.forkMonitor.ObserveHead(blockMsg.Peer.Pretty(), block.Slot, blockRoot)
+	rs.advancePeerHead(ctx, blockMsg.Peer, block, blockRoot)
 	return block, beaconState, true, nil
 }
 
+// receiveBatchedBlocks processes the blocks sent by a peer in response to a catch up
+// request, in order, and records whether the peer served us a usable batch.
+func (rs *RegularSync) receiveBatchedBlocks(msg p2p.Message) error {
+	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.receiveBatchedBlocks")
+	defer span.End()
+
+	rs.catchUpRequestsLock.Lock()
+	_, requested := rs.catchUpRequests[msg.Peer]
+	delete(rs.catchUpRequests, msg.Peer)
+	rs.catchUpRequestsLock.Unlock()
+	if !requested {
+		log.WithField("peer", msg.Peer.Pretty()).Debug("Received unrequested batched blocks")
+		return nil
+	}
+
+	response := msg.Data.(*pb.BatchedBeaconBlockResponse)
+	log.WithFields(logrus.Fields{
+		"peer":   msg.Peer.Pretty(),
+		"blocks": len(response.BatchedBlocks),
+	}).Debug("Received batched blocks from peer")
+	for _, block := range response.BatchedBlocks {
+		blockMsg := p2p.Message{
+			Ctx:  ctx,
+			Peer: msg.Peer,
+			Data: &pb.BeaconBlockResponse{Block: block},
+		}
+		if err := rs.receiveBlock(blockMsg); err != nil {
+			if err := rs.db.RecordPeerBatch(ctx, msg.Peer, false); err != nil {
+				log.WithError(err).Error("Could not record peer batch result")
+			}
+			return errors.Wrap(err, "could not process batched block")
+		}
+	}
+	return rs.db.RecordPeerBatch(ctx, msg.Peer, true)
+}
+
+// requestCatchUp requests every block between our finalized block and the head last
+// reported by the peer, when that head is more than an epoch ahead of our own.
+func (rs *RegularSync) requestCatchUp(ctx context.Context, pid peer.ID, beaconState *pb.BeaconState) {
+	status, err := rs.peerStatus(ctx, pid)
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve peer status")
+		return
+	}
+	if status == nil || status.HeadSlot <= beaconState.Slot+params.BeaconConfig().SlotsPerEpoch {
+		return
+	}
+
+	rs.catchUpRequestsLock.Lock()
+	defer rs.catchUpRequestsLock.Unlock()
+	// Only one catch up request is kept in flight per peer, unless it went unanswered.
+	if sent, ok := rs.catchUpRequests[pid]; ok && time.Since(sent) < catchUpRequestTimeout {
+		return
+	}
+	finalizedBlk, err := rs.db.FinalizedBlock()
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve finalized block")
+		return
+	}
+	finalizedRoot, err := ssz.SigningRoot(finalizedBlk)
+	if err != nil {
+		log.WithError(err).Error("Could not hash finalized block")
+		return
+	}
+
+	log.WithFields(logrus.Fields{
+		"peer":         pid.Pretty(),
+		"peerHeadSlot": status.HeadSlot,
+		"headSlot":     beaconState.Slot,
+	}).Info("Fell behind peer, requesting blocks since last finalized block")
+	if err := rs.p2p.Send(ctx, &pb.BatchedBeaconBlockRequest{
+		FinalizedRoot: finalizedRoot[:],
+		CanonicalRoot: status.HeadRoot,
+	}, pid); err != nil {
+		log.WithError(err).Error("Could not send batched block request")
+		return
+	}
+	rs.catchUpRequests[pid] = time.Now()
+}
+
+// ForceResync requests the blocks since our last finalized block from each of the given peers
+// whose last reported head is more than an epoch ahead of ours, even if a catch up request to the
+// peer is still in flight. It returns the number of peers the blocks were requested from.
+func (rs *RegularSync) ForceResync(ctx context.Context, pids []peer.ID) (int, error) {
+	beaconState, err := rs.db.HeadState(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not retrieve head state")
+	}
+	if beaconState == nil {
+		return 0, errors.New("no head state to resync from")
+	}
+
+	rs.catchUpRequestsLock.Lock()
+	rs.catchUpRequests = make(map[peer.ID]time.Time)
+	rs.catchUpRequestsLock.Unlock()
+	for _, pid := range pids {
+		rs.requestCatchUp(ctx, pid, beaconState)
+	}
+
+	rs.catchUpRequestsLock.Lock()
+	defer rs.catchUpRequestsLock.Unlock()
+	return len(rs.catchUpRequests), nil
+}
+
+// advancePeerHead updates the head of a peer when it sends us a block past it. The update
+// is written to the DB with the next flush of the peer statuses.
+func (rs *RegularSync) advancePeerHead(ctx context.Context, pid peer.ID, block *ethpb.BeaconBlock, blockRoot [32]byte) {
+	status, err := rs.peerStatus(ctx, pid)
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve peer status")
+		return
+	}
+	if status == nil || block.Slot <= status.HeadSlot {
+		return
+	}
+	updated := proto.Clone(status).(*pb.Hello)
+	updated.HeadSlot = block.Slot
+	updated.HeadRoot = blockRoot[:]
+
+	rs.pendingPeers.lock.Lock()
+	defer rs.pendingPeers.lock.Unlock()
+	// Another block of the peer may have advanced its head in the meantime.
+	if pending, ok := rs.pendingPeers.heads[pid]; ok && pending.HeadSlot >= updated.HeadSlot {
+		return
+	}
+	rs.pendingPeers.heads[pid] = updated
+}
+
 func (rs *RegularSync) insertPendingBlock(ctx context.Context, blockRoot [32]byte, blockMsg p2p.Message) {
 	rs.blocksAwaitingProcessingLock.Lock()
 	defer rs.blocksAwaitingProcessingLock.Unlock()
@@ -3,13 +3,17 @@ package sync
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/gogo/protobuf/proto"
+	peer "github.com/libp2p/go-libp2p-peer"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	p2p "github.com/prysmaticlabs/prysm/shared/deprecated-p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -159,3 +163,137 @@ func TestReceiveBlock_RecursivelyProcessesChildren(t *testing.T) {
 		t.Errorf("Expected blocks awaiting processing map to be empty, received len = %d", len(rs.blocksAwaitingProcessing))
 	}
 }
+
+func TestRequestCatchUp_PeerMoreThanAnEpochAhead(t *testing.T) {
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	finalizedBlock := &ethpb.BeaconBlock{Slot: 0}
+	if err := db.SaveFinalizedBlock(finalizedBlock); err != nil {
+		t.Fatal(err)
+	}
+	finalizedRoot, err := ssz.SigningRoot(finalizedBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState := &pb.BeaconState{Slot: 10}
+	pid := peer.ID("peer-a")
+	headRoot := []byte{'a'}
+	if err := db.SavePeerStatus(ctx, pid, &pb.Hello{
+		HeadRoot: headRoot,
+		HeadSlot: beaconState.Slot + params.BeaconConfig().SlotsPerEpoch,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	mp := &mockP2P{}
+	rs := setupService(db)
+	rs.p2p = mp
+
+	// A peer within an epoch of our head is caught up with by regular block requests.
+	rs.requestCatchUp(ctx, pid, beaconState)
+	if mp.sentMsg != nil {
+		t.Errorf("Expected no batched block request, sent %v", mp.sentMsg)
+	}
+
+	if err := db.SavePeerStatus(ctx, pid, &pb.Hello{
+		HeadRoot: headRoot,
+		HeadSlot: beaconState.Slot + params.BeaconConfig().SlotsPerEpoch + 1,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	rs.requestCatchUp(ctx, pid, beaconState)
+	want := &pb.BatchedBeaconBlockRequest{
+		FinalizedRoot: finalizedRoot[:],
+		CanonicalRoot: headRoot,
+	}
+	if !proto.Equal(mp.sentMsg, want) {
+		t.Errorf("Wanted %v, got %v", want, mp.sentMsg)
+	}
+
+	// The request is not repeated while the peer has yet to answer it.
+	mp.sentMsg = nil
+	rs.requestCatchUp(ctx, pid, beaconState)
+	if mp.sentMsg != nil {
+		t.Errorf("Expected no duplicate batched block request, sent %v", mp.sentMsg)
+	}
+}
+
+func TestForceResync_IgnoresRequestsInFlight(t *testing.T) {
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	finalizedBlock := &ethpb.BeaconBlock{Slot: 0}
+	if err := db.SaveFinalizedBlock(finalizedBlock); err != nil {
+		t.Fatal(err)
+	}
+	beaconState := &pb.BeaconState{Slot: 10}
+	if err := db.SaveStateDeprecated(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	ahead, behind := peer.ID("peer-a"), peer.ID("peer-b")
+	if err := db.SavePeerStatus(ctx, ahead, &pb.Hello{
+		HeadRoot: []byte{'a'},
+		HeadSlot: beaconState.Slot + params.BeaconConfig().SlotsPerEpoch + 1,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SavePeerStatus(ctx, behind, &pb.Hello{HeadSlot: beaconState.Slot}); err != nil {
+		t.Fatal(err)
+	}
+
+	mp := &mockP2P{}
+	rs := setupService(db)
+	rs.p2p = mp
+	rs.catchUpRequests[ahead] = time.Now()
+
+	requested, err := rs.ForceResync(ctx, []peer.ID{ahead, behind})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requested != 1 {
+		t.Errorf("Wanted blocks requested from 1 peer, got %d", requested)
+	}
+	if _, ok := mp.sentMsg.(*pb.BatchedBeaconBlockRequest); !ok {
+		t.Errorf("Expected a batched block request, sent %v", mp.sentMsg)
+	}
+}
+
+func TestAdvancePeerHead_BuffersUntilFlush(t *testing.T) {
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+	pid := peer.ID("peer-a")
+	if err := db.SavePeerStatus(ctx, pid, &pb.Hello{HeadSlot: 5}); err != nil {
+		t.Fatal(err)
+	}
+	rs := setupService(db)
+
+	rs.advancePeerHead(ctx, pid, &ethpb.BeaconBlock{Slot: 7}, [32]byte{'a'})
+	rs.advancePeerHead(ctx, pid, &ethpb.BeaconBlock{Slot: 6}, [32]byte{'b'})
+	stored, err := db.PeerStatus(ctx, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.HeadSlot != 5 {
+		t.Errorf("Wanted the stored head unchanged before the flush, got slot %d", stored.HeadSlot)
+	}
+	status, err := rs.peerStatus(ctx, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.HeadSlot != 7 {
+		t.Errorf("Wanted the buffered head at slot 7, got %d", status.HeadSlot)
+	}
+
+	rs.flushPeerUpdates(ctx)
+	stored, err = db.PeerStatus(ctx, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.HeadSlot != 7 {
+		t.Errorf("Wanted the flushed head at slot 7, got %d", stored.HeadSlot)
+	}
+}
@@ -10,10 +10,13 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	peer "github.com/libp2p/go-libp2p-peer"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	blockchain "github.com/prysmaticlabs/prysm/beacon-chain/deprecated-blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
@@ -26,6 +29,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/logutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -55,6 +59,12 @@ type p2pAPI interface {
 	p2p.DeprecatedSubscriber
 }
 
+// peerDisconnectProvider is implemented by the p2p servers reporting the peers they disconnect
+// from.
+type peerDisconnectProvider interface {
+	PeerDisconnectFeed() *event.Feed
+}
+
 // RegularSync is the gateway and the bridge between the p2p network and the local beacon chain.
 // In broad terms, a new block is synced in 4 steps:
 //     1. Receive a block hash from a peer
@@ -80,17 +90,27 @@ type RegularSync struct {
 	blockBuf                     chan deprecatedp2p.Message
 	blockRequestByHash           chan deprecatedp2p.Message
 	batchedRequestBuf            chan deprecatedp2p.Message
+	batchedBlockBuf              chan deprecatedp2p.Message
 	stateRequestBuf              chan deprecatedp2p.Message
 	chainHeadReqBuf              chan deprecatedp2p.Message
+	chainHeadResBuf              chan deprecatedp2p.Message
 	attestationBuf               chan deprecatedp2p.Message
 	exitBuf                      chan deprecatedp2p.Message
 	canonicalBuf                 chan *pb.BeaconBlockAnnounce
+	disconnectBuf                chan peer.ID
 	highestObservedSlot          uint64
 	blocksAwaitingProcessing     map[[32]byte]deprecatedp2p.Message
 	blocksAwaitingProcessingLock sync.RWMutex
 	blockProcessingLock          sync.RWMutex
 	blockAnnouncements           map[uint64][]byte
 	blockAnnouncementsLock       sync.RWMutex
+	catchUpRequests              map[peer.ID]time.Time
+	catchUpRequestsLock          sync.Mutex
+	pendingPeers                 *pendingPeerUpdates
+	recentlyProcessed            *cache.RecentlyProcessedCache
+	forkMonitor                  *cache.ForkMonitor
+	genesisTimeCache             uint64
+	genesisTimeLock              sync.Mutex
 }
 
 // RegularSyncConfig allows the channel's buffer sizes to be changed.
@@ -103,12 +123,15 @@ type RegularSyncConfig struct {
 	AttestationBufferSize   int
 	ExitBufferSize          int
 	ChainHeadReqBufferSize  int
+	ChainHeadResBufferSize  int
 	CanonicalBufferSize     int
 	ChainService            chainService
 	OperationService        operations.OperationFeeds
 	AttsService             attsService
 	BeaconDB                *db.BeaconDB
 	P2P                     p2pAPI
+	RecentlyProcessed       *cache.RecentlyProcessedCache
+	ForkMonitor             *cache.ForkMonitor
 }
 
 // DefaultRegularSyncConfig provides the default configuration for a sync service.
@@ -120,6 +143,7 @@ func DefaultRegularSyncConfig() *RegularSyncConfig {
 		BatchedBufferSize:       params.BeaconConfig().DefaultBufferSize,
 		StateReqBufferSize:      params.BeaconConfig().DefaultBufferSize,
 		ChainHeadReqBufferSize:  params.BeaconConfig().DefaultBufferSize,
+		ChainHeadResBufferSize:  params.BeaconConfig().DefaultBufferSize,
 		AttestationBufferSize:   params.BeaconConfig().DefaultBufferSize,
 		ExitBufferSize:          params.BeaconConfig().DefaultBufferSize,
 		CanonicalBufferSize:     params.BeaconConfig().DefaultBufferSize,
@@ -142,13 +166,20 @@ func NewRegularSyncService(ctx context.Context, cfg *RegularSyncConfig) *Regular
 		blockBuf:                 make(chan deprecatedp2p.Message, cfg.BlockBufferSize),
 		blockRequestByHash:       make(chan deprecatedp2p.Message, cfg.BlockReqHashBufferSize),
 		batchedRequestBuf:        make(chan deprecatedp2p.Message, cfg.BatchedBufferSize),
+		batchedBlockBuf:          make(chan deprecatedp2p.Message, cfg.BatchedBufferSize),
 		stateRequestBuf:          make(chan deprecatedp2p.Message, cfg.StateReqBufferSize),
 		attestationBuf:           make(chan deprecatedp2p.Message, cfg.AttestationBufferSize),
 		exitBuf:                  make(chan deprecatedp2p.Message, cfg.ExitBufferSize),
 		chainHeadReqBuf:          make(chan deprecatedp2p.Message, cfg.ChainHeadReqBufferSize),
+		chainHeadResBuf:          make(chan deprecatedp2p.Message, cfg.ChainHeadResBufferSize),
 		canonicalBuf:             make(chan *pb.BeaconBlockAnnounce, cfg.CanonicalBufferSize),
+		disconnectBuf:            make(chan peer.ID, params.BeaconConfig().DefaultBufferSize),
 		blocksAwaitingProcessing: make(map[[32]byte]deprecatedp2p.Message),
 		blockAnnouncements:       make(map[uint64][]byte),
+		catchUpRequests:          make(map[peer.ID]time.Time),
+		pendingPeers:             newPendingPeerUpdates(),
+		recentlyProcessed:        cfg.RecentlyProcessed,
+		forkMonitor:              cfg.ForkMonitor,
 	}
 }
 
@@ -181,21 +212,36 @@ func (rs *RegularSync) run() {
 	blockSub := rs.p2p.Subscribe(&pb.BeaconBlockResponse{}, rs.blockBuf)
 	blockRequestHashSub := rs.p2p.Subscribe(&pb.BeaconBlockRequest{}, rs.blockRequestByHash)
 	batchedBlockRequestSub := rs.p2p.Subscribe(&pb.BatchedBeaconBlockRequest{}, rs.batchedRequestBuf)
+	batchedBlockSub := rs.p2p.Subscribe(&pb.BatchedBeaconBlockResponse{}, rs.batchedBlockBuf)
 	stateRequestSub := rs.p2p.Subscribe(&pb.BeaconStateRequest{}, rs.stateRequestBuf)
 	attestationSub := rs.p2p.Subscribe(&ethpb.Attestation{}, rs.attestationBuf)
 	exitSub := rs.p2p.Subscribe(&ethpb.VoluntaryExit{}, rs.exitBuf)
 	chainHeadReqSub := rs.p2p.Subscribe(&pb.ChainHeadRequest{}, rs.chainHeadReqBuf)
+	chainHeadResSub := rs.p2p.Subscribe(&pb.ChainHeadResponse{}, rs.chainHeadResBuf)
 	canonicalBlockSub := rs.chainService.CanonicalBlockFeed().Subscribe(rs.canonicalBuf)
 
 	defer announceBlockSub.Unsubscribe()
 	defer blockSub.Unsubscribe()
 	defer blockRequestHashSub.Unsubscribe()
 	defer batchedBlockRequestSub.Unsubscribe()
+	defer batchedBlockSub.Unsubscribe()
 	defer stateRequestSub.Unsubscribe()
 	defer chainHeadReqSub.Unsubscribe()
+	defer chainHeadResSub.Unsubscribe()
 	defer attestationSub.Unsubscribe()
 	defer exitSub.Unsubscribe()
 	defer canonicalBlockSub.Unsubscribe()
+	if provider, ok := rs.p2p.(peerDisconnectProvider); ok {
+		disconnectSub := provider.PeerDisconnectFeed().Subscribe(rs.disconnectBuf)
+		defer disconnectSub.Unsubscribe()
+	}
+
+	flushTicker := time.NewTicker(peerStatusFlushInterval)
+	defer flushTicker.Stop()
+	pruneTicker := time.NewTicker(peerStatusPruneInterval)
+	defer pruneTicker.Stop()
+	refreshTicker := time.NewTicker(peerStatusRefreshInterval)
+	defer refreshTicker.Stop()
 
 	log.Info("Listening for regular sync messages from peers")
 
@@ -203,7 +249,14 @@ func (rs *RegularSync) run() {
 		select {
 		case <-rs.ctx.Done():
 			log.Debug("Exiting goroutine")
+			rs.flushPeerUpdates(context.Background())
 			return
+		case <-flushTicker.C:
+			go rs.flushPeerUpdates(rs.ctx)
+		case <-pruneTicker.C:
+			go rs.prunePeerStatuses(rs.ctx)
+		case <-refreshTicker.C:
+			go rs.requestPeerHeads(rs.ctx)
 		case msg := <-rs.announceBlockBuf:
 			go safelyHandleMessage(rs.receiveBlockAnnounce, msg)
 		case msg := <-rs.attestationBuf:
@@ -216,12 +269,18 @@ func (rs *RegularSync) run() {
 			go safelyHandleMessage(rs.handleBlockRequestByHash, msg)
 		case msg := <-rs.batchedRequestBuf:
 			go safelyHandleMessage(rs.handleBatchedBlockRequest, msg)
+		case msg := <-rs.batchedBlockBuf:
+			go safelyHandleMessage(rs.receiveBatchedBlocks, msg)
 		case msg := <-rs.stateRequestBuf:
 			go safelyHandleMessage(rs.handleStateRequest, msg)
 		case msg := <-rs.chainHeadReqBuf:
 			go safelyHandleMessage(rs.handleChainHeadRequest, msg)
+		case msg := <-rs.chainHeadResBuf:
+			go safelyHandleMessage(rs.receiveChainHeadResponse, msg)
 		case blockAnnounce := <-rs.canonicalBuf:
 			go rs.broadcastCanonicalBlock(rs.ctx, blockAnnounce)
+		case pid := <-rs.disconnectBuf:
+			rs.forkMonitor.RemovePeer(pid.Pretty())
 		}
 	}
 }
@@ -434,6 +493,27 @@ func (rs *RegularSync) receiveAttestation(msg deprecatedp2p.Message) error {
 		return nil
 	}
 
+	// The attestation may have been submitted over RPC, or gossiped by several peers.
+	attestationHash, err := hashutil.HashProto(attestation)
+	if err != nil {
+		return err
+	}
+	firstSeen, err := rs.recentlyProcessed.MarkProcessed(attestationHash, cache.KindAttestation, cache.SourceGossip)
+	if err != nil {
+		return err
+	}
+	if !firstSeen {
+		log.WithField("attestationHash", fmt.Sprintf("%#x", bytesutil.Trunc(attestationHash[:]))).
+			Debug("Received a recently processed attestation")
+		return nil
+	}
+	attSlot, err := helpers.AttestationDataSlot(headState, attestation.Data)
+	if err != nil {
+		log.WithError(err).Debug("Could not compute attestation slot")
+	} else {
+		rs.recordPropagation(ctx, attestationMessage, msg.Peer, headState.GenesisTime, attSlot, roughtime.Now())
+	}
+
 	_, sendAttestationSpan := trace.StartSpan(ctx, "beacon-chain.sync.sendAttestation")
 	log.Debug("Sending newly received attestation to subscribers")
 	rs.operationsService.IncomingAttFeed().Send(attestation)
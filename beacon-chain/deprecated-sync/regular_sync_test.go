@@ -1,6 +1,7 @@
 package sync
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io/ioutil"
@@ -12,10 +13,12 @@ import (
 	"github.com/gogo/protobuf/proto"
 	peer "github.com/libp2p/go-libp2p-peer"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	p2p "github.com/prysmaticlabs/prysm/shared/deprecated-p2p"
@@ -53,6 +56,24 @@ func (mp *mockP2P) Reputation(_ peer.ID, val int) {
 
 }
 
+type mockDisconnectP2P struct {
+	mockP2P
+	disconnectFeed event.Feed
+}
+
+func (mp *mockDisconnectP2P) PeerDisconnectFeed() *event.Feed {
+	return &mp.disconnectFeed
+}
+
+type mockPeersP2P struct {
+	mockP2P
+	peers []peer.ID
+}
+
+func (mp *mockPeersP2P) Peers() []peer.ID {
+	return mp.peers
+}
+
 type mockChainService struct {
 	sFeed *event.Feed
 	cFeed *event.Feed
@@ -136,6 +157,37 @@ func setupService(db *db.BeaconDB) *RegularSync {
 	return NewRegularSyncService(context.Background(), cfg)
 }
 
+func TestRun_RemovesDisconnectedPeersFromForkMonitor(t *testing.T) {
+	monitor := cache.NewForkMonitor(nil)
+	monitor.ObserveHead(peer.ID("a").Pretty(), 5, [32]byte{'a'})
+	monitor.ObserveHead(peer.ID("b").Pretty(), 5, [32]byte{'b'})
+
+	p2pService := &mockDisconnectP2P{}
+	rs := NewRegularSyncService(context.Background(), &RegularSyncConfig{
+		ChainService: &mockChainService{},
+		P2P:          p2pService,
+		ForkMonitor:  monitor,
+	})
+	go rs.run()
+	defer rs.cancel()
+
+	for i := 0; p2pService.disconnectFeed.Send(peer.ID("b")) == 0; i++ {
+		if i == 100 {
+			t.Fatal("The regular sync did not subscribe to the peer disconnections")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	for i := 0; ; i++ {
+		if _, contested := monitor.CompetingHeads(); contested == 0 {
+			break
+		}
+		if i == 100 {
+			t.Fatal("Wanted the head of the disconnected peer to be forgotten")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
 func TestProcessBlockRoot_OK(t *testing.T) {
 	hook := logTest.NewGlobal()
 
@@ -678,3 +730,69 @@ func TestCanonicalBlockList_NilParentBlock(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestPeerForks_FromChainHeadResponses(t *testing.T) {
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+	finalized := &ethpb.BeaconBlock{Slot: 3 * params.BeaconConfig().SlotsPerEpoch}
+	if err := db.SaveBlockDeprecated(finalized); err != nil {
+		t.Fatal(err)
+	}
+	finalizedRoot, err := ssz.SigningRoot(finalized)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The status saved at initial sync is replaced by the response of the peer.
+	if err := db.SavePeerStatus(ctx, "c", &pb.Hello{FinalizedRoot: finalizedRoot[:], FinalizedEpoch: 3}); err != nil {
+		t.Fatal(err)
+	}
+	rs := NewRegularSyncService(ctx, &RegularSyncConfig{
+		ChainService: &mockChainService{},
+		P2P:          &mockPeersP2P{peers: []peer.ID{"a", "b", "c", "d"}},
+		BeaconDB:     db,
+	})
+
+	responses := map[peer.ID][]byte{
+		"a": finalizedRoot[:],
+		"b": finalizedRoot[:],
+		// A finalized block the node doesn't have.
+		"c": {'c'},
+		// Not connected.
+		"e": finalizedRoot[:],
+	}
+	for pid, root := range responses {
+		msg := p2p.Message{
+			Ctx:  ctx,
+			Peer: pid,
+			Data: &pb.ChainHeadResponse{CanonicalSlot: 100, FinalizedBlockRoot: root},
+		}
+		if err := rs.receiveChainHeadResponse(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Peer d never reported its chain head.
+	forks := rs.PeerForks()
+	want := []prysmsync.PeerFork{
+		{ForkVersion: params.BeaconConfig().GenesisForkVersion, FinalizedEpoch: 3, FinalizedRoot: finalizedRoot[:], Peers: 2},
+		{ForkVersion: params.BeaconConfig().GenesisForkVersion, FinalizedRoot: []byte{'c'}, Peers: 1},
+	}
+	if len(forks) != len(want) {
+		t.Fatalf("Wanted %d forks, got %d", len(want), len(forks))
+	}
+	for i, f := range forks {
+		if !reflect.DeepEqual(*f, want[i]) {
+			t.Errorf("Wanted fork %v, got %v", want[i], *f)
+		}
+	}
+
+	// The statuses are written to the DB with the next flush.
+	rs.flushPeerUpdates(ctx)
+	status, err := db.PeerStatus(ctx, "c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(status.FinalizedRoot, []byte{'c'}) {
+		t.Errorf("Wanted the reported finalized root to be saved, got %#x", status.FinalizedRoot)
+	}
+}
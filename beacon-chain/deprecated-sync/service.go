@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/deprecated-sync/initial-sync"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	"github.com/sirupsen/logrus"
 )
 
@@ -23,13 +25,15 @@ type Service struct {
 
 // Config defines the configured services required for sync to work.
 type Config struct {
-	ChainService     chainService
-	BeaconDB         db.Database
-	DepositCache     *depositcache.DepositCache
-	P2P              p2pAPI
-	AttsService      attsService
-	OperationService operations.OperationFeeds
-	PowChainService  powChainService
+	ChainService      chainService
+	BeaconDB          db.Database
+	DepositCache      *depositcache.DepositCache
+	P2P               p2pAPI
+	AttsService       attsService
+	OperationService  operations.OperationFeeds
+	PowChainService   powChainService
+	RecentlyProcessed *cache.RecentlyProcessedCache
+	ForkMonitor       *cache.ForkMonitor
 }
 
 // NewSyncService creates a new instance of SyncService using the config
@@ -40,6 +44,7 @@ func NewSyncService(ctx context.Context, cfg *Config) *Service {
 	sqCfg.P2P = cfg.P2P
 	sqCfg.PowChain = cfg.PowChainService
 	sqCfg.ChainService = cfg.ChainService
+	sqCfg.ForkMonitor = cfg.ForkMonitor
 
 	isCfg := initialsync.DefaultConfig()
 	isCfg.BeaconDB = cfg.BeaconDB.(*db.BeaconDB)
@@ -54,6 +59,8 @@ func NewSyncService(ctx context.Context, cfg *Config) *Service {
 	rsCfg.P2P = cfg.P2P
 	rsCfg.AttsService = cfg.AttsService
 	rsCfg.OperationService = cfg.OperationService
+	rsCfg.RecentlyProcessed = cfg.RecentlyProcessed
+	rsCfg.ForkMonitor = cfg.ForkMonitor
 
 	sq := NewQuerierService(ctx, sqCfg)
 	rs := NewRegularSyncService(ctx, rsCfg)
@@ -123,6 +130,12 @@ func (ss *Service) Syncing() bool {
 	return !isSynced
 }
 
+// PeerForks groups the connected peers by the fork they are on, from the chain heads they
+// reported to regular sync.
+func (ss *Service) PeerForks() []*prysmsync.PeerFork {
+	return ss.RegularSync.PeerForks()
+}
+
 func (ss *Service) run() {
 	ss.Querier.Start()
 
@@ -5,6 +5,11 @@ import (
 )
 
 var (
+	// ModeFlag selects a preset of flag values suited to the role of the node.
+	ModeFlag = cli.StringFlag{
+		Name:  "mode",
+		Usage: "Operating mode presetting the flags left unset: archival (keep all states to serve history), validating (prune aggressively, prioritize duty latency) or relay-only (no validator RPC, all attestation subnets with --experimental-sync)",
+	}
 	// NoCustomConfigFlag determines whether to launch a beacon chain using real parameters or demo parameters.
 	NoCustomConfigFlag = cli.BoolFlag{
 		Name:  "no-custom-config",
@@ -22,6 +27,23 @@ var (
 		Usage: "A mainchain web3 provider string endpoint. Can either be an IPC file string or a WebSocket endpoint. Cannot be an HTTP endpoint.",
 		Value: "wss://goerli.prylabs.net/websocket",
 	}
+	// Web3ProviderRequestsPerSecondFlag defines the rate limit of the requests to each web3 provider endpoint.
+	Web3ProviderRequestsPerSecondFlag = cli.Float64Flag{
+		Name:  "web3provider-requests-per-second",
+		Usage: "Maximum sustained rate of requests to each web3 provider endpoint, to stay within provider quotas. 0 means no limit",
+	}
+	// Web3ProviderMaxAttemptsFlag defines how many times a request failing with a transient error is sent to a web3 provider.
+	Web3ProviderMaxAttemptsFlag = cli.IntFlag{
+		Name:  "web3provider-max-attempts",
+		Usage: "Number of times a request to a web3 provider failing with a transient error is sent before giving up",
+		Value: 4,
+	}
+	// Web3ProviderRequestTimeoutFlag defines how long a single request to a web3 provider may take.
+	Web3ProviderRequestTimeoutFlag = cli.IntFlag{
+		Name:  "web3provider-request-timeout",
+		Usage: "Seconds a single request to a web3 provider may take before it is abandoned, counted as a failure and retried. 0 means no timeout",
+		Value: 10,
+	}
 	// DepositContractFlag defines a flag for the deposit contract address.
 	DepositContractFlag = cli.StringFlag{
 		Name:  "deposit-contract",
@@ -53,4 +75,241 @@ var (
 		Name:  "grpc-gateway-port",
 		Usage: "Enable gRPC gateway for JSON requests",
 	}
+	// MaxPendingAttestationsFlag caps the number of attestations held in the operations pool.
+	MaxPendingAttestationsFlag = cli.IntFlag{
+		Name:  "max-pending-attestations",
+		Usage: "Maximum number of pending attestations held in the operations pool, 0 for no limit",
+		Value: 8192,
+	}
+	// MaxPendingAttestationsPerSlotFlag caps the number of attestations held in the operations pool for a single slot.
+	MaxPendingAttestationsPerSlotFlag = cli.IntFlag{
+		Name:  "max-pending-attestations-per-slot",
+		Usage: "Maximum number of pending attestations held in the operations pool for a single slot, 0 for no limit",
+		Value: 512,
+	}
+	// MaxPendingExitsFlag caps the number of voluntary exits held in the operations pool.
+	MaxPendingExitsFlag = cli.IntFlag{
+		Name:  "max-pending-exits",
+		Usage: "Maximum number of pending voluntary exits held in the operations pool, 0 for no limit",
+		Value: 1024,
+	}
+	// MaxPendingSlashingsFlag caps the number of slashings of each kind held in the operations pool.
+	MaxPendingSlashingsFlag = cli.IntFlag{
+		Name:  "max-pending-slashings",
+		Usage: "Maximum number of pending proposer slashings, and of attester slashings, held in the operations pool, 0 for no limit",
+		Value: 256,
+	}
+	// OpsPoolEvictionPolicyFlag defines which pending operation is dropped once a pool limit is reached.
+	OpsPoolEvictionPolicyFlag = cli.StringFlag{
+		Name:  "ops-pool-eviction-policy",
+		Usage: "Which pending operation to drop once an operations pool limit is reached: oldest-first or lowest-value-first",
+		Value: "oldest-first",
+	}
+	// DBSnapshotFlag snapshots the database at startup, ahead of risky operations.
+	DBSnapshotFlag = cli.BoolFlag{
+		Name: "db-snapshot",
+		Usage: "Snapshot the database at startup and hold off risky operations such as pruning until it is written. " +
+			"Each snapshot is a full copy of the database",
+	}
+	// DBSnapshotRetentionFlag defines how many database snapshots are kept on disk.
+	DBSnapshotRetentionFlag = cli.IntFlag{
+		Name:  "db-snapshot-retention",
+		Usage: "Number of database snapshots, taken with --db-snapshot or on demand, kept on disk",
+		Value: 3,
+	}
+	// ForkChoiceMaxDepthFlag caps the number of blocks fork choice walks through.
+	ForkChoiceMaxDepthFlag = cli.Uint64Flag{
+		Name:  "fork-choice-max-depth",
+		Usage: "Maximum number of blocks fork choice walks through when looking for an ancestor or for the head, past which the walk fails. 0 for no limit",
+	}
+	// ForkChoiceAuditSizeFlag defines how many head changes are kept in the fork choice audit log.
+	ForkChoiceAuditSizeFlag = cli.Uint64Flag{
+		Name:  "fork-choice-audit-size",
+		Usage: "Number of head changes whose fork choice candidates, weights and tie breaks are kept in memory for the ForkChoiceAudit RPC and the fork-choice-audit admin command. Disabled when 0",
+	}
+	// BlockBroadcastPolicyFlag defines if and when processed blocks are broadcast to peers.
+	BlockBroadcastPolicyFlag = cli.StringFlag{
+		Name:  "block-broadcast-policy",
+		Usage: "When blocks processed by the node are broadcast: immediate, delayed or local-only. The blocks proposed by its validators are always broadcast immediately",
+		Value: "immediate",
+	}
+	// BlockBroadcastDelayFlag defines how long blocks are held back with the delayed broadcast policy.
+	BlockBroadcastDelayFlag = cli.IntFlag{
+		Name:  "block-broadcast-delay-ms",
+		Usage: "Number of milliseconds blocks are held back before being broadcast with the delayed block broadcast policy",
+		Value: 2000,
+	}
+	// HistoricalStateRetentionFlag defines which historical states are deleted on finalization.
+	HistoricalStateRetentionFlag = cli.StringFlag{
+		Name:  "historical-state-retention",
+		Usage: "Historical states kept in the database when a new state is finalized: keep-all, keep-finalized-only, keep-epochs (the states of the last --historical-state-retention-epochs epochs before the finalized state) or archive (one state every --historical-state-retention-epochs epochs)",
+		Value: "keep-finalized-only",
+	}
+	// HistoricalStateRetentionEpochsFlag defines the retention window or archive period of historical states.
+	HistoricalStateRetentionEpochsFlag = cli.Uint64Flag{
+		Name:  "historical-state-retention-epochs",
+		Usage: "Number of epochs of historical states kept before the finalized state with the keep-epochs retention, or between archived states with the archive retention",
+	}
+	// HistoricalStateRetentionDryRunFlag logs what the historical state retention policies would delete, without deleting anything.
+	HistoricalStateRetentionDryRunFlag = cli.BoolFlag{
+		Name:  "historical-state-retention-dry-run",
+		Usage: "Keep all historical states, and log the number of states and bytes each retention policy would delete on finalization",
+	}
+	// StaleForkDepthFlag defines how far behind the finalized checkpoint side forks are deleted.
+	StaleForkDepthFlag = cli.Uint64Flag{
+		Name:  "stale-fork-depth",
+		Usage: "Number of epochs behind the finalized checkpoint past which side forks are deleted from the database by a periodic cleanup job, for nodes which went through long partitions. 0 disables the job",
+	}
+	// StaleForkCleanupIntervalFlag defines how often stale side forks are looked for.
+	StaleForkCleanupIntervalFlag = cli.Uint64Flag{
+		Name:  "stale-fork-cleanup-interval-epochs",
+		Usage: "Number of epochs between two runs of the stale fork cleanup job",
+		Value: 8,
+	}
+	// AnalyzeEpochBalancesFlag enables the comparison of validator balances across epoch boundaries.
+	AnalyzeEpochBalancesFlag = cli.BoolFlag{
+		Name:  "analyze-epoch-balances",
+		Usage: "Compare the validator balances of consecutive epochs, and warn when a large share of the validators is penalized or the network enters an inactivity leak",
+	}
+	// MassPenaltyFractionFlag defines the share of the active validators penalized over an epoch
+	// past which the balance analysis warns about mass penalties.
+	MassPenaltyFractionFlag = cli.Float64Flag{
+		Name:  "mass-penalty-fraction",
+		Usage: "Share of the active validators penalized over an epoch past which --analyze-epoch-balances warns about mass penalties, a third if unset",
+	}
+	// AdminSocketFlag defines the path of the unix socket serving the admin commands of the node.
+	AdminSocketFlag = cli.StringFlag{
+		Name:  "admin-socket",
+		Usage: "Path of a unix socket on which local scripts can trigger-backup, set-log-level, drop-peer, force-resync, dump-goroutines and fork-choice-audit. The admin commands are disabled if empty",
+	}
+	// MinGenesisTimeFlag overrides the earliest genesis time of the beacon chain.
+	MinGenesisTimeFlag = cli.Uint64Flag{
+		Name:  "min-genesis-time",
+		Usage: "Unix time before which the beacon chain doesn't start, even if enough validators deposited, to coordinate testnet launches",
+	}
+	// GenesisDelayFlag overrides the delay between the eth1 block triggering ChainStart and genesis.
+	GenesisDelayFlag = cli.Uint64Flag{
+		Name:  "genesis-delay",
+		Usage: "Number of seconds from the eth1 block triggering ChainStart to genesis. Delays of a day or more are rounded down to the start of a day, as specified",
+	}
+	// MaxSkipSlotsFlag bounds the number of empty slots processed at once for an incoming block.
+	MaxSkipSlotsFlag = cli.Uint64Flag{
+		Name:  "max-skip-slots",
+		Usage: "Maximum number of empty slots processed at once for an incoming block. Blocks further ahead of their parent are rejected with an error, a chunk of their empty slots being processed at every attempt. 0 disables the limit",
+		Value: 1024,
+	}
+	// ShutdownBroadcastGracePeriodFlag bounds how long shutdown waits for critical messages to be sent to peers.
+	ShutdownBroadcastGracePeriodFlag = cli.IntFlag{
+		Name:  "shutdown-broadcast-grace-ms",
+		Usage: "Maximum number of milliseconds the node waits at shutdown for the blocks, slashings and voluntary exits being broadcast to be sent to peers. 0 disables the wait",
+		Value: 2000,
+	}
+	// DisableVoluntaryExitGossipFlag stops the node from subscribing to and relaying voluntary exits.
+	DisableVoluntaryExitGossipFlag = cli.BoolFlag{
+		Name:  "disable-voluntary-exit-gossip",
+		Usage: "Do not subscribe to the voluntary exit gossip topic, so that voluntary exits from peers are neither added to the operations pool nor relayed. Requires --experimental-sync",
+	}
+	// DisableProposerSlashingGossipFlag stops the node from subscribing to and relaying proposer slashings.
+	DisableProposerSlashingGossipFlag = cli.BoolFlag{
+		Name:  "disable-proposer-slashing-gossip",
+		Usage: "Do not subscribe to the proposer slashing gossip topic, so that proposer slashings from peers are neither added to the operations pool nor relayed. Requires --experimental-sync",
+	}
+	// DisableAttesterSlashingGossipFlag stops the node from subscribing to and relaying attester slashings.
+	DisableAttesterSlashingGossipFlag = cli.BoolFlag{
+		Name:  "disable-attester-slashing-gossip",
+		Usage: "Do not subscribe to the attester slashing gossip topic, so that attester slashings from peers are neither added to the operations pool nor relayed. Requires --experimental-sync",
+	}
+	// MonitorDepositsFlag enables the deposit inclusion monitor.
+	MonitorDepositsFlag = cli.BoolFlag{
+		Name:  "monitor-deposits",
+		Usage: "Follow every deposit made to the eth1 deposit contract until it is included in the beacon chain, to export its inclusion latency and list the stuck deposits. Reads the head state every slot",
+	}
+	// DepositInclusionWindowFlag defines how long a deposit may wait for inclusion before being reported as stuck.
+	DepositInclusionWindowFlag = cli.Uint64Flag{
+		Name:  "deposit-inclusion-window-minutes",
+		Usage: "Number of minutes after which a deposit made to the eth1 deposit contract and not included in the beacon chain is reported as stuck, with --monitor-deposits. 0 derives the window from the eth1 follow distance and voting period",
+	}
+	// RPCQuotasFileFlag defines the file of the API keys allowed to use the RPC server, and their quotas.
+	RPCQuotasFileFlag = cli.StringFlag{
+		Name:  "rpc-quotas-file",
+		Usage: "JSON file listing the API keys clients must send in the x-api-key gRPC metadata, or HTTP header through the gateway, with their name, requests_per_minute, max_streams and max_duties_pubkeys quotas. Any client is served when unset",
+	}
+	// EnableReplicationFlag lets read-only replicas stream the finalized chain from the RPC server.
+	EnableReplicationFlag = cli.BoolFlag{
+		Name:  "enable-replication",
+		Usage: "Serve the finalized blocks and states to read-only replicas started with --replica-of this node's RPC server",
+	}
+	// ReplicaOfFlag runs the node as a read-only replica of another beacon node.
+	ReplicaOfFlag = cli.StringFlag{
+		Name:  "replica-of",
+		Usage: "host:port of the RPC server of a beacon node started with --enable-replication. The node then replicates its finalized chain instead of syncing from the network, and only serves the Node and BeaconChain RPC services. Requires the legacy database",
+	}
+	// ReplicaCertFlag defines the certificate of the RPC server of the primary node of a replica.
+	ReplicaCertFlag = cli.StringFlag{
+		Name:  "replica-of-tls-cert",
+		Usage: "Certificate of the RPC server of the node given to --replica-of, for a secure connection",
+	}
+	// ReplicaStateIntervalFlag defines how often a replica receives the finalized state.
+	ReplicaStateIntervalFlag = cli.Uint64Flag{
+		Name:  "replica-state-interval",
+		Usage: "Minimum number of epochs between two finalized states replicated from the node given to --replica-of",
+		Value: 1,
+	}
+	// ReplicaAPIKeyFlag defines the API key a replica sends to its primary node.
+	ReplicaAPIKeyFlag = cli.StringFlag{
+		Name:  "replica-of-api-key",
+		Usage: "API key sent in the x-api-key gRPC metadata to the node given to --replica-of, for nodes started with --rpc-quotas-file",
+	}
+	// DisableValidatorRPCFlag stops the node from serving the RPC services used by validator clients.
+	DisableValidatorRPCFlag = cli.BoolFlag{
+		Name:  "disable-validator-rpc",
+		Usage: "Do not serve the beacon, proposer, attester and validator RPC services used by validator clients, only the node and beacon chain services",
+	}
+	// SubnetBackboneFlag keeps the node subscribed to a few random attestation subnets.
+	SubnetBackboneFlag = cli.BoolFlag{
+		Name:  "attestation-subnet-backbone",
+		Usage: "Stay subscribed to a few random attestation subnets, renewed every few epochs and advertised to peers, as part of the backbone of the subnets. Requires --experimental-sync",
+	}
+	// SubscribeAllSubnetsFlag subscribes the node to every attestation subnet.
+	SubscribeAllSubnetsFlag = cli.BoolFlag{
+		Name:  "subscribe-all-subnets",
+		Usage: "Subscribe to every attestation subnet instead of a few random ones, to relay all attestations. Requires --experimental-sync",
+	}
+)
+
+// ResearchFlags inject faults into the networking of the node, to study their effects in
+// controlled testnets. They are hidden from the help, and must never be set on a node serving
+// validators.
+var ResearchFlags = []cli.Flag{
+	ResearchGossipBlockDelayFlag,
+	ResearchGossipBlockDropRateFlag,
+	ResearchGossipAttestationDelayFlag,
+	ResearchGossipAttestationDropRateFlag,
+}
+
+var (
+	// ResearchGossipBlockDelayFlag delays the gossiped blocks before they are relayed and processed.
+	ResearchGossipBlockDelayFlag = cli.IntFlag{
+		Name:   "research-gossip-block-delay-ms",
+		Usage:  "Number of milliseconds every block received from gossip is held back before being relayed to peers and processed. For research nodes only",
+		Hidden: true,
+	}
+	// ResearchGossipBlockDropRateFlag drops a share of the gossiped blocks.
+	ResearchGossipBlockDropRateFlag = cli.Float64Flag{
+		Name:   "research-gossip-block-drop-rate",
+		Usage:  "Probability, between 0 and 1, of a block received from gossip being dropped, neither relayed to peers nor processed. For research nodes only",
+		Hidden: true,
+	}
+	// ResearchGossipAttestationDelayFlag delays the gossiped attestations before they are relayed and processed.
+	ResearchGossipAttestationDelayFlag = cli.IntFlag{
+		Name:   "research-gossip-attestation-delay-ms",
+		Usage:  "Number of milliseconds every attestation received from gossip is held back before being relayed to peers and processed. For research nodes only",
+		Hidden: true,
+	}
+	// ResearchGossipAttestationDropRateFlag drops a share of the gossiped attestations.
+	ResearchGossipAttestationDropRateFlag = cli.Float64Flag{
+		Name:   "research-gossip-attestation-drop-rate",
+		Usage:  "Probability, between 0 and 1, of an attestation received from gossip being dropped, neither relayed to peers nor processed. For research nodes only",
+		Hidden: true,
+	}
 )
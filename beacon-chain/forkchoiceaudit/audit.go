@@ -0,0 +1,121 @@
+// Package forkchoiceaudit records why the fork choice rule changed the head of the chain, for
+// both the legacy and the new blockchain services, so that operators can inspect the decisions
+// behind a reorg.
+package forkchoiceaudit
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Candidate is a child block weighed by the fork choice rule.
+type Candidate struct {
+	Root string `json:"root"`
+	Slot uint64 `json:"slot"`
+	// Weight is the number of latest votes for the block with the legacy fork choice, and the
+	// attesting balance of the block in Gwei with the new one.
+	Weight uint64 `json:"weight"`
+}
+
+// Step is the choice of the heaviest child of a block, on the way from the justified block to
+// the head.
+type Step struct {
+	Parent     string       `json:"parent"`
+	Candidates []*Candidate `json:"candidates"`
+	Chosen     string       `json:"chosen"`
+	// TieBreak is set when several candidates had the highest weight, the chosen one being the
+	// candidate with the lexicographically highest root.
+	TieBreak bool `json:"tie_break"`
+}
+
+// NewStep records the choice of a child among the candidates, given with their weights.
+func NewStep(parent [32]byte, candidates []*Candidate, chosen [32]byte) *Step {
+	step := &Step{
+		Parent:     Root(parent),
+		Candidates: candidates,
+		Chosen:     Root(chosen),
+	}
+	var chosenWeight uint64
+	for _, c := range candidates {
+		if c.Root == step.Chosen {
+			chosenWeight = c.Weight
+		}
+	}
+	tied := 0
+	for _, c := range candidates {
+		if c.Weight == chosenWeight {
+			tied++
+		}
+	}
+	step.TieBreak = tied > 1
+	return step
+}
+
+// Decision records why the fork choice rule changed the head of the chain.
+type Decision struct {
+	Time          time.Time `json:"time"`
+	JustifiedRoot string    `json:"justified_root"`
+	JustifiedSlot uint64    `json:"justified_slot"`
+	PreviousHead  string    `json:"previous_head"`
+	PreviousSlot  uint64    `json:"previous_slot"`
+	NewHead       string    `json:"new_head"`
+	NewSlot       uint64    `json:"new_slot"`
+	Reorg         bool      `json:"reorg"`
+	Steps         []*Step   `json:"steps"`
+}
+
+// Root formats a block root as recorded in the decisions.
+func Root(root [32]byte) string {
+	return fmt.Sprintf("%#x", root)
+}
+
+// Log keeps the latest head changes in a ring buffer. It is safe for concurrent use.
+type Log struct {
+	lock      sync.Mutex
+	decisions []*Decision
+	next      int
+	full      bool
+}
+
+// NewLog creates a log retaining the given number of head changes, or nil if size is 0.
+func NewLog(size uint64) *Log {
+	if size == 0 {
+		return nil
+	}
+	return &Log{decisions: make([]*Decision, size)}
+}
+
+// Record adds a decision, overwriting the oldest one once the buffer is full.
+func (l *Log) Record(decision *Decision) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.decisions[l.next] = decision
+	l.next = (l.next + 1) % len(l.decisions)
+	if l.next == 0 {
+		l.full = true
+	}
+}
+
+// Latest returns up to count of the most recent decisions, oldest first, all of the retained
+// ones if count is 0. A nil log returns nil.
+func (l *Log) Latest(count int) []*Decision {
+	if l == nil {
+		return nil
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	size := l.next
+	if l.full {
+		size = len(l.decisions)
+	}
+	if count <= 0 || count > size {
+		count = size
+	}
+	decisions := make([]*Decision, count)
+	for i := range decisions {
+		idx := (l.next - count + i + len(l.decisions)) % len(l.decisions)
+		decisions[i] = l.decisions[idx]
+	}
+	return decisions
+}
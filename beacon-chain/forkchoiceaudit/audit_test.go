@@ -0,0 +1,55 @@
+package forkchoiceaudit
+
+import (
+	"testing"
+)
+
+func TestLog_RingBuffer(t *testing.T) {
+	audit := NewLog(3)
+	if decisions := audit.Latest(0); decisions == nil || len(decisions) != 0 {
+		t.Fatalf("Wanted no decisions, got %v", decisions)
+	}
+	for slot := uint64(1); slot <= 5; slot++ {
+		audit.Record(&Decision{NewSlot: slot})
+	}
+
+	decisions := audit.Latest(0)
+	if len(decisions) != 3 {
+		t.Fatalf("Wanted 3 decisions, got %d", len(decisions))
+	}
+	for i, want := range []uint64{3, 4, 5} {
+		if decisions[i].NewSlot != want {
+			t.Errorf("Wanted decision %d at slot %d, got %d", i, want, decisions[i].NewSlot)
+		}
+	}
+	decisions = audit.Latest(2)
+	if len(decisions) != 2 || decisions[0].NewSlot != 4 || decisions[1].NewSlot != 5 {
+		t.Errorf("Wanted the decisions at slots 4 and 5, got %v", decisions)
+	}
+
+	if NewLog(0).Latest(0) != nil {
+		t.Error("Wanted a disabled log to return nil")
+	}
+}
+
+func TestNewStep_TieBreak(t *testing.T) {
+	a, b, c := [32]byte{'a'}, [32]byte{'b'}, [32]byte{'c'}
+	candidates := func(weights ...uint64) []*Candidate {
+		return []*Candidate{
+			{Root: Root(a), Slot: 2, Weight: weights[0]},
+			{Root: Root(b), Slot: 2, Weight: weights[1]},
+			{Root: Root(c), Slot: 3, Weight: weights[2]},
+		}
+	}
+
+	step := NewStep([32]byte{'p'}, candidates(5, 5, 2), b)
+	if step.Chosen != Root(b) || step.Parent != Root([32]byte{'p'}) {
+		t.Errorf("Wanted chosen root %s, got %s", Root(b), step.Chosen)
+	}
+	if !step.TieBreak {
+		t.Error("Wanted a tie break between the candidates with weight 5")
+	}
+	if step := NewStep([32]byte{'p'}, candidates(5, 7, 2), b); step.TieBreak {
+		t.Error("Wanted no tie break for a single heaviest candidate")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -16,6 +17,10 @@ import (
 
 var _ = shared.Service(&Gateway{})
 
+// apiKeyHeader is the header HTTP clients send their API key in. It is forwarded as is in the gRPC
+// metadata, where the RPC server expects it when enforcing quotas.
+const apiKeyHeader = "x-api-key"
+
 // Gateway is the gRPC gateway to serve HTTP JSON traffic as a proxy and forward
 // it to the beacon-chain gRPC server.
 type Gateway struct {
@@ -47,7 +52,7 @@ func (g *Gateway) Start() {
 
 	g.conn = conn
 
-	gwmux := gwruntime.NewServeMux()
+	gwmux := gwruntime.NewServeMux(gwruntime.WithIncomingHeaderMatcher(incomingHeaderMatcher))
 	for _, f := range []func(context.Context, *gwruntime.ServeMux, *grpc.ClientConn) error{
 		pb.RegisterBeaconServiceHandler,
 	} {
@@ -116,6 +121,15 @@ func New(ctx context.Context, remoteAddress, gatewayAddress string, mux *http.Se
 	}
 }
 
+// incomingHeaderMatcher forwards the API key header to the gRPC server, along with the headers
+// forwarded by default.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, apiKeyHeader) {
+		return apiKeyHeader, true
+	}
+	return gwruntime.DefaultHeaderMatcher(key)
+}
+
 // dial the gRPC server.
 func dial(ctx context.Context, network, addr string) (*grpc.ClientConn, error) {
 	switch network {
@@ -83,6 +83,21 @@ func (mr *MockBeaconServiceServerMockRecorder) CanonicalHead(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanonicalHead", reflect.TypeOf((*MockBeaconServiceServer)(nil).CanonicalHead), arg0, arg1)
 }
 
+// ChainStartStatus mocks base method
+func (m *MockBeaconServiceServer) ChainStartStatus(arg0 context.Context, arg1 *types.Empty) (*v1.ChainStartStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChainStartStatus", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ChainStartStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChainStartStatus indicates an expected call of ChainStartStatus
+func (mr *MockBeaconServiceServerMockRecorder) ChainStartStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainStartStatus", reflect.TypeOf((*MockBeaconServiceServer)(nil).ChainStartStatus), arg0, arg1)
+}
+
 // WaitForChainStart mocks base method
 func (m *MockBeaconServiceServer) WaitForChainStart(arg0 *types.Empty, arg1 v1.BeaconService_WaitForChainStartServer) error {
 	m.ctrl.T.Helper()
@@ -24,11 +24,11 @@ func SetupDBDeprecated(t testing.TB) *db.BeaconDB {
 	if err := os.RemoveAll(path); err != nil {
 		t.Fatalf("Failed to remove directory: %v", err)
 	}
-	db, err := db.NewDBDeprecated(path)
+	beaconDB, err := db.NewDBDeprecated(path)
 	if err != nil {
 		t.Fatalf("Could not setup DB: %v", err)
 	}
-	return db
+	return beaconDB
 }
 
 // TeardownDBDeprecated cleans up a BeaconDB instance.
@@ -51,6 +51,21 @@ func (mr *MockValidatorServiceServerMockRecorder) CommitteeAssignment(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitteeAssignment", reflect.TypeOf((*MockValidatorServiceServer)(nil).CommitteeAssignment), arg0, arg1)
 }
 
+// CommitteeAssignmentProof mocks base method
+func (m *MockValidatorServiceServer) CommitteeAssignmentProof(arg0 context.Context, arg1 *v1.AssignmentProofRequest) (*v1.AssignmentProofResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitteeAssignmentProof", arg0, arg1)
+	ret0, _ := ret[0].(*v1.AssignmentProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitteeAssignmentProof indicates an expected call of CommitteeAssignmentProof
+func (mr *MockValidatorServiceServerMockRecorder) CommitteeAssignmentProof(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitteeAssignmentProof", reflect.TypeOf((*MockValidatorServiceServer)(nil).CommitteeAssignmentProof), arg0, arg1)
+}
+
 // DomainData mocks base method
 func (m *MockValidatorServiceServer) DomainData(arg0 context.Context, arg1 *v1.DomainRequest) (*v1.DomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +96,20 @@ func (mr *MockValidatorServiceServerMockRecorder) ExitedValidators(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExitedValidators", reflect.TypeOf((*MockValidatorServiceServer)(nil).ExitedValidators), arg0, arg1)
 }
 
+// StreamDuties mocks base method
+func (m *MockValidatorServiceServer) StreamDuties(arg0 *v1.AssignmentRequest, arg1 v1.ValidatorService_StreamDutiesServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamDuties", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamDuties indicates an expected call of StreamDuties
+func (mr *MockValidatorServiceServerMockRecorder) StreamDuties(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamDuties", reflect.TypeOf((*MockValidatorServiceServer)(nil).StreamDuties), arg0, arg1)
+}
+
 // ValidatorIndex mocks base method
 func (m *MockValidatorServiceServer) ValidatorIndex(arg0 context.Context, arg1 *v1.ValidatorIndexRequest) (*v1.ValidatorIndexResponse, error) {
 	m.ctrl.T.Helper()
@@ -111,6 +140,21 @@ func (mr *MockValidatorServiceServerMockRecorder) ValidatorPerformance(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorPerformance", reflect.TypeOf((*MockValidatorServiceServer)(nil).ValidatorPerformance), arg0, arg1)
 }
 
+// ValidatorQueue mocks base method
+func (m *MockValidatorServiceServer) ValidatorQueue(arg0 context.Context, arg1 *v1.ValidatorIndexRequest) (*v1.ValidatorQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatorQueue", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ValidatorQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorQueue indicates an expected call of ValidatorQueue
+func (mr *MockValidatorServiceServerMockRecorder) ValidatorQueue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorQueue", reflect.TypeOf((*MockValidatorServiceServer)(nil).ValidatorQueue), arg0, arg1)
+}
+
 // ValidatorStatus mocks base method
 func (m *MockValidatorServiceServer) ValidatorStatus(arg0 context.Context, arg1 *v1.ValidatorIndexRequest) (*v1.ValidatorStatusResponse, error) {
 	m.ctrl.T.Helper()
@@ -21,15 +21,56 @@ import (
 )
 
 var appFlags = []cli.Flag{
+	flags.ModeFlag,
 	flags.NoCustomConfigFlag,
 	flags.DepositContractFlag,
 	flags.Web3ProviderFlag,
 	flags.HTTPWeb3ProviderFlag,
+	flags.Web3ProviderRequestsPerSecondFlag,
+	flags.Web3ProviderMaxAttemptsFlag,
+	flags.Web3ProviderRequestTimeoutFlag,
 	flags.RPCPort,
 	flags.CertFlag,
 	flags.KeyFlag,
+	flags.RPCQuotasFileFlag,
+	flags.EnableReplicationFlag,
+	flags.ReplicaOfFlag,
+	flags.ReplicaCertFlag,
+	flags.ReplicaStateIntervalFlag,
+	flags.ReplicaAPIKeyFlag,
+	flags.DisableValidatorRPCFlag,
+	flags.SubnetBackboneFlag,
+	flags.SubscribeAllSubnetsFlag,
 	flags.EnableDBCleanup,
 	flags.GRPCGatewayPort,
+	flags.MaxPendingAttestationsFlag,
+	flags.MaxPendingAttestationsPerSlotFlag,
+	flags.MaxPendingExitsFlag,
+	flags.MaxPendingSlashingsFlag,
+	flags.OpsPoolEvictionPolicyFlag,
+	flags.DBSnapshotFlag,
+	flags.DBSnapshotRetentionFlag,
+	flags.ForkChoiceMaxDepthFlag,
+	flags.ForkChoiceAuditSizeFlag,
+	flags.BlockBroadcastPolicyFlag,
+	flags.BlockBroadcastDelayFlag,
+	flags.HistoricalStateRetentionFlag,
+	flags.HistoricalStateRetentionEpochsFlag,
+	flags.HistoricalStateRetentionDryRunFlag,
+	flags.StaleForkDepthFlag,
+	flags.StaleForkCleanupIntervalFlag,
+	flags.AnalyzeEpochBalancesFlag,
+	flags.MassPenaltyFractionFlag,
+	flags.AdminSocketFlag,
+	flags.MinGenesisTimeFlag,
+	flags.GenesisDelayFlag,
+	flags.MaxSkipSlotsFlag,
+	flags.ShutdownBroadcastGracePeriodFlag,
+	flags.DisableVoluntaryExitGossipFlag,
+	flags.DisableProposerSlashingGossipFlag,
+	flags.DisableAttesterSlashingGossipFlag,
+	flags.MonitorDepositsFlag,
+	flags.DepositInclusionWindowFlag,
 	cmd.BootstrapNode,
 	cmd.NoDiscovery,
 	cmd.StaticPeers,
@@ -40,6 +81,7 @@ var appFlags = []cli.Flag{
 	cmd.P2PPrivKey,
 	cmd.P2PWhitelist,
 	cmd.P2PEncoding,
+	cmd.P2PIPPreference,
 	cmd.DataDirFlag,
 	cmd.VerbosityFlag,
 	cmd.EnableTracingFlag,
@@ -73,7 +115,8 @@ func main() {
 	app.Action = startNode
 	app.Version = version.GetVersion()
 
-	app.Flags = appFlags
+	// The research flags are left out of appFlags, which is the source of the help.
+	app.Flags = append(appFlags, flags.ResearchFlags...)
 
 	app.Before = func(ctx *cli.Context) error {
 		format := ctx.GlobalString(cmd.LogFormat.Name)
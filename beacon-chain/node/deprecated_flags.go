@@ -0,0 +1,26 @@
+package node
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/urfave/cli"
+)
+
+// applyDeprecatedFlags maps the deprecated flags set on the command line to the flags which
+// replaced them, unless those are set too.
+func applyDeprecatedFlags(ctx *cli.Context) error {
+	if ctx.GlobalBool(featureconfig.DisableHistoricalStatePruningFlag.Name) {
+		if ctx.GlobalIsSet(flags.HistoricalStateRetentionFlag.Name) {
+			log.Warnf("--%s is deprecated and ignored, as --%s is set",
+				featureconfig.DisableHistoricalStatePruningFlag.Name, flags.HistoricalStateRetentionFlag.Name)
+			return nil
+		}
+		log.Warnf("--%s is deprecated, use --%s=keep-all instead",
+			featureconfig.DisableHistoricalStatePruningFlag.Name, flags.HistoricalStateRetentionFlag.Name)
+		if err := ctx.GlobalSet(flags.HistoricalStateRetentionFlag.Name, "keep-all"); err != nil {
+			return errors.Wrapf(err, "could not set --%s", flags.HistoricalStateRetentionFlag.Name)
+		}
+	}
+	return nil
+}
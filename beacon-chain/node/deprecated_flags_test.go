@@ -0,0 +1,25 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+)
+
+func TestApplyDeprecatedFlags_DisableHistoricalStatePruning(t *testing.T) {
+	ctx := modeContext(t, []string{"--disable-historical-state-pruning"})
+	if err := applyDeprecatedFlags(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if retention := ctx.GlobalString(flags.HistoricalStateRetentionFlag.Name); retention != "keep-all" {
+		t.Errorf("Wanted the deprecated flag to keep all states, got %s", retention)
+	}
+
+	ctx = modeContext(t, []string{"--disable-historical-state-pruning", "--historical-state-retention=keep-epochs"})
+	if err := applyDeprecatedFlags(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if retention := ctx.GlobalString(flags.HistoricalStateRetentionFlag.Name); retention != "keep-epochs" {
+		t.Errorf("Wanted the retention set on the command line to take precedence, got %s", retention)
+	}
+}
@@ -0,0 +1,128 @@
+package node
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// modePresets are the flag values set by each operating mode of the --mode flag.
+var modePresets = map[string]map[string]string{
+	// Archival nodes keep every state so that any historical query can be answered.
+	"archival": {
+		flags.HistoricalStateRetentionFlag.Name: "keep-all",
+		flags.StaleForkDepthFlag.Name:           "0",
+	},
+	// Validating nodes keep their database small and serve duties without delay.
+	"validating": {
+		flags.HistoricalStateRetentionFlag.Name: "keep-finalized-only",
+		flags.EnableDBCleanup.Name:              "true",
+		flags.StaleForkDepthFlag.Name:           "2",
+		flags.StaleForkCleanupIntervalFlag.Name: "4",
+	},
+	// Relay-only nodes serve the network rather than validators. They subscribe to every
+	// attestation subnet with the new sync service only.
+	"relay-only": {
+		flags.HistoricalStateRetentionFlag.Name: "keep-finalized-only",
+		flags.EnableDBCleanup.Name:              "true",
+		flags.DisableValidatorRPCFlag.Name:      "true",
+		flags.SubscribeAllSubnetsFlag.Name:      "true",
+	},
+}
+
+// applyMode sets the flags preset by the operating mode given to --mode. Flags set on the
+// command line take precedence over the preset, and the flags only the new sync service
+// implements are left unset when it isn't enabled. The feature config must be initialized.
+func applyMode(ctx *cli.Context) error {
+	mode := ctx.GlobalString(flags.ModeFlag.Name)
+	if mode == "" {
+		return nil
+	}
+	preset, ok := modePresets[mode]
+	if !ok {
+		modes := make([]string, 0, len(modePresets))
+		for name := range modePresets {
+			modes = append(modes, name)
+		}
+		sort.Strings(modes)
+		return fmt.Errorf("unknown mode %q, expected one of %s", mode, strings.Join(modes, ", "))
+	}
+	names := make([]string, 0, len(preset))
+	for name := range preset {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if ctx.GlobalIsSet(name) {
+			log.WithFields(logrus.Fields{
+				"mode":  mode,
+				"flag":  name,
+				"value": ctx.GlobalGeneric(name),
+			}).Warn("Flag set on the command line overrides the operating mode preset")
+			continue
+		}
+		if !featureconfig.FeatureConfig().UseNewSync && isNewSyncFlag(name) {
+			log.WithFields(logrus.Fields{
+				"mode": mode,
+				"flag": name,
+			}).Info("Not presetting a flag the new sync service isn't enabled for")
+			continue
+		}
+		if err := ctx.GlobalSet(name, preset[name]); err != nil {
+			return errors.Wrapf(err, "could not preset --%s for mode %s", name, mode)
+		}
+	}
+	log.WithField("mode", mode).Info("Using operating mode presets")
+	return nil
+}
+
+// newSyncFlags are the flags only the new sync service implements.
+var newSyncFlags = []string{
+	flags.SubnetBackboneFlag.Name,
+	flags.SubscribeAllSubnetsFlag.Name,
+	flags.DisableVoluntaryExitGossipFlag.Name,
+	flags.DisableProposerSlashingGossipFlag.Name,
+	flags.DisableAttesterSlashingGossipFlag.Name,
+}
+
+func isNewSyncFlag(name string) bool {
+	for _, n := range newSyncFlags {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// checkNewSyncFlags rejects the flags only the new sync service implements when it isn't
+// enabled.
+func checkNewSyncFlags(ctx *cli.Context) error {
+	if featureconfig.FeatureConfig().UseNewSync {
+		return nil
+	}
+	for _, name := range newSyncFlags {
+		if ctx.GlobalBool(name) {
+			return fmt.Errorf("--%s requires --%s", name, featureconfig.UseNewSyncFlag.Name)
+		}
+	}
+	return nil
+}
+
+// checkNewP2PFlags rejects the flags only the new p2p service implements when it isn't enabled.
+// The IP preference has a default value, so it is only rejected when set on the command line.
+func checkNewP2PFlags(ctx *cli.Context) error {
+	if featureconfig.FeatureConfig().UseNewP2P {
+		return nil
+	}
+	if ctx.GlobalIsSet(cmd.P2PIPPreference.Name) {
+		return fmt.Errorf("--%s requires --%s", cmd.P2PIPPreference.Name, featureconfig.UseNewP2PFlag.Name)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package node
+
+import (
+	"flag"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/urfave/cli"
+)
+
+func modeContext(t *testing.T, args []string) *cli.Context {
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.ModeFlag.Name, "", "")
+	set.String(flags.HistoricalStateRetentionFlag.Name, flags.HistoricalStateRetentionFlag.Value, "")
+	set.Bool(flags.EnableDBCleanup.Name, false, "")
+	set.Uint64(flags.StaleForkDepthFlag.Name, 0, "")
+	set.Uint64(flags.StaleForkCleanupIntervalFlag.Name, flags.StaleForkCleanupIntervalFlag.Value, "")
+	set.String(flags.BlockBroadcastPolicyFlag.Name, flags.BlockBroadcastPolicyFlag.Value, "")
+	set.Bool(flags.DisableValidatorRPCFlag.Name, false, "")
+	set.Bool(flags.SubnetBackboneFlag.Name, false, "")
+	set.Bool(flags.SubscribeAllSubnetsFlag.Name, false, "")
+	set.Bool(flags.DisableVoluntaryExitGossipFlag.Name, false, "")
+	set.Bool(flags.DisableProposerSlashingGossipFlag.Name, false, "")
+	set.Bool(flags.DisableAttesterSlashingGossipFlag.Name, false, "")
+	set.Bool(featureconfig.DisableHistoricalStatePruningFlag.Name, false, "")
+	set.String(cmd.P2PIPPreference.Name, cmd.P2PIPPreference.Value, "")
+	if err := set.Parse(args); err != nil {
+		t.Fatal(err)
+	}
+	return cli.NewContext(cli.NewApp(), set, nil)
+}
+
+func TestApplyMode_Presets(t *testing.T) {
+	defer featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{UseNewSync: true})
+	ctx := modeContext(t, []string{"--mode=relay-only"})
+	if err := applyMode(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !ctx.GlobalBool(flags.DisableValidatorRPCFlag.Name) || !ctx.GlobalBool(flags.SubscribeAllSubnetsFlag.Name) {
+		t.Error("Wanted relay-only mode to disable the validator RPC and subscribe to all subnets")
+	}
+	if !ctx.GlobalBool(flags.EnableDBCleanup.Name) {
+		t.Error("Wanted relay-only mode to enable the database cleanup")
+	}
+
+	ctx = modeContext(t, []string{"--mode=archival"})
+	if err := applyMode(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if retention := ctx.GlobalString(flags.HistoricalStateRetentionFlag.Name); retention != "keep-all" {
+		t.Errorf("Wanted archival mode to keep all states, got %s", retention)
+	}
+	if ctx.GlobalBool(flags.DisableValidatorRPCFlag.Name) {
+		t.Error("Wanted archival mode to serve validators")
+	}
+}
+
+func TestApplyMode_DefaultFeatures(t *testing.T) {
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
+	for mode := range modePresets {
+		ctx := modeContext(t, []string{"--mode=" + mode})
+		if err := applyMode(ctx); err != nil {
+			t.Fatalf("Wanted mode %s to apply, got %v", mode, err)
+		}
+		if err := checkNewSyncFlags(ctx); err != nil {
+			t.Errorf("Wanted mode %s to work with the default sync, got %v", mode, err)
+		}
+	}
+
+	ctx := modeContext(t, []string{"--mode=relay-only"})
+	if err := applyMode(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if ctx.GlobalBool(flags.SubscribeAllSubnetsFlag.Name) {
+		t.Error("Wanted relay-only mode not to subscribe to all subnets without the new sync")
+	}
+	if !ctx.GlobalBool(flags.DisableValidatorRPCFlag.Name) {
+		t.Error("Wanted relay-only mode to disable the validator RPC without the new sync")
+	}
+}
+
+func TestApplyMode_CommandLineOverridesPreset(t *testing.T) {
+	ctx := modeContext(t, []string{"--mode=validating", "--stale-fork-depth=10"})
+	if err := applyMode(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if depth := ctx.GlobalUint64(flags.StaleForkDepthFlag.Name); depth != 10 {
+		t.Errorf("Wanted stale fork depth 10 from the command line, got %d", depth)
+	}
+	if interval := ctx.GlobalUint64(flags.StaleForkCleanupIntervalFlag.Name); interval != 4 {
+		t.Errorf("Wanted stale fork cleanup interval 4 from the preset, got %d", interval)
+	}
+}
+
+func TestApplyMode_Unknown(t *testing.T) {
+	if err := applyMode(modeContext(t, []string{"--mode=light"})); err == nil || !strings.Contains(err.Error(), "unknown mode") {
+		t.Errorf("Wanted unknown mode error, got %v", err)
+	}
+	if err := applyMode(modeContext(t, nil)); err != nil {
+		t.Errorf("Wanted no error without a mode, got %v", err)
+	}
+}
+
+func TestCheckNewSyncFlags(t *testing.T) {
+	defer featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
+
+	ctx := modeContext(t, []string{"--subscribe-all-subnets"})
+	if err := checkNewSyncFlags(ctx); err == nil || !strings.Contains(err.Error(), "--subscribe-all-subnets requires") {
+		t.Errorf("Wanted --subscribe-all-subnets to require the new sync, got %v", err)
+	}
+	if err := checkNewSyncFlags(modeContext(t, []string{"--attestation-subnet-backbone"})); err == nil || !strings.Contains(err.Error(), "--attestation-subnet-backbone requires") {
+		t.Errorf("Wanted --attestation-subnet-backbone to require the new sync, got %v", err)
+	}
+	if err := checkNewSyncFlags(modeContext(t, []string{"--disable-voluntary-exit-gossip"})); err == nil || !strings.Contains(err.Error(), "--disable-voluntary-exit-gossip requires") {
+		t.Errorf("Wanted --disable-voluntary-exit-gossip to require the new sync, got %v", err)
+	}
+	if err := checkNewSyncFlags(modeContext(t, []string{"--mode=validating"})); err != nil {
+		t.Errorf("Wanted validating mode without the new sync, got %v", err)
+	}
+
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{UseNewSync: true})
+	if err := checkNewSyncFlags(ctx); err != nil {
+		t.Errorf("Wanted --subscribe-all-subnets with the new sync, got %v", err)
+	}
+}
+
+func TestCheckNewP2PFlags(t *testing.T) {
+	defer featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
+
+	ctx := modeContext(t, []string{"--p2p-ip-preference=ipv6"})
+	if err := checkNewP2PFlags(ctx); err == nil || !strings.Contains(err.Error(), "--p2p-ip-preference requires") {
+		t.Errorf("Wanted --p2p-ip-preference to require the new p2p, got %v", err)
+	}
+	if err := checkNewP2PFlags(modeContext(t, nil)); err != nil {
+		t.Errorf("Wanted the default IP preference without the new p2p, got %v", err)
+	}
+
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{UseNewP2P: true})
+	if err := checkNewP2PFlags(ctx); err != nil {
+		t.Errorf("Wanted --p2p-ip-preference with the new p2p, got %v", err)
+	}
+}
@@ -10,15 +10,21 @@ import (
 	"path"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	gethRPC "github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/admin"
 	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/balances"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/depositmonitor"
 	dblockchain "github.com/prysmaticlabs/prysm/beacon-chain/deprecated-blockchain"
 	rbcsync "github.com/prysmaticlabs/prysm/beacon-chain/deprecated-sync"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
@@ -26,6 +32,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/replica"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
 	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/shared"
@@ -33,6 +40,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	deprecatedp2p "github.com/prysmaticlabs/prysm/shared/deprecated-p2p"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/gossipfault"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
 	"github.com/prysmaticlabs/prysm/shared/tracing"
@@ -50,12 +58,15 @@ const testSkipPowFlag = "test-skip-pow"
 // full PoS node. It handles the lifecycle of the entire system and registers
 // services to a service registry.
 type BeaconNode struct {
-	ctx          *cli.Context
-	services     *shared.ServiceRegistry
-	lock         sync.RWMutex
-	stop         chan struct{} // Channel to wait for termination notifications.
-	db           db.Database
-	depositCache *depositcache.DepositCache
+	ctx               *cli.Context
+	services          *shared.ServiceRegistry
+	lock              sync.RWMutex
+	stop              chan struct{} // Channel to wait for termination notifications.
+	db                db.Database
+	depositCache      *depositcache.DepositCache
+	recentlyProcessed *cache.RecentlyProcessedCache // Shared by the RPC servers and the sync service.
+	forkMonitor       *cache.ForkMonitor            // Fed by the sync service, read by the RPC servers.
+	gossipFaults      *gossipfault.Config           // Injected by the p2p service, or the sync service with the new p2p.
 }
 
 // NewBeaconNode creates a new node instance, sets up configuration options, and registers
@@ -70,6 +81,19 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 	); err != nil {
 		return nil, err
 	}
+	if err := applyDeprecatedFlags(ctx); err != nil {
+		return nil, err
+	}
+	featureconfig.ConfigureBeaconFeatures(ctx)
+	if err := applyMode(ctx); err != nil {
+		return nil, err
+	}
+	if err := checkNewSyncFlags(ctx); err != nil {
+		return nil, err
+	}
+	if err := checkNewP2PFlags(ctx); err != nil {
+		return nil, err
+	}
 	registry := shared.NewServiceRegistry()
 
 	beacon := &BeaconNode{
@@ -83,13 +107,46 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		log.Info("Using custom parameter configuration")
 		params.UseDemoBeaconConfig()
 	}
-
-	featureconfig.ConfigureBeaconFeatures(ctx)
+	if ctx.GlobalIsSet(flags.MinGenesisTimeFlag.Name) || ctx.GlobalIsSet(flags.GenesisDelayFlag.Name) {
+		c := *params.BeaconConfig()
+		if ctx.GlobalIsSet(flags.MinGenesisTimeFlag.Name) {
+			c.MinGenesisTime = ctx.GlobalUint64(flags.MinGenesisTimeFlag.Name)
+		}
+		if ctx.GlobalIsSet(flags.GenesisDelayFlag.Name) {
+			c.GenesisDelay = ctx.GlobalUint64(flags.GenesisDelayFlag.Name)
+		}
+		log.WithFields(logrus.Fields{
+			"minGenesisTime": c.MinGenesisTime,
+			"genesisDelay":   c.GenesisDelay,
+		}).Info("Using custom genesis time parameters")
+		params.OverrideBeaconConfig(&c)
+	}
+	if ctx.GlobalIsSet(flags.MaxSkipSlotsFlag.Name) {
+		c := *params.BeaconConfig()
+		c.MaxSkipSlotsPerBlock = ctx.GlobalUint64(flags.MaxSkipSlotsFlag.Name)
+		params.OverrideBeaconConfig(&c)
+	}
 
 	if err := beacon.startDB(ctx); err != nil {
 		return nil, err
 	}
 
+	// Replicas only keep their database up to date with the primary and serve queries from it.
+	if ctx.GlobalString(flags.ReplicaOfFlag.Name) != "" {
+		if err := beacon.registerReplicaServices(ctx); err != nil {
+			return nil, err
+		}
+		if err := beacon.registerGRPCGateway(ctx); err != nil {
+			return nil, err
+		}
+		if !ctx.GlobalBool(cmd.DisableMonitoringFlag.Name) {
+			if err := beacon.registerPrometheusService(ctx); err != nil {
+				return nil, err
+			}
+		}
+		return beacon, nil
+	}
+
 	if err := beacon.registerP2P(ctx); err != nil {
 		return nil, err
 	}
@@ -110,6 +167,12 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if ctx.GlobalBool(flags.MonitorDepositsFlag.Name) {
+		if err := beacon.registerDepositMonitor(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := beacon.registerSyncService(ctx); err != nil {
 		return nil, err
 	}
@@ -122,6 +185,12 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if ctx.GlobalString(flags.AdminSocketFlag.Name) != "" {
+		if err := beacon.registerAdminService(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	if !ctx.GlobalBool(cmd.DisableMonitoringFlag.Name) {
 		if err := beacon.registerPrometheusService(ctx); err != nil {
 			return nil, err
@@ -187,12 +256,36 @@ func (b *BeaconNode) startDB(ctx *cli.Context) error {
 		}
 	}
 
+	snapshots := ctx.GlobalBool(flags.DBSnapshotFlag.Name)
+	retention := ctx.GlobalInt(flags.DBSnapshotRetentionFlag.Name)
+	if retention < 1 {
+		return fmt.Errorf("--%s must be at least 1", flags.DBSnapshotRetentionFlag.Name)
+	}
 	var d db.Database
 	var err error
 	if featureconfig.FeatureConfig().UseNewDatabase {
-		d, err = db.NewDB(dbPath)
+		var store *kv.Store
+		store, err = kv.NewKVStore(dbPath)
+		if err == nil && snapshots {
+			store.EnableSnapshots(retention)
+		}
+		d = store
 	} else {
-		d, err = db.NewDBDeprecated(dbPath)
+		var beaconDB *db.BeaconDB
+		beaconDB, err = db.NewDBDeprecated(dbPath)
+		if err == nil {
+			if snapshots {
+				beaconDB.EnableSnapshots(retention)
+				// Snapshot the database now, so that it is ready by the time it is first pruned.
+				beaconDB.StartSnapshot()
+			}
+			err = beaconDB.ConfigureStateRetention(
+				db.StateRetentionPolicy(ctx.GlobalString(flags.HistoricalStateRetentionFlag.Name)),
+				ctx.GlobalUint64(flags.HistoricalStateRetentionEpochsFlag.Name),
+				ctx.GlobalBool(flags.HistoricalStateRetentionDryRunFlag.Name),
+			)
+		}
+		d = beaconDB
 	}
 	if err != nil {
 		return err
@@ -201,23 +294,32 @@ func (b *BeaconNode) startDB(ctx *cli.Context) error {
 	log.WithField("path", dbPath).Info("Checking db")
 	b.db = d
 	b.depositCache = depositcache.NewDepositCache()
+	b.recentlyProcessed = cache.NewRecentlyProcessedCache()
+	b.forkMonitor = cache.NewForkMonitor(b.db)
 	return nil
 }
 
 func (b *BeaconNode) registerP2P(ctx *cli.Context) error {
+	faults, err := gossipFaults(ctx)
+	if err != nil {
+		return err
+	}
+	b.gossipFaults = faults
 	if featureconfig.FeatureConfig().UseNewP2P {
 		svc, err := p2p.NewService(&p2p.Config{
-			NoDiscovery:       ctx.GlobalBool(cmd.NoDiscovery.Name),
-			StaticPeers:       ctx.GlobalStringSlice(cmd.StaticPeers.Name),
-			BootstrapNodeAddr: ctx.GlobalString(cmd.BootstrapNode.Name),
-			RelayNodeAddr:     ctx.GlobalString(cmd.RelayNode.Name),
-			HostAddress:       ctx.GlobalString(cmd.P2PHost.Name),
-			PrivateKey:        ctx.GlobalString(cmd.P2PPrivKey.Name),
-			Port:              ctx.GlobalUint(cmd.P2PPort.Name),
-			MaxPeers:          ctx.GlobalUint(cmd.P2PMaxPeers.Name),
-			WhitelistCIDR:     ctx.GlobalString(cmd.P2PWhitelist.Name),
-			EnableUPnP:        ctx.GlobalBool(cmd.EnableUPnPFlag.Name),
-			Encoding:          ctx.GlobalString(cmd.P2PEncoding.Name),
+			NoDiscovery:         ctx.GlobalBool(cmd.NoDiscovery.Name),
+			StaticPeers:         ctx.GlobalStringSlice(cmd.StaticPeers.Name),
+			BootstrapNodeAddr:   ctx.GlobalString(cmd.BootstrapNode.Name),
+			RelayNodeAddr:       ctx.GlobalString(cmd.RelayNode.Name),
+			HostAddress:         ctx.GlobalString(cmd.P2PHost.Name),
+			PrivateKey:          ctx.GlobalString(cmd.P2PPrivKey.Name),
+			Port:                ctx.GlobalUint(cmd.P2PPort.Name),
+			MaxPeers:            ctx.GlobalUint(cmd.P2PMaxPeers.Name),
+			WhitelistCIDR:       ctx.GlobalString(cmd.P2PWhitelist.Name),
+			EnableUPnP:          ctx.GlobalBool(cmd.EnableUPnPFlag.Name),
+			Encoding:            ctx.GlobalString(cmd.P2PEncoding.Name),
+			IPPreference:        p2p.IPPreference(ctx.GlobalString(cmd.P2PIPPreference.Name)),
+			ShutdownGracePeriod: shutdownGracePeriod(ctx),
 		})
 		if err != nil {
 			return err
@@ -225,7 +327,7 @@ func (b *BeaconNode) registerP2P(ctx *cli.Context) error {
 		return b.services.RegisterService(svc)
 	}
 
-	beaconp2p, err := deprecatedConfigureP2P(ctx)
+	beaconp2p, err := deprecatedConfigureP2P(ctx, faults)
 	if err != nil {
 		return errors.Wrap(err, "could not register deprecatedp2p service")
 	}
@@ -233,6 +335,37 @@ func (b *BeaconNode) registerP2P(ctx *cli.Context) error {
 	return b.services.RegisterService(beaconp2p)
 }
 
+// shutdownGracePeriod returns how long the p2p service waits at shutdown for critical messages to
+// be sent to peers.
+func shutdownGracePeriod(ctx *cli.Context) time.Duration {
+	return time.Duration(ctx.GlobalInt(flags.ShutdownBroadcastGracePeriodFlag.Name)) * time.Millisecond
+}
+
+// gossipFaults returns the faults injected into the gossip of the node by the research flags, or
+// nil if none is set.
+func gossipFaults(ctx *cli.Context) (*gossipfault.Config, error) {
+	faults := &gossipfault.Config{
+		Blocks: gossipfault.Rule{
+			Delay:    time.Duration(ctx.GlobalInt(flags.ResearchGossipBlockDelayFlag.Name)) * time.Millisecond,
+			DropRate: ctx.GlobalFloat64(flags.ResearchGossipBlockDropRateFlag.Name),
+		},
+		Attestations: gossipfault.Rule{
+			Delay:    time.Duration(ctx.GlobalInt(flags.ResearchGossipAttestationDelayFlag.Name)) * time.Millisecond,
+			DropRate: ctx.GlobalFloat64(flags.ResearchGossipAttestationDropRateFlag.Name),
+		},
+	}
+	for _, rate := range []float64{faults.Blocks.DropRate, faults.Attestations.DropRate} {
+		if rate < 0 || rate > 1 {
+			return nil, fmt.Errorf("gossip drop rate %v is not between 0 and 1", rate)
+		}
+	}
+	if !faults.Enabled() {
+		return nil, nil
+	}
+	log.Warn("Injecting faults into the gossip of blocks and attestations, this node must only be used for research")
+	return faults, nil
+}
+
 func (b *BeaconNode) fetchP2P(ctx *cli.Context) p2p.P2P {
 	if featureconfig.FeatureConfig().UseNewP2P {
 		var p *p2p.Service
@@ -261,14 +394,37 @@ func (b *BeaconNode) registerBlockchainService(ctx *cli.Context) error {
 
 	maxRoutines := ctx.GlobalInt64(cmd.MaxGoroutines.Name)
 
+	policy := p2p.BroadcastPolicy(ctx.GlobalString(flags.BlockBroadcastPolicyFlag.Name))
+	delay := time.Duration(ctx.GlobalInt(flags.BlockBroadcastDelayFlag.Name)) * time.Millisecond
+	switch policy {
+	case p2p.BroadcastImmediate:
+	case p2p.BroadcastDelayed, p2p.BroadcastLocalOnly:
+		log.WithFields(logrus.Fields{
+			"policy": policy,
+			"delay":  delay,
+		}).Warn("Blocks received from peers will not be broadcast immediately, blocks proposed by the validators of this node still are")
+	default:
+		return fmt.Errorf("unknown block broadcast policy %q", policy)
+	}
+	blockBroadcaster := p2p.NewBlockBroadcaster(b.fetchP2P(ctx), policy, delay)
+	var balanceMonitor *balances.Monitor
+	if ctx.GlobalBool(flags.AnalyzeEpochBalancesFlag.Name) {
+		balanceMonitor = balances.NewMonitor(&balances.Config{
+			PenalizedFraction: ctx.GlobalFloat64(flags.MassPenaltyFractionFlag.Name),
+		})
+	}
+
 	if featureconfig.FeatureConfig().UseNewBlockChainService {
 		blockchainService, err := blockchain.NewChainService(context.Background(), &blockchain.Config{
-			BeaconDB:       b.db,
-			DepositCache:   b.depositCache,
-			Web3Service:    web3Service,
-			OpsPoolService: opsService,
-			P2p:            b.fetchP2P(ctx),
-			MaxRoutines:    maxRoutines,
+			BeaconDB:            b.db,
+			DepositCache:        b.depositCache,
+			Web3Service:         web3Service,
+			OpsPoolService:      opsService,
+			P2p:                 blockBroadcaster,
+			MaxRoutines:         maxRoutines,
+			ForkChoiceMaxDepth:  ctx.GlobalUint64(flags.ForkChoiceMaxDepthFlag.Name),
+			BalanceMonitor:      balanceMonitor,
+			ForkChoiceAuditSize: ctx.GlobalUint64(flags.ForkChoiceAuditSizeFlag.Name),
 		})
 		if err != nil {
 			return errors.Wrap(err, "could not register blockchain service")
@@ -281,14 +437,20 @@ func (b *BeaconNode) registerBlockchainService(ctx *cli.Context) error {
 		return err
 	}
 
+	epochDuration := params.BeaconConfig().SlotsPerEpoch * params.BeaconConfig().SecondsPerSlot
+	staleForkInterval := time.Duration(ctx.GlobalUint64(flags.StaleForkCleanupIntervalFlag.Name)*epochDuration) * time.Second
 	deprecatedBlockchainService, err := dblockchain.NewChainService(context.Background(), &dblockchain.Config{
-		BeaconDB:       b.db,
-		DepositCache:   b.depositCache,
-		Web3Service:    web3Service,
-		OpsPoolService: opsService,
-		AttsService:    attsService,
-		P2p:            b.fetchP2P(ctx),
-		MaxRoutines:    maxRoutines,
+		BeaconDB:                 b.db,
+		DepositCache:             b.depositCache,
+		Web3Service:              web3Service,
+		OpsPoolService:           opsService,
+		AttsService:              attsService,
+		P2p:                      blockBroadcaster,
+		MaxRoutines:              maxRoutines,
+		StaleForkDepth:           ctx.GlobalUint64(flags.StaleForkDepthFlag.Name),
+		StaleForkCleanupInterval: staleForkInterval,
+		BalanceMonitor:           balanceMonitor,
+		ForkChoiceAuditSize:      ctx.GlobalUint64(flags.ForkChoiceAuditSizeFlag.Name),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not register deprecated blockchain service")
@@ -297,9 +459,20 @@ func (b *BeaconNode) registerBlockchainService(ctx *cli.Context) error {
 }
 
 func (b *BeaconNode) registerOperationService(ctx *cli.Context) error {
+	policy := operations.EvictionPolicy(ctx.GlobalString(flags.OpsPoolEvictionPolicyFlag.Name))
+	if policy != operations.EvictOldestFirst && policy != operations.EvictLowestValueFirst {
+		return fmt.Errorf("unknown operations pool eviction policy %q", policy)
+	}
 	operationService := operations.NewOpsPoolService(context.Background(), &operations.Config{
 		BeaconDB: b.db,
 		P2P:      b.fetchP2P(ctx),
+		PoolLimits: &operations.PoolLimits{
+			MaxAttestations:        ctx.GlobalInt(flags.MaxPendingAttestationsFlag.Name),
+			MaxAttestationsPerSlot: ctx.GlobalInt(flags.MaxPendingAttestationsPerSlotFlag.Name),
+			MaxExits:               ctx.GlobalInt(flags.MaxPendingExitsFlag.Name),
+			MaxSlashings:           ctx.GlobalInt(flags.MaxPendingSlashingsFlag.Name),
+			EvictionPolicy:         policy,
+		},
 	})
 
 	return b.services.RegisterService(operationService)
@@ -328,13 +501,13 @@ func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
 	if err != nil {
 		log.Fatalf("Access to PoW chain is required for validator. Unable to connect to Geth node: %v", err)
 	}
-	httpClient := ethclient.NewClient(httpRPCClient)
+	httpClient := powchain.NewRPCClient(ethclient.NewClient(httpRPCClient), web3ClientConfig(cliCtx, "http"))
 
 	rpcClient, err := gethRPC.Dial(cliCtx.GlobalString(flags.Web3ProviderFlag.Name))
 	if err != nil {
 		log.Fatalf("Access to PoW chain is required for validator. Unable to connect to Geth node: %v", err)
 	}
-	powClient := ethclient.NewClient(rpcClient)
+	powClient := powchain.NewRPCClient(ethclient.NewClient(rpcClient), web3ClientConfig(cliCtx, "websocket"))
 
 	ctx := context.Background()
 	cfg := &powchain.Web3ServiceConfig{
@@ -364,6 +537,30 @@ func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
 	return b.services.RegisterService(web3Service)
 }
 
+// web3ClientConfig returns the rate limit and retry policy of the requests to a web3 provider endpoint.
+func web3ClientConfig(cliCtx *cli.Context, name string) *powchain.RPCClientConfig {
+	cfg := powchain.DefaultRPCClientConfig(name)
+	cfg.RequestsPerSecond = cliCtx.GlobalFloat64(flags.Web3ProviderRequestsPerSecondFlag.Name)
+	cfg.MaxAttempts = cliCtx.GlobalInt(flags.Web3ProviderMaxAttemptsFlag.Name)
+	cfg.AttemptTimeout = time.Duration(cliCtx.GlobalInt(flags.Web3ProviderRequestTimeoutFlag.Name)) * time.Second
+	return cfg
+}
+
+func (b *BeaconNode) registerDepositMonitor(ctx *cli.Context) error {
+	var web3Service *powchain.Web3Service
+	if err := b.services.FetchService(&web3Service); err != nil {
+		return err
+	}
+	window := time.Duration(ctx.GlobalUint64(flags.DepositInclusionWindowFlag.Name)) * time.Minute
+	monitor := depositmonitor.NewService(context.Background(), &depositmonitor.Config{
+		DepositCache:    b.depositCache,
+		BeaconDB:        b.db,
+		Eth1Blocks:      web3Service,
+		InclusionWindow: window,
+	})
+	return b.services.RegisterService(monitor)
+}
+
 func (b *BeaconNode) registerSyncService(ctx *cli.Context) error {
 	var chainService *dblockchain.ChainService
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -386,23 +583,41 @@ func (b *BeaconNode) registerSyncService(ctx *cli.Context) error {
 	}
 
 	if featureconfig.FeatureConfig().UseNewSync {
+		var disabledTopics []string
+		if ctx.GlobalBool(flags.DisableVoluntaryExitGossipFlag.Name) {
+			disabledTopics = append(disabledTopics, "/eth2/voluntary_exit")
+		}
+		if ctx.GlobalBool(flags.DisableProposerSlashingGossipFlag.Name) {
+			disabledTopics = append(disabledTopics, "/eth2/proposer_slashing")
+		}
+		if ctx.GlobalBool(flags.DisableAttesterSlashingGossipFlag.Name) {
+			disabledTopics = append(disabledTopics, "/eth2/attester_slashing")
+		}
 		rs := prysmsync.NewRegularSync(&prysmsync.Config{
-			DB:         b.db,
-			P2P:        b.fetchP2P(ctx),
-			Operations: operationService,
+			DB:                  b.db,
+			P2P:                 b.fetchP2P(ctx),
+			Operations:          operationService,
+			DisabledTopics:      disabledTopics,
+			ForkMonitor:         b.forkMonitor,
+			RecentlyProcessed:   b.recentlyProcessed,
+			SubnetBackbone:      ctx.GlobalBool(flags.SubnetBackboneFlag.Name),
+			SubscribeAllSubnets: ctx.GlobalBool(flags.SubscribeAllSubnetsFlag.Name),
+			GossipFaults:        b.gossipFaults,
 		})
 
 		return b.services.RegisterService(rs)
 	}
 
 	cfg := &rbcsync.Config{
-		ChainService:     chainService,
-		P2P:              b.fetchP2P(ctx),
-		BeaconDB:         b.db,
-		DepositCache:     b.depositCache,
-		OperationService: operationService,
-		PowChainService:  web3Service,
-		AttsService:      attsService,
+		ChainService:      chainService,
+		P2P:               b.fetchP2P(ctx),
+		BeaconDB:          b.db,
+		DepositCache:      b.depositCache,
+		OperationService:  operationService,
+		PowChainService:   web3Service,
+		AttsService:       attsService,
+		RecentlyProcessed: b.recentlyProcessed,
+		ForkMonitor:       b.forkMonitor,
 	}
 
 	syncService := rbcsync.NewSyncService(context.Background(), cfg)
@@ -425,39 +640,107 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 		return err
 	}
 
+	var depositMonitor *depositmonitor.Service
+	if ctx.GlobalBool(flags.MonitorDepositsFlag.Name) {
+		if err := b.services.FetchService(&depositMonitor); err != nil {
+			return err
+		}
+	}
+
 	var syncChecker prysmsync.Checker
+	var peerForks prysmsync.PeerForkReporter
 	if featureconfig.FeatureConfig().UseNewSync {
 		var syncService *prysmsync.RegularSync
 		if err := b.services.FetchService(&syncService); err != nil {
 			return err
 		}
 		syncChecker = syncService
+		peerForks = syncService
 	} else {
 		var syncService *rbcsync.Service
 		if err := b.services.FetchService(&syncService); err != nil {
 			return err
 		}
 		syncChecker = syncService
+		peerForks = syncService
 	}
 
 	port := ctx.GlobalString(flags.RPCPort.Name)
 	cert := ctx.GlobalString(flags.CertFlag.Name)
 	key := ctx.GlobalString(flags.KeyFlag.Name)
+	quotas, err := rpcQuotas(ctx)
+	if err != nil {
+		return err
+	}
 	rpcService := rpc.NewRPCService(context.Background(), &rpc.Config{
-		Port:             port,
-		CertFlag:         cert,
-		KeyFlag:          key,
-		BeaconDB:         b.db,
-		Broadcaster:      b.fetchP2P(ctx),
-		ChainService:     chainService,
-		OperationService: operationService,
-		POWChainService:  web3Service,
-		SyncService:      syncChecker,
+		Port:                port,
+		CertFlag:            cert,
+		KeyFlag:             key,
+		BeaconDB:            b.db,
+		Broadcaster:         b.fetchP2P(ctx),
+		PeerEvents:          b.fetchP2P(ctx),
+		ChainService:        chainService,
+		OperationService:    operationService,
+		POWChainService:     web3Service,
+		SyncService:         syncChecker,
+		PeerForks:           peerForks,
+		RecentlyProcessed:   b.recentlyProcessed,
+		ForkMonitor:         b.forkMonitor,
+		DepositCache:        b.depositCache,
+		DepositMonitor:      depositMonitor,
+		Quotas:              quotas,
+		EnableReplication:   ctx.GlobalBool(flags.EnableReplicationFlag.Name),
+		DisableValidatorAPI: ctx.GlobalBool(flags.DisableValidatorRPCFlag.Name),
 	})
 
 	return b.services.RegisterService(rpcService)
 }
 
+// registerReplicaServices registers the replication of the finalized chain of the primary node
+// and a read-only RPC server, in place of the services of a syncing node.
+func (b *BeaconNode) registerReplicaServices(ctx *cli.Context) error {
+	// TODO(3045): Use the db.Database interface only.
+	beaconDB, ok := b.db.(*db.BeaconDB)
+	if !ok {
+		return errors.New("replicas require the legacy database")
+	}
+	replicaService := replica.NewService(context.Background(), &replica.Config{
+		Primary:             ctx.GlobalString(flags.ReplicaOfFlag.Name),
+		CertFlag:            ctx.GlobalString(flags.ReplicaCertFlag.Name),
+		BeaconDB:            beaconDB,
+		StateIntervalEpochs: ctx.GlobalUint64(flags.ReplicaStateIntervalFlag.Name),
+		APIKey:              ctx.GlobalString(flags.ReplicaAPIKeyFlag.Name),
+	})
+	if err := b.services.RegisterService(replicaService); err != nil {
+		return err
+	}
+
+	quotas, err := rpcQuotas(ctx)
+	if err != nil {
+		return err
+	}
+	rpcService := rpc.NewRPCService(context.Background(), &rpc.Config{
+		Port:        ctx.GlobalString(flags.RPCPort.Name),
+		CertFlag:    ctx.GlobalString(flags.CertFlag.Name),
+		KeyFlag:     ctx.GlobalString(flags.KeyFlag.Name),
+		BeaconDB:    b.db,
+		SyncService: replicaService,
+		ForkMonitor: b.forkMonitor,
+		Quotas:      quotas,
+		ReadOnly:    true,
+	})
+	return b.services.RegisterService(rpcService)
+}
+
+// rpcQuotas loads the per API key quotas of the RPC server, if a quotas file is given.
+func rpcQuotas(ctx *cli.Context) ([]*rpc.Quota, error) {
+	quotasFile := ctx.GlobalString(flags.RPCQuotasFileFlag.Name)
+	if quotasFile == "" {
+		return nil, nil
+	}
+	return rpc.LoadQuotas(quotasFile)
+}
+
 func (b *BeaconNode) registerPrometheusService(ctx *cli.Context) error {
 	service := prometheus.NewPrometheusService(
 		fmt.Sprintf(":%d", ctx.GlobalInt64(cmd.MonitoringPortFlag.Name)),
@@ -468,6 +751,39 @@ func (b *BeaconNode) registerPrometheusService(ctx *cli.Context) error {
 	return b.services.RegisterService(service)
 }
 
+func (b *BeaconNode) registerAdminService(ctx *cli.Context) error {
+	cfg := &admin.Config{
+		SocketPath: ctx.GlobalString(flags.AdminSocketFlag.Name),
+	}
+	if snapshotter, ok := b.db.(admin.Snapshotter); ok {
+		cfg.DB = snapshotter
+	}
+	if peers, ok := b.fetchP2P(ctx).(admin.PeerManager); ok {
+		cfg.Peers = peers
+	}
+	if !featureconfig.FeatureConfig().UseNewSync {
+		var syncService *rbcsync.Service
+		if err := b.services.FetchService(&syncService); err != nil {
+			return err
+		}
+		cfg.Sync = syncService.RegularSync
+	}
+	if featureconfig.FeatureConfig().UseNewBlockChainService {
+		var chainService *blockchain.ChainService
+		if err := b.services.FetchService(&chainService); err != nil {
+			return err
+		}
+		cfg.ForkChoice = chainService
+	} else {
+		var chainService *dblockchain.ChainService
+		if err := b.services.FetchService(&chainService); err != nil {
+			return err
+		}
+		cfg.ForkChoice = chainService
+	}
+	return b.services.RegisterService(admin.NewService(context.Background(), cfg))
+}
+
 func (b *BeaconNode) registerAttestationService() error {
 	attsService := attestation.NewAttestationService(context.Background(),
 		&attestation.Config{
@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	p2p "github.com/prysmaticlabs/prysm/shared/deprecated-p2p"
 	"github.com/prysmaticlabs/prysm/shared/deprecated-p2p/adapter/metric"
+	"github.com/prysmaticlabs/prysm/shared/gossipfault"
 	"github.com/urfave/cli"
 )
 
@@ -30,7 +31,7 @@ var deprecatedTopicMappings = map[pb.Topic]proto.Message{
 }
 
 // Deprecated: Do not use. See #3147.
-func deprecatedConfigureP2P(ctx *cli.Context) (*p2p.Server, error) {
+func deprecatedConfigureP2P(ctx *cli.Context, faults *gossipfault.Config) (*p2p.Server, error) {
 	contractAddress := ctx.GlobalString(flags.DepositContractFlag.Name)
 	if contractAddress == "" {
 		var err error
@@ -57,6 +58,8 @@ func deprecatedConfigureP2P(ctx *cli.Context) (*p2p.Server, error) {
 		DepositContractAddress: contractAddress,
 		WhitelistCIDR:          ctx.GlobalString(cmd.P2PWhitelist.Name),
 		EnableUPnP:             ctx.GlobalBool(cmd.EnableUPnPFlag.Name),
+		ShutdownGracePeriod:    shutdownGracePeriod(ctx),
+		GossipFaults:           faults,
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,22 @@
+package operations
+
+import "github.com/pkg/errors"
+
+var (
+	// ErrUnknownBlockRoot is returned when an attestation votes for a beacon block root
+	// which is not known to the node. Callers may retry once the block is received.
+	ErrUnknownBlockRoot = errors.New("unknown block root")
+	// ErrPastSlot is returned when an attestation is too old to ever be included in a block.
+	ErrPastSlot = errors.New("attestation slot is too far in the past")
+	// ErrInvalidSignature is returned when the aggregate signature of an attestation does not verify.
+	ErrInvalidSignature = errors.New("invalid attestation signature")
+	// ErrAlreadyAggregated is returned when every aggregation bit of an attestation is
+	// already present in the node's pool.
+	ErrAlreadyAggregated = errors.New("attestation already aggregated")
+	// ErrAlreadyIncluded is returned when every aggregation bit of an attestation is already
+	// included in a canonical block.
+	ErrAlreadyIncluded = errors.New("attestation already included")
+	// ErrPoolFull is returned when the pool limit of an operation is reached and the
+	// eviction policy ranks the incoming operation below every pending one.
+	ErrPoolFull = errors.New("operation pool is full")
+)
@@ -0,0 +1,200 @@
+package operations
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// includedEpochs is the number of epochs of canonical blocks whose attestations are tracked as
+// included. Attestations can only be included in the epoch following their slot, and the pool
+// drops older ones, so two epochs cover every attestation the pool may still hold.
+const includedEpochs = 2
+
+type includedAtt struct {
+	bits bitfield.Bitlist
+	slot uint64
+}
+
+// includedAtts keeps track of the votes already included in the canonical chain for each
+// attestation data root, so that proposers are not handed attestations which add nothing to the
+// chain. head is the root of the canonical block whose attestations were recorded last.
+type includedAtts struct {
+	atts map[[32]byte]*includedAtt
+	head [32]byte
+	lock sync.RWMutex
+}
+
+func newIncludedAtts() *includedAtts {
+	return &includedAtts{
+		atts: make(map[[32]byte]*includedAtt),
+	}
+}
+
+// add records the attestations of a block at the given slot as included.
+func (i *includedAtts) add(attestations []*ethpb.Attestation, slot uint64) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	for _, att := range attestations {
+		root, err := hashutil.HashProto(att.Data)
+		if err != nil {
+			return err
+		}
+		prev, ok := i.atts[root]
+		if !ok || prev.bits.Len() != att.AggregationBits.Len() {
+			i.atts[root] = &includedAtt{bits: att.AggregationBits, slot: slot}
+			continue
+		}
+		prev.bits = prev.bits.Or(att.AggregationBits)
+		if slot > prev.slot {
+			prev.slot = slot
+		}
+	}
+	return nil
+}
+
+// headRoot returns the root of the canonical block whose attestations were recorded last.
+func (i *includedAtts) headRoot() [32]byte {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return i.head
+}
+
+// setHead records the root of the canonical block whose attestations were recorded last.
+func (i *includedAtts) setHead(root [32]byte) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.head = root
+}
+
+// replace swaps the included attestations for the ones of another view.
+func (i *includedAtts) replace(view *includedAtts) {
+	view.lock.RLock()
+	defer view.lock.RUnlock()
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.atts = view.atts
+	i.head = view.head
+}
+
+// covers returns true if every vote of the attestation with the given data root was already
+// included on chain.
+func (i *includedAtts) covers(root [32]byte, bits bitfield.Bitlist) bool {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	prev, ok := i.atts[root]
+	if !ok || prev.bits.Len() != bits.Len() {
+		return false
+	}
+	return prev.bits.Contains(bits)
+}
+
+// removeBefore forgets the attestations last included in a block before the given slot.
+func (i *includedAtts) removeBefore(slot uint64) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	for root, att := range i.atts {
+		if att.slot < slot {
+			delete(i.atts, root)
+		}
+	}
+}
+
+// restoreIncludedAttestations rebuilds the view of the included attestations from the canonical
+// blocks of the last epochs, as it only lives in memory and would otherwise be empty after a
+// restart, letting proposers include attestations which are already on chain.
+func (s *Service) restoreIncludedAttestations(ctx context.Context) error {
+	head, err := s.headBlock(ctx)
+	if err != nil {
+		return err
+	}
+	headRoot, err := ssz.SigningRoot(head)
+	if err != nil {
+		return errors.Wrap(err, "could not hash head block")
+	}
+	var minSlot uint64
+	if window := includedEpochs * params.BeaconConfig().SlotsPerEpoch; head.Slot > window {
+		minSlot = head.Slot - window
+	}
+
+	view := newIncludedAtts()
+	view.head = headRoot
+	blocks := 0
+	for blk := head; blk != nil && blk.Slot >= minSlot; {
+		if blk.Body != nil {
+			if err := view.add(blk.Body.Attestations, blk.Slot); err != nil {
+				return err
+			}
+		}
+		blocks++
+		if blk.Slot == 0 {
+			break
+		}
+		blk, err = s.beaconDB.Block(ctx, bytesutil.ToBytes32(blk.ParentRoot))
+		if err != nil {
+			return errors.Wrap(err, "could not retrieve parent block")
+		}
+	}
+	s.included.replace(view)
+	log.WithFields(logrus.Fields{
+		"blocks":    blocks,
+		"startSlot": minSlot,
+		"headSlot":  head.Slot,
+	}).Info("Restored included attestations from canonical blocks")
+	return nil
+}
+
+// updateIncludedAttestations records the attestations of the head block once it was processed.
+// A processed block which did not become the head is not canonical, and its attestations are not
+// recorded. When the head does not extend the block recorded last, the chain was reorganized and
+// the view is rebuilt from the canonical blocks, dropping the attestations of the blocks which
+// left the chain.
+func (s *Service) updateIncludedAttestations(ctx context.Context) error {
+	head, err := s.headBlock(ctx)
+	if err != nil {
+		return err
+	}
+	headRoot, err := ssz.SigningRoot(head)
+	if err != nil {
+		return errors.Wrap(err, "could not hash head block")
+	}
+	previous := s.included.headRoot()
+	if headRoot == previous {
+		return nil
+	}
+	if previous == [32]byte{} || bytesutil.ToBytes32(head.ParentRoot) != previous {
+		return s.restoreIncludedAttestations(ctx)
+	}
+	if head.Body != nil {
+		if err := s.included.add(head.Body.Attestations, head.Slot); err != nil {
+			return err
+		}
+	}
+	s.included.setHead(headRoot)
+	return nil
+}
+
+// headBlock returns the head of the canonical chain.
+func (s *Service) headBlock(ctx context.Context) (*ethpb.BeaconBlock, error) {
+	// TODO(3219): Replace with new fork choice service.
+	if d, ok := s.beaconDB.(*db.BeaconDB); ok {
+		return d.ChainHead()
+	}
+	head, err := s.beaconDB.HeadBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if head == nil {
+		return nil, errors.New("no head block")
+	}
+	return head, nil
+}
@@ -0,0 +1,180 @@
+package operations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func includedTestAtt(shard uint64, bits bitfield.Bitlist) *ethpb.Attestation {
+	return &ethpb.Attestation{
+		AggregationBits: bits,
+		Data: &ethpb.AttestationData{
+			Crosslink: &ethpb.Crosslink{Shard: shard},
+			Source:    &ethpb.Checkpoint{},
+			Target:    &ethpb.Checkpoint{},
+		},
+	}
+}
+
+func TestIncludedAtts_Covers(t *testing.T) {
+	included := newIncludedAtts()
+	if err := included.add([]*ethpb.Attestation{includedTestAtt(1, bitfield.Bitlist{0x09})}, 10); err != nil {
+		t.Fatal(err)
+	}
+	if err := included.add([]*ethpb.Attestation{includedTestAtt(1, bitfield.Bitlist{0x0a})}, 12); err != nil {
+		t.Fatal(err)
+	}
+	root, err := hashutil.HashProto(includedTestAtt(1, nil).Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !included.covers(root, bitfield.Bitlist{0x0b}) {
+		t.Error("Wanted votes of both blocks to be included")
+	}
+	if included.covers(root, bitfield.Bitlist{0x0c}) {
+		t.Error("Wanted attestation with a new vote not to be included")
+	}
+
+	included.removeBefore(12)
+	if !included.covers(root, bitfield.Bitlist{0x09}) {
+		t.Error("Wanted attestation last included at slot 12 to be kept")
+	}
+	included.removeBefore(13)
+	if included.covers(root, bitfield.Bitlist{0x09}) {
+		t.Error("Wanted attestation last included at slot 12 to be removed")
+	}
+}
+
+func TestRestoreIncludedAttestations_OK(t *testing.T) {
+	beaconDB := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, beaconDB)
+	ctx := context.Background()
+
+	// Only the attestations of the blocks of the last epochs are restored.
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	slots := []uint64{1, 2*slotsPerEpoch + 1, 3 * slotsPerEpoch}
+	var parentRoot [32]byte
+	var head *ethpb.BeaconBlock
+	for i, slot := range slots {
+		head = &ethpb.BeaconBlock{
+			Slot:       slot,
+			ParentRoot: parentRoot[:],
+			Body: &ethpb.BeaconBlockBody{
+				Attestations: []*ethpb.Attestation{includedTestAtt(uint64(i), bitfield.Bitlist{0x03})},
+			},
+		}
+		if err := beaconDB.SaveBlock(ctx, head); err != nil {
+			t.Fatal(err)
+		}
+		root, err := ssz.SigningRoot(head)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parentRoot = root
+	}
+	if err := beaconDB.UpdateChainHead(ctx, head, &pb.BeaconState{Slot: head.Slot}); err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewOpsPoolService(ctx, &Config{BeaconDB: beaconDB})
+	if err := s.restoreIncludedAttestations(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for i := range slots {
+		root, err := hashutil.HashProto(includedTestAtt(uint64(i), nil).Data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := i > 0
+		if got := s.included.covers(root, bitfield.Bitlist{0x03}); got != want {
+			t.Errorf("Wanted attestation of block at slot %d included: %v, got %v", slots[i], want, got)
+		}
+	}
+}
+
+func TestUpdateIncludedAttestations_OnlyRecordsCanonicalBlocks(t *testing.T) {
+	beaconDB := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, beaconDB)
+	ctx := context.Background()
+	s := NewOpsPoolService(ctx, &Config{BeaconDB: beaconDB})
+
+	genesis := &ethpb.BeaconBlock{Slot: 0, Body: &ethpb.BeaconBlockBody{}}
+	genesisRoot, err := ssz.SigningRoot(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newBlock := func(slot uint64, parentRoot [32]byte, shard uint64) (*ethpb.BeaconBlock, [32]byte) {
+		blk := &ethpb.BeaconBlock{
+			Slot:       slot,
+			ParentRoot: parentRoot[:],
+			Body: &ethpb.BeaconBlockBody{
+				Attestations: []*ethpb.Attestation{includedTestAtt(shard, bitfield.Bitlist{0x03})},
+			},
+		}
+		if err := beaconDB.SaveBlock(ctx, blk); err != nil {
+			t.Fatal(err)
+		}
+		root, err := ssz.SigningRoot(blk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return blk, root
+	}
+	setHead := func(blk *ethpb.BeaconBlock) {
+		if err := beaconDB.UpdateChainHead(ctx, blk, &pb.BeaconState{Slot: blk.Slot}); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.updateIncludedAttestations(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	included := func(shard uint64) bool {
+		root, err := hashutil.HashProto(includedTestAtt(shard, nil).Data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s.included.covers(root, bitfield.Bitlist{0x03})
+	}
+	if err := beaconDB.SaveBlock(ctx, genesis); err != nil {
+		t.Fatal(err)
+	}
+
+	a, _ := newBlock(1, genesisRoot, 1)
+	setHead(a)
+	if !included(1) {
+		t.Error("Wanted attestation of the head block to be included")
+	}
+
+	// A processed block which does not become the head is not canonical.
+	b, bRoot := newBlock(2, genesisRoot, 2)
+	if err := s.updateIncludedAttestations(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if included(2) {
+		t.Error("Wanted attestation of a non canonical block not to be included")
+	}
+
+	// Once the chain is reorganized, the attestations of the block which left it are dropped.
+	setHead(b)
+	if !included(2) || included(1) {
+		t.Errorf("Wanted only the attestation of the new canonical block included, got %v and %v", included(1), included(2))
+	}
+
+	c, cRoot := newBlock(3, bRoot, 3)
+	setHead(c)
+	if !included(2) || !included(3) {
+		t.Error("Wanted attestations of both canonical blocks to be included")
+	}
+	if s.included.headRoot() != cRoot {
+		t.Errorf("Wanted head %#x recorded, got %#x", cRoot, s.included.headRoot())
+	}
+}
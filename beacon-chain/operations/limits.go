@@ -0,0 +1,287 @@
+package operations
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/sirupsen/logrus"
+)
+
+// EvictionPolicy decides which pending operation is dropped once a pool limit is reached.
+type EvictionPolicy string
+
+const (
+	// EvictOldestFirst drops the pending operation which was received first.
+	EvictOldestFirst EvictionPolicy = "oldest-first"
+	// EvictLowestValueFirst drops the pending operation which is worth the least to a
+	// proposer, such as the attestation with the fewest aggregated votes. Operations of
+	// equal value are dropped oldest first.
+	EvictLowestValueFirst EvictionPolicy = "lowest-value-first"
+)
+
+var (
+	evictedOperations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "operations_pool_evicted_total",
+		Help: "Number of pending operations evicted from the pool to make room for new ones",
+	}, []string{"operation"})
+	rejectedOperations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "operations_pool_rejected_total",
+		Help: "Number of operations rejected because the pool was full of more valuable ones",
+	}, []string{"operation"})
+	pendingOperations = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "operations_pool_size",
+		Help: "Number of pending operations held in the pool",
+	}, []string{"operation"})
+)
+
+// PoolLimits caps the number of pending operations held by the service, so a gossip
+// flood cannot exhaust the node's memory. A limit of 0 disables the cap.
+type PoolLimits struct {
+	MaxAttestations        int
+	MaxAttestationsPerSlot int
+	MaxExits               int
+	// MaxSlashings applies to proposer and attester slashings separately.
+	MaxSlashings   int
+	EvictionPolicy EvictionPolicy
+}
+
+// DefaultPoolLimits holds an epoch's worth of full blocks of attestations.
+func DefaultPoolLimits() *PoolLimits {
+	return &PoolLimits{
+		MaxAttestations:        8192,
+		MaxAttestationsPerSlot: 512,
+		MaxExits:               1024,
+		MaxSlashings:           256,
+		EvictionPolicy:         EvictOldestFirst,
+	}
+}
+
+type pendingOp struct {
+	root  [32]byte
+	slot  uint64
+	value uint64
+	seq   uint64
+}
+
+// opPool keeps track of the pending operations of a kind stored in the DB, in order to
+// enforce the pool limits without reading the operations back from the DB.
+type opPool struct {
+	name       string
+	maxTotal   int
+	maxPerSlot int
+	policy     EvictionPolicy
+	ops        map[[32]byte]*pendingOp
+	slotCounts map[uint64]int
+	seq        uint64
+	lock       sync.Mutex
+}
+
+func newOpPool(name string, maxTotal int, maxPerSlot int, policy EvictionPolicy) *opPool {
+	return &opPool{
+		name:       name,
+		maxTotal:   maxTotal,
+		maxPerSlot: maxPerSlot,
+		policy:     policy,
+		ops:        make(map[[32]byte]*pendingOp),
+		slotCounts: make(map[uint64]int),
+	}
+}
+
+// admit tracks an incoming operation and returns the roots of the pending operations
+// evicted to make room for it, which the caller must delete from the DB. ErrPoolFull is
+// returned, and nothing is evicted, when the policy ranks the incoming operation below
+// every pending one. Admitting an operation already tracked updates its value.
+func (p *opPool) admit(root [32]byte, slot uint64, value uint64) ([][32]byte, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if op, ok := p.ops[root]; ok {
+		op.value = value
+		return nil, nil
+	}
+
+	incoming := &pendingOp{root: root, slot: slot, value: value, seq: p.seq}
+	var victim *pendingOp
+	if p.maxPerSlot > 0 && p.slotCounts[slot] >= p.maxPerSlot {
+		victim = p.victim(incoming, func(op *pendingOp) bool { return op.slot == slot })
+	} else if p.maxTotal > 0 && len(p.ops) >= p.maxTotal {
+		victim = p.victim(incoming, func(op *pendingOp) bool { return true })
+	}
+	if victim == incoming {
+		rejectedOperations.WithLabelValues(p.name).Inc()
+		return nil, ErrPoolFull
+	}
+
+	var evicted [][32]byte
+	if victim != nil {
+		p.delete(victim)
+		evicted = append(evicted, victim.root)
+		evictedOperations.WithLabelValues(p.name).Inc()
+	}
+	p.seq++
+	p.ops[root] = incoming
+	p.slotCounts[slot]++
+	pendingOperations.WithLabelValues(p.name).Set(float64(len(p.ops)))
+	return evicted, nil
+}
+
+// remove stops tracking an operation, once it was included in a block or deleted.
+func (p *opPool) remove(root [32]byte) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if op, ok := p.ops[root]; ok {
+		p.delete(op)
+	}
+	pendingOperations.WithLabelValues(p.name).Set(float64(len(p.ops)))
+}
+
+// removeBefore stops tracking every operation older than the given slot.
+func (p *opPool) removeBefore(slot uint64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for _, op := range p.ops {
+		if op.slot < slot {
+			p.delete(op)
+		}
+	}
+	pendingOperations.WithLabelValues(p.name).Set(float64(len(p.ops)))
+}
+
+func (p *opPool) delete(op *pendingOp) {
+	delete(p.ops, op.root)
+	p.slotCounts[op.slot]--
+	if p.slotCounts[op.slot] <= 0 {
+		delete(p.slotCounts, op.slot)
+	}
+}
+
+// victim returns the operation the eviction policy drops first among the incoming
+// operation and the pending ones matching the filter.
+func (p *opPool) victim(incoming *pendingOp, filter func(*pendingOp) bool) *pendingOp {
+	victim := incoming
+	for _, op := range p.ops {
+		if filter(op) && p.ranksBelow(op, victim) {
+			victim = op
+		}
+	}
+	return victim
+}
+
+func (p *opPool) ranksBelow(a *pendingOp, b *pendingOp) bool {
+	if p.policy == EvictLowestValueFirst && a.value != b.value {
+		return a.value < b.value
+	}
+	return a.seq < b.seq
+}
+
+// restorePoolLimits tracks the pending operations left in the DB by a previous run, so that
+// the pool limits account for them after a restart. Operations beyond the limits, when they
+// were lowered, are evicted from the DB.
+func (s *Service) restorePoolLimits(ctx context.Context) error {
+	restored := make(map[string]int)
+	var evicted [][32]byte
+	admit := func(pool *opPool, root [32]byte, slot uint64, value uint64) {
+		roots, err := pool.admit(root, slot, value)
+		if err == ErrPoolFull {
+			// The stored operation ranks below every tracked one, it is evicted itself.
+			roots = [][32]byte{root}
+		}
+		if len(roots) > 0 {
+			evicted = append(evicted, roots...)
+			return
+		}
+		restored[pool.name]++
+	}
+
+	atts, err := s.beaconDB.Attestations(ctx, nil /*filter*/)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve pending attestations")
+	}
+	for _, att := range atts {
+		hash, err := hashutil.HashProto(att.Data)
+		if err != nil {
+			return err
+		}
+		// The start slot of the target epoch stands in for the attestation slot, which
+		// requires a state to compute.
+		admit(s.attestations, hash, helpers.StartSlot(att.Data.Target.Epoch), att.AggregationBits.Count())
+	}
+	for _, root := range evicted {
+		if err := s.beaconDB.DeleteAttestation(ctx, root); err != nil {
+			return errors.Wrap(err, "could not delete evicted attestation")
+		}
+	}
+	evicted = nil
+
+	if d, ok := s.beaconDB.(*db.BeaconDB); ok {
+		exits, err := d.Exits(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not retrieve pending exits")
+		}
+		for _, exit := range exits {
+			hash, err := hashutil.HashProto(exit)
+			if err != nil {
+				return err
+			}
+			admit(s.exits, hash, helpers.StartSlot(exit.Epoch), 0 /*value*/)
+		}
+		for _, root := range evicted {
+			if err := d.DeleteExit(ctx, root); err != nil {
+				return errors.Wrap(err, "could not delete evicted exit")
+			}
+		}
+		evicted = nil
+	} else {
+		proposerSlashings, err := s.beaconDB.ProposerSlashings(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not retrieve pending proposer slashings")
+		}
+		for _, slashing := range proposerSlashings {
+			root, err := ssz.HashTreeRoot(slashing)
+			if err != nil {
+				return err
+			}
+			admit(s.proposerSlashings, root, slashing.Header_1.Slot, 0 /*value*/)
+		}
+		for _, root := range evicted {
+			if err := s.beaconDB.DeleteProposerSlashing(ctx, root); err != nil {
+				return errors.Wrap(err, "could not delete evicted proposer slashing")
+			}
+		}
+		evicted = nil
+
+		attesterSlashings, err := s.beaconDB.AttesterSlashings(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not retrieve pending attester slashings")
+		}
+		for _, slashing := range attesterSlashings {
+			root, err := ssz.HashTreeRoot(slashing)
+			if err != nil {
+				return err
+			}
+			admit(s.attesterSlashings, root, helpers.StartSlot(slashing.Attestation_1.Data.Target.Epoch), 0 /*value*/)
+		}
+		for _, root := range evicted {
+			if err := s.beaconDB.DeleteAttesterSlashing(ctx, root); err != nil {
+				return errors.Wrap(err, "could not delete evicted attester slashing")
+			}
+		}
+	}
+
+	log.WithFields(logrus.Fields{
+		"attestations":      restored[s.attestations.name],
+		"exits":             restored[s.exits.name],
+		"proposerSlashings": restored[s.proposerSlashings.name],
+		"attesterSlashings": restored[s.attesterSlashings.name],
+	}).Info("Restored pending operations into the pool limits")
+	return nil
+}
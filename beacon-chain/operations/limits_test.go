@@ -0,0 +1,213 @@
+package operations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+func TestOpPool_OldestFirstEvictsOldest(t *testing.T) {
+	p := newOpPool("test", 2, 0, EvictOldestFirst)
+	for _, root := range [][32]byte{{'a'}, {'b'}} {
+		if _, err := p.admit(root, 1, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	evicted, err := p.admit([32]byte{'c'}, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evicted) != 1 || evicted[0] != [32]byte{'a'} {
+		t.Errorf("Wanted %v evicted, got %v", [][32]byte{{'a'}}, evicted)
+	}
+	if len(p.ops) != 2 {
+		t.Errorf("Wanted 2 pending operations, got %d", len(p.ops))
+	}
+}
+
+func TestOpPool_LowestValueFirstEvictsLowestValue(t *testing.T) {
+	p := newOpPool("test", 2, 0, EvictLowestValueFirst)
+	if _, err := p.admit([32]byte{'a'}, 1, 3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.admit([32]byte{'b'}, 1, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	evicted, err := p.admit([32]byte{'c'}, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evicted) != 1 || evicted[0] != [32]byte{'b'} {
+		t.Errorf("Wanted %v evicted, got %v", [][32]byte{{'b'}}, evicted)
+	}
+
+	// An operation worth less than every pending one is rejected.
+	if _, err := p.admit([32]byte{'d'}, 1, 1); err != ErrPoolFull {
+		t.Errorf("Wanted %v, got %v", ErrPoolFull, err)
+	}
+	if _, ok := p.ops[[32]byte{'d'}]; ok {
+		t.Error("Expected rejected operation to not be tracked")
+	}
+}
+
+func TestOpPool_PerSlotLimitOnlyEvictsFromSameSlot(t *testing.T) {
+	p := newOpPool("test", 0, 1, EvictOldestFirst)
+	if _, err := p.admit([32]byte{'a'}, 1, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.admit([32]byte{'b'}, 2, 0); err != nil {
+		t.Fatal(err)
+	}
+	evicted, err := p.admit([32]byte{'c'}, 2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evicted) != 1 || evicted[0] != [32]byte{'b'} {
+		t.Errorf("Wanted %v evicted, got %v", [][32]byte{{'b'}}, evicted)
+	}
+	if p.slotCounts[1] != 1 || p.slotCounts[2] != 1 {
+		t.Errorf("Wanted 1 pending operation per slot, got %v", p.slotCounts)
+	}
+}
+
+func TestOpPool_RemoveBefore(t *testing.T) {
+	p := newOpPool("test", 0, 0, EvictOldestFirst)
+	for slot := uint64(0); slot < 4; slot++ {
+		if _, err := p.admit([32]byte{byte(slot)}, slot, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	p.removeBefore(2)
+	if len(p.ops) != 2 {
+		t.Errorf("Wanted 2 pending operations, got %d", len(p.ops))
+	}
+	if _, ok := p.slotCounts[1]; ok {
+		t.Error("Expected slot 1 to no longer be tracked")
+	}
+}
+
+func TestHandleValidatorExits_EvictsFromDB(t *testing.T) {
+	beaconDB := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{
+		BeaconDB: beaconDB,
+		PoolLimits: &PoolLimits{
+			MaxExits:       1,
+			EvictionPolicy: EvictOldestFirst,
+		},
+	})
+
+	oldExit := &ethpb.VoluntaryExit{Epoch: 100}
+	newExit := &ethpb.VoluntaryExit{Epoch: 101}
+	for _, exit := range []*ethpb.VoluntaryExit{oldExit, newExit} {
+		if err := service.HandleValidatorExits(context.Background(), exit); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	oldHash, err := hashutil.HashProto(oldExit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newHash, err := hashutil.HashProto(newExit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beaconDB.HasExit(oldHash) {
+		t.Error("Expected evicted exit to be deleted from DB")
+	}
+	if !beaconDB.HasExit(newHash) {
+		t.Error("Expected new exit to be saved in DB")
+	}
+}
+
+func TestRestorePoolLimits_TracksStoredOperations(t *testing.T) {
+	beaconDB := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, beaconDB)
+	ctx := context.Background()
+
+	exits := []*ethpb.VoluntaryExit{{Epoch: 100}, {Epoch: 101}, {Epoch: 102}}
+	for _, exit := range exits {
+		if err := beaconDB.SaveExit(ctx, exit); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The limit was lowered since the exits were saved.
+	service := NewOpsPoolService(ctx, &Config{
+		BeaconDB: beaconDB,
+		PoolLimits: &PoolLimits{
+			MaxExits:       2,
+			EvictionPolicy: EvictOldestFirst,
+		},
+	})
+	if err := service.restorePoolLimits(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(service.exits.ops) != 2 {
+		t.Errorf("Wanted 2 exits tracked after a restart, got %d", len(service.exits.ops))
+	}
+	stored, err := beaconDB.Exits(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 2 {
+		t.Errorf("Wanted the exit beyond the limit deleted from DB, got %d stored", len(stored))
+	}
+
+	// A new exit counts against the restored ones.
+	if err := service.HandleValidatorExits(ctx, &ethpb.VoluntaryExit{Epoch: 103}); err != nil {
+		t.Fatal(err)
+	}
+	if len(service.exits.ops) != 2 {
+		t.Errorf("Wanted the limit of 2 exits enforced, got %d", len(service.exits.ops))
+	}
+}
+
+func TestHandleProcessedBlock_RemovesIncludedSlashings(t *testing.T) {
+	beaconDB := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, beaconDB)
+	if err := beaconDB.SaveStateDeprecated(context.Background(), &pb.BeaconState{}); err != nil {
+		t.Fatal(err)
+	}
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+
+	proposerSlashing := &ethpb.ProposerSlashing{ProposerIndex: 1}
+	attesterSlashing := &ethpb.AttesterSlashing{
+		Attestation_1: &ethpb.IndexedAttestation{Data: &ethpb.AttestationData{Target: &ethpb.Checkpoint{}}},
+		Attestation_2: &ethpb.IndexedAttestation{Data: &ethpb.AttestationData{Target: &ethpb.Checkpoint{}}},
+	}
+	proposerRoot, err := ssz.HashTreeRoot(proposerSlashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attesterRoot, err := ssz.HashTreeRoot(attesterSlashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.proposerSlashings.admit(proposerRoot, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.attesterSlashings.admit(attesterRoot, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	block := &ethpb.BeaconBlock{
+		Body: &ethpb.BeaconBlockBody{
+			ProposerSlashings: []*ethpb.ProposerSlashing{proposerSlashing},
+			AttesterSlashings: []*ethpb.AttesterSlashing{attesterSlashing},
+		},
+	}
+	if err := service.handleProcessedBlock(context.Background(), block); err != nil {
+		t.Fatal(err)
+	}
+	if len(service.proposerSlashings.ops) != 0 || len(service.attesterSlashings.ops) != 0 {
+		t.Errorf("Wanted included slashings removed from the pool, got %d proposer and %d attester slashings",
+			len(service.proposerSlashings.ops), len(service.attesterSlashings.ops))
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	handler "github.com/prysmaticlabs/prysm/shared/messagehandler"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/tracing"
 )
 
 var log = logrus.WithField("prefix", "operation")
@@ -61,18 +62,28 @@ type Service struct {
 	p2p                        p2p.Broadcaster
 	error                      error
 	attestationLock            sync.Mutex
+	attestations               *opPool
+	exits                      *opPool
+	proposerSlashings          *opPool
+	attesterSlashings          *opPool
+	included                   *includedAtts
 }
 
 // Config options for the service.
 type Config struct {
-	BeaconDB db.Database
-	P2P      p2p.Broadcaster
+	BeaconDB   db.Database
+	P2P        p2p.Broadcaster
+	PoolLimits *PoolLimits
 }
 
 // NewOpsPoolService instantiates a new service instance that will
 // be registered into a running beacon node.
 func NewOpsPoolService(ctx context.Context, cfg *Config) *Service {
 	ctx, cancel := context.WithCancel(ctx)
+	limits := cfg.PoolLimits
+	if limits == nil {
+		limits = DefaultPoolLimits()
+	}
 	return &Service{
 		ctx:                        ctx,
 		cancel:                     cancel,
@@ -84,12 +95,23 @@ func NewOpsPoolService(ctx context.Context, cfg *Config) *Service {
 		incomingProcessedBlockFeed: new(event.Feed),
 		incomingProcessedBlock:     make(chan *ethpb.BeaconBlock, params.BeaconConfig().DefaultBufferSize),
 		p2p:                        cfg.P2P,
+		attestations:               newOpPool("attestation", limits.MaxAttestations, limits.MaxAttestationsPerSlot, limits.EvictionPolicy),
+		exits:                      newOpPool("voluntary_exit", limits.MaxExits, 0, limits.EvictionPolicy),
+		proposerSlashings:          newOpPool("proposer_slashing", limits.MaxSlashings, 0, limits.EvictionPolicy),
+		attesterSlashings:          newOpPool("attester_slashing", limits.MaxSlashings, 0, limits.EvictionPolicy),
+		included:                   newIncludedAtts(),
 	}
 }
 
 // Start an beacon block operation pool service's main event loop.
 func (s *Service) Start() {
 	log.Info("Starting service")
+	if err := s.restoreIncludedAttestations(s.ctx); err != nil {
+		log.WithError(err).Warn("Could not restore included attestations from canonical blocks")
+	}
+	if err := s.restorePoolLimits(s.ctx); err != nil {
+		log.WithError(err).Warn("Could not restore pending operations into the pool limits")
+	}
 	go s.saveOperations()
 	go s.removeOperations()
 }
@@ -146,8 +168,19 @@ func (s *Service) AttestationPool(ctx context.Context, requestedSlot uint64) ([]
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not process slots up to %d", requestedSlot)
 	}
+	// The head may have been updated after its block was processed, as the deprecated chain
+	// service runs its fork choice once the block operations are cleaned up.
+	if err := s.updateIncludedAttestations(ctx); err != nil {
+		log.WithError(err).Warn("Could not record included attestations of the canonical chain")
+	}
 
+	// The attestations with the most votes come first, so that the ones left out past the
+	// maximum number of attestations per block are the least valuable.
 	sort.Slice(attestationsFromDB, func(i, j int) bool {
+		iVotes, jVotes := attestationsFromDB[i].AggregationBits.Count(), attestationsFromDB[j].AggregationBits.Count()
+		if iVotes != jVotes {
+			return iVotes > jVotes
+		}
 		return attestationsFromDB[i].Data.Crosslink.Shard < attestationsFromDB[j].Data.Crosslink.Shard
 	})
 
@@ -170,6 +203,15 @@ func (s *Service) AttestationPool(ctx context.Context, requestedSlot uint64) ([]
 			continue
 		}
 
+		// Attestations already included on chain add nothing to a new block.
+		dataRoot, err := hashutil.HashProto(att.Data)
+		if err != nil {
+			return nil, err
+		}
+		if s.included.covers(dataRoot, att.AggregationBits) {
+			continue
+		}
+
 		validAttsCount++
 		// Stop the max attestation number per beacon block is reached.
 		if validAttsCount == params.BeaconConfig().MaxAttestations {
@@ -217,17 +259,83 @@ func (s *Service) HandleValidatorExits(ctx context.Context, message proto.Messag
 	if err != nil {
 		return err
 	}
+	evicted, err := s.exits.admit(hash, helpers.StartSlot(exit.Epoch), 0 /*value*/)
+	if err != nil {
+		return err
+	}
+	for _, root := range evicted {
+		if err := s.beaconDB.(*db.BeaconDB).DeleteExit(ctx, root); err != nil {
+			return errors.Wrap(err, "could not delete evicted exit")
+		}
+	}
 	if err := s.beaconDB.(*db.BeaconDB).SaveExit(ctx, exit); err != nil {
+		s.exits.remove(hash)
 		return err
 	}
 	log.WithField("hash", fmt.Sprintf("%#x", hash)).Info("Exit request saved in DB")
 	return nil
 }
 
+// HandleProposerSlashing processes a proposer slashing operation.
+func (s *Service) HandleProposerSlashing(ctx context.Context, message proto.Message) error {
+	ctx, span := trace.StartSpan(ctx, "operations.HandleProposerSlashing")
+	defer span.End()
+
+	slashing := message.(*ethpb.ProposerSlashing)
+	root, err := ssz.HashTreeRoot(slashing)
+	if err != nil {
+		return err
+	}
+	evicted, err := s.proposerSlashings.admit(root, slashing.Header_1.Slot, 0 /*value*/)
+	if err != nil {
+		return err
+	}
+	for _, r := range evicted {
+		if err := s.beaconDB.DeleteProposerSlashing(ctx, r); err != nil {
+			return errors.Wrap(err, "could not delete evicted proposer slashing")
+		}
+	}
+	if err := s.beaconDB.SaveProposerSlashing(ctx, slashing); err != nil {
+		s.proposerSlashings.remove(root)
+		return err
+	}
+	return nil
+}
+
+// HandleAttesterSlashing processes an attester slashing operation.
+func (s *Service) HandleAttesterSlashing(ctx context.Context, message proto.Message) error {
+	ctx, span := trace.StartSpan(ctx, "operations.HandleAttesterSlashing")
+	defer span.End()
+
+	slashing := message.(*ethpb.AttesterSlashing)
+	root, err := ssz.HashTreeRoot(slashing)
+	if err != nil {
+		return err
+	}
+	slot := helpers.StartSlot(slashing.Attestation_1.Data.Target.Epoch)
+	evicted, err := s.attesterSlashings.admit(root, slot, 0 /*value*/)
+	if err != nil {
+		return err
+	}
+	for _, r := range evicted {
+		if err := s.beaconDB.DeleteAttesterSlashing(ctx, r); err != nil {
+			return errors.Wrap(err, "could not delete evicted attester slashing")
+		}
+	}
+	if err := s.beaconDB.SaveAttesterSlashing(ctx, slashing); err != nil {
+		s.attesterSlashings.remove(root)
+		return err
+	}
+	return nil
+}
+
 // HandleAttestation processes a received attestation message.
 func (s *Service) HandleAttestation(ctx context.Context, message proto.Message) error {
 	ctx, span := trace.StartSpan(ctx, "operations.HandleAttestation")
 	defer span.End()
+	if dutyID := tracing.DutyID(ctx); dutyID != "" {
+		span.AddAttributes(trace.StringAttribute("dutyID", dutyID))
+	}
 	s.attestationLock.Lock()
 	defer s.attestationLock.Unlock()
 
@@ -246,7 +354,20 @@ func (s *Service) HandleAttestation(ctx context.Context, message proto.Message)
 		}
 	}
 
+	attestationDataSlot, err := helpers.AttestationDataSlot(bState, attestation.Data)
+	if err != nil {
+		return errors.Wrap(err, "could not get attestation slot")
+	}
+	// Attestations more than one epoch older than the head state can no longer be included by
+	// proposers, those exactly one epoch old still can.
+	if attestationDataSlot+params.BeaconConfig().SlotsPerEpoch < bState.Slot {
+		return errors.Wrapf(ErrPastSlot, "attestation slot %d, head slot %d", attestationDataSlot, bState.Slot)
+	}
+
 	if err := blocks.VerifyAttestation(bState, attestation); err != nil {
+		if errors.Cause(err) == blocks.ErrSigFailedToVerify {
+			return errors.Wrap(ErrInvalidSignature, err.Error())
+		}
 		return err
 	}
 
@@ -256,6 +377,9 @@ func (s *Service) HandleAttestation(ctx context.Context, message proto.Message)
 	}
 
 	incomingAttBits := attestation.AggregationBits
+	if s.included.covers(hash, incomingAttBits) {
+		return ErrAlreadyIncluded
+	}
 	if s.beaconDB.HasAttestation(ctx, hash) {
 		dbAtt, err := s.beaconDB.Attestation(ctx, hash)
 		if err != nil {
@@ -264,6 +388,9 @@ func (s *Service) HandleAttestation(ctx context.Context, message proto.Message)
 
 		if !dbAtt.AggregationBits.Contains(incomingAttBits) {
 			newAggregationBits := dbAtt.AggregationBits.Or(incomingAttBits)
+			if err := s.admitAttestation(ctx, hash, attestationDataSlot, newAggregationBits.Count()); err != nil {
+				return err
+			}
 			incomingAttSig, err := bls.SignatureFromBytes(attestation.Signature)
 			if err != nil {
 				return err
@@ -279,16 +406,35 @@ func (s *Service) HandleAttestation(ctx context.Context, message proto.Message)
 				return err
 			}
 		} else {
-			return nil
+			return ErrAlreadyAggregated
 		}
 	} else {
+		if err := s.admitAttestation(ctx, hash, attestationDataSlot, incomingAttBits.Count()); err != nil {
+			return err
+		}
 		if err := s.beaconDB.SaveAttestation(ctx, attestation); err != nil {
+			s.attestations.remove(hash)
 			return err
 		}
 	}
 	return nil
 }
 
+// admitAttestation makes room in the pool for an attestation, valued by the number of
+// votes it aggregates, deleting the attestations evicted in its favour.
+func (s *Service) admitAttestation(ctx context.Context, hash [32]byte, slot uint64, votes uint64) error {
+	evicted, err := s.attestations.admit(hash, slot, votes)
+	if err != nil {
+		return err
+	}
+	for _, root := range evicted {
+		if err := s.beaconDB.DeleteAttestation(ctx, root); err != nil {
+			return errors.Wrap(err, "could not delete evicted attestation")
+		}
+	}
+	return nil
+}
+
 // IsAttCanonical returns true if the input attestation is voting on the canonical chain, false
 // otherwise. The steps to verify are:
 //	1.) retrieve the voted block
@@ -342,6 +488,9 @@ func (s *Service) handleProcessedBlock(ctx context.Context, message proto.Messag
 	if err := s.removeAttestationsFromPool(ctx, block.Body.Attestations); err != nil {
 		return errors.Wrap(err, "could not remove processed attestations from DB")
 	}
+	if err := s.updateIncludedAttestations(ctx); err != nil {
+		log.WithError(err).Warn("Could not record included attestations of the canonical chain")
+	}
 	state, err := s.beaconDB.HeadState(s.ctx)
 	if err != nil {
 		return errors.New("could not retrieve attestations from DB")
@@ -349,6 +498,37 @@ func (s *Service) handleProcessedBlock(ctx context.Context, message proto.Messag
 	if err := s.removeEpochOldAttestations(ctx, state); err != nil {
 		return errors.Wrapf(err, "could not remove old attestations from DB at slot %d", block.Slot)
 	}
+	// Attestations one epoch older than the head state can no longer be included,
+	// so they should not take up room in the pool.
+	if state.Slot >= params.BeaconConfig().SlotsPerEpoch {
+		s.attestations.removeBefore(state.Slot - params.BeaconConfig().SlotsPerEpoch + 1)
+	}
+	if window := includedEpochs * params.BeaconConfig().SlotsPerEpoch; state.Slot > window {
+		s.included.removeBefore(state.Slot - window)
+	}
+	for _, exit := range block.Body.VoluntaryExits {
+		hash, err := hashutil.HashProto(exit)
+		if err != nil {
+			return err
+		}
+		s.exits.remove(hash)
+	}
+	// Slashings are tracked by their hash tree root, see HandleProposerSlashing and
+	// HandleAttesterSlashing.
+	for _, slashing := range block.Body.ProposerSlashings {
+		root, err := ssz.HashTreeRoot(slashing)
+		if err != nil {
+			return err
+		}
+		s.proposerSlashings.remove(root)
+	}
+	for _, slashing := range block.Body.AttesterSlashings {
+		root, err := ssz.HashTreeRoot(slashing)
+		if err != nil {
+			return err
+		}
+		s.attesterSlashings.remove(root)
+	}
 	return nil
 }
 
@@ -360,6 +540,7 @@ func (s *Service) removeAttestationsFromPool(ctx context.Context, attestations [
 		if err != nil {
 			return err
 		}
+		s.attestations.remove(hash)
 		if s.beaconDB.HasAttestation(ctx, hash) {
 			if err := s.beaconDB.DeleteAttestation(ctx, hash); err != nil {
 				return err
@@ -420,8 +420,8 @@ func TestHandleAttestation_Skips_PreviouslyAggregatedAttestations(t *testing.T)
 		t.Error(err)
 	}
 
-	if err := service.HandleAttestation(context.Background(), att1); err != nil {
-		t.Error(err)
+	if err := service.HandleAttestation(context.Background(), att1); err != ErrAlreadyAggregated {
+		t.Errorf("Expected %v, received %v", ErrAlreadyAggregated, err)
 	}
 
 	attDataHash, err := hashutil.HashProto(att2.Data)
@@ -442,8 +442,8 @@ func TestHandleAttestation_Skips_PreviouslyAggregatedAttestations(t *testing.T)
 		t.Error("Expected aggregated signatures to be equal")
 	}
 
-	if err := service.HandleAttestation(context.Background(), att2); err != nil {
-		t.Error(err)
+	if err := service.HandleAttestation(context.Background(), att2); err != ErrAlreadyAggregated {
+		t.Errorf("Expected %v, received %v", ErrAlreadyAggregated, err)
 	}
 	dbAtt, err = service.beaconDB.Attestation(ctx, attDataHash)
 	if err != nil {
@@ -458,8 +458,8 @@ func TestHandleAttestation_Skips_PreviouslyAggregatedAttestations(t *testing.T)
 		t.Error("Expected aggregated signatures to be equal")
 	}
 
-	if err := service.HandleAttestation(context.Background(), att3); err != nil {
-		t.Error(err)
+	if err := service.HandleAttestation(context.Background(), att3); err != ErrAlreadyAggregated {
+		t.Errorf("Expected %v, received %v", ErrAlreadyAggregated, err)
 	}
 	dbAtt, err = service.beaconDB.Attestation(ctx, attDataHash)
 	if err != nil {
@@ -520,6 +520,53 @@ func TestRetrieveAttestations_OK(t *testing.T) {
 	}
 }
 
+func TestRetrieveAttestations_MostVotesFirst(t *testing.T) {
+	helpers.ClearAllCaches()
+
+	beaconDB := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+
+	// Save 140 attestations, the ones of the last shards with the most votes. They would be left
+	// out past the maximum number of attestations if the pool was ordered by shard.
+	origAttestations := make([]*ethpb.Attestation, 140)
+	for i := 0; i < len(origAttestations); i++ {
+		origAttestations[i] = &ethpb.Attestation{
+			Data: &ethpb.AttestationData{
+				Crosslink: &ethpb.Crosslink{
+					Shard: uint64(i),
+				},
+				Source: &ethpb.Checkpoint{},
+				Target: &ethpb.Checkpoint{},
+			},
+		}
+		if i >= 130 {
+			origAttestations[i].AggregationBits = bitfield.Bitlist{0x07}
+		}
+		if err := service.beaconDB.SaveAttestation(context.Background(), origAttestations[i]); err != nil {
+			t.Fatalf("Failed to save attestation: %v", err)
+		}
+	}
+	if err := beaconDB.SaveStateDeprecated(context.Background(), &pb.BeaconState{
+		Slot: 64,
+		CurrentCrosslinks: []*ethpb.Crosslink{{
+			StartEpoch: 0,
+			DataRoot:   params.BeaconConfig().ZeroHash[:]}}}); err != nil {
+		t.Fatal(err)
+	}
+	attestations, err := service.AttestationPool(context.Background(), 64)
+	if err != nil {
+		t.Fatalf("Could not retrieve attestations: %v", err)
+	}
+
+	if !reflect.DeepEqual(attestations[:10], origAttestations[130:]) {
+		t.Error("Wanted the attestations with the most votes first")
+	}
+	if !reflect.DeepEqual(attestations[10:], origAttestations[0:117]) {
+		t.Error("Wanted the other attestations ordered by shard")
+	}
+}
+
 func TestRetrieveAttestations_PruneInvalidAtts(t *testing.T) {
 	helpers.ClearAllCaches()
 
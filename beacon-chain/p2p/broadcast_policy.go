@@ -0,0 +1,93 @@
+package p2p
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/shared/publishutil"
+)
+
+// BroadcastPolicy decides if and when the blocks processed by the chain service are broadcast to
+// peers. The blocks proposed by the validators connected to the node are always broadcast
+// immediately, so that the node never costs them their proposals.
+type BroadcastPolicy string
+
+const (
+	// BroadcastImmediate broadcasts blocks as soon as they are processed.
+	BroadcastImmediate BroadcastPolicy = "immediate"
+	// BroadcastDelayed holds blocks back for a fixed delay before broadcasting them, to study
+	// late blocks in research setups.
+	BroadcastDelayed BroadcastPolicy = "delayed"
+	// BroadcastLocalOnly never broadcasts blocks, for nodes which only analyze the chain.
+	BroadcastLocalOnly BroadcastPolicy = "local-only"
+)
+
+type localProposalKey struct{}
+
+// WithLocalProposal returns a copy of the context marking the block broadcast with it as proposed
+// by a validator connected to the node, which is broadcast whatever the policy.
+func WithLocalProposal(ctx context.Context) context.Context {
+	return context.WithValue(ctx, localProposalKey{}, true)
+}
+
+func isLocalProposal(ctx context.Context) bool {
+	local, _ := ctx.Value(localProposalKey{}).(bool)
+	return local
+}
+
+// blockBroadcaster applies a broadcast policy to the messages of an underlying broadcaster.
+type blockBroadcaster struct {
+	Broadcaster
+	policy    BroadcastPolicy
+	delay     time.Duration
+	publishes *publishutil.Tracker
+}
+
+// NewBlockBroadcaster wraps the broadcaster used by the chain service to announce blocks so
+// that it follows the given policy. The broadcaster is returned as is for BroadcastImmediate.
+func NewBlockBroadcaster(b Broadcaster, policy BroadcastPolicy, delay time.Duration) Broadcaster {
+	if policy == BroadcastImmediate {
+		return b
+	}
+	bb := &blockBroadcaster{
+		Broadcaster: b,
+		policy:      policy,
+		delay:       delay,
+	}
+	if tracker, ok := b.(PublishTracker); ok {
+		bb.publishes = tracker.PublishTracker()
+	}
+	return bb
+}
+
+// Broadcast withholds or delays the message according to the policy, unless it is a local
+// proposal.
+func (b *blockBroadcaster) Broadcast(ctx context.Context, msg proto.Message) error {
+	if isLocalProposal(ctx) {
+		return b.Broadcaster.Broadcast(ctx, msg)
+	}
+	switch b.policy {
+	case BroadcastLocalOnly:
+		log.WithField("type", reflect.TypeOf(msg)).Debug("Not broadcasting message with local-only broadcast policy")
+		return nil
+	case BroadcastDelayed:
+		// Shutdown waits for the delayed message to be sent to peers, as for any other
+		// critical message.
+		done := func() {}
+		if b.publishes != nil && publishutil.Critical(msg) {
+			done = b.publishes.Begin()
+		}
+		// The message outlives the caller's context, which is often an RPC request.
+		time.AfterFunc(b.delay, func() {
+			defer done()
+			if err := b.Broadcaster.Broadcast(context.Background(), msg); err != nil {
+				log.WithError(err).Error("Could not broadcast delayed message")
+			}
+		})
+		return nil
+	default:
+		return b.Broadcaster.Broadcast(ctx, msg)
+	}
+}
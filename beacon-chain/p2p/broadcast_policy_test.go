@@ -0,0 +1,99 @@
+package p2p
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	testpb "github.com/prysmaticlabs/prysm/proto/testing"
+	"github.com/prysmaticlabs/prysm/shared/publishutil"
+)
+
+type countingBroadcaster struct {
+	lock  sync.Mutex
+	count int
+}
+
+func (c *countingBroadcaster) Broadcast(_ context.Context, _ proto.Message) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.count++
+	return nil
+}
+
+func (c *countingBroadcaster) broadcasts() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.count
+}
+
+type trackingBroadcaster struct {
+	countingBroadcaster
+	publishes publishutil.Tracker
+}
+
+func (c *trackingBroadcaster) PublishTracker() *publishutil.Tracker {
+	return &c.publishes
+}
+
+func TestNewBlockBroadcaster_Immediate(t *testing.T) {
+	b := &countingBroadcaster{}
+	if NewBlockBroadcaster(b, BroadcastImmediate, time.Second) != Broadcaster(b) {
+		t.Error("Wanted immediate policy to return the broadcaster as is")
+	}
+}
+
+func TestBlockBroadcaster_LocalOnly(t *testing.T) {
+	b := &countingBroadcaster{}
+	if err := NewBlockBroadcaster(b, BroadcastLocalOnly, 0).Broadcast(context.Background(), &testpb.TestSimpleMessage{}); err != nil {
+		t.Fatal(err)
+	}
+	if n := b.broadcasts(); n != 0 {
+		t.Errorf("Wanted no broadcast, got %d", n)
+	}
+}
+
+func TestBlockBroadcaster_Delayed(t *testing.T) {
+	b := &countingBroadcaster{}
+	delay := 50 * time.Millisecond
+	if err := NewBlockBroadcaster(b, BroadcastDelayed, delay).Broadcast(context.Background(), &testpb.TestSimpleMessage{}); err != nil {
+		t.Fatal(err)
+	}
+	if n := b.broadcasts(); n != 0 {
+		t.Errorf("Wanted no broadcast before the delay, got %d", n)
+	}
+	time.Sleep(4 * delay)
+	if n := b.broadcasts(); n != 1 {
+		t.Errorf("Wanted 1 broadcast after the delay, got %d", n)
+	}
+}
+
+func TestBlockBroadcaster_DelayedIsFlushed(t *testing.T) {
+	b := &trackingBroadcaster{}
+	delay := 100 * time.Millisecond
+	if err := NewBlockBroadcaster(b, BroadcastDelayed, delay).Broadcast(context.Background(), &ethpb.BeaconBlock{}); err != nil {
+		t.Fatal(err)
+	}
+	if !b.publishes.Flush(5 * time.Second) {
+		t.Error("Wanted flush to succeed")
+	}
+	if n := b.broadcasts(); n != 1 {
+		t.Errorf("Wanted the delayed block to be broadcast before the flush returned, got %d broadcasts", n)
+	}
+}
+
+func TestBlockBroadcaster_LocalProposalIsImmediate(t *testing.T) {
+	ctx := WithLocalProposal(context.Background())
+	for _, policy := range []BroadcastPolicy{BroadcastLocalOnly, BroadcastDelayed} {
+		b := &countingBroadcaster{}
+		if err := NewBlockBroadcaster(b, policy, time.Hour).Broadcast(ctx, &ethpb.BeaconBlock{}); err != nil {
+			t.Fatal(err)
+		}
+		if n := b.broadcasts(); n != 1 {
+			t.Errorf("Wanted the local proposal to be broadcast right away with the %s policy, got %d broadcasts", policy, n)
+		}
+	}
+}
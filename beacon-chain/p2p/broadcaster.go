@@ -7,6 +7,9 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/publishutil"
+	"github.com/prysmaticlabs/prysm/shared/tracing"
+	"go.opencensus.io/trace"
 )
 
 // ErrMessageNotMapped occurs on a Broadcast attempt when a message has not been defined in the
@@ -15,10 +18,22 @@ var ErrMessageNotMapped = errors.New("message type is not mapped to a PubSub top
 
 // Broadcast a message to the p2p network.
 func (s *Service) Broadcast(ctx context.Context, msg proto.Message) error {
+	_, span := trace.StartSpan(ctx, "p2p.Broadcast")
+	defer span.End()
+
 	topic, ok := GossipTypeMapping[reflect.TypeOf(msg)]
 	if !ok {
 		return ErrMessageNotMapped
 	}
+	span.AddAttributes(trace.StringAttribute("topic", topic))
+	if publishutil.Critical(msg) {
+		// Shutdown waits for the message to be sent to peers.
+		done := s.publishes.Begin()
+		defer done()
+	}
+	if dutyID := tracing.DutyID(ctx); dutyID != "" {
+		span.AddAttributes(trace.StringAttribute("dutyID", dutyID))
+	}
 
 	buf := new(bytes.Buffer)
 	if _, err := s.Encoding().Encode(buf, msg); err != nil {
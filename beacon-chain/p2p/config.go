@@ -1,5 +1,7 @@
 package p2p
 
+import "time"
+
 // Config for the p2p service. These parameters are set from application level flags
 // to initialize the p2p service.
 type Config struct {
@@ -15,4 +17,9 @@ type Config struct {
 	WhitelistCIDR     string
 	EnableUPnP        bool
 	Encoding          string
+	IPPreference      IPPreference
+
+	// ShutdownGracePeriod bounds how long the service waits at shutdown for the blocks,
+	// slashings and voluntary exits being broadcast to be sent to peers. Zero disables the wait.
+	ShutdownGracePeriod time.Duration
 }
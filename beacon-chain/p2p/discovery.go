@@ -1,6 +1,7 @@
 package p2p
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"fmt"
 	"net"
@@ -31,7 +32,7 @@ func createListener(ipAddr net.IP, port int, privKey *ecdsa.PrivateKey) *discv5.
 		IP:   ipAddr,
 		Port: port,
 	}
-	conn, err := net.ListenUDP("udp4", udpAddr)
+	conn, err := net.ListenUDP(fmt.Sprintf("udp%d", ipVersion(ipAddr)), udpAddr)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -43,12 +44,14 @@ func createListener(ipAddr net.IP, port int, privKey *ecdsa.PrivateKey) *discv5.
 	return network
 }
 
-func startDiscoveryV5(addr net.IP, privKey *ecdsa.PrivateKey, cfg *Config) (*discv5.Network, error) {
-	listener := createListener(addr, int(cfg.UDPPort), privKey)
+// startDiscoveryV5 starts discovery on the listening address of the IP version of the bootstrap
+// node.
+func startDiscoveryV5(ips []net.IP, privKey *ecdsa.PrivateKey, cfg *Config) (*discv5.Network, error) {
 	bootNode, err := discv5.ParseNode(cfg.BootstrapNodeAddr)
 	if err != nil {
 		return nil, err
 	}
+	listener := createListener(discoveryIP(ips, bootNode.IP), int(cfg.UDPPort), privKey)
 	if err := listener.SetFallbackNodes([]*discv5.Node{bootNode}); err != nil {
 		return nil, err
 	}
@@ -57,12 +60,21 @@ func startDiscoveryV5(addr net.IP, privKey *ecdsa.PrivateKey, cfg *Config) (*dis
 	return listener, nil
 }
 
+// AdvertiseSubnet registers the topic of an attestation subnet with the discovery service until
+// the context is done. Discovery v5 nodes carry no record, so the subnets are advertised as
+// discovery topics, which peers look up with SearchTopic.
+func (s *Service) AdvertiseSubnet(ctx context.Context, subnet uint64) {
+	if s.dv5Listener == nil {
+		return
+	}
+	go s.dv5Listener.RegisterTopic(discv5.Topic(AttestationSubnetTopic(subnet)), ctx.Done())
+}
+
 func convertToMultiAddr(nodes []*discv5.Node) []ma.Multiaddr {
 	var multiAddrs []ma.Multiaddr
 	for _, node := range nodes {
-		ip4 := node.IP.To4()
-		if ip4 == nil {
-			log.Error("Node doesn't have an ip4 address")
+		if node.IP == nil || node.IP.IsUnspecified() {
+			log.Error("Node doesn't have a valid ip address")
 			continue
 		}
 		pubkey, err := node.ID.Pubkey()
@@ -75,7 +87,7 @@ func convertToMultiAddr(nodes []*discv5.Node) []ma.Multiaddr {
 		if err != nil {
 			log.Errorf("Could not get peer id: %v", err)
 		}
-		multiAddrString := fmt.Sprintf("/ip4/%s/tcp/%d/p2p/%s", ip4.String(), node.TCP, id)
+		multiAddrString := fmt.Sprintf("/ip%d/%s/tcp/%d/p2p/%s", ipVersion(node.IP), node.IP.String(), node.TCP, id)
 		multiAddr, err := ma.NewMultiaddr(multiAddrString)
 		if err != nil {
 			log.Errorf("Could not get multiaddr:%v", err)
@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"fmt"
 	"net"
+	"strings"
 	"testing"
 	"time"
 
@@ -71,7 +72,7 @@ func TestStartDiscV5_DiscoverAllPeers(t *testing.T) {
 		port = 2000 + i
 		cfg.UDPPort = uint(port)
 		ipAddr, pkey := createAddrAndPrivKey(t)
-		listener, err := startDiscoveryV5(ipAddr, pkey, cfg)
+		listener, err := startDiscoveryV5([]net.IP{ipAddr}, pkey, cfg)
 		if err != nil {
 			t.Errorf("Could not start discovery for node: %v", err)
 		}
@@ -105,7 +106,7 @@ func TestMultiAddrsConversion_InvalidIPAddr(t *testing.T) {
 	node := discv5.NewNode(nodeID, ipAddr, 0, 0)
 	_ = convertToMultiAddr([]*discv5.Node{node})
 
-	testutil.AssertLogsContain(t, hook, "Node doesn't have an ip4 address")
+	testutil.AssertLogsContain(t, hook, "Node doesn't have a valid ip address")
 }
 
 func TestMultiAddrConversion_OK(t *testing.T) {
@@ -115,7 +116,7 @@ func TestMultiAddrConversion_OK(t *testing.T) {
 	listener := createListener(ipAddr, port, pkey)
 
 	_ = convertToMultiAddr([]*discv5.Node{listener.Self()})
-	testutil.AssertLogsDoNotContain(t, hook, "Node doesn't have an ip4 address")
+	testutil.AssertLogsDoNotContain(t, hook, "Node doesn't have a valid ip address")
 	testutil.AssertLogsDoNotContain(t, hook, "Invalid port, the tcp port of the node is a reserved port")
 	testutil.AssertLogsDoNotContain(t, hook, "Could not get multiaddr")
 }
@@ -156,3 +157,20 @@ func TestStaticPeering_PeersAreAdded(t *testing.T) {
 		t.Errorf("Not all peers added to peerstore, wanted %d but got %d", 5, len(peers))
 	}
 }
+
+func TestMultiAddrConversion_IPv6(t *testing.T) {
+	pkey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Could not generate key %v", err)
+	}
+	node := discv5.NewNode(discv5.PubkeyID(&pkey.PublicKey), net.ParseIP("2001:db8::1"), 0, 3000)
+
+	addrs := convertToMultiAddr([]*discv5.Node{node})
+	if len(addrs) != 1 {
+		t.Fatalf("Wanted 1 multiaddr, got %d", len(addrs))
+	}
+	want := "/ip6/2001:db8::1/tcp/3000/p2p/"
+	if !strings.HasPrefix(addrs[0].String(), want) {
+		t.Errorf("Wanted multiaddr starting with %s, got %s", want, addrs[0])
+	}
+}
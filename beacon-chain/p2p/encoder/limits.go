@@ -0,0 +1,211 @@
+package encoder
+
+import (
+	"encoding/binary"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// ErrOperationLimit is the cause of the decoding errors of blocks holding more operations of a kind
+// than the beacon chain config allows. Such blocks can never be valid, so they are rejected before
+// any further processing, and their sender may be penalized.
+var ErrOperationLimit = errors.New("block exceeds operation limit")
+
+// ErrMessageTooLarge is the cause of the decoding errors of messages larger than allowed, before
+// or after decompression. Such messages are rejected before being read or unmarshaled.
+var ErrMessageTooLarge = errors.New("message exceeds size limit")
+
+// Message size limits, as in the networking spec.
+const (
+	// MaxGossipSize is the max size of a gossip message, and of any single RPC message.
+	MaxGossipSize = 1 << 20
+	// MaxReqRespSize is the max size of a beacon blocks response, which may hold many blocks.
+	MaxReqRespSize = 1 << 22
+)
+
+// MaxMessageSize returns the max encoded or decoded size of a message of the given type.
+func MaxMessageSize(msg proto.Message) uint64 {
+	switch msg.(type) {
+	case *pb.BeaconBlocksResponse, *pb.BatchedBeaconBlockResponse:
+		return MaxReqRespSize
+	}
+	return MaxGossipSize
+}
+
+// CheckOperationLimits verifies the operation counts of the blocks in a decoded message. Messages
+// decoded by an ssz network encoder are verified before they are unmarshaled, this is for the
+// encodings which can not be inspected before.
+func CheckOperationLimits(msg proto.Message) error {
+	switch m := msg.(type) {
+	case *ethpb.BeaconBlock:
+		return checkBlockOperationLimits(m)
+	case *pb.BeaconBlocksResponse:
+		for _, blk := range m.Blocks {
+			if err := checkBlockOperationLimits(blk); err != nil {
+				return err
+			}
+		}
+	case *pb.BeaconBlockResponse:
+		return checkBlockOperationLimits(m.Block)
+	case *pb.BatchedBeaconBlockResponse:
+		for _, blk := range m.BatchedBlocks {
+			if err := checkBlockOperationLimits(blk); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func checkBlockOperationLimits(blk *ethpb.BeaconBlock) error {
+	body := blk.GetBody()
+	if body == nil {
+		return nil
+	}
+	counts := []uint64{
+		uint64(len(body.ProposerSlashings)),
+		uint64(len(body.AttesterSlashings)),
+		uint64(len(body.Attestations)),
+		uint64(len(body.Deposits)),
+		uint64(len(body.VoluntaryExits)),
+		uint64(len(body.Transfers)),
+	}
+	return verifyOperationCounts(blk.Slot, counts)
+}
+
+// operationLimit is the max count of an operation in a block, with the encoded size of a single
+// operation, or 0 for the operations of variable size.
+type operationLimit struct {
+	name string
+	size uint64
+	max  uint64
+}
+
+// operationLimits lists the operation limits in the ssz order of a block body.
+func operationLimits() []operationLimit {
+	cfg := params.BeaconConfig()
+	return []operationLimit{
+		{"proposer slashings", 408, cfg.MaxProposerSlashings},
+		{"attester slashings", 0, cfg.MaxAttesterSlashings},
+		{"attestations", 0, cfg.MaxAttestations},
+		{"deposits", 1240, cfg.MaxDeposits},
+		{"voluntary exits", 112, cfg.MaxVoluntaryExits},
+		{"transfers", 184, cfg.MaxTransfers},
+	}
+}
+
+func verifyOperationCounts(slot uint64, counts []uint64) error {
+	for i, l := range operationLimits() {
+		if counts[i] > l.max {
+			return errors.Wrapf(ErrOperationLimit, "block at slot %d has %d %s, max %d", slot, counts[i], l.name, l.max)
+		}
+	}
+	return nil
+}
+
+// Offsets in an ssz encoded block. The body offset follows the slot, parent root and state root of
+// the block, and the operation list offsets follow the randao reveal, eth1 data and graffiti of its
+// body.
+const (
+	sszOffsetSize          = 4
+	sszBlockBodyOffset     = 8 + 32 + 32
+	sszBodyOperationOffset = 96 + 72 + 32
+)
+
+// checkEncodedOperationLimits verifies the operation counts of the blocks in an ssz encoded message
+// from their offsets, before the message is unmarshaled. Malformed offsets are left for the
+// unmarshaling to reject.
+func checkEncodedOperationLimits(b []byte, msg proto.Message) error {
+	switch msg.(type) {
+	case *ethpb.BeaconBlock:
+		return checkEncodedBlockOperationLimits(b)
+	case *pb.BeaconBlocksResponse:
+		// The response only holds the offset of its list of blocks.
+		offset, ok := readOffset(b, 0)
+		if !ok || offset > uint64(len(b)) {
+			return nil
+		}
+		blocks, ok := splitVariableList(b[offset:])
+		if !ok {
+			return nil
+		}
+		for _, blk := range blocks {
+			if err := checkEncodedBlockOperationLimits(blk); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func checkEncodedBlockOperationLimits(b []byte) error {
+	bodyOffset, ok := readOffset(b, sszBlockBodyOffset)
+	if !ok || bodyOffset > uint64(len(b)) {
+		return nil
+	}
+	body := b[bodyOffset:]
+	limits := operationLimits()
+	offsets := make([]uint64, len(limits)+1)
+	for i := range limits {
+		offsets[i], ok = readOffset(body, sszBodyOperationOffset+i*sszOffsetSize)
+		if !ok {
+			return nil
+		}
+	}
+	offsets[len(limits)] = uint64(len(body))
+	counts := make([]uint64, len(limits))
+	for i, l := range limits {
+		start, end := offsets[i], offsets[i+1]
+		if start > end || end > uint64(len(body)) {
+			return nil
+		}
+		if l.size != 0 {
+			counts[i] = (end - start) / l.size
+			continue
+		}
+		// A list of variable size elements starts with the offsets of its elements.
+		if end-start >= sszOffsetSize {
+			first, _ := readOffset(body[start:end], 0)
+			counts[i] = first / sszOffsetSize
+		}
+	}
+	return verifyOperationCounts(binary.LittleEndian.Uint64(b[:8]), counts)
+}
+
+// splitVariableList splits an ssz encoded list of variable size elements. It returns false if the
+// offsets of the elements are malformed.
+func splitVariableList(b []byte) ([][]byte, bool) {
+	if len(b) == 0 {
+		return nil, true
+	}
+	first, ok := readOffset(b, 0)
+	if !ok || first == 0 || first%sszOffsetSize != 0 || first > uint64(len(b)) {
+		return nil, false
+	}
+	n := int(first / sszOffsetSize)
+	elements := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		start, _ := readOffset(b, i*sszOffsetSize)
+		end := uint64(len(b))
+		if i+1 < n {
+			end, _ = readOffset(b, (i+1)*sszOffsetSize)
+		}
+		if start > end || end > uint64(len(b)) {
+			return nil, false
+		}
+		elements[i] = b[start:end]
+	}
+	return elements, true
+}
+
+// readOffset reads the 4 byte little endian offset at the given position of an ssz encoding.
+func readOffset(b []byte, pos int) (uint64, bool) {
+	if len(b) < pos+sszOffsetSize {
+		return 0, false
+	}
+	return uint64(binary.LittleEndian.Uint32(b[pos : pos+sszOffsetSize])), true
+}
@@ -0,0 +1,152 @@
+package encoder_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func blockWithExits(n int) *ethpb.BeaconBlock {
+	exits := make([]*ethpb.VoluntaryExit, n)
+	for i := range exits {
+		exits[i] = &ethpb.VoluntaryExit{ValidatorIndex: uint64(i), Signature: make([]byte, 96)}
+	}
+	return &ethpb.BeaconBlock{
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		Body: &ethpb.BeaconBlockBody{
+			RandaoReveal:   make([]byte, 96),
+			Eth1Data:       &ethpb.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)},
+			Graffiti:       make([]byte, 32),
+			VoluntaryExits: exits,
+		},
+		Signature: make([]byte, 96),
+	}
+}
+
+func TestSszNetworkEncoder_OperationLimits(t *testing.T) {
+	e := &encoder.SszNetworkEncoder{}
+	prev := params.BeaconConfig()
+	defer params.OverrideBeaconConfig(prev)
+	cfg := *prev
+	cfg.MaxVoluntaryExits = 2
+	params.OverrideBeaconConfig(&cfg)
+	max := int(cfg.MaxVoluntaryExits)
+
+	buf := new(bytes.Buffer)
+	if _, err := e.Encode(buf, blockWithExits(max)); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Decode(buf, &ethpb.BeaconBlock{}); err != nil {
+		t.Errorf("Wanted block at the limit to decode, got %v", err)
+	}
+
+	buf.Reset()
+	if _, err := e.Encode(buf, blockWithExits(max+1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Decode(buf, &ethpb.BeaconBlock{}); errors.Cause(err) != encoder.ErrOperationLimit {
+		t.Errorf("Wanted %v, got %v", encoder.ErrOperationLimit, err)
+	}
+
+	buf.Reset()
+	resp := &pb.BeaconBlocksResponse{Blocks: []*ethpb.BeaconBlock{blockWithExits(1), blockWithExits(max + 1)}}
+	if _, err := e.Encode(buf, resp); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Decode(buf, &pb.BeaconBlocksResponse{}); errors.Cause(err) != encoder.ErrOperationLimit {
+		t.Errorf("Wanted %v, got %v", encoder.ErrOperationLimit, err)
+	}
+}
+
+func TestSszNetworkEncoder_MessageTooLarge(t *testing.T) {
+	e := &encoder.SszNetworkEncoder{}
+	// Only the length prefix is sent, the decoder must not wait for nor allocate the rest.
+	buf := bytes.NewBuffer(proto.EncodeVarint(encoder.MaxGossipSize + 1))
+	if err := e.Decode(buf, &ethpb.BeaconBlock{}); errors.Cause(err) != encoder.ErrMessageTooLarge {
+		t.Errorf("Wanted %v, got %v", encoder.ErrMessageTooLarge, err)
+	}
+
+	buf = bytes.NewBuffer(proto.EncodeVarint(encoder.MaxReqRespSize + 1))
+	if err := e.Decode(buf, &pb.BeaconBlocksResponse{}); errors.Cause(err) != encoder.ErrMessageTooLarge {
+		t.Errorf("Wanted %v, got %v", encoder.ErrMessageTooLarge, err)
+	}
+}
+
+func TestSszNetworkEncoder_DecompressedMessageTooLarge(t *testing.T) {
+	e := &encoder.SszNetworkEncoder{UseSnappyCompression: true}
+	b := snappy.Encode(nil, make([]byte, encoder.MaxGossipSize+1))
+	buf := bytes.NewBuffer(append(proto.EncodeVarint(uint64(len(b))), b...))
+	if err := e.Decode(buf, &ethpb.BeaconBlock{}); errors.Cause(err) != encoder.ErrMessageTooLarge {
+		t.Errorf("Wanted %v, got %v", encoder.ErrMessageTooLarge, err)
+	}
+}
+
+func TestSszNetworkEncoder_OperationLimitsCheckedBeforeUnmarshal(t *testing.T) {
+	e := &encoder.SszNetworkEncoder{}
+	prev := params.BeaconConfig()
+	defer params.OverrideBeaconConfig(prev)
+	cfg := *prev
+	cfg.MaxVoluntaryExits = 2
+	cfg.MaxAttestations = 1
+	params.OverrideBeaconConfig(&cfg)
+
+	// A trailing byte makes the block impossible to unmarshal, the operation counts are still read
+	// from its offsets.
+	b, err := ssz.Marshal(blockWithExits(int(cfg.MaxVoluntaryExits) + 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b = append(b, 0)
+	buf := bytes.NewBuffer(append(proto.EncodeVarint(uint64(len(b))), b...))
+	if err := e.Decode(buf, &ethpb.BeaconBlock{}); errors.Cause(err) != encoder.ErrOperationLimit {
+		t.Errorf("Wanted %v, got %v", encoder.ErrOperationLimit, err)
+	}
+
+	// Attestations are of variable size, they are counted from their offsets.
+	blk := blockWithExits(0)
+	att := &ethpb.Attestation{
+		AggregationBits: []byte{1},
+		CustodyBits:     []byte{1},
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Crosslink:       &ethpb.Crosslink{ParentRoot: make([]byte, 32), DataRoot: make([]byte, 32)},
+		},
+		Signature: make([]byte, 96),
+	}
+	blk.Body.Attestations = []*ethpb.Attestation{att, att}
+	buf.Reset()
+	if _, err := e.Encode(buf, blk); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Decode(buf, &ethpb.BeaconBlock{}); errors.Cause(err) != encoder.ErrOperationLimit {
+		t.Errorf("Wanted %v, got %v", encoder.ErrOperationLimit, err)
+	}
+}
+
+func TestCheckOperationLimits_DecodedMessages(t *testing.T) {
+	prev := params.BeaconConfig()
+	defer params.OverrideBeaconConfig(prev)
+	cfg := *prev
+	cfg.MaxVoluntaryExits = 2
+	params.OverrideBeaconConfig(&cfg)
+	max := int(cfg.MaxVoluntaryExits)
+
+	if err := encoder.CheckOperationLimits(&pb.BeaconBlockResponse{Block: blockWithExits(max)}); err != nil {
+		t.Errorf("Wanted block at the limit to pass, got %v", err)
+	}
+	resp := &pb.BatchedBeaconBlockResponse{BatchedBlocks: []*ethpb.BeaconBlock{blockWithExits(1), blockWithExits(max + 1)}}
+	if err := encoder.CheckOperationLimits(resp); errors.Cause(err) != encoder.ErrOperationLimit {
+		t.Errorf("Wanted %v, got %v", encoder.ErrOperationLimit, err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/snappy"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-ssz"
 )
 
@@ -34,25 +35,40 @@ func (e SszNetworkEncoder) Encode(w io.Writer, msg proto.Message) (int, error) {
 	return w.Write(b)
 }
 
-// Decode the bytes from io.Reader to the protobuf message provided.
+// Decode the bytes from io.Reader to the protobuf message provided. Messages larger than allowed
+// are rejected with ErrMessageTooLarge, and blocks holding more operations than allowed with
+// ErrOperationLimit.
 func (e SszNetworkEncoder) Decode(r io.Reader, to proto.Message) error {
 	msgLen, err := readVarint(r)
 	if err != nil {
 		return err
 	}
+	max := MaxMessageSize(to)
+	if msgLen > max {
+		return errors.Wrapf(ErrMessageTooLarge, "message length %d, max %d", msgLen, max)
+	}
 	b := make([]byte, msgLen)
 	_, err = r.Read(b)
 	if err != nil {
 		return err
 	}
 	if e.UseSnappyCompression {
-		var err error
+		decodedLen, err := snappy.DecodedLen(b)
+		if err != nil {
+			return err
+		}
+		if uint64(decodedLen) > max {
+			return errors.Wrapf(ErrMessageTooLarge, "decompressed message length %d, max %d", decodedLen, max)
+		}
 		b, err = snappy.Decode(nil /*dst*/, b)
 		if err != nil {
 			return err
 		}
 	}
 
+	if err := checkEncodedOperationLimits(b, to); err != nil {
+		return err
+	}
 	return ssz.Unmarshal(b, to)
 }
 
@@ -1,14 +1,20 @@
 package p2p
 
 import (
+	"fmt"
 	"reflect"
 
 	"github.com/gogo/protobuf/proto"
 	pb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
+// AttestationSubnetTopicFormat is the topic of an attestation subnet, formatted with the
+// index of the subnet.
+const AttestationSubnetTopicFormat = "/eth2/committee_index%d_beacon_attestation"
+
 // GossipTopicMappings represent the protocol ID to protobuf message type map for easy
-// lookup.
+// lookup. The attestation subnet topics are added on init.
 var GossipTopicMappings = map[string]proto.Message{
 	"/eth2/beacon_block":       &pb.BeaconBlock{},
 	"/eth2/beacon_attestation": &pb.Attestation{},
@@ -18,11 +24,19 @@ var GossipTopicMappings = map[string]proto.Message{
 }
 
 // GossipTypeMapping is the inverse of GossipTopicMappings so that an arbitrary protobuf message
-// can be mapped to a protocol ID string.
+// can be mapped to a protocol ID string. Attestations are mapped to the global attestation topic.
 var GossipTypeMapping = make(map[reflect.Type]string)
 
 func init() {
 	for k, v := range GossipTopicMappings {
 		GossipTypeMapping[reflect.TypeOf(v)] = k
 	}
+	for i := uint64(0); i < params.BeaconConfig().AttestationSubnetCount; i++ {
+		GossipTopicMappings[AttestationSubnetTopic(i)] = &pb.Attestation{}
+	}
+}
+
+// AttestationSubnetTopic returns the topic of the attestation subnet with the given index.
+func AttestationSubnetTopic(subnet uint64) string {
+	return fmt.Sprintf(AttestationSubnetTopicFormat, subnet)
 }
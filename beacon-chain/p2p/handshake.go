@@ -3,6 +3,7 @@ package p2p
 import (
 	"sync"
 
+	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
@@ -10,11 +11,20 @@ import (
 var handshakes = make(map[peer.ID]*pb.Hello)
 var handshakeLock sync.Mutex
 
-// AddHandshake to the local records for initial sync.
+// AddHandshake to the local records for initial sync, and report the completed handshake on the
+// peer event feed.
 func (p *Service) AddHandshake(pid peer.ID, hello *pb.Hello) {
 	handshakeLock.Lock()
-	defer handshakeLock.Unlock()
 	handshakes[pid] = hello
+	handshakeLock.Unlock()
+
+	var conn network.Conn
+	if p.host != nil {
+		if conns := p.host.Network().ConnsToPeer(pid); len(conns) > 0 {
+			conn = conns[0]
+		}
+	}
+	p.queuePeerEvent(PeerHandshakeComplete, pid, conn, hello)
 }
 
 // Handshakes has not been implemented yet and it may be moved to regular sync...
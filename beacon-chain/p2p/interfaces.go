@@ -9,6 +9,8 @@ import (
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/publishutil"
 )
 
 // P2P represents the full p2p interface composed of all of the sub-interfaces.
@@ -19,7 +21,9 @@ type P2P interface {
 	PubSubProvider
 	PeerManager
 	HandshakeManager
+	PeerEventProvider
 	Sender
+	SubnetAdvertiser
 	DeprecatedSubscriber
 
 	Started() bool
@@ -30,11 +34,23 @@ type Broadcaster interface {
 	Broadcast(context.Context, proto.Message) error
 }
 
+// PublishTracker is implemented by the broadcasters which flush the critical messages being
+// published at shutdown.
+type PublishTracker interface {
+	PublishTracker() *publishutil.Tracker
+}
+
 // SetStreamHandler configures p2p to handle streams of a certain topic ID.
 type SetStreamHandler interface {
 	SetStreamHandler(topic string, handler network.StreamHandler)
 }
 
+// StreamOpener opens streams to connected peers, to send them requests. It is not part of P2P as
+// the deprecated p2p server only sends messages with Send.
+type StreamOpener interface {
+	NewStream(ctx context.Context, pid peer.ID, topic string) (network.Stream, error)
+}
+
 // EncodingProvider provides p2p network encoding.
 type EncodingProvider interface {
 	Encoding() encoder.NetworkEncoding
@@ -48,6 +64,7 @@ type PubSubProvider interface {
 // PeerManager abstracts some peer management methods from libp2p.
 type PeerManager interface {
 	Disconnect(peer.ID) error
+	Reputation(peer.ID, int)
 }
 
 // HandshakeManager abstracts certain methods regarding handshake records.
@@ -55,6 +72,17 @@ type HandshakeManager interface {
 	AddHandshake(peer.ID, *pb.Hello)
 }
 
+// PeerEventProvider provides the feed of peer connection, disconnection and handshake events.
+type PeerEventProvider interface {
+	PeerEventFeed() *event.Feed
+}
+
+// SubnetAdvertiser advertises the attestation subnets the node is subscribed to, so that peers
+// looking for the subscribers of a subnet can find the node.
+type SubnetAdvertiser interface {
+	AdvertiseSubnet(ctx context.Context, subnet uint64)
+}
+
 // Sender abstracts the sending functionality from libp2p.
 type Sender interface {
 	Send(context.Context, proto.Message, peer.ID) error
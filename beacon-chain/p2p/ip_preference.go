@@ -0,0 +1,161 @@
+package p2p
+
+import (
+	"fmt"
+	"net"
+	"sort"
+
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/shared/iputils"
+)
+
+// IPPreference decides which IP versions the service listens on, runs discovery on and dials
+// peers with.
+type IPPreference string
+
+const (
+	// IPAuto listens on every IP version the host has an external address for, running the
+	// node dual-stack when it has both. Discovery runs over the IP version of the bootstrap
+	// node, and IPv4 addresses of peers are dialed first.
+	IPAuto IPPreference = "auto"
+	// PreferIPv4 listens and runs discovery on IPv4, falling back to IPv6 on hosts without an
+	// external IPv4 address.
+	PreferIPv4 IPPreference = "ipv4"
+	// PreferIPv6 listens and runs discovery on IPv6, falling back to IPv4 on hosts without an
+	// external IPv6 address.
+	PreferIPv6 IPPreference = "ipv6"
+)
+
+// listenIPs returns the addresses the host listens on, the address used for discovery first.
+// The host address from the config takes precedence over the preference.
+func listenIPs(cfg *Config) ([]net.IP, error) {
+	if cfg.HostAddress != "" {
+		ip := net.ParseIP(cfg.HostAddress)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid host address %q", cfg.HostAddress)
+		}
+		return []net.IP{ip}, nil
+	}
+
+	v4, err := externalIP(iputils.ExternalIPv4)
+	if err != nil {
+		return nil, err
+	}
+	v6, err := externalIP(iputils.ExternalIPv6)
+	if err != nil {
+		return nil, err
+	}
+	var ips []net.IP
+	switch cfg.IPPreference {
+	case IPAuto, "":
+		ips = appendIPs(ips, v4, v6)
+	case PreferIPv4:
+		ips = appendIPs(ips, v4)
+		if len(ips) == 0 {
+			ips = appendIPs(ips, v6)
+		}
+	case PreferIPv6:
+		ips = appendIPs(ips, v6)
+		if len(ips) == 0 {
+			ips = appendIPs(ips, v4)
+		}
+	default:
+		return nil, fmt.Errorf("unknown IP preference %q", cfg.IPPreference)
+	}
+	if len(ips) == 0 {
+		// Without any external address, the node is only reachable locally.
+		ips = append(ips, net.ParseIP("127.0.0.1"))
+	}
+	return ips, nil
+}
+
+// discoveryIP returns the listening address discovery binds its single UDP socket to: the address
+// of the IP version of the bootstrap node, which the node could not reach over the other version,
+// or the preferred address if the node doesn't listen on that version.
+func discoveryIP(ips []net.IP, bootNode net.IP) net.IP {
+	for _, ip := range ips {
+		if ipVersion(ip) == ipVersion(bootNode) {
+			return ip
+		}
+	}
+	return ips[0]
+}
+
+// externalIP returns the external address given by the lookup, or nil if the host only has a
+// loopback address of that IP version.
+func externalIP(lookup func() (string, error)) (net.IP, error) {
+	addr, err := lookup()
+	if err != nil {
+		return nil, err
+	}
+	ip := net.ParseIP(addr)
+	if ip == nil || ip.IsLoopback() {
+		return nil, nil
+	}
+	return ip, nil
+}
+
+func appendIPs(ips []net.IP, candidates ...net.IP) []net.IP {
+	for _, ip := range candidates {
+		if ip != nil {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
+
+// dialableAddrs drops the addresses of IP versions the host doesn't listen on, which it most
+// likely can't reach, and orders the remaining addresses so that the preferred IP version is
+// dialed first. Addresses without an IP, such as DNS addresses, rank with the preferred version.
+func dialableAddrs(addrs []ma.Multiaddr, listening []net.IP, pref IPPreference) []ma.Multiaddr {
+	hasVersion := make(map[int]bool)
+	for _, ip := range listening {
+		hasVersion[ipVersion(ip)] = true
+	}
+	preferred := 4
+	if pref == PreferIPv6 || !hasVersion[4] {
+		preferred = 6
+	}
+
+	var dialable []ma.Multiaddr
+	for _, addr := range addrs {
+		if v := addrIPVersion(addr); v != 0 && !hasVersion[v] {
+			continue
+		}
+		dialable = append(dialable, addr)
+	}
+	rank := func(addr ma.Multiaddr) int {
+		if v := addrIPVersion(addr); v != 0 && v != preferred {
+			return 1
+		}
+		return 0
+	}
+	sort.SliceStable(dialable, func(i, j int) bool {
+		return rank(dialable[i]) < rank(dialable[j])
+	})
+	return dialable
+}
+
+// ipVersion returns the version of an IP address, 4 or 6.
+func ipVersion(ip net.IP) int {
+	if ip.To4() != nil {
+		return 4
+	}
+	return 6
+}
+
+// addrIPVersion returns the IP version of a multiaddr, or 0 if it doesn't start with an IP.
+func addrIPVersion(addr ma.Multiaddr) int {
+	protocols := addr.Protocols()
+	if len(protocols) == 0 {
+		return 0
+	}
+	switch protocols[0].Code {
+	case ma.P_IP4:
+		return 4
+	case ma.P_IP6:
+		return 6
+	default:
+		return 0
+	}
+}
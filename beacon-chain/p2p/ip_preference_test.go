@@ -0,0 +1,107 @@
+package p2p
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+func TestListenIPs_HostAddress(t *testing.T) {
+	for _, addr := range []string{"192.168.0.1", "2001:db8::1"} {
+		ips, err := listenIPs(&Config{HostAddress: addr, IPPreference: PreferIPv4})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ips) != 1 || !ips[0].Equal(net.ParseIP(addr)) {
+			t.Errorf("Wanted to listen on %s only, got %v", addr, ips)
+		}
+	}
+
+	if _, err := listenIPs(&Config{HostAddress: "not an ip"}); err == nil {
+		t.Error("Wanted error for invalid host address")
+	}
+}
+
+func TestListenIPs_UnknownPreference(t *testing.T) {
+	if _, err := listenIPs(&Config{IPPreference: "ipv5"}); err == nil {
+		t.Error("Wanted error for unknown IP preference")
+	}
+}
+
+func TestDiscoveryIP_MatchesBootstrapNode(t *testing.T) {
+	v4, v6 := net.ParseIP("192.168.0.1"), net.ParseIP("2001:db8::1")
+	if ip := discoveryIP([]net.IP{v4, v6}, net.ParseIP("2001:db8::2")); !ip.Equal(v6) {
+		t.Errorf("Wanted discovery on %s to reach an IPv6 bootstrap node, got %s", v6, ip)
+	}
+	if ip := discoveryIP([]net.IP{v6, v4}, net.ParseIP("192.168.0.2")); !ip.Equal(v4) {
+		t.Errorf("Wanted discovery on %s to reach an IPv4 bootstrap node, got %s", v4, ip)
+	}
+	if ip := discoveryIP([]net.IP{v6}, net.ParseIP("192.168.0.2")); !ip.Equal(v6) {
+		t.Errorf("Wanted discovery on the only listening address %s, got %s", v6, ip)
+	}
+}
+
+func TestBuildOptions_DualStack(t *testing.T) {
+	ips := []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")}
+	pkey, err := privKey(&Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts := buildOptions(&Config{Port: 2000}, ips, pkey); len(opts) != 2 {
+		t.Errorf("Wanted 2 options, got %d", len(opts))
+	}
+}
+
+func TestDialableAddrs(t *testing.T) {
+	v4, err := ma.NewMultiaddr("/ip4/192.168.0.1/tcp/3000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v6, err := ma.NewMultiaddr("/ip6/2001:db8::1/tcp/3000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dualStack := []net.IP{net.ParseIP("192.168.0.2"), net.ParseIP("2001:db8::2")}
+
+	tests := []struct {
+		name      string
+		listening []net.IP
+		pref      IPPreference
+		want      []ma.Multiaddr
+	}{
+		{
+			name:      "auto prefers ipv4",
+			listening: dualStack,
+			pref:      IPAuto,
+			want:      []ma.Multiaddr{v4, v6},
+		},
+		{
+			name:      "ipv6 preferred",
+			listening: dualStack,
+			pref:      PreferIPv6,
+			want:      []ma.Multiaddr{v6, v4},
+		},
+		{
+			name:      "ipv6 only host",
+			listening: []net.IP{net.ParseIP("2001:db8::2")},
+			pref:      PreferIPv4,
+			want:      []ma.Multiaddr{v6},
+		},
+		{
+			name:      "ipv4 only host",
+			listening: []net.IP{net.ParseIP("192.168.0.2")},
+			pref:      IPAuto,
+			want:      []ma.Multiaddr{v4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dialableAddrs([]ma.Multiaddr{v6, v4}, tt.listening, tt.pref)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Wanted %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
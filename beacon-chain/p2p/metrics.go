@@ -0,0 +1,35 @@
+package p2p
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	connectedPeers = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "p2p_connected_peers",
+		Help: "The number of peers connected to the node",
+	})
+	peerEventsCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_peer_events_total",
+		Help: "The number of peer events, by type",
+	}, []string{"type"})
+)
+
+// recordPeerMetrics updates the peer metrics from the peer event feed until the service stops.
+func (s *Service) recordPeerMetrics() {
+	events := make(chan *PeerEvent, peerEventBufferSize)
+	sub := s.peerFeed.Subscribe(events)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case e := <-events:
+			peerEventsCount.WithLabelValues(string(e.Type)).Inc()
+			connectedPeers.Set(float64(len(s.host.Network().Peers())))
+		case <-sub.Err():
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
@@ -10,15 +10,23 @@ import (
 	ma "github.com/multiformats/go-multiaddr"
 )
 
-// buildOptions for the libp2p host.
-func buildOptions(cfg *Config, ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Option {
-	listen, err := ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", ip, cfg.Port))
-	if err != nil {
-		log.Fatalf("Failed to p2p listen: %v", err)
+// buildOptions for the libp2p host, which listens on the TCP port of the config on every given
+// address.
+func buildOptions(cfg *Config, ips []net.IP, priKey *ecdsa.PrivateKey) []libp2p.Option {
+	listen := make([]ma.Multiaddr, len(ips))
+	for i, ip := range ips {
+		addr, err := ma.NewMultiaddr(fmt.Sprintf("/ip%d/%s/tcp/%d", ipVersion(ip), ip, cfg.Port))
+		if err != nil {
+			log.Fatalf("Failed to p2p listen: %v", err)
+		}
+		listen[i] = addr
 	}
 	options := []libp2p.Option{
 		privKeyOption(priKey),
-		libp2p.ListenAddrs(listen),
+		libp2p.ListenAddrs(listen...),
+	}
+	if cfg.MaxPeers > 0 {
+		options = append(options, optionConnectionManager(cfg.MaxPeers))
 	}
 	if cfg.EnableUPnP {
 		options = append(options, libp2p.NATPortMap()) //Allow to use UPnP
@@ -0,0 +1,91 @@
+package p2p
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+)
+
+// peerEventBufferSize is the number of peer events queued for the feed before new events are
+// dropped, as libp2p connection notifications must not block.
+const peerEventBufferSize = 256
+
+// PeerEventType is the kind of change in the state of a peer.
+type PeerEventType string
+
+const (
+	// PeerConnected is sent when a connection to a peer is opened.
+	PeerConnected PeerEventType = "connected"
+	// PeerDisconnected is sent when a connection to a peer is closed.
+	PeerDisconnected PeerEventType = "disconnected"
+	// PeerHandshakeComplete is sent when a peer on our fork has sent its hello message.
+	PeerHandshakeComplete PeerEventType = "handshake_complete"
+)
+
+// PeerEvent is sent on the peer event feed when the state of a peer changes.
+type PeerEvent struct {
+	Type   PeerEventType
+	PeerID peer.ID
+	// Address is the remote multiaddress of the connection to the peer, if any.
+	Address ma.Multiaddr
+	// Direction is the direction of the connection to the peer, if known.
+	Direction network.Direction
+	// Hello is the chain status sent by the peer. It is only set for PeerHandshakeComplete.
+	Hello *pb.Hello
+	Time  time.Time
+}
+
+// PeerEventFeed returns the feed of peer events, on which *PeerEvent values are sent.
+// Subscribers should drain their channel promptly, as a slow subscriber delays the events
+// of every other subscriber.
+func (s *Service) PeerEventFeed() *event.Feed {
+	return s.peerFeed
+}
+
+// peerNotifiee reports the connections and disconnections of the host as peer events.
+func (s *Service) peerNotifiee() network.Notifiee {
+	return &network.NotifyBundle{
+		ConnectedF: func(_ network.Network, conn network.Conn) {
+			s.queuePeerEvent(PeerConnected, conn.RemotePeer(), conn, nil)
+		},
+		DisconnectedF: func(_ network.Network, conn network.Conn) {
+			s.queuePeerEvent(PeerDisconnected, conn.RemotePeer(), conn, nil)
+		},
+	}
+}
+
+// queuePeerEvent queues a peer event for the feed without blocking. The event is dropped if the
+// queue is full.
+func (s *Service) queuePeerEvent(typ PeerEventType, pid peer.ID, conn network.Conn, hello *pb.Hello) {
+	e := &PeerEvent{
+		Type:   typ,
+		PeerID: pid,
+		Hello:  hello,
+		Time:   time.Now(),
+	}
+	if conn != nil {
+		e.Address = conn.RemoteMultiaddr()
+		e.Direction = conn.Stat().Direction
+	}
+	select {
+	case s.peerEvents <- e:
+	default:
+		log.WithField("peer", pid).WithField("type", typ).Warn("Peer event queue is full, dropping event")
+	}
+}
+
+// sendPeerEvents sends the queued peer events on the feed, in order, until the service stops.
+func (s *Service) sendPeerEvents() {
+	for {
+		select {
+		case e := <-s.peerEvents:
+			s.peerFeed.Send(e)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
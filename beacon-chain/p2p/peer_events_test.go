@@ -0,0 +1,42 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestService_PeerEventsAreSentInOrder(t *testing.T) {
+	s, _ := NewService(&Config{})
+	defer s.cancel()
+	go s.sendPeerEvents()
+
+	events := make(chan *PeerEvent, 3)
+	sub := s.PeerEventFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+
+	pid := peer.ID("peer")
+	hello := &pb.Hello{HeadSlot: 5}
+	s.queuePeerEvent(PeerConnected, pid, nil, nil)
+	s.AddHandshake(pid, hello)
+	s.queuePeerEvent(PeerDisconnected, pid, nil, nil)
+
+	for _, want := range []PeerEventType{PeerConnected, PeerHandshakeComplete, PeerDisconnected} {
+		select {
+		case e := <-events:
+			if e.Type != want {
+				t.Errorf("Wanted event %s, got %s", want, e.Type)
+			}
+			if e.PeerID != pid {
+				t.Errorf("Wanted peer %s, got %s", pid, e.PeerID)
+			}
+			if want == PeerHandshakeComplete && e.Hello != hello {
+				t.Errorf("Wanted hello %v, got %v", hello, e.Hello)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Timed out waiting for event %s", want)
+		}
+	}
+}
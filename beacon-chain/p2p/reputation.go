@@ -0,0 +1,50 @@
+package p2p
+
+import (
+	"math"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	connmgr "github.com/libp2p/go-libp2p-connmgr"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+// tagReputation is the connection manager tag holding the reputation of a peer.
+const tagReputation = "prysm-reputation"
+
+// Reputation penalties.
+const (
+	// RepPenaltyOperationLimit is the penalty for sending a block holding more operations of a
+	// kind than a block may hold.
+	RepPenaltyOperationLimit = -500
+	// RepPenaltyMessageTooLarge is the penalty for sending a message larger than a message of
+	// its kind may be.
+	RepPenaltyMessageTooLarge = -500
+	// RepPenaltyInvalidBlock is the penalty for sending a block failing the state transition.
+	RepPenaltyInvalidBlock = -500
+	// RepPenaltyPreFinalizedBlock is the penalty for sending a block conflicting with the
+	// finalized checkpoint, which an honest peer on another fork may still send.
+	RepPenaltyPreFinalizedBlock = -100
+)
+
+// optionConnectionManager keeps the number of connections of the host around the max peers,
+// pruning the peers with the lowest reputation first.
+func optionConnectionManager(maxPeers uint) libp2p.Option {
+	if maxPeers < 5 {
+		log.Warn("Max peers < 5. Defaulting to 5 max peers")
+		maxPeers = 5
+	}
+	minPeers := int(math.Max(5, float64(maxPeers-5)))
+	cm := connmgr.NewConnManager(minPeers, int(maxPeers), 20*time.Second)
+
+	return libp2p.ConnectionManager(cm)
+}
+
+// Reputation adds (or subtracts) a given reward/penalty against a peer.
+// Eventually, the lowest scoring peers will be pruned from the connections.
+func (s *Service) Reputation(pid peer.ID, val int) {
+	if ti := s.host.ConnManager().GetTagInfo(pid); ti != nil {
+		val += ti.Tags[tagReputation]
+	}
+	s.host.ConnManager().TagPeer(pid, tagReputation, val)
+}
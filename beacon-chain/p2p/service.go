@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"context"
+	"net"
 	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/discv5"
@@ -18,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared"
 	deprecatedp2p "github.com/prysmaticlabs/prysm/shared/deprecated-p2p"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/publishutil"
 )
 
 var _ = shared.Service(&Service{})
@@ -33,6 +35,10 @@ type Service struct {
 	dv5Listener Listener
 	host        host.Host
 	pubsub      *pubsub.PubSub
+	peerFeed    *event.Feed
+	peerEvents  chan *PeerEvent
+	publishes   publishutil.Tracker
+	listenIPs   []net.IP
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -40,9 +46,11 @@ type Service struct {
 func NewService(cfg *Config) (*Service, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &Service{
-		ctx:    ctx,
-		cancel: cancel,
-		cfg:    cfg,
+		ctx:        ctx,
+		cancel:     cancel,
+		cfg:        cfg,
+		peerFeed:   new(event.Feed),
+		peerEvents: make(chan *PeerEvent, peerEventBufferSize),
 	}, nil
 }
 
@@ -53,7 +61,12 @@ func (s *Service) Start() {
 		return
 	}
 
-	ipAddr := ipAddr(s.cfg)
+	ips, err := listenIPs(s.cfg)
+	if err != nil {
+		s.startupErr = err
+		return
+	}
+	s.listenIPs = ips
 	privKey, err := privKey(s.cfg)
 	if err != nil {
 		s.startupErr = err
@@ -61,15 +74,18 @@ func (s *Service) Start() {
 	}
 
 	// TODO(3147): Add host options
-	opts := buildOptions(s.cfg, ipAddr, privKey)
+	opts := buildOptions(s.cfg, ips, privKey)
 	h, err := libp2p.New(s.ctx, opts...)
 	if err != nil {
 		s.startupErr = err
 		return
 	}
 	s.host = h
+	s.host.Network().Notify(s.peerNotifiee())
+	go s.sendPeerEvents()
+	go s.recordPeerMetrics()
 	if s.cfg.BootstrapNodeAddr != "" {
-		listener, err := startDiscoveryV5(ipAddr, privKey, s.cfg)
+		listener, err := startDiscoveryV5(ips, privKey, s.cfg)
 		if err != nil {
 			log.WithError(err).Error("Failed to start discovery")
 			s.startupErr = err
@@ -100,17 +116,31 @@ func (s *Service) Start() {
 
 	s.started = true
 
-	multiAddrs := s.host.Network().ListenAddresses()
-	log.Infof("Node currently listening at %s", multiAddrs[1].String())
+	for _, addr := range s.host.Network().ListenAddresses() {
+		log.Infof("Node currently listening at %s", addr.String())
+	}
 }
 
 // Stop the p2p service and terminate all peer connections.
 func (s *Service) Stop() error {
 	s.started = false
+	s.flushPublishes()
+	s.cancel()
 	s.dv5Listener.Close()
 	return nil
 }
 
+// flushPublishes waits, for at most the configured grace period, for the critical messages being
+// broadcast to be sent to peers, before pubsub is stopped.
+func (s *Service) flushPublishes() {
+	if s.cfg == nil || s.cfg.ShutdownGracePeriod == 0 {
+		return
+	}
+	if !s.publishes.Flush(s.cfg.ShutdownGracePeriod) {
+		log.WithField("gracePeriod", s.cfg.ShutdownGracePeriod).Warn("Stopping before all blocks, slashings and exits were sent to peers")
+	}
+}
+
 // Status of the p2p service. Will return an error if the service is considered unhealthy to
 // indicate that this node should not serve traffic until the issue has been resolved.
 func (s *Service) Status() error {
@@ -125,6 +155,11 @@ func (s *Service) Started() bool {
 	return s.started
 }
 
+// PublishTracker returns the tracker of the critical messages flushed at shutdown.
+func (s *Service) PublishTracker() *publishutil.Tracker {
+	return &s.publishes
+}
+
 // Encoding returns the configured networking encoding.
 func (s *Service) Encoding() encoder.NetworkEncoding {
 	encoding := s.cfg.Encoding
@@ -149,10 +184,20 @@ func (s *Service) SetStreamHandler(topic string, handler network.StreamHandler)
 	s.host.SetStreamHandler(protocol.ID(topic), handler)
 }
 
+// NewStream opens a stream to a connected peer for the protocol of the topic.
+// This method is a pass through to libp2pcore.Host.NewStream.
+func (s *Service) NewStream(ctx context.Context, pid peer.ID, topic string) (network.Stream, error) {
+	return s.host.NewStream(ctx, pid, protocol.ID(topic))
+}
+
 // Disconnect from a peer.
 func (s *Service) Disconnect(pid peer.ID) error {
-	// TODO(3147): Implement disconnect
-	return nil
+	return s.host.Network().ClosePeer(pid)
+}
+
+// Peers returns the peers with an open connection.
+func (s *Service) Peers() []peer.ID {
+	return s.host.Network().Peers()
 }
 
 // listen for new nodes watches for new nodes in the network and adds them to the peerstore.
@@ -189,6 +234,11 @@ func (s *Service) connectWithAllPeers(multiAddrs []ma.Multiaddr) {
 		if info.ID == s.host.ID() {
 			continue
 		}
+		info.Addrs = dialableAddrs(info.Addrs, s.listenIPs, s.cfg.IPPreference)
+		if len(info.Addrs) == 0 {
+			log.WithField("peer", info.ID).Debug("Peer has no address on the IP versions the node listens on")
+			continue
+		}
 		if err := s.host.Connect(s.ctx, info); err != nil {
 			log.Errorf("Could not connect with peer: %v", err)
 		}
@@ -54,7 +54,7 @@ func createPeer(t *testing.T, cfg *Config, port int) (Listener, host.Host) {
 	h, pkey, ipAddr := createHost(t, port)
 	cfg.UDPPort = uint(port)
 	cfg.Port = uint(port)
-	listener, err := startDiscoveryV5(ipAddr, pkey, cfg)
+	listener, err := startDiscoveryV5([]net.IP{ipAddr}, pkey, cfg)
 	if err != nil {
 		t.Errorf("Could not start discovery for node: %v", err)
 	}
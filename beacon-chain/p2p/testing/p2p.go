@@ -3,6 +3,8 @@ package testing
 import (
 	"bytes"
 	"context"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -25,7 +27,12 @@ type TestP2P struct {
 	t               *testing.T
 	Host            host.Host
 	pubsub          *pubsub.PubSub
+	peerFeed        event.Feed
 	BroadcastCalled bool
+	reputations     map[peer.ID]int
+	reputationsLock sync.Mutex
+	advertised      map[uint64]int
+	advertisedLock  sync.Mutex
 }
 
 // NewTestP2P initializes a new p2p test service.
@@ -41,9 +48,11 @@ func NewTestP2P(t *testing.T) *TestP2P {
 	}
 
 	return &TestP2P{
-		t:      t,
-		Host:   h,
-		pubsub: ps,
+		t:           t,
+		Host:        h,
+		pubsub:      ps,
+		reputations: make(map[peer.ID]int),
+		advertised:  make(map[uint64]int),
 	}
 }
 
@@ -59,8 +68,8 @@ func connect(a, b host.Host) error {
 	return a.Connect(context.Background(), pinfo)
 }
 
-// ReceiveRPC simulates an incoming RPC.
-func (p *TestP2P) ReceiveRPC(topic string, msg proto.Message) {
+// ReceiveRPC simulates an incoming RPC, and returns the ID of the peer which sent it.
+func (p *TestP2P) ReceiveRPC(topic string, msg proto.Message) peer.ID {
 	h := bhost.NewBlankHost(swarmt.GenSwarm(p.t, context.Background()))
 	if err := connect(h, p.Host); err != nil {
 		p.t.Fatalf("Failed to connect two peers for RPC: %v", err)
@@ -77,10 +86,12 @@ func (p *TestP2P) ReceiveRPC(topic string, msg proto.Message) {
 	}
 
 	p.t.Logf("Wrote %d bytes", n)
+	return h.ID()
 }
 
-// ReceivePubSub simulates an incoming message over pubsub on a given topic.
-func (p *TestP2P) ReceivePubSub(topic string, msg proto.Message) {
+// ReceivePubSub simulates an incoming message over pubsub on a given topic, and returns the ID of
+// the peer which sent it.
+func (p *TestP2P) ReceivePubSub(topic string, msg proto.Message) peer.ID {
 	h := bhost.NewBlankHost(swarmt.GenSwarm(p.t, context.Background()))
 	ps, err := pubsub.NewFloodSub(context.Background(), h,
 		pubsub.WithMessageSigning(false),
@@ -105,6 +116,7 @@ func (p *TestP2P) ReceivePubSub(topic string, msg proto.Message) {
 	if err := ps.Publish(topic+p.Encoding().ProtocolSuffix(), buf.Bytes()); err != nil {
 		p.t.Fatalf("Failed to publish message; %v", err)
 	}
+	return h.ID()
 }
 
 // Broadcast a message.
@@ -118,6 +130,11 @@ func (p *TestP2P) SetStreamHandler(topic string, handler network.StreamHandler)
 	p.Host.SetStreamHandler(protocol.ID(topic), handler)
 }
 
+// NewStream opens a stream to a connected peer for the protocol of the topic.
+func (p *TestP2P) NewStream(ctx context.Context, pid peer.ID, topic string) (network.Stream, error) {
+	return p.Host.NewStream(ctx, pid, protocol.ID(topic))
+}
+
 // Encoding returns ssz encoding.
 func (p *TestP2P) Encoding() encoder.NetworkEncoding {
 	return &encoder.SszNetworkEncoder{}
@@ -134,11 +151,60 @@ func (p *TestP2P) Disconnect(pid peer.ID) error {
 	return p.Host.Network().ClosePeer(pid)
 }
 
+// Reputation adds a reward or penalty to the reputation of a peer.
+func (p *TestP2P) Reputation(pid peer.ID, val int) {
+	p.reputationsLock.Lock()
+	defer p.reputationsLock.Unlock()
+	p.reputations[pid] += val
+}
+
+// PeerReputation returns the sum of the rewards and penalties given to a peer.
+func (p *TestP2P) PeerReputation(pid peer.ID) int {
+	p.reputationsLock.Lock()
+	defer p.reputationsLock.Unlock()
+	return p.reputations[pid]
+}
+
 // AddHandshake to the peer handshake records.
 func (p *TestP2P) AddHandshake(pid peer.ID, hello *pb.Hello) {
 	// TODO(3147): add this.
 }
 
+// PeerEventFeed returns the feed of peer events. Tests send the events on it directly.
+func (p *TestP2P) PeerEventFeed() *event.Feed {
+	return &p.peerFeed
+}
+
+// AdvertiseSubnet records the subnet as advertised until the context is done.
+func (p *TestP2P) AdvertiseSubnet(ctx context.Context, subnet uint64) {
+	p.advertisedLock.Lock()
+	defer p.advertisedLock.Unlock()
+	p.advertised[subnet]++
+	go func() {
+		<-ctx.Done()
+		p.advertisedLock.Lock()
+		defer p.advertisedLock.Unlock()
+		p.advertised[subnet]--
+		if p.advertised[subnet] == 0 {
+			delete(p.advertised, subnet)
+		}
+	}()
+}
+
+// AdvertisedSubnets returns the subnets being advertised, in increasing order.
+func (p *TestP2P) AdvertisedSubnets() []uint64 {
+	p.advertisedLock.Lock()
+	defer p.advertisedLock.Unlock()
+	subnets := make([]uint64, 0, len(p.advertised))
+	for subnet := range p.advertised {
+		subnets = append(subnets, subnet)
+	}
+	sort.Slice(subnets, func(i, j int) bool {
+		return subnets[i] < subnets[j]
+	})
+	return subnets
+}
+
 // Send a message to a specific peer.
 func (p *TestP2P) Send(ctx context.Context, msg proto.Message, pid peer.ID) error {
 	// TODO(3147): add this.
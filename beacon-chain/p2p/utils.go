@@ -3,13 +3,11 @@ package p2p
 import (
 	"crypto/ecdsa"
 	"crypto/rand"
-	"net"
 	"os"
 
 	"github.com/btcsuite/btcd/btcec"
 	curve "github.com/ethereum/go-ethereum/crypto"
 	"github.com/libp2p/go-libp2p-core/crypto"
-	"github.com/prysmaticlabs/prysm/shared/iputils"
 )
 
 func convertFromInterfacePrivKey(privkey crypto.PrivKey) *ecdsa.PrivateKey {
@@ -52,14 +50,3 @@ func privKey(cfg *Config) (*ecdsa.PrivateKey, error) {
 	}
 	return priv, nil
 }
-
-func ipAddr(cfg *Config) net.IP {
-	if cfg.HostAddress == "" {
-		ip, err := iputils.ExternalIPv4()
-		if err != nil {
-			log.Fatalf("Could not get IPv4 address: %v", err)
-		}
-		return net.ParseIP(ip)
-	}
-	return net.ParseIP(cfg.HostAddress)
-}
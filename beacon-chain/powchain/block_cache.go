@@ -9,8 +9,8 @@ import (
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/shared/params"
-	"k8s.io/client-go/tools/cache"
 )
 
 var (
@@ -23,18 +23,18 @@ var (
 	// Eth1FollowDistance.
 	maxCacheSize = int(2 * params.BeaconConfig().Eth1FollowDistance)
 
-	// Metrics
+	// Metrics, deprecated in favour of the metrics of the powchain_block_by_hash store.
 	blockCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "powchain_block_cache_miss",
-		Help: "The number of block requests that aren't present in the cache.",
+		Help: "The number of block requests that aren't present in the cache. Deprecated, use cache_misses_total{cache=\"powchain_block_by_hash\"}.",
 	})
 	blockCacheHit = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "powchain_block_cache_hit",
-		Help: "The number of block requests that are present in the cache.",
+		Help: "The number of block requests that are present in the cache. Deprecated, use cache_hits_total{cache=\"powchain_block_by_hash\"}.",
 	})
 	blockCacheSize = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "powchain_block_cache_size",
-		Help: "The number of blocks in the block cache",
+		Help: "The number of blocks in the block cache. Deprecated, use cache_size{cache=\"powchain_block_by_hash\"}.",
 	})
 )
 
@@ -76,35 +76,36 @@ func heightKeyFn(obj interface{}) (string, error) {
 
 // blockCache struct with two queues for looking up by hash or by block height.
 type blockCache struct {
-	hashCache   *cache.FIFO
-	heightCache *cache.FIFO
-	lock        sync.RWMutex
+	hashCache   *cache.Store
+	heightCache *cache.Store
+	lock        sync.Mutex
 }
 
 // newBlockCache creates a new block cache for storing/accessing blockInfo from
 // memory.
 func newBlockCache() *blockCache {
 	return &blockCache{
-		hashCache:   cache.NewFIFO(hashKeyFn),
-		heightCache: cache.NewFIFO(heightKeyFn),
+		hashCache: cache.NewStore(cache.StoreConfig{
+			Name:    "powchain_block_by_hash",
+			MaxSize: maxCacheSize,
+			Legacy: &cache.LegacyMetrics{
+				Hits:   blockCacheHit,
+				Misses: blockCacheMiss,
+				Size:   blockCacheSize,
+			},
+		}),
+		heightCache: cache.NewStore(cache.StoreConfig{
+			Name:    "powchain_block_by_height",
+			MaxSize: maxCacheSize,
+		}),
 	}
 }
 
 // BlockInfoByHash fetches blockInfo by its block hash. Returns true with a
 // reference to the block info, if exists. Otherwise returns false, nil.
 func (b *blockCache) BlockInfoByHash(hash common.Hash) (bool, *blockInfo, error) {
-	b.lock.RLock()
-	defer b.lock.RUnlock()
-
-	obj, exists, err := b.hashCache.GetByKey(hash.Hex())
-	if err != nil {
-		return false, nil, err
-	}
-
-	if exists {
-		blockCacheHit.Inc()
-	} else {
-		blockCacheMiss.Inc()
+	obj, exists := b.hashCache.Get(hash.Hex())
+	if !exists {
 		return false, nil, nil
 	}
 
@@ -119,18 +120,8 @@ func (b *blockCache) BlockInfoByHash(hash common.Hash) (bool, *blockInfo, error)
 // BlockInfoByHeight fetches blockInfo by its block number. Returns true with a
 // reference to the block info, if exists. Otherwise returns false, nil.
 func (b *blockCache) BlockInfoByHeight(height *big.Int) (bool, *blockInfo, error) {
-	b.lock.RLock()
-	defer b.lock.RUnlock()
-
-	obj, exists, err := b.heightCache.GetByKey(height.String())
-	if err != nil {
-		return false, nil, err
-	}
-
-	if exists {
-		blockCacheHit.Inc()
-	} else {
-		blockCacheMiss.Inc()
+	obj, exists := b.heightCache.Get(height.String())
+	if !exists {
 		return false, nil, nil
 	}
 
@@ -152,31 +143,15 @@ func (b *blockCache) AddBlock(blk *gethTypes.Block) error {
 	defer b.lock.Unlock()
 
 	bInfo := blockToBlockInfo(blk)
-
-	if err := b.hashCache.AddIfNotPresent(bInfo); err != nil {
+	hashKey, err := hashKeyFn(bInfo)
+	if err != nil {
 		return err
 	}
-	if err := b.heightCache.AddIfNotPresent(bInfo); err != nil {
+	heightKey, err := heightKeyFn(bInfo)
+	if err != nil {
 		return err
 	}
-
-	trim(b.hashCache, maxCacheSize)
-	trim(b.heightCache, maxCacheSize)
-
-	blockCacheSize.Set(float64(len(b.hashCache.ListKeys())))
-
-	return nil
-}
-
-// trim the FIFO queue to the maxSize.
-func trim(queue *cache.FIFO, maxSize int) {
-	for s := len(queue.ListKeys()); s > maxSize; s-- {
-		// #nosec G104 popProcessNoopFunc never returns an error
-		_, _ = queue.Pop(popProcessNoopFunc)
-	}
-}
-
-// popProcessNoopFunc is a no-op function that never returns an error.
-func popProcessNoopFunc(obj interface{}) error {
+	b.hashCache.SetIfAbsent(hashKey, bInfo)
+	b.heightCache.SetIfAbsent(heightKey, bInfo)
 	return nil
 }
@@ -148,18 +148,18 @@ func TestBlockCache_maxSize(t *testing.T) {
 		}
 	}
 
-	if len(cache.hashCache.ListKeys()) != maxCacheSize {
+	if cache.hashCache.Len() != maxCacheSize {
 		t.Errorf(
 			"Expected hash cache key size to be %d, got %d",
 			maxCacheSize,
-			len(cache.hashCache.ListKeys()),
+			cache.hashCache.Len(),
 		)
 	}
-	if len(cache.heightCache.ListKeys()) != maxCacheSize {
+	if cache.heightCache.Len() != maxCacheSize {
 		t.Errorf(
 			"Expected height cache key size to be %d, got %d",
 			maxCacheSize,
-			len(cache.heightCache.ListKeys()),
+			cache.heightCache.Len(),
 		)
 	}
 }
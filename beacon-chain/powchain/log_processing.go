@@ -53,12 +53,7 @@ func (w *Web3Service) ProcessLog(depositLog gethTypes.Log) error {
 			if blk == nil {
 				return errors.Wrap(err, "got empty block from powchain service")
 			}
-			timeStamp := blk.Time()
-			triggered := state.IsValidGenesisState(w.activeValidatorCount, timeStamp)
-			if triggered {
-				w.setGenesisTime(timeStamp)
-				w.ProcessChainStart(uint64(w.eth2GenesisTime), depositLog.BlockHash, blk.Number())
-			}
+			w.checkChainStart(blk.Time(), depositLog.BlockHash, blk.Number())
 		}
 		return nil
 	}
@@ -162,6 +157,18 @@ func (w *Web3Service) ProcessDepositLog(depositLog gethTypes.Log) error {
 	return nil
 }
 
+// checkChainStart processes ChainStart if the eth1 block with the given timestamp starts the
+// beacon chain: enough validators are active from the deposits received so far, and the genesis
+// time the block gives is no earlier than MIN_GENESIS_TIME.
+func (w *Web3Service) checkChainStart(timeStamp uint64, blockHash [32]byte, blockNumber *big.Int) {
+	genesis := genesisTime(timeStamp)
+	if !state.IsValidGenesisState(w.activeValidatorCount, genesis) {
+		return
+	}
+	w.eth2GenesisTime = genesis
+	w.ProcessChainStart(genesis, blockHash, blockNumber)
+}
+
 // ProcessChainStart processes the log which had been received from
 // the ETH1.0 chain by trying to determine when to start the beacon chain.
 func (w *Web3Service) ProcessChainStart(genesisTime uint64, eth1BlockHash [32]byte, blockNumber *big.Int) {
@@ -208,33 +215,67 @@ func (w *Web3Service) ProcessChainStart(genesisTime uint64, eth1BlockHash [32]by
 	w.chainStartFeed.Send(chainStartTime)
 }
 
-func (w *Web3Service) setGenesisTime(timeStamp uint64) {
+// EstimatedGenesisTime returns the genesis time of the beacon chain once it has started. Before
+// ChainStart, it returns the genesis time the chain would have if ChainStart was triggered by the
+// latest eth1 block, but no earlier than MIN_GENESIS_TIME, or 0 while there are not enough active
+// validator deposits to trigger it.
+func (w *Web3Service) EstimatedGenesisTime() uint64 {
+	if w.chainStarted {
+		return w.eth2GenesisTime
+	}
+	if w.activeValidatorCount < params.BeaconConfig().MinGenesisActiveValidatorCount {
+		return 0
+	}
+	genesis := params.BeaconConfig().MinGenesisTime
+	if t := w.blockTime.Unix(); t > 0 && genesisTime(uint64(t)) > genesis {
+		genesis = genesisTime(uint64(t))
+	}
+	return genesis
+}
+
+// genesisTime returns the genesis time of a beacon chain started by an eth1 block with the given
+// timestamp. As specified, genesis is at the start of the day GENESIS_DELAY seconds after the
+// block. Delays shorter than a day, used by test networks, are not rounded.
+func genesisTime(timeStamp uint64) uint64 {
+	delay := params.BeaconConfig().GenesisDelay
 	if featureconfig.FeatureConfig().NoGenesisDelay {
-		w.eth2GenesisTime = uint64(time.Unix(int64(timeStamp), 0).Add(30 * time.Second).Unix())
-	} else {
-		timeStampRdDown := timeStamp - timeStamp%params.BeaconConfig().SecondsPerDay
-		// genesisTime will be set to the first second of the day, two days after it was triggered.
-		w.eth2GenesisTime = timeStampRdDown + 2*params.BeaconConfig().SecondsPerDay
+		delay = 30
+	}
+	genesis := timeStamp + delay
+	if delay < params.BeaconConfig().SecondsPerDay {
+		return genesis
 	}
+	return genesis - genesis%params.BeaconConfig().SecondsPerDay
 }
 
+// pastLogsPageSize is the number of eth1 blocks whose deposit logs are requested at once when
+// processing the past logs, so that each request is answered within the attempt timeout of the
+// eth1 node requests, even by remote providers.
+var pastLogsPageSize = uint64(100000)
+
 // processPastLogs processes all the past logs from the deposit contract and
 // updates the deposit trie with the data from each individual log.
 func (w *Web3Service) processPastLogs() error {
-	query := ethereum.FilterQuery{
-		Addresses: []common.Address{
-			w.depositContractAddress,
-		},
-	}
-
-	logs, err := w.httpLogger.FilterLogs(w.ctx, query)
-	if err != nil {
-		return err
-	}
-
-	for _, log := range logs {
-		if err := w.ProcessLog(log); err != nil {
-			return errors.Wrap(err, "could not process log")
+	for from := uint64(0); from <= w.blockHeight.Uint64(); from += pastLogsPageSize {
+		to := from + pastLogsPageSize - 1
+		if to > w.blockHeight.Uint64() {
+			to = w.blockHeight.Uint64()
+		}
+		query := ethereum.FilterQuery{
+			Addresses: []common.Address{
+				w.depositContractAddress,
+			},
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+		}
+		logs, err := w.httpLogger.FilterLogs(w.ctx, query)
+		if err != nil {
+			return errors.Wrapf(err, "could not get deposit logs of eth1 blocks %d to %d", from, to)
+		}
+		for _, log := range logs {
+			if err := w.ProcessLog(log); err != nil {
+				return errors.Wrap(err, "could not process log")
+			}
 		}
 	}
 	w.lastRequestedBlock.Set(w.blockHeight)
@@ -279,6 +320,16 @@ func (w *Web3Service) requestBatchedLogs() error {
 	}
 
 	w.lastRequestedBlock.Set(requestedBlock)
+
+	// When the deposits were received before MIN_GENESIS_TIME, the beacon chain starts with the
+	// first eth1 block late enough, even if it has no deposits.
+	if !w.chainStarted && w.activeValidatorCount >= params.BeaconConfig().MinGenesisActiveValidatorCount {
+		header, err := w.blockFetcher.HeaderByNumber(w.ctx, requestedBlock)
+		if err != nil {
+			return errors.Wrap(err, "could not get eth1 header")
+		}
+		w.checkChainStart(header.Time, header.Hash(), header.Number)
+	}
 	return nil
 }
 
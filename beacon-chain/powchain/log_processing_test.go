@@ -11,6 +11,7 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
@@ -451,3 +452,139 @@ func TestWeb3ServiceProcessDepositLog_RequestMissedDeposits(t *testing.T) {
 
 	hook.Reset()
 }
+
+func TestEstimatedGenesisTime(t *testing.T) {
+	defer params.OverrideBeaconConfig(params.BeaconConfig())
+	bConfig := params.MinimalSpecConfig()
+	bConfig.MinGenesisTime = 1578009600
+	params.OverrideBeaconConfig(bConfig)
+
+	tests := []struct {
+		name            string
+		web3Service     *Web3Service
+		wantGenesisTime uint64
+	}{
+		{
+			name:            "not enough deposits",
+			web3Service:     &Web3Service{activeValidatorCount: 63},
+			wantGenesisTime: 0,
+		},
+		{
+			name:            "eth1 block before min genesis time",
+			web3Service:     &Web3Service{activeValidatorCount: 64},
+			wantGenesisTime: 1578009600,
+		},
+		{
+			name:            "eth1 block after min genesis time",
+			web3Service:     &Web3Service{activeValidatorCount: 64, blockTime: time.Unix(1578100000, 0)},
+			wantGenesisTime: 1578096000 + 2*86400,
+		},
+		{
+			name:            "chain started",
+			web3Service:     &Web3Service{activeValidatorCount: 64, chainStarted: true, eth2GenesisTime: 42},
+			wantGenesisTime: 42,
+		},
+	}
+	for _, tt := range tests {
+		if got := tt.web3Service.EstimatedGenesisTime(); got != tt.wantGenesisTime {
+			t.Errorf("%s: wanted genesis time %d, got %d", tt.name, tt.wantGenesisTime, got)
+		}
+	}
+}
+
+func TestGenesisTime(t *testing.T) {
+	defer params.OverrideBeaconConfig(params.BeaconConfig())
+	bConfig := params.MinimalSpecConfig()
+
+	bConfig.GenesisDelay = 2 * 86400
+	params.OverrideBeaconConfig(bConfig)
+	if got := genesisTime(1578100000); got != 1578096000+2*86400 {
+		t.Errorf("Wanted genesis at the start of the day two days later, got %d", got)
+	}
+
+	bConfig.GenesisDelay = 300
+	params.OverrideBeaconConfig(bConfig)
+	if got := genesisTime(1578100000); got != 1578100300 {
+		t.Errorf("Wanted genesis 300 seconds later, got %d", got)
+	}
+}
+
+// pagingLogger serves the deposit logs of the simulated backend, recording the queries.
+type pagingLogger struct {
+	goodLogger
+	backend interface {
+		FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]gethTypes.Log, error)
+	}
+	queries []ethereum.FilterQuery
+}
+
+func (l *pagingLogger) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	l.queries = append(l.queries, q)
+	return l.backend.FilterLogs(ctx, q)
+}
+
+func TestProcessPastLogs_RequestsPages(t *testing.T) {
+	testutil.ResetCache()
+	testAcc, err := contracts.Setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("unable to set up simulated db instance: %v", err)
+	}
+	logger := &pagingLogger{backend: testAcc.Backend}
+	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+		Endpoint:        endpoint,
+		DepositContract: testAcc.ContractAddr,
+		Reader:          &goodReader{},
+		Logger:          &goodLogger{},
+		HTTPLogger:      logger,
+		ContractBackend: testAcc.Backend,
+		BeaconDB:        beaconDB,
+		DepositCache:    depositcache.NewDepositCache(),
+		BlockFetcher:    &goodFetcher{},
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	defer func(size uint64) {
+		pastLogsPageSize = size
+	}(pastLogsPageSize)
+	pastLogsPageSize = 2
+
+	// One deposit per block.
+	deposits, _ := testutil.SetupInitialDeposits(t, 3)
+	testAcc.TxOpts.Value = contracts.Amount32Eth()
+	testAcc.TxOpts.GasLimit = 1000000
+	for _, dep := range deposits {
+		data := dep.Data
+		if _, err := testAcc.Contract.Deposit(testAcc.TxOpts, data.PublicKey, data.WithdrawalCredentials, data.Signature); err != nil {
+			t.Fatalf("Could not deposit to deposit contract %v", err)
+		}
+		testAcc.Backend.Commit()
+	}
+	header, err := testAcc.Backend.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	web3Service.blockHeight = header.Number
+
+	if err := web3Service.processPastLogs(); err != nil {
+		t.Fatalf("Could not process past logs: %v", err)
+	}
+	if web3Service.lastReceivedMerkleIndex != 2 {
+		t.Errorf("Wanted the 3 deposits processed once, last index is %d", web3Service.lastReceivedMerkleIndex)
+	}
+	wantedPages := int(header.Number.Uint64()/pastLogsPageSize + 1)
+	if len(logger.queries) != wantedPages {
+		t.Fatalf("Wanted %d pages of logs requested, got %d", wantedPages, len(logger.queries))
+	}
+	last := logger.queries[len(logger.queries)-1]
+	if last.ToBlock.Cmp(header.Number) != 0 {
+		t.Errorf("Wanted logs requested up to block %v, got %v", header.Number, last.ToBlock)
+	}
+	if web3Service.lastRequestedBlock.Cmp(header.Number) != 0 {
+		t.Errorf("Wanted last requested block %v, got %v", header.Number, web3Service.lastRequestedBlock)
+	}
+}
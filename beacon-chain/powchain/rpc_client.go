@@ -0,0 +1,368 @@
+package powchain
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	rpcRequestLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "powchain_rpc_request_latency_seconds",
+		Help:    "The latency of the requests to the eth1 node, retries included.",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}, []string{"client", "method"})
+	rpcRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "powchain_rpc_requests_total",
+		Help: "The number of requests to the eth1 node, by outcome.",
+	}, []string{"client", "method", "outcome"})
+	rpcThrottled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "powchain_rpc_throttled_seconds_total",
+		Help: "The time requests to the eth1 node waited for the rate limit.",
+	}, []string{"client"})
+	rpcCircuitOpen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_rpc_circuit_open",
+		Help: "Whether requests to the eth1 node fail without being sent, after repeated failures.",
+	}, []string{"client"})
+)
+
+// errCircuitOpen is returned for requests not sent to an eth1 node failing repeatedly.
+var errCircuitOpen = errors.New("eth1 node is degraded, circuit open")
+
+// errAttemptTimeout is returned for requests the eth1 node didn't answer within the attempt
+// timeout.
+var errAttemptTimeout = errors.New("eth1 node request timed out")
+
+// RPCClientConfig defines how the requests to an eth1 node are paced and retried.
+type RPCClientConfig struct {
+	// Name identifies the endpoint in logs and metrics.
+	Name string
+	// RequestsPerSecond is the sustained rate of requests allowed by the provider quota, bursts
+	// of up to a second worth of requests are allowed. Zero means no limit.
+	RequestsPerSecond float64
+	// MaxAttempts is the number of times a request is sent before giving up.
+	MaxAttempts int
+	// BaseDelay is the backoff before the first retry, doubled after each attempt.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff between two attempts.
+	MaxDelay time.Duration
+	// AttemptTimeout bounds each attempt, so that an eth1 node which hangs counts as failing
+	// rather than holding the request until the deadline of the caller. Zero means no timeout.
+	AttemptTimeout time.Duration
+	// BreakerThreshold is the number of consecutive failed requests after which the circuit
+	// opens, failing requests without contacting the eth1 node.
+	BreakerThreshold int
+	// BreakerCooldown is how long the circuit stays open before a request is let through again
+	// to probe the eth1 node.
+	BreakerCooldown time.Duration
+}
+
+// DefaultRPCClientConfig returns the retry policy of an eth1 endpoint, without rate limit.
+func DefaultRPCClientConfig(name string) *RPCClientConfig {
+	return &RPCClientConfig{
+		Name:             name,
+		MaxAttempts:      4,
+		BaseDelay:        250 * time.Millisecond,
+		MaxDelay:         5 * time.Second,
+		AttemptTimeout:   10 * time.Second,
+		BreakerThreshold: 8,
+		BreakerCooldown:  30 * time.Second,
+	}
+}
+
+// RPCClient wraps the client of an eth1 node, so that every request of the powchain service is
+// rate limited, retried with a backoff on transient errors, measured, and failed early while the
+// eth1 node keeps failing.
+type RPCClient struct {
+	backend    Client
+	cfg        *RPCClientConfig
+	lock       sync.Mutex
+	tokens     float64
+	lastRefill time.Time
+	failures   int
+	open       bool
+	openedAt   time.Time
+	now        func() time.Time
+	sleep      func(ctx context.Context, d time.Duration) error
+}
+
+// NewRPCClient wraps the client of an eth1 node.
+func NewRPCClient(backend Client, cfg *RPCClientConfig) *RPCClient {
+	if cfg.MaxAttempts < 1 {
+		cfg.MaxAttempts = 1
+	}
+	return &RPCClient{
+		backend:    backend,
+		cfg:        cfg,
+		tokens:     math.Max(cfg.RequestsPerSecond, 1),
+		lastRefill: time.Now(),
+		now:        time.Now,
+		sleep:      sleepContext,
+	}
+}
+
+// sleepContext waits for the given duration, or until the context is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// retryable returns true if the request may succeed when sent again. Requests for unknown blocks
+// and abandoned requests are not retried.
+func retryable(err error) bool {
+	switch err {
+	case nil, ethereum.NotFound, context.Canceled, context.DeadlineExceeded, errCircuitOpen:
+		return false
+	default:
+		return true
+	}
+}
+
+// healthy returns true if the eth1 node answered the request. Requests for unknown blocks are
+// answered, while requests past the deadline of the caller were not.
+func healthy(err error) bool {
+	return err == nil || err == ethereum.NotFound
+}
+
+// reserve takes a token from the rate limit, and returns how long to wait before sending the
+// request.
+func (c *RPCClient) reserve() time.Duration {
+	if c.cfg.RequestsPerSecond <= 0 {
+		return 0
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	now := c.now()
+	burst := math.Max(c.cfg.RequestsPerSecond, 1)
+	c.tokens = math.Min(burst, c.tokens+now.Sub(c.lastRefill).Seconds()*c.cfg.RequestsPerSecond)
+	c.lastRefill = now
+	c.tokens--
+	if c.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-c.tokens / c.cfg.RequestsPerSecond * float64(time.Second))
+}
+
+// backoff returns the delay before the given retry, exponential in the attempt and jittered over
+// its upper half.
+func (c *RPCClient) backoff(attempt int) time.Duration {
+	delay := c.cfg.BaseDelay << uint(attempt)
+	if delay <= 0 || delay > c.cfg.MaxDelay {
+		delay = c.cfg.MaxDelay
+	}
+	half := int64(delay / 2)
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// allow returns false if the circuit is open. Once the cooldown elapsed, a request is let through
+// to probe whether the eth1 node recovered.
+func (c *RPCClient) allow() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.open {
+		return true
+	}
+	if c.now().Sub(c.openedAt) < c.cfg.BreakerCooldown {
+		return false
+	}
+	// Half open, the failure of the probe opens the circuit for another cooldown.
+	c.openedAt = c.now()
+	return true
+}
+
+// record updates the circuit with the outcome of a request.
+func (c *RPCClient) record(err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if healthy(err) {
+		if c.open {
+			log.WithField("client", c.cfg.Name).Info("Eth1 node recovered")
+			rpcCircuitOpen.WithLabelValues(c.cfg.Name).Set(0)
+		}
+		c.failures = 0
+		c.open = false
+		return
+	}
+	c.failures++
+	if !c.open && c.failures >= c.cfg.BreakerThreshold {
+		c.open = true
+		c.openedAt = c.now()
+		rpcCircuitOpen.WithLabelValues(c.cfg.Name).Set(1)
+		log.WithFields(logrus.Fields{
+			"client":              c.cfg.Name,
+			"consecutiveFailures": c.failures,
+			"cooldown":            c.cfg.BreakerCooldown,
+		}).WithError(err).Warn("Eth1 node degraded, failing requests until it recovers")
+	}
+}
+
+// call sends a request to the eth1 node, waiting for the rate limit and retrying it on transient
+// errors, attempt timeouts included, as long as the context allows it.
+func (c *RPCClient) call(ctx context.Context, method string, request func(ctx context.Context) error) error {
+	start := c.now()
+	if !c.allow() {
+		rpcRequests.WithLabelValues(c.cfg.Name, method, "circuit_open").Inc()
+		return errCircuitOpen
+	}
+	// sentErr is the outcome of the last attempt which reached the eth1 node, if one was sent.
+	var err, sentErr error
+	sent := false
+	for attempt := 0; attempt < c.cfg.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			delay := c.backoff(attempt - 1)
+			log.WithError(err).WithFields(logrus.Fields{
+				"client":  c.cfg.Name,
+				"method":  method,
+				"attempt": attempt + 1,
+				"backoff": delay,
+			}).Debug("Retrying eth1 node request")
+			if sleepErr := c.sleep(ctx, delay); sleepErr != nil {
+				break
+			}
+		}
+		if wait := c.reserve(); wait > 0 {
+			rpcThrottled.WithLabelValues(c.cfg.Name).Add(wait.Seconds())
+			if sleepErr := c.sleep(ctx, wait); sleepErr != nil {
+				err = sleepErr
+				break
+			}
+		}
+		err = c.attempt(ctx, request)
+		sentErr, sent = err, true
+		if !retryable(err) || ctx.Err() != nil {
+			break
+		}
+	}
+	// Requests abandoned by the service, and requests which ran out of time waiting for the rate
+	// limit before reaching the eth1 node, say nothing about its health, unlike requests the eth1
+	// node didn't answer before the deadline of the caller.
+	if sent && ctx.Err() != context.Canceled {
+		c.record(sentErr)
+	}
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+	}
+	rpcRequests.WithLabelValues(c.cfg.Name, method, outcome).Inc()
+	rpcRequestLatency.WithLabelValues(c.cfg.Name, method).Observe(c.now().Sub(start).Seconds())
+	return err
+}
+
+// attempt sends the request once, within the attempt timeout.
+func (c *RPCClient) attempt(ctx context.Context, request func(ctx context.Context) error) error {
+	if c.cfg.AttemptTimeout <= 0 {
+		return request(ctx)
+	}
+	attemptCtx, cancel := context.WithTimeout(ctx, c.cfg.AttemptTimeout)
+	defer cancel()
+	err := request(attemptCtx)
+	if err != nil && ctx.Err() == nil && attemptCtx.Err() == context.DeadlineExceeded {
+		return errAttemptTimeout
+	}
+	return err
+}
+
+// SubscribeNewHead subscribes to the headers of the new eth1 blocks.
+func (c *RPCClient) SubscribeNewHead(ctx context.Context, ch chan<- *gethTypes.Header) (ethereum.Subscription, error) {
+	var sub ethereum.Subscription
+	err := c.call(ctx, "SubscribeNewHead", func(ctx context.Context) error {
+		var err error
+		sub, err = c.backend.SubscribeNewHead(ctx, ch)
+		return err
+	})
+	return sub, err
+}
+
+// BlockByHash returns the eth1 block with the given hash.
+func (c *RPCClient) BlockByHash(ctx context.Context, hash common.Hash) (*gethTypes.Block, error) {
+	var block *gethTypes.Block
+	err := c.call(ctx, "BlockByHash", func(ctx context.Context) error {
+		var err error
+		block, err = c.backend.BlockByHash(ctx, hash)
+		return err
+	})
+	return block, err
+}
+
+// BlockByNumber returns the eth1 block at the given height, the latest block if nil.
+func (c *RPCClient) BlockByNumber(ctx context.Context, number *big.Int) (*gethTypes.Block, error) {
+	var block *gethTypes.Block
+	err := c.call(ctx, "BlockByNumber", func(ctx context.Context) error {
+		var err error
+		block, err = c.backend.BlockByNumber(ctx, number)
+		return err
+	})
+	return block, err
+}
+
+// HeaderByNumber returns the header of the eth1 block at the given height, the latest block if nil.
+func (c *RPCClient) HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error) {
+	var header *gethTypes.Header
+	err := c.call(ctx, "HeaderByNumber", func(ctx context.Context) error {
+		var err error
+		header, err = c.backend.HeaderByNumber(ctx, number)
+		return err
+	})
+	return header, err
+}
+
+// FilterLogs returns the logs matching the query.
+func (c *RPCClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	var logs []gethTypes.Log
+	err := c.call(ctx, "FilterLogs", func(ctx context.Context) error {
+		var err error
+		logs, err = c.backend.FilterLogs(ctx, query)
+		return err
+	})
+	return logs, err
+}
+
+// SubscribeFilterLogs subscribes to the new logs matching the query.
+func (c *RPCClient) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- gethTypes.Log) (ethereum.Subscription, error) {
+	var sub ethereum.Subscription
+	err := c.call(ctx, "SubscribeFilterLogs", func(ctx context.Context) error {
+		var err error
+		sub, err = c.backend.SubscribeFilterLogs(ctx, query, ch)
+		return err
+	})
+	return sub, err
+}
+
+// CodeAt returns the code of the contract at the given address.
+func (c *RPCClient) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	var code []byte
+	err := c.call(ctx, "CodeAt", func(ctx context.Context) error {
+		var err error
+		code, err = c.backend.CodeAt(ctx, contract, blockNumber)
+		return err
+	})
+	return code, err
+}
+
+// CallContract executes a contract call.
+func (c *RPCClient) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	var result []byte
+	err := c.call(ctx, "CallContract", func(ctx context.Context) error {
+		var err error
+		result, err = c.backend.CallContract(ctx, call, blockNumber)
+		return err
+	})
+	return result, err
+}
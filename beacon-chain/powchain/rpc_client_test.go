@@ -0,0 +1,204 @@
+package powchain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+type flakyClient struct {
+	Client
+	calls    int
+	failures int
+	err      error
+}
+
+func (f *flakyClient) HeaderByNumber(_ context.Context, number *big.Int) (*gethTypes.Header, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return nil, f.err
+	}
+	return &gethTypes.Header{Number: big.NewInt(10)}, nil
+}
+
+// hangingClient doesn't answer the first requests until their context is done.
+type hangingClient struct {
+	Client
+	calls int
+	hangs int
+}
+
+func (h *hangingClient) HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error) {
+	h.calls++
+	if h.calls <= h.hangs {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return &gethTypes.Header{Number: big.NewInt(10)}, nil
+}
+
+func newTestRPCClient(backend Client, cfg *RPCClientConfig) (*RPCClient, *[]time.Duration) {
+	c := NewRPCClient(backend, cfg)
+	var slept []time.Duration
+	c.sleep = func(_ context.Context, d time.Duration) error {
+		slept = append(slept, d)
+		return nil
+	}
+	return c, &slept
+}
+
+func TestRPCClient_RetriesTransientErrors(t *testing.T) {
+	backend := &flakyClient{failures: 2, err: errors.New("connection reset")}
+	c, slept := newTestRPCClient(backend, DefaultRPCClientConfig("test"))
+
+	header, err := c.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header.Number.Uint64() != 10 {
+		t.Errorf("Wanted header 10, got %d", header.Number.Uint64())
+	}
+	if backend.calls != 3 || len(*slept) != 2 {
+		t.Errorf("Wanted 3 calls and 2 backoffs, got %d calls and %d backoffs", backend.calls, len(*slept))
+	}
+}
+
+func TestRPCClient_DoesNotRetryNotFound(t *testing.T) {
+	backend := &flakyClient{failures: 2, err: ethereum.NotFound}
+	c, _ := newTestRPCClient(backend, DefaultRPCClientConfig("test"))
+
+	if _, err := c.HeaderByNumber(context.Background(), big.NewInt(1)); err != ethereum.NotFound {
+		t.Errorf("Wanted not found error, got %v", err)
+	}
+	if backend.calls != 1 {
+		t.Errorf("Wanted 1 call, got %d", backend.calls)
+	}
+}
+
+func TestRPCClient_CircuitBreaker(t *testing.T) {
+	backend := &flakyClient{failures: 100, err: errors.New("connection refused")}
+	cfg := DefaultRPCClientConfig("test")
+	cfg.MaxAttempts = 1
+	cfg.BreakerThreshold = 2
+	c, _ := newTestRPCClient(backend, cfg)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.HeaderByNumber(context.Background(), nil); err == nil {
+			t.Fatal("Expected an error")
+		}
+	}
+	if _, err := c.HeaderByNumber(context.Background(), nil); err != errCircuitOpen {
+		t.Errorf("Wanted circuit open error, got %v", err)
+	}
+	if backend.calls != 2 {
+		t.Errorf("Wanted 2 calls while the circuit is open, got %d", backend.calls)
+	}
+
+	// A probe is let through after the cooldown, and closes the circuit when it succeeds.
+	now = now.Add(cfg.BreakerCooldown)
+	backend.failures = 0
+	if _, err := c.HeaderByNumber(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if c.open {
+		t.Error("Wanted the circuit closed after a successful probe")
+	}
+}
+
+func TestRPCClient_RetriesAttemptTimeouts(t *testing.T) {
+	backend := &hangingClient{hangs: 1}
+	cfg := DefaultRPCClientConfig("test")
+	cfg.AttemptTimeout = 10 * time.Millisecond
+	c, _ := newTestRPCClient(backend, cfg)
+
+	if _, err := c.HeaderByNumber(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if backend.calls != 2 {
+		t.Errorf("Wanted the request sent again after the attempt timed out, got %d calls", backend.calls)
+	}
+}
+
+func TestRPCClient_CircuitOpensOnTimeouts(t *testing.T) {
+	backend := &hangingClient{hangs: 100}
+	cfg := DefaultRPCClientConfig("test")
+	cfg.MaxAttempts = 1
+	cfg.AttemptTimeout = 10 * time.Millisecond
+	cfg.BreakerThreshold = 2
+	c, _ := newTestRPCClient(backend, cfg)
+
+	if _, err := c.HeaderByNumber(context.Background(), nil); err != errAttemptTimeout {
+		t.Errorf("Wanted attempt timeout error, got %v", err)
+	}
+	// The deadline of the caller passing counts as a failure too.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if _, err := c.HeaderByNumber(ctx, nil); err != context.DeadlineExceeded {
+		t.Errorf("Wanted deadline exceeded error, got %v", err)
+	}
+	if !c.open {
+		t.Error("Wanted the circuit open after the eth1 node timed out repeatedly")
+	}
+
+	// Requests canceled by the caller are not recorded.
+	c.open = false
+	c.failures = 0
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.HeaderByNumber(ctx, nil); err != context.Canceled {
+		t.Errorf("Wanted canceled error, got %v", err)
+	}
+	if c.failures != 0 {
+		t.Errorf("Wanted no failure recorded for a canceled request, got %d", c.failures)
+	}
+}
+
+func TestRPCClient_RateLimit(t *testing.T) {
+	backend := &flakyClient{}
+	cfg := DefaultRPCClientConfig("test")
+	cfg.RequestsPerSecond = 2
+	c, slept := newTestRPCClient(backend, cfg)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+	c.lastRefill = now
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.HeaderByNumber(context.Background(), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The burst of 2 requests goes through, the third one waits for a token.
+	if len(*slept) != 1 || (*slept)[0] != 500*time.Millisecond {
+		t.Errorf("Wanted a single wait of 500ms, got %v", *slept)
+	}
+}
+
+func TestRPCClient_RateLimitWaitNotRecorded(t *testing.T) {
+	backend := &flakyClient{}
+	cfg := DefaultRPCClientConfig("test")
+	cfg.RequestsPerSecond = 1
+	cfg.BreakerThreshold = 1
+	c := NewRPCClient(backend, cfg)
+	c.tokens = 0
+	// The deadline of the caller passes while the request waits for a token.
+	c.sleep = func(_ context.Context, _ time.Duration) error {
+		return context.DeadlineExceeded
+	}
+
+	if _, err := c.HeaderByNumber(context.Background(), nil); err != context.DeadlineExceeded {
+		t.Errorf("Wanted deadline exceeded error, got %v", err)
+	}
+	if backend.calls != 0 {
+		t.Errorf("Wanted the eth1 node not to be contacted, got %d calls", backend.calls)
+	}
+	if c.failures != 0 || c.open {
+		t.Errorf("Wanted no failure recorded for a request which never reached the eth1 node, got %d", c.failures)
+	}
+}
@@ -116,7 +116,7 @@ type Web3ServiceConfig struct {
 	Logger          bind.ContractFilterer
 	HTTPLogger      bind.ContractFilterer
 	BlockFetcher    POWBlockFetcher
-	ContractBackend bind.ContractBackend
+	ContractBackend bind.ContractCaller
 	BeaconDB        db.Database
 	DepositCache    *depositcache.DepositCache
 }
@@ -213,6 +213,12 @@ func (w *Web3Service) HasChainStarted() bool {
 	return w.chainStarted
 }
 
+// ActiveValidatorCount returns the number of validators whose deposits processed
+// before ChainStart add up to the maximum effective balance.
+func (w *Web3Service) ActiveValidatorCount() uint64 {
+	return w.activeValidatorCount
+}
+
 // Status is service health checks. Return nil or error.
 func (w *Web3Service) Status() error {
 	// Web3Service don't start
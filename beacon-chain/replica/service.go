@@ -0,0 +1,272 @@
+// Package replica keeps the database of a read-only beacon node up to date with the finalized
+// chain of a primary beacon node, streamed over the RPC server of the primary. Replicas neither
+// sync from the network nor run the chain service, they serve the heavy RPC queries of block
+// explorers and archives so the validating node doesn't have to.
+package replica
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var log = logrus.WithField("prefix", "replica")
+
+// apiKeyMetadataKey is the gRPC metadata key a primary enforcing quotas reads the API key from.
+const apiKeyMetadataKey = "x-api-key"
+
+// reconnectDelay is the time waited before reconnecting to the primary once the stream broke.
+var reconnectDelay = 10 * time.Second
+
+var (
+	replicatedBlocks = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "replica_blocks_total",
+		Help: "The number of finalized blocks replicated from the primary node.",
+	})
+	replicatedStates = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "replica_states_total",
+		Help: "The number of finalized states replicated from the primary node.",
+	})
+	replicatedSlot = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "replica_finalized_slot",
+		Help: "The slot of the latest finalized block replicated with its state.",
+	})
+)
+
+// Config options for the replica service.
+type Config struct {
+	// Primary is the host:port of the RPC server of the primary beacon node.
+	Primary string
+	// CertFlag is the certificate of the RPC server of the primary, the connection is insecure
+	// when empty.
+	CertFlag string
+	BeaconDB *db.BeaconDB
+	// StateIntervalEpochs is the minimum number of epochs between two finalized states sent by
+	// the primary.
+	StateIntervalEpochs uint64
+	// APIKey is sent to primaries enforcing per client quotas.
+	APIKey string
+}
+
+// Service replicates the finalized chain of a primary beacon node in the database of the node.
+type Service struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	cfg       *Config
+	conn      *grpc.ClientConn
+	lock      sync.RWMutex
+	caughtUp  bool
+	lastError error
+}
+
+// NewService creates a replica service for the given configuration.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+	}
+}
+
+// Start connects to the primary and replicates its finalized chain until the service stops,
+// reconnecting whenever the stream breaks.
+func (s *Service) Start() {
+	var dialOpt grpc.DialOption
+	if s.cfg.CertFlag != "" {
+		creds, err := credentials.NewClientTLSFromFile(s.cfg.CertFlag, "")
+		if err != nil {
+			log.WithError(err).Error("Could not get valid credentials")
+			s.setError(err)
+			return
+		}
+		dialOpt = grpc.WithTransportCredentials(creds)
+	} else {
+		dialOpt = grpc.WithInsecure()
+		log.Warn("You are using an insecure gRPC connection to the primary! Please provide a certificate to connect securely")
+	}
+	opts := []grpc.DialOption{dialOpt}
+	if s.cfg.APIKey != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(&apiKeyCredentials{
+			key:     s.cfg.APIKey,
+			withTLS: s.cfg.CertFlag != "",
+		}))
+	}
+	conn, err := grpc.DialContext(s.ctx, s.cfg.Primary, opts...)
+	if err != nil {
+		log.WithError(err).Errorf("Could not dial primary node at %s", s.cfg.Primary)
+		s.setError(err)
+		return
+	}
+	s.conn = conn
+	log.WithField("primary", s.cfg.Primary).Info("Replicating the finalized chain of the primary node")
+	go s.run(pb.NewBeaconServiceClient(conn))
+}
+
+// Stop the replication and close the connection to the primary.
+func (s *Service) Stop() error {
+	s.cancel()
+	if s.conn != nil {
+		return s.conn.Close()
+	}
+	return nil
+}
+
+// Status returns the error which interrupted the replication last, if the node did not catch up
+// with the primary since.
+func (s *Service) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.caughtUp {
+		return nil
+	}
+	return s.lastError
+}
+
+// Syncing is true until the latest finalized state of the primary is replicated.
+func (s *Service) Syncing() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return !s.caughtUp
+}
+
+func (s *Service) setError(err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.caughtUp = false
+	s.lastError = err
+}
+
+func (s *Service) setCaughtUp() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.caughtUp = true
+	s.lastError = nil
+}
+
+func (s *Service) run(client pb.BeaconServiceClient) {
+	for {
+		err := s.replicate(client)
+		if s.ctx.Err() != nil {
+			return
+		}
+		log.WithError(err).Error("Replication from the primary node interrupted, reconnecting")
+		s.setError(err)
+		select {
+		case <-time.After(reconnectDelay):
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// replicate streams the finalized chain of the primary from the slot after the head of the
+// replica, which is the latest finalized block replicated with its state.
+func (s *Service) replicate(client pb.BeaconServiceClient) error {
+	var fromSlot uint64
+	if head, err := s.cfg.BeaconDB.ChainHead(); err == nil && head != nil {
+		fromSlot = head.Slot + 1
+	}
+	stream, err := client.StreamFinalizedChain(s.ctx, &pb.FinalizedChainRequest{
+		FromSlot:            fromSlot,
+		StateIntervalEpochs: s.cfg.StateIntervalEpochs,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open the finalized chain stream")
+	}
+	for {
+		update, err := stream.Recv()
+		if err == io.EOF {
+			return errors.New("primary closed the finalized chain stream")
+		}
+		if err != nil {
+			return errors.Wrap(err, "could not receive finalized chain update")
+		}
+		if err := s.applyUpdate(s.ctx, update); err != nil {
+			return err
+		}
+	}
+}
+
+// applyUpdate saves a replicated block and indexes it by slot, as every replicated block is
+// finalized. When the update carries the finalized state of the block, the block becomes the
+// finalized checkpoint and the head of the replica, which caught up with the primary once this is
+// the finalized checkpoint of the primary.
+func (s *Service) applyUpdate(ctx context.Context, update *pb.FinalizedChainUpdate) error {
+	if update.Block == nil {
+		return errors.New("received a finalized chain update without block")
+	}
+	if err := s.cfg.BeaconDB.SaveBlockDeprecated(update.Block); err != nil {
+		return errors.Wrap(err, "could not save replicated block")
+	}
+	if err := s.cfg.BeaconDB.IndexCanonicalBlock(update.Block); err != nil {
+		return errors.Wrap(err, "could not index replicated block")
+	}
+	if len(update.State) == 0 {
+		replicatedBlocks.Inc()
+		return nil
+	}
+
+	finalizedState := &pbp2p.BeaconState{}
+	if err := proto.Unmarshal(update.State, finalizedState); err != nil {
+		return errors.Wrap(err, "could not decode replicated state")
+	}
+	if err := s.cfg.BeaconDB.SaveFinalizedBlock(update.Block); err != nil {
+		return errors.Wrap(err, "could not save replicated finalized block")
+	}
+	if err := s.cfg.BeaconDB.SaveFinalizedState(finalizedState); err != nil {
+		return errors.Wrap(err, "could not save replicated finalized state")
+	}
+	if err := s.cfg.BeaconDB.UpdateChainHead(ctx, update.Block, finalizedState); err != nil {
+		return errors.Wrap(err, "could not update replica head")
+	}
+	replicatedStates.Inc()
+	replicatedSlot.Set(float64(update.Block.Slot))
+	if update.Block.Slot >= update.FinalizedSlot {
+		s.setCaughtUp()
+	}
+
+	root, err := ssz.SigningRoot(update.Block)
+	if err != nil {
+		return errors.Wrap(err, "could not hash replicated block")
+	}
+	log.WithFields(logrus.Fields{
+		"slot":  update.Block.Slot,
+		"epoch": helpers.SlotToEpoch(update.Block.Slot),
+		"root":  fmt.Sprintf("%#x", bytesutil.Trunc(root[:])),
+	}).Info("Replicated finalized checkpoint")
+	return nil
+}
+
+// apiKeyCredentials authenticates the replica to a primary enforcing a quota per API key.
+type apiKeyCredentials struct {
+	key     string
+	withTLS bool
+}
+
+// GetRequestMetadata attaches the API key to every request.
+func (c *apiKeyCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{apiKeyMetadataKey: c.key}, nil
+}
+
+// RequireTransportSecurity returns true when the connection uses TLS, so the key is never sent
+// in clear over a connection meant to be secure.
+func (c *apiKeyCredentials) RequireTransportSecurity() bool {
+	return c.withTLS
+}
@@ -0,0 +1,91 @@
+package replica
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+func TestApplyUpdate(t *testing.T) {
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+	s := NewService(ctx, &Config{BeaconDB: db})
+
+	// The primary finalized slot 3 and sends the chain leading to it.
+	block := &ethpb.BeaconBlock{Slot: 1}
+	if err := s.applyUpdate(ctx, &pb.FinalizedChainUpdate{Block: block, FinalizedSlot: 3}); err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.SigningRoot(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !db.HasBlockDeprecated(root) {
+		t.Error("Expected the replicated block to be saved")
+	}
+	canonical, err := db.CanonicalBlockBySlot(ctx, block.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(canonical, block) {
+		t.Errorf("Wanted the replicated block indexed at slot %d, got %v", block.Slot, canonical)
+	}
+	if !s.Syncing() {
+		t.Error("Expected the replica to be syncing until a finalized state is replicated")
+	}
+
+	for _, slot := range []uint64{2, 3} {
+		finalizedBlock := &ethpb.BeaconBlock{Slot: slot}
+		finalizedState := &pbp2p.BeaconState{Slot: slot}
+		enc, err := proto.Marshal(finalizedState)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.applyUpdate(ctx, &pb.FinalizedChainUpdate{
+			Block:         finalizedBlock,
+			State:         enc,
+			FinalizedSlot: 3,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		replicatedState, err := db.FinalizedState()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(replicatedState, finalizedState) {
+			t.Errorf("Wanted finalized state %v, got %v", finalizedState, replicatedState)
+		}
+		head, err := db.ChainHead()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if head.Slot != finalizedBlock.Slot {
+			t.Errorf("Wanted head at slot %d, got %d", finalizedBlock.Slot, head.Slot)
+		}
+		if caughtUp := !s.Syncing(); caughtUp != (slot == 3) {
+			t.Errorf("Wanted caught up %v with the state of slot %d, got %v", slot == 3, slot, caughtUp)
+		}
+	}
+}
+
+func TestApplyUpdate_RejectsCorruptState(t *testing.T) {
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+	s := NewService(ctx, &Config{BeaconDB: db})
+
+	update := &pb.FinalizedChainUpdate{Block: &ethpb.BeaconBlock{Slot: 2}, State: []byte{0xff}}
+	if err := s.applyUpdate(ctx, update); err == nil {
+		t.Error("Expected an error for an undecodable state")
+	}
+	if err := s.applyUpdate(ctx, &pb.FinalizedChainUpdate{}); err == nil {
+		t.Error("Expected an error for an update without block")
+	}
+}
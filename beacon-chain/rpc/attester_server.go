@@ -3,13 +3,14 @@ package rpc
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
-	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
@@ -17,23 +18,52 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/tracing"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // AttesterServer defines a server implementation of the gRPC Attester service,
 // providing RPC methods for validators acting as attesters to broadcast votes on beacon blocks.
 type AttesterServer struct {
-	p2p              p2p.Broadcaster
-	beaconDB         db.Database
-	operationService operationService
-	cache            *cache.AttestationCache
+	p2p               p2p.Broadcaster
+	beaconDB          db.Database
+	operationService  operationService
+	cache             *cache.AttestationCache
+	recentlyProcessed *cache.RecentlyProcessedCache
+	prefetcher        *statePrefetcher
 }
 
 // SubmitAttestation is a function called by an attester in a sharding validator to vote
 // on a block via an attestation object as defined in the Ethereum Serenity specification.
 func (as *AttesterServer) SubmitAttestation(ctx context.Context, att *ethpb.Attestation) (*pb.AttestResponse, error) {
-	if err := as.operationService.HandleAttestation(ctx, att); err != nil {
+	ctx, span := trace.StartSpan(ctx, "AttesterServer.SubmitAttestation")
+	defer span.End()
+	dutyID := tracing.DutyID(ctx)
+	span.AddAttributes(trace.StringAttribute("dutyID", dutyID))
+
+	hash, err := hashutil.HashProto(att)
+	if err != nil {
 		return nil, err
 	}
+	// The same attestation may already have been received by gossip, or submitted twice.
+	firstSeen, err := as.recentlyProcessed.MarkProcessed(hash, cache.KindAttestation, cache.SourceRPC)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check recently processed attestations")
+	}
+	if !firstSeen {
+		return &pb.AttestResponse{Root: hash[:]}, nil
+	}
+
+	poolStart := time.Now()
+	if err := as.operationService.HandleAttestation(ctx, att); err != nil {
+		if forgetErr := as.recentlyProcessed.Forget(hash); forgetErr != nil {
+			log.WithError(forgetErr).Error("Could not forget failed attestation")
+		}
+		return nil, attestationStatusError(err)
+	}
 
 	// Update attestation target for RPC server to run necessary fork choice.
 	// We need to retrieve the head block to get its parent root.
@@ -43,7 +73,7 @@ func (as *AttesterServer) SubmitAttestation(ctx context.Context, att *ethpb.Atte
 	}
 	// If the head block is nil, we can't save the attestation target.
 	if head == nil {
-		return nil, fmt.Errorf("could not find head %#x in db", bytesutil.Trunc(att.Data.BeaconBlockRoot))
+		return nil, attestationStatusError(errors.Wrapf(operations.ErrUnknownBlockRoot, "could not find head %#x in db", bytesutil.Trunc(att.Data.BeaconBlockRoot)))
 	}
 	// TODO(#3088): Remove this when fork-choice is updated to the new one.
 	attestationSlot := att.Data.Target.Epoch * params.BeaconConfig().SlotsPerEpoch
@@ -59,14 +89,17 @@ func (as *AttesterServer) SubmitAttestation(ctx context.Context, att *ethpb.Atte
 		}
 	}
 
-	if err := as.p2p.Broadcast(ctx, att); err != nil {
-		return nil, err
-	}
+	poolDuration := time.Since(poolStart)
 
-	hash, err := hashutil.HashProto(att)
-	if err != nil {
+	broadcastStart := time.Now()
+	if err := as.p2p.Broadcast(ctx, att); err != nil {
 		return nil, err
 	}
+	log.WithFields(logrus.Fields{
+		"dutyID":            dutyID,
+		"poolDuration":      poolDuration,
+		"broadcastDuration": time.Since(broadcastStart),
+	}).Debug("Attestation submitted to pool and broadcast")
 
 	return &pb.AttestResponse{Root: hash[:]}, nil
 }
@@ -74,6 +107,23 @@ func (as *AttesterServer) SubmitAttestation(ctx context.Context, att *ethpb.Atte
 // RequestAttestation requests that the beacon node produce an IndexedAttestation,
 // with a blank signature field, which the validator will then sign.
 func (as *AttesterServer) RequestAttestation(ctx context.Context, req *pb.AttestationRequest) (*ethpb.AttestationData, error) {
+	// Requests carrying a committee are validated against it, and the shard attested to
+	// is the one assigned to that committee. The head state advanced to the slot, usually
+	// prefetched, is reused to build the attestation data on a cache miss.
+	var headState *pbp2p.BeaconState
+	if req.CommitteeLength != 0 {
+		var err error
+		headState, err = as.advancedHeadState(ctx, req.Slot)
+		if err != nil {
+			return nil, err
+		}
+		shard, err := as.committeeShard(ctx, req, headState)
+		if err != nil {
+			return nil, err
+		}
+		req.Shard = shard
+	}
+
 	res, err := as.cache.Get(ctx, req)
 	if err != nil {
 		return nil, err
@@ -115,14 +165,11 @@ func (as *AttesterServer) RequestAttestation(ctx context.Context, req *pb.Attest
 	}
 
 	// Let head state be the state of head block processed through empty slots up to assigned slot.
-	headState, err := as.beaconDB.HeadState(ctx)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not fetch head state")
-	}
-
-	headState, err = state.ProcessSlots(ctx, headState, req.Slot)
-	if err != nil {
-		return nil, errors.Wrapf(err, "could not process slots up to %d", req.Slot)
+	if headState == nil {
+		headState, err = as.advancedHeadState(ctx, req.Slot)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	targetEpoch := helpers.CurrentEpoch(headState)
@@ -169,3 +216,59 @@ func (as *AttesterServer) RequestAttestation(ctx context.Context, req *pb.Attest
 
 	return res, nil
 }
+
+// advancedHeadState returns the head state processed through empty slots up to the slot.
+func (as *AttesterServer) advancedHeadState(ctx context.Context, slot uint64) (*pbp2p.BeaconState, error) {
+	headState, err := as.prefetcher.advancedHeadState(ctx, as.beaconDB, slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch head state")
+	}
+	if headState.Slot > slot {
+		return nil, fmt.Errorf("could not process slots up to %d: head state is at slot %d", slot, headState.Slot)
+	}
+	return headState, nil
+}
+
+// committeeShard checks that the committee index and length of the request match a
+// committee assigned to the requested slot, and that the requesting validator belongs
+// to it. It returns the shard of the committee. The given state must be in the epoch of
+// the requested slot.
+func (as *AttesterServer) committeeShard(ctx context.Context, req *pb.AttestationRequest, headState *pbp2p.BeaconState) (uint64, error) {
+	epoch := helpers.SlotToEpoch(req.Slot)
+	committeeCount, err := helpers.CommitteeCount(headState, epoch)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get committee count")
+	}
+	committeesPerSlot := committeeCount / params.BeaconConfig().SlotsPerEpoch
+	firstIndex := committeesPerSlot * (req.Slot % params.BeaconConfig().SlotsPerEpoch)
+	if req.CommitteeIndex < firstIndex || req.CommitteeIndex >= firstIndex+committeesPerSlot {
+		return 0, status.Errorf(codes.InvalidArgument, "%s: committee %d is not assigned to slot %d",
+			reasonInvalidCommittee, req.CommitteeIndex, req.Slot)
+	}
+
+	shard, err := helpers.CommitteeShard(headState, epoch, req.CommitteeIndex)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get committee shard")
+	}
+	committee, err := helpers.CrosslinkCommittee(headState, epoch, shard)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not get crosslink committee for shard %d", shard)
+	}
+	if uint64(len(committee)) != req.CommitteeLength {
+		return 0, status.Errorf(codes.InvalidArgument, "%s: committee %d has %d members, request has %d",
+			reasonInvalidCommittee, req.CommitteeIndex, len(committee), req.CommitteeLength)
+	}
+
+	validatorIndex, ok, err := as.beaconDB.ValidatorIndex(ctx, bytesutil.ToBytes48(req.PublicKey))
+	if err != nil || !ok {
+		return 0, status.Errorf(codes.InvalidArgument, "%s: unknown validator %#x",
+			reasonInvalidCommittee, bytesutil.Trunc(req.PublicKey))
+	}
+	for _, index := range committee {
+		if index == validatorIndex {
+			return shard, nil
+		}
+	}
+	return 0, status.Errorf(codes.InvalidArgument, "%s: validator %d is not a member of committee %d at slot %d",
+		reasonInvalidCommittee, validatorIndex, req.CommitteeIndex, req.Slot)
+}
@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"sync"
 	"testing"
@@ -8,14 +9,20 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	blk "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	db2 "github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type mockBroadcaster struct{}
@@ -83,6 +90,40 @@ func TestSubmitAttestation_OK(t *testing.T) {
 	}
 }
 
+func TestSubmitAttestation_SkipsRecentlyProcessed(t *testing.T) {
+	recentlyProcessed := cache.NewRecentlyProcessedCache()
+	attesterServer := &AttesterServer{
+		operationService:  &mockOperationService{},
+		p2p:               &mockBroadcaster{},
+		cache:             cache.NewAttestationCache(),
+		recentlyProcessed: recentlyProcessed,
+	}
+	att := &ethpb.Attestation{
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: []byte{'a'},
+			Source:          &ethpb.Checkpoint{},
+			Target:          &ethpb.Checkpoint{},
+		},
+	}
+	hash, err := hashutil.HashProto(att)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The attestation was already received by gossip, so it must not be looked up in the
+	// database, which is not set, or broadcast again.
+	if _, err := recentlyProcessed.MarkProcessed(hash, cache.KindAttestation, cache.SourceGossip); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := attesterServer.SubmitAttestation(context.Background(), att)
+	if err != nil {
+		t.Fatalf("Could not submit attestation: %v", err)
+	}
+	if !bytes.Equal(res.Root, hash[:]) {
+		t.Errorf("Wanted root %#x, got %#x", hash, res.Root)
+	}
+}
+
 func TestRequestAttestation_OK(t *testing.T) {
 	db := internal.SetupDBDeprecated(t)
 	defer internal.TeardownDBDeprecated(t, db)
@@ -189,6 +230,88 @@ func TestRequestAttestation_OK(t *testing.T) {
 	}
 }
 
+func TestRequestAttestation_ValidatesCommittee(t *testing.T) {
+	helpers.ClearAllCaches()
+
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	genesis := blk.NewGenesisBlock([]byte{})
+	if err := db.SaveBlockDeprecated(genesis); err != nil {
+		t.Fatalf("Could not save genesis block: %v", err)
+	}
+	deposits, _ := testutil.SetupInitialDeposits(t, params.BeaconConfig().MinGenesisActiveValidatorCount/16)
+	beaconState, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	if err := db.UpdateChainHead(ctx, genesis, beaconState); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+
+	vs := &ValidatorServer{beaconDB: db}
+	res, err := vs.CommitteeAssignment(ctx, &pb.AssignmentRequest{
+		PublicKeys: [][]byte{deposits[0].Data.PublicKey},
+		EpochStart: 0,
+	})
+	if err != nil {
+		t.Fatalf("Could not call epoch committee assignment %v", err)
+	}
+	assignment := res.ValidatorAssignment[0]
+
+	attesterServer := &AttesterServer{
+		beaconDB: db,
+		p2p:      &mockBroadcaster{},
+		cache:    cache.NewAttestationCache(),
+	}
+	req := &pb.AttestationRequest{
+		PublicKey:       deposits[0].Data.PublicKey,
+		Slot:            assignment.Slot,
+		CommitteeIndex:  assignment.CommitteeIndex,
+		CommitteeLength: uint64(len(assignment.Committee)),
+	}
+	headState, err := attesterServer.advancedHeadState(ctx, req.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shard, err := attesterServer.committeeShard(ctx, req, headState)
+	if err != nil {
+		t.Fatalf("Could not validate committee: %v", err)
+	}
+	if shard != assignment.Shard {
+		t.Errorf("Wanted shard %d, got %d", assignment.Shard, shard)
+	}
+
+	wrongLength := proto.Clone(req).(*pb.AttestationRequest)
+	wrongLength.CommitteeLength++
+	if _, err := attesterServer.committeeShard(ctx, wrongLength, headState); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Wanted %v for a wrong committee length, got %v", codes.InvalidArgument, err)
+	}
+
+	wrongSlot := proto.Clone(req).(*pb.AttestationRequest)
+	wrongSlot.Slot++
+	if _, err := attesterServer.committeeShard(ctx, wrongSlot, headState); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Wanted %v for a committee of another slot, got %v", codes.InvalidArgument, err)
+	}
+
+	members := make(map[uint64]bool)
+	for _, index := range assignment.Committee {
+		members[index] = true
+	}
+	for i, deposit := range deposits {
+		if members[uint64(i)] {
+			continue
+		}
+		notMember := proto.Clone(req).(*pb.AttestationRequest)
+		notMember.PublicKey = deposit.Data.PublicKey
+		if _, err := attesterServer.committeeShard(ctx, notMember, headState); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Wanted %v for a validator outside the committee, got %v", codes.InvalidArgument, err)
+		}
+		break
+	}
+}
+
 func TestAttestationDataAtSlot_handlesFarAwayJustifiedEpoch(t *testing.T) {
 	// Scenario:
 	//
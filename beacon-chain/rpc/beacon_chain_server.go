@@ -3,13 +3,16 @@ package rpc
 import (
 	"context"
 	"sort"
+	"time"
 
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/depositmonitor"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/pagination"
@@ -22,8 +25,9 @@ import (
 // providing RPC endpoints to access data relevant to the Ethereum 2.0 phase 0
 // beacon chain.
 type BeaconChainServer struct {
-	beaconDB db.Database
-	pool     operations.Pool
+	beaconDB       db.Database
+	pool           operations.Pool
+	depositMonitor *depositmonitor.Service
 }
 
 // sortableAttestations implements the Sort interface to sort attestations
@@ -92,6 +96,9 @@ func (bs *BeaconChainServer) ListAttestations(
 func (bs *BeaconChainServer) AttestationPool(
 	ctx context.Context, _ *ptypes.Empty,
 ) (*ethpb.AttestationPoolResponse, error) {
+	if bs.pool == nil {
+		return nil, status.Error(codes.Unimplemented, "the attestation pool is not available on this node")
+	}
 	headBlock, err := bs.beaconDB.(*db.BeaconDB).ChainHead()
 	if err != nil {
 		return nil, err
@@ -328,9 +335,7 @@ func (bs *BeaconChainServer) GetValidatorQueue(
 
 // ListValidatorAssignments retrieves the validator assignments for a given epoch,
 // optional validator indices or public keys may be included to filter validator assignments.
-//
-// TODO(#3045): Implement validator set for a specific epoch. Current implementation returns latest set,
-// this is blocked by DB refactor.
+// Assignments of past epochs are computed from the archived historical states.
 func (bs *BeaconChainServer) ListValidatorAssignments(
 	ctx context.Context, req *ethpb.ListValidatorAssignmentsRequest,
 ) (*ethpb.ValidatorAssignments, error) {
@@ -340,9 +345,9 @@ func (bs *BeaconChainServer) ListValidatorAssignments(
 	}
 
 	e := req.Epoch
-	s, err := bs.beaconDB.HeadState(ctx)
+	s, err := bs.committeesState(ctx, e)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "could not retrieve current state: %v", err)
+		return nil, err
 	}
 
 	var res []*ethpb.ValidatorAssignments_CommitteeAssignment
@@ -447,6 +452,130 @@ func (bs *BeaconChainServer) ListValidatorAssignments(
 	}, nil
 }
 
+// ListCommittees retrieves the crosslink committees of a given epoch, sorted by slot. Committees of
+// past epochs are computed from the archived historical states.
+func (bs *BeaconChainServer) ListCommittees(
+	ctx context.Context, req *ethpb.ListCommitteesRequest,
+) (*ethpb.Committees, error) {
+	s, err := bs.committeesState(ctx, req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	committeeCount, err := helpers.CommitteeCount(s, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve committee count: %v", err)
+	}
+	startShard, err := helpers.StartShard(s, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve start shard: %v", err)
+	}
+	committeesPerSlot := committeeCount / params.BeaconConfig().SlotsPerEpoch
+	startSlot := helpers.StartSlot(req.Epoch)
+
+	committees := make([]*ethpb.Committees_Committee, 0, committeeCount)
+	for i := uint64(0); i < committeeCount; i++ {
+		shard := (startShard + i) % params.BeaconConfig().ShardCount
+		committee, err := helpers.CrosslinkCommittee(s, req.Epoch, shard)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve committee of shard %d: %v", shard, err)
+		}
+		committees = append(committees, &ethpb.Committees_Committee{
+			Slot:             startSlot + i/committeesPerSlot,
+			Shard:            shard,
+			ValidatorIndices: committee,
+		})
+	}
+
+	return &ethpb.Committees{
+		Epoch:      req.Epoch,
+		Committees: committees,
+	}, nil
+}
+
+// committeesState returns a state from which the committees of the given epoch can be computed: the
+// head state for the current and next epochs, and the earliest historical state following a past
+// epoch, so that validator balances and registry changes since then affect the proposers as little
+// as possible.
+func (bs *BeaconChainServer) committeesState(ctx context.Context, epoch uint64) (*pbp2p.BeaconState, error) {
+	headState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve current state: %v", err)
+	}
+	if epoch > helpers.NextEpoch(headState) {
+		return nil, status.Errorf(codes.InvalidArgument, "epoch %d can't be greater than next epoch %d",
+			epoch, helpers.NextEpoch(headState))
+	}
+	if epoch >= helpers.CurrentEpoch(headState) {
+		return headState, nil
+	}
+
+	beaconDB, ok := bs.beaconDB.(*db.BeaconDB)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "committees of past epochs are only available with the deprecated database")
+	}
+	s, err := beaconDB.HistoricalStateForEpoch(ctx, epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve historical state: %v", err)
+	}
+	if s == nil {
+		s = headState
+	}
+	// The randao mixes and active index roots committees are shuffled with are overwritten after
+	// EPOCHS_PER_HISTORICAL_VECTOR epochs.
+	maxLag := params.BeaconConfig().EpochsPerHistoricalVector - params.BeaconConfig().ActivationExitDelay - 1
+	if helpers.CurrentEpoch(s) > epoch+maxLag {
+		return nil, status.Errorf(codes.NotFound, "no historical state archived within %d epochs after epoch %d",
+			maxLag, epoch)
+	}
+	return s, nil
+}
+
+// GetChainStats retrieves the cumulative statistics of the chain processed over the lifetime of
+// the node.
+func (bs *BeaconChainServer) GetChainStats(ctx context.Context, _ *ptypes.Empty) (*ethpb.ChainStats, error) {
+	beaconDB, ok := bs.beaconDB.(*db.BeaconDB)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "chain statistics are only recorded by the deprecated database")
+	}
+	stats := make(map[db.ChainStat]uint64, len(db.ChainStats))
+	for _, stat := range db.ChainStats {
+		count, err := beaconDB.ChainStat(ctx, stat)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve chain statistic %s: %v", stat, err)
+		}
+		stats[stat] = count
+	}
+	return &ethpb.ChainStats{
+		BlocksProcessed: stats[db.BlocksProcessedStat],
+		Reorgs:          stats[db.ReorgsStat],
+		InvalidBlocks:   stats[db.InvalidBlocksStat],
+	}, nil
+}
+
+// ListStuckDeposits retrieves the deposits seen in the eth1 deposit contract and not included in
+// the beacon chain within the expected inclusion window.
+func (bs *BeaconChainServer) ListStuckDeposits(ctx context.Context, _ *ptypes.Empty) (*ethpb.StuckDeposits, error) {
+	if bs.depositMonitor == nil {
+		return nil, status.Error(codes.Unimplemented, "deposit inclusion is not monitored, run the node with --monitor-deposits")
+	}
+	stuck, included := bs.depositMonitor.StuckDeposits()
+	res := &ethpb.StuckDeposits{
+		Eth1DepositIndex:       included,
+		InclusionWindowSeconds: uint64(bs.depositMonitor.InclusionWindow().Seconds()),
+		Deposits:               make([]*ethpb.StuckDeposits_Deposit, len(stuck)),
+	}
+	for i, d := range stuck {
+		res.Deposits[i] = &ethpb.StuckDeposits_Deposit{
+			Index:           d.Index,
+			PublicKey:       d.PublicKey,
+			Eth1BlockNumber: d.Eth1Block,
+			PendingSeconds:  uint64(time.Since(d.Seen).Seconds()),
+		}
+	}
+	return res, nil
+}
+
 // GetValidatorParticipation retrieves the validator participation information for a given epoch,
 // it returns the information about validator's participation rate
 //
@@ -486,3 +615,48 @@ func (bs *BeaconChainServer) GetValidatorParticipation(
 		EligibleEther:           totalBalances,
 	}, nil
 }
+
+// GetValidatorBalanceHistory retrieves a validator's balance at the end of each epoch of a range,
+// from the historical states saved in the DB. An end epoch of zero stands for the current epoch.
+// Ranges with more balances than the requested maximum number of points are downsampled.
+func (bs *BeaconChainServer) GetValidatorBalanceHistory(
+	ctx context.Context, req *ethpb.ValidatorBalanceHistoryRequest,
+) (*ethpb.ValidatorBalanceHistory, error) {
+	beaconDB, ok := bs.beaconDB.(*db.BeaconDB)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "balance history is only available with the deprecated database")
+	}
+
+	index := req.Index
+	if len(req.PublicKey) > 0 {
+		var err error
+		index, err = beaconDB.ValidatorIndexDeprecated(req.PublicKey)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "could not retrieve validator index: %v", err)
+		}
+	}
+
+	endEpoch := req.EndEpoch
+	if endEpoch == 0 {
+		s, err := bs.beaconDB.HeadState(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve current state: %v", err)
+		}
+		endEpoch = helpers.SlotToEpoch(s.Slot)
+	}
+	if req.StartEpoch > endEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "start epoch %d is after end epoch %d",
+			req.StartEpoch, endEpoch)
+	}
+
+	epochs, balances, err := beaconDB.ValidatorBalanceHistory(ctx, index, req.StartEpoch, endEpoch, int(req.MaxPoints))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve balance history: %v", err)
+	}
+
+	return &ethpb.ValidatorBalanceHistory{
+		Index:    index,
+		Epochs:   epochs,
+		Balances: balances,
+	}, nil
+}
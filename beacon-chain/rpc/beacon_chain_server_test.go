@@ -13,11 +13,16 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	db2 "github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbt "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type mockPool struct{}
@@ -1051,3 +1056,213 @@ func TestBeaconChainServer_ListBlocksErrors(t *testing.T) {
 
 	}
 }
+
+func TestBeaconChainServer_GetValidatorBalanceHistory(t *testing.T) {
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	pubKey := []byte{'A'}
+	if err := db.SaveValidatorIndexDeprecated(pubKey, 0); err != nil {
+		t.Fatal(err)
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	var s *pbp2p.BeaconState
+	for e := uint64(0); e < 10; e++ {
+		s = &pbp2p.BeaconState{Slot: e * slotsPerEpoch, Balances: []uint64{e * 10}}
+		if err := db.SaveHistoricalState(ctx, s, [32]byte{byte(e)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.SaveStateDeprecated(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconChainServer{
+		beaconDB: db,
+	}
+
+	tests := []struct {
+		req  *ethpb.ValidatorBalanceHistoryRequest
+		want *ethpb.ValidatorBalanceHistory
+	}{
+		{
+			req: &ethpb.ValidatorBalanceHistoryRequest{StartEpoch: 2, EndEpoch: 4},
+			want: &ethpb.ValidatorBalanceHistory{
+				Epochs:   []uint64{2, 3, 4},
+				Balances: []uint64{20, 30, 40},
+			},
+		},
+		{
+			req: &ethpb.ValidatorBalanceHistoryRequest{PublicKey: pubKey, MaxPoints: 4},
+			want: &ethpb.ValidatorBalanceHistory{
+				Epochs:   []uint64{0, 3, 6, 9},
+				Balances: []uint64{0, 30, 60, 90},
+			},
+		},
+		{
+			req: &ethpb.ValidatorBalanceHistoryRequest{StartEpoch: 5, MaxPoints: 1},
+			want: &ethpb.ValidatorBalanceHistory{
+				Epochs:   []uint64{9},
+				Balances: []uint64{90},
+			},
+		},
+	}
+	for _, tt := range tests {
+		res, err := bs.GetValidatorBalanceHistory(ctx, tt.req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(res, tt.want) {
+			t.Errorf("Wanted %v, got %v", tt.want, res)
+		}
+	}
+
+	req := &ethpb.ValidatorBalanceHistoryRequest{StartEpoch: 5, EndEpoch: 4}
+	wanted := "start epoch 5 is after end epoch 4"
+	if _, err := bs.GetValidatorBalanceHistory(ctx, req); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %v, received %v", wanted, err)
+	}
+}
+
+func TestBeaconChainServer_GetValidatorBalanceHistoryUnimplemented(t *testing.T) {
+	db := dbt.SetupDB(t)
+	defer dbt.TeardownDB(t, db)
+
+	bs := &BeaconChainServer{
+		beaconDB: db,
+	}
+	_, err := bs.GetValidatorBalanceHistory(context.Background(), &ethpb.ValidatorBalanceHistoryRequest{})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("Wanted code %v, got %v", codes.Unimplemented, err)
+	}
+}
+
+// saveCanonicalHistoricalState saves a historical state along with a main chain block at its slot,
+// since only the states of the main chain are looked up.
+func saveCanonicalHistoricalState(t *testing.T, beaconDB *db2.BeaconDB, s *pbp2p.BeaconState) {
+	ctx := context.Background()
+	blk := &ethpb.BeaconBlock{Slot: s.Slot, Body: &ethpb.BeaconBlockBody{}}
+	if err := beaconDB.SaveBlock(ctx, blk); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.UpdateChainHead(ctx, blk, s); err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.SigningRoot(blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveHistoricalState(ctx, s, root); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBeaconChainServer_ListCommitteesFromArchivedState(t *testing.T) {
+	helpers.ClearAllCaches()
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 64)
+	archived, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	saveCanonicalHistoricalState(t, db, archived)
+	// The randao mixes of the head state no longer hold the ones the committees of the first
+	// epochs were shuffled with.
+	headState := proto.Clone(archived).(*pbp2p.BeaconState)
+	headState.Slot = 3 * params.BeaconConfig().SlotsPerEpoch
+	for i := range headState.RandaoMixes {
+		headState.RandaoMixes[i] = []byte{'h', 'e', 'a', 'd'}
+	}
+	if err := db.SaveStateDeprecated(ctx, headState); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconChainServer{beaconDB: db}
+	res, err := bs.ListCommittees(ctx, &ethpb.ListCommitteesRequest{Epoch: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Committees) == 0 {
+		t.Fatal("Expected committees")
+	}
+	startSlot := helpers.StartSlot(1)
+	members := 0
+	for _, c := range res.Committees {
+		want, err := helpers.CrosslinkCommittee(archived, 1, c.Shard)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(c.ValidatorIndices, want) {
+			t.Errorf("Wanted committee %v for shard %d, got %v", want, c.Shard, c.ValidatorIndices)
+		}
+		if c.Slot < startSlot || c.Slot >= startSlot+params.BeaconConfig().SlotsPerEpoch {
+			t.Errorf("Committee slot %d is not in epoch 1", c.Slot)
+		}
+		members += len(c.ValidatorIndices)
+	}
+	if members != len(archived.Validators) {
+		t.Errorf("Wanted %d validators in committees, got %d", len(archived.Validators), members)
+	}
+
+	wanted := "can't be greater than next epoch"
+	if _, err := bs.ListCommittees(ctx, &ethpb.ListCommitteesRequest{Epoch: 5}); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %v, received %v", wanted, err)
+	}
+}
+
+func TestBeaconChainServer_ListCommitteesOfPastEpochUnimplemented(t *testing.T) {
+	db := dbt.SetupDB(t)
+	defer dbt.TeardownDB(t, db)
+	ctx := context.Background()
+
+	headRoot := [32]byte{'a'}
+	headState := &pbp2p.BeaconState{Slot: 3 * params.BeaconConfig().SlotsPerEpoch}
+	if err := db.SaveState(ctx, headState, headRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveHeadBlockRoot(ctx, headRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconChainServer{beaconDB: db}
+	_, err := bs.ListCommittees(ctx, &ethpb.ListCommitteesRequest{Epoch: 1})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("Wanted code %v, got %v", codes.Unimplemented, err)
+	}
+}
+
+func TestBeaconChainServer_GetChainStats(t *testing.T) {
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	bs := &BeaconChainServer{beaconDB: db}
+	for i := 0; i < 3; i++ {
+		if _, err := db.IncrementChainStat(ctx, db2.BlocksProcessedStat); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := db.IncrementChainStat(ctx, db2.ReorgsStat); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := bs.GetChainStats(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &ethpb.ChainStats{BlocksProcessed: 3, Reorgs: 1}
+	if !proto.Equal(res, want) {
+		t.Errorf("Wanted %v, received %v", want, res)
+	}
+}
+
+func TestBeaconChainServer_ListStuckDepositsUnmonitored(t *testing.T) {
+	bs := &BeaconChainServer{}
+	if _, err := bs.ListStuckDeposits(context.Background(), &ptypes.Empty{}); err == nil {
+		t.Error("Expected an error when deposit inclusion is not monitored")
+	}
+}
@@ -12,6 +12,7 @@ import (
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -30,6 +31,7 @@ type BeaconServer struct {
 	incomingAttestation chan *ethpb.Attestation
 	canonicalStateChan  chan *pbp2p.BeaconState
 	chainStartChan      chan time.Time
+	replicationEnabled  bool
 }
 
 // WaitForChainStart queries the logs of the Deposit Contract in order to verify the beacon chain
@@ -68,6 +70,19 @@ func (bs *BeaconServer) WaitForChainStart(req *ptypes.Empty, stream pb.BeaconSer
 	}
 }
 
+// ChainStartStatus reports the progress of the deposit contract towards ChainStart, so that
+// validator clients and dashboards can count down to genesis while the node waits for it.
+func (bs *BeaconServer) ChainStartStatus(ctx context.Context, _ *ptypes.Empty) (*pb.ChainStartStatusResponse, error) {
+	return &pb.ChainStartStatusResponse{
+		Started:                        bs.powChainService.HasChainStarted(),
+		GenesisTime:                    bs.powChainService.EstimatedGenesisTime(),
+		DepositCount:                   uint64(len(bs.powChainService.ChainStartDeposits())),
+		ActiveValidatorCount:           bs.powChainService.ActiveValidatorCount(),
+		MinGenesisActiveValidatorCount: params.BeaconConfig().MinGenesisActiveValidatorCount,
+		MinGenesisTime:                 params.BeaconConfig().MinGenesisTime,
+	}, nil
+}
+
 // CanonicalHead of the current beacon chain. This method is requested on-demand
 // by a validator when it is their time to propose or attest.
 func (bs *BeaconServer) CanonicalHead(ctx context.Context, req *ptypes.Empty) (*ethpb.BeaconBlock, error) {
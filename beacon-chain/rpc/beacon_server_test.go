@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
 	"github.com/prysmaticlabs/go-ssz"
@@ -90,6 +91,14 @@ func (f *faultyPOWChainService) ChainStartETH1Data() *ethpb.Eth1Data {
 	return &ethpb.Eth1Data{}
 }
 
+func (f *faultyPOWChainService) ActiveValidatorCount() uint64 {
+	return 0
+}
+
+func (f *faultyPOWChainService) EstimatedGenesisTime() uint64 {
+	return 0
+}
+
 type mockPOWChainService struct {
 	chainStartFeed      *event.Feed
 	latestBlockNumber   *big.Int
@@ -171,6 +180,14 @@ func (m *mockPOWChainService) ChainStartETH1Data() *ethpb.Eth1Data {
 	return m.eth1Data
 }
 
+func (m *mockPOWChainService) ActiveValidatorCount() uint64 {
+	return 0
+}
+
+func (m *mockPOWChainService) EstimatedGenesisTime() uint64 {
+	return uint64(time.Unix(0, 0).Unix())
+}
+
 func TestWaitForChainStart_ContextClosed(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	beaconServer := &BeaconServer{
@@ -247,6 +264,26 @@ func TestWaitForChainStart_NotStartedThenLogFired(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Sending ChainStart log and genesis time to connected validator clients")
 }
 
+func TestChainStartStatus_NotStarted(t *testing.T) {
+	beaconServer := &BeaconServer{
+		ctx: context.Background(),
+		powChainService: &faultyPOWChainService{
+			chainStartFeed: new(event.Feed),
+		},
+	}
+	res, err := beaconServer.ChainStartStatus(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Could not call RPC method: %v", err)
+	}
+	want := &pb.ChainStartStatusResponse{
+		MinGenesisActiveValidatorCount: params.BeaconConfig().MinGenesisActiveValidatorCount,
+		MinGenesisTime:                 params.BeaconConfig().MinGenesisTime,
+	}
+	if !proto.Equal(res, want) {
+		t.Errorf("Wanted %v, got %v", want, res)
+	}
+}
+
 func TestBlockTree_OK(t *testing.T) {
 	t.Skip() // TODO(3219): Add after new fork choice service.
 	db := internal.SetupDBDeprecated(t)
@@ -0,0 +1,98 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamCanonicalBlocks streams a summary of the canonical blocks of the requested range of
+// slots, in slot order. The proposers of an epoch are computed from the earliest historical state
+// saved in the epoch, and left unknown if no state of the epoch is saved.
+func (bs *BeaconChainServer) StreamCanonicalBlocks(req *ethpb.CanonicalBlocksRequest, stream ethpb.BeaconChain_StreamCanonicalBlocksServer) error {
+	ctx := stream.Context()
+	if req.EndSlot < req.StartSlot {
+		return status.Errorf(codes.InvalidArgument, "end slot %d is before start slot %d", req.EndSlot, req.StartSlot)
+	}
+	// TODO(3045): Use the db.Database interface only.
+	beaconDB, ok := bs.beaconDB.(*db.BeaconDB)
+	if !ok {
+		return status.Error(codes.Unimplemented, "canonical blocks are only indexed by slot in the legacy database")
+	}
+	endSlot := req.EndSlot
+	if head := beaconDB.HighestBlockSlot(); endSlot > head {
+		endSlot = head
+	}
+
+	proposers := make(map[uint64][]uint64)
+	for slot := req.StartSlot; slot <= endSlot; slot++ {
+		if ctx.Err() != nil {
+			return status.Error(codes.Canceled, "stream context closed")
+		}
+		blk, err := beaconDB.CanonicalBlockBySlot(ctx, slot)
+		if err != nil {
+			return status.Errorf(codes.Internal, "could not retrieve block at slot %d: %v", slot, err)
+		}
+		if blk == nil {
+			continue
+		}
+		root, err := ssz.SigningRoot(blk)
+		if err != nil {
+			return status.Errorf(codes.Internal, "could not hash block at slot %d: %v", slot, err)
+		}
+		record := &ethpb.CanonicalBlockRecord{
+			Slot:       blk.Slot,
+			Root:       root[:],
+			ParentRoot: blk.ParentRoot,
+		}
+		if blk.Body != nil {
+			record.AttestationCount = uint64(len(blk.Body.Attestations))
+			record.DepositCount = uint64(len(blk.Body.Deposits))
+			record.Graffiti = blk.Body.Graffiti
+		}
+
+		epoch := helpers.SlotToEpoch(blk.Slot)
+		slotProposers, ok := proposers[epoch]
+		if !ok {
+			slotProposers, err = epochProposers(ctx, beaconDB, epoch)
+			if err != nil {
+				return err
+			}
+			proposers[epoch] = slotProposers
+		}
+		if slotProposers != nil {
+			record.ProposerIndex = slotProposers[blk.Slot-helpers.StartSlot(epoch)]
+			record.ProposerKnown = true
+		}
+
+		if err := stream.Send(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// epochProposers returns the proposer indices of the slots of an epoch, or nil if no state of
+// the epoch is saved to compute them. The proposers depend on the effective balances at the
+// epoch, which a state of the epoch before doesn't hold yet.
+func epochProposers(ctx context.Context, beaconDB *db.BeaconDB, epoch uint64) ([]uint64, error) {
+	// The earliest state whose committees are those of the next epoch is the earliest state
+	// of the epoch.
+	s, err := beaconDB.HistoricalStateForEpoch(ctx, epoch+1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve historical state: %v", err)
+	}
+	if s == nil || helpers.CurrentEpoch(s) != epoch {
+		return nil, nil
+	}
+	indices, err := helpers.ProposerIndices(s, epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not compute proposers of epoch %d: %v", epoch, err)
+	}
+	return indices, nil
+}
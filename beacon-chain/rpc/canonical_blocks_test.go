@@ -0,0 +1,117 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc"
+)
+
+type mockCanonicalBlocksStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*ethpb.CanonicalBlockRecord
+}
+
+func (m *mockCanonicalBlocksStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockCanonicalBlocksStream) Send(record *ethpb.CanonicalBlockRecord) error {
+	m.sent = append(m.sent, record)
+	return nil
+}
+
+func TestStreamCanonicalBlocks(t *testing.T) {
+	helpers.ClearAllCaches()
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	deposits, _ := testutil.SetupInitialDeposits(t, params.BeaconConfig().MinGenesisActiveValidatorCount/16)
+	genesisState, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	saveCanonicalHistoricalState(t, db, genesisState)
+	// The proposers of an epoch are not computed from a state of the epoch before.
+	archivedSlot := 2 * params.BeaconConfig().SlotsPerEpoch
+	archived := proto.Clone(genesisState).(*pbp2p.BeaconState)
+	archived.Slot = archivedSlot
+	saveCanonicalHistoricalState(t, db, archived)
+	lateSlot := 3 * params.BeaconConfig().SlotsPerEpoch
+	blocks := []*ethpb.BeaconBlock{
+		{
+			Slot:       1,
+			ParentRoot: []byte{'p'},
+			Body: &ethpb.BeaconBlockBody{
+				Attestations: []*ethpb.Attestation{{}, {}},
+				Deposits:     []*ethpb.Deposit{{}},
+				Graffiti:     []byte{'g'},
+			},
+		},
+		{Slot: 3, Body: &ethpb.BeaconBlockBody{}},
+		{Slot: lateSlot, Body: &ethpb.BeaconBlockBody{}},
+	}
+	for _, blk := range blocks {
+		if err := db.SaveBlock(ctx, blk); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateChainHead(ctx, blk, genesisState); err != nil {
+			t.Fatal(err)
+		}
+	}
+	proposers, err := helpers.ProposerIndices(genesisState, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	archivedProposers, err := helpers.ProposerIndices(archived, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconChainServer{beaconDB: db}
+	stream := &mockCanonicalBlocksStream{ctx: ctx}
+	if err := bs.StreamCanonicalBlocks(&ethpb.CanonicalBlocksRequest{StartSlot: 1, EndSlot: lateSlot + 10}, stream); err != nil {
+		t.Fatal(err)
+	}
+	if len(stream.sent) != 4 {
+		t.Fatalf("Wanted 4 records, got %d", len(stream.sent))
+	}
+	root, err := ssz.SigningRoot(blocks[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := stream.sent[0]
+	if first.Slot != 1 || !bytes.Equal(first.Root, root[:]) || !bytes.Equal(first.ParentRoot, []byte{'p'}) {
+		t.Errorf("Wanted block at slot 1 with root %#x, got %v", root, first)
+	}
+	if first.AttestationCount != 2 || first.DepositCount != 1 || !bytes.Equal(first.Graffiti, []byte{'g'}) {
+		t.Errorf("Wanted 2 attestations, 1 deposit and graffiti g, got %v", first)
+	}
+	for i, slot := range []uint64{1, 3} {
+		if record := stream.sent[i]; !record.ProposerKnown || record.ProposerIndex != proposers[slot] {
+			t.Errorf("Wanted proposer %d at slot %d, got %v", proposers[slot], slot, record)
+		}
+	}
+	if record := stream.sent[2]; record.Slot != archivedSlot || !record.ProposerKnown || record.ProposerIndex != archivedProposers[0] {
+		t.Errorf("Wanted proposer %d at slot %d, got %v", archivedProposers[0], archivedSlot, record)
+	}
+	if record := stream.sent[3]; record.Slot != lateSlot || record.ProposerKnown {
+		t.Errorf("Wanted unknown proposer at slot %d, got %v", lateSlot, record)
+	}
+
+	if err := bs.StreamCanonicalBlocks(&ethpb.CanonicalBlocksRequest{StartSlot: 2, EndSlot: 1}, stream); err == nil {
+		t.Error("Expected an error for an end slot before the start slot")
+	}
+}
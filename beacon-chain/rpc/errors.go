@@ -0,0 +1,82 @@
+package rpc
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/forkchoice"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	blockchain "github.com/prysmaticlabs/prysm/beacon-chain/deprecated-blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Reasons prefixed to the status messages returned by the attestation and block submission
+// RPCs. The gRPC code tells validator clients whether a request is worth retrying
+// (NotFound, Unavailable) or not (InvalidArgument, OutOfRange, AlreadyExists) while the
+// reason identifies the exact condition.
+const (
+	reasonUnknownBlockRoot  = "UNKNOWN_BLOCK_ROOT"
+	reasonPastSlot          = "PAST_SLOT"
+	reasonInvalidSignature  = "INVALID_SIGNATURE"
+	reasonAlreadyAggregated = "ALREADY_AGGREGATED"
+	reasonAlreadyIncluded   = "ALREADY_INCLUDED"
+	reasonInvalidBlock      = "INVALID_BLOCK"
+	reasonInvalidCommittee  = "INVALID_COMMITTEE"
+	reasonFutureSlot        = "FUTURE_SLOT"
+	reasonPreFinalized      = "PRE_FINALIZED"
+)
+
+// attestationStatusError maps errors from the operations service to gRPC status errors.
+func attestationStatusError(err error) error {
+	switch errors.Cause(err) {
+	case operations.ErrUnknownBlockRoot:
+		return status.Errorf(codes.NotFound, "%s: %v", reasonUnknownBlockRoot, err)
+	case operations.ErrPastSlot:
+		return status.Errorf(codes.OutOfRange, "%s: %v", reasonPastSlot, err)
+	case operations.ErrInvalidSignature:
+		return status.Errorf(codes.InvalidArgument, "%s: %v", reasonInvalidSignature, err)
+	case operations.ErrAlreadyAggregated:
+		return status.Errorf(codes.AlreadyExists, "%s: %v", reasonAlreadyAggregated, err)
+	case operations.ErrAlreadyIncluded:
+		return status.Errorf(codes.AlreadyExists, "%s: %v", reasonAlreadyIncluded, err)
+	default:
+		return status.Errorf(codes.Internal, "could not handle attestation: %v", err)
+	}
+}
+
+// blockStatusError maps errors from processing a proposed block to gRPC status errors.
+func blockStatusError(err error) error {
+	if rejection := forkchoice.BlockRejection(err); rejection != nil {
+		return blockRejectionStatusError(rejection)
+	}
+	if err == blockchain.ErrParentDoesNotExist {
+		return status.Errorf(codes.NotFound, "%s: %v", reasonUnknownBlockRoot, err)
+	}
+	if _, ok := err.(*blockchain.BlockFailedProcessingErr); ok {
+		if errors.Cause(err) == blocks.ErrSigFailedToVerify {
+			return status.Errorf(codes.InvalidArgument, "%s: %v", reasonInvalidSignature, err)
+		}
+		return status.Errorf(codes.InvalidArgument, "%s: %v", reasonInvalidBlock, err)
+	}
+	return status.Errorf(codes.Internal, "could not process beacon block: %v", err)
+}
+
+// blockRejectionStatusError maps the reasons fork choice rejects a block for to gRPC status
+// errors. Blocks whose slot or parent is yet to come may be proposed again later.
+func blockRejectionStatusError(rejection *forkchoice.BlockRejectedError) error {
+	switch rejection.Reason {
+	case forkchoice.RejectFutureSlot:
+		return status.Errorf(codes.Unavailable, "%s: %v", reasonFutureSlot, rejection)
+	case forkchoice.RejectUnknownParent:
+		return status.Errorf(codes.NotFound, "%s: %v", reasonUnknownBlockRoot, rejection)
+	case forkchoice.RejectPreFinalized:
+		return status.Errorf(codes.OutOfRange, "%s: %v", reasonPreFinalized, rejection)
+	case forkchoice.RejectInvalidStateTransition:
+		if errors.Cause(rejection) == blocks.ErrSigFailedToVerify {
+			return status.Errorf(codes.InvalidArgument, "%s: %v", reasonInvalidSignature, rejection)
+		}
+		return status.Errorf(codes.InvalidArgument, "%s: %v", reasonInvalidBlock, rejection)
+	default:
+		return status.Errorf(codes.Internal, "could not process beacon block: %v", rejection)
+	}
+}
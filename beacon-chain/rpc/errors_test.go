@@ -0,0 +1,79 @@
+package rpc
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/forkchoice"
+	blockchain "github.com/prysmaticlabs/prysm/beacon-chain/deprecated-blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAttestationStatusError(t *testing.T) {
+	tests := []struct {
+		err    error
+		code   codes.Code
+		reason string
+	}{
+		{err: pkgerrors.Wrap(operations.ErrUnknownBlockRoot, "foo"), code: codes.NotFound, reason: reasonUnknownBlockRoot},
+		{err: operations.ErrPastSlot, code: codes.OutOfRange, reason: reasonPastSlot},
+		{err: pkgerrors.Wrap(operations.ErrInvalidSignature, "foo"), code: codes.InvalidArgument, reason: reasonInvalidSignature},
+		{err: operations.ErrAlreadyAggregated, code: codes.AlreadyExists, reason: reasonAlreadyAggregated},
+		{err: operations.ErrAlreadyIncluded, code: codes.AlreadyExists, reason: reasonAlreadyIncluded},
+		{err: errors.New("db failure"), code: codes.Internal},
+	}
+	for _, tt := range tests {
+		st, ok := status.FromError(attestationStatusError(tt.err))
+		if !ok {
+			t.Fatalf("Expected a status error for %v", tt.err)
+		}
+		if st.Code() != tt.code {
+			t.Errorf("Wanted code %v for %v, received %v", tt.code, tt.err, st.Code())
+		}
+		if !strings.HasPrefix(st.Message(), tt.reason) {
+			t.Errorf("Wanted reason %s in message, received %s", tt.reason, st.Message())
+		}
+	}
+}
+
+func TestBlockStatusError(t *testing.T) {
+	st, _ := status.FromError(blockStatusError(blockchain.ErrParentDoesNotExist))
+	if st.Code() != codes.NotFound || !strings.HasPrefix(st.Message(), reasonUnknownBlockRoot) {
+		t.Errorf("Unexpected status for missing parent: %v", st)
+	}
+	st, _ = status.FromError(blockStatusError(&blockchain.BlockFailedProcessingErr{}))
+	if st.Code() != codes.InvalidArgument || !strings.HasPrefix(st.Message(), reasonInvalidBlock) {
+		t.Errorf("Unexpected status for failed block: %v", st)
+	}
+	st, _ = status.FromError(blockStatusError(pkgerrors.New("db failure")))
+	if st.Code() != codes.Internal {
+		t.Errorf("Wanted code %v, received %v", codes.Internal, st.Code())
+	}
+}
+
+func TestBlockStatusError_Rejections(t *testing.T) {
+	tests := []struct {
+		reason     forkchoice.BlockRejectionReason
+		code       codes.Code
+		wantReason string
+	}{
+		{reason: forkchoice.RejectFutureSlot, code: codes.Unavailable, wantReason: reasonFutureSlot},
+		{reason: forkchoice.RejectUnknownParent, code: codes.NotFound, wantReason: reasonUnknownBlockRoot},
+		{reason: forkchoice.RejectPreFinalized, code: codes.OutOfRange, wantReason: reasonPreFinalized},
+		{reason: forkchoice.RejectInvalidStateTransition, code: codes.InvalidArgument, wantReason: reasonInvalidBlock},
+	}
+	for _, tt := range tests {
+		err := pkgerrors.Wrap(&forkchoice.BlockRejectedError{Reason: tt.reason, OperationIndex: -1}, "could not process block")
+		st, ok := status.FromError(blockStatusError(err))
+		if !ok {
+			t.Fatalf("Expected a status error for %s", tt.reason)
+		}
+		if st.Code() != tt.code || !strings.HasPrefix(st.Message(), tt.wantReason) {
+			t.Errorf("Wanted %v %s for %s, received %v %s", tt.code, tt.wantReason, tt.reason, st.Code(), st.Message())
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package rpc
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ForkChoiceAudit returns the latest head changes of the chain service with the fork choice
+// decisions behind them, oldest first, all of the retained ones if the requested count is 0.
+func (bs *BeaconServer) ForkChoiceAudit(ctx context.Context, req *pb.ForkChoiceAuditRequest) (*pb.ForkChoiceAuditResponse, error) {
+	decisions := bs.chainService.ForkChoiceAudit(int(req.Count))
+	if decisions == nil {
+		return nil, status.Error(codes.FailedPrecondition, "fork choice audit is disabled, set --fork-choice-audit-size to enable it")
+	}
+	res := &pb.ForkChoiceAuditResponse{
+		Decisions: make([]*pb.ForkChoiceAuditResponse_Decision, len(decisions)),
+	}
+	for i, d := range decisions {
+		steps := make([]*pb.ForkChoiceAuditResponse_Step, len(d.Steps))
+		for j, step := range d.Steps {
+			candidates := make([]*pb.ForkChoiceAuditResponse_Candidate, len(step.Candidates))
+			for k, c := range step.Candidates {
+				candidates[k] = &pb.ForkChoiceAuditResponse_Candidate{
+					Root:   auditRoot(c.Root),
+					Slot:   c.Slot,
+					Weight: c.Weight,
+				}
+			}
+			steps[j] = &pb.ForkChoiceAuditResponse_Step{
+				Parent:     auditRoot(step.Parent),
+				Candidates: candidates,
+				Chosen:     auditRoot(step.Chosen),
+				TieBreak:   step.TieBreak,
+			}
+		}
+		res.Decisions[i] = &pb.ForkChoiceAuditResponse_Decision{
+			Timestamp:     uint64(d.Time.Unix()),
+			JustifiedRoot: auditRoot(d.JustifiedRoot),
+			JustifiedSlot: d.JustifiedSlot,
+			PreviousHead:  auditRoot(d.PreviousHead),
+			PreviousSlot:  d.PreviousSlot,
+			NewHead:       auditRoot(d.NewHead),
+			NewSlot:       d.NewSlot,
+			Reorg:         d.Reorg,
+			Steps:         steps,
+		}
+	}
+	return res, nil
+}
+
+// auditRoot decodes a block root recorded in the fork choice audit, always formatted by
+// forkchoiceaudit.Root.
+func auditRoot(root string) []byte {
+	b, err := hex.DecodeString(strings.TrimPrefix(root, "0x"))
+	if err != nil {
+		return nil
+	}
+	return b
+}
@@ -0,0 +1,65 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoiceaudit"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestForkChoiceAudit_Disabled(t *testing.T) {
+	bs := &BeaconServer{chainService: newMockChainService()}
+	_, err := bs.ForkChoiceAudit(context.Background(), &pb.ForkChoiceAuditRequest{})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Wanted a failed precondition with the audit log disabled, got %v", err)
+	}
+}
+
+func TestForkChoiceAudit_ReportsDecisions(t *testing.T) {
+	parent, a, b := [32]byte{'p'}, [32]byte{'a'}, [32]byte{'b'}
+	chainService := newMockChainService()
+	chainService.forkChoiceAudit = []*forkchoiceaudit.Decision{{
+		Time:          time.Unix(100, 0),
+		JustifiedRoot: forkchoiceaudit.Root(parent),
+		PreviousHead:  forkchoiceaudit.Root(a),
+		PreviousSlot:  5,
+		NewHead:       forkchoiceaudit.Root(b),
+		NewSlot:       6,
+		Reorg:         true,
+		Steps: []*forkchoiceaudit.Step{forkchoiceaudit.NewStep(parent, []*forkchoiceaudit.Candidate{
+			{Root: forkchoiceaudit.Root(a), Slot: 5, Weight: 3},
+			{Root: forkchoiceaudit.Root(b), Slot: 6, Weight: 4},
+		}, b)},
+	}}
+	bs := &BeaconServer{chainService: chainService}
+
+	res, err := bs.ForkChoiceAudit(context.Background(), &pb.ForkChoiceAuditRequest{Count: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Decisions) != 1 {
+		t.Fatalf("Wanted 1 decision, got %d", len(res.Decisions))
+	}
+	d := res.Decisions[0]
+	if d.Timestamp != 100 || !d.Reorg || d.PreviousSlot != 5 || d.NewSlot != 6 {
+		t.Errorf("Unexpected decision %+v", d)
+	}
+	if !bytes.Equal(d.PreviousHead, a[:]) || !bytes.Equal(d.NewHead, b[:]) || !bytes.Equal(d.JustifiedRoot, parent[:]) {
+		t.Errorf("Wanted the roots of the decision, got %+v", d)
+	}
+	if len(d.Steps) != 1 || len(d.Steps[0].Candidates) != 2 {
+		t.Fatalf("Wanted 1 step with 2 candidates, got %+v", d.Steps)
+	}
+	step := d.Steps[0]
+	if !bytes.Equal(step.Parent, parent[:]) || !bytes.Equal(step.Chosen, b[:]) || step.TieBreak {
+		t.Errorf("Unexpected step %+v", step)
+	}
+	if c := step.Candidates[1]; !bytes.Equal(c.Root, b[:]) || c.Slot != 6 || c.Weight != 4 {
+		t.Errorf("Unexpected candidate %+v", c)
+	}
+}
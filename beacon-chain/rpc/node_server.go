@@ -6,15 +6,31 @@ import (
 	"time"
 
 	ptypes "github.com/gogo/protobuf/types"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// peerEventQueueSize is the number of peer events queued for a client of StreamPeerEvents, past
+// which events are dropped until the client catches up.
+const peerEventQueueSize = 256
+
+var peerEventsDropped = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "rpc_peer_events_dropped",
+	Help: "The number of peer events not streamed to a client which didn't keep up with them",
+})
+
 // NodeServer defines a server implementation of the gRPC Node service,
 // providing RPC endpoints for verifying a beacon node's sync status, genesis and
 // version information, and services the node implements and runs.
@@ -22,6 +38,9 @@ type NodeServer struct {
 	syncChecker sync.Checker
 	server      *grpc.Server
 	beaconDB    db.Database
+	peerEvents  p2p.PeerEventProvider
+	forkMonitor *cache.ForkMonitor
+	peerForks   sync.PeerForkReporter
 }
 
 // GetSyncStatus checks the current network sync status of the node.
@@ -77,3 +96,128 @@ func (ns *NodeServer) ListImplementedServices(ctx context.Context, _ *ptypes.Emp
 		Services: serviceNames,
 	}, nil
 }
+
+// StreamPeerEvents streams the connections, disconnections and completed handshakes of the peers
+// of the node, until the client closes the stream. The peer event feed holds up p2p until every
+// subscriber received an event, so the events are queued for the client, and dropped while the
+// queue is full, rather than sent to it from the feed.
+func (ns *NodeServer) StreamPeerEvents(_ *ptypes.Empty, stream ethpb.Node_StreamPeerEventsServer) error {
+	if ns.peerEvents == nil {
+		return status.Error(codes.Unimplemented, "peer events are not available on this node")
+	}
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	events := make(chan *p2p.PeerEvent, params.BeaconConfig().DefaultBufferSize)
+	sub := ns.peerEvents.PeerEventFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+
+	queue := make(chan *p2p.PeerEvent, peerEventQueueSize)
+	go func() {
+		for {
+			select {
+			case e := <-events:
+				select {
+				case queue <- e:
+				default:
+					peerEventsDropped.Inc()
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case e := <-queue:
+			res, err := peerEventProto(e)
+			if err != nil {
+				return status.Errorf(codes.Internal, "could not convert peer event: %v", err)
+			}
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		case err := <-sub.Err():
+			return status.Errorf(codes.Aborted, "peer event subscription closed: %v", err)
+		case <-ctx.Done():
+			return errors.New("stream context closed, exiting goroutine")
+		}
+	}
+}
+
+// ListCompetingHeads lists the heads peers recently reported or relayed at the slots where more
+// than one head was observed, with the number of peers following each of them.
+func (ns *NodeServer) ListCompetingHeads(ctx context.Context, _ *ptypes.Empty) (*ethpb.CompetingHeads, error) {
+	observed, contestedSlots := ns.forkMonitor.CompetingHeads()
+	heads := make([]*ethpb.CompetingHeads_Head, len(observed))
+	for i, h := range observed {
+		root := h.Root
+		heads[i] = &ethpb.CompetingHeads_Head{
+			Slot:   h.Slot,
+			Root:   root[:],
+			Weight: h.Weight,
+		}
+	}
+	return &ethpb.CompetingHeads{
+		Heads:          heads,
+		ContestedSlots: contestedSlots,
+	}, nil
+}
+
+// ListPeerForks lists the number of connected peers on each fork version and finalized checkpoint
+// they last reported, along with the fork version and finalized checkpoint of the node.
+func (ns *NodeServer) ListPeerForks(ctx context.Context, _ *ptypes.Empty) (*ethpb.PeerForks, error) {
+	if ns.peerForks == nil {
+		return nil, status.Error(codes.Unimplemented, "peer forks are not tracked by the sync service")
+	}
+	headState, err := ns.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve head state: %v", err)
+	}
+	peerForks := ns.peerForks.PeerForks()
+	forks := make([]*ethpb.PeerForks_Fork, len(peerForks))
+	for i, f := range peerForks {
+		forks[i] = &ethpb.PeerForks_Fork{
+			ForkVersion:    f.ForkVersion,
+			FinalizedEpoch: f.FinalizedEpoch,
+			FinalizedRoot:  f.FinalizedRoot,
+			PeerCount:      f.Peers,
+		}
+	}
+	return &ethpb.PeerForks{
+		// The fork version the node sends in its own handshakes.
+		ForkVersion:    params.BeaconConfig().GenesisForkVersion,
+		FinalizedEpoch: headState.FinalizedCheckpoint.Epoch,
+		FinalizedRoot:  headState.FinalizedCheckpoint.Root,
+		Forks:          forks,
+	}, nil
+}
+
+// peerEventProto converts a peer event of the p2p service to its RPC representation.
+func peerEventProto(e *p2p.PeerEvent) (*ethpb.PeerEvent, error) {
+	t, err := ptypes.TimestampProto(e.Time)
+	if err != nil {
+		return nil, err
+	}
+	res := &ethpb.PeerEvent{
+		Type:   string(e.Type),
+		PeerId: e.PeerID.Pretty(),
+		Time:   t,
+	}
+	if e.Address != nil {
+		res.Address = e.Address.String()
+	}
+	switch e.Direction {
+	case network.DirInbound:
+		res.Direction = "inbound"
+	case network.DirOutbound:
+		res.Direction = "outbound"
+	}
+	if e.Hello != nil {
+		res.HeadSlot = e.Hello.HeadSlot
+		res.HeadRoot = e.Hello.HeadRoot
+		res.FinalizedEpoch = e.Hello.FinalizedEpoch
+		res.FinalizedRoot = e.Hello.FinalizedRoot
+	}
+	return res, nil
+}
@@ -9,9 +9,16 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gogo/protobuf/proto"
 	ptypes "github.com/gogo/protobuf/types"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -114,3 +121,180 @@ func TestNodeServer_GetImplementedServices(t *testing.T) {
 		t.Errorf("Expected 2 services, received %d: %v", len(res.Services), res.Services)
 	}
 }
+
+type mockPeerEventProvider struct {
+	feed event.Feed
+}
+
+func (m *mockPeerEventProvider) PeerEventFeed() *event.Feed {
+	return &m.feed
+}
+
+type mockPeerEventStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *ethpb.PeerEvent
+}
+
+func (m *mockPeerEventStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockPeerEventStream) Send(e *ethpb.PeerEvent) error {
+	select {
+	case m.sent <- e:
+		return nil
+	case <-m.ctx.Done():
+		return m.ctx.Err()
+	}
+}
+
+func TestNodeServer_StreamPeerEvents(t *testing.T) {
+	provider := &mockPeerEventProvider{}
+	ns := &NodeServer{
+		peerEvents: provider,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &mockPeerEventStream{ctx: ctx, sent: make(chan *ethpb.PeerEvent, 1)}
+	exitRoutine := make(chan bool)
+	go func() {
+		if err := ns.StreamPeerEvents(&ptypes.Empty{}, stream); err == nil {
+			t.Error("Expected an error when the stream is closed")
+		}
+		exitRoutine <- true
+	}()
+
+	pid := peer.ID("peer")
+	e := &p2p.PeerEvent{
+		Type:      p2p.PeerHandshakeComplete,
+		PeerID:    pid,
+		Direction: network.DirInbound,
+		Hello:     &pb.Hello{HeadSlot: 5, FinalizedEpoch: 1},
+		Time:      time.Unix(10, 0),
+	}
+	// Wait for the server to subscribe to the feed.
+	for provider.feed.Send(e) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	want := &ethpb.PeerEvent{
+		Type:           "handshake_complete",
+		PeerId:         pid.Pretty(),
+		Direction:      "inbound",
+		HeadSlot:       5,
+		FinalizedEpoch: 1,
+		Time:           &ptypes.Timestamp{Seconds: 10},
+	}
+	if res := <-stream.sent; !proto.Equal(res, want) {
+		t.Errorf("Wanted %v, got %v", want, res)
+	}
+	cancel()
+	<-exitRoutine
+}
+
+func TestNodeServer_StreamPeerEventsSlowClient(t *testing.T) {
+	provider := &mockPeerEventProvider{}
+	ns := &NodeServer{
+		peerEvents: provider,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	// The client never reads the events sent to it.
+	stream := &mockPeerEventStream{ctx: ctx, sent: make(chan *ethpb.PeerEvent)}
+	exitRoutine := make(chan bool)
+	go func() {
+		if err := ns.StreamPeerEvents(&ptypes.Empty{}, stream); err == nil {
+			t.Error("Expected an error when the stream is closed")
+		}
+		exitRoutine <- true
+	}()
+
+	e := &p2p.PeerEvent{Type: p2p.PeerConnected, PeerID: peer.ID("peer"), Time: time.Unix(10, 0)}
+	for provider.feed.Send(e) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	sent := make(chan bool)
+	go func() {
+		for i := 0; i < 2*peerEventQueueSize; i++ {
+			provider.feed.Send(e)
+		}
+		sent <- true
+	}()
+	select {
+	case <-sent:
+	case <-time.After(5 * time.Second):
+		t.Error("Peer event feed held up by a slow client")
+	}
+	cancel()
+	<-exitRoutine
+}
+
+func TestNodeServer_ListCompetingHeads(t *testing.T) {
+	monitor := cache.NewForkMonitor(nil)
+	ns := &NodeServer{
+		forkMonitor: monitor,
+	}
+	monitor.ObserveHead("a", 10, [32]byte{'x'})
+	monitor.ObserveHead("b", 10, [32]byte{'y'})
+	monitor.ObserveHead("c", 10, [32]byte{'y'})
+	monitor.ObserveHead("d", 11, [32]byte{'z'})
+
+	res, err := ns.ListCompetingHeads(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootY := [32]byte{'y'}
+	rootX := [32]byte{'x'}
+	want := &ethpb.CompetingHeads{
+		Heads: []*ethpb.CompetingHeads_Head{
+			{Slot: 10, Root: rootY[:], Weight: 2},
+			{Slot: 10, Root: rootX[:], Weight: 1},
+		},
+		ContestedSlots: 1,
+	}
+	if !proto.Equal(res, want) {
+		t.Errorf("Wanted %v, got %v", want, res)
+	}
+}
+
+type mockPeerForkReporter struct {
+	forks []*sync.PeerFork
+}
+
+func (m *mockPeerForkReporter) PeerForks() []*sync.PeerFork {
+	return m.forks
+}
+
+func TestNodeServer_ListPeerForks(t *testing.T) {
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+	if err := db.SaveStateDeprecated(ctx, &pb.BeaconState{
+		FinalizedCheckpoint: &ethpb.Checkpoint{Epoch: 5, Root: []byte{'a'}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	ns := &NodeServer{
+		beaconDB: db,
+		peerForks: &mockPeerForkReporter{forks: []*sync.PeerFork{
+			{ForkVersion: []byte{0, 0, 0, 0}, FinalizedEpoch: 5, FinalizedRoot: []byte{'a'}, Peers: 3},
+			{ForkVersion: []byte{0, 0, 0, 1}, FinalizedEpoch: 4, FinalizedRoot: []byte{'b'}, Peers: 1},
+		}},
+	}
+
+	res, err := ns.ListPeerForks(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &ethpb.PeerForks{
+		ForkVersion:    params.BeaconConfig().GenesisForkVersion,
+		FinalizedEpoch: 5,
+		FinalizedRoot:  []byte{'a'},
+		Forks: []*ethpb.PeerForks_Fork{
+			{ForkVersion: []byte{0, 0, 0, 0}, FinalizedEpoch: 5, FinalizedRoot: []byte{'a'}, PeerCount: 3},
+			{ForkVersion: []byte{0, 0, 0, 1}, FinalizedEpoch: 4, FinalizedRoot: []byte{'b'}, PeerCount: 1},
+		},
+	}
+	if !proto.Equal(res, want) {
+		t.Errorf("Wanted %v, got %v", want, res)
+	}
+}
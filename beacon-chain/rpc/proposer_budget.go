@@ -0,0 +1,86 @@
+package rpc
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// proposalStage is a step of block production with its own share of the slot.
+type proposalStage string
+
+const (
+	stageStateAdvance proposalStage = "state_advance"
+	stageEth1Vote     proposalStage = "eth1_vote"
+	stagePacking      proposalStage = "attestation_packing"
+	stageStateRoot    proposalStage = "state_root"
+)
+
+// proposalStageBudgets are the shares of a slot each stage of block production may take. The
+// rest of the slot is left to the validator to sign the block and to the network to propagate it.
+var proposalStageBudgets = map[proposalStage]float64{
+	stageStateAdvance: 0.1,
+	stageEth1Vote:     0.05,
+	stagePacking:      0.15,
+	stageStateRoot:    0.1,
+}
+
+var (
+	proposalStageDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "proposer_stage_duration_seconds",
+		Help:    "The time taken by each stage of block production.",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2, 4, 8},
+	}, []string{"stage"})
+	proposalStageOverBudget = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "proposer_stage_over_budget_total",
+		Help: "The number of times a stage of block production exceeded its time budget.",
+	}, []string{"stage"})
+)
+
+// stageBudget returns the time the stage of block production may take.
+func stageBudget(stage proposalStage) time.Duration {
+	slot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	return time.Duration(proposalStageBudgets[stage] * float64(slot))
+}
+
+// proposalTimer measures the stages of a block production against their budget.
+type proposalTimer struct {
+	slot      uint64
+	now       func() time.Time
+	durations logrus.Fields
+}
+
+func newProposalTimer(slot uint64) *proposalTimer {
+	return &proposalTimer{
+		slot:      slot,
+		now:       time.Now,
+		durations: make(logrus.Fields),
+	}
+}
+
+// track starts timing a stage, the returned function ends it.
+func (t *proposalTimer) track(stage proposalStage) func() {
+	start := t.now()
+	return func() {
+		elapsed := t.now().Sub(start)
+		t.durations[string(stage)] = elapsed
+		proposalStageDuration.WithLabelValues(string(stage)).Observe(elapsed.Seconds())
+		if budget := stageBudget(stage); elapsed > budget {
+			proposalStageOverBudget.WithLabelValues(string(stage)).Inc()
+			log.WithFields(logrus.Fields{
+				"slot":    t.slot,
+				"stage":   stage,
+				"elapsed": elapsed,
+				"budget":  budget,
+			}).Warn("Block production stage exceeded its time budget")
+		}
+	}
+}
+
+// logDurations reports the time taken by every stage of the block production.
+func (t *proposalTimer) logDurations() {
+	log.WithField("slot", t.slot).WithFields(t.durations).Debug("Timed block production")
+}
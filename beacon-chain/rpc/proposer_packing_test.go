@@ -0,0 +1,338 @@
+package rpc
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// minPackingEfficiency is the share of the votes of the best packing of the pool a proposed block
+// must hold.
+const minPackingEfficiency = 0.95
+
+// setupAttestationPacking starts a chain at genesis and fills a real operations pool with the
+// votes of every committee of the first epoch whose attestations can be included in a block at
+// proposalSlot. Every vote is handled separately, so the pool aggregates them as it would for
+// attestations received from the network. It returns the proposer server, the validator keys
+// and the number of votes in the pool.
+func setupAttestationPacking(tb testing.TB, beaconDB *db.BeaconDB, numValidators uint64, proposalSlot uint64) (*ProposerServer, []*bls.SecretKey, int) {
+	ctx := context.Background()
+	helpers.ClearAllCaches()
+
+	deposits, privKeys := testutil.SetupInitialDeposits(tb, numValidators)
+	beaconState, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		tb.Fatalf("Could not instantiate genesis state: %v", err)
+	}
+	stateRoot, err := ssz.HashTreeRoot(beaconState)
+	if err != nil {
+		tb.Fatalf("Could not hash genesis state: %v", err)
+	}
+	genesis := b.NewGenesisBlock(stateRoot[:])
+	if err := beaconDB.SaveBlockDeprecated(genesis); err != nil {
+		tb.Fatalf("Could not save genesis block: %v", err)
+	}
+	if err := beaconDB.UpdateChainHead(ctx, genesis, beaconState); err != nil {
+		tb.Fatalf("Could not save genesis state: %v", err)
+	}
+	genesisRoot, err := ssz.SigningRoot(genesis)
+	if err != nil {
+		tb.Fatalf("Could not get signing root %v", err)
+	}
+
+	opsService := operations.NewOpsPoolService(ctx, &operations.Config{BeaconDB: beaconDB})
+	committeeCount, err := helpers.CommitteeCount(beaconState, 0)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	startShard, err := helpers.StartShard(beaconState, 0)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	domain := helpers.Domain(beaconState, 0, params.BeaconConfig().DomainAttestation)
+
+	pooledVotes := 0
+	for i := uint64(0); i < committeeCount; i++ {
+		shard := (startShard + i) % params.BeaconConfig().ShardCount
+		parentCrosslink := beaconState.CurrentCrosslinks[shard]
+		parentRoot, err := ssz.HashTreeRoot(parentCrosslink)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		data := &ethpb.AttestationData{
+			BeaconBlockRoot: genesisRoot[:],
+			Source:          beaconState.CurrentJustifiedCheckpoint,
+			Target:          &ethpb.Checkpoint{Epoch: 0, Root: genesisRoot[:]},
+			Crosslink: &ethpb.Crosslink{
+				Shard:      shard,
+				ParentRoot: parentRoot[:],
+				StartEpoch: parentCrosslink.EndEpoch,
+				EndEpoch:   0,
+				DataRoot:   params.BeaconConfig().ZeroHash[:],
+			},
+		}
+		slot, err := helpers.AttestationDataSlot(beaconState, data)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		if slot+params.BeaconConfig().MinAttestationInclusionDelay > proposalSlot {
+			continue
+		}
+
+		committee, err := helpers.CrosslinkCommittee(beaconState, 0, shard)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		hashTreeRoot, err := ssz.HashTreeRoot(&pbp2p.AttestationDataAndCustodyBit{
+			Data:       data,
+			CustodyBit: false,
+		})
+		if err != nil {
+			tb.Fatal(err)
+		}
+		for j, index := range committee {
+			aggregationBits := bitfield.NewBitlist(uint64(len(committee)))
+			aggregationBits.SetBitAt(uint64(j), true)
+			att := &ethpb.Attestation{
+				Data:            data,
+				AggregationBits: aggregationBits,
+				CustodyBits:     bitfield.NewBitlist(uint64(len(committee))),
+				Signature:       privKeys[index].Sign(hashTreeRoot[:], domain).Marshal(),
+			}
+			if err := opsService.HandleAttestation(ctx, att); err != nil {
+				tb.Fatalf("Could not add attestation of validator %d to the pool: %v", index, err)
+			}
+			pooledVotes++
+		}
+	}
+
+	// The eth1 chain holds no deposits past the genesis ones: the proposer votes for the genesis
+	// eth1 data and packs no deposit.
+	powChainService := &mockPOWChainService{
+		blockNumberByHeight: map[uint64]*big.Int{
+			0: big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance)),
+		},
+		hashesByHeight: map[int][]byte{
+			0: beaconState.Eth1Data.BlockHash,
+		},
+		eth1Data: beaconState.Eth1Data,
+	}
+	proposerServer := &ProposerServer{
+		beaconDB:         beaconDB,
+		chainService:     &mockChainService{},
+		powChainService:  powChainService,
+		operationService: opsService,
+		depositCache:     depositcache.NewDepositCache(),
+	}
+	return proposerServer, privKeys, pooledVotes
+}
+
+// packBlock requests a block for the slot from the proposer server, packing the attestations of
+// the operations pool, and signs it.
+func packBlock(ctx context.Context, ps *ProposerServer, beaconDB *db.BeaconDB, privKeys []*bls.SecretKey, slot uint64) (*ethpb.BeaconBlock, error) {
+	headState, err := beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	headState.Slot = slot
+	randaoReveal, err := testutil.CreateRandaoReveal(headState, helpers.SlotToEpoch(slot), privKeys)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := ps.RequestBlock(ctx, &pb.BlockRequest{Slot: slot, RandaoReveal: randaoReveal})
+	if err != nil {
+		return nil, err
+	}
+	return testutil.SignBlock(headState, blk, privKeys)
+}
+
+// packedVotes returns the number of votes aggregated in the attestations of a block.
+func packedVotes(blk *ethpb.BeaconBlock) int {
+	votes := 0
+	for _, att := range blk.Body.Attestations {
+		votes += int(att.AggregationBits.Count())
+	}
+	return votes
+}
+
+// assertPackingEfficiency checks that a block packs at least minPackingEfficiency of the votes
+// of the best packing of the pool, the attestations with the most votes up to the block limit.
+func assertPackingEfficiency(t *testing.T, ps *ProposerServer, blk *ethpb.BeaconBlock) {
+	if uint64(len(blk.Body.Attestations)) > params.BeaconConfig().MaxAttestations {
+		t.Errorf("Wanted at most %d attestations, got %d", params.BeaconConfig().MaxAttestations, len(blk.Body.Attestations))
+	}
+	pool, err := ps.operationService.AttestationPool(context.Background(), blk.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	votes := make([]int, len(pool))
+	for i, att := range pool {
+		votes[i] = int(att.AggregationBits.Count())
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(votes)))
+	if uint64(len(votes)) > params.BeaconConfig().MaxAttestations {
+		votes = votes[:params.BeaconConfig().MaxAttestations]
+	}
+	bestVotes := 0
+	for _, v := range votes {
+		bestVotes += v
+	}
+	if bestVotes == 0 {
+		t.Fatal("Expected votes in the operations pool")
+	}
+	efficiency := float64(packedVotes(blk)) / float64(bestVotes)
+	if efficiency < minPackingEfficiency {
+		t.Errorf("Wanted a packing efficiency of at least %.2f, packed %d of %d votes", minPackingEfficiency, packedVotes(blk), bestVotes)
+	}
+}
+
+// verifyPackedBlock runs the full state transition of a packed block on the head state and
+// checks it matches the state root computed by the proposer.
+func verifyPackedBlock(t *testing.T, beaconDB *db.BeaconDB, blk *ethpb.BeaconBlock) {
+	ctx := context.Background()
+	headState, err := beaconDB.HeadState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	postState, err := state.ExecuteStateTransition(ctx, headState, blk)
+	if err != nil {
+		t.Fatalf("Packed block failed the state transition: %v", err)
+	}
+	postRoot, err := ssz.HashTreeRoot(postState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if postRoot != bytesutil.ToBytes32(blk.StateRoot) {
+		t.Errorf("Wanted state root %#x, got %#x", blk.StateRoot, postRoot)
+	}
+}
+
+func TestAttestationPacking_PoolToProposer(t *testing.T) {
+	beaconDB := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, beaconDB)
+	ctx := context.Background()
+
+	// Committees of 8 validators, one per slot.
+	proposalSlot := params.BeaconConfig().SlotsPerEpoch - 1
+	proposerServer, privKeys, pooledVotes := setupAttestationPacking(t, beaconDB, 512, proposalSlot)
+	if pooledVotes == 0 {
+		t.Fatal("Expected votes in the operations pool")
+	}
+
+	blk, err := packBlock(ctx, proposerServer, beaconDB, privKeys, proposalSlot)
+	if err != nil {
+		t.Fatalf("Could not pack block: %v", err)
+	}
+	verifyPackedBlock(t, beaconDB, blk)
+	assertPackingEfficiency(t, proposerServer, blk)
+
+	// The pool holds one aggregate per committee, well under the block limit, so every vote
+	// must be packed.
+	if votes := packedVotes(blk); votes != pooledVotes {
+		t.Errorf("Wanted %d packed votes, got %d", pooledVotes, votes)
+	}
+}
+
+func TestAttestationPacking_RespectsMaxAttestations(t *testing.T) {
+	beaconDB := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, beaconDB)
+	ctx := context.Background()
+	prev := params.BeaconConfig()
+	defer params.OverrideBeaconConfig(prev)
+	cfg := *prev
+	cfg.MaxAttestations = 4
+	params.OverrideBeaconConfig(&cfg)
+
+	proposalSlot := params.BeaconConfig().SlotsPerEpoch - 1
+	proposerServer, privKeys, _ := setupAttestationPacking(t, beaconDB, 512, proposalSlot)
+
+	blk, err := packBlock(ctx, proposerServer, beaconDB, privKeys, proposalSlot)
+	if err != nil {
+		t.Fatalf("Could not pack block: %v", err)
+	}
+	verifyPackedBlock(t, beaconDB, blk)
+	assertPackingEfficiency(t, proposerServer, blk)
+}
+
+func BenchmarkAttestationPacking(b *testing.B) {
+	beaconDB := internal.SetupDBDeprecated(b)
+	defer internal.TeardownDBDeprecated(b, beaconDB)
+	ctx := context.Background()
+
+	proposalSlot := params.BeaconConfig().SlotsPerEpoch - 1
+	proposerServer, privKeys, pooledVotes := setupAttestationPacking(b, beaconDB, 256, proposalSlot)
+
+	b.ResetTimer()
+	var blk *ethpb.BeaconBlock
+	for i := 0; i < b.N; i++ {
+		var err error
+		blk, err = packBlock(ctx, proposerServer, beaconDB, privKeys, proposalSlot)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	b.Logf("Packed %d of %d pooled votes in %d attestations", packedVotes(blk), pooledVotes, len(blk.Body.Attestations))
+}
+
+func TestAttestationPacking_StopsAtDeadline(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconDB := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, beaconDB)
+	ctx := context.Background()
+
+	proposalSlot := params.BeaconConfig().SlotsPerEpoch - 1
+	proposerServer, _, _ := setupAttestationPacking(t, beaconDB, 64, proposalSlot)
+
+	headState, err := proposerServer.advancedHeadState(ctx, proposalSlot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The budget is already exhausted, the packing returns the best found so far: nothing.
+	atts, err := proposerServer.packAttestations(ctx, headState, proposalSlot, time.Now().Add(-time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(atts) != 0 {
+		t.Errorf("Wanted no attestations packed past the deadline, got %d", len(atts))
+	}
+	testutil.AssertLogsContain(t, hook, "Attestation packing budget exhausted")
+
+	headState, err = proposerServer.advancedHeadState(ctx, proposalSlot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	atts, err = proposerServer.packAttestations(ctx, headState, proposalSlot, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(atts) == 0 {
+		t.Error("Expected attestations to be packed within the budget")
+	}
+	for i := 1; i < len(atts); i++ {
+		if atts[i].AggregationBits.Count() > atts[i-1].AggregationBits.Count() {
+			t.Errorf("Wanted attestations with the most votes first, got %d votes after %d",
+				atts[i].AggregationBits.Count(), atts[i-1].AggregationBits.Count())
+		}
+	}
+}
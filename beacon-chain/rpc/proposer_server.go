@@ -4,21 +4,29 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sort"
+	"time"
 
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/tracing"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
 )
 
 // ProposerServer defines a server implementation of the gRPC Proposer service,
@@ -31,6 +39,8 @@ type ProposerServer struct {
 	operationService   operationService
 	canonicalStateChan chan *pbp2p.BeaconState
 	depositCache       *depositcache.DepositCache
+	recentlyProcessed  *cache.RecentlyProcessedCache
+	prefetcher         *statePrefetcher
 }
 
 // RequestBlock is called by a proposer during its assigned slot to request a block to sign
@@ -48,8 +58,19 @@ func (ps *ProposerServer) RequestBlock(ctx context.Context, req *pb.BlockRequest
 		return nil, errors.Wrap(err, "could not get parent block signing root")
 	}
 
+	timer := newProposalTimer(req.Slot)
+	defer timer.logDurations()
+
+	done := timer.track(stageStateAdvance)
+	headState, err := ps.advancedHeadState(ctx, req.Slot)
+	done()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not advance head state to the proposal slot")
+	}
+
 	// Construct block body
 	// Pack ETH1 deposits which have not been included in the beacon chain
+	done = timer.track(stageEth1Vote)
 	eth1Data, err := ps.eth1Data(ctx, req.Slot)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get ETH1 data")
@@ -60,9 +81,19 @@ func (ps *ProposerServer) RequestBlock(ctx context.Context, req *pb.BlockRequest
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get eth1 deposits")
 	}
+	done()
 
-	// Pack aggregated attestations which have not been included in the beacon chain.
-	attestations, err := ps.attestations(ctx, req.Slot)
+	// Pack aggregated attestations which have not been included in the beacon chain, with the
+	// best packing found within the budget of the stage.
+	deadline := time.Now().Add(stageBudget(stagePacking))
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	// The attestations are packed on a copy, the advanced state is reused for the state root.
+	done = timer.track(stagePacking)
+	packingState := proto.Clone(headState).(*pbp2p.BeaconState)
+	attestations, err := ps.packAttestations(ctx, packingState, req.Slot, deadline)
+	done()
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get pending attestations")
 	}
@@ -92,7 +123,9 @@ func (ps *ProposerServer) RequestBlock(ctx context.Context, req *pb.BlockRequest
 	}
 
 	// Compute state root with the newly constructed block.
-	stateRoot, err = ps.computeStateRoot(ctx, blk)
+	done = timer.track(stageStateRoot)
+	stateRoot, err = ps.computeStateRoot(ctx, headState, blk)
+	done()
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get compute state root")
 	}
@@ -104,6 +137,11 @@ func (ps *ProposerServer) RequestBlock(ctx context.Context, req *pb.BlockRequest
 // ProposeBlock is called by a proposer during its assigned slot to create a block in an attempt
 // to get it processed by the beacon node as the canonical head.
 func (ps *ProposerServer) ProposeBlock(ctx context.Context, blk *ethpb.BeaconBlock) (*pb.ProposeResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.ProposeBlock")
+	defer span.End()
+	dutyID := tracing.DutyID(ctx)
+	span.AddAttributes(trace.StringAttribute("dutyID", dutyID))
+
 	root, err := ssz.SigningRoot(blk)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not tree hash block")
@@ -111,9 +149,25 @@ func (ps *ProposerServer) ProposeBlock(ctx context.Context, blk *ethpb.BeaconBlo
 	log.WithField("blockRoot", fmt.Sprintf("%#x", bytesutil.Trunc(root[:]))).Debugf(
 		"Block proposal received via RPC")
 
-	beaconState, err := ps.chainService.ReceiveBlockDeprecated(ctx, blk)
+	// The same block may already have been received by gossip, or proposed twice.
+	firstSeen, err := ps.recentlyProcessed.MarkProcessed(root, cache.KindBlock, cache.SourceRPC)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check recently processed blocks")
+	}
+	if !firstSeen {
+		log.WithField("blockRoot", fmt.Sprintf("%#x", bytesutil.Trunc(root[:]))).Debug(
+			"Block proposal already processed")
+		return &pb.ProposeResponse{BlockRoot: root[:]}, nil
+	}
+
+	// The proposal is broadcast right away, whatever the block broadcast policy of the node.
+	receiveStart := time.Now()
+	beaconState, err := ps.chainService.ReceiveBlockDeprecated(p2p.WithLocalProposal(ctx), blk)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not process beacon block")
+		if forgetErr := ps.recentlyProcessed.Forget(root); forgetErr != nil {
+			log.WithError(forgetErr).Error("Could not forget failed block")
+		}
+		return nil, blockStatusError(err)
 	}
 
 	db, isLegacyDB := ps.beaconDB.(*db.BeaconDB)
@@ -128,6 +182,10 @@ func (ps *ProposerServer) ProposeBlock(ctx context.Context, blk *ethpb.BeaconBlo
 		"headRoot": fmt.Sprintf("%#x", bytesutil.Trunc(root[:])),
 		"headSlot": blk.Slot,
 	}).Info("Chain head block and state updated")
+	log.WithFields(logrus.Fields{
+		"dutyID":          dutyID,
+		"receiveDuration": time.Since(receiveStart),
+	}).Debug("Block proposal processed and broadcast")
 
 	return &pb.ProposeResponse{BlockRoot: root[:]}, nil
 }
@@ -138,23 +196,33 @@ func (ps *ProposerServer) ProposeBlock(ctx context.Context, blk *ethpb.BeaconBlo
 // attestations which are ready for inclusion. That is, attestations that satisfy:
 // attestation.slot + MIN_ATTESTATION_INCLUSION_DELAY <= state.slot.
 func (ps *ProposerServer) attestations(ctx context.Context, expectedSlot uint64) ([]*ethpb.Attestation, error) {
-	beaconState, err := ps.beaconDB.HeadState(ctx)
+	beaconState, err := ps.advancedHeadState(ctx, expectedSlot)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not retrieve beacon state")
+		return nil, err
 	}
+	return ps.packAttestations(ctx, beaconState, expectedSlot, time.Time{})
+}
+
+// advancedHeadState returns the head state, advanced to the given slot if it is behind.
+func (ps *ProposerServer) advancedHeadState(ctx context.Context, slot uint64) (*pbp2p.BeaconState, error) {
+	return ps.prefetcher.advancedHeadState(ctx, ps.beaconDB, slot)
+}
+
+// packAttestations checks the pending attestations ready for inclusion against the state advanced
+// to the proposal slot, the ones with the most votes first. Once the deadline passes, the
+// attestations checked so far are returned instead of all of them, a zero deadline means none.
+// The state is modified by the attestations packed.
+func (ps *ProposerServer) packAttestations(
+	ctx context.Context,
+	beaconState *pbp2p.BeaconState,
+	expectedSlot uint64,
+	deadline time.Time,
+) ([]*ethpb.Attestation, error) {
 	atts, err := ps.operationService.AttestationPool(ctx, expectedSlot)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not retrieve pending attestations from operations service")
 	}
 
-	// advance slot, if it is behind
-	if beaconState.Slot < expectedSlot {
-		beaconState, err = state.ProcessSlots(ctx, beaconState, expectedSlot)
-		if err != nil {
-			return nil, err
-		}
-	}
-
 	var attsReadyForInclusion []*ethpb.Attestation
 	for _, att := range atts {
 		slot, err := helpers.AttestationDataSlot(beaconState, att.Data)
@@ -167,8 +235,22 @@ func (ps *ProposerServer) attestations(ctx context.Context, expectedSlot uint64)
 		}
 	}
 
+	// The attestations worth the most votes are checked first, so that stopping at the deadline
+	// leaves out the least valuable ones.
+	sort.SliceStable(attsReadyForInclusion, func(i, j int) bool {
+		return attsReadyForInclusion[i].AggregationBits.Count() > attsReadyForInclusion[j].AggregationBits.Count()
+	})
+
 	validAtts := make([]*ethpb.Attestation, 0, len(attsReadyForInclusion))
-	for _, att := range attsReadyForInclusion {
+	for i, att := range attsReadyForInclusion {
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			log.WithFields(logrus.Fields{
+				"slot":      expectedSlot,
+				"packed":    len(validAtts),
+				"unchecked": len(attsReadyForInclusion) - i,
+			}).Warn("Attestation packing budget exhausted, proposing the attestations packed so far")
+			break
+		}
 		slot, err := helpers.AttestationDataSlot(beaconState, att.Data)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not get attestation slot")
@@ -223,25 +305,97 @@ func (ps *ProposerServer) attestations(ctx context.Context, expectedSlot uint64)
 //  - Subtract that eth1block.number by ETH1_FOLLOW_DISTANCE.
 //  - This is the eth1block to use for the block proposal.
 func (ps *ProposerServer) eth1Data(ctx context.Context, slot uint64) (*ethpb.Eth1Data, error) {
+	blockNumber, err := ps.eth1VotingPeriodStartBlock(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	return ps.defaultEth1DataResponse(ctx, blockNumber)
+}
+
+// eth1VotingPeriodStartBlock returns the number of the most recent eth1 block before the start of
+// the eth1 voting period of the given slot.
+func (ps *ProposerServer) eth1VotingPeriodStartBlock(ctx context.Context, slot uint64) (*big.Int, error) {
 	eth1VotingPeriodStartTime, _ := ps.powChainService.ETH2GenesisTime()
 	eth1VotingPeriodStartTime += (slot - (slot % params.BeaconConfig().SlotsPerEth1VotingPeriod)) * params.BeaconConfig().SecondsPerSlot
 
 	// Look up most recent block up to timestamp
-	blockNumber, err := ps.powChainService.BlockNumberByTimestamp(ctx, eth1VotingPeriodStartTime)
+	return ps.powChainService.BlockNumberByTimestamp(ctx, eth1VotingPeriodStartTime)
+}
+
+// Eth1DataVotes returns the tally of the eth1 data votes of the current voting period in the head
+// state, along with the eth1 data the node votes for in this voting period and the eth1 blocks
+// this vote is determined from. It helps operators understand why deposits are not
+// included in blocks.
+func (ps *ProposerServer) Eth1DataVotes(ctx context.Context, _ *ptypes.Empty) (*pb.Eth1DataVotesResponse, error) {
+	headState, err := ps.beaconDB.HeadState(ctx)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "could not retrieve head state")
+	}
+	votingPeriod := params.BeaconConfig().SlotsPerEth1VotingPeriod
+	res := &pb.Eth1DataVotesResponse{
+		Slot:                  headState.Slot,
+		VotingPeriodStartSlot: headState.Slot - headState.Slot%votingPeriod,
+		Eth1Data:              headState.Eth1Data,
+		Eth1DepositIndex:      headState.Eth1DepositIndex,
+		VotesRequired:         votingPeriod/2 + 1,
+		Votes:                 tallyEth1DataVotes(headState.Eth1DataVotes),
 	}
 
-	return ps.defaultEth1DataResponse(ctx, blockNumber)
+	// The intended vote is the one of the voting period of the head state, which the tally
+	// belongs to, even when the next slot starts another period.
+	res.IntendedVote, err = ps.eth1Data(ctx, headState.Slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not determine intended eth1 data vote")
+	}
+	startBlock, err := ps.eth1VotingPeriodStartBlock(ctx, headState.Slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch eth1 voting period start block")
+	}
+	ancestorHeight := followDistanceAncestor(startBlock)
+	for _, height := range []*big.Int{startBlock, ancestorHeight} {
+		blockHash, err := ps.powChainService.BlockHashByHeight(ctx, height)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not fetch eth1 block %d", height)
+		}
+		depositCount, depositRoot := ps.depositCache.DepositsNumberAndRootAtHeight(ctx, height)
+		res.Candidates = append(res.Candidates, &pb.Eth1DataVotesResponse_Eth1Block{
+			BlockNumber:  height.Uint64(),
+			BlockHash:    blockHash[:],
+			DepositCount: depositCount,
+			DepositRoot:  depositRoot[:],
+		})
+	}
+	return res, nil
 }
 
-// computeStateRoot computes the state root after a block has been processed through a state transition and
-// returns it to the validator client.
-func (ps *ProposerServer) computeStateRoot(ctx context.Context, block *ethpb.BeaconBlock) ([]byte, error) {
-	beaconState, err := ps.beaconDB.HeadState(ctx)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not get beacon state")
+// tallyEth1DataVotes counts the votes of each distinct eth1 data, by decreasing count. Votes with
+// the same count are kept in the order they were first cast.
+func tallyEth1DataVotes(votes []*ethpb.Eth1Data) []*pb.Eth1DataVotesResponse_VoteTally {
+	tallies := []*pb.Eth1DataVotesResponse_VoteTally{}
+	for _, vote := range votes {
+		found := false
+		for _, tally := range tallies {
+			if proto.Equal(tally.Eth1Data, vote) {
+				tally.Count++
+				found = true
+				break
+			}
+		}
+		if !found {
+			tallies = append(tallies, &pb.Eth1DataVotesResponse_VoteTally{Eth1Data: vote, Count: 1})
+		}
 	}
+	sort.SliceStable(tallies, func(i, j int) bool {
+		return tallies[i].Count > tallies[j].Count
+	})
+	return tallies
+}
+
+// computeStateRoot computes the state root after a block has been processed through a state transition and
+// returns it to the validator client. The pre-state is the head state, which may already be advanced to
+// the slot of the block, and is not modified.
+func (ps *ProposerServer) computeStateRoot(ctx context.Context, beaconState *pbp2p.BeaconState, block *ethpb.BeaconBlock) ([]byte, error) {
 	s, err := state.ExecuteStateTransitionNoVerify(
 		ctx,
 		beaconState,
@@ -355,8 +509,7 @@ func (ps *ProposerServer) canonicalEth1Data(ctx context.Context, beaconState *pb
 // hash of eth1 block hash that is FOLLOW_DISTANCE back from its
 // latest block.
 func (ps *ProposerServer) defaultEth1DataResponse(ctx context.Context, currentHeight *big.Int) (*ethpb.Eth1Data, error) {
-	eth1FollowDistance := int64(params.BeaconConfig().Eth1FollowDistance)
-	ancestorHeight := big.NewInt(0).Sub(currentHeight, big.NewInt(eth1FollowDistance))
+	ancestorHeight := followDistanceAncestor(currentHeight)
 	blockHash, err := ps.powChainService.BlockHashByHeight(ctx, ancestorHeight)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not fetch ETH1_FOLLOW_DISTANCE ancestor")
@@ -372,3 +525,13 @@ func (ps *ProposerServer) defaultEth1DataResponse(ctx context.Context, currentHe
 		DepositCount: depositsTillHeight,
 	}, nil
 }
+
+// followDistanceAncestor returns the height of the ETH1_FOLLOW_DISTANCE ancestor of the given eth1
+// block, or of the genesis block early in the eth1 chain.
+func followDistanceAncestor(height *big.Int) *big.Int {
+	ancestorHeight := big.NewInt(0).Sub(height, big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance)))
+	if ancestorHeight.Sign() < 0 {
+		return big.NewInt(0)
+	}
+	return ancestorHeight
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
@@ -17,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -138,7 +140,7 @@ func TestComputeStateRoot_OK(t *testing.T) {
 	blockSig := privKeys[proposerIdx].Sign(signingRoot[:], domain).Marshal()
 	req.Signature = blockSig[:]
 
-	_, err = proposerServer.computeStateRoot(context.Background(), req)
+	_, err = proposerServer.computeStateRoot(context.Background(), beaconState, req)
 	if err != nil {
 		t.Error(err)
 	}
@@ -1244,6 +1246,143 @@ func TestEth1Data(t *testing.T) {
 	}
 }
 
+func TestEth1DataVotes(t *testing.T) {
+	beaconDB := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, beaconDB)
+	ctx := context.Background()
+
+	voteA := &ethpb.Eth1Data{BlockHash: []byte("a"), DepositCount: 1}
+	voteB := &ethpb.Eth1Data{BlockHash: []byte("b"), DepositCount: 2}
+	voteC := &ethpb.Eth1Data{BlockHash: []byte("c"), DepositCount: 3}
+	votingPeriod := params.BeaconConfig().SlotsPerEth1VotingPeriod
+	// The last slot of the voting period, the vote is not taken from the next period.
+	beaconState := &pbp2p.BeaconState{
+		Slot:             2*votingPeriod - 1,
+		Eth1Data:         &ethpb.Eth1Data{BlockHash: []byte("state")},
+		Eth1DepositIndex: 1,
+		Eth1DataVotes:    []*ethpb.Eth1Data{voteC, voteA, voteB, voteA, voteB, voteA},
+	}
+	if err := beaconDB.SaveStateDeprecated(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		t.Fatalf("could not setup deposit trie: %v", err)
+	}
+	depositCache := depositcache.NewDepositCache()
+	for i, height := range []int64{100, 200, 5000} {
+		deposit := &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             []byte{byte(i)},
+				Signature:             mockSig[:],
+				WithdrawalCredentials: mockCreds[:],
+			},
+		}
+		depositCache.InsertDeposit(ctx, deposit, big.NewInt(height), i, depositTrie.Root())
+	}
+
+	startBlock := 4000
+	ancestor := startBlock - int(params.BeaconConfig().Eth1FollowDistance)
+	periodStartTime := votingPeriod * params.BeaconConfig().SecondsPerSlot
+	ps := &ProposerServer{
+		beaconDB: beaconDB,
+		powChainService: &mockPOWChainService{
+			blockNumberByHeight: map[uint64]*big.Int{
+				periodStartTime: big.NewInt(int64(startBlock)),
+			},
+			hashesByHeight: map[int][]byte{
+				startBlock: []byte("start"),
+				ancestor:   []byte("ancestor"),
+			},
+		},
+		depositCache: depositCache,
+	}
+
+	res, err := ps.Eth1DataVotes(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Slot != beaconState.Slot || res.VotingPeriodStartSlot != votingPeriod {
+		t.Errorf("Wanted slot %d in voting period starting at %d, got %d and %d", beaconState.Slot, votingPeriod, res.Slot, res.VotingPeriodStartSlot)
+	}
+	if res.VotesRequired != votingPeriod/2+1 {
+		t.Errorf("Wanted %d votes required, got %d", votingPeriod/2+1, res.VotesRequired)
+	}
+	if !proto.Equal(res.Eth1Data, beaconState.Eth1Data) || res.Eth1DepositIndex != 1 {
+		t.Errorf("Unexpected state eth1 data %v with deposit index %d", res.Eth1Data, res.Eth1DepositIndex)
+	}
+
+	wantVotes := []*pb.Eth1DataVotesResponse_VoteTally{
+		{Eth1Data: voteA, Count: 3},
+		{Eth1Data: voteB, Count: 2},
+		{Eth1Data: voteC, Count: 1},
+	}
+	if len(res.Votes) != len(wantVotes) {
+		t.Fatalf("Wanted %d tallies, got %d", len(wantVotes), len(res.Votes))
+	}
+	for i := range wantVotes {
+		if !proto.Equal(res.Votes[i], wantVotes[i]) {
+			t.Errorf("Wanted tally %v, got %v", wantVotes[i], res.Votes[i])
+		}
+	}
+
+	// Only the deposits up to the follow distance ancestor are voted for.
+	if res.IntendedVote.DepositCount != 2 || string(res.IntendedVote.BlockHash[:8]) != "ancestor" {
+		t.Errorf("Unexpected intended vote %v", res.IntendedVote)
+	}
+	if len(res.Candidates) != 2 {
+		t.Fatalf("Wanted 2 candidate blocks, got %d", len(res.Candidates))
+	}
+	if res.Candidates[0].BlockNumber != uint64(startBlock) || res.Candidates[0].DepositCount != 2 {
+		t.Errorf("Unexpected voting period start block %v", res.Candidates[0])
+	}
+	if res.Candidates[1].BlockNumber != uint64(ancestor) || res.Candidates[1].DepositCount != 2 {
+		t.Errorf("Unexpected follow distance ancestor %v", res.Candidates[1])
+	}
+}
+
+func TestEth1DataVotes_EarlyEth1Chain(t *testing.T) {
+	beaconDB := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, beaconDB)
+	ctx := context.Background()
+
+	beaconState := &pbp2p.BeaconState{
+		Slot:          4,
+		Eth1Data:      &ethpb.Eth1Data{BlockHash: []byte("state")},
+		Eth1DataVotes: []*ethpb.Eth1Data{},
+	}
+	if err := beaconDB.SaveStateDeprecated(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	// The voting period starts before the eth1 chain is ETH1_FOLLOW_DISTANCE blocks long.
+	ps := &ProposerServer{
+		beaconDB: beaconDB,
+		powChainService: &mockPOWChainService{
+			blockNumberByHeight: map[uint64]*big.Int{
+				0: big.NewInt(10),
+			},
+			hashesByHeight: map[int][]byte{
+				0:  []byte("genesis"),
+				10: []byte("start"),
+			},
+			eth1Data: &ethpb.Eth1Data{BlockHash: []byte("chainstart")},
+		},
+		depositCache: depositcache.NewDepositCache(),
+	}
+
+	res, err := ps.Eth1DataVotes(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Candidates) != 2 {
+		t.Fatalf("Wanted 2 candidate blocks, got %d", len(res.Candidates))
+	}
+	if res.Candidates[1].BlockNumber != 0 || string(res.Candidates[1].BlockHash[:7]) != "genesis" {
+		t.Errorf("Wanted the follow distance ancestor to be the genesis block, got %v", res.Candidates[1])
+	}
+}
+
 func Benchmark_Eth1Data(b *testing.B) {
 	beaconDB := internal.SetupDBDeprecated(b)
 	defer internal.TeardownDBDeprecated(b, beaconDB)
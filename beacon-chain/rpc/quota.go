@@ -0,0 +1,235 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// APIKeyMetadataKey is the gRPC metadata key clients send their API key in, when the RPC server
+// enforces quotas.
+const APIKeyMetadataKey = "x-api-key"
+
+var (
+	quotaRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "rpc_quota_requests_total",
+		Help: "The number of RPC requests and streams checked against the quota of an API key, by outcome.",
+	}, []string{"tenant", "outcome"})
+	quotaActiveStreams = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "rpc_quota_active_streams",
+		Help: "The number of streams currently open with an API key.",
+	}, []string{"tenant"})
+)
+
+// Quota limits the usage of the RPC server by the holder of an API key. A zero limit means no
+// limit.
+type Quota struct {
+	// Name identifies the tenant in logs and metrics, so the API key itself is never exposed.
+	Name   string `json:"name"`
+	APIKey string `json:"api_key"`
+	// RequestsPerMinute is the sustained rate of requests and new streams, bursts of up to a
+	// minute worth of requests are allowed.
+	RequestsPerMinute uint64 `json:"requests_per_minute"`
+	// MaxStreams is the number of streams the tenant may hold open at once.
+	MaxStreams uint64 `json:"max_streams"`
+	// MaxDutiesPubkeys is the number of public keys a duties request may ask assignments for.
+	MaxDutiesPubkeys uint64 `json:"max_duties_pubkeys"`
+}
+
+// LoadQuotas reads the quotas of the RPC server from a JSON file holding a list of quotas.
+func LoadQuotas(path string) ([]*Quota, error) {
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read quotas file")
+	}
+	var quotas []*Quota
+	if err := json.Unmarshal(enc, &quotas); err != nil {
+		return nil, errors.Wrap(err, "could not parse quotas file")
+	}
+	keys := make(map[string]bool)
+	names := make(map[string]bool)
+	for i, q := range quotas {
+		if q.Name == "" || q.APIKey == "" {
+			return nil, errors.Errorf("quota %d must have a name and an API key", i)
+		}
+		if keys[q.APIKey] || names[q.Name] {
+			return nil, errors.Errorf("quota %s reuses the name or API key of another quota", q.Name)
+		}
+		keys[q.APIKey] = true
+		names[q.Name] = true
+	}
+	return quotas, nil
+}
+
+// tenant tracks the usage of the RPC server with an API key.
+type tenant struct {
+	quota      *Quota
+	tokens     float64
+	lastRefill time.Time
+	streams    uint64
+}
+
+// quotaEnforcer rejects the requests without a known API key, and those exceeding the quota of
+// their key.
+type quotaEnforcer struct {
+	lock    sync.Mutex
+	tenants map[string]*tenant
+	now     func() time.Time
+}
+
+func newQuotaEnforcer(quotas []*Quota) *quotaEnforcer {
+	e := &quotaEnforcer{
+		tenants: make(map[string]*tenant, len(quotas)),
+		now:     time.Now,
+	}
+	for _, q := range quotas {
+		e.tenants[q.APIKey] = &tenant{
+			quota:      q,
+			tokens:     float64(q.RequestsPerMinute),
+			lastRefill: e.now(),
+		}
+	}
+	return e
+}
+
+// tenant returns the tenant of the API key sent with the request.
+func (e *quotaEnforcer) tenant(ctx context.Context) (*tenant, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(APIKeyMetadataKey)) == 0 {
+		quotaRequests.WithLabelValues("unknown", "unauthenticated").Inc()
+		return nil, status.Errorf(codes.Unauthenticated, "missing %s metadata", APIKeyMetadataKey)
+	}
+	t, ok := e.tenants[md.Get(APIKeyMetadataKey)[0]]
+	if !ok {
+		quotaRequests.WithLabelValues("unknown", "unauthenticated").Inc()
+		return nil, status.Error(codes.Unauthenticated, "unknown API key")
+	}
+	return t, nil
+}
+
+// takeRequest consumes a request from the rate of the tenant, refilled continuously.
+func (e *quotaEnforcer) takeRequest(t *tenant) error {
+	if t.quota.RequestsPerMinute == 0 {
+		return nil
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	now := e.now()
+	rate := float64(t.quota.RequestsPerMinute)
+	t.tokens += now.Sub(t.lastRefill).Minutes() * rate
+	if t.tokens > rate {
+		t.tokens = rate
+	}
+	t.lastRefill = now
+	if t.tokens < 1 {
+		quotaRequests.WithLabelValues(t.quota.Name, "rate_limited").Inc()
+		return status.Errorf(codes.ResourceExhausted, "quota of %d requests per minute exceeded", t.quota.RequestsPerMinute)
+	}
+	t.tokens--
+	return nil
+}
+
+// checkDutiesPubkeys rejects the duties requests asking for more public keys than the tenant
+// is allowed to. Unlike the rate and stream quotas, retrying doesn't help, so the request is
+// denied rather than reported as exhausting a resource.
+func checkDutiesPubkeys(t *tenant, req interface{}) error {
+	r, ok := req.(*pb.AssignmentRequest)
+	if !ok || t.quota.MaxDutiesPubkeys == 0 || uint64(len(r.PublicKeys)) <= t.quota.MaxDutiesPubkeys {
+		return nil
+	}
+	quotaRequests.WithLabelValues(t.quota.Name, "too_many_pubkeys").Inc()
+	return status.Errorf(
+		codes.PermissionDenied,
+		"duties requested for %d public keys, quota allows %d",
+		len(r.PublicKeys),
+		t.quota.MaxDutiesPubkeys,
+	)
+}
+
+// openStream reserves a stream for the tenant, the returned function releases it.
+func (e *quotaEnforcer) openStream(t *tenant) (func(), error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if t.quota.MaxStreams != 0 && t.streams >= t.quota.MaxStreams {
+		quotaRequests.WithLabelValues(t.quota.Name, "stream_limited").Inc()
+		return nil, status.Errorf(codes.ResourceExhausted, "quota of %d concurrent streams exceeded", t.quota.MaxStreams)
+	}
+	t.streams++
+	quotaActiveStreams.WithLabelValues(t.quota.Name).Inc()
+	return func() {
+		e.lock.Lock()
+		defer e.lock.Unlock()
+		t.streams--
+		quotaActiveStreams.WithLabelValues(t.quota.Name).Dec()
+	}, nil
+}
+
+// unaryInterceptor enforces the quota of the API key of unary requests.
+func (e *quotaEnforcer) unaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	t, err := e.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.takeRequest(t); err != nil {
+		return nil, err
+	}
+	if err := checkDutiesPubkeys(t, req); err != nil {
+		return nil, err
+	}
+	quotaRequests.WithLabelValues(t.quota.Name, "allowed").Inc()
+	return handler(ctx, req)
+}
+
+// streamInterceptor enforces the quota of the API key of streams. Opening a stream counts as
+// a request.
+func (e *quotaEnforcer) streamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	t, err := e.tenant(ss.Context())
+	if err != nil {
+		return err
+	}
+	if err := e.takeRequest(t); err != nil {
+		return err
+	}
+	release, err := e.openStream(t)
+	if err != nil {
+		return err
+	}
+	defer release()
+	quotaRequests.WithLabelValues(t.quota.Name, "allowed").Inc()
+	return handler(srv, &quotaServerStream{ServerStream: ss, tenant: t})
+}
+
+// quotaServerStream checks the requests received on a stream against the quota of its tenant.
+type quotaServerStream struct {
+	grpc.ServerStream
+	tenant *tenant
+}
+
+// RecvMsg receives a request of the stream, rejecting duties requests for too many keys.
+func (s *quotaServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return checkDutiesPubkeys(s.tenant, m)
+}
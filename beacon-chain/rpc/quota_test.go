@@ -0,0 +1,151 @@
+package rpc
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func withAPIKey(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(APIKeyMetadataKey, key))
+}
+
+func okHandler(_ context.Context, _ interface{}) (interface{}, error) {
+	return "ok", nil
+}
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *mockServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestQuotaEnforcer_RequiresKnownAPIKey(t *testing.T) {
+	e := newQuotaEnforcer([]*Quota{{Name: "alice", APIKey: "secret"}})
+	info := &grpc.UnaryServerInfo{}
+
+	if _, err := e.unaryInterceptor(context.Background(), nil, info, okHandler); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Wanted code %v without API key, got %v", codes.Unauthenticated, status.Code(err))
+	}
+	if _, err := e.unaryInterceptor(withAPIKey("wrong"), nil, info, okHandler); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Wanted code %v with unknown API key, got %v", codes.Unauthenticated, status.Code(err))
+	}
+	if _, err := e.unaryInterceptor(withAPIKey("secret"), nil, info, okHandler); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestQuotaEnforcer_RequestsPerMinute(t *testing.T) {
+	e := newQuotaEnforcer([]*Quota{
+		{Name: "alice", APIKey: "alice-key", RequestsPerMinute: 3},
+		{Name: "bob", APIKey: "bob-key"},
+	})
+	now := time.Now()
+	e.now = func() time.Time { return now }
+	info := &grpc.UnaryServerInfo{}
+
+	for i := 0; i < 3; i++ {
+		if _, err := e.unaryInterceptor(withAPIKey("alice-key"), nil, info, okHandler); err != nil {
+			t.Fatalf("Unexpected error on request %d: %v", i, err)
+		}
+	}
+	if _, err := e.unaryInterceptor(withAPIKey("alice-key"), nil, info, okHandler); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Wanted code %v, got %v", codes.ResourceExhausted, status.Code(err))
+	}
+	// Other tenants are not affected.
+	if _, err := e.unaryInterceptor(withAPIKey("bob-key"), nil, info, okHandler); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	// A request is refilled every 20 seconds.
+	now = now.Add(20 * time.Second)
+	if _, err := e.unaryInterceptor(withAPIKey("alice-key"), nil, info, okHandler); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestQuotaEnforcer_MaxDutiesPubkeys(t *testing.T) {
+	e := newQuotaEnforcer([]*Quota{{Name: "alice", APIKey: "secret", MaxDutiesPubkeys: 2}})
+	info := &grpc.UnaryServerInfo{}
+
+	req := &pb.AssignmentRequest{PublicKeys: [][]byte{{1}, {2}}}
+	if _, err := e.unaryInterceptor(withAPIKey("secret"), req, info, okHandler); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	req.PublicKeys = append(req.PublicKeys, []byte{3})
+	if _, err := e.unaryInterceptor(withAPIKey("secret"), req, info, okHandler); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Wanted code %v, got %v", codes.PermissionDenied, status.Code(err))
+	}
+}
+
+func TestQuotaEnforcer_MaxStreams(t *testing.T) {
+	e := newQuotaEnforcer([]*Quota{{Name: "alice", APIKey: "secret", MaxStreams: 1}})
+	stream := &mockServerStream{ctx: withAPIKey("secret")}
+	info := &grpc.StreamServerInfo{}
+
+	opened := make(chan struct{})
+	done := make(chan struct{})
+	errs := make(chan error, 1)
+	go func() {
+		errs <- e.streamInterceptor(nil, stream, info, func(_ interface{}, _ grpc.ServerStream) error {
+			close(opened)
+			<-done
+			return nil
+		})
+	}()
+	<-opened
+
+	noop := func(_ interface{}, _ grpc.ServerStream) error { return nil }
+	if err := e.streamInterceptor(nil, stream, info, noop); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Wanted code %v, got %v", codes.ResourceExhausted, status.Code(err))
+	}
+	close(done)
+	if err := <-errs; err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// The stream was released once closed.
+	if err := e.streamInterceptor(nil, stream, info, noop); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestLoadQuotas(t *testing.T) {
+	dir, err := ioutil.TempDir("", "quotas")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "quotas.json")
+	enc := []byte(`[{"name": "alice", "api_key": "secret", "requests_per_minute": 600, "max_streams": 2, "max_duties_pubkeys": 64}]`)
+	if err := ioutil.WriteFile(path, enc, 0600); err != nil {
+		t.Fatal(err)
+	}
+	quotas, err := LoadQuotas(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Quota{Name: "alice", APIKey: "secret", RequestsPerMinute: 600, MaxStreams: 2, MaxDutiesPubkeys: 64}
+	if len(quotas) != 1 || *quotas[0] != *want {
+		t.Errorf("Wanted %v, got %v", want, quotas)
+	}
+
+	enc = []byte(`[{"name": "alice", "api_key": "secret"}, {"name": "bob", "api_key": "secret"}]`)
+	if err := ioutil.WriteFile(path, enc, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadQuotas(path); err == nil {
+		t.Error("Expected an error for a reused API key")
+	}
+}
@@ -0,0 +1,107 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamFinalizedChain streams the canonical blocks up to the finalized checkpoint to a replica,
+// from the requested slot, and the finalized state every time finality advances by the requested
+// number of epochs. The finalized checkpoint is checked for progress once per slot.
+func (bs *BeaconServer) StreamFinalizedChain(req *pb.FinalizedChainRequest, stream pb.BeaconService_StreamFinalizedChainServer) error {
+	if !bs.replicationEnabled {
+		return status.Error(codes.PermissionDenied, "replication is not enabled on this node")
+	}
+	// TODO(3045): Use the db.Database interface only.
+	beaconDB, ok := bs.beaconDB.(*db.BeaconDB)
+	if !ok {
+		return status.Error(codes.Unimplemented, "replication is only supported with the legacy database")
+	}
+	r := &chainReplicator{
+		beaconDB:      beaconDB,
+		stream:        stream,
+		nextSlot:      req.FromSlot,
+		stateInterval: req.StateIntervalEpochs,
+	}
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		if err := r.sendFinalized(stream.Context()); err != nil {
+			return err
+		}
+		select {
+		case <-ticker.C:
+		case <-stream.Context().Done():
+			return errors.New("stream context closed, exiting goroutine")
+		case <-bs.ctx.Done():
+			return errors.New("rpc context closed, exiting goroutine")
+		}
+	}
+}
+
+// chainReplicator tracks what was sent to a replica on its stream.
+type chainReplicator struct {
+	beaconDB       *db.BeaconDB
+	stream         pb.BeaconService_StreamFinalizedChainServer
+	nextSlot       uint64
+	stateInterval  uint64
+	sentState      bool
+	lastStateEpoch uint64
+}
+
+// sendFinalized sends the canonical blocks up to the finalized block the replica doesn't have
+// yet, then the finalized state if finality advanced enough since the last one sent.
+func (r *chainReplicator) sendFinalized(ctx context.Context) error {
+	finalizedBlock, err := r.beaconDB.FinalizedBlock()
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not retrieve finalized block: %v", err)
+	}
+	for ; r.nextSlot <= finalizedBlock.Slot; r.nextSlot++ {
+		block, err := r.beaconDB.CanonicalBlockBySlot(ctx, r.nextSlot)
+		if err != nil {
+			return status.Errorf(codes.Internal, "could not retrieve block at slot %d: %v", r.nextSlot, err)
+		}
+		// Skipped slot.
+		if block == nil {
+			continue
+		}
+		if err := r.stream.Send(&pb.FinalizedChainUpdate{
+			Block:         block,
+			FinalizedSlot: finalizedBlock.Slot,
+		}); err != nil {
+			return err
+		}
+	}
+
+	epoch := helpers.SlotToEpoch(finalizedBlock.Slot)
+	if r.sentState && (epoch <= r.lastStateEpoch || epoch-r.lastStateEpoch < r.stateInterval) {
+		return nil
+	}
+	finalizedState, err := r.beaconDB.FinalizedState()
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not retrieve finalized state: %v", err)
+	}
+	enc, err := proto.Marshal(finalizedState)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not encode finalized state: %v", err)
+	}
+	if err := r.stream.Send(&pb.FinalizedChainUpdate{
+		Block:         finalizedBlock,
+		State:         enc,
+		FinalizedSlot: finalizedBlock.Slot,
+	}); err != nil {
+		return err
+	}
+	r.sentState = true
+	r.lastStateEpoch = epoch
+	return nil
+}
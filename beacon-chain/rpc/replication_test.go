@@ -0,0 +1,119 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockFinalizedChainStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*pb.FinalizedChainUpdate
+}
+
+func (m *mockFinalizedChainStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockFinalizedChainStream) Send(update *pb.FinalizedChainUpdate) error {
+	m.sent = append(m.sent, update)
+	return nil
+}
+
+func TestStreamFinalizedChain_RequiresReplicationEnabled(t *testing.T) {
+	bs := &BeaconServer{}
+	stream := &mockFinalizedChainStream{ctx: context.Background()}
+	err := bs.StreamFinalizedChain(&pb.FinalizedChainRequest{}, stream)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Wanted code %v, got %v", codes.PermissionDenied, status.Code(err))
+	}
+}
+
+func TestChainReplicator_SendsFinalizedBlocksAndStates(t *testing.T) {
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	// Slot 2 is skipped, slot 5 is not finalized yet.
+	for _, slot := range []uint64{0, 1, 3, 5} {
+		block := &ethpb.BeaconBlock{Slot: slot}
+		if err := db.SaveBlockDeprecated(block); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateChainHead(ctx, block, &pbp2p.BeaconState{Slot: slot}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	finalizedState := &pbp2p.BeaconState{Slot: 3}
+	if err := db.SaveFinalizedBlock(&ethpb.BeaconBlock{Slot: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveFinalizedState(finalizedState); err != nil {
+		t.Fatal(err)
+	}
+
+	stream := &mockFinalizedChainStream{ctx: ctx}
+	r := &chainReplicator{
+		beaconDB:      db,
+		stream:        stream,
+		nextSlot:      1,
+		stateInterval: 2,
+	}
+	if err := r.sendFinalized(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(stream.sent) != 3 {
+		t.Fatalf("Wanted 2 blocks and the finalized state, got %d updates", len(stream.sent))
+	}
+	for i, slot := range []uint64{1, 3} {
+		if stream.sent[i].Block.Slot != slot || stream.sent[i].State != nil {
+			t.Errorf("Wanted block at slot %d without state, got %v", slot, stream.sent[i])
+		}
+	}
+	for _, update := range stream.sent {
+		if update.FinalizedSlot != 3 {
+			t.Errorf("Wanted every update to carry the finalized slot 3, got %d", update.FinalizedSlot)
+		}
+	}
+	replicated := &pbp2p.BeaconState{}
+	if err := proto.Unmarshal(stream.sent[2].State, replicated); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(replicated, finalizedState) {
+		t.Errorf("Wanted finalized state %v, got %v", finalizedState, replicated)
+	}
+
+	// Finality advancing by less than the interval sends the blocks, but not the state.
+	if err := db.SaveFinalizedBlock(&ethpb.BeaconBlock{Slot: params.BeaconConfig().SlotsPerEpoch}); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.sendFinalized(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(stream.sent) != 4 || stream.sent[3].Block.Slot != 5 {
+		t.Fatalf("Wanted the block at slot 5 only, got %v", stream.sent[3:])
+	}
+
+	// Once finality advanced by the interval, the state is sent again.
+	finalizedSlot := 2 * params.BeaconConfig().SlotsPerEpoch
+	if err := db.SaveFinalizedBlock(&ethpb.BeaconBlock{Slot: finalizedSlot}); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.sendFinalized(ctx); err != nil {
+		t.Fatal(err)
+	}
+	last := stream.sent[len(stream.sent)-1]
+	if last.Block.Slot != finalizedSlot || last.State == nil {
+		t.Errorf("Wanted the finalized state at slot %d, got %v", finalizedSlot, last)
+	}
+}
@@ -14,8 +14,11 @@ import (
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/depositmonitor"
 	blockchain "github.com/prysmaticlabs/prysm/beacon-chain/deprecated-blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoiceaudit"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
@@ -43,6 +46,7 @@ type chainService interface {
 	blockchain.BlockReceiver
 	blockchain.ForkChoice
 	blockchain.TargetsFetcher
+	ForkChoiceAudit(count int) []*forkchoiceaudit.Decision
 }
 
 type operationService interface {
@@ -66,6 +70,8 @@ type powChainService interface {
 	ChainStartDepositHashes() ([][]byte, error)
 	ChainStartDeposits() []*ethpb.Deposit
 	ChainStartETH1Data() *ethpb.Eth1Data
+	ActiveValidatorCount() uint64
+	EstimatedGenesisTime() uint64
 }
 
 // Service defining an RPC server for a beacon node.
@@ -86,19 +92,45 @@ type Service struct {
 	incomingAttestation chan *ethpb.Attestation
 	credentialError     error
 	p2p                 p2p.Broadcaster
+	peerEvents          p2p.PeerEventProvider
+	recentlyProcessed   *cache.RecentlyProcessedCache
+	forkMonitor         *cache.ForkMonitor
+	peerForks           sync.PeerForkReporter
+	depositCache        *depositcache.DepositCache
+	depositMonitor      *depositmonitor.Service
+	quotas              []*Quota
+	replicationEnabled  bool
+	readOnly            bool
+	disableValidatorAPI bool
 }
 
 // Config options for the beacon node RPC server.
 type Config struct {
-	Port             string
-	CertFlag         string
-	KeyFlag          string
-	BeaconDB         db.Database
-	ChainService     chainService
-	POWChainService  powChainService
-	OperationService operationService
-	SyncService      sync.Checker
-	Broadcaster      p2p.Broadcaster
+	Port              string
+	CertFlag          string
+	KeyFlag           string
+	BeaconDB          db.Database
+	ChainService      chainService
+	POWChainService   powChainService
+	OperationService  operationService
+	SyncService       sync.Checker
+	Broadcaster       p2p.Broadcaster
+	PeerEvents        p2p.PeerEventProvider
+	PeerForks         sync.PeerForkReporter
+	RecentlyProcessed *cache.RecentlyProcessedCache
+	ForkMonitor       *cache.ForkMonitor
+	DepositCache      *depositcache.DepositCache
+	DepositMonitor    *depositmonitor.Service
+	// Quotas of the API keys clients must authenticate with, none are required when empty.
+	Quotas []*Quota
+	// EnableReplication lets read-only replicas stream the finalized chain from the node.
+	EnableReplication bool
+	// ReadOnly only serves the Node and BeaconChain services, for replicas which neither sync
+	// nor run the chain and validator services.
+	ReadOnly bool
+	// DisableValidatorAPI does not serve the services used by validator clients, for nodes
+	// which only relay and serve the chain.
+	DisableValidatorAPI bool
 }
 
 // NewRPCService creates a new instance of a struct implementing the BeaconServiceServer
@@ -110,10 +142,20 @@ func NewRPCService(ctx context.Context, cfg *Config) *Service {
 		cancel:              cancel,
 		beaconDB:            cfg.BeaconDB,
 		p2p:                 cfg.Broadcaster,
+		peerEvents:          cfg.PeerEvents,
 		chainService:        cfg.ChainService,
 		powChainService:     cfg.POWChainService,
 		operationService:    cfg.OperationService,
 		syncService:         cfg.SyncService,
+		recentlyProcessed:   cfg.RecentlyProcessed,
+		forkMonitor:         cfg.ForkMonitor,
+		peerForks:           cfg.PeerForks,
+		depositCache:        cfg.DepositCache,
+		depositMonitor:      cfg.DepositMonitor,
+		quotas:              cfg.Quotas,
+		replicationEnabled:  cfg.EnableReplication,
+		readOnly:            cfg.ReadOnly,
+		disableValidatorAPI: cfg.DisableValidatorAPI,
 		port:                cfg.Port,
 		withCert:            cfg.CertFlag,
 		withKey:             cfg.KeyFlag,
@@ -132,16 +174,24 @@ func (s *Service) Start() {
 	s.listener = lis
 	log.WithField("port", s.port).Info("Listening on port")
 
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		recovery.StreamServerInterceptor(),
+		grpc_prometheus.StreamServerInterceptor,
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		recovery.UnaryServerInterceptor(),
+		grpc_prometheus.UnaryServerInterceptor,
+	}
+	if len(s.quotas) > 0 {
+		enforcer := newQuotaEnforcer(s.quotas)
+		streamInterceptors = append(streamInterceptors, enforcer.streamInterceptor)
+		unaryInterceptors = append(unaryInterceptors, enforcer.unaryInterceptor)
+		log.WithField("apiKeys", len(s.quotas)).Info("Enforcing RPC quotas per API key")
+	}
 	opts := []grpc.ServerOption{
 		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
-		grpc.StreamInterceptor(middleware.ChainStreamServer(
-			recovery.StreamServerInterceptor(),
-			grpc_prometheus.StreamServerInterceptor,
-		)),
-		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
-			recovery.UnaryServerInterceptor(),
-			grpc_prometheus.UnaryServerInterceptor,
-		)),
+		grpc.StreamInterceptor(middleware.ChainStreamServer(streamInterceptors...)),
+		grpc.UnaryInterceptor(middleware.ChainUnaryServer(unaryInterceptors...)),
 	}
 	// TODO(#791): Utilize a certificate for secure connections
 	// between beacon nodes and validator clients.
@@ -157,6 +207,12 @@ func (s *Service) Start() {
 	}
 	s.grpcServer = grpc.NewServer(opts...)
 
+	serveValidators := !s.readOnly && !s.disableValidatorAPI
+	var prefetcher *statePrefetcher
+	if serveValidators {
+		prefetcher = newStatePrefetcher(s.beaconDB)
+		go prefetcher.run(s.ctx)
+	}
 	beaconServer := &BeaconServer{
 		beaconDB:            s.beaconDB,
 		ctx:                 s.ctx,
@@ -167,6 +223,7 @@ func (s *Service) Start() {
 		incomingAttestation: s.incomingAttestation,
 		canonicalStateChan:  s.canonicalStateChan,
 		chainStartChan:      make(chan time.Time, 1),
+		replicationEnabled:  s.replicationEnabled,
 	}
 	proposerServer := &ProposerServer{
 		beaconDB:           s.beaconDB,
@@ -174,12 +231,17 @@ func (s *Service) Start() {
 		powChainService:    s.powChainService,
 		operationService:   s.operationService,
 		canonicalStateChan: s.canonicalStateChan,
+		depositCache:       s.depositCache,
+		recentlyProcessed:  s.recentlyProcessed,
+		prefetcher:         prefetcher,
 	}
 	attesterServer := &AttesterServer{
-		beaconDB:         s.beaconDB,
-		operationService: s.operationService,
-		p2p:              s.p2p,
-		cache:            cache.NewAttestationCache(),
+		beaconDB:          s.beaconDB,
+		operationService:  s.operationService,
+		p2p:               s.p2p,
+		cache:             cache.NewAttestationCache(),
+		recentlyProcessed: s.recentlyProcessed,
+		prefetcher:        prefetcher,
 	}
 	validatorServer := &ValidatorServer{
 		ctx:                s.ctx,
@@ -187,20 +249,27 @@ func (s *Service) Start() {
 		chainService:       s.chainService,
 		canonicalStateChan: s.canonicalStateChan,
 		powChainService:    s.powChainService,
+		prefetcher:         prefetcher,
 	}
 	nodeServer := &NodeServer{
 		beaconDB:    s.beaconDB,
 		server:      s.grpcServer,
 		syncChecker: s.syncService,
+		peerEvents:  s.peerEvents,
+		forkMonitor: s.forkMonitor,
+		peerForks:   s.peerForks,
 	}
 	beaconChainServer := &BeaconChainServer{
-		beaconDB: s.beaconDB,
-		pool:     s.operationService,
+		beaconDB:       s.beaconDB,
+		pool:           s.operationService,
+		depositMonitor: s.depositMonitor,
+	}
+	if serveValidators {
+		pb.RegisterBeaconServiceServer(s.grpcServer, beaconServer)
+		pb.RegisterProposerServiceServer(s.grpcServer, proposerServer)
+		pb.RegisterAttesterServiceServer(s.grpcServer, attesterServer)
+		pb.RegisterValidatorServiceServer(s.grpcServer, validatorServer)
 	}
-	pb.RegisterBeaconServiceServer(s.grpcServer, beaconServer)
-	pb.RegisterProposerServiceServer(s.grpcServer, proposerServer)
-	pb.RegisterAttesterServiceServer(s.grpcServer, attesterServer)
-	pb.RegisterValidatorServiceServer(s.grpcServer, validatorServer)
 	ethpb.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpb.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
 
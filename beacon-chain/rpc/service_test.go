@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoiceaudit"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -85,6 +86,7 @@ type mockChainService struct {
 	stateInitializedFeed *event.Feed
 	canonicalBlocks      map[uint64][]byte
 	targets              map[uint64]*pb.AttestationTarget
+	forkChoiceAudit      []*forkchoiceaudit.Decision
 }
 
 func (m *mockChainService) StateInitializedFeed() *event.Feed {
@@ -119,6 +121,10 @@ func (m *mockChainService) AttestationTargets(justifiedState *pb.BeaconState) (m
 	return m.targets, nil
 }
 
+func (m *mockChainService) ForkChoiceAudit(count int) []*forkchoiceaudit.Decision {
+	return m.forkChoiceAudit
+}
+
 func newMockChainService() *mockChainService {
 	return &mockChainService{
 		blockFeed:            new(event.Feed),
@@ -0,0 +1,206 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// statePrefetchLead is how long before the start of a duty slot the head state is advanced to it.
+const statePrefetchLead = 3 * time.Second
+
+var (
+	statePrefetchHits = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "rpc_state_prefetch_hits_total",
+		Help: "The number of duty requests served from a head state prefetched to their slot.",
+	})
+	statePrefetchMisses = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "rpc_state_prefetch_misses_total",
+		Help: "The number of duty requests which had to advance the head state to their slot.",
+	})
+	statePrefetchSaved = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "rpc_state_prefetch_saved_seconds_total",
+		Help: "The time spent processing slots saved on duty requests by prefetching the advanced head state, less the time copying it.",
+	})
+)
+
+// prefetchedState is the head state advanced to a slot before it started.
+type prefetchedState struct {
+	headRoot [32]byte
+	state    *pbp2p.BeaconState
+	// took is the time spent processing the slots up to the prefetched one.
+	took time.Duration
+}
+
+// statePrefetcher advances the head state to the slots of the duties handed out to validators a
+// few seconds before they start, so that attestation and block requests don't process the empty
+// slots, or the epoch transition, on their critical path. A prefetched state is only used as long
+// as the head it was advanced from remains the head.
+type statePrefetcher struct {
+	beaconDB db.Database
+	lock     sync.Mutex
+	duties   map[uint64]bool
+	states   map[uint64]*prefetchedState
+}
+
+func newStatePrefetcher(beaconDB db.Database) *statePrefetcher {
+	return &statePrefetcher{
+		beaconDB: beaconDB,
+		duties:   make(map[uint64]bool),
+		states:   make(map[uint64]*prefetchedState),
+	}
+}
+
+// expectDuty records a slot at which a validator attests or proposes, as the assignments are
+// requested or sent on the duty streams.
+func (p *statePrefetcher) expectDuty(slot uint64) {
+	if p == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.duties[slot] = true
+}
+
+// run prefetches the state of every upcoming duty slot until the context is canceled.
+func (p *statePrefetcher) run(ctx context.Context) {
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	for {
+		wait := slotDuration
+		head, err := p.beaconDB.HeadState(ctx)
+		if err != nil {
+			log.WithError(err).Error("Could not fetch head state to prefetch duty states")
+		} else if head != nil {
+			genesis := time.Unix(int64(head.GenesisTime), 0)
+			next := uint64(0)
+			if now := time.Now(); now.After(genesis) {
+				next = uint64(now.Sub(genesis)/slotDuration) + 1
+			}
+			wait = time.Until(genesis.Add(time.Duration(next)*slotDuration - statePrefetchLead))
+			if wait <= 0 {
+				p.prefetch(ctx, next)
+				wait = time.Until(genesis.Add(time.Duration(next) * slotDuration))
+			}
+		}
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// prefetch advances the head state to the slot if a duty is expected at it, and forgets the
+// duties and states of past slots.
+func (p *statePrefetcher) prefetch(ctx context.Context, slot uint64) {
+	p.lock.Lock()
+	for s := range p.duties {
+		if s < slot {
+			delete(p.duties, s)
+		}
+	}
+	for s := range p.states {
+		if s < slot {
+			delete(p.states, s)
+		}
+	}
+	expected := p.duties[slot]
+	p.lock.Unlock()
+	if !expected {
+		return
+	}
+
+	headRoot, headState, err := p.headState(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not fetch head to prefetch duty state")
+		return
+	}
+	if headState.Slot >= slot {
+		return
+	}
+	start := time.Now()
+	headState, err = state.ProcessSlots(ctx, headState, slot)
+	if err != nil {
+		log.WithError(err).Errorf("Could not prefetch state of slot %d", slot)
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.states[slot] = &prefetchedState{
+		headRoot: headRoot,
+		state:    headState,
+		took:     time.Since(start),
+	}
+}
+
+// headState returns the head state along with the signing root of its latest block header,
+// which identifies the head block. The root is derived from the state itself, so that a head
+// change between two database reads can't tag a state with the root of another head.
+func (p *statePrefetcher) headState(ctx context.Context) ([32]byte, *pbp2p.BeaconState, error) {
+	headState, err := p.beaconDB.HeadState(ctx)
+	if err != nil {
+		return [32]byte{}, nil, errors.Wrap(err, "could not retrieve head state")
+	}
+	headRoot, err := ssz.SigningRoot(headState.LatestBlockHeader)
+	if err != nil {
+		return [32]byte{}, nil, errors.Wrap(err, "could not hash latest block header")
+	}
+	return headRoot, headState, nil
+}
+
+// advancedHeadState returns the head state advanced to the given slot if it is behind, from the
+// prefetched state of the slot if the head did not change since it was prefetched. The returned
+// state may be modified by the caller.
+func (p *statePrefetcher) advancedHeadState(ctx context.Context, beaconDB db.Database, slot uint64) (*pbp2p.BeaconState, error) {
+	if p == nil {
+		headState, err := beaconDB.HeadState(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve head state")
+		}
+		return advanceState(ctx, headState, slot)
+	}
+	headRoot, headState, err := p.headState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if headState.Slot >= slot {
+		return headState, nil
+	}
+	p.lock.Lock()
+	prefetched, ok := p.states[slot]
+	p.lock.Unlock()
+	if ok && prefetched.headRoot == headRoot {
+		start := time.Now()
+		advanced := proto.Clone(prefetched.state).(*pbp2p.BeaconState)
+		statePrefetchHits.Inc()
+		// The head state is read either way, only the slot processing is saved.
+		if saved := prefetched.took - time.Since(start); saved > 0 {
+			statePrefetchSaved.Add(saved.Seconds())
+		}
+		return advanced, nil
+	}
+	statePrefetchMisses.Inc()
+	return advanceState(ctx, headState, slot)
+}
+
+// advanceState processes the empty slots of a state up to the given slot, if it is behind.
+func advanceState(ctx context.Context, beaconState *pbp2p.BeaconState, slot uint64) (*pbp2p.BeaconState, error) {
+	if beaconState.Slot >= slot {
+		return beaconState, nil
+	}
+	beaconState, err := state.ProcessSlots(ctx, beaconState, slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not process slots up to %d", slot)
+	}
+	return beaconState, nil
+}
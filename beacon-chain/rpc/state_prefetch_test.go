@@ -0,0 +1,87 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	blk "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestStatePrefetcher_AdvancedHeadState(t *testing.T) {
+	helpers.ClearAllCaches()
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	deposits, _ := testutil.SetupInitialDeposits(t, params.BeaconConfig().MinGenesisActiveValidatorCount/16)
+	genesisState, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := blk.NewGenesisBlock([]byte{})
+	if err := db.SaveBlock(ctx, genesis); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, genesis, genesisState); err != nil {
+		t.Fatal(err)
+	}
+
+	p := newStatePrefetcher(db)
+	// No state is prefetched for slots without duties.
+	p.prefetch(ctx, 4)
+	if len(p.states) != 0 {
+		t.Fatalf("Wanted no prefetched state, got %d", len(p.states))
+	}
+	p.expectDuty(5)
+	p.prefetch(ctx, 5)
+	if len(p.states) != 1 {
+		t.Fatalf("Wanted 1 prefetched state, got %d", len(p.states))
+	}
+
+	s, err := p.advancedHeadState(ctx, db, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Slot != 5 {
+		t.Errorf("Wanted state at slot 5, got %d", s.Slot)
+	}
+	// Callers may modify the returned state without affecting the prefetched one.
+	s.Slot = 6
+	if p.states[5].state.Slot != 5 {
+		t.Errorf("Wanted prefetched state at slot 5, got %d", p.states[5].state.Slot)
+	}
+
+	// A prefetched state is not used once the head changes.
+	head := &ethpb.BeaconBlock{Slot: 1, ParentRoot: []byte{'a'}}
+	if err := db.SaveBlock(ctx, head); err != nil {
+		t.Fatal(err)
+	}
+	headState := proto.Clone(genesisState).(*pbp2p.BeaconState)
+	headState.Slot = 1
+	headState.LatestBlockHeader = &ethpb.BeaconBlockHeader{Slot: 1, ParentRoot: []byte{'a'}}
+	headState.Balances[0]++
+	if err := db.UpdateChainHead(ctx, head, headState); err != nil {
+		t.Fatal(err)
+	}
+	s, err = p.advancedHeadState(ctx, db, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Slot != 5 || s.Balances[0] != headState.Balances[0] {
+		t.Errorf("Wanted the new head state advanced to slot 5, got slot %d", s.Slot)
+	}
+
+	// Duties and states of past slots are forgotten.
+	p.prefetch(ctx, 6)
+	if len(p.states) != 0 || len(p.duties) != 0 {
+		t.Errorf("Wanted past duties and states forgotten, got %d duties and %d states", len(p.duties), len(p.states))
+	}
+}
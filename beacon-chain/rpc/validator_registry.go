@@ -0,0 +1,77 @@
+package rpc
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamValidatorRegistry streams the validator registry at the requested epoch, one validator per
+// message in index order. The registry of a past epoch is read from the earliest historical state
+// saved in the epoch, and the request fails with NotFound if no such state is saved. The registry
+// of a future epoch is not known yet. The requested epoch is set in every entry.
+func (bs *BeaconChainServer) StreamValidatorRegistry(req *ethpb.ValidatorRegistryRequest, stream ethpb.BeaconChain_StreamValidatorRegistryServer) error {
+	ctx := stream.Context()
+	s, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not retrieve head state: %v", err)
+	}
+	if req.Epoch > helpers.CurrentEpoch(s) {
+		return status.Errorf(codes.InvalidArgument, "cannot retrieve the registry of epoch %d, the current epoch is %d", req.Epoch, helpers.CurrentEpoch(s))
+	}
+	if req.Epoch < helpers.CurrentEpoch(s) {
+		// TODO(3045): Use the db.Database interface only.
+		beaconDB, ok := bs.beaconDB.(*db.BeaconDB)
+		if !ok {
+			return status.Error(codes.Unimplemented, "past registries are only archived by the legacy database")
+		}
+		// The earliest state whose committees are those of the next epoch is the earliest state
+		// of the epoch.
+		historical, err := beaconDB.HistoricalStateForEpoch(ctx, req.Epoch+1)
+		if err != nil {
+			return status.Errorf(codes.Internal, "could not retrieve historical state: %v", err)
+		}
+		// The earliest state saved may be of a later epoch if the epoch was skipped or its
+		// states were not archived.
+		if historical == nil || helpers.CurrentEpoch(historical) != req.Epoch {
+			return status.Errorf(codes.NotFound, "no state archived for epoch %d", req.Epoch)
+		}
+		s = historical
+	}
+
+	epoch := req.Epoch
+	for i, v := range s.Validators {
+		if ctx.Err() != nil {
+			return status.Error(codes.Canceled, "stream context closed")
+		}
+		if err := stream.Send(&ethpb.ValidatorRegistryEntry{
+			Epoch:     epoch,
+			Index:     uint64(i),
+			Validator: v,
+			Balance:   s.Balances[i],
+			Status:    registryStatus(v, epoch),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// registryStatus returns the status of a validator at the given epoch, as exported with the
+// validator registry.
+func registryStatus(v *ethpb.Validator, epoch uint64) string {
+	switch {
+	case epoch < v.ActivationEpoch:
+		return "pending"
+	case v.Slashed && epoch < v.WithdrawableEpoch:
+		return "slashed"
+	case epoch < v.ExitEpoch:
+		return "active"
+	case epoch < v.WithdrawableEpoch:
+		return "exited"
+	default:
+		return "withdrawable"
+	}
+}
@@ -0,0 +1,126 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockValidatorRegistryStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*ethpb.ValidatorRegistryEntry
+}
+
+func (m *mockValidatorRegistryStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockValidatorRegistryStream) Send(entry *ethpb.ValidatorRegistryEntry) error {
+	m.sent = append(m.sent, entry)
+	return nil
+}
+
+func TestStreamValidatorRegistry_FromArchivedState(t *testing.T) {
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	archived := &pbp2p.BeaconState{
+		Slot: params.BeaconConfig().SlotsPerEpoch,
+		Validators: []*ethpb.Validator{
+			{PublicKey: []byte{'a'}, ActivationEpoch: 0, ExitEpoch: farFuture, WithdrawableEpoch: farFuture},
+			{PublicKey: []byte{'b'}, ActivationEpoch: 2, ExitEpoch: farFuture, WithdrawableEpoch: farFuture},
+		},
+		Balances: []uint64{32, 31},
+	}
+	saveCanonicalHistoricalState(t, db, archived)
+	headState := proto.Clone(archived).(*pbp2p.BeaconState)
+	headState.Slot = 3 * params.BeaconConfig().SlotsPerEpoch
+	headState.Balances = []uint64{33, 32}
+	if err := db.SaveStateDeprecated(ctx, headState); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconChainServer{beaconDB: db}
+	stream := &mockValidatorRegistryStream{ctx: ctx}
+	if err := bs.StreamValidatorRegistry(&ethpb.ValidatorRegistryRequest{Epoch: 1}, stream); err != nil {
+		t.Fatal(err)
+	}
+	if len(stream.sent) != 2 {
+		t.Fatalf("Wanted 2 entries, got %d", len(stream.sent))
+	}
+	for i, want := range []struct {
+		balance uint64
+		status  string
+	}{{32, "active"}, {31, "pending"}} {
+		entry := stream.sent[i]
+		if entry.Epoch != 1 || entry.Index != uint64(i) || entry.Balance != want.balance || entry.Status != want.status {
+			t.Errorf("Wanted entry %d at epoch 1 with balance %d and status %s, got %v", i, want.balance, want.status, entry)
+		}
+	}
+
+	stream = &mockValidatorRegistryStream{ctx: ctx}
+	if err := bs.StreamValidatorRegistry(&ethpb.ValidatorRegistryRequest{Epoch: 3}, stream); err != nil {
+		t.Fatal(err)
+	}
+	if entry := stream.sent[1]; entry.Epoch != 3 || entry.Balance != 32 || entry.Status != "active" {
+		t.Errorf("Wanted head state entry at epoch 3, got %v", entry)
+	}
+}
+
+func TestStreamValidatorRegistry_UnknownEpoch(t *testing.T) {
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	archived := &pbp2p.BeaconState{
+		Slot:       3 * params.BeaconConfig().SlotsPerEpoch,
+		Validators: []*ethpb.Validator{{PublicKey: []byte{'a'}}},
+		Balances:   []uint64{32},
+	}
+	saveCanonicalHistoricalState(t, db, archived)
+	headState := proto.Clone(archived).(*pbp2p.BeaconState)
+	headState.Slot = 5 * params.BeaconConfig().SlotsPerEpoch
+	if err := db.SaveStateDeprecated(ctx, headState); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconChainServer{beaconDB: db}
+	for epoch, want := range map[uint64]codes.Code{
+		// The earliest state archived from epoch 1 on is of epoch 3.
+		1: codes.NotFound,
+		6: codes.InvalidArgument,
+	} {
+		stream := &mockValidatorRegistryStream{ctx: ctx}
+		err := bs.StreamValidatorRegistry(&ethpb.ValidatorRegistryRequest{Epoch: epoch}, stream)
+		if status.Code(err) != want {
+			t.Errorf("Wanted code %v for epoch %d, got %v", want, epoch, err)
+		}
+		if len(stream.sent) != 0 {
+			t.Errorf("Wanted no entries for epoch %d, got %d", epoch, len(stream.sent))
+		}
+	}
+}
+
+func TestRegistryStatus(t *testing.T) {
+	v := &ethpb.Validator{ActivationEpoch: 2, ExitEpoch: 5, WithdrawableEpoch: 10}
+	for epoch, want := range map[uint64]string{1: "pending", 2: "active", 5: "exited", 10: "withdrawable"} {
+		if got := registryStatus(v, epoch); got != want {
+			t.Errorf("Wanted status %s at epoch %d, got %s", want, epoch, got)
+		}
+	}
+	v.Slashed = true
+	if got := registryStatus(v, 5); got != "slashed" {
+		t.Errorf("Wanted slashed status, got %s", got)
+	}
+}
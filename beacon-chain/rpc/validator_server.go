@@ -5,9 +5,13 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sort"
+	"sync"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
@@ -18,6 +22,8 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // ValidatorServer defines a server implementation of the gRPC Validator service,
@@ -31,6 +37,8 @@ type ValidatorServer struct {
 	canonicalStateChan chan *pbp2p.BeaconState
 	powChainService    powChainService
 	depositCache       *depositcache.DepositCache
+	prefetcher         *statePrefetcher
+	dutyStates         dutyStates
 }
 
 // WaitForActivation checks if a validator public key exists in the active validator registry of the current
@@ -139,10 +147,36 @@ func (vs *ValidatorServer) CommitteeAssignment(ctx context.Context, req *pb.Assi
 		}
 	}
 
+	return vs.committeeAssignments(ctx, s, req.EpochStart, req.PublicKeys)
+}
+
+// committeeAssignments returns the assignments of the validators in an epoch, from a state
+// advanced to the start of that epoch. The state is not modified.
+func (vs *ValidatorServer) committeeAssignments(
+	ctx context.Context,
+	beaconState *pbp2p.BeaconState,
+	epoch uint64,
+	pubkeys [][]byte,
+) (*pb.AssignmentResponse, error) {
+	// Only the slot of the copy is modified by the assignment lookups, so the validators and
+	// other fields are shared.
+	copied := *beaconState
+	s := &copied
+
+	nextEpochProposers, err := helpers.ProposerIndices(s, epoch+1)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get next epoch proposers")
+	}
+	nextEpochStartSlot := helpers.StartSlot(epoch + 1)
+	nextEpochProposerSlots := make(map[uint64][]uint64)
+	for i, proposer := range nextEpochProposers {
+		nextEpochProposerSlots[proposer] = append(nextEpochProposerSlots[proposer], nextEpochStartSlot+uint64(i))
+	}
+
 	validatorIndexMap := stateutils.ValidatorIndexMap(s)
 	var assignments []*pb.AssignmentResponse_ValidatorAssignment
 
-	for _, pk := range req.PublicKeys {
+	for _, pk := range pubkeys {
 		if ctx.Err() != nil {
 			return nil, ctx.Err()
 		}
@@ -156,10 +190,15 @@ func (vs *ValidatorServer) CommitteeAssignment(ctx context.Context, req *pb.Assi
 		v := s.Validators[idx]
 		// Update validator assignment when it is active
 		if ok && helpers.IsActiveValidator(v, helpers.CurrentEpoch(s)) {
-			assignment, err = vs.assignment(pk, s, req.EpochStart)
+			assignment, err = vs.assignment(pk, s, epoch)
 			if err != nil {
 				return nil, err
 			}
+			assignment.NextEpochProposerSlots = nextEpochProposerSlots[uint64(idx)]
+			vs.prefetcher.expectDuty(assignment.Slot)
+			for _, slot := range assignment.NextEpochProposerSlots {
+				vs.prefetcher.expectDuty(slot)
+			}
 		} else if ok {
 			// Update inactive validator's status
 			status := vs.lookupValidatorStatus(uint64(idx), s)
@@ -170,9 +209,98 @@ func (vs *ValidatorServer) CommitteeAssignment(ctx context.Context, req *pb.Assi
 
 	return &pb.AssignmentResponse{
 		ValidatorAssignment: assignments,
+		Epoch:               epoch,
 	}, nil
 }
 
+// StreamDuties sends the committee assignments of the requested validators for the current and
+// the next epoch, then sends them again at the start of every new epoch, until the stream is
+// closed. Validators thus know their duties two epochs ahead, and the proposer slots of the epoch
+// after the assignments let them prepare their proposals even earlier. The assignments of the
+// next epoch are computed with empty slots from the head, and are final once resent as the
+// assignments of the current epoch. The states the assignments are computed from are advanced
+// once per epoch for every stream, see dutyStates.
+func (vs *ValidatorServer) StreamDuties(req *pb.AssignmentRequest, stream pb.ValidatorService_StreamDutiesServer) error {
+	sent := false
+	var lastEpoch uint64
+	for {
+		head, err := vs.beaconDB.HeadState(stream.Context())
+		if err != nil {
+			return errors.Wrap(err, "could not fetch beacon state")
+		}
+		epoch := currentEpochAt(head.GenesisTime, time.Now())
+		if !sent || epoch != lastEpoch {
+			states, err := vs.dutyStates.get(stream.Context(), head, epoch)
+			if err != nil {
+				return err
+			}
+			for i, s := range states {
+				res, err := vs.committeeAssignments(stream.Context(), s, epoch+uint64(i), req.PublicKeys)
+				if err != nil {
+					return err
+				}
+				if err := stream.Send(res); err != nil {
+					return err
+				}
+			}
+			sent = true
+			lastEpoch = epoch
+		}
+
+		select {
+		case <-time.After(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second):
+		case <-stream.Context().Done():
+			return errors.New("stream context closed, exiting goroutine")
+		case <-vs.ctx.Done():
+			return errors.New("rpc context closed, exiting goroutine")
+		}
+	}
+}
+
+// dutyStates holds the head state advanced to the start of the current and the next epoch of
+// the wall clock, so that the duty streams of every validator client share a single state
+// transition per epoch. The zero value is ready to use.
+type dutyStates struct {
+	lock   sync.Mutex
+	epoch  uint64
+	states []*pbp2p.BeaconState
+}
+
+// get returns the states at the start of the given epoch and of the next one, advancing the head
+// state the first time the epoch is requested. The returned states must not be modified.
+func (d *dutyStates) get(ctx context.Context, head *pbp2p.BeaconState, epoch uint64) ([]*pbp2p.BeaconState, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.states != nil && d.epoch == epoch {
+		return d.states, nil
+	}
+	s := head
+	states := make([]*pbp2p.BeaconState, 0, 2)
+	for _, e := range []uint64{epoch, epoch + 1} {
+		if epochStartSlot := helpers.StartSlot(e); s.Slot < epochStartSlot {
+			var err error
+			s, err = state.ProcessSlots(ctx, proto.Clone(s).(*pbp2p.BeaconState), epochStartSlot)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not process slots up to %d", epochStartSlot)
+			}
+		}
+		states = append(states, s)
+	}
+	d.epoch = epoch
+	d.states = states
+	return states, nil
+}
+
+// currentEpochAt returns the epoch of the wall clock at the given time for a chain started at
+// genesisTime, or 0 before genesis.
+func currentEpochAt(genesisTime uint64, now time.Time) uint64 {
+	if now.Unix() < int64(genesisTime) {
+		return 0
+	}
+	slot := (uint64(now.Unix()) - genesisTime) / params.BeaconConfig().SecondsPerSlot
+	return helpers.SlotToEpoch(slot)
+}
+
 func (vs *ValidatorServer) assignment(
 	pubkey []byte,
 	beaconState *pbp2p.BeaconState,
@@ -197,14 +325,115 @@ func (vs *ValidatorServer) assignment(
 	if err != nil {
 		return nil, err
 	}
+	committeeIndex, err := helpers.CommitteeIndex(beaconState, epochStart, shard)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get committee index")
+	}
 	status := vs.lookupValidatorStatus(idx, beaconState)
 	return &pb.AssignmentResponse_ValidatorAssignment{
-		Committee:  committee,
-		Shard:      shard,
-		Slot:       slot,
-		IsProposer: isProposer,
-		PublicKey:  pubkey,
-		Status:     status,
+		Committee:      committee,
+		Shard:          shard,
+		Slot:           slot,
+		IsProposer:     isProposer,
+		PublicKey:      pubkey,
+		Status:         status,
+		CommitteeIndex: committeeIndex,
+	}, nil
+}
+
+// CommitteeAssignmentProof returns the inputs of the committee shuffling which placed a
+// validator in its committee for the requested epoch, at most the next epoch of the head
+// state, so that an external auditor can verify the assignment without trusting the node. Given the seed and the active
+// validator count, compute_shuffled_index(shuffled_index) must equal active_index, and
+// the committee of committee_index, which is crosslinked to the shard at the slot,
+// spans shuffled_index in the shuffled active validator indices.
+func (vs *ValidatorServer) CommitteeAssignmentProof(
+	ctx context.Context,
+	req *pb.AssignmentProofRequest) (*pb.AssignmentProofResponse, error) {
+	s, err := vs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch beacon state")
+	}
+
+	// The shuffling of the next epoch is already determined by the head state, which the proof
+	// is computed from so that it can be checked against a state the node stored. The state is
+	// not advanced, a later shuffling depends on randao mixes which are not known yet.
+	if nextEpoch := helpers.NextEpoch(s); req.Epoch > nextEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "epoch %d is later than the next epoch %d", req.Epoch, nextEpoch)
+	}
+
+	validatorIndex, ok := stateutils.ValidatorIndexMap(s)[bytesutil.ToBytes32(req.PublicKey)]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "validator %#x not found in state", bytesutil.Trunc(req.PublicKey))
+	}
+	activeIndices, err := helpers.ActiveValidatorIndices(s, req.Epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get active validator indices")
+	}
+	activeIndex := -1
+	for i, idx := range activeIndices {
+		if idx == uint64(validatorIndex) {
+			activeIndex = i
+			break
+		}
+	}
+	if activeIndex < 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "validator %d is not active in epoch %d", validatorIndex, req.Epoch)
+	}
+
+	// The head state root is computed first as CommitteeAssignment moves the state slot to the
+	// assigned slot.
+	stateRoot, err := ssz.HashTreeRoot(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash beacon state")
+	}
+	seed, err := helpers.Seed(s, req.Epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get seed")
+	}
+	shuffledIndex, err := helpers.UnShuffledIndex(uint64(activeIndex), uint64(len(activeIndices)), seed)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unshuffle active index")
+	}
+	committeeCount, err := helpers.CommitteeCount(s, req.Epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get committee count")
+	}
+	startShard, err := helpers.StartShard(s, req.Epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get start shard")
+	}
+	// See helpers.Seed for the look ahead of the randao mix.
+	lookAheadEpoch := req.Epoch + params.BeaconConfig().EpochsPerHistoricalVector -
+		params.BeaconConfig().MinSeedLookahead - 1
+	randaoMix := helpers.RandaoMix(s, lookAheadEpoch)
+	activeIndexRoot := helpers.ActiveIndexRoot(s, req.Epoch)
+
+	committee, shard, slot, _, err := helpers.CommitteeAssignment(s, req.Epoch, uint64(validatorIndex))
+	if err != nil {
+		return nil, err
+	}
+	committeeIndex, err := helpers.CommitteeIndex(s, req.Epoch, shard)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get committee index")
+	}
+
+	return &pb.AssignmentProofResponse{
+		Epoch:                req.Epoch,
+		ValidatorIndex:       uint64(validatorIndex),
+		ActiveValidatorCount: uint64(len(activeIndices)),
+		ActiveIndex:          uint64(activeIndex),
+		ShuffledIndex:        shuffledIndex,
+		CommitteeCount:       committeeCount,
+		StartShard:           startShard,
+		CommitteeIndex:       committeeIndex,
+		Shard:                shard,
+		Slot:                 slot,
+		StateRoot:            stateRoot[:],
+		Seed:                 seed[:],
+		RandaoMix:            randaoMix,
+		ActiveIndexRoot:      activeIndexRoot,
+		Committee:            committee,
 	}, nil
 }
 
@@ -216,6 +445,7 @@ func (vs *ValidatorServer) assignment(
 //	WITHDRAWABLE - validator's deposit can be withdrawn after lock up period.
 //	EXITED - validator has exited, means the deposit has been withdrawn.
 //	EXITED_SLASHED - validator was forcefully exited due to slashing.
+//	DEPOSIT_RECEIVED - validator's deposit was seen but is not yet included in the state.
 func (vs *ValidatorServer) ValidatorStatus(
 	ctx context.Context,
 	req *pb.ValidatorIndexRequest) (*pb.ValidatorStatusResponse, error) {
@@ -293,6 +523,115 @@ func (vs *ValidatorServer) ExitedValidators(
 	return resp, nil
 }
 
+// ValidatorQueue returns the current activation and exit queue lengths and the churn
+// limit of the head state. When a public key is requested, it also returns the position
+// of the validator in the activation queue and the estimated number of epochs until it
+// is activated, and until it exits if it were to initiate its exit now.
+func (vs *ValidatorServer) ValidatorQueue(
+	ctx context.Context,
+	req *pb.ValidatorIndexRequest) (*pb.ValidatorQueueResponse, error) {
+	beaconState, err := vs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch beacon state")
+	}
+	churnLimit, err := helpers.ValidatorChurnLimit(beaconState)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get churn limit")
+	}
+
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	// Validators waiting for an activation epoch, in the order process_registry_updates
+	// activates them.
+	var awaitingActivation []uint64
+	var activationQueueLength, exitQueueLength uint64
+	exitQueueEpoch := helpers.DelayedActivationExitEpoch(currentEpoch)
+	for idx, v := range beaconState.Validators {
+		if v.ActivationEligibilityEpoch != farFutureEpoch && v.ActivationEpoch > currentEpoch {
+			activationQueueLength++
+			if v.ActivationEpoch == farFutureEpoch {
+				awaitingActivation = append(awaitingActivation, uint64(idx))
+			}
+		}
+		if v.ExitEpoch != farFutureEpoch {
+			if v.ExitEpoch > currentEpoch {
+				exitQueueLength++
+			}
+			if v.ExitEpoch > exitQueueEpoch {
+				exitQueueEpoch = v.ExitEpoch
+			}
+		}
+	}
+	sort.SliceStable(awaitingActivation, func(i, j int) bool {
+		return beaconState.Validators[awaitingActivation[i]].ActivationEligibilityEpoch <
+			beaconState.Validators[awaitingActivation[j]].ActivationEligibilityEpoch
+	})
+	// An exit initiated now lands in the exit queue epoch, unless it is already full.
+	var exitQueueChurn uint64
+	for _, v := range beaconState.Validators {
+		if v.ExitEpoch == exitQueueEpoch {
+			exitQueueChurn++
+		}
+	}
+	if exitQueueChurn >= churnLimit {
+		exitQueueEpoch++
+	}
+
+	res := &pb.ValidatorQueueResponse{
+		ChurnLimit:            churnLimit,
+		ActivationQueueLength: activationQueueLength,
+		ExitQueueLength:       exitQueueLength,
+	}
+	if len(req.PublicKey) == 0 {
+		return res, nil
+	}
+
+	var validator *ethpb.Validator
+	var validatorIndex uint64
+	for idx, v := range beaconState.Validators {
+		if bytes.Equal(v.PublicKey, req.PublicKey) {
+			validator = v
+			validatorIndex = uint64(idx)
+			break
+		}
+	}
+	if validator == nil {
+		return nil, fmt.Errorf("validator %#x not found in head state", bytesutil.Trunc(req.PublicKey))
+	}
+
+	switch {
+	case validator.ActivationEpoch != farFutureEpoch:
+		if validator.ActivationEpoch > currentEpoch {
+			res.EpochsUntilActivation = validator.ActivationEpoch - currentEpoch
+		}
+	case validator.ActivationEligibilityEpoch == farFutureEpoch:
+		// The validator joins the back of the queue once its balance makes it eligible.
+		res.PositionInActivationQueue = uint64(len(awaitingActivation))
+		res.EpochsUntilActivation = farFutureEpoch
+		if validator.EffectiveBalance == params.BeaconConfig().MaxEffectiveBalance {
+			res.EpochsUntilActivation = helpers.DelayedActivationExitEpoch(res.PositionInActivationQueue / churnLimit)
+		}
+	default:
+		for i, idx := range awaitingActivation {
+			if idx == validatorIndex {
+				res.PositionInActivationQueue = uint64(i)
+				break
+			}
+		}
+		// Each epoch transition schedules the activation of churn limit validators.
+		res.EpochsUntilActivation = helpers.DelayedActivationExitEpoch(res.PositionInActivationQueue / churnLimit)
+	}
+
+	if validator.ExitEpoch != farFutureEpoch {
+		if validator.ExitEpoch > currentEpoch {
+			res.EpochsUntilExit = validator.ExitEpoch - currentEpoch
+		}
+	} else {
+		res.EpochsUntilExit = exitQueueEpoch - currentEpoch
+	}
+	return res, nil
+}
+
 func (vs *ValidatorServer) validatorStatus(
 	ctx context.Context, pubKey []byte, chainStarted bool,
 	chainStartKeys map[[96]byte]bool, idxMap map[[32]byte]int,
@@ -309,6 +648,9 @@ func (vs *ValidatorServer) validatorStatus(
 	}
 
 	if !ok {
+		if pending := vs.depositCache.PendingDepositsByPubkey(ctx, pubKey); len(pending) > 0 {
+			return vs.pendingDepositStatus(ctx, pending[0].Block, chainStarted, beaconState)
+		}
 		return &pb.ValidatorStatusResponse{
 			Status:                 pb.ValidatorStatus_UNKNOWN_STATUS,
 			ActivationEpoch:        params.BeaconConfig().FarFutureEpoch,
@@ -384,13 +726,43 @@ func (vs *ValidatorServer) validatorStatus(
 	}
 
 	status := vs.lookupValidatorStatus(uint64(valIdx), beaconState)
-	return &pb.ValidatorStatusResponse{
+	res := &pb.ValidatorStatusResponse{
 		Status:                    status,
 		Eth1DepositBlockNumber:    eth1BlockNumBigInt.Uint64(),
 		PositionInActivationQueue: positionInQueue,
 		DepositInclusionSlot:      depositBlockSlot,
 		ActivationEpoch:           activationEpoch,
 	}
+	// Once an exit is initiated, the state holds the epochs at which the validator exits and
+	// can withdraw.
+	if validatorInState != nil && validatorInState.ExitEpoch != params.BeaconConfig().FarFutureEpoch {
+		res.ExitEpoch = validatorInState.ExitEpoch
+		res.WithdrawableEpoch = validatorInState.WithdrawableEpoch
+	}
+	return res
+}
+
+// pendingDepositStatus builds the status response of a validator whose deposit
+// has been observed on the proof of work chain but not yet processed into the
+// beacon state.
+func (vs *ValidatorServer) pendingDepositStatus(ctx context.Context, eth1BlockNumBigInt *big.Int,
+	chainStarted bool, beaconState *pbp2p.BeaconState) *pb.ValidatorStatusResponse {
+	eligibilityEpoch := params.BeaconConfig().FarFutureEpoch
+	if chainStarted {
+		inclusionSlot, err := vs.estimatedInclusionSlot(ctx, eth1BlockNumBigInt, beaconState)
+		if err == nil {
+			if inclusionSlot < beaconState.Slot {
+				inclusionSlot = beaconState.Slot
+			}
+			eligibilityEpoch = helpers.SlotToEpoch(inclusionSlot)
+		}
+	}
+	return &pb.ValidatorStatusResponse{
+		Status:                     pb.ValidatorStatus_DEPOSIT_RECEIVED,
+		ActivationEpoch:            params.BeaconConfig().FarFutureEpoch,
+		Eth1DepositBlockNumber:     eth1BlockNumBigInt.Uint64(),
+		ActivationEligibilityEpoch: eligibilityEpoch,
+	}
 }
 
 func (vs *ValidatorServer) lookupValidatorStatus(validatorIdx uint64, beaconState *pbp2p.BeaconState) pb.ValidatorStatus {
@@ -419,6 +791,23 @@ func (vs *ValidatorServer) lookupValidatorStatus(validatorIdx uint64, beaconStat
 }
 
 func (vs *ValidatorServer) depositBlockSlot(ctx context.Context, currentSlot uint64,
+	eth1BlockNumBigInt *big.Int, beaconState *pbp2p.BeaconState) (uint64, error) {
+	depositBlockSlot, err := vs.estimatedInclusionSlot(ctx, eth1BlockNumBigInt, beaconState)
+	if err != nil {
+		return 0, err
+	}
+
+	if depositBlockSlot > currentSlot {
+		return 0, nil
+	}
+
+	return depositBlockSlot, nil
+}
+
+// estimatedInclusionSlot returns the slot at which a deposit made in the given eth1 block
+// is expected to be included in the beacon state, once it is past the follow distance and
+// an eth1 voting period has elapsed.
+func (vs *ValidatorServer) estimatedInclusionSlot(ctx context.Context,
 	eth1BlockNumBigInt *big.Int, beaconState *pbp2p.BeaconState) (uint64, error) {
 	blockTimeStamp, err := vs.powChainService.BlockTimeByHeight(ctx, eth1BlockNumBigInt)
 	if err != nil {
@@ -433,13 +822,7 @@ func (vs *ValidatorServer) depositBlockSlot(ctx context.Context, currentSlot uin
 
 	eth2Genesis := time.Unix(int64(beaconState.GenesisTime), 0)
 	eth2TimeDifference := timeToInclusion.Sub(eth2Genesis).Seconds()
-	depositBlockSlot := uint64(eth2TimeDifference) / params.BeaconConfig().SecondsPerSlot
-
-	if depositBlockSlot > currentSlot {
-		return 0, nil
-	}
-
-	return depositBlockSlot, nil
+	return uint64(eth2TimeDifference) / params.BeaconConfig().SecondsPerSlot, nil
 }
 
 func (vs *ValidatorServer) chainStartPubkeys() map[[96]byte]bool {
@@ -457,7 +840,7 @@ func (vs *ValidatorServer) DomainData(ctx context.Context, request *pb.DomainReq
 	if err != nil {
 		return nil, errors.Wrap(err, "could not retrieve beacon state")
 	}
-	dv := helpers.Domain(state, request.Epoch, request.Domain)
+	dv := helpers.DomainAtEpoch(state.Fork, request.Epoch, request.Domain)
 	return &pb.DomainResponse{
 		SignatureDomain: dv,
 	}, nil
@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"fmt"
@@ -26,9 +27,14 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestValidatorIndex_OK(t *testing.T) {
@@ -299,6 +305,199 @@ func TestCommitteeAssignment_CurrentEpoch_ShouldNotFail(t *testing.T) {
 	}
 }
 
+func TestCommitteeAssignment_NextEpochProposerSlots(t *testing.T) {
+	helpers.ClearAllCaches()
+
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	genesis := blk.NewGenesisBlock([]byte{})
+	if err := db.SaveBlockDeprecated(genesis); err != nil {
+		t.Fatalf("Could not save genesis block: %v", err)
+	}
+	depChainStart := params.BeaconConfig().MinGenesisActiveValidatorCount / 16
+
+	deposits, _ := testutil.SetupInitialDeposits(t, depChainStart)
+	state, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	if err := db.UpdateChainHead(ctx, genesis, state); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+	for i := 0; i < len(deposits); i++ {
+		if err := db.SaveValidatorIndexBatch(deposits[i].Data.PublicKey, i); err != nil {
+			t.Fatalf("Could not save validator index: %v", err)
+		}
+	}
+
+	proposers, err := helpers.ProposerIndices(state, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposer := proposers[0]
+	var wanted []uint64
+	for i, idx := range proposers {
+		if idx == proposer {
+			wanted = append(wanted, helpers.StartSlot(1)+uint64(i))
+		}
+	}
+
+	vs := &ValidatorServer{
+		beaconDB: db,
+	}
+	req := &pb.AssignmentRequest{
+		PublicKeys: [][]byte{deposits[proposer].Data.PublicKey},
+		EpochStart: 0,
+	}
+	res, err := vs.CommitteeAssignment(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Could not call epoch committee assignment %v", err)
+	}
+	if res.Epoch != 0 {
+		t.Errorf("Wanted epoch 0, got %d", res.Epoch)
+	}
+	slots := res.ValidatorAssignment[0].NextEpochProposerSlots
+	if len(slots) != len(wanted) {
+		t.Fatalf("Wanted next epoch proposer slots %v, got %v", wanted, slots)
+	}
+	for i := range wanted {
+		if slots[i] != wanted[i] {
+			t.Errorf("Wanted next epoch proposer slots %v, got %v", wanted, slots)
+		}
+	}
+}
+
+type mockDutiesStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pb.AssignmentResponse
+}
+
+func (m *mockDutiesStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockDutiesStream) Send(res *pb.AssignmentResponse) error {
+	m.sent <- res
+	return nil
+}
+
+func TestStreamDuties_SendsCurrentAndNextEpoch(t *testing.T) {
+	helpers.ClearAllCaches()
+
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	genesis := blk.NewGenesisBlock([]byte{})
+	if err := db.SaveBlockDeprecated(genesis); err != nil {
+		t.Fatalf("Could not save genesis block: %v", err)
+	}
+	depChainStart := params.BeaconConfig().MinGenesisActiveValidatorCount / 16
+
+	deposits, _ := testutil.SetupInitialDeposits(t, depChainStart)
+	state, err := state.GenesisBeaconState(deposits, uint64(time.Now().Unix()), &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	if err := db.UpdateChainHead(ctx, genesis, state); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+	if err := db.SaveValidatorIndexBatch(deposits[0].Data.PublicKey, 0); err != nil {
+		t.Fatalf("Could not save validator index: %v", err)
+	}
+
+	vs := &ValidatorServer{
+		ctx:        context.Background(),
+		beaconDB:   db,
+		prefetcher: newStatePrefetcher(db),
+	}
+	streamCtx, cancel := context.WithCancel(context.Background())
+	stream := &mockDutiesStream{ctx: streamCtx, sent: make(chan *pb.AssignmentResponse, 2)}
+	exitRoutine := make(chan bool)
+	go func() {
+		req := &pb.AssignmentRequest{PublicKeys: [][]byte{deposits[0].Data.PublicKey}}
+		if err := vs.StreamDuties(req, stream); err == nil {
+			t.Error("Expected an error when the stream is closed")
+		}
+		exitRoutine <- true
+	}()
+
+	current := <-stream.sent
+	next := <-stream.sent
+	cancel()
+	<-exitRoutine
+	if current.Epoch != 0 || next.Epoch != 1 {
+		t.Errorf("Wanted duties of epochs 0 and 1, got %d and %d", current.Epoch, next.Epoch)
+	}
+	for _, res := range []*pb.AssignmentResponse{current, next} {
+		if len(res.ValidatorAssignment) != 1 || !bytes.Equal(res.ValidatorAssignment[0].PublicKey, deposits[0].Data.PublicKey) {
+			t.Fatalf("Wanted the assignment of the requested validator, got %v", res.ValidatorAssignment)
+		}
+		// The streamed duties are prefetched.
+		if slot := res.ValidatorAssignment[0].Slot; !vs.prefetcher.duties[slot] {
+			t.Errorf("Wanted a duty expected at slot %d", slot)
+		}
+	}
+}
+
+func TestDutyStates_AdvancesOncePerEpoch(t *testing.T) {
+	helpers.ClearAllCaches()
+	deposits, _ := testutil.SetupInitialDeposits(t, params.BeaconConfig().MinGenesisActiveValidatorCount/16)
+	head, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	var d dutyStates
+	states, err := d.get(ctx, head, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(states) != 2 || states[0].Slot != 0 || states[1].Slot != params.BeaconConfig().SlotsPerEpoch {
+		t.Fatalf("Wanted the states at the start of epochs 0 and 1, got %v", states)
+	}
+	if head.Slot != 0 {
+		t.Errorf("Wanted the head state untouched, got slot %d", head.Slot)
+	}
+	again, err := d.get(ctx, head, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again[1] != states[1] {
+		t.Error("Wanted the states of the epoch to be shared")
+	}
+	next, err := d.get(ctx, head, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next[0].Slot != params.BeaconConfig().SlotsPerEpoch || next[1].Slot != 2*params.BeaconConfig().SlotsPerEpoch {
+		t.Errorf("Wanted the states at the start of epochs 1 and 2, got slots %d and %d", next[0].Slot, next[1].Slot)
+	}
+}
+
+func TestCurrentEpochAt(t *testing.T) {
+	secondsPerEpoch := int64(params.BeaconConfig().SecondsPerSlot * params.BeaconConfig().SlotsPerEpoch)
+	tests := []struct {
+		genesisTime uint64
+		now         int64
+		epoch       uint64
+	}{
+		{genesisTime: 100, now: 50, epoch: 0},
+		{genesisTime: 100, now: 100, epoch: 0},
+		{genesisTime: 100, now: 100 + secondsPerEpoch - 1, epoch: 0},
+		{genesisTime: 100, now: 100 + 3*secondsPerEpoch, epoch: 3},
+	}
+	for _, tt := range tests {
+		if epoch := currentEpochAt(tt.genesisTime, time.Unix(tt.now, 0)); epoch != tt.epoch {
+			t.Errorf("Wanted epoch %d at %d for genesis at %d, got %d", tt.epoch, tt.now, tt.genesisTime, epoch)
+		}
+	}
+}
+
 func TestCommitteeAssignment_multipleKeys_OK(t *testing.T) {
 	db := internal.SetupDBDeprecated(t)
 	defer internal.TeardownDBDeprecated(t, db)
@@ -357,6 +556,128 @@ func TestCommitteeAssignment_multipleKeys_OK(t *testing.T) {
 	}
 }
 
+func TestCommitteeAssignmentProof_OK(t *testing.T) {
+	helpers.ClearAllCaches()
+
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	genesis := blk.NewGenesisBlock([]byte{})
+	if err := db.SaveBlockDeprecated(genesis); err != nil {
+		t.Fatalf("Could not save genesis block: %v", err)
+	}
+	deposits, _ := testutil.SetupInitialDeposits(t, params.BeaconConfig().MinGenesisActiveValidatorCount/16)
+	state, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	if err := db.UpdateChainHead(ctx, genesis, state); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+	headRoot, err := ssz.HashTreeRoot(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vs := &ValidatorServer{
+		beaconDB: db,
+	}
+	validatorIndex := uint64(len(deposits) / 2)
+	res, err := vs.CommitteeAssignmentProof(ctx, &pb.AssignmentProofRequest{
+		Epoch:     0,
+		PublicKey: deposits[validatorIndex].Data.PublicKey,
+	})
+	if err != nil {
+		t.Fatalf("Could not get committee assignment proof: %v", err)
+	}
+	if res.ValidatorIndex != validatorIndex {
+		t.Errorf("Wanted validator index %d, got %d", validatorIndex, res.ValidatorIndex)
+	}
+	if !bytes.Equal(res.StateRoot, headRoot[:]) {
+		t.Errorf("Wanted the root %#x of the head state, got %#x", headRoot, res.StateRoot)
+	}
+
+	// An auditor recomputes the seed and the shuffling from the returned inputs.
+	seedInput := append(append(res.RandaoMix, res.ActiveIndexRoot...), bytesutil.Bytes32(res.Epoch)...)
+	if seed := hashutil.Hash(seedInput); !bytes.Equal(seed[:], res.Seed) {
+		t.Errorf("Wanted seed %#x, got %#x", seed, res.Seed)
+	}
+	activeIndex, err := helpers.ShuffledIndex(res.ShuffledIndex, res.ActiveValidatorCount, bytesutil.ToBytes32(res.Seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if activeIndex != res.ActiveIndex {
+		t.Errorf("Wanted shuffled index to map to active index %d, got %d", res.ActiveIndex, activeIndex)
+	}
+	start := helpers.SplitOffset(res.ActiveValidatorCount, res.CommitteeCount, res.CommitteeIndex)
+	end := helpers.SplitOffset(res.ActiveValidatorCount, res.CommitteeCount, res.CommitteeIndex+1)
+	if res.ShuffledIndex < start || res.ShuffledIndex >= end {
+		t.Errorf("Wanted shuffled index in committee range [%d, %d), got %d", start, end, res.ShuffledIndex)
+	}
+	var inCommittee bool
+	for _, idx := range res.Committee {
+		if idx == validatorIndex {
+			inCommittee = true
+		}
+	}
+	if !inCommittee {
+		t.Errorf("Wanted validator %d in committee %v", validatorIndex, res.Committee)
+	}
+	if res.Slot >= params.BeaconConfig().SlotsPerEpoch {
+		t.Errorf("Assigned slot %d can't be outside of epoch 0", res.Slot)
+	}
+}
+
+func TestCommitteeAssignmentProof_UnknownValidator(t *testing.T) {
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 8)
+	beaconState, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	if err := db.UpdateChainHead(ctx, blk.NewGenesisBlock([]byte{}), beaconState); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+
+	vs := &ValidatorServer{
+		beaconDB: db,
+	}
+	_, err = vs.CommitteeAssignmentProof(ctx, &pb.AssignmentProofRequest{PublicKey: []byte{'A'}})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Wanted NotFound, got %v", err)
+	}
+}
+
+func TestCommitteeAssignmentProof_EpochTooFar(t *testing.T) {
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 8)
+	beaconState, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	if err := db.UpdateChainHead(ctx, blk.NewGenesisBlock([]byte{}), beaconState); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+
+	vs := &ValidatorServer{
+		beaconDB: db,
+	}
+	_, err = vs.CommitteeAssignmentProof(ctx, &pb.AssignmentProofRequest{
+		Epoch:     2,
+		PublicKey: deposits[0].Data.PublicKey,
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Wanted InvalidArgument, got %v", err)
+	}
+}
+
 func TestValidatorStatus_PendingActive(t *testing.T) {
 	db := internal.SetupDBDeprecated(t)
 	defer internal.TeardownDBDeprecated(t, db)
@@ -543,6 +864,12 @@ func TestValidatorStatus_InitiatedExit(t *testing.T) {
 	if resp.Status != pb.ValidatorStatus_INITIATED_EXIT {
 		t.Errorf("Wanted %v, got %v", pb.ValidatorStatus_INITIATED_EXIT, resp.Status)
 	}
+	if resp.ExitEpoch != exitEpoch {
+		t.Errorf("Wanted exit epoch %d, got %d", exitEpoch, resp.ExitEpoch)
+	}
+	if resp.WithdrawableEpoch != withdrawableEpoch {
+		t.Errorf("Wanted withdrawable epoch %d, got %d", withdrawableEpoch, resp.WithdrawableEpoch)
+	}
 }
 
 func TestValidatorStatus_Withdrawable(t *testing.T) {
@@ -777,6 +1104,62 @@ func TestValidatorStatus_UnknownStatus(t *testing.T) {
 	}
 }
 
+func TestValidatorStatus_DepositReceived(t *testing.T) {
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	pubKey := []byte{'A'}
+	beaconState := &pbp2p.BeaconState{
+		Slot:       5000,
+		Validators: []*ethpb.Validator{},
+	}
+	if err := db.SaveStateDeprecated(ctx, beaconState); err != nil {
+		t.Fatalf("could not save state: %v", err)
+	}
+	deposit := &ethpb.Deposit{
+		Data: &ethpb.Deposit_Data{
+			PublicKey:             pubKey,
+			Signature:             []byte("hi"),
+			WithdrawalCredentials: []byte("hey"),
+		},
+	}
+	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		t.Fatal(fmt.Errorf("could not setup deposit trie: %v", err))
+	}
+	depositCache := depositcache.NewDepositCache()
+	depositCache.InsertDeposit(ctx, deposit, big.NewInt(10) /*blockNum*/, 0, depositTrie.Root())
+	depositCache.InsertPendingDeposit(ctx, deposit, big.NewInt(10) /*blockNum*/, 0, depositTrie.Root())
+	height := time.Unix(int64(params.BeaconConfig().Eth1FollowDistance), 0).Unix()
+	vs := &ValidatorServer{
+		beaconDB: db,
+		powChainService: &mockPOWChainService{
+			blockTimeByHeight: map[int]uint64{
+				10: uint64(height),
+			},
+		},
+		depositCache: depositCache,
+	}
+	req := &pb.ValidatorIndexRequest{
+		PublicKey: pubKey,
+	}
+	resp, err := vs.ValidatorStatus(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Could not get validator status %v", err)
+	}
+	if resp.Status != pb.ValidatorStatus_DEPOSIT_RECEIVED {
+		t.Errorf("Wanted %v, got %v", pb.ValidatorStatus_DEPOSIT_RECEIVED, resp.Status)
+	}
+	if resp.Eth1DepositBlockNumber != 10 {
+		t.Errorf("Wanted eth1 deposit block number 10, got %d", resp.Eth1DepositBlockNumber)
+	}
+	if resp.ActivationEligibilityEpoch < helpers.CurrentEpoch(beaconState) ||
+		resp.ActivationEligibilityEpoch == params.BeaconConfig().FarFutureEpoch {
+		t.Errorf("Unexpected activation eligibility epoch %d", resp.ActivationEligibilityEpoch)
+	}
+}
+
 func TestWaitForActivation_ContextClosed(t *testing.T) {
 	db := internal.SetupDBDeprecated(t)
 	defer internal.TeardownDBDeprecated(t, db)
@@ -1119,3 +1502,96 @@ func genesisState(validators uint64) (*pbp2p.BeaconState, error) {
 	}
 	return state.GenesisBeaconState(deposits, uint64(genesisTime), &ethpb.Eth1Data{})
 }
+
+func TestValidatorQueue_OK(t *testing.T) {
+	helpers.ClearAllCaches()
+	db := internal.SetupDBDeprecated(t)
+	defer internal.TeardownDBDeprecated(t, db)
+	ctx := context.Background()
+
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	currentEpoch := uint64(10)
+	var validators []*ethpb.Validator
+	// Active validators.
+	for i := 0; i < 8; i++ {
+		validators = append(validators, &ethpb.Validator{ExitEpoch: farFuture})
+	}
+	// Validators waiting for activation, eligible in reverse registry order.
+	for i := 0; i < 6; i++ {
+		validators = append(validators, &ethpb.Validator{
+			ActivationEligibilityEpoch: uint64(6 - i),
+			ActivationEpoch:            farFuture,
+			ExitEpoch:                  farFuture,
+		})
+	}
+	// A validator scheduled for activation and an exiting validator.
+	validators = append(validators, &ethpb.Validator{ActivationEpoch: currentEpoch + 2, ExitEpoch: farFuture})
+	validators = append(validators, &ethpb.Validator{ExitEpoch: currentEpoch + 5})
+	for i, v := range validators {
+		v.PublicKey = []byte{byte(i)}
+		v.WithdrawableEpoch = farFuture
+	}
+	beaconState := &pbp2p.BeaconState{
+		Slot:       currentEpoch * params.BeaconConfig().SlotsPerEpoch,
+		Validators: validators,
+	}
+	if err := db.SaveStateDeprecated(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	vs := &ValidatorServer{beaconDB: db}
+
+	res, err := vs.ValidatorQueue(ctx, &pb.ValidatorIndexRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ChurnLimit != params.BeaconConfig().MinPerEpochChurnLimit {
+		t.Errorf("Wanted churn limit %d, got %d", params.BeaconConfig().MinPerEpochChurnLimit, res.ChurnLimit)
+	}
+	if res.ActivationQueueLength != 7 {
+		t.Errorf("Wanted activation queue length 7, got %d", res.ActivationQueueLength)
+	}
+	if res.ExitQueueLength != 1 {
+		t.Errorf("Wanted exit queue length 1, got %d", res.ExitQueueLength)
+	}
+
+	// The first validator waiting for activation became eligible last.
+	res, err = vs.ValidatorQueue(ctx, &pb.ValidatorIndexRequest{PublicKey: []byte{8}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.PositionInActivationQueue != 5 {
+		t.Errorf("Wanted position 5 in activation queue, got %d", res.PositionInActivationQueue)
+	}
+	wantActivation := helpers.DelayedActivationExitEpoch(5 / res.ChurnLimit)
+	if res.EpochsUntilActivation != wantActivation {
+		t.Errorf("Wanted %d epochs until activation, got %d", wantActivation, res.EpochsUntilActivation)
+	}
+	exitQueueEpoch := helpers.DelayedActivationExitEpoch(currentEpoch)
+	if exitQueueEpoch < currentEpoch+5 {
+		exitQueueEpoch = currentEpoch + 5
+	}
+	if res.EpochsUntilExit != exitQueueEpoch-currentEpoch {
+		t.Errorf("Wanted %d epochs until exit, got %d", exitQueueEpoch-currentEpoch, res.EpochsUntilExit)
+	}
+
+	res, err = vs.ValidatorQueue(ctx, &pb.ValidatorIndexRequest{PublicKey: []byte{14}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.EpochsUntilActivation != 2 {
+		t.Errorf("Wanted 2 epochs until activation, got %d", res.EpochsUntilActivation)
+	}
+
+	res, err = vs.ValidatorQueue(ctx, &pb.ValidatorIndexRequest{PublicKey: []byte{15}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.EpochsUntilActivation != 0 || res.EpochsUntilExit != 5 {
+		t.Errorf("Wanted 0 epochs until activation and 5 until exit, got %d and %d",
+			res.EpochsUntilActivation, res.EpochsUntilExit)
+	}
+
+	if _, err := vs.ValidatorQueue(ctx, &pb.ValidatorIndexRequest{PublicKey: []byte{'x'}}); err == nil {
+		t.Error("Expected an error for a validator not in the head state")
+	}
+}
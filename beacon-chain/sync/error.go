@@ -5,6 +5,8 @@ import (
 	"errors"
 	"io"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/forkchoice"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
 
@@ -25,6 +27,25 @@ func (r *RegularSync) generateErrorResponse(code byte, reason string) ([]byte, e
 	return buf.Bytes(), nil
 }
 
+// blockRejectionPenalty maps an error from processing a block received from a peer, over gossip
+// or in a blocks by range response, to the reputation penalty of the peer. Blocks which may
+// become valid later, once their slot or parent comes, and blocks which failed for an internal
+// reason are not held against the peer.
+func blockRejectionPenalty(err error) int {
+	rejection := forkchoice.BlockRejection(err)
+	if rejection == nil {
+		return 0
+	}
+	switch rejection.Reason {
+	case forkchoice.RejectPreFinalized:
+		return p2p.RepPenaltyPreFinalizedBlock
+	case forkchoice.RejectInvalidStateTransition:
+		return p2p.RepPenaltyInvalidBlock
+	default:
+		return 0
+	}
+}
+
 func (r *RegularSync) readStatusCode(stream io.Reader) (uint8, *pb.ErrorMessage, error) {
 	b := make([]byte, 1)
 	_, err := stream.Read(b)
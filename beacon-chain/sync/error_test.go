@@ -2,8 +2,11 @@ package sync
 
 import (
 	"bytes"
+	"errors"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/forkchoice"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
@@ -33,3 +36,32 @@ func TestRegularSync_generateErrorResponse(t *testing.T) {
 		t.Errorf("Received the wrong message: %v", msg)
 	}
 }
+
+func TestBlockRejectionPenalty(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "internal error", err: errors.New("could not save block")},
+		{name: "future slot", err: &forkchoice.BlockRejectedError{Reason: forkchoice.RejectFutureSlot}},
+		{name: "unknown parent", err: &forkchoice.BlockRejectedError{Reason: forkchoice.RejectUnknownParent}},
+		{
+			name: "pre finalized",
+			err:  &forkchoice.BlockRejectedError{Reason: forkchoice.RejectPreFinalized},
+			want: p2p.RepPenaltyPreFinalizedBlock,
+		},
+		{
+			name: "invalid state transition",
+			err:  &forkchoice.BlockRejectedError{Reason: forkchoice.RejectInvalidStateTransition},
+			want: p2p.RepPenaltyInvalidBlock,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := blockRejectionPenalty(tt.err); got != tt.want {
+				t.Errorf("Wanted penalty %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// peerStatusBufferSize is the capacity of the channel receiving the peer events.
+const peerStatusBufferSize = 256
+
+// peerStatusRefreshInterval is how often the connected peers are sent a hello message again, so
+// that the statuses they reported in their handshake do not go stale.
+var peerStatusRefreshInterval = time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+
+// trackPeerStatuses keeps the registry of the chain status of the connected peers up to date
+// from the peer event feed. The status of a peer is recorded when its handshake completes, and
+// dropped when it disconnects.
+func (r *RegularSync) trackPeerStatuses() {
+	events := make(chan *p2p.PeerEvent, peerStatusBufferSize)
+	sub := r.p2p.PeerEventFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case e := <-events:
+			r.updatePeerStatus(e)
+		case <-sub.Err():
+			return
+		case <-r.ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *RegularSync) updatePeerStatus(e *p2p.PeerEvent) {
+	r.peerStatusesLock.Lock()
+	defer r.peerStatusesLock.Unlock()
+	switch e.Type {
+	case p2p.PeerHandshakeComplete:
+		r.setPeerStatus(e.PeerID, e.Hello)
+	case p2p.PeerDisconnected:
+		delete(r.peerStatuses, e.PeerID)
+		r.forkMonitor.RemovePeer(e.PeerID.Pretty())
+	}
+}
+
+// setPeerStatus records the status of a peer. The caller must hold the peer statuses lock.
+func (r *RegularSync) setPeerStatus(pid peer.ID, hello *pb.Hello) {
+	r.peerStatuses[pid] = hello
+	if hello != nil {
+		r.forkMonitor.ObserveHead(pid.Pretty(), hello.HeadSlot, bytesutil.ToBytes32(hello.HeadRoot))
+	}
+}
+
+// refreshPeerStatuses sends a hello message to the peers which completed their handshake once per
+// epoch, and records the status they respond with in place of the one of their handshake.
+func (r *RegularSync) refreshPeerStatuses(opener p2p.StreamOpener) {
+	ticker := time.NewTicker(peerStatusRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.refreshPeerStatusesOnce(r.ctx, opener)
+		case <-r.ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *RegularSync) refreshPeerStatusesOnce(ctx context.Context, opener p2p.StreamOpener) {
+	r.peerStatusesLock.RLock()
+	pids := make([]peer.ID, 0, len(r.peerStatuses))
+	for pid := range r.peerStatuses {
+		pids = append(pids, pid)
+	}
+	r.peerStatusesLock.RUnlock()
+
+	for _, pid := range pids {
+		hello, err := r.sendRPCHelloRequest(ctx, opener, pid)
+		if err != nil {
+			log.WithError(err).WithField("peer", pid.Pretty()).Debug("Could not refresh peer status")
+			continue
+		}
+		r.peerStatusesLock.Lock()
+		// The peer may have disconnected while it was queried.
+		if _, ok := r.peerStatuses[pid]; ok {
+			r.setPeerStatus(pid, hello)
+		}
+		r.peerStatusesLock.Unlock()
+	}
+}
+
+// PeerStatus returns the chain status sent by a connected peer in its handshake, or nil if the
+// peer hasn't completed its handshake.
+func (r *RegularSync) PeerStatus(pid peer.ID) *pb.Hello {
+	r.peerStatusesLock.RLock()
+	defer r.peerStatusesLock.RUnlock()
+	return r.peerStatuses[pid]
+}
+
+// PeerFork is a fork version and finalized checkpoint reported by connected peers in their
+// statuses.
+type PeerFork struct {
+	ForkVersion    []byte
+	FinalizedEpoch uint64
+	FinalizedRoot  []byte
+	Peers          uint64
+}
+
+// PeerForks groups the connected peers which completed their handshake by the fork version and
+// finalized checkpoint they last reported, sorted by decreasing number of peers.
+func (r *RegularSync) PeerForks() []*PeerFork {
+	r.peerStatusesLock.RLock()
+	defer r.peerStatusesLock.RUnlock()
+	statuses := make([]*pb.Hello, 0, len(r.peerStatuses))
+	for _, hello := range r.peerStatuses {
+		statuses = append(statuses, hello)
+	}
+	return GroupPeerForks(statuses)
+}
+
+// GroupPeerForks groups peer statuses by fork version and finalized checkpoint, sorted by
+// decreasing number of peers. Nil statuses are skipped.
+func GroupPeerForks(statuses []*pb.Hello) []*PeerFork {
+	byFork := make(map[string]*PeerFork)
+	for _, hello := range statuses {
+		if hello == nil {
+			continue
+		}
+		key := fmt.Sprintf("%#x/%d/%#x", hello.ForkVersion, hello.FinalizedEpoch, hello.FinalizedRoot)
+		f, ok := byFork[key]
+		if !ok {
+			f = &PeerFork{
+				ForkVersion:    hello.ForkVersion,
+				FinalizedEpoch: hello.FinalizedEpoch,
+				FinalizedRoot:  hello.FinalizedRoot,
+			}
+			byFork[key] = f
+		}
+		f.Peers++
+	}
+	forks := make([]*PeerFork, 0, len(byFork))
+	for _, f := range byFork {
+		forks = append(forks, f)
+	}
+	sort.Slice(forks, func(i, j int) bool {
+		if forks[i].Peers != forks[j].Peers {
+			return forks[i].Peers > forks[j].Peers
+		}
+		if c := bytes.Compare(forks[i].ForkVersion, forks[j].ForkVersion); c != 0 {
+			return c < 0
+		}
+		if forks[i].FinalizedEpoch != forks[j].FinalizedEpoch {
+			return forks[i].FinalizedEpoch > forks[j].FinalizedEpoch
+		}
+		return bytes.Compare(forks[i].FinalizedRoot, forks[j].FinalizedRoot) < 0
+	})
+	return forks
+}
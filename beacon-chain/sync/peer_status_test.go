@@ -0,0 +1,111 @@
+package sync
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	db "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestUpdatePeerStatus(t *testing.T) {
+	r := NewRegularSync(&Config{P2P: p2ptest.NewTestP2P(t)})
+	pid := peer.ID("peer")
+	hello := &pb.Hello{HeadSlot: 5}
+
+	r.updatePeerStatus(&p2p.PeerEvent{Type: p2p.PeerConnected, PeerID: pid})
+	if status := r.PeerStatus(pid); status != nil {
+		t.Errorf("Wanted no status before handshake, got %v", status)
+	}
+	r.updatePeerStatus(&p2p.PeerEvent{Type: p2p.PeerHandshakeComplete, PeerID: pid, Hello: hello})
+	if status := r.PeerStatus(pid); status != hello {
+		t.Errorf("Wanted status %v, got %v", hello, status)
+	}
+	r.updatePeerStatus(&p2p.PeerEvent{Type: p2p.PeerDisconnected, PeerID: pid})
+	if status := r.PeerStatus(pid); status != nil {
+		t.Errorf("Wanted no status after disconnection, got %v", status)
+	}
+}
+
+func TestPeerForks(t *testing.T) {
+	r := NewRegularSync(&Config{P2P: p2ptest.NewTestP2P(t)})
+	hellos := map[peer.ID]*pb.Hello{
+		"a": {ForkVersion: []byte{0, 0, 0, 0}, FinalizedEpoch: 5, FinalizedRoot: []byte{'a'}},
+		"b": {ForkVersion: []byte{0, 0, 0, 0}, FinalizedEpoch: 5, FinalizedRoot: []byte{'a'}},
+		"c": {ForkVersion: []byte{0, 0, 0, 1}, FinalizedEpoch: 5, FinalizedRoot: []byte{'a'}},
+		"d": {ForkVersion: []byte{0, 0, 0, 0}, FinalizedEpoch: 4, FinalizedRoot: []byte{'d'}},
+		// Same finalized epoch as a and b, on another block.
+		"e": {ForkVersion: []byte{0, 0, 0, 0}, FinalizedEpoch: 5, FinalizedRoot: []byte{'e'}},
+	}
+	for pid, hello := range hellos {
+		r.updatePeerStatus(&p2p.PeerEvent{Type: p2p.PeerHandshakeComplete, PeerID: pid, Hello: hello})
+	}
+	// Peers without a completed handshake are not counted.
+	r.updatePeerStatus(&p2p.PeerEvent{Type: p2p.PeerHandshakeComplete, PeerID: "f"})
+
+	forks := r.PeerForks()
+	want := []PeerFork{
+		{ForkVersion: []byte{0, 0, 0, 0}, FinalizedEpoch: 5, FinalizedRoot: []byte{'a'}, Peers: 2},
+		{ForkVersion: []byte{0, 0, 0, 0}, FinalizedEpoch: 5, FinalizedRoot: []byte{'e'}, Peers: 1},
+		{ForkVersion: []byte{0, 0, 0, 0}, FinalizedEpoch: 4, FinalizedRoot: []byte{'d'}, Peers: 1},
+		{ForkVersion: []byte{0, 0, 0, 1}, FinalizedEpoch: 5, FinalizedRoot: []byte{'a'}, Peers: 1},
+	}
+	if len(forks) != len(want) {
+		t.Fatalf("Wanted %d forks, got %d", len(want), len(forks))
+	}
+	for i, f := range forks {
+		if !reflect.DeepEqual(*f, want[i]) {
+			t.Errorf("Wanted fork %v, got %v", want[i], *f)
+		}
+	}
+}
+
+func TestRefreshPeerStatuses(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+
+	d := db.SetupDB(t)
+	defer db.TeardownDB(t, d)
+	headRoot := [32]byte{'h'}
+	headState, err := state.GenesisBeaconState(nil, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	headState.Slot = 111
+	headState.BlockRoots[111%params.BeaconConfig().SlotsPerHistoricalRoot] = headRoot[:]
+	headState.FinalizedCheckpoint = &ethpb.Checkpoint{Epoch: 5, Root: []byte{'f'}}
+	if err := d.SaveHeadBlockRoot(context.Background(), headRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SaveState(context.Background(), headState, headRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	r1 := NewRegularSync(&Config{P2P: p1, DB: d})
+	r2 := NewRegularSync(&Config{P2P: p2, DB: d})
+	r2.registerRPC(helloTopic, &pb.Hello{}, r2.helloRPCHandler)
+
+	pid := p2.Host.ID()
+	r1.updatePeerStatus(&p2p.PeerEvent{Type: p2p.PeerHandshakeComplete, PeerID: pid, Hello: &pb.Hello{HeadSlot: 5}})
+	r1.refreshPeerStatusesOnce(context.Background(), p1)
+
+	want := &pb.Hello{
+		ForkVersion:    params.BeaconConfig().GenesisForkVersion,
+		FinalizedRoot:  []byte{'f'},
+		FinalizedEpoch: 5,
+		HeadRoot:       headRoot[:],
+		HeadSlot:       111,
+	}
+	if status := r1.PeerStatus(pid); !proto.Equal(status, want) {
+		t.Errorf("Wanted the refreshed status %v, got %v", want, status)
+	}
+}
@@ -30,7 +30,7 @@ func notImplementedRPCHandler(_ context.Context, _ proto.Message, _ libp2pcore.S
 // registerRPCHandlers for p2p RPC.
 func (r *RegularSync) registerRPCHandlers() {
 	r.registerRPC(
-		"/eth2/beacon_chain/req/hello/1",
+		helloTopic,
 		&pb.Hello{},
 		r.helloRPCHandler,
 	)
@@ -74,6 +74,9 @@ func (r *RegularSync) registerRPC(topic string, base proto.Message, handle rpcHa
 		// destination.
 		msg := proto.Clone(base)
 		if err := r.p2p.Encoding().Decode(stream, msg); err != nil {
+			if penalty := decodePenalty(err); penalty != 0 {
+				r.p2p.Reputation(stream.Conn().RemotePeer(), penalty)
+			}
 			log.WithError(err).Error("Failed to decode stream message")
 			return
 		}
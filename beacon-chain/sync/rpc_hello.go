@@ -3,14 +3,21 @@ package sync
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	libp2pcore "github.com/libp2p/go-libp2p-core"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
+// helloTopic is the RPC topic of the hello messages, without the suffix of the encoding.
+const helloTopic = "/eth2/beacon_chain/req/hello/1"
+
 // helloRPCHandler reads the incoming Hello RPC from the peer and responds with our version of a hello message.
 // This handler will disconnect any peer that does not match our fork version.
 func (r *RegularSync) helloRPCHandler(ctx context.Context, msg proto.Message, stream libp2pcore.Stream) error {
@@ -43,7 +50,7 @@ func (r *RegularSync) helloRPCHandler(ctx context.Context, msg proto.Message, st
 
 	r.p2p.AddHandshake(stream.Conn().RemotePeer(), m)
 
-	state, err := r.db.HeadState(ctx)
+	resp, err := r.helloMessage(ctx)
 	if err != nil {
 		log.WithError(err).Error("Failed to get head state")
 		resp, err := r.generateErrorResponse(responseCodeServerError, genericError)
@@ -53,18 +60,59 @@ func (r *RegularSync) helloRPCHandler(ctx context.Context, msg proto.Message, st
 		return err
 	}
 
-	resp := &pb.Hello{
+	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+		log.WithError(err).Error("Failed to write to stream")
+	}
+	_, err = r.p2p.Encoding().Encode(stream, resp)
+
+	return err
+}
+
+// helloMessage returns our version of a hello message, from the head state.
+func (r *RegularSync) helloMessage(ctx context.Context) (*pb.Hello, error) {
+	state, err := r.db.HeadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Hello{
 		ForkVersion:    params.BeaconConfig().GenesisForkVersion,
 		FinalizedRoot:  state.FinalizedCheckpoint.Root,
 		FinalizedEpoch: state.FinalizedCheckpoint.Epoch,
 		HeadRoot:       state.BlockRoots[state.Slot%params.BeaconConfig().SlotsPerHistoricalRoot],
 		HeadSlot:       state.Slot,
-	}
+	}, nil
+}
 
-	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
-		log.WithError(err).Error("Failed to write to stream")
+// sendRPCHelloRequest sends our version of a hello message to a connected peer over a stream
+// opened by the p2p service, and returns the hello message the peer responds with.
+func (r *RegularSync) sendRPCHelloRequest(ctx context.Context, opener p2p.StreamOpener, pid peer.ID) (*pb.Hello, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := r.helloMessage(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head state")
 	}
-	_, err = r.p2p.Encoding().Encode(stream, resp)
+	stream, err := opener.NewStream(ctx, pid, helloTopic+r.p2p.Encoding().ProtocolSuffix())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open stream")
+	}
+	defer stream.Close()
+	setRPCStreamDeadlines(stream)
 
-	return err
+	if _, err := r.p2p.Encoding().Encode(stream, req); err != nil {
+		return nil, errors.Wrap(err, "could not send hello message")
+	}
+	code, errMsg, err := r.readStatusCode(stream)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read response code")
+	}
+	if code != responseCodeSuccess {
+		return nil, fmt.Errorf("peer responded with code %d: %s", code, errMsg.ErrorMessage)
+	}
+	resp := &pb.Hello{}
+	if err := r.p2p.Encoding().Decode(stream, resp); err != nil {
+		return nil, errors.Wrap(err, "could not decode hello message")
+	}
+	return resp, nil
 }
@@ -10,8 +10,11 @@ import (
 	"github.com/gogo/protobuf/proto"
 	libp2pcore "github.com/libp2p/go-libp2p-core"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	pb "github.com/prysmaticlabs/prysm/proto/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
 
@@ -56,3 +59,42 @@ func TestRegisterRPC_ReceivesValidMessage(t *testing.T) {
 		t.Fatal("Did not receive RPC in 1 second")
 	}
 }
+
+func TestRegisterRPC_PenalizesSenderOfBlockOverOperationLimit(t *testing.T) {
+	prev := params.BeaconConfig()
+	defer params.OverrideBeaconConfig(prev)
+	cfg := *prev
+	cfg.MaxVoluntaryExits = 0
+	params.OverrideBeaconConfig(&cfg)
+
+	p := p2ptest.NewTestP2P(t)
+	r := &RegularSync{
+		ctx: context.Background(),
+		p2p: p,
+	}
+	topic := "/testing/blocks/1"
+	r.registerRPC(topic, &ethpb.BeaconBlock{}, func(_ context.Context, _ proto.Message, _ libp2pcore.Stream) error {
+		t.Error("Wanted the block to be rejected before being handled")
+		return nil
+	})
+
+	blk := &ethpb.BeaconBlock{
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		Body: &ethpb.BeaconBlockBody{
+			RandaoReveal:   make([]byte, 96),
+			Eth1Data:       &ethpb.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)},
+			Graffiti:       make([]byte, 32),
+			VoluntaryExits: []*ethpb.VoluntaryExit{{Signature: make([]byte, 96)}},
+		},
+		Signature: make([]byte, 96),
+	}
+	sender := p.ReceiveRPC(topic, blk)
+
+	for i := 0; i < 100 && p.PeerReputation(sender) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if rep := p.PeerReputation(sender); rep != p2p.RepPenaltyOperationLimit {
+		t.Errorf("Wanted reputation %d for the sending peer, got %d", p2p.RepPenaltyOperationLimit, rep)
+	}
+}
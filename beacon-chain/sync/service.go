@@ -2,13 +2,18 @@ package sync
 
 import (
 	"context"
+	"sync"
 	"time"
 
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/gossipfault"
 )
 
 var _ = shared.Service(&RegularSync{})
@@ -18,15 +23,44 @@ type Config struct {
 	P2P        p2p.P2P
 	DB         db.Database
 	Operations *operations.Service
+	// DisabledTopics lists the gossip topics, such as /eth2/proposer_slashing, which the node
+	// neither subscribes to nor relays.
+	DisabledTopics []string
+	// ForkMonitor is fed the heads the peers report in their handshake.
+	ForkMonitor *cache.ForkMonitor
+	// RecentlyProcessed holds the roots of the blocks and attestations the node processed, from
+	// RPC or gossip, so that they are not relayed again.
+	RecentlyProcessed *cache.RecentlyProcessedCache
+	// SubnetBackbone keeps the node subscribed to a few random attestation subnets.
+	SubnetBackbone bool
+	// SubscribeAllSubnets subscribes the node to every attestation subnet instead of a few
+	// random ones.
+	SubscribeAllSubnets bool
+	// GossipFaults are the delays and drops injected into the gossip of blocks and attestations
+	// on research nodes. Nil injects no fault.
+	GossipFaults *gossipfault.Config
 }
 
 // NewRegularSync service.
 func NewRegularSync(cfg *Config) *RegularSync {
+	disabledTopics := make(map[string]bool)
+	for _, topic := range cfg.DisabledTopics {
+		disabledTopics[topic] = true
+	}
+	ctx, cancel := context.WithCancel(context.Background())
 	return &RegularSync{
-		ctx:        context.Background(),
-		db:         cfg.DB,
-		p2p:        cfg.P2P,
-		operations: cfg.Operations,
+		ctx:                 ctx,
+		cancel:              cancel,
+		db:                  cfg.DB,
+		p2p:                 cfg.P2P,
+		operations:          cfg.Operations,
+		disabledTopics:      disabledTopics,
+		peerStatuses:        make(map[peer.ID]*pb.Hello),
+		forkMonitor:         cfg.ForkMonitor,
+		recentlyProcessed:   cfg.RecentlyProcessed,
+		subnetBackbone:      cfg.SubnetBackbone,
+		subscribeAllSubnets: cfg.SubscribeAllSubnets,
+		gossipFaults:        cfg.GossipFaults,
 	}
 }
 
@@ -34,10 +68,24 @@ func NewRegularSync(cfg *Config) *RegularSync {
 // main entry point for network messages.
 type RegularSync struct {
 	ctx        context.Context
+	cancel     context.CancelFunc
 	p2p        p2p.P2P
 	db         db.Database
 	chain      *blockchain.ChainService
 	operations *operations.Service
+
+	disabledTopics map[string]bool
+
+	peerStatuses     map[peer.ID]*pb.Hello
+	peerStatusesLock sync.RWMutex
+	forkMonitor      *cache.ForkMonitor
+
+	recentlyProcessed *cache.RecentlyProcessedCache
+
+	subnetBackbone      bool
+	subscribeAllSubnets bool
+
+	gossipFaults *gossipfault.Config
 }
 
 // Start the regular sync service by initializing all of the p2p sync handlers.
@@ -46,13 +94,21 @@ func (r *RegularSync) Start() {
 	for !r.p2p.Started() {
 		time.Sleep(200 * time.Millisecond)
 	}
+	go r.trackPeerStatuses()
+	if opener, ok := r.p2p.(p2p.StreamOpener); ok {
+		go r.refreshPeerStatuses(opener)
+	}
 	r.registerRPCHandlers()
 	r.registerSubscribers()
+	if r.subnetBackbone || r.subscribeAllSubnets {
+		go r.subscribeToRandomSubnets()
+	}
 	log.Info("Regular sync started")
 }
 
 // Stop the regular sync service.
 func (r *RegularSync) Stop() error {
+	r.cancel()
 	return nil
 }
 
@@ -73,3 +129,9 @@ type Checker interface {
 	Syncing() bool
 	Status() error
 }
+
+// PeerForkReporter reports the forks the connected peers of the node are on. It is implemented
+// by the regular sync services of both the new and the deprecated sync.
+type PeerForkReporter interface {
+	PeerForks() []*PeerFork
+}
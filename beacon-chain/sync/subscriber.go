@@ -3,15 +3,35 @@ package sync
 import (
 	"bytes"
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 )
 
-var oneYear = 365 * 24 * time.Hour
+// seenOperationsCacheSize is the number of operations of each kind remembered, valid or not,
+// so that they are not validated nor propagated again. It is the default size of the LRU caches
+// the operations were remembered in before being kept in stores.
+const seenOperationsCacheSize = 5000
+
+// decodedMessages holds the pubsub messages decoded by the topic validators until the subscription
+// pipeline takes them, so that each message is only decoded once. Messages the pipeline does not
+// take, such as those of a cancelled subscription, expire.
+var decodedMessages = cache.NewStore(cache.StoreConfig{
+	Name:    "decoded_pubsub_messages",
+	MaxSize: 1000,
+	TTL:     time.Minute,
+})
+
+func decodedMessageKey(topic string, data *pubsub.Message) string {
+	return topic + string(data.From) + string(data.Seqno)
+}
 
 // prefix to add to keys, so that we can represent invalid objects
 var invalid = "invalidObject"
@@ -38,12 +58,12 @@ func noopValidator(_ context.Context, _ proto.Message, _ p2p.Broadcaster) bool {
 func (r *RegularSync) registerSubscribers() {
 	r.subscribe(
 		"/eth2/beacon_block",
-		noopValidator,
+		r.validateBeaconBlock,
 		notImplementedSubHandler, // TODO(3147): Implement.
 	)
 	r.subscribe(
 		"/eth2/beacon_attestation",
-		noopValidator,
+		r.validateBeaconAttestation,
 		notImplementedSubHandler, // TODO(3147): Implement.
 	)
 	r.subscribe(
@@ -65,7 +85,18 @@ func (r *RegularSync) registerSubscribers() {
 
 // subscribe to a given topic with a given validator and subscription handler.
 // The base protobuf message is used to initialize new messages for decoding.
+// Topics disabled by the node operator are not subscribed to, so their messages are neither
+// processed nor relayed.
 func (r *RegularSync) subscribe(topic string, validate validator, handle subHandler) {
+	if r.disabledTopics[topic] {
+		log.WithField("topic", topic).Info("Gossip topic disabled, not subscribing nor relaying its messages")
+		return
+	}
+	r.subscribeWithContext(r.ctx, topic, validate, handle)
+}
+
+// subscribeWithContext subscribes to a given topic until the context is done.
+func (r *RegularSync) subscribeWithContext(ctx context.Context, topic string, validate validator, handle subHandler) {
 	base := p2p.GossipTopicMappings[topic]
 	if base == nil {
 		panic(fmt.Sprintf("%s is not mapped to any message in GossipTopicMappings", topic))
@@ -74,6 +105,13 @@ func (r *RegularSync) subscribe(topic string, validate validator, handle subHand
 	topic += r.p2p.Encoding().ProtocolSuffix()
 	log := log.WithField("topic", topic)
 
+	// The faults of research nodes are injected in the same validator, as a topic only has one.
+	topicValidator, opts := r.gossipFaults.Wrap(topic, base, r.decodeValidator(topic, base))
+	if err := r.p2p.PubSub().RegisterTopicValidator(topic, topicValidator, opts...); err != nil {
+		// The validator is removed when unsubscribing, so this is a misconfiguration as well.
+		panic(err)
+	}
+
 	sub, err := r.p2p.PubSub().Subscribe(topic)
 	if err != nil {
 		// Any error subscribing to a PubSub topic would be the result of a misconfiguration of
@@ -84,16 +122,24 @@ func (r *RegularSync) subscribe(topic string, validate validator, handle subHand
 
 	// Pipeline decodes the incoming subscription data, runs the validation, and handles the
 	// message.
-	pipeline := func(data []byte) {
-		if data == nil {
+	pipeline := func(data *pubsub.Message) {
+		if data.Data == nil {
 			log.Warn("Received nil message on pubsub")
 			return
 		}
 
-		msg := proto.Clone(base)
-		if err := r.p2p.Encoding().Decode(bytes.NewBuffer(data), msg); err != nil {
-			log.WithError(err).Warn("Failed to decode pubsub message")
-			return
+		key := decodedMessageKey(topic, data)
+		var msg proto.Message
+		if decoded, ok := decodedMessages.Get(key); ok {
+			msg = decoded.(proto.Message)
+			decodedMessages.Delete(key)
+		} else {
+			// The message was evicted before the pipeline took it.
+			msg = proto.Clone(base)
+			if err := r.p2p.Encoding().Decode(bytes.NewBuffer(data.Data), msg); err != nil {
+				log.WithError(err).Warn("Failed to decode pubsub message")
+				return
+			}
 		}
 
 		if !validate(r.ctx, msg, r.p2p) {
@@ -113,16 +159,55 @@ func (r *RegularSync) subscribe(topic string, validate validator, handle subHand
 	// The main message loop for receiving incoming messages from this subscription.
 	messageLoop := func() {
 		for {
-			msg, err := sub.Next(r.ctx)
+			msg, err := sub.Next(ctx)
 			if err != nil {
+				if ctx.Err() != nil {
+					sub.Cancel()
+					if err := r.p2p.PubSub().UnregisterTopicValidator(topic); err != nil {
+						log.WithError(err).Error("Could not unregister topic validator")
+					}
+					log.Debug("Unsubscribed from topic")
+					return
+				}
 				log.WithError(err).Error("Subscription next failed")
 				// TODO(3147): Mark status unhealthy.
 				return
 			}
 
-			go pipeline(msg.Data)
+			go pipeline(msg)
 		}
 	}
 
 	go messageLoop()
 }
+
+// decodeValidator returns a pubsub topic validator decoding the messages of a topic, and rejecting
+// those which do not decode. Rejected messages are neither relayed nor delivered to the
+// subscription, and the peer which relayed a message which can never be valid to this node, rather
+// than its author, is penalized. The decoded messages are passed on to the subscription pipeline.
+func (r *RegularSync) decodeValidator(topic string, base proto.Message) pubsub.Validator {
+	return func(_ context.Context, pid peer.ID, data *pubsub.Message) bool {
+		msg := proto.Clone(base)
+		if err := r.p2p.Encoding().Decode(bytes.NewBuffer(data.Data), msg); err != nil {
+			if penalty := decodePenalty(err); penalty != 0 {
+				r.p2p.Reputation(pid, penalty)
+			}
+			log.WithError(err).WithField("peer", pid.Pretty()).Debug("Rejecting invalid pubsub message")
+			return false
+		}
+		decodedMessages.Set(decodedMessageKey(topic, data), msg)
+		return true
+	}
+}
+
+// decodePenalty returns the reputation penalty of the peer which sent a message failing to decode
+// with the given error, or 0 if the error does not prove the peer misbehaves.
+func decodePenalty(err error) int {
+	switch errors.Cause(err) {
+	case encoder.ErrOperationLimit:
+		return p2p.RepPenaltyOperationLimit
+	case encoder.ErrMessageTooLarge:
+		return p2p.RepPenaltyMessageTooLarge
+	}
+	return 0
+}
@@ -0,0 +1,92 @@
+package sync
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/sirupsen/logrus"
+)
+
+// subscribeToRandomSubnets keeps the node subscribed to a few random attestation subnets, or to
+// all of them if the service is configured to, on top of the subscriptions driven by validator
+// duties, so that every subnet has a backbone of long lived subscribers. The subnets are renewed
+// after a random number of epochs between EpochsPerRandomSubnetSubscription and twice that
+// number, and advertised to peers for as long as they are subscribed to. The subnets picked
+// again are kept subscribed to.
+func (r *RegularSync) subscribeToRandomSubnets() {
+	randGen := rand.New(rand.NewSource(roughtime.Now().UnixNano()))
+	count := params.BeaconConfig().RandomSubnetsPerValidator
+	if r.subscribeAllSubnets {
+		count = params.BeaconConfig().AttestationSubnetCount
+	}
+	subscriptions := make(map[uint64]context.CancelFunc)
+	for {
+		subnets := randomSubnets(randGen, count)
+		picked := make(map[uint64]bool, len(subnets))
+		for _, subnet := range subnets {
+			picked[subnet] = true
+		}
+		for subnet, cancel := range subscriptions {
+			if !picked[subnet] {
+				cancel()
+				delete(subscriptions, subnet)
+			}
+		}
+		for _, subnet := range subnets {
+			if _, ok := subscriptions[subnet]; ok {
+				continue
+			}
+			ctx, cancel := context.WithCancel(r.ctx)
+			r.subscribeWithContext(
+				ctx,
+				p2p.AttestationSubnetTopic(subnet),
+				r.validateBeaconAttestation,
+				notImplementedSubHandler, // TODO(3147): Implement.
+			)
+			r.p2p.AdvertiseSubnet(ctx, subnet)
+			subscriptions[subnet] = cancel
+		}
+		duration := randomSubnetDuration(randGen)
+		log.WithFields(logrus.Fields{
+			"subnets":  subnets,
+			"duration": duration,
+		}).Info("Subscribed to random attestation subnets")
+
+		select {
+		case <-time.After(duration):
+		case <-r.ctx.Done():
+			// The subscriptions are derived from the context of the service.
+			return
+		}
+	}
+}
+
+// randomSubnets returns count distinct attestation subnets picked at random, in increasing order.
+func randomSubnets(randGen *rand.Rand, count uint64) []uint64 {
+	subnetCount := params.BeaconConfig().AttestationSubnetCount
+	if count > subnetCount {
+		count = subnetCount
+	}
+	subnets := make([]uint64, count)
+	for i, subnet := range randGen.Perm(int(subnetCount))[:count] {
+		subnets[i] = uint64(subnet)
+	}
+	sort.Slice(subnets, func(i, j int) bool {
+		return subnets[i] < subnets[j]
+	})
+	return subnets
+}
+
+// randomSubnetDuration returns how long random subnets are subscribed to, between
+// EpochsPerRandomSubnetSubscription and twice that number of epochs.
+func randomSubnetDuration(randGen *rand.Rand) time.Duration {
+	minEpochs := params.BeaconConfig().EpochsPerRandomSubnetSubscription
+	epochs := minEpochs + uint64(randGen.Int63n(int64(minEpochs)+1))
+	slots := epochs * params.BeaconConfig().SlotsPerEpoch
+	return time.Duration(slots*params.BeaconConfig().SecondsPerSlot) * time.Second
+}
@@ -0,0 +1,178 @@
+package sync
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestRandomSubnets(t *testing.T) {
+	randGen := rand.New(rand.NewSource(1))
+	subnetCount := params.BeaconConfig().AttestationSubnetCount
+	for _, count := range []uint64{0, 1, 4, subnetCount, subnetCount + 1} {
+		subnets := randomSubnets(randGen, count)
+		wanted := count
+		if wanted > subnetCount {
+			wanted = subnetCount
+		}
+		if uint64(len(subnets)) != wanted {
+			t.Errorf("Wanted %d subnets, got %d", wanted, len(subnets))
+		}
+		for i, subnet := range subnets {
+			if subnet >= subnetCount {
+				t.Errorf("Wanted subnet below %d, got %d", subnetCount, subnet)
+			}
+			if i > 0 && subnet <= subnets[i-1] {
+				t.Errorf("Wanted distinct subnets in increasing order, got %v", subnets)
+			}
+		}
+	}
+}
+
+func TestRandomSubnetDuration(t *testing.T) {
+	randGen := rand.New(rand.NewSource(1))
+	epoch := time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+	minDuration := time.Duration(params.BeaconConfig().EpochsPerRandomSubnetSubscription) * epoch
+	for i := 0; i < 100; i++ {
+		d := randomSubnetDuration(randGen)
+		if d < minDuration || d > 2*minDuration {
+			t.Errorf("Wanted duration between %v and %v, got %v", minDuration, 2*minDuration, d)
+		}
+		if d%epoch != 0 {
+			t.Errorf("Wanted duration of whole epochs, got %v", d)
+		}
+	}
+}
+
+func TestSubscribeWithContext_UnsubscribesWhenDone(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	r := RegularSync{
+		ctx: context.Background(),
+		p2p: p,
+	}
+
+	topic := p2p.AttestationSubnetTopic(3)
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.subscribeWithContext(ctx, topic, noopValidator, func(_ context.Context, msg proto.Message) error {
+		if _, ok := msg.(*pb.Attestation); !ok {
+			t.Errorf("Unexpected incoming message: %+v", msg)
+		}
+		wg.Done()
+		return nil
+	})
+
+	p.ReceivePubSub(topic, &pb.Attestation{})
+	if testutil.WaitTimeout(&wg, time.Second) {
+		t.Fatal("Did not receive PubSub in 1 second")
+	}
+
+	cancel()
+	fullTopic := topic + p.Encoding().ProtocolSuffix()
+	for i := 0; i < 10; i++ {
+		if !containsTopic(p.PubSub().GetTopics(), fullTopic) {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Errorf("Wanted no subscription to %s after the context is done", fullTopic)
+}
+
+func TestStart_SubscribesToSubnetBackbone(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	r := NewRegularSync(&Config{P2P: p, SubnetBackbone: true})
+	r.Start()
+
+	wanted := params.BeaconConfig().RandomSubnetsPerValidator
+	subnets := waitForSubnets(t, p, wanted)
+	advertised := p.AdvertisedSubnets()
+	if len(advertised) != len(subnets) {
+		t.Fatalf("Wanted the subscribed subnets %v to be advertised, got %v", subnets, advertised)
+	}
+	for i, subnet := range subnets {
+		if advertised[i] != subnet {
+			t.Errorf("Wanted the subscribed subnets %v to be advertised, got %v", subnets, advertised)
+		}
+	}
+
+	if err := r.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	waitForSubnets(t, p, 0)
+	for i := 0; i < 10 && len(p.AdvertisedSubnets()) != 0; i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	if advertised := p.AdvertisedSubnets(); len(advertised) != 0 {
+		t.Errorf("Wanted no subnet advertised once stopped, got %v", advertised)
+	}
+}
+
+func TestStart_SubscribesToAllSubnets(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	r := NewRegularSync(&Config{P2P: p, SubscribeAllSubnets: true})
+	r.Start()
+	defer r.Stop()
+
+	waitForSubnets(t, p, params.BeaconConfig().AttestationSubnetCount)
+	if advertised := p.AdvertisedSubnets(); uint64(len(advertised)) != params.BeaconConfig().AttestationSubnetCount {
+		t.Errorf("Wanted every subnet advertised, got %v", advertised)
+	}
+}
+
+func TestStart_NoSubnetBackboneByDefault(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	r := NewRegularSync(&Config{P2P: p})
+	r.Start()
+	defer r.Stop()
+
+	time.Sleep(200 * time.Millisecond)
+	if subnets := subscribedSubnets(p); len(subnets) != 0 {
+		t.Errorf("Wanted no subnet subscription, got %v", subnets)
+	}
+}
+
+// waitForSubnets waits for the node to be subscribed to the given number of attestation subnets,
+// and returns them.
+func waitForSubnets(t *testing.T, p *p2ptest.TestP2P, count uint64) []uint64 {
+	var subnets []uint64
+	for i := 0; i < 20; i++ {
+		subnets = subscribedSubnets(p)
+		if uint64(len(subnets)) == count {
+			return subnets
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Fatalf("Wanted %d subscribed subnets, got %v", count, subnets)
+	return nil
+}
+
+// subscribedSubnets returns the attestation subnets subscribed to, in increasing order.
+func subscribedSubnets(p *p2ptest.TestP2P) []uint64 {
+	var subnets []uint64
+	for subnet := uint64(0); subnet < params.BeaconConfig().AttestationSubnetCount; subnet++ {
+		if containsTopic(p.PubSub().GetTopics(), p2p.AttestationSubnetTopic(subnet)+p.Encoding().ProtocolSuffix()) {
+			subnets = append(subnets, subnet)
+		}
+	}
+	return subnets
+}
+
+func containsTopic(topics []string, topic string) bool {
+	for _, t := range topics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
@@ -11,11 +11,9 @@ func (s *RegularSync) voluntaryExitSubscriber(ctx context.Context, msg proto.Mes
 }
 
 func (s *RegularSync) attesterSlashingSubscriber(ctx context.Context, msg proto.Message) error {
-	// TODO(#3259): Requires handlers in operations service to be implemented.
-	return nil
+	return s.operations.HandleAttesterSlashing(ctx, msg)
 }
 
 func (s *RegularSync) proposerSlashingSubscriber(ctx context.Context, msg proto.Message) error {
-	// TODO(#3259): Requires handlers in operations service to be implemented.
-	return nil
+	return s.operations.HandleProposerSlashing(ctx, msg)
 }
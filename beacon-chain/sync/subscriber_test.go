@@ -7,12 +7,16 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/gossipfault"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
 
 func TestSubscribe_ReceivesValidMessage(t *testing.T) {
+	decodedMessages.Clear()
 	p2p := p2ptest.NewTestP2P(t)
 	r := RegularSync{
 		ctx: context.Background(),
@@ -37,4 +41,92 @@ func TestSubscribe_ReceivesValidMessage(t *testing.T) {
 	if testutil.WaitTimeout(&wg, time.Second) {
 		t.Fatal("Did not receive PubSub in 1 second")
 	}
+	if n := decodedMessages.Len(); n != 0 {
+		t.Errorf("Wanted the message decoded by the topic validator to be taken, %d left", n)
+	}
+}
+
+func TestSubscribe_DisabledTopic(t *testing.T) {
+	p2p := p2ptest.NewTestP2P(t)
+	r := NewRegularSync(&Config{
+		P2P:            p2p,
+		DisabledTopics: []string{"/eth2/proposer_slashing"},
+	})
+
+	r.subscribe("/eth2/proposer_slashing", noopValidator, notImplementedSubHandler)
+	r.subscribe("/eth2/voluntary_exit", noopValidator, notImplementedSubHandler)
+
+	topics := p2p.PubSub().GetTopics()
+	suffix := p2p.Encoding().ProtocolSuffix()
+	if len(topics) != 1 || topics[0] != "/eth2/voluntary_exit"+suffix {
+		t.Errorf("Wanted to only subscribe to the voluntary exit topic, got %v", topics)
+	}
+}
+
+func TestSubscribe_PenalizesRelayerOfBlockOverOperationLimit(t *testing.T) {
+	prev := params.BeaconConfig()
+	defer params.OverrideBeaconConfig(prev)
+	cfg := *prev
+	cfg.MaxVoluntaryExits = 0
+	params.OverrideBeaconConfig(&cfg)
+
+	p := p2ptest.NewTestP2P(t)
+	r := RegularSync{
+		ctx: context.Background(),
+		p2p: p,
+	}
+	r.subscribe("/eth2/beacon_block", noopValidator, func(_ context.Context, _ proto.Message) error {
+		t.Error("Wanted the block to be rejected before being handled")
+		return nil
+	})
+
+	blk := &pb.BeaconBlock{
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		Body: &pb.BeaconBlockBody{
+			RandaoReveal:   make([]byte, 96),
+			Eth1Data:       &pb.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)},
+			Graffiti:       make([]byte, 32),
+			VoluntaryExits: []*pb.VoluntaryExit{{Signature: make([]byte, 96)}},
+		},
+		Signature: make([]byte, 96),
+	}
+	relayer := p.ReceivePubSub("/eth2/beacon_block", blk)
+
+	for i := 0; i < 100 && p.PeerReputation(relayer) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if rep := p.PeerReputation(relayer); rep != p2p.RepPenaltyOperationLimit {
+		t.Errorf("Wanted reputation %d for the relaying peer, got %d", p2p.RepPenaltyOperationLimit, rep)
+	}
+}
+
+func TestSubscribe_InjectsGossipFaults(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	r := RegularSync{
+		ctx: context.Background(),
+		p2p: p,
+		gossipFaults: &gossipfault.Config{
+			Attestations: gossipfault.Rule{DropRate: 1},
+		},
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	r.subscribe("/eth2/beacon_attestation", noopValidator, func(_ context.Context, _ proto.Message) error {
+		t.Error("Wanted the attestation to be dropped before being handled")
+		return nil
+	})
+	r.subscribe("/eth2/voluntary_exit", noopValidator, func(_ context.Context, _ proto.Message) error {
+		wg.Done()
+		return nil
+	})
+
+	p.ReceivePubSub("/eth2/beacon_attestation", &pb.Attestation{})
+	p.ReceivePubSub("/eth2/voluntary_exit", &pb.VoluntaryExit{Epoch: 55})
+
+	if testutil.WaitTimeout(&wg, time.Second) {
+		t.Fatal("Did not receive the voluntary exit, which has no fault, in 1 second")
+	}
+	time.Sleep(100 * time.Millisecond)
 }
@@ -4,7 +4,7 @@ import (
 	"context"
 
 	"github.com/gogo/protobuf/proto"
-	"github.com/karlseguin/ccache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
@@ -12,7 +12,11 @@ import (
 )
 
 // seenAttesterSlashings represents a cache of all the seen slashings
-var seenAttesterSlashings = ccache.New(ccache.Configure())
+var seenAttesterSlashings = cache.NewStore(cache.StoreConfig{
+	Name:    "seen_attester_slashings",
+	MaxSize: seenOperationsCacheSize,
+	LRU:     true,
+})
 
 func attSlashingCacheKey(slashing *ethpb.AttesterSlashing) (string, error) {
 	hash, err := hashutil.HashProto(slashing)
@@ -36,10 +40,10 @@ func (r *RegularSync) validateAttesterSlashing(ctx context.Context, msg proto.Me
 	}
 
 	invalidKey := invalid + cacheKey
-	if seenAttesterSlashings.Get(invalidKey) != nil {
+	if _, ok := seenAttesterSlashings.Get(invalidKey); ok {
 		return false
 	}
-	if seenAttesterSlashings.Get(cacheKey) != nil {
+	if _, ok := seenAttesterSlashings.Get(cacheKey); ok {
 		return false
 	}
 	state, err := r.db.HeadState(ctx)
@@ -50,10 +54,10 @@ func (r *RegularSync) validateAttesterSlashing(ctx context.Context, msg proto.Me
 
 	if err := blocks.VerifyAttesterSlashing(state, slashing); err != nil {
 		log.WithError(err).Warn("Received invalid attester slashing")
-		seenAttesterSlashings.Set(invalidKey, true /*value*/, oneYear /*TTL*/)
+		seenAttesterSlashings.Set(invalidKey, true)
 		return false
 	}
-	seenAttesterSlashings.Set(cacheKey, true /*value*/, oneYear /*TTL*/)
+	seenAttesterSlashings.Set(cacheKey, true)
 
 	if err := p.Broadcast(ctx, slashing); err != nil {
 		log.WithError(err).Error("Failed to propagate attester slashing")
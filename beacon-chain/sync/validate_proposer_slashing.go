@@ -4,7 +4,7 @@ import (
 	"context"
 
 	"github.com/gogo/protobuf/proto"
-	"github.com/karlseguin/ccache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
@@ -12,7 +12,11 @@ import (
 )
 
 // seenProposerSlashings represents a cache of all the seen slashings
-var seenProposerSlashings = ccache.New(ccache.Configure())
+var seenProposerSlashings = cache.NewStore(cache.StoreConfig{
+	Name:    "seen_proposer_slashings",
+	MaxSize: seenOperationsCacheSize,
+	LRU:     true,
+})
 
 func propSlashingCacheKey(slashing *ethpb.ProposerSlashing) (string, error) {
 	hash, err := hashutil.HashProto(slashing)
@@ -36,10 +40,10 @@ func (r *RegularSync) validateProposerSlashing(ctx context.Context, msg proto.Me
 	}
 
 	invalidKey := invalid + cacheKey
-	if seenProposerSlashings.Get(invalidKey) != nil {
+	if _, ok := seenProposerSlashings.Get(invalidKey); ok {
 		return false
 	}
-	if seenProposerSlashings.Get(cacheKey) != nil {
+	if _, ok := seenProposerSlashings.Get(cacheKey); ok {
 		return false
 	}
 	state, err := r.db.HeadState(ctx)
@@ -50,10 +54,10 @@ func (r *RegularSync) validateProposerSlashing(ctx context.Context, msg proto.Me
 
 	if err := blocks.VerifyProposerSlashing(state, slashing); err != nil {
 		log.WithError(err).Warn("Received invalid proposer slashing")
-		seenProposerSlashings.Set(invalidKey, true /*value*/, oneYear /*TTL*/)
+		seenProposerSlashings.Set(invalidKey, true)
 		return false
 	}
-	seenProposerSlashings.Set(cacheKey, true /*value*/, oneYear /*TTL*/)
+	seenProposerSlashings.Set(cacheKey, true)
 
 	if err := p.Broadcast(ctx, slashing); err != nil {
 		log.WithError(err).Error("Failed to propagate proposer slashing")
@@ -0,0 +1,53 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// validateBeaconBlock drops the blocks recently processed by the node, such as a block it
+// proposed over RPC and receives back by gossip. Blocks are not processed by this sync yet, so
+// they are not marked as processed here, which would make the RPC server skip them.
+func (r *RegularSync) validateBeaconBlock(ctx context.Context, msg proto.Message, p p2p.Broadcaster) bool {
+	blk, ok := msg.(*ethpb.BeaconBlock)
+	if !ok {
+		return false
+	}
+	root, err := ssz.SigningRoot(blk)
+	if err != nil {
+		log.WithError(err).Error("Could not tree hash block")
+		return false
+	}
+	seen, err := r.recentlyProcessed.Seen(root)
+	if err != nil {
+		log.WithError(err).Error("Could not check recently processed blocks")
+		return false
+	}
+	return !seen
+}
+
+// validateBeaconAttestation drops the attestations recently processed by the node, such as an
+// attestation submitted over RPC and received back by gossip. Like blocks, attestations are not
+// marked as processed until this sync processes them.
+func (r *RegularSync) validateBeaconAttestation(ctx context.Context, msg proto.Message, p p2p.Broadcaster) bool {
+	att, ok := msg.(*ethpb.Attestation)
+	if !ok {
+		return false
+	}
+	hash, err := hashutil.HashProto(att)
+	if err != nil {
+		log.WithError(err).Error("Could not hash attestation")
+		return false
+	}
+	seen, err := r.recentlyProcessed.Seen(hash)
+	if err != nil {
+		log.WithError(err).Error("Could not check recently processed attestations")
+		return false
+	}
+	return !seen
+}
@@ -0,0 +1,58 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+func TestValidateBeaconBlock_DropsRecentlyProcessedBlock(t *testing.T) {
+	ctx := context.Background()
+	p := p2ptest.NewTestP2P(t)
+	r := &RegularSync{recentlyProcessed: cache.NewRecentlyProcessedCache()}
+	blk := &ethpb.BeaconBlock{Slot: 5}
+
+	if !r.validateBeaconBlock(ctx, blk, p) {
+		t.Fatal("Wanted a block not processed yet to be valid")
+	}
+	root, err := ssz.SigningRoot(blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seen, _ := r.recentlyProcessed.Seen(root); seen {
+		t.Error("Wanted the block not to be marked as processed by the validator")
+	}
+
+	if _, err := r.recentlyProcessed.MarkProcessed(root, cache.KindBlock, cache.SourceRPC); err != nil {
+		t.Fatal(err)
+	}
+	if r.validateBeaconBlock(ctx, blk, p) {
+		t.Error("Wanted a block proposed over RPC to be dropped")
+	}
+}
+
+func TestValidateBeaconAttestation_DropsRecentlyProcessedAttestation(t *testing.T) {
+	ctx := context.Background()
+	p := p2ptest.NewTestP2P(t)
+	r := &RegularSync{recentlyProcessed: cache.NewRecentlyProcessedCache()}
+	att := &ethpb.Attestation{AggregationBits: []byte{0x03}}
+
+	if !r.validateBeaconAttestation(ctx, att, p) {
+		t.Fatal("Wanted an attestation not processed yet to be valid")
+	}
+	hash, err := hashutil.HashProto(att)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.recentlyProcessed.MarkProcessed(hash, cache.KindAttestation, cache.SourceRPC); err != nil {
+		t.Fatal(err)
+	}
+	if r.validateBeaconAttestation(ctx, att, p) {
+		t.Error("Wanted an attestation submitted over RPC to be dropped")
+	}
+}
@@ -5,14 +5,18 @@ import (
 	"fmt"
 
 	"github.com/gogo/protobuf/proto"
-	"github.com/karlseguin/ccache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 )
 
 // seenExits tracks exits we've already seen to prevent feedback loop.
-var seenExits = ccache.New(ccache.Configure())
+var seenExits = cache.NewStore(cache.StoreConfig{
+	Name:    "seen_voluntary_exits",
+	MaxSize: seenOperationsCacheSize,
+	LRU:     true,
+})
 
 func exitCacheKey(exit *ethpb.VoluntaryExit) string {
 	return fmt.Sprintf("%d-%d", exit.Epoch, exit.ValidatorIndex)
@@ -27,10 +31,10 @@ func (r *RegularSync) validateVoluntaryExit(ctx context.Context, msg proto.Messa
 	}
 	cacheKey := exitCacheKey(exit)
 	invalidKey := invalid + cacheKey
-	if seenExits.Get(invalidKey) != nil {
+	if _, ok := seenExits.Get(invalidKey); ok {
 		return false
 	}
-	if seenExits.Get(cacheKey) != nil {
+	if _, ok := seenExits.Get(cacheKey); ok {
 		return false
 	}
 	state, err := r.db.HeadState(ctx)
@@ -40,10 +44,10 @@ func (r *RegularSync) validateVoluntaryExit(ctx context.Context, msg proto.Messa
 	}
 	if err := blocks.VerifyExit(state, exit); err != nil {
 		log.WithError(err).Warn("Received invalid voluntary exit")
-		seenExits.Set(invalidKey, true /*value*/, oneYear /*TTL*/)
+		seenExits.Set(invalidKey, true)
 		return false
 	}
-	seenExits.Set(cacheKey, true /*value*/, oneYear /*TTL*/)
+	seenExits.Set(cacheKey, true)
 
 	if err := p.Broadcast(ctx, exit); err != nil {
 		log.WithError(err).Error("Failed to propagate voluntary exit")
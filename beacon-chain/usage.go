@@ -74,15 +74,56 @@ var appHelpFlagGroups = []flagGroup{
 	{
 		Name: "beacon-chain",
 		Flags: []cli.Flag{
+			flags.ModeFlag,
 			flags.NoCustomConfigFlag,
 			flags.DepositContractFlag,
 			flags.Web3ProviderFlag,
 			flags.RPCPort,
 			flags.CertFlag,
 			flags.KeyFlag,
+			flags.RPCQuotasFileFlag,
+			flags.EnableReplicationFlag,
+			flags.ReplicaOfFlag,
+			flags.ReplicaCertFlag,
+			flags.ReplicaStateIntervalFlag,
+			flags.ReplicaAPIKeyFlag,
+			flags.DisableValidatorRPCFlag,
+			flags.SubnetBackboneFlag,
+			flags.SubscribeAllSubnetsFlag,
 			flags.EnableDBCleanup,
 			flags.GRPCGatewayPort,
+			flags.MaxPendingAttestationsFlag,
+			flags.MaxPendingAttestationsPerSlotFlag,
+			flags.MaxPendingExitsFlag,
+			flags.MaxPendingSlashingsFlag,
+			flags.OpsPoolEvictionPolicyFlag,
+			flags.DBSnapshotFlag,
+			flags.DBSnapshotRetentionFlag,
+			flags.ForkChoiceMaxDepthFlag,
+			flags.ForkChoiceAuditSizeFlag,
+			flags.BlockBroadcastPolicyFlag,
+			flags.BlockBroadcastDelayFlag,
+			flags.HistoricalStateRetentionFlag,
+			flags.HistoricalStateRetentionEpochsFlag,
+			flags.HistoricalStateRetentionDryRunFlag,
+			flags.StaleForkDepthFlag,
+			flags.StaleForkCleanupIntervalFlag,
+			flags.AnalyzeEpochBalancesFlag,
+			flags.MassPenaltyFractionFlag,
+			flags.AdminSocketFlag,
+			flags.MinGenesisTimeFlag,
+			flags.GenesisDelayFlag,
+			flags.MaxSkipSlotsFlag,
+			flags.ShutdownBroadcastGracePeriodFlag,
+			flags.DisableVoluntaryExitGossipFlag,
+			flags.DisableProposerSlashingGossipFlag,
+			flags.DisableAttesterSlashingGossipFlag,
+			flags.MonitorDepositsFlag,
+			flags.DepositInclusionWindowFlag,
 			flags.HTTPWeb3ProviderFlag,
+			flags.Web3ProviderRequestsPerSecondFlag,
+			flags.Web3ProviderMaxAttemptsFlag,
+			flags.Web3ProviderRequestTimeoutFlag,
 		},
 	},
 	{
@@ -95,6 +136,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.StaticPeers,
 			cmd.EnableUPnPFlag,
 			cmd.P2PEncoding,
+			cmd.P2PIPPreference,
 		},
 	},
 	{
@@ -41,6 +41,8 @@ func main() {
 	var depositAmount int64
 	var depositDelay int64
 	var randomKey bool
+	var deterministicKeys uint64
+	var deterministicKeyOffset uint64
 
 	customFormatter := new(prefixed.TextFormatter)
 	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
@@ -112,6 +114,16 @@ func main() {
 			Usage:       "Use a randomly generated keystore key",
 			Destination: &randomKey,
 		},
+		cli.Uint64Flag{
+			Name:        "deterministic-keys",
+			Usage:       "Deposit this number of deterministic validator keys, as used by validator clients running with --simulate",
+			Destination: &deterministicKeys,
+		},
+		cli.Uint64Flag{
+			Name:        "deterministic-key-offset",
+			Usage:       "Index of the first deterministic validator key to deposit",
+			Destination: &deterministicKeyOffset,
+		},
 	}
 
 	app.Action = func(c *cli.Context) {
@@ -168,7 +180,15 @@ func main() {
 		}
 
 		validatorKeys := make(map[string]*prysmKeyStore.Key)
-		if randomKey {
+		if deterministicKeys > 0 {
+			for i := deterministicKeyOffset; i < deterministicKeyOffset+deterministicKeys; i++ {
+				validatorKey, err := prysmKeyStore.DeterministicKey(i)
+				if err != nil {
+					log.Fatalf("Could not generate deterministic key: %v", err)
+				}
+				validatorKeys[hex.EncodeToString(validatorKey.PublicKey.Marshal())] = validatorKey
+			}
+		} else if randomKey {
 			validatorKey, err := prysmKeyStore.NewKey(rand.Reader)
 			validatorKeys[hex.EncodeToString(validatorKey.PublicKey.Marshal())] = validatorKey
 			if err != nil {
@@ -60,13 +60,14 @@ func (ValidatorRole) EnumDescriptor() ([]byte, []int) {
 type ValidatorStatus int32
 
 const (
-	ValidatorStatus_UNKNOWN_STATUS ValidatorStatus = 0
-	ValidatorStatus_PENDING_ACTIVE ValidatorStatus = 1
-	ValidatorStatus_ACTIVE         ValidatorStatus = 2
-	ValidatorStatus_INITIATED_EXIT ValidatorStatus = 3
-	ValidatorStatus_WITHDRAWABLE   ValidatorStatus = 4
-	ValidatorStatus_EXITED         ValidatorStatus = 5
-	ValidatorStatus_EXITED_SLASHED ValidatorStatus = 6
+	ValidatorStatus_UNKNOWN_STATUS   ValidatorStatus = 0
+	ValidatorStatus_PENDING_ACTIVE   ValidatorStatus = 1
+	ValidatorStatus_ACTIVE           ValidatorStatus = 2
+	ValidatorStatus_INITIATED_EXIT   ValidatorStatus = 3
+	ValidatorStatus_WITHDRAWABLE     ValidatorStatus = 4
+	ValidatorStatus_EXITED           ValidatorStatus = 5
+	ValidatorStatus_EXITED_SLASHED   ValidatorStatus = 6
+	ValidatorStatus_DEPOSIT_RECEIVED ValidatorStatus = 7
 )
 
 var ValidatorStatus_name = map[int32]string{
@@ -77,16 +78,18 @@ var ValidatorStatus_name = map[int32]string{
 	4: "WITHDRAWABLE",
 	5: "EXITED",
 	6: "EXITED_SLASHED",
+	7: "DEPOSIT_RECEIVED",
 }
 
 var ValidatorStatus_value = map[string]int32{
-	"UNKNOWN_STATUS": 0,
-	"PENDING_ACTIVE": 1,
-	"ACTIVE":         2,
-	"INITIATED_EXIT": 3,
-	"WITHDRAWABLE":   4,
-	"EXITED":         5,
-	"EXITED_SLASHED": 6,
+	"UNKNOWN_STATUS":   0,
+	"PENDING_ACTIVE":   1,
+	"ACTIVE":           2,
+	"INITIATED_EXIT":   3,
+	"WITHDRAWABLE":     4,
+	"EXITED":           5,
+	"EXITED_SLASHED":   6,
+	"DEPOSIT_RECEIVED": 7,
 }
 
 func (x ValidatorStatus) String() string {
@@ -204,6 +207,8 @@ type AttestationRequest struct {
 	PocBit               []byte   `protobuf:"bytes,2,opt,name=poc_bit,json=pocBit,proto3" json:"poc_bit,omitempty"`
 	Slot                 uint64   `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
 	Shard                uint64   `protobuf:"varint,4,opt,name=shard,proto3" json:"shard,omitempty"`
+	CommitteeIndex       uint64   `protobuf:"varint,5,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	CommitteeLength      uint64   `protobuf:"varint,6,opt,name=committee_length,json=committeeLength,proto3" json:"committee_length,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -270,6 +275,20 @@ func (m *AttestationRequest) GetShard() uint64 {
 	return 0
 }
 
+func (m *AttestationRequest) GetCommitteeIndex() uint64 {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+func (m *AttestationRequest) GetCommitteeLength() uint64 {
+	if m != nil {
+		return m.CommitteeLength
+	}
+	return 0
+}
+
 type AttestResponse struct {
 	Root                 []byte   `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -901,6 +920,7 @@ func (m *AssignmentRequest) GetPublicKeys() [][]byte {
 
 type AssignmentResponse struct {
 	ValidatorAssignment  []*AssignmentResponse_ValidatorAssignment `protobuf:"bytes,1,rep,name=validator_assignment,json=validatorAssignment,proto3" json:"validator_assignment,omitempty"`
+	Epoch                uint64                                    `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
 	XXX_unrecognized     []byte                                    `json:"-"`
 	XXX_sizecache        int32                                     `json:"-"`
@@ -946,16 +966,25 @@ func (m *AssignmentResponse) GetValidatorAssignment() []*AssignmentResponse_Vali
 	return nil
 }
 
+func (m *AssignmentResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
 type AssignmentResponse_ValidatorAssignment struct {
-	Committee            []uint64        `protobuf:"varint,1,rep,packed,name=committee,proto3" json:"committee,omitempty"`
-	Shard                uint64          `protobuf:"varint,2,opt,name=shard,proto3" json:"shard,omitempty"`
-	Slot                 uint64          `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
-	IsProposer           bool            `protobuf:"varint,4,opt,name=is_proposer,json=isProposer,proto3" json:"is_proposer,omitempty"`
-	PublicKey            []byte          `protobuf:"bytes,5,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Status               ValidatorStatus `protobuf:"varint,6,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Committee              []uint64        `protobuf:"varint,1,rep,packed,name=committee,proto3" json:"committee,omitempty"`
+	Shard                  uint64          `protobuf:"varint,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	Slot                   uint64          `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	IsProposer             bool            `protobuf:"varint,4,opt,name=is_proposer,json=isProposer,proto3" json:"is_proposer,omitempty"`
+	PublicKey              []byte          `protobuf:"bytes,5,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Status                 ValidatorStatus `protobuf:"varint,6,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
+	CommitteeIndex         uint64          `protobuf:"varint,7,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	NextEpochProposerSlots []uint64        `protobuf:"varint,8,rep,packed,name=next_epoch_proposer_slots,json=nextEpochProposerSlots,proto3" json:"next_epoch_proposer_slots,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
+	XXX_unrecognized       []byte          `json:"-"`
+	XXX_sizecache          int32           `json:"-"`
 }
 
 func (m *AssignmentResponse_ValidatorAssignment) Reset() {
@@ -1035,15 +1064,32 @@ func (m *AssignmentResponse_ValidatorAssignment) GetStatus() ValidatorStatus {
 	return ValidatorStatus_UNKNOWN_STATUS
 }
 
+func (m *AssignmentResponse_ValidatorAssignment) GetCommitteeIndex() uint64 {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+func (m *AssignmentResponse_ValidatorAssignment) GetNextEpochProposerSlots() []uint64 {
+	if m != nil {
+		return m.NextEpochProposerSlots
+	}
+	return nil
+}
+
 type ValidatorStatusResponse struct {
-	Status                    ValidatorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
-	Eth1DepositBlockNumber    uint64          `protobuf:"varint,2,opt,name=eth1_deposit_block_number,json=eth1DepositBlockNumber,proto3" json:"eth1_deposit_block_number,omitempty"`
-	DepositInclusionSlot      uint64          `protobuf:"varint,3,opt,name=deposit_inclusion_slot,json=depositInclusionSlot,proto3" json:"deposit_inclusion_slot,omitempty"`
-	ActivationEpoch           uint64          `protobuf:"varint,4,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
-	PositionInActivationQueue uint64          `protobuf:"varint,5,opt,name=position_in_activation_queue,json=positionInActivationQueue,proto3" json:"position_in_activation_queue,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{}        `json:"-"`
-	XXX_unrecognized          []byte          `json:"-"`
-	XXX_sizecache             int32           `json:"-"`
+	Status                     ValidatorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
+	Eth1DepositBlockNumber     uint64          `protobuf:"varint,2,opt,name=eth1_deposit_block_number,json=eth1DepositBlockNumber,proto3" json:"eth1_deposit_block_number,omitempty"`
+	DepositInclusionSlot       uint64          `protobuf:"varint,3,opt,name=deposit_inclusion_slot,json=depositInclusionSlot,proto3" json:"deposit_inclusion_slot,omitempty"`
+	ActivationEpoch            uint64          `protobuf:"varint,4,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
+	PositionInActivationQueue  uint64          `protobuf:"varint,5,opt,name=position_in_activation_queue,json=positionInActivationQueue,proto3" json:"position_in_activation_queue,omitempty"`
+	ActivationEligibilityEpoch uint64          `protobuf:"varint,6,opt,name=activation_eligibility_epoch,json=activationEligibilityEpoch,proto3" json:"activation_eligibility_epoch,omitempty"`
+	ExitEpoch                  uint64          `protobuf:"varint,7,opt,name=exit_epoch,json=exitEpoch,proto3" json:"exit_epoch,omitempty"`
+	WithdrawableEpoch          uint64          `protobuf:"varint,8,opt,name=withdrawable_epoch,json=withdrawableEpoch,proto3" json:"withdrawable_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{}        `json:"-"`
+	XXX_unrecognized           []byte          `json:"-"`
+	XXX_sizecache              int32           `json:"-"`
 }
 
 func (m *ValidatorStatusResponse) Reset()         { *m = ValidatorStatusResponse{} }
@@ -1114,6 +1160,27 @@ func (m *ValidatorStatusResponse) GetPositionInActivationQueue() uint64 {
 	return 0
 }
 
+func (m *ValidatorStatusResponse) GetActivationEligibilityEpoch() uint64 {
+	if m != nil {
+		return m.ActivationEligibilityEpoch
+	}
+	return 0
+}
+
+func (m *ValidatorStatusResponse) GetExitEpoch() uint64 {
+	if m != nil {
+		return m.ExitEpoch
+	}
+	return 0
+}
+
+func (m *ValidatorStatusResponse) GetWithdrawableEpoch() uint64 {
+	if m != nil {
+		return m.WithdrawableEpoch
+	}
+	return 0
+}
+
 type DomainRequest struct {
 	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	Domain               []byte   `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
@@ -1389,2135 +1456,7119 @@ func (m *TreeBlockSlotRequest) GetSlotTo() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorRole", ValidatorRole_name, ValidatorRole_value)
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
-	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
-	proto.RegisterType((*ProposeResponse)(nil), "ethereum.beacon.rpc.v1.ProposeResponse")
-	proto.RegisterType((*AttestationRequest)(nil), "ethereum.beacon.rpc.v1.AttestationRequest")
-	proto.RegisterType((*AttestResponse)(nil), "ethereum.beacon.rpc.v1.AttestResponse")
-	proto.RegisterType((*ValidatorPerformanceRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceRequest")
-	proto.RegisterType((*ValidatorPerformanceResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceResponse")
-	proto.RegisterType((*ValidatorActivationRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationRequest")
-	proto.RegisterType((*ValidatorActivationResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse")
-	proto.RegisterType((*ValidatorActivationResponse_Status)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse.Status")
-	proto.RegisterType((*ExitedValidatorsRequest)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsRequest")
-	proto.RegisterType((*ExitedValidatorsResponse)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsResponse")
-	proto.RegisterType((*ChainStartResponse)(nil), "ethereum.beacon.rpc.v1.ChainStartResponse")
-	proto.RegisterType((*ValidatorIndexRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexRequest")
-	proto.RegisterType((*ValidatorIndexResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexResponse")
-	proto.RegisterType((*AssignmentRequest)(nil), "ethereum.beacon.rpc.v1.AssignmentRequest")
-	proto.RegisterType((*AssignmentResponse)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse")
-	proto.RegisterType((*AssignmentResponse_ValidatorAssignment)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse.ValidatorAssignment")
-	proto.RegisterType((*ValidatorStatusResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorStatusResponse")
-	proto.RegisterType((*DomainRequest)(nil), "ethereum.beacon.rpc.v1.DomainRequest")
-	proto.RegisterType((*DomainResponse)(nil), "ethereum.beacon.rpc.v1.DomainResponse")
-	proto.RegisterType((*BlockTreeResponse)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse")
-	proto.RegisterType((*BlockTreeResponse_TreeNode)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse.TreeNode")
-	proto.RegisterType((*TreeBlockSlotRequest)(nil), "ethereum.beacon.rpc.v1.TreeBlockSlotRequest")
+type ValidatorQueueResponse struct {
+	ChurnLimit                uint64   `protobuf:"varint,1,opt,name=churn_limit,json=churnLimit,proto3" json:"churn_limit,omitempty"`
+	ActivationQueueLength     uint64   `protobuf:"varint,2,opt,name=activation_queue_length,json=activationQueueLength,proto3" json:"activation_queue_length,omitempty"`
+	ExitQueueLength           uint64   `protobuf:"varint,3,opt,name=exit_queue_length,json=exitQueueLength,proto3" json:"exit_queue_length,omitempty"`
+	PositionInActivationQueue uint64   `protobuf:"varint,4,opt,name=position_in_activation_queue,json=positionInActivationQueue,proto3" json:"position_in_activation_queue,omitempty"`
+	EpochsUntilActivation     uint64   `protobuf:"varint,5,opt,name=epochs_until_activation,json=epochsUntilActivation,proto3" json:"epochs_until_activation,omitempty"`
+	EpochsUntilExit           uint64   `protobuf:"varint,6,opt,name=epochs_until_exit,json=epochsUntilExit,proto3" json:"epochs_until_exit,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285) }
-
-var fileDescriptor_9eb4e94b85965285 = []byte{
-	// 1859 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x5f, 0x6f, 0x1b, 0xc7,
-	0x11, 0xcf, 0x51, 0x12, 0x2d, 0x8f, 0x28, 0x89, 0x5a, 0xcb, 0xb2, 0x4c, 0xff, 0xbb, 0x5e, 0x9d,
-	0x54, 0x12, 0xa2, 0xa3, 0x44, 0x07, 0x46, 0xaa, 0xc0, 0x4d, 0x29, 0x89, 0x96, 0x59, 0x0b, 0x14,
-	0x73, 0xa4, 0xed, 0x14, 0x79, 0xb8, 0x2e, 0x8f, 0x6b, 0xf2, 0x6a, 0xde, 0xed, 0xf9, 0x6e, 0xc9,
-	0x58, 0x7d, 0x28, 0xd0, 0xbe, 0xf6, 0xa9, 0xe9, 0x07, 0xc8, 0x87, 0xe8, 0x43, 0x81, 0xa2, 0xcf,
-	0x45, 0xd0, 0xa7, 0x02, 0x7d, 0x6c, 0x51, 0x14, 0x46, 0x1e, 0xfa, 0x31, 0x82, 0xfd, 0x73, 0xc7,
-	0x13, 0x29, 0x5a, 0x94, 0x9f, 0x78, 0x3b, 0x33, 0xbf, 0x99, 0xd9, 0xd9, 0xd9, 0x99, 0x59, 0x82,
-	0x11, 0x84, 0x94, 0xd1, 0x62, 0x8b, 0x60, 0x87, 0xfa, 0xc5, 0x30, 0x70, 0x8a, 0x83, 0xdd, 0x62,
-	0x44, 0xc2, 0x81, 0xeb, 0x90, 0xc8, 0x14, 0x4c, 0xb4, 0x46, 0x58, 0x97, 0x84, 0xa4, 0xef, 0x99,
-	0x52, 0xcc, 0x0c, 0x03, 0xc7, 0x1c, 0xec, 0x16, 0x6e, 0x75, 0x28, 0xed, 0xf4, 0x48, 0x51, 0x48,
-	0xb5, 0xfa, 0x2f, 0x8b, 0xc4, 0x0b, 0xd8, 0xa9, 0x04, 0x15, 0x3e, 0x94, 0x8a, 0x09, 0xeb, 0x16,
-	0x07, 0xbb, 0xb8, 0x17, 0x74, 0xf1, 0xae, 0xb2, 0x62, 0xb7, 0x7a, 0xd4, 0x79, 0xa5, 0xc4, 0xee,
-	0x9f, 0x23, 0x86, 0x19, 0x23, 0x11, 0xc3, 0xcc, 0xa5, 0xbe, 0x92, 0xba, 0xad, 0x2c, 0xe1, 0xc0,
-	0x2d, 0x62, 0xdf, 0xa7, 0x92, 0xa9, 0xfc, 0x2b, 0x7c, 0x2c, 0x7e, 0x9c, 0xed, 0x0e, 0xf1, 0xb7,
-	0xa3, 0xaf, 0x71, 0xa7, 0x43, 0xc2, 0x22, 0x0d, 0x84, 0xc4, 0xb8, 0xb4, 0x71, 0x04, 0xb9, 0x7d,
-	0xee, 0x80, 0x45, 0x5e, 0xf7, 0x49, 0xc4, 0x10, 0x82, 0xd9, 0xa8, 0x47, 0xd9, 0xba, 0xa6, 0x6b,
-	0x1b, 0xb3, 0x96, 0xf8, 0x46, 0x3f, 0x86, 0xc5, 0x10, 0xfb, 0x6d, 0x4c, 0xed, 0x90, 0x0c, 0x08,
-	0xee, 0xad, 0x67, 0x74, 0x6d, 0x23, 0x67, 0xe5, 0x24, 0xd1, 0x12, 0x34, 0x63, 0x07, 0x96, 0xeb,
-	0x21, 0x0d, 0x68, 0x44, 0x2c, 0x12, 0x05, 0xd4, 0x8f, 0x08, 0xba, 0x03, 0x20, 0x36, 0x67, 0x87,
-	0x54, 0x69, 0xcc, 0x59, 0x57, 0x05, 0xc5, 0xa2, 0x94, 0x19, 0x03, 0x40, 0xe5, 0xe1, 0xde, 0x62,
-	0x07, 0xee, 0x00, 0x04, 0xfd, 0x56, 0xcf, 0x75, 0xec, 0x57, 0xe4, 0x34, 0x06, 0x49, 0xca, 0x53,
-	0x72, 0x8a, 0x6e, 0xc0, 0x95, 0x80, 0x3a, 0x76, 0xcb, 0x65, 0xca, 0x8b, 0x6c, 0x40, 0x9d, 0x7d,
-	0x77, 0xe8, 0xf8, 0x4c, 0xca, 0xf1, 0x55, 0x98, 0x8b, 0xba, 0x38, 0x6c, 0xaf, 0xcf, 0x0a, 0xa2,
-	0x5c, 0x18, 0xf7, 0x61, 0x49, 0xda, 0x4d, 0x1c, 0x45, 0x30, 0x9b, 0x72, 0x51, 0x7c, 0x1b, 0x75,
-	0xb8, 0xf5, 0x1c, 0xf7, 0xdc, 0x36, 0x66, 0x34, 0xac, 0x93, 0xf0, 0x25, 0x0d, 0x3d, 0xec, 0x3b,
-	0xe4, 0x5d, 0x71, 0x3a, 0xeb, 0x7a, 0x66, 0xc4, 0x75, 0xe3, 0x7b, 0x0d, 0x6e, 0x9f, 0xaf, 0x52,
-	0xb9, 0xb1, 0x0e, 0x57, 0x5a, 0xb8, 0xc7, 0x49, 0x4a, 0x6d, 0xbc, 0x44, 0x9b, 0x90, 0x67, 0x94,
-	0xe1, 0x9e, 0x3d, 0x88, 0xf1, 0x91, 0xd0, 0x3f, 0x6b, 0x2d, 0x0b, 0x7a, 0xa2, 0x36, 0x42, 0x0f,
-	0xe1, 0x86, 0x14, 0xc5, 0x0e, 0x73, 0x07, 0x24, 0x8d, 0x90, 0xa1, 0xb9, 0x2e, 0xd8, 0x65, 0xc1,
-	0x4d, 0xe1, 0x8e, 0x40, 0xc7, 0x03, 0x12, 0xe2, 0x0e, 0x19, 0x43, 0xda, 0xb1, 0x57, 0x3c, 0x8c,
-	0x19, 0xeb, 0x8e, 0x92, 0x1b, 0x51, 0xb1, 0x2f, 0x85, 0x8c, 0x47, 0x50, 0x48, 0x68, 0x42, 0xe4,
-	0xcc, 0xf1, 0xde, 0x83, 0x85, 0x61, 0x8c, 0xa2, 0x75, 0x4d, 0x9f, 0xd9, 0xc8, 0x59, 0x90, 0x04,
-	0x29, 0x32, 0xbe, 0xcd, 0xa4, 0x02, 0x9f, 0xc6, 0xab, 0x20, 0x3d, 0x84, 0xeb, 0x58, 0x52, 0x49,
-	0xdb, 0x1e, 0x53, 0xb5, 0x9f, 0x59, 0xd7, 0xac, 0x6b, 0x89, 0x40, 0x3d, 0xd1, 0x8b, 0x9e, 0xc3,
-	0x3c, 0xcf, 0xb4, 0x7e, 0x44, 0x78, 0xe8, 0x66, 0x36, 0x16, 0x4a, 0x7b, 0xe6, 0xf9, 0x37, 0xd9,
-	0x7c, 0x87, 0x79, 0xb3, 0x21, 0x74, 0x58, 0x89, 0xae, 0x42, 0x00, 0x59, 0x49, 0xbb, 0x28, 0x73,
-	0x8f, 0x20, 0x2b, 0x41, 0xe2, 0xe4, 0x16, 0x4a, 0xc5, 0x0b, 0xcd, 0x2b, 0x5b, 0xca, 0xb4, 0xa5,
-	0xe0, 0xc6, 0x1e, 0xdc, 0xa8, 0xbc, 0x71, 0x19, 0x69, 0x0f, 0x4f, 0x6f, 0xea, 0xe8, 0x7e, 0x06,
-	0xeb, 0xe3, 0x58, 0x15, 0xd9, 0x0b, 0xc1, 0x5f, 0x00, 0x3a, 0xe8, 0x62, 0xd7, 0x6f, 0x30, 0x1c,
-	0xb2, 0x74, 0xd6, 0x46, 0x9c, 0x40, 0xda, 0x62, 0xcf, 0xf3, 0x56, 0xbc, 0x44, 0x3f, 0x82, 0x5c,
-	0x87, 0xf8, 0x24, 0x72, 0x23, 0x9b, 0xb9, 0x1e, 0x51, 0x19, 0xbb, 0xa0, 0x68, 0x4d, 0xd7, 0x23,
-	0xc6, 0x43, 0xb8, 0x9e, 0x78, 0x52, 0xf5, 0xdb, 0xe4, 0xcd, 0x74, 0x65, 0xc0, 0x30, 0x61, 0x6d,
-	0x14, 0xa7, 0xdc, 0x59, 0x85, 0x39, 0x97, 0x13, 0xd4, 0x15, 0x92, 0x0b, 0xe3, 0x19, 0xac, 0x94,
-	0xa3, 0xc8, 0xed, 0xf8, 0x1e, 0xf1, 0x59, 0x2a, 0x5a, 0x24, 0xa0, 0x4e, 0xd7, 0x16, 0x0e, 0x2b,
-	0x00, 0x08, 0x92, 0xd8, 0xe2, 0x68, 0x44, 0x32, 0x63, 0x11, 0xf9, 0x7f, 0x06, 0x50, 0x5a, 0xaf,
-	0xf2, 0xe1, 0x35, 0xac, 0x0e, 0x2f, 0x0f, 0x4e, 0xf8, 0x22, 0xa4, 0x0b, 0xa5, 0x9f, 0x4d, 0x3a,
-	0xf8, 0x71, 0x4d, 0xa9, 0x54, 0x1c, 0xf2, 0xae, 0x0d, 0xc6, 0x89, 0x85, 0xff, 0x6a, 0x70, 0xed,
-	0x1c, 0x61, 0x74, 0x1b, 0xae, 0x3a, 0xd4, 0xf3, 0x5c, 0xc6, 0x08, 0x11, 0xf6, 0x67, 0xad, 0x21,
-	0x61, 0x58, 0x20, 0x33, 0xa9, 0x02, 0x79, 0x6e, 0x29, 0xbd, 0x07, 0x0b, 0x6e, 0x64, 0x07, 0xb2,
-	0xc2, 0x87, 0xa2, 0x12, 0xcc, 0x5b, 0xe0, 0x46, 0xaa, 0xe6, 0x87, 0x23, 0x07, 0x36, 0x37, 0x9a,
-	0xfd, 0x9f, 0x27, 0xd9, 0x9f, 0xd5, 0xb5, 0x8d, 0xa5, 0xd2, 0x4f, 0xa6, 0xcd, 0xfe, 0x38, 0xeb,
-	0xff, 0x92, 0x81, 0x1b, 0x13, 0x6e, 0x46, 0x4a, 0xb9, 0xf6, 0x5e, 0xca, 0xd1, 0x4f, 0xe1, 0x26,
-	0x61, 0xdd, 0x5d, 0xbb, 0x4d, 0x02, 0x1a, 0xb9, 0x4c, 0xf6, 0x64, 0xdb, 0xef, 0x7b, 0x2d, 0x12,
-	0xaa, 0xd8, 0xf0, 0xb6, 0xbf, 0x7b, 0x28, 0xf9, 0xa2, 0x63, 0xd6, 0x04, 0x17, 0x7d, 0x02, 0x6b,
-	0x31, 0xca, 0xf5, 0x9d, 0x5e, 0x3f, 0x72, 0xa9, 0x6f, 0xa7, 0xc2, 0xb7, 0xaa, 0xb8, 0xd5, 0x98,
-	0xd9, 0xe0, 0xe1, 0xdc, 0x84, 0x3c, 0x4e, 0x8a, 0x8b, 0x2d, 0x52, 0x4e, 0x35, 0xa9, 0xe5, 0x21,
-	0xbd, 0xc2, 0xc9, 0xe8, 0x73, 0xb8, 0x2d, 0x14, 0x70, 0x41, 0xd7, 0xb7, 0x53, 0xb0, 0xd7, 0x7d,
-	0xd2, 0x27, 0x22, 0xd4, 0xb3, 0xd6, 0xcd, 0x58, 0xa6, 0xea, 0x0f, 0xab, 0xd6, 0x17, 0x5c, 0xc0,
-	0x78, 0x04, 0x8b, 0x87, 0xd4, 0xc3, 0x6e, 0x52, 0x83, 0x57, 0x61, 0x4e, 0x5a, 0x54, 0x57, 0x44,
-	0x2c, 0xd0, 0x1a, 0x64, 0xdb, 0x42, 0x2c, 0x6e, 0xac, 0x72, 0x65, 0x7c, 0x06, 0x4b, 0x31, 0x5c,
-	0x85, 0x7b, 0x13, 0xf2, 0x3c, 0xbf, 0x30, 0xeb, 0x87, 0xc4, 0x56, 0x18, 0xa9, 0x6a, 0x39, 0xa1,
-	0x4b, 0x88, 0xf1, 0xc7, 0x0c, 0xac, 0x88, 0x68, 0x35, 0x43, 0x32, 0x6c, 0x74, 0x8f, 0x61, 0x96,
-	0x85, 0x2a, 0x1f, 0x17, 0x4a, 0xa5, 0x49, 0xa7, 0x35, 0x06, 0x34, 0xf9, 0xa2, 0x46, 0xdb, 0xc4,
-	0x12, 0xf8, 0xc2, 0x9f, 0x35, 0x98, 0x8f, 0x49, 0xe8, 0x53, 0x98, 0x13, 0xc7, 0x26, 0x5c, 0x59,
-	0x28, 0x19, 0x43, 0xad, 0x84, 0x75, 0xcd, 0x78, 0x9c, 0x32, 0xf7, 0x85, 0x09, 0x39, 0xf3, 0x48,
-	0xc0, 0xc8, 0x9c, 0x92, 0x19, 0x99, 0x53, 0xd0, 0x36, 0xa0, 0x00, 0x87, 0xcc, 0x75, 0xdc, 0x40,
-	0x34, 0x9d, 0x01, 0x65, 0x24, 0x6e, 0xa6, 0x2b, 0x69, 0xce, 0x73, 0xce, 0xe0, 0x37, 0x45, 0xf5,
-	0x6a, 0x21, 0x27, 0x4f, 0x15, 0x64, 0x9b, 0xe6, 0x14, 0xe3, 0x18, 0x56, 0xb9, 0xd3, 0xc2, 0x05,
-	0x9e, 0x0c, 0xf1, 0xb1, 0xdc, 0x82, 0xab, 0x3c, 0x6f, 0xec, 0x97, 0x21, 0xf5, 0x54, 0x3c, 0xe7,
-	0x39, 0xe1, 0x71, 0x48, 0x3d, 0x3e, 0xf7, 0x08, 0x26, 0xa3, 0x2a, 0x1f, 0xb3, 0x7c, 0xd9, 0xa4,
-	0x5b, 0x9f, 0xc2, 0x62, 0x92, 0xd5, 0x16, 0xed, 0x11, 0xb4, 0x00, 0x57, 0x9e, 0xd5, 0x9e, 0xd6,
-	0x4e, 0x5e, 0xd4, 0xf2, 0x1f, 0xa0, 0x1c, 0xcc, 0x97, 0x9b, 0xcd, 0x4a, 0xa3, 0x59, 0xb1, 0xf2,
-	0x1a, 0x5f, 0xd5, 0xad, 0x93, 0xfa, 0x49, 0xa3, 0x62, 0xe5, 0x33, 0x5b, 0x7f, 0xd0, 0x60, 0x79,
-	0xe4, 0x42, 0x20, 0x04, 0x4b, 0x0a, 0x6c, 0x37, 0x9a, 0xe5, 0xe6, 0xb3, 0x46, 0xfe, 0x03, 0x4e,
-	0xab, 0x57, 0x6a, 0x87, 0xd5, 0xda, 0x91, 0x5d, 0x3e, 0x68, 0x56, 0x9f, 0x57, 0xf2, 0x1a, 0x02,
-	0xc8, 0xaa, 0xef, 0x0c, 0xe7, 0x57, 0x6b, 0xd5, 0x66, 0xb5, 0xdc, 0xac, 0x1c, 0xda, 0x95, 0x2f,
-	0xab, 0xcd, 0xfc, 0x0c, 0xca, 0x43, 0xee, 0x45, 0xb5, 0xf9, 0xe4, 0xd0, 0x2a, 0xbf, 0x28, 0xef,
-	0x1f, 0x57, 0xf2, 0xb3, 0x1c, 0xc1, 0x79, 0x95, 0xc3, 0xfc, 0x1c, 0x47, 0xc8, 0x6f, 0xbb, 0x71,
-	0x5c, 0x6e, 0x3c, 0xa9, 0x1c, 0xe6, 0xb3, 0xa5, 0xbf, 0xcf, 0xc0, 0xa2, 0x3c, 0x9b, 0x86, 0x9c,
-	0xb7, 0xd1, 0x2f, 0x61, 0xe5, 0x05, 0x76, 0xd9, 0x63, 0x1a, 0x0e, 0xbb, 0x0e, 0x5a, 0x33, 0xe5,
-	0xf0, 0x6b, 0xc6, 0x63, 0xb6, 0x59, 0xe1, 0x63, 0x76, 0x61, 0x6b, 0x52, 0x12, 0x8d, 0x77, 0xac,
-	0x1d, 0x0d, 0x3d, 0x85, 0xc5, 0x03, 0xec, 0x53, 0xdf, 0x75, 0x70, 0xef, 0x09, 0xc1, 0xed, 0x89,
-	0x6a, 0xa7, 0xc8, 0x22, 0xf4, 0xad, 0x06, 0x57, 0x93, 0x54, 0x9d, 0xa8, 0x69, 0x73, 0xea, 0x2c,
-	0x37, 0x4e, 0xbe, 0x29, 0xef, 0x20, 0xf3, 0x31, 0x61, 0x4e, 0x97, 0x44, 0xba, 0x48, 0x44, 0x9d,
-	0xe7, 0xbb, 0x1e, 0xb9, 0xbe, 0x43, 0xf4, 0x1e, 0x8e, 0x98, 0xfe, 0xd2, 0xf5, 0x71, 0xcf, 0xfd,
-	0x0d, 0x69, 0x4b, 0xbe, 0xf9, 0xfb, 0x7f, 0x7d, 0xff, 0xa7, 0xcc, 0x1a, 0x5a, 0xe5, 0x0f, 0x16,
-	0xf5, 0x7c, 0x11, 0x0c, 0x8e, 0x43, 0xaf, 0x20, 0x9f, 0x58, 0xd9, 0x3f, 0xe5, 0x39, 0x17, 0xa1,
-	0x8f, 0x27, 0xf9, 0x73, 0x5e, 0x6e, 0x5e, 0xc2, 0xfb, 0xd2, 0x7f, 0x34, 0x58, 0x96, 0xf3, 0x35,
-	0x09, 0xe3, 0xa3, 0xec, 0x02, 0x52, 0x9a, 0x52, 0x13, 0x3f, 0x9a, 0x78, 0x66, 0xe3, 0xcf, 0x82,
-	0xc2, 0x47, 0x13, 0x0e, 0x22, 0x25, 0x7a, 0x88, 0x19, 0x46, 0x36, 0xac, 0x34, 0xfa, 0x2d, 0xcf,
-	0x3d, 0x63, 0xc8, 0xb8, 0x18, 0x9c, 0x36, 0x70, 0x9e, 0x33, 0xc9, 0xf6, 0xbe, 0xd3, 0x92, 0x87,
-	0x4e, 0xb2, 0xbd, 0x2f, 0x21, 0xa7, 0xfc, 0x94, 0x19, 0x71, 0xff, 0x9d, 0xd1, 0x8a, 0xb7, 0x34,
-	0x4d, 0x6e, 0x7d, 0x05, 0x39, 0x65, 0x4c, 0xae, 0xa7, 0xc0, 0x14, 0x26, 0x76, 0xbf, 0x91, 0xf7,
-	0x59, 0xe9, 0x6f, 0x59, 0xc8, 0x0f, 0x0b, 0x80, 0xda, 0xcb, 0x57, 0x00, 0xb2, 0x76, 0x8b, 0x70,
-	0x7e, 0x38, 0x49, 0xd7, 0x99, 0x8e, 0x32, 0x39, 0x78, 0x23, 0x9d, 0xe3, 0xb7, 0xc9, 0x95, 0x1e,
-	0x36, 0x29, 0x54, 0xba, 0xd4, 0x1c, 0x2e, 0x0d, 0x3e, 0x78, 0x8f, 0xd9, 0x7d, 0x47, 0x43, 0x14,
-	0x96, 0xce, 0x8e, 0x8d, 0x68, 0xfb, 0x42, 0x45, 0xe9, 0xb1, 0xb4, 0x60, 0x4e, 0x2b, 0xae, 0x36,
-	0xdc, 0x83, 0x6b, 0x07, 0xf1, 0xb4, 0x95, 0x9a, 0xca, 0x36, 0xa7, 0x19, 0x01, 0xa5, 0xc5, 0xad,
-	0xe9, 0xa7, 0x45, 0xf4, 0x7a, 0xbc, 0xa0, 0x5f, 0x72, 0x7f, 0x97, 0x7d, 0x94, 0xa0, 0xdf, 0x69,
-	0xb0, 0x7a, 0xde, 0xa3, 0x16, 0x5d, 0x7c, 0x42, 0xe3, 0xaf, 0xea, 0xc2, 0x27, 0x97, 0x03, 0x29,
-	0x1f, 0xfa, 0x90, 0x1f, 0x7d, 0xd4, 0xa0, 0x89, 0x1b, 0x99, 0xf0, 0x74, 0x2a, 0xec, 0x4c, 0x0f,
-	0x90, 0x66, 0xf7, 0xff, 0x31, 0xf3, 0x4d, 0xf9, 0xaf, 0x33, 0xe8, 0xdf, 0x1a, 0xcc, 0xd5, 0xc3,
-	0xd3, 0xc8, 0x43, 0xf7, 0x7f, 0xd1, 0x38, 0xa9, 0xe9, 0x56, 0xfd, 0x40, 0x8f, 0xff, 0x32, 0xd2,
-	0x83, 0x90, 0x0e, 0xdc, 0x36, 0xaf, 0xd2, 0xa7, 0xba, 0x10, 0x32, 0x8d, 0x03, 0x58, 0x12, 0x5f,
-	0x98, 0xb9, 0x8e, 0x7e, 0x8c, 0x5b, 0x11, 0xba, 0xd9, 0x65, 0x2c, 0x88, 0xf6, 0x8a, 0xc5, 0x20,
-	0xa6, 0xf7, 0x70, 0x2b, 0x32, 0x1d, 0xea, 0x15, 0xd6, 0x18, 0xc1, 0xde, 0xcf, 0xc7, 0xe8, 0x5b,
-	0xbf, 0x82, 0x7b, 0x47, 0xb5, 0x67, 0xfa, 0x11, 0xf1, 0x49, 0x88, 0x7b, 0xba, 0x7c, 0xe7, 0xea,
-	0xc7, 0xae, 0x43, 0xfc, 0x88, 0xe8, 0x83, 0x07, 0xe6, 0x0e, 0x7a, 0x14, 0x6b, 0xed, 0xb8, 0xac,
-	0xdb, 0x6f, 0x71, 0xd8, 0x59, 0x03, 0x72, 0xc5, 0xdb, 0x44, 0xab, 0xe8, 0x61, 0x5e, 0xae, 0x8b,
-	0xc7, 0xd5, 0x83, 0x4a, 0xad, 0x51, 0x31, 0xbd, 0x76, 0x69, 0x6e, 0xc7, 0xdc, 0x31, 0x77, 0x0a,
-	0xcb, 0x38, 0x70, 0xcd, 0x20, 0x3c, 0x15, 0x96, 0x7d, 0xc2, 0xb6, 0xb4, 0x4c, 0x29, 0x8f, 0x83,
-	0xa0, 0xe7, 0x3a, 0xe2, 0x72, 0x15, 0x7f, 0x1d, 0x51, 0xbf, 0x74, 0x33, 0x4d, 0xe9, 0x84, 0x81,
-	0xb3, 0xfd, 0x35, 0x69, 0x6d, 0x33, 0xf2, 0x86, 0x4d, 0x60, 0xbd, 0x03, 0xc5, 0x59, 0x7b, 0x63,
-	0x26, 0xf6, 0x26, 0x9b, 0x08, 0x1f, 0xf2, 0x22, 0x79, 0x1a, 0x79, 0xfa, 0x91, 0xd8, 0x29, 0xfa,
-	0x68, 0xba, 0x9d, 0x7f, 0xf7, 0xf6, 0xae, 0xf6, 0xcf, 0xb7, 0x77, 0xb5, 0xff, 0xbd, 0xbd, 0xab,
-	0xb5, 0xb2, 0xa2, 0x5d, 0x3f, 0xf8, 0x21, 0x00, 0x00, 0xff, 0xff, 0x99, 0x12, 0xeb, 0xfa, 0x02,
-	0x14, 0x00, 0x00,
+func (m *ValidatorQueueResponse) Reset()         { *m = ValidatorQueueResponse{} }
+func (m *ValidatorQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorQueueResponse) ProtoMessage()    {}
+func (*ValidatorQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{20}
+}
+func (m *ValidatorQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorQueueResponse.Merge(m, src)
+}
+func (m *ValidatorQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorQueueResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ValidatorQueueResponse proto.InternalMessageInfo
 
-// BeaconServiceClient is the client API for BeaconService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type BeaconServiceClient interface {
-	WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (BeaconService_WaitForChainStartClient, error)
-	CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
-	BlockTree(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*BlockTreeResponse, error)
-	BlockTreeBySlots(ctx context.Context, in *TreeBlockSlotRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error)
+func (m *ValidatorQueueResponse) GetChurnLimit() uint64 {
+	if m != nil {
+		return m.ChurnLimit
+	}
+	return 0
 }
 
-type beaconServiceClient struct {
-	cc *grpc.ClientConn
+func (m *ValidatorQueueResponse) GetActivationQueueLength() uint64 {
+	if m != nil {
+		return m.ActivationQueueLength
+	}
+	return 0
 }
 
-func NewBeaconServiceClient(cc *grpc.ClientConn) BeaconServiceClient {
-	return &beaconServiceClient{cc}
+func (m *ValidatorQueueResponse) GetExitQueueLength() uint64 {
+	if m != nil {
+		return m.ExitQueueLength
+	}
+	return 0
 }
 
-func (c *beaconServiceClient) WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (BeaconService_WaitForChainStartClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_BeaconService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.BeaconService/WaitForChainStart", opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorQueueResponse) GetPositionInActivationQueue() uint64 {
+	if m != nil {
+		return m.PositionInActivationQueue
 	}
-	x := &beaconServiceWaitForChainStartClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	return 0
+}
+
+func (m *ValidatorQueueResponse) GetEpochsUntilActivation() uint64 {
+	if m != nil {
+		return m.EpochsUntilActivation
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return 0
+}
+
+func (m *ValidatorQueueResponse) GetEpochsUntilExit() uint64 {
+	if m != nil {
+		return m.EpochsUntilExit
 	}
-	return x, nil
+	return 0
 }
 
-type BeaconService_WaitForChainStartClient interface {
-	Recv() (*ChainStartResponse, error)
-	grpc.ClientStream
+type AssignmentProofRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PublicKey            []byte   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type beaconServiceWaitForChainStartClient struct {
-	grpc.ClientStream
+func (m *AssignmentProofRequest) Reset()         { *m = AssignmentProofRequest{} }
+func (m *AssignmentProofRequest) String() string { return proto.CompactTextString(m) }
+func (*AssignmentProofRequest) ProtoMessage()    {}
+func (*AssignmentProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{21}
+}
+func (m *AssignmentProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssignmentProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssignmentProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssignmentProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignmentProofRequest.Merge(m, src)
+}
+func (m *AssignmentProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssignmentProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignmentProofRequest.DiscardUnknown(m)
 }
 
-func (x *beaconServiceWaitForChainStartClient) Recv() (*ChainStartResponse, error) {
-	m := new(ChainStartResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_AssignmentProofRequest proto.InternalMessageInfo
+
+func (m *AssignmentProofRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return m, nil
+	return 0
 }
 
-func (c *beaconServiceClient) CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
-	out := new(v1alpha1.BeaconBlock)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AssignmentProofRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	return out, nil
+	return nil
 }
 
-func (c *beaconServiceClient) BlockTree(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*BlockTreeResponse, error) {
-	out := new(BlockTreeResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/BlockTree", in, out, opts...)
-	if err != nil {
-		return nil, err
+// AssignmentProofResponse holds the inputs of the committee shuffling for an epoch, so that
+// an external auditor can recompute why a validator was assigned to its committee and slot.
+type AssignmentProofResponse struct {
+	Epoch                uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ValidatorIndex       uint64 `protobuf:"varint,2,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	ActiveValidatorCount uint64 `protobuf:"varint,3,opt,name=active_validator_count,json=activeValidatorCount,proto3" json:"active_validator_count,omitempty"`
+	// Position of the validator in the active validator indices of the epoch.
+	ActiveIndex uint64 `protobuf:"varint,4,opt,name=active_index,json=activeIndex,proto3" json:"active_index,omitempty"`
+	// Index i such that compute_shuffled_index(i, active_validator_count, seed) == active_index.
+	ShuffledIndex  uint64 `protobuf:"varint,5,opt,name=shuffled_index,json=shuffledIndex,proto3" json:"shuffled_index,omitempty"`
+	CommitteeCount uint64 `protobuf:"varint,6,opt,name=committee_count,json=committeeCount,proto3" json:"committee_count,omitempty"`
+	StartShard     uint64 `protobuf:"varint,7,opt,name=start_shard,json=startShard,proto3" json:"start_shard,omitempty"`
+	CommitteeIndex uint64 `protobuf:"varint,8,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	Shard          uint64 `protobuf:"varint,9,opt,name=shard,proto3" json:"shard,omitempty"`
+	Slot           uint64 `protobuf:"varint,10,opt,name=slot,proto3" json:"slot,omitempty"`
+	// Root of the head state the proof was computed from.
+	StateRoot []byte `protobuf:"bytes,11,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// seed is hash(randao_mix + active_index_root + epoch).
+	Seed                 []byte   `protobuf:"bytes,12,opt,name=seed,proto3" json:"seed,omitempty"`
+	RandaoMix            []byte   `protobuf:"bytes,13,opt,name=randao_mix,json=randaoMix,proto3" json:"randao_mix,omitempty"`
+	ActiveIndexRoot      []byte   `protobuf:"bytes,14,opt,name=active_index_root,json=activeIndexRoot,proto3" json:"active_index_root,omitempty"`
+	Committee            []uint64 `protobuf:"varint,15,rep,name=committee,packed,proto3" json:"committee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AssignmentProofResponse) Reset()         { *m = AssignmentProofResponse{} }
+func (m *AssignmentProofResponse) String() string { return proto.CompactTextString(m) }
+func (*AssignmentProofResponse) ProtoMessage()    {}
+func (*AssignmentProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{22}
+}
+func (m *AssignmentProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssignmentProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssignmentProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AssignmentProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignmentProofResponse.Merge(m, src)
+}
+func (m *AssignmentProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssignmentProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignmentProofResponse.DiscardUnknown(m)
 }
 
-func (c *beaconServiceClient) BlockTreeBySlots(ctx context.Context, in *TreeBlockSlotRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error) {
-	out := new(BlockTreeResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/BlockTreeBySlots", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AssignmentProofResponse proto.InternalMessageInfo
+
+func (m *AssignmentProofResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return out, nil
+	return 0
 }
 
-// BeaconServiceServer is the server API for BeaconService service.
-type BeaconServiceServer interface {
-	WaitForChainStart(*types.Empty, BeaconService_WaitForChainStartServer) error
-	CanonicalHead(context.Context, *types.Empty) (*v1alpha1.BeaconBlock, error)
-	BlockTree(context.Context, *types.Empty) (*BlockTreeResponse, error)
-	BlockTreeBySlots(context.Context, *TreeBlockSlotRequest) (*BlockTreeResponse, error)
+func (m *AssignmentProofResponse) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
 }
 
-func RegisterBeaconServiceServer(s *grpc.Server, srv BeaconServiceServer) {
-	s.RegisterService(&_BeaconService_serviceDesc, srv)
+func (m *AssignmentProofResponse) GetActiveValidatorCount() uint64 {
+	if m != nil {
+		return m.ActiveValidatorCount
+	}
+	return 0
 }
 
-func _BeaconService_WaitForChainStart_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(types.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *AssignmentProofResponse) GetActiveIndex() uint64 {
+	if m != nil {
+		return m.ActiveIndex
 	}
-	return srv.(BeaconServiceServer).WaitForChainStart(m, &beaconServiceWaitForChainStartServer{stream})
+	return 0
 }
 
-type BeaconService_WaitForChainStartServer interface {
-	Send(*ChainStartResponse) error
-	grpc.ServerStream
+func (m *AssignmentProofResponse) GetShuffledIndex() uint64 {
+	if m != nil {
+		return m.ShuffledIndex
+	}
+	return 0
 }
 
-type beaconServiceWaitForChainStartServer struct {
-	grpc.ServerStream
+func (m *AssignmentProofResponse) GetCommitteeCount() uint64 {
+	if m != nil {
+		return m.CommitteeCount
+	}
+	return 0
 }
 
-func (x *beaconServiceWaitForChainStartServer) Send(m *ChainStartResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *AssignmentProofResponse) GetStartShard() uint64 {
+	if m != nil {
+		return m.StartShard
+	}
+	return 0
 }
 
-func _BeaconService_CanonicalHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *AssignmentProofResponse) GetCommitteeIndex() uint64 {
+	if m != nil {
+		return m.CommitteeIndex
 	}
-	if interceptor == nil {
-		return srv.(BeaconServiceServer).CanonicalHead(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServiceServer).CanonicalHead(ctx, req.(*types.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _BeaconService_BlockTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconServiceServer).BlockTree(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BlockTree",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServiceServer).BlockTree(ctx, req.(*types.Empty))
+func (m *AssignmentProofResponse) GetShard() uint64 {
+	if m != nil {
+		return m.Shard
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _BeaconService_BlockTreeBySlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TreeBlockSlotRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconServiceServer).BlockTreeBySlots(ctx, in)
+func (m *AssignmentProofResponse) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BlockTreeBySlots",
+	return 0
+}
+
+func (m *AssignmentProofResponse) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServiceServer).BlockTreeBySlots(ctx, req.(*TreeBlockSlotRequest))
+	return nil
+}
+
+func (m *AssignmentProofResponse) GetSeed() []byte {
+	if m != nil {
+		return m.Seed
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _BeaconService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.BeaconService",
-	HandlerType: (*BeaconServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CanonicalHead",
-			Handler:    _BeaconService_CanonicalHead_Handler,
-		},
-		{
-			MethodName: "BlockTree",
-			Handler:    _BeaconService_BlockTree_Handler,
-		},
-		{
-			MethodName: "BlockTreeBySlots",
-			Handler:    _BeaconService_BlockTreeBySlots_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "WaitForChainStart",
-			Handler:       _BeaconService_WaitForChainStart_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "proto/beacon/rpc/v1/services.proto",
+func (m *AssignmentProofResponse) GetRandaoMix() []byte {
+	if m != nil {
+		return m.RandaoMix
+	}
+	return nil
 }
 
-// AttesterServiceClient is the client API for AttesterService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AttesterServiceClient interface {
-	RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error)
-	SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error)
+func (m *AssignmentProofResponse) GetActiveIndexRoot() []byte {
+	if m != nil {
+		return m.ActiveIndexRoot
+	}
+	return nil
 }
 
-type attesterServiceClient struct {
-	cc *grpc.ClientConn
+func (m *AssignmentProofResponse) GetCommittee() []uint64 {
+	if m != nil {
+		return m.Committee
+	}
+	return nil
 }
 
-func NewAttesterServiceClient(cc *grpc.ClientConn) AttesterServiceClient {
-	return &attesterServiceClient{cc}
+type ChainStartStatusResponse struct {
+	Started                        bool     `protobuf:"varint,1,opt,name=started,proto3" json:"started,omitempty"`
+	GenesisTime                    uint64   `protobuf:"varint,2,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+	DepositCount                   uint64   `protobuf:"varint,3,opt,name=deposit_count,json=depositCount,proto3" json:"deposit_count,omitempty"`
+	ActiveValidatorCount           uint64   `protobuf:"varint,4,opt,name=active_validator_count,json=activeValidatorCount,proto3" json:"active_validator_count,omitempty"`
+	MinGenesisActiveValidatorCount uint64   `protobuf:"varint,5,opt,name=min_genesis_active_validator_count,json=minGenesisActiveValidatorCount,proto3" json:"min_genesis_active_validator_count,omitempty"`
+	MinGenesisTime                 uint64   `protobuf:"varint,6,opt,name=min_genesis_time,json=minGenesisTime,proto3" json:"min_genesis_time,omitempty"`
+	XXX_NoUnkeyedLiteral           struct{} `json:"-"`
+	XXX_unrecognized               []byte   `json:"-"`
+	XXX_sizecache                  int32    `json:"-"`
 }
 
-func (c *attesterServiceClient) RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error) {
-	out := new(v1alpha1.AttestationData)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChainStartStatusResponse) Reset()         { *m = ChainStartStatusResponse{} }
+func (m *ChainStartStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*ChainStartStatusResponse) ProtoMessage()    {}
+func (*ChainStartStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{23}
+}
+func (m *ChainStartStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainStartStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainStartStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ChainStartStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainStartStatusResponse.Merge(m, src)
+}
+func (m *ChainStartStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainStartStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainStartStatusResponse.DiscardUnknown(m)
 }
 
-func (c *attesterServiceClient) SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error) {
-	out := new(AttestResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ChainStartStatusResponse proto.InternalMessageInfo
+
+func (m *ChainStartStatusResponse) GetStarted() bool {
+	if m != nil {
+		return m.Started
 	}
-	return out, nil
+	return false
 }
 
-// AttesterServiceServer is the server API for AttesterService service.
-type AttesterServiceServer interface {
-	RequestAttestation(context.Context, *AttestationRequest) (*v1alpha1.AttestationData, error)
-	SubmitAttestation(context.Context, *v1alpha1.Attestation) (*AttestResponse, error)
+func (m *ChainStartStatusResponse) GetGenesisTime() uint64 {
+	if m != nil {
+		return m.GenesisTime
+	}
+	return 0
 }
 
-func RegisterAttesterServiceServer(s *grpc.Server, srv AttesterServiceServer) {
-	s.RegisterService(&_AttesterService_serviceDesc, srv)
+func (m *ChainStartStatusResponse) GetDepositCount() uint64 {
+	if m != nil {
+		return m.DepositCount
+	}
+	return 0
 }
 
-func _AttesterService_RequestAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AttestationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AttesterServiceServer).RequestAttestation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttesterServiceServer).RequestAttestation(ctx, req.(*AttestationRequest))
+func (m *ChainStartStatusResponse) GetActiveValidatorCount() uint64 {
+	if m != nil {
+		return m.ActiveValidatorCount
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AttesterService_SubmitAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.Attestation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AttesterServiceServer).SubmitAttestation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttesterServiceServer).SubmitAttestation(ctx, req.(*v1alpha1.Attestation))
+func (m *ChainStartStatusResponse) GetMinGenesisActiveValidatorCount() uint64 {
+	if m != nil {
+		return m.MinGenesisActiveValidatorCount
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _AttesterService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.AttesterService",
-	HandlerType: (*AttesterServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RequestAttestation",
-			Handler:    _AttesterService_RequestAttestation_Handler,
-		},
-		{
-			MethodName: "SubmitAttestation",
-			Handler:    _AttesterService_SubmitAttestation_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/beacon/rpc/v1/services.proto",
+func (m *ChainStartStatusResponse) GetMinGenesisTime() uint64 {
+	if m != nil {
+		return m.MinGenesisTime
+	}
+	return 0
 }
 
-// ProposerServiceClient is the client API for ProposerService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ProposerServiceClient interface {
-	RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
-	ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error)
+type Eth1DataVotesResponse struct {
+	Slot                  uint64                             `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	VotingPeriodStartSlot uint64                             `protobuf:"varint,2,opt,name=voting_period_start_slot,json=votingPeriodStartSlot,proto3" json:"voting_period_start_slot,omitempty"`
+	Eth1Data              *v1alpha1.Eth1Data                 `protobuf:"bytes,3,opt,name=eth1_data,json=eth1Data,proto3" json:"eth1_data,omitempty"`
+	Eth1DepositIndex      uint64                             `protobuf:"varint,4,opt,name=eth1_deposit_index,json=eth1DepositIndex,proto3" json:"eth1_deposit_index,omitempty"`
+	VotesRequired         uint64                             `protobuf:"varint,5,opt,name=votes_required,json=votesRequired,proto3" json:"votes_required,omitempty"`
+	Votes                 []*Eth1DataVotesResponse_VoteTally `protobuf:"bytes,6,rep,name=votes,proto3" json:"votes,omitempty"`
+	IntendedVote          *v1alpha1.Eth1Data                 `protobuf:"bytes,7,opt,name=intended_vote,json=intendedVote,proto3" json:"intended_vote,omitempty"`
+	Candidates            []*Eth1DataVotesResponse_Eth1Block `protobuf:"bytes,8,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                           `json:"-"`
+	XXX_unrecognized      []byte                             `json:"-"`
+	XXX_sizecache         int32                              `json:"-"`
 }
 
-type proposerServiceClient struct {
-	cc *grpc.ClientConn
+func (m *Eth1DataVotesResponse) Reset()         { *m = Eth1DataVotesResponse{} }
+func (m *Eth1DataVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*Eth1DataVotesResponse) ProtoMessage()    {}
+func (*Eth1DataVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{24}
 }
-
-func NewProposerServiceClient(cc *grpc.ClientConn) ProposerServiceClient {
-	return &proposerServiceClient{cc}
+func (m *Eth1DataVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Eth1DataVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Eth1DataVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Eth1DataVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1DataVotesResponse.Merge(m, src)
+}
+func (m *Eth1DataVotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *Eth1DataVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1DataVotesResponse.DiscardUnknown(m)
 }
 
-func (c *proposerServiceClient) RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
-	out := new(v1alpha1.BeaconBlock)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Eth1DataVotesResponse proto.InternalMessageInfo
+
+func (m *Eth1DataVotesResponse) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
 	}
-	return out, nil
+	return 0
 }
 
-func (c *proposerServiceClient) ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error) {
-	out := new(ProposeResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Eth1DataVotesResponse) GetVotingPeriodStartSlot() uint64 {
+	if m != nil {
+		return m.VotingPeriodStartSlot
 	}
-	return out, nil
+	return 0
 }
 
-// ProposerServiceServer is the server API for ProposerService service.
-type ProposerServiceServer interface {
-	RequestBlock(context.Context, *BlockRequest) (*v1alpha1.BeaconBlock, error)
-	ProposeBlock(context.Context, *v1alpha1.BeaconBlock) (*ProposeResponse, error)
+func (m *Eth1DataVotesResponse) GetEth1Data() *v1alpha1.Eth1Data {
+	if m != nil {
+		return m.Eth1Data
+	}
+	return nil
 }
 
-func RegisterProposerServiceServer(s *grpc.Server, srv ProposerServiceServer) {
-	s.RegisterService(&_ProposerService_serviceDesc, srv)
+func (m *Eth1DataVotesResponse) GetEth1DepositIndex() uint64 {
+	if m != nil {
+		return m.Eth1DepositIndex
+	}
+	return 0
 }
 
-func _ProposerService_RequestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Eth1DataVotesResponse) GetVotesRequired() uint64 {
+	if m != nil {
+		return m.VotesRequired
 	}
-	if interceptor == nil {
-		return srv.(ProposerServiceServer).RequestBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProposerServiceServer).RequestBlock(ctx, req.(*BlockRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _ProposerService_ProposeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.BeaconBlock)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProposerServiceServer).ProposeBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProposerServiceServer).ProposeBlock(ctx, req.(*v1alpha1.BeaconBlock))
+func (m *Eth1DataVotesResponse) GetVotes() []*Eth1DataVotesResponse_VoteTally {
+	if m != nil {
+		return m.Votes
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _ProposerService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.ProposerService",
-	HandlerType: (*ProposerServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RequestBlock",
-			Handler:    _ProposerService_RequestBlock_Handler,
-		},
-		{
-			MethodName: "ProposeBlock",
-			Handler:    _ProposerService_ProposeBlock_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/beacon/rpc/v1/services.proto",
+func (m *Eth1DataVotesResponse) GetIntendedVote() *v1alpha1.Eth1Data {
+	if m != nil {
+		return m.IntendedVote
+	}
+	return nil
 }
 
-// ValidatorServiceClient is the client API for ValidatorService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ValidatorServiceClient interface {
-	DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error)
-	WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error)
-	ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error)
-	CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error)
-	ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error)
-	ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error)
-	ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error)
+func (m *Eth1DataVotesResponse) GetCandidates() []*Eth1DataVotesResponse_Eth1Block {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
 }
 
-type validatorServiceClient struct {
-	cc *grpc.ClientConn
+type Eth1DataVotesResponse_VoteTally struct {
+	Eth1Data             *v1alpha1.Eth1Data `protobuf:"bytes,1,opt,name=eth1_data,json=eth1Data,proto3" json:"eth1_data,omitempty"`
+	Count                uint64             `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func NewValidatorServiceClient(cc *grpc.ClientConn) ValidatorServiceClient {
-	return &validatorServiceClient{cc}
+func (m *Eth1DataVotesResponse_VoteTally) Reset()         { *m = Eth1DataVotesResponse_VoteTally{} }
+func (m *Eth1DataVotesResponse_VoteTally) String() string { return proto.CompactTextString(m) }
+func (*Eth1DataVotesResponse_VoteTally) ProtoMessage()    {}
+func (*Eth1DataVotesResponse_VoteTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{24, 0}
 }
-
-func (c *validatorServiceClient) DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error) {
-	out := new(DomainResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/DomainData", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Eth1DataVotesResponse_VoteTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Eth1DataVotesResponse_VoteTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Eth1DataVotesResponse_VoteTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *Eth1DataVotesResponse_VoteTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1DataVotesResponse_VoteTally.Merge(m, src)
+}
+func (m *Eth1DataVotesResponse_VoteTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *Eth1DataVotesResponse_VoteTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1DataVotesResponse_VoteTally.DiscardUnknown(m)
 }
 
-func (c *validatorServiceClient) WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ValidatorService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.ValidatorService/WaitForActivation", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &validatorServiceWaitForActivationClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_Eth1DataVotesResponse_VoteTally proto.InternalMessageInfo
+
+func (m *Eth1DataVotesResponse_VoteTally) GetEth1Data() *v1alpha1.Eth1Data {
+	if m != nil {
+		return m.Eth1Data
 	}
-	return x, nil
+	return nil
 }
 
-type ValidatorService_WaitForActivationClient interface {
-	Recv() (*ValidatorActivationResponse, error)
-	grpc.ClientStream
+func (m *Eth1DataVotesResponse_VoteTally) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-type validatorServiceWaitForActivationClient struct {
-	grpc.ClientStream
+type Eth1DataVotesResponse_Eth1Block struct {
+	BlockNumber          uint64   `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	DepositCount         uint64   `protobuf:"varint,3,opt,name=deposit_count,json=depositCount,proto3" json:"deposit_count,omitempty"`
+	DepositRoot          []byte   `protobuf:"bytes,4,opt,name=deposit_root,json=depositRoot,proto3" json:"deposit_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *validatorServiceWaitForActivationClient) Recv() (*ValidatorActivationResponse, error) {
-	m := new(ValidatorActivationResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *Eth1DataVotesResponse_Eth1Block) Reset()         { *m = Eth1DataVotesResponse_Eth1Block{} }
+func (m *Eth1DataVotesResponse_Eth1Block) String() string { return proto.CompactTextString(m) }
+func (*Eth1DataVotesResponse_Eth1Block) ProtoMessage()    {}
+func (*Eth1DataVotesResponse_Eth1Block) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{24, 1}
 }
-
-func (c *validatorServiceClient) ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error) {
-	out := new(ValidatorIndexResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Eth1DataVotesResponse_Eth1Block) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Eth1DataVotesResponse_Eth1Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Eth1DataVotesResponse_Eth1Block.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *Eth1DataVotesResponse_Eth1Block) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1DataVotesResponse_Eth1Block.Merge(m, src)
+}
+func (m *Eth1DataVotesResponse_Eth1Block) XXX_Size() int {
+	return m.Size()
+}
+func (m *Eth1DataVotesResponse_Eth1Block) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1DataVotesResponse_Eth1Block.DiscardUnknown(m)
 }
 
-func (c *validatorServiceClient) CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error) {
-	out := new(AssignmentResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Eth1DataVotesResponse_Eth1Block proto.InternalMessageInfo
+
+func (m *Eth1DataVotesResponse_Eth1Block) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
 	}
-	return out, nil
+	return 0
 }
 
-func (c *validatorServiceClient) ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error) {
-	out := new(ValidatorStatusResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Eth1DataVotesResponse_Eth1Block) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
 	}
-	return out, nil
+	return nil
 }
 
-func (c *validatorServiceClient) ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error) {
-	out := new(ValidatorPerformanceResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Eth1DataVotesResponse_Eth1Block) GetDepositCount() uint64 {
+	if m != nil {
+		return m.DepositCount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *validatorServiceClient) ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error) {
-	out := new(ExitedValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Eth1DataVotesResponse_Eth1Block) GetDepositRoot() []byte {
+	if m != nil {
+		return m.DepositRoot
 	}
-	return out, nil
+	return nil
 }
 
-// ValidatorServiceServer is the server API for ValidatorService service.
-type ValidatorServiceServer interface {
-	DomainData(context.Context, *DomainRequest) (*DomainResponse, error)
-	WaitForActivation(*ValidatorActivationRequest, ValidatorService_WaitForActivationServer) error
-	ValidatorIndex(context.Context, *ValidatorIndexRequest) (*ValidatorIndexResponse, error)
-	CommitteeAssignment(context.Context, *AssignmentRequest) (*AssignmentResponse, error)
-	ValidatorStatus(context.Context, *ValidatorIndexRequest) (*ValidatorStatusResponse, error)
-	ValidatorPerformance(context.Context, *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error)
-	ExitedValidators(context.Context, *ExitedValidatorsRequest) (*ExitedValidatorsResponse, error)
+type FinalizedChainRequest struct {
+	FromSlot             uint64   `protobuf:"varint,1,opt,name=from_slot,json=fromSlot,proto3" json:"from_slot,omitempty"`
+	StateIntervalEpochs  uint64   `protobuf:"varint,2,opt,name=state_interval_epochs,json=stateIntervalEpochs,proto3" json:"state_interval_epochs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterValidatorServiceServer(s *grpc.Server, srv ValidatorServiceServer) {
-	s.RegisterService(&_ValidatorService_serviceDesc, srv)
+func (m *FinalizedChainRequest) Reset()         { *m = FinalizedChainRequest{} }
+func (m *FinalizedChainRequest) String() string { return proto.CompactTextString(m) }
+func (*FinalizedChainRequest) ProtoMessage()    {}
+func (*FinalizedChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{25}
 }
-
-func _ValidatorService_DomainData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DomainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).DomainData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/DomainData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).DomainData(ctx, req.(*DomainRequest))
+func (m *FinalizedChainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalizedChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalizedChainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *FinalizedChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizedChainRequest.Merge(m, src)
+}
+func (m *FinalizedChainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalizedChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizedChainRequest.DiscardUnknown(m)
 }
 
-func _ValidatorService_WaitForActivation_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ValidatorActivationRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+var xxx_messageInfo_FinalizedChainRequest proto.InternalMessageInfo
+
+func (m *FinalizedChainRequest) GetFromSlot() uint64 {
+	if m != nil {
+		return m.FromSlot
 	}
-	return srv.(ValidatorServiceServer).WaitForActivation(m, &validatorServiceWaitForActivationServer{stream})
+	return 0
 }
 
-type ValidatorService_WaitForActivationServer interface {
-	Send(*ValidatorActivationResponse) error
-	grpc.ServerStream
+func (m *FinalizedChainRequest) GetStateIntervalEpochs() uint64 {
+	if m != nil {
+		return m.StateIntervalEpochs
+	}
+	return 0
 }
 
-type validatorServiceWaitForActivationServer struct {
-	grpc.ServerStream
+type FinalizedChainUpdate struct {
+	Block                *v1alpha1.BeaconBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	State                []byte                `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	FinalizedSlot        uint64                `protobuf:"varint,3,opt,name=finalized_slot,json=finalizedSlot,proto3" json:"finalized_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (x *validatorServiceWaitForActivationServer) Send(m *ValidatorActivationResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *FinalizedChainUpdate) Reset()         { *m = FinalizedChainUpdate{} }
+func (m *FinalizedChainUpdate) String() string { return proto.CompactTextString(m) }
+func (*FinalizedChainUpdate) ProtoMessage()    {}
+func (*FinalizedChainUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{26}
 }
-
-func _ValidatorService_ValidatorIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidatorIndexRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, req.(*ValidatorIndexRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *FinalizedChainUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _ValidatorService_CommitteeAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignmentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, req.(*AssignmentRequest))
+func (m *FinalizedChainUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalizedChainUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *FinalizedChainUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizedChainUpdate.Merge(m, src)
+}
+func (m *FinalizedChainUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalizedChainUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizedChainUpdate.DiscardUnknown(m)
 }
 
-func _ValidatorService_ValidatorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidatorIndexRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, req.(*ValidatorIndexRequest))
+var xxx_messageInfo_FinalizedChainUpdate proto.InternalMessageInfo
+
+func (m *FinalizedChainUpdate) GetBlock() *v1alpha1.BeaconBlock {
+	if m != nil {
+		return m.Block
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ValidatorService_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidatorPerformanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance",
+func (m *FinalizedChainUpdate) GetState() []byte {
+	if m != nil {
+		return m.State
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, req.(*ValidatorPerformanceRequest))
+	return nil
+}
+
+func (m *FinalizedChainUpdate) GetFinalizedSlot() uint64 {
+	if m != nil {
+		return m.FinalizedSlot
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _ValidatorService_ExitedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExitedValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type ForkChoiceAuditRequest struct {
+	Count                uint64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForkChoiceAuditRequest) Reset()         { *m = ForkChoiceAuditRequest{} }
+func (m *ForkChoiceAuditRequest) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceAuditRequest) ProtoMessage()    {}
+func (*ForkChoiceAuditRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{27}
+}
+func (m *ForkChoiceAuditRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkChoiceAuditRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkChoiceAuditRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ExitedValidators(ctx, in)
+}
+func (m *ForkChoiceAuditRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceAuditRequest.Merge(m, src)
+}
+func (m *ForkChoiceAuditRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkChoiceAuditRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceAuditRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkChoiceAuditRequest proto.InternalMessageInfo
+
+func (m *ForkChoiceAuditRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators",
+	return 0
+}
+
+type ForkChoiceAuditResponse struct {
+	Decisions            []*ForkChoiceAuditResponse_Decision `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *ForkChoiceAuditResponse) Reset()         { *m = ForkChoiceAuditResponse{} }
+func (m *ForkChoiceAuditResponse) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceAuditResponse) ProtoMessage()    {}
+func (*ForkChoiceAuditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{28}
+}
+func (m *ForkChoiceAuditResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkChoiceAuditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkChoiceAuditResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ExitedValidators(ctx, req.(*ExitedValidatorsRequest))
+}
+func (m *ForkChoiceAuditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceAuditResponse.Merge(m, src)
+}
+func (m *ForkChoiceAuditResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkChoiceAuditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceAuditResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkChoiceAuditResponse proto.InternalMessageInfo
+
+func (m *ForkChoiceAuditResponse) GetDecisions() []*ForkChoiceAuditResponse_Decision {
+	if m != nil {
+		return m.Decisions
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _ValidatorService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.ValidatorService",
-	HandlerType: (*ValidatorServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "DomainData",
-			Handler:    _ValidatorService_DomainData_Handler,
-		},
-		{
-			MethodName: "ValidatorIndex",
-			Handler:    _ValidatorService_ValidatorIndex_Handler,
-		},
-		{
-			MethodName: "CommitteeAssignment",
-			Handler:    _ValidatorService_CommitteeAssignment_Handler,
-		},
-		{
-			MethodName: "ValidatorStatus",
-			Handler:    _ValidatorService_ValidatorStatus_Handler,
-		},
-		{
-			MethodName: "ValidatorPerformance",
-			Handler:    _ValidatorService_ValidatorPerformance_Handler,
-		},
-		{
-			MethodName: "ExitedValidators",
-			Handler:    _ValidatorService_ExitedValidators_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "WaitForActivation",
-			Handler:       _ValidatorService_WaitForActivation_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "proto/beacon/rpc/v1/services.proto",
+type ForkChoiceAuditResponse_Decision struct {
+	Timestamp            uint64                          `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	JustifiedRoot        []byte                          `protobuf:"bytes,2,opt,name=justified_root,json=justifiedRoot,proto3" json:"justified_root,omitempty"`
+	JustifiedSlot        uint64                          `protobuf:"varint,3,opt,name=justified_slot,json=justifiedSlot,proto3" json:"justified_slot,omitempty"`
+	PreviousHead         []byte                          `protobuf:"bytes,4,opt,name=previous_head,json=previousHead,proto3" json:"previous_head,omitempty"`
+	PreviousSlot         uint64                          `protobuf:"varint,5,opt,name=previous_slot,json=previousSlot,proto3" json:"previous_slot,omitempty"`
+	NewHead              []byte                          `protobuf:"bytes,6,opt,name=new_head,json=newHead,proto3" json:"new_head,omitempty"`
+	NewSlot              uint64                          `protobuf:"varint,7,opt,name=new_slot,json=newSlot,proto3" json:"new_slot,omitempty"`
+	Reorg                bool                            `protobuf:"varint,8,opt,name=reorg,proto3" json:"reorg,omitempty"`
+	Steps                []*ForkChoiceAuditResponse_Step `protobuf:"bytes,9,rep,name=steps,proto3" json:"steps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *ForkChoiceAuditResponse_Decision) Reset()         { *m = ForkChoiceAuditResponse_Decision{} }
+func (m *ForkChoiceAuditResponse_Decision) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceAuditResponse_Decision) ProtoMessage()    {}
+func (*ForkChoiceAuditResponse_Decision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{28, 0}
+}
+func (m *ForkChoiceAuditResponse_Decision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkChoiceAuditResponse_Decision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkChoiceAuditResponse_Decision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForkChoiceAuditResponse_Decision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceAuditResponse_Decision.Merge(m, src)
+}
+func (m *ForkChoiceAuditResponse_Decision) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkChoiceAuditResponse_Decision) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceAuditResponse_Decision.DiscardUnknown(m)
 }
 
-func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ForkChoiceAuditResponse_Decision proto.InternalMessageInfo
+
+func (m *ForkChoiceAuditResponse_Decision) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+func (m *ForkChoiceAuditResponse_Decision) GetJustifiedRoot() []byte {
+	if m != nil {
+		return m.JustifiedRoot
 	}
-	if len(m.RandaoReveal) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.RandaoReveal)))
-		i += copy(dAtA[i:], m.RandaoReveal)
+	return nil
+}
+
+func (m *ForkChoiceAuditResponse_Decision) GetJustifiedSlot() uint64 {
+	if m != nil {
+		return m.JustifiedSlot
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return 0
+}
+
+func (m *ForkChoiceAuditResponse_Decision) GetPreviousHead() []byte {
+	if m != nil {
+		return m.PreviousHead
 	}
-	return i, nil
+	return nil
 }
 
-func (m *ProposeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ForkChoiceAuditResponse_Decision) GetPreviousSlot() uint64 {
+	if m != nil {
+		return m.PreviousSlot
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ProposeResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlockRoot) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
-		i += copy(dAtA[i:], m.BlockRoot)
+func (m *ForkChoiceAuditResponse_Decision) GetNewHead() []byte {
+	if m != nil {
+		return m.NewHead
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return nil
+}
+
+func (m *ForkChoiceAuditResponse_Decision) GetNewSlot() uint64 {
+	if m != nil {
+		return m.NewSlot
 	}
-	return i, nil
+	return 0
 }
 
-func (m *AttestationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ForkChoiceAuditResponse_Decision) GetReorg() bool {
+	if m != nil {
+		return m.Reorg
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *AttestationRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
-	}
-	if len(m.PocBit) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PocBit)))
-		i += copy(dAtA[i:], m.PocBit)
-	}
-	if m.Slot != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
-	}
-	if m.Shard != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Shard))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ForkChoiceAuditResponse_Decision) GetSteps() []*ForkChoiceAuditResponse_Step {
+	if m != nil {
+		return m.Steps
 	}
-	return i, nil
+	return nil
 }
 
-func (m *AttestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ForkChoiceAuditResponse_Step struct {
+	Parent               []byte                               `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	Candidates           []*ForkChoiceAuditResponse_Candidate `protobuf:"bytes,2,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Chosen               []byte                               `protobuf:"bytes,3,opt,name=chosen,proto3" json:"chosen,omitempty"`
+	TieBreak             bool                                 `protobuf:"varint,4,opt,name=tie_break,json=tieBreak,proto3" json:"tie_break,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
 }
 
-func (m *AttestResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Root) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.Root)))
-		i += copy(dAtA[i:], m.Root)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ForkChoiceAuditResponse_Step) Reset()         { *m = ForkChoiceAuditResponse_Step{} }
+func (m *ForkChoiceAuditResponse_Step) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceAuditResponse_Step) ProtoMessage()    {}
+func (*ForkChoiceAuditResponse_Step) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{28, 1}
+}
+func (m *ForkChoiceAuditResponse_Step) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkChoiceAuditResponse_Step) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkChoiceAuditResponse_Step.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *ForkChoiceAuditResponse_Step) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceAuditResponse_Step.Merge(m, src)
+}
+func (m *ForkChoiceAuditResponse_Step) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkChoiceAuditResponse_Step) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceAuditResponse_Step.DiscardUnknown(m)
 }
 
-func (m *ValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ForkChoiceAuditResponse_Step proto.InternalMessageInfo
+
+func (m *ForkChoiceAuditResponse_Step) GetParent() []byte {
+	if m != nil {
+		return m.Parent
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ValidatorPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
-	}
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ForkChoiceAuditResponse_Step) GetCandidates() []*ForkChoiceAuditResponse_Candidate {
+	if m != nil {
+		return m.Candidates
 	}
-	return i, nil
+	return nil
 }
 
-func (m *ValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ForkChoiceAuditResponse_Step) GetChosen() []byte {
+	if m != nil {
+		return m.Chosen
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Balance != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Balance))
-	}
-	if m.TotalValidators != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.TotalValidators))
-	}
-	if m.TotalActiveValidators != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.TotalActiveValidators))
-	}
-	if m.AverageActiveValidatorBalance != 0 {
-		dAtA[i] = 0x25
-		i++
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.AverageActiveValidatorBalance))))
-		i += 4
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ForkChoiceAuditResponse_Step) GetTieBreak() bool {
+	if m != nil {
+		return m.TieBreak
 	}
-	return i, nil
+	return false
 }
 
-func (m *ValidatorActivationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ForkChoiceAuditResponse_Candidate struct {
+	Root                 []byte   `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Slot                 uint64   `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Weight               uint64   `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidatorActivationRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
+func (m *ForkChoiceAuditResponse_Candidate) Reset()         { *m = ForkChoiceAuditResponse_Candidate{} }
+func (m *ForkChoiceAuditResponse_Candidate) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceAuditResponse_Candidate) ProtoMessage()    {}
+func (*ForkChoiceAuditResponse_Candidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{28, 2}
+}
+func (m *ForkChoiceAuditResponse_Candidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkChoiceAuditResponse_Candidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkChoiceAuditResponse_Candidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
 }
-
-func (m *ValidatorActivationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ForkChoiceAuditResponse_Candidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceAuditResponse_Candidate.Merge(m, src)
+}
+func (m *ForkChoiceAuditResponse_Candidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkChoiceAuditResponse_Candidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceAuditResponse_Candidate.DiscardUnknown(m)
 }
 
-func (m *ValidatorActivationResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ActivatedPublicKeys) > 0 {
-		for _, b := range m.ActivatedPublicKeys {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if len(m.Statuses) > 0 {
-		for _, msg := range m.Statuses {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_ForkChoiceAuditResponse_Candidate proto.InternalMessageInfo
+
+func (m *ForkChoiceAuditResponse_Candidate) GetRoot() []byte {
+	if m != nil {
+		return m.Root
 	}
-	return i, nil
+	return nil
 }
 
-func (m *ValidatorActivationResponse_Status) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ForkChoiceAuditResponse_Candidate) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ValidatorActivationResponse_Status) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
-	}
-	if m.Status != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Status.Size()))
-		n1, err := m.Status.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ForkChoiceAuditResponse_Candidate) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
 	}
-	return i, nil
+	return 0
 }
 
-func (m *ExitedValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorRole", ValidatorRole_name, ValidatorRole_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
+	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
+	proto.RegisterType((*ProposeResponse)(nil), "ethereum.beacon.rpc.v1.ProposeResponse")
+	proto.RegisterType((*AttestationRequest)(nil), "ethereum.beacon.rpc.v1.AttestationRequest")
+	proto.RegisterType((*AttestResponse)(nil), "ethereum.beacon.rpc.v1.AttestResponse")
+	proto.RegisterType((*ValidatorPerformanceRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceRequest")
+	proto.RegisterType((*ValidatorPerformanceResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceResponse")
+	proto.RegisterType((*ValidatorActivationRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationRequest")
+	proto.RegisterType((*ValidatorActivationResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse")
+	proto.RegisterType((*ValidatorActivationResponse_Status)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse.Status")
+	proto.RegisterType((*ExitedValidatorsRequest)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsRequest")
+	proto.RegisterType((*ExitedValidatorsResponse)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsResponse")
+	proto.RegisterType((*ChainStartResponse)(nil), "ethereum.beacon.rpc.v1.ChainStartResponse")
+	proto.RegisterType((*ValidatorIndexRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexRequest")
+	proto.RegisterType((*ValidatorIndexResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexResponse")
+	proto.RegisterType((*AssignmentRequest)(nil), "ethereum.beacon.rpc.v1.AssignmentRequest")
+	proto.RegisterType((*AssignmentResponse)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse")
+	proto.RegisterType((*AssignmentResponse_ValidatorAssignment)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse.ValidatorAssignment")
+	proto.RegisterType((*ValidatorStatusResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorStatusResponse")
+	proto.RegisterType((*DomainRequest)(nil), "ethereum.beacon.rpc.v1.DomainRequest")
+	proto.RegisterType((*DomainResponse)(nil), "ethereum.beacon.rpc.v1.DomainResponse")
+	proto.RegisterType((*BlockTreeResponse)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse")
+	proto.RegisterType((*BlockTreeResponse_TreeNode)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse.TreeNode")
+	proto.RegisterType((*TreeBlockSlotRequest)(nil), "ethereum.beacon.rpc.v1.TreeBlockSlotRequest")
+	proto.RegisterType((*ValidatorQueueResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorQueueResponse")
+	proto.RegisterType((*AssignmentProofRequest)(nil), "ethereum.beacon.rpc.v1.AssignmentProofRequest")
+	proto.RegisterType((*AssignmentProofResponse)(nil), "ethereum.beacon.rpc.v1.AssignmentProofResponse")
+	proto.RegisterType((*ChainStartStatusResponse)(nil), "ethereum.beacon.rpc.v1.ChainStartStatusResponse")
+	proto.RegisterType((*Eth1DataVotesResponse)(nil), "ethereum.beacon.rpc.v1.Eth1DataVotesResponse")
+	proto.RegisterType((*Eth1DataVotesResponse_VoteTally)(nil), "ethereum.beacon.rpc.v1.Eth1DataVotesResponse.VoteTally")
+	proto.RegisterType((*Eth1DataVotesResponse_Eth1Block)(nil), "ethereum.beacon.rpc.v1.Eth1DataVotesResponse.Eth1Block")
+	proto.RegisterType((*FinalizedChainRequest)(nil), "ethereum.beacon.rpc.v1.FinalizedChainRequest")
+	proto.RegisterType((*FinalizedChainUpdate)(nil), "ethereum.beacon.rpc.v1.FinalizedChainUpdate")
+	proto.RegisterType((*ForkChoiceAuditRequest)(nil), "ethereum.beacon.rpc.v1.ForkChoiceAuditRequest")
+	proto.RegisterType((*ForkChoiceAuditResponse)(nil), "ethereum.beacon.rpc.v1.ForkChoiceAuditResponse")
+	proto.RegisterType((*ForkChoiceAuditResponse_Decision)(nil), "ethereum.beacon.rpc.v1.ForkChoiceAuditResponse.Decision")
+	proto.RegisterType((*ForkChoiceAuditResponse_Step)(nil), "ethereum.beacon.rpc.v1.ForkChoiceAuditResponse.Step")
+	proto.RegisterType((*ForkChoiceAuditResponse_Candidate)(nil), "ethereum.beacon.rpc.v1.ForkChoiceAuditResponse.Candidate")
 }
 
-func (m *ExitedValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285)
 }
 
-func (m *ExitedValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_9eb4e94b85965285 = []byte{
+	// 3034 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0xcb, 0x6f, 0x1b, 0xc7,
+	0xf9, 0x59, 0x8a, 0xa2, 0xa9, 0x8f, 0x94, 0x44, 0x8f, 0x25, 0x99, 0xa6, 0x5f, 0xcc, 0xfe, 0x1c,
+	0xc7, 0x36, 0x6c, 0x52, 0x66, 0x02, 0x27, 0x71, 0x7e, 0x69, 0x42, 0x49, 0xb4, 0xcd, 0x58, 0x91,
+	0x95, 0x25, 0x6d, 0x27, 0x48, 0x81, 0xed, 0x70, 0x39, 0x22, 0x27, 0x5e, 0xee, 0xae, 0x77, 0x87,
+	0xb4, 0xd5, 0x02, 0x05, 0xda, 0x7b, 0x51, 0x24, 0x41, 0xcf, 0x69, 0x6f, 0x3d, 0xf5, 0xd2, 0x5b,
+	0x8f, 0xb9, 0xb4, 0xc8, 0xa9, 0x40, 0x2f, 0x05, 0x5a, 0x14, 0x85, 0x91, 0x3f, 0xa4, 0x98, 0xc7,
+	0x2e, 0x97, 0x2f, 0x8b, 0x4a, 0xda, 0x93, 0x38, 0xdf, 0x7b, 0xbe, 0xf9, 0x5e, 0x3b, 0x23, 0xd0,
+	0x3d, 0xdf, 0x65, 0x6e, 0xb9, 0x45, 0xb0, 0xe5, 0x3a, 0x65, 0xdf, 0xb3, 0xca, 0x83, 0x9b, 0xe5,
+	0x80, 0xf8, 0x03, 0x6a, 0x91, 0xa0, 0x24, 0x90, 0x68, 0x83, 0xb0, 0x2e, 0xf1, 0x49, 0xbf, 0x57,
+	0x92, 0x64, 0x25, 0xdf, 0xb3, 0x4a, 0x83, 0x9b, 0x85, 0xb3, 0x1d, 0xd7, 0xed, 0xd8, 0xa4, 0x2c,
+	0xa8, 0x5a, 0xfd, 0x83, 0x32, 0xe9, 0x79, 0xec, 0x50, 0x32, 0x15, 0x5e, 0x93, 0x82, 0x09, 0xeb,
+	0x96, 0x07, 0x37, 0xb1, 0xed, 0x75, 0xf1, 0x4d, 0xa5, 0xc5, 0x6c, 0xd9, 0xae, 0xf5, 0x44, 0x91,
+	0x5d, 0x9a, 0x42, 0x86, 0x19, 0x23, 0x01, 0xc3, 0x8c, 0xba, 0x8e, 0xa2, 0x3a, 0xa7, 0x34, 0x61,
+	0x8f, 0x96, 0xb1, 0xe3, 0xb8, 0x12, 0xa9, 0xec, 0x2b, 0x5c, 0x17, 0x7f, 0xac, 0x1b, 0x1d, 0xe2,
+	0xdc, 0x08, 0x9e, 0xe1, 0x4e, 0x87, 0xf8, 0x65, 0xd7, 0x13, 0x14, 0x93, 0xd4, 0xfa, 0x5d, 0xc8,
+	0x6e, 0x71, 0x03, 0x0c, 0xf2, 0xb4, 0x4f, 0x02, 0x86, 0x10, 0x24, 0x03, 0xdb, 0x65, 0x79, 0xad,
+	0xa8, 0x5d, 0x49, 0x1a, 0xe2, 0x37, 0xfa, 0x3f, 0x58, 0xf6, 0xb1, 0xd3, 0xc6, 0xae, 0xe9, 0x93,
+	0x01, 0xc1, 0x76, 0x3e, 0x51, 0xd4, 0xae, 0x64, 0x8d, 0xac, 0x04, 0x1a, 0x02, 0xa6, 0x6f, 0xc2,
+	0xea, 0xbe, 0xef, 0x7a, 0x6e, 0x40, 0x0c, 0x12, 0x78, 0xae, 0x13, 0x10, 0x74, 0x1e, 0x40, 0x6c,
+	0xce, 0xf4, 0x5d, 0x25, 0x31, 0x6b, 0x2c, 0x09, 0x88, 0xe1, 0xba, 0x4c, 0xff, 0x56, 0x03, 0x54,
+	0x1d, 0x6e, 0x2e, 0xb4, 0xe0, 0x3c, 0x80, 0xd7, 0x6f, 0xd9, 0xd4, 0x32, 0x9f, 0x90, 0xc3, 0x90,
+	0x4b, 0x42, 0xee, 0x93, 0x43, 0x74, 0x1a, 0x4e, 0x78, 0xae, 0x65, 0xb6, 0x28, 0x53, 0x66, 0xa4,
+	0x3c, 0xd7, 0xda, 0xa2, 0x43, 0xcb, 0x17, 0x62, 0x96, 0xaf, 0xc1, 0x62, 0xd0, 0xc5, 0x7e, 0x3b,
+	0x9f, 0x14, 0x40, 0xb9, 0x40, 0xaf, 0xc3, 0xaa, 0xe5, 0xf6, 0x7a, 0x94, 0x31, 0x42, 0x4c, 0xea,
+	0xb4, 0xc9, 0xf3, 0xfc, 0xa2, 0xc0, 0xaf, 0x44, 0xe0, 0x3a, 0x87, 0xa2, 0xab, 0x90, 0x1b, 0x12,
+	0xda, 0xc4, 0xe9, 0xb0, 0x6e, 0x3e, 0x25, 0x28, 0x87, 0x02, 0x76, 0x05, 0x58, 0xbf, 0x04, 0x2b,
+	0x72, 0x2f, 0xd1, 0xee, 0x11, 0x24, 0x63, 0xfb, 0x16, 0xbf, 0xf5, 0x7d, 0x38, 0xfb, 0x08, 0xdb,
+	0xb4, 0x8d, 0x99, 0xeb, 0xef, 0x13, 0xff, 0xc0, 0xf5, 0x7b, 0xd8, 0xb1, 0xc8, 0xcb, 0x9c, 0x3f,
+	0xea, 0x8e, 0xc4, 0x98, 0x3b, 0xf4, 0xef, 0x34, 0x38, 0x37, 0x5d, 0xa4, 0x32, 0x23, 0x0f, 0x27,
+	0x5a, 0xd8, 0xe6, 0x20, 0x25, 0x36, 0x5c, 0xf2, 0xdd, 0x31, 0x97, 0x61, 0xdb, 0x1c, 0x84, 0xfc,
+	0x81, 0x90, 0x9f, 0x34, 0x56, 0x05, 0x3c, 0x12, 0x1b, 0xa0, 0x5b, 0x70, 0x5a, 0x92, 0x62, 0x8b,
+	0xd1, 0x01, 0x89, 0x73, 0x48, 0x77, 0xaf, 0x0b, 0x74, 0x55, 0x60, 0x63, 0x7c, 0x77, 0xa1, 0x88,
+	0x07, 0xc4, 0xc7, 0x1d, 0x32, 0xc1, 0x69, 0x86, 0x56, 0xf1, 0xa3, 0x49, 0x18, 0xe7, 0x15, 0xdd,
+	0x98, 0x88, 0x2d, 0x49, 0xa4, 0xbf, 0x07, 0x85, 0x08, 0x26, 0x48, 0x46, 0x42, 0xe6, 0x22, 0x64,
+	0x86, 0x3e, 0x0a, 0xf2, 0x5a, 0x71, 0xe1, 0x4a, 0xd6, 0x80, 0xc8, 0x49, 0x81, 0xfe, 0x75, 0x02,
+	0xce, 0x4e, 0xe5, 0x57, 0x4e, 0xba, 0x05, 0xeb, 0x58, 0x42, 0x49, 0xdb, 0x9c, 0x10, 0xb5, 0x95,
+	0xc8, 0x6b, 0xc6, 0xa9, 0x88, 0x60, 0x3f, 0x92, 0x8b, 0x1e, 0x41, 0x9a, 0x47, 0x6f, 0x3f, 0x20,
+	0xdc, 0x75, 0x0b, 0x57, 0x32, 0x95, 0xdb, 0xa5, 0xe9, 0xe5, 0xa1, 0xf4, 0x12, 0xf5, 0xa5, 0x86,
+	0x90, 0x61, 0x44, 0xb2, 0x0a, 0x1e, 0xa4, 0x24, 0xec, 0xa8, 0x6c, 0xb8, 0x0b, 0x29, 0xc9, 0x24,
+	0x4e, 0x2e, 0x53, 0x29, 0x1f, 0xa9, 0x5e, 0xe9, 0x52, 0xaa, 0x0d, 0xc5, 0xae, 0xdf, 0x86, 0xd3,
+	0xb5, 0xe7, 0x94, 0x91, 0xf6, 0xf0, 0xf4, 0xe6, 0xf6, 0xee, 0xbb, 0x90, 0x9f, 0xe4, 0x55, 0x9e,
+	0x3d, 0x92, 0xf9, 0x63, 0x40, 0xdb, 0x5d, 0x4c, 0x9d, 0x06, 0xc3, 0x3e, 0x8b, 0x47, 0x6d, 0xc0,
+	0x01, 0xa4, 0x2d, 0xf6, 0x9c, 0x36, 0xc2, 0x25, 0x7a, 0x15, 0xb2, 0x1d, 0xe2, 0x90, 0x80, 0x06,
+	0x26, 0xa3, 0x3d, 0xa2, 0x22, 0x36, 0xa3, 0x60, 0x4d, 0xda, 0x23, 0xfa, 0x2d, 0x58, 0x8f, 0x2c,
+	0x11, 0x89, 0x3c, 0x5f, 0x69, 0xd1, 0x4b, 0xb0, 0x31, 0xce, 0xa7, 0xcc, 0x59, 0x83, 0x45, 0x59,
+	0x27, 0x64, 0x0a, 0xc9, 0x85, 0xfe, 0x10, 0x4e, 0x56, 0x83, 0x80, 0x76, 0x9c, 0x1e, 0x71, 0x58,
+	0xcc, 0x5b, 0xc4, 0x73, 0xad, 0xae, 0x29, 0x0c, 0x56, 0x0c, 0x20, 0x40, 0x62, 0x8b, 0xe3, 0x1e,
+	0x49, 0x4c, 0x78, 0xe4, 0xc5, 0x02, 0xa0, 0xb8, 0x5c, 0x65, 0xc3, 0x53, 0x58, 0x1b, 0x26, 0x0f,
+	0x8e, 0xf0, 0xc2, 0xa5, 0x99, 0xca, 0x8f, 0x66, 0x1d, 0xfc, 0xa4, 0xa4, 0x58, 0x28, 0x0e, 0x71,
+	0xa7, 0x06, 0x93, 0x40, 0xbe, 0x6d, 0x61, 0xb8, 0x72, 0xb2, 0x5c, 0x14, 0xbe, 0x49, 0xc0, 0xa9,
+	0x29, 0x22, 0xd0, 0x39, 0x58, 0x8a, 0xaa, 0xa2, 0xb0, 0x2a, 0x69, 0x0c, 0x01, 0xc3, 0x52, 0x9c,
+	0x88, 0x97, 0xe2, 0x69, 0x45, 0xfb, 0x22, 0x64, 0x68, 0x60, 0x7a, 0xb2, 0x99, 0xf8, 0xa2, 0x3e,
+	0xa4, 0x0d, 0xa0, 0x81, 0x6a, 0x2f, 0xfe, 0xd8, 0x31, 0x2e, 0x8e, 0xe7, 0xc4, 0xfb, 0x51, 0x4e,
+	0xf0, 0x5a, 0xbd, 0x52, 0x79, 0x7d, 0xde, 0x9c, 0x50, 0x6c, 0xd3, 0xfa, 0xc3, 0x89, 0xa9, 0xfd,
+	0xe1, 0x1d, 0x38, 0xe3, 0x90, 0xe7, 0xcc, 0x94, 0x07, 0x1e, 0x5a, 0x6c, 0xf2, 0x5d, 0x04, 0xf9,
+	0xb4, 0xf0, 0xc0, 0x06, 0x27, 0xa8, 0x71, 0x7c, 0x68, 0x7e, 0x83, 0x63, 0xf5, 0x3f, 0x2f, 0xc0,
+	0xe9, 0x19, 0x39, 0x19, 0xdb, 0x80, 0xf6, 0xfd, 0x36, 0xf0, 0x0e, 0x9c, 0x21, 0xac, 0x7b, 0xd3,
+	0x6c, 0x13, 0xcf, 0x0d, 0x28, 0x93, 0x23, 0x86, 0xe9, 0xf4, 0x7b, 0x2d, 0xe2, 0x2b, 0xff, 0xf3,
+	0x29, 0xe6, 0xe6, 0x8e, 0xc4, 0x8b, 0x01, 0x60, 0x4f, 0x60, 0xd1, 0x9b, 0xb0, 0x11, 0x72, 0x51,
+	0xc7, 0xb2, 0xfb, 0x01, 0x75, 0x1d, 0x33, 0x76, 0x44, 0x6b, 0x0a, 0x5b, 0x0f, 0x91, 0x7c, 0x3b,
+	0xbc, 0x95, 0xe0, 0xa8, 0xac, 0x49, 0x77, 0xa8, 0x96, 0xbb, 0x3a, 0x84, 0x0b, 0x2f, 0xa0, 0xf7,
+	0xe1, 0x9c, 0x10, 0xc0, 0x09, 0xa9, 0x63, 0xc6, 0xd8, 0x9e, 0xf6, 0x49, 0x9f, 0xa8, 0x4e, 0x7c,
+	0x26, 0xa4, 0xa9, 0x3b, 0xc3, 0x7a, 0xf9, 0x31, 0x27, 0x40, 0x1f, 0xc0, 0xb9, 0xb8, 0x2e, 0x9b,
+	0x76, 0x68, 0x8b, 0xda, 0x94, 0x1d, 0x2a, 0xbd, 0xb2, 0x41, 0x17, 0x62, 0x7a, 0x87, 0x24, 0xd2,
+	0x84, 0xf3, 0x00, 0xe4, 0x39, 0x55, 0xc7, 0xa6, 0x8e, 0x76, 0x89, 0x43, 0x24, 0xfa, 0x06, 0xa0,
+	0x67, 0x94, 0x75, 0xdb, 0x3e, 0x7e, 0x86, 0x5b, 0x36, 0x51, 0x64, 0x69, 0x41, 0x76, 0x32, 0x8e,
+	0x11, 0xe4, 0xfa, 0x7b, 0xb0, 0xbc, 0xe3, 0xf6, 0x30, 0x8d, 0xba, 0x51, 0x94, 0x35, 0x5a, 0x2c,
+	0x6b, 0xd0, 0x06, 0xa4, 0xda, 0x82, 0x2c, 0x1c, 0x5b, 0xe4, 0x4a, 0x7f, 0x17, 0x56, 0x42, 0x76,
+	0x75, 0xfc, 0x57, 0x21, 0xc7, 0x73, 0x0a, 0xb3, 0xbe, 0x4f, 0x4c, 0xc5, 0x23, 0x45, 0xad, 0x46,
+	0x70, 0xc9, 0xa2, 0x7f, 0x91, 0x80, 0x93, 0xe2, 0xf4, 0x9a, 0x3e, 0x19, 0xb6, 0xfc, 0x3b, 0x90,
+	0x64, 0xbe, 0xca, 0xc1, 0x4c, 0xa5, 0x32, 0x2b, 0x7a, 0x26, 0x18, 0x4b, 0x7c, 0xb1, 0xe7, 0xb6,
+	0x89, 0x21, 0xf8, 0x0b, 0x7f, 0xd4, 0x20, 0x1d, 0x82, 0xd0, 0xdb, 0xb0, 0x28, 0xc2, 0x48, 0x98,
+	0x92, 0xa9, 0xe8, 0x43, 0xa9, 0x84, 0x75, 0x4b, 0xe1, 0xb4, 0x5a, 0xda, 0x12, 0x2a, 0x84, 0x68,
+	0x43, 0x32, 0x8c, 0x8d, 0x81, 0x89, 0xb1, 0x31, 0x90, 0xbb, 0xdb, 0xc3, 0x3e, 0xa3, 0x16, 0xf5,
+	0x44, 0xfb, 0x1d, 0xb8, 0x8c, 0x84, 0x63, 0xc5, 0xc9, 0x38, 0xe6, 0x11, 0x47, 0xf0, 0xea, 0xa0,
+	0xa6, 0x16, 0x41, 0x27, 0xa3, 0x0c, 0x04, 0x48, 0x10, 0xe8, 0xbb, 0xb0, 0xc6, 0x8d, 0x16, 0x26,
+	0xf0, 0xe0, 0x0c, 0x8f, 0xe5, 0x2c, 0x2c, 0xf1, 0x38, 0x36, 0x0f, 0x7c, 0xb7, 0xa7, 0xfc, 0x99,
+	0xe6, 0x80, 0x3b, 0xbe, 0xdb, 0xe3, 0x53, 0xa5, 0x40, 0x32, 0x57, 0xe5, 0x47, 0x8a, 0x2f, 0x9b,
+	0xae, 0xfe, 0x4d, 0x22, 0xd6, 0x14, 0x44, 0x00, 0xc6, 0x5b, 0x9b, 0xd5, 0xed, 0xfb, 0x8e, 0x69,
+	0xd3, 0x1e, 0x8d, 0x2a, 0xbd, 0x00, 0xed, 0x72, 0x08, 0x9f, 0x9a, 0xc6, 0xc3, 0x3b, 0x9c, 0x22,
+	0xa5, 0x92, 0x75, 0x3c, 0x1a, 0xdb, 0x72, 0x96, 0x44, 0xd7, 0xe0, 0xa4, 0x88, 0xcf, 0x11, 0x0e,
+	0xe9, 0x90, 0x55, 0x8e, 0x88, 0xd3, 0x1e, 0x95, 0x4e, 0xc9, 0xa3, 0xd2, 0xe9, 0x16, 0x9c, 0x16,
+	0x01, 0x1a, 0x98, 0x7d, 0x87, 0x51, 0x3b, 0x26, 0x41, 0xa5, 0xe2, 0xba, 0x44, 0x3f, 0xe4, 0xd8,
+	0x21, 0xb3, 0x30, 0x32, 0xce, 0xc7, 0x0d, 0x0b, 0x87, 0xe3, 0x18, 0x07, 0x1f, 0x0c, 0xf4, 0x8f,
+	0x60, 0x63, 0xd8, 0x27, 0xf6, 0x7d, 0xd7, 0x3d, 0x78, 0x79, 0xae, 0x1c, 0x31, 0xf3, 0x7e, 0x91,
+	0x84, 0xd3, 0x13, 0xf2, 0x86, 0x9d, 0x7a, 0x8a, 0xc0, 0xd7, 0x61, 0x75, 0xd8, 0x3b, 0x65, 0x45,
+	0x97, 0x27, 0xb0, 0x32, 0x18, 0x69, 0xf8, 0xbc, 0xfc, 0x4d, 0x0c, 0xaa, 0x96, 0xdb, 0x77, 0xa2,
+	0xf2, 0x87, 0x47, 0xe7, 0xd3, 0x6d, 0x8e, 0xe3, 0x33, 0x89, 0xe2, 0x92, 0xb2, 0xa5, 0xd3, 0x33,
+	0x12, 0x26, 0x05, 0xbf, 0x06, 0x2b, 0x41, 0xb7, 0x7f, 0x70, 0x60, 0x93, 0xf6, 0xc8, 0x27, 0xc7,
+	0x72, 0x08, 0x95, 0x64, 0x23, 0xad, 0x47, 0x2a, 0x4e, 0x8d, 0xb5, 0x1e, 0xa9, 0xf2, 0x22, 0x64,
+	0xc4, 0x80, 0x61, 0xca, 0xa6, 0x2a, 0x8b, 0x18, 0x08, 0x50, 0x63, 0xd6, 0x47, 0x4e, 0x7a, 0x6a,
+	0x13, 0x8b, 0x1a, 0xf3, 0xd2, 0xb4, 0xc6, 0x0c, 0xa3, 0x9f, 0x22, 0xbc, 0xc1, 0x10, 0x99, 0xc8,
+	0x19, 0x79, 0x2c, 0x02, 0x22, 0x12, 0x99, 0xb3, 0x10, 0xd2, 0xce, 0x67, 0x05, 0x42, 0xfc, 0xe6,
+	0x2c, 0xea, 0xd3, 0xb1, 0x47, 0x9f, 0xe7, 0x97, 0x25, 0x8b, 0x84, 0x7c, 0x44, 0x9f, 0xf3, 0x20,
+	0x8a, 0x3b, 0x4e, 0x0a, 0x5e, 0x11, 0x54, 0xab, 0x31, 0xef, 0x09, 0xf1, 0x23, 0xe3, 0xc5, 0xea,
+	0xd8, 0x78, 0xa1, 0xff, 0x3e, 0x01, 0xf9, 0xe1, 0x1c, 0x39, 0xd6, 0x50, 0x7f, 0xc8, 0x34, 0xc9,
+	0xbf, 0x7e, 0xc3, 0x8e, 0x18, 0x8f, 0x84, 0xac, 0x02, 0xca, 0xe3, 0x98, 0x1d, 0x37, 0xc9, 0x97,
+	0xc4, 0xcd, 0x87, 0xa0, 0xf7, 0xa8, 0x63, 0x86, 0x16, 0xcc, 0x90, 0x20, 0x03, 0xe5, 0x42, 0x8f,
+	0x3a, 0x77, 0x25, 0x61, 0x75, 0x9a, 0xac, 0x2b, 0x90, 0x8b, 0xcb, 0x12, 0xbb, 0x51, 0xa1, 0x33,
+	0xe4, 0x14, 0xe3, 0xf1, 0xbf, 0x16, 0x61, 0xbd, 0xc6, 0xbb, 0x3f, 0x66, 0x58, 0x94, 0xcc, 0xf8,
+	0x27, 0xeb, 0xc4, 0xf7, 0xe7, 0x5b, 0x90, 0x1f, 0xb8, 0x8c, 0x3a, 0x1d, 0xd3, 0x23, 0x3e, 0x75,
+	0xdb, 0xa6, 0x0a, 0x3b, 0x5b, 0xd5, 0xf2, 0xa4, 0xb1, 0x2e, 0xf1, 0xfb, 0x02, 0x2d, 0xdd, 0xcf,
+	0x19, 0xff, 0x1f, 0x96, 0xe4, 0x10, 0x82, 0x19, 0x16, 0x3e, 0xcb, 0x54, 0x2e, 0xce, 0x68, 0x1a,
+	0xa1, 0x35, 0x46, 0x9a, 0xa8, 0x5f, 0xe8, 0x3a, 0xa0, 0x91, 0x11, 0x26, 0x9e, 0x58, 0xb9, 0xd8,
+	0xec, 0x12, 0x65, 0x97, 0x68, 0x07, 0xa6, 0x4f, 0x9e, 0xf6, 0xa9, 0x4f, 0xda, 0x61, 0x76, 0x0d,
+	0xe4, 0xfe, 0x24, 0x10, 0x7d, 0x04, 0x8b, 0x02, 0x90, 0x4f, 0x89, 0xce, 0xf8, 0xd6, 0xac, 0xce,
+	0x38, 0xd5, 0x3b, 0x25, 0xbe, 0x6a, 0x62, 0xdb, 0x3e, 0x34, 0xa4, 0x14, 0xb4, 0x03, 0xcb, 0xd4,
+	0x61, 0xc4, 0x69, 0xab, 0xae, 0x95, 0x3f, 0x31, 0xdf, 0x2e, 0xb3, 0x21, 0x17, 0x97, 0x88, 0x1e,
+	0x03, 0x58, 0xd8, 0x69, 0xf3, 0xb3, 0x24, 0x72, 0x6a, 0x3c, 0xb6, 0x65, 0x1c, 0x2a, 0x5b, 0x6e,
+	0x4c, 0x54, 0xc1, 0x84, 0xa5, 0xc8, 0xe4, 0xd1, 0xd3, 0xd0, 0x8e, 0x7b, 0x1a, 0x6b, 0xb0, 0x28,
+	0x63, 0x51, 0x0d, 0xef, 0x62, 0x51, 0xf8, 0x8d, 0x06, 0x4b, 0x91, 0x6a, 0x9e, 0x4a, 0x23, 0x73,
+	0xa6, 0x0c, 0xa2, 0x4c, 0x2b, 0x36, 0x5c, 0x46, 0x93, 0x40, 0x17, 0x07, 0xdd, 0x91, 0x49, 0xe0,
+	0x1e, 0x0e, 0xba, 0xf3, 0x65, 0xda, 0xab, 0x10, 0xae, 0x65, 0xb5, 0x48, 0x0a, 0x29, 0x19, 0x05,
+	0x13, 0x17, 0x4b, 0x5d, 0x58, 0xbf, 0x43, 0x1d, 0x6c, 0xd3, 0x9f, 0x92, 0xb6, 0xa8, 0x09, 0xb1,
+	0x11, 0x80, 0x77, 0x7f, 0x33, 0x16, 0xe4, 0x69, 0x0e, 0x10, 0xf1, 0x5a, 0x81, 0x75, 0x59, 0xdd,
+	0xf8, 0xe9, 0xf8, 0x03, 0x6c, 0xcb, 0xc1, 0x2f, 0xbc, 0x13, 0x39, 0x25, 0x90, 0x75, 0x85, 0x13,
+	0xa3, 0x5f, 0xa0, 0xff, 0x4a, 0x83, 0xb5, 0x51, 0x55, 0x0f, 0x3d, 0xee, 0xfc, 0x1f, 0x30, 0x2d,
+	0xf1, 0x72, 0xcc, 0x35, 0x29, 0xf7, 0xc8, 0x05, 0x0f, 0xf0, 0x83, 0x50, 0x4f, 0x7c, 0x1c, 0x5f,
+	0x8e, 0xa0, 0x7c, 0x0f, 0xfc, 0x0b, 0xf6, 0x8e, 0xeb, 0x3f, 0xd9, 0xee, 0xba, 0xd4, 0x22, 0xd5,
+	0x7e, 0x9b, 0xb2, 0x58, 0xa3, 0x95, 0x3e, 0xd5, 0x62, 0x27, 0xa8, 0x7f, 0xbb, 0x08, 0xa7, 0x27,
+	0x18, 0x54, 0x31, 0x78, 0x04, 0x4b, 0x6d, 0x62, 0x51, 0x3e, 0xe3, 0x07, 0x6a, 0x94, 0x7c, 0x7b,
+	0x56, 0x58, 0xce, 0x90, 0x51, 0xda, 0x51, 0x02, 0x8c, 0xa1, 0xa8, 0xc2, 0xdf, 0x13, 0x90, 0x0e,
+	0xe1, 0xbc, 0xa8, 0xf3, 0x4a, 0x15, 0x30, 0xdc, 0xf3, 0x94, 0x69, 0x43, 0x00, 0xdf, 0xf5, 0xe7,
+	0xfd, 0x80, 0xd1, 0x03, 0x4a, 0xda, 0xf1, 0xe9, 0x71, 0x39, 0x82, 0x8a, 0xce, 0x30, 0x42, 0x16,
+	0x77, 0x4e, 0x04, 0x6d, 0xa8, 0x6b, 0x4c, 0xcf, 0x27, 0x03, 0xea, 0xf6, 0x03, 0xb3, 0x4b, 0x70,
+	0x5b, 0x85, 0x4e, 0x36, 0x04, 0xde, 0x23, 0xb8, 0x3d, 0x42, 0x24, 0x44, 0xc9, 0x42, 0x12, 0x11,
+	0x09, 0x49, 0x67, 0x20, 0xed, 0x90, 0x67, 0x52, 0x48, 0x4a, 0x08, 0x39, 0xe1, 0x90, 0x67, 0x82,
+	0x5f, 0xa1, 0x04, 0xab, 0x6c, 0xca, 0x1c, 0xd5, 0x50, 0x97, 0x91, 0x3e, 0x71, 0xfd, 0x8e, 0xe8,
+	0xc3, 0x69, 0x43, 0x2e, 0xd0, 0x87, 0xfc, 0xbc, 0x89, 0x17, 0xe4, 0x97, 0x84, 0x8b, 0xdf, 0x3c,
+	0xae, 0x8b, 0x1b, 0x8c, 0x78, 0x86, 0x14, 0x51, 0xf8, 0x83, 0x06, 0x49, 0xbe, 0xe6, 0x1f, 0x1b,
+	0x1e, 0xf6, 0x89, 0x13, 0xde, 0x3e, 0xaa, 0x15, 0xfa, 0x74, 0xa4, 0xd6, 0xc8, 0x1b, 0xab, 0x77,
+	0x8e, 0xab, 0x71, 0x3b, 0x94, 0x10, 0xaf, 0x36, 0x5c, 0xa5, 0xd5, 0x75, 0x03, 0xe2, 0x08, 0xe7,
+	0x67, 0x0d, 0xb5, 0xe2, 0x39, 0xc7, 0x28, 0x31, 0x5b, 0x3e, 0xc1, 0x4f, 0xd4, 0xb7, 0x7c, 0x9a,
+	0x51, 0xb2, 0xc5, 0xd7, 0x85, 0xfb, 0xb0, 0x14, 0x49, 0x9b, 0x76, 0x61, 0x1a, 0x75, 0xa4, 0x44,
+	0xac, 0x23, 0x6d, 0x40, 0xea, 0x19, 0xa1, 0x9d, 0x6e, 0x78, 0xcc, 0x6a, 0x75, 0xed, 0x6d, 0x58,
+	0x8e, 0x7a, 0xa2, 0xe1, 0xda, 0x04, 0x65, 0xe0, 0xc4, 0xc3, 0xbd, 0xfb, 0x7b, 0x0f, 0x1e, 0xef,
+	0xe5, 0x5e, 0x41, 0x59, 0x48, 0x57, 0x9b, 0xcd, 0x5a, 0xa3, 0x59, 0x33, 0x72, 0x1a, 0x5f, 0xed,
+	0x1b, 0x0f, 0xf6, 0x1f, 0x34, 0x6a, 0x46, 0x2e, 0x71, 0xed, 0x77, 0x1a, 0xac, 0x8e, 0x7d, 0x4b,
+	0x23, 0x04, 0x2b, 0x8a, 0xd9, 0x6c, 0x34, 0xab, 0xcd, 0x87, 0x8d, 0xdc, 0x2b, 0x1c, 0xb6, 0x5f,
+	0xdb, 0xdb, 0xa9, 0xef, 0xdd, 0x35, 0xab, 0xdb, 0xcd, 0xfa, 0xa3, 0x5a, 0x4e, 0x43, 0x00, 0x29,
+	0xf5, 0x3b, 0xc1, 0xf1, 0xf5, 0xbd, 0x7a, 0xb3, 0x5e, 0x6d, 0xd6, 0x76, 0xcc, 0xda, 0x27, 0xf5,
+	0x66, 0x6e, 0x01, 0xe5, 0x20, 0xfb, 0xb8, 0xde, 0xbc, 0xb7, 0x63, 0x54, 0x1f, 0x57, 0xb7, 0x76,
+	0x6b, 0xb9, 0x24, 0xe7, 0xe0, 0xb8, 0xda, 0x4e, 0x6e, 0x91, 0x73, 0xc8, 0xdf, 0x66, 0x63, 0xb7,
+	0xda, 0xb8, 0x57, 0xdb, 0xc9, 0xa5, 0xd0, 0x1a, 0xe4, 0x76, 0x6a, 0xfb, 0x0f, 0x1a, 0xf5, 0xa6,
+	0x69, 0xd4, 0xb6, 0x6b, 0xf5, 0x47, 0xb5, 0x9d, 0xdc, 0x89, 0xca, 0xaf, 0x53, 0xb0, 0x2c, 0xcb,
+	0x45, 0x43, 0xbe, 0x47, 0xa0, 0x4f, 0xe1, 0xe4, 0x63, 0x4c, 0xd9, 0x1d, 0xd7, 0x1f, 0x0e, 0x3e,
+	0x68, 0xa3, 0x24, 0x1f, 0x07, 0x4a, 0xe1, 0x33, 0x44, 0xa9, 0xc6, 0x9f, 0x21, 0x0a, 0xd7, 0x66,
+	0x9d, 0xf2, 0xe4, 0xe5, 0xdb, 0xa6, 0x86, 0xee, 0xc3, 0xf2, 0x36, 0x76, 0x5c, 0x87, 0x5a, 0xd8,
+	0x16, 0x61, 0x3d, 0x4b, 0xec, 0x1c, 0x85, 0x0d, 0x7d, 0xad, 0xc1, 0x52, 0xf4, 0xad, 0x39, 0x53,
+	0xd2, 0xd5, 0xb9, 0x3f, 0x53, 0xf5, 0x07, 0x5f, 0x56, 0x37, 0x51, 0xe9, 0x0e, 0x61, 0x56, 0x97,
+	0x04, 0x45, 0x51, 0x36, 0x8b, 0xcc, 0x27, 0xa4, 0x18, 0x50, 0xc7, 0x22, 0x45, 0x1b, 0x07, 0xac,
+	0x18, 0xd5, 0x45, 0x89, 0x2f, 0xfd, 0xf2, 0x6f, 0xdf, 0x7d, 0x95, 0xd8, 0x40, 0x6b, 0xfc, 0x41,
+	0x47, 0x3d, 0xef, 0x08, 0x04, 0xe7, 0x43, 0x4f, 0x20, 0x17, 0x69, 0xd9, 0x3a, 0x14, 0x17, 0x34,
+	0xe8, 0xfa, 0x2c, 0x7b, 0xa6, 0x7d, 0x5c, 0x1e, 0xc3, 0x7a, 0xf4, 0x63, 0xc8, 0x8d, 0x0f, 0xaa,
+	0x33, 0x9d, 0xb2, 0x79, 0xf4, 0xa9, 0x8d, 0x8d, 0xba, 0x7d, 0x58, 0x6b, 0x30, 0x9f, 0xe0, 0xde,
+	0x68, 0x5b, 0x42, 0x37, 0x66, 0x66, 0xf9, 0xb4, 0x4e, 0x59, 0xb8, 0x3e, 0x1f, 0xb9, 0xec, 0x76,
+	0x9b, 0x1a, 0xf2, 0x61, 0x75, 0xac, 0x5c, 0xa0, 0xd2, 0xdc, 0x75, 0x45, 0xaa, 0x2c, 0x1f, 0xb3,
+	0x0e, 0x55, 0xfe, 0xa9, 0xc1, 0xaa, 0x7c, 0x73, 0x21, 0x7e, 0x98, 0x13, 0x5d, 0x40, 0x4a, 0x5e,
+	0xec, 0x65, 0x09, 0xcd, 0x0c, 0xfe, 0xc9, 0xe7, 0xa7, 0xc2, 0xe5, 0x19, 0x11, 0x1d, 0x23, 0x15,
+	0x23, 0x91, 0x09, 0x27, 0x1b, 0xfd, 0x56, 0x8f, 0x8e, 0x28, 0xd2, 0x8f, 0x66, 0x2e, 0x5c, 0x7e,
+	0xb9, 0x31, 0xd1, 0xf6, 0x7e, 0x9b, 0x88, 0x5e, 0xd4, 0xa2, 0xed, 0x7d, 0x02, 0x59, 0x65, 0xa7,
+	0x4c, 0xad, 0x4b, 0x2f, 0x0d, 0xbb, 0x70, 0x4b, 0xf3, 0x24, 0xe9, 0x67, 0x90, 0x55, 0xca, 0xe4,
+	0x7a, 0x0e, 0x9e, 0xc2, 0xcc, 0x7b, 0xc9, 0xf1, 0x87, 0xc0, 0x47, 0xb0, 0x3c, 0x32, 0xb8, 0xce,
+	0x8c, 0xf7, 0x1b, 0xc7, 0x9a, 0x7b, 0x2b, 0x5f, 0x2d, 0x41, 0x6e, 0x58, 0xb7, 0x95, 0x8f, 0x3e,
+	0x03, 0x90, 0xb7, 0x63, 0xe2, 0x98, 0x5e, 0x9b, 0x25, 0x71, 0xe4, 0xce, 0xae, 0x70, 0xf9, 0x28,
+	0x32, 0xb5, 0x93, 0x9f, 0x47, 0x35, 0x37, 0x76, 0x15, 0x52, 0x39, 0xd6, 0x9b, 0x8f, 0x54, 0xf8,
+	0xc6, 0xf7, 0x78, 0x27, 0xda, 0xd4, 0x90, 0x0b, 0x2b, 0xa3, 0x4f, 0x14, 0xb3, 0x13, 0x7b, 0xea,
+	0x13, 0x48, 0xa1, 0x34, 0x2f, 0xb9, 0xda, 0xb0, 0x0d, 0xa7, 0xb6, 0xc3, 0x8f, 0xec, 0xd8, 0x5d,
+	0xff, 0xd5, 0x79, 0x9e, 0x1b, 0xa4, 0xc6, 0x6b, 0xf3, 0xbf, 0x4c, 0xa0, 0xa7, 0x93, 0x7d, 0xf8,
+	0x98, 0xfb, 0x3b, 0xee, 0x03, 0x18, 0xfa, 0x85, 0x06, 0x6b, 0xd3, 0x1e, 0x50, 0xd1, 0xd1, 0x27,
+	0x34, 0xf9, 0x82, 0x5b, 0x78, 0xf3, 0x78, 0x4c, 0x51, 0xd1, 0xce, 0x8d, 0x3f, 0xa0, 0xa1, 0x99,
+	0x1b, 0x99, 0xf1, 0x4c, 0x57, 0xd8, 0x9c, 0x9f, 0x41, 0xa9, 0x8d, 0x07, 0x93, 0xbc, 0x0c, 0xfc,
+	0xaf, 0x07, 0xd3, 0xe8, 0x8d, 0xe9, 0xcf, 0x20, 0x3f, 0x25, 0x98, 0xc4, 0x05, 0xde, 0xec, 0x76,
+	0x31, 0xfd, 0xe6, 0xb0, 0x50, 0x9e, 0x9b, 0x5e, 0x29, 0xef, 0x40, 0x56, 0x76, 0xc6, 0x9d, 0x3e,
+	0xa3, 0x24, 0xf8, 0x1f, 0x85, 0xf0, 0xa6, 0xb6, 0xf5, 0xed, 0xc2, 0x97, 0xd5, 0x3f, 0x2d, 0xa0,
+	0x7f, 0x68, 0xb0, 0xb8, 0xef, 0x1f, 0x06, 0x3d, 0x74, 0xe9, 0xc3, 0xc6, 0x83, 0xbd, 0xa2, 0xb1,
+	0xbf, 0x5d, 0x0c, 0xff, 0x95, 0xa4, 0xe8, 0xf9, 0xee, 0x80, 0xb6, 0xf9, 0x74, 0x72, 0x58, 0x14,
+	0x44, 0x25, 0x7d, 0x1b, 0x56, 0xc4, 0x2f, 0xcc, 0xa8, 0x55, 0xdc, 0xc5, 0xad, 0x00, 0x9d, 0xe9,
+	0x32, 0xe6, 0x05, 0xb7, 0xcb, 0x65, 0x2f, 0x84, 0xdb, 0xb8, 0x15, 0x94, 0x2c, 0xb7, 0x57, 0xd8,
+	0x60, 0x04, 0xf7, 0x3e, 0x98, 0x80, 0x5f, 0xfb, 0x09, 0x5c, 0xbc, 0xbb, 0xf7, 0xb0, 0xc8, 0xaf,
+	0x7b, 0x7c, 0x6c, 0x17, 0xe5, 0x53, 0x75, 0x71, 0x97, 0x5a, 0xc4, 0x09, 0x48, 0x71, 0xf0, 0x46,
+	0x69, 0x13, 0xbd, 0x17, 0x4a, 0xed, 0x50, 0xd6, 0xed, 0xb7, 0x38, 0xdb, 0xa8, 0x02, 0xb9, 0xe2,
+	0xe3, 0x51, 0xab, 0xdc, 0xc3, 0xbc, 0xbb, 0x96, 0x77, 0xeb, 0xdb, 0xb5, 0xbd, 0x46, 0xad, 0xd4,
+	0x6b, 0x57, 0x16, 0x37, 0x4b, 0x9b, 0xa5, 0xcd, 0xc2, 0x2a, 0xf6, 0x68, 0xc9, 0xf3, 0x0f, 0x85,
+	0x66, 0x87, 0xb0, 0x6b, 0x5a, 0xa2, 0x92, 0xc3, 0x9e, 0x67, 0x53, 0x4b, 0xd4, 0xac, 0xf2, 0xe7,
+	0x81, 0xeb, 0x54, 0xce, 0xc4, 0x21, 0x1d, 0xdf, 0xb3, 0x6e, 0x3c, 0x23, 0xad, 0x1b, 0x8c, 0x3c,
+	0x67, 0x33, 0x50, 0x2f, 0xe1, 0xe2, 0xa8, 0xdb, 0x13, 0x2a, 0x6e, 0xcf, 0x56, 0xe1, 0xdf, 0xe2,
+	0x3d, 0xed, 0x30, 0xe8, 0x15, 0xef, 0x8a, 0x9d, 0xa2, 0xcb, 0xf3, 0xed, 0xfc, 0x2f, 0x2f, 0x2e,
+	0x68, 0x7f, 0x7d, 0x71, 0x41, 0xfb, 0xf7, 0x8b, 0x0b, 0x5a, 0x2b, 0x25, 0x3a, 0xd4, 0x1b, 0xff,
+	0x19, 0x00, 0x56, 0x50, 0xbc, 0x05, 0x1a, 0x24, 0x00, 0x00,
 }
 
-func (m *ExitedValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BeaconServiceClient is the client API for BeaconService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BeaconServiceClient interface {
+	WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (BeaconService_WaitForChainStartClient, error)
+	CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
+	BlockTree(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*BlockTreeResponse, error)
+	BlockTreeBySlots(ctx context.Context, in *TreeBlockSlotRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error)
+	ChainStartStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ChainStartStatusResponse, error)
+	StreamFinalizedChain(ctx context.Context, in *FinalizedChainRequest, opts ...grpc.CallOption) (BeaconService_StreamFinalizedChainClient, error)
+	ForkChoiceAudit(ctx context.Context, in *ForkChoiceAuditRequest, opts ...grpc.CallOption) (*ForkChoiceAuditResponse, error)
 }
 
-func (m *ChainStartResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type beaconServiceClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *ChainStartResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Started {
-		dAtA[i] = 0x8
-		i++
-		if m.Started {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func NewBeaconServiceClient(cc *grpc.ClientConn) BeaconServiceClient {
+	return &beaconServiceClient{cc}
+}
+
+func (c *beaconServiceClient) WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (BeaconService_WaitForChainStartClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.BeaconService/WaitForChainStart", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.GenesisTime != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.GenesisTime))
+	x := &beaconServiceWaitForChainStartClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return x, nil
 }
 
-func (m *ValidatorIndexRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+type BeaconService_WaitForChainStartClient interface {
+	Recv() (*ChainStartResponse, error)
+	grpc.ClientStream
+}
+
+type beaconServiceWaitForChainStartClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconServiceWaitForChainStartClient) Recv() (*ChainStartResponse, error) {
+	m := new(ChainStartResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *ValidatorIndexRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *beaconServiceClient) CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
+	out := new(v1alpha1.BeaconBlock)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ValidatorIndexResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *beaconServiceClient) BlockTree(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*BlockTreeResponse, error) {
+	out := new(BlockTreeResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/BlockTree", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ValidatorIndexResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Index != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Index))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *beaconServiceClient) BlockTreeBySlots(ctx context.Context, in *TreeBlockSlotRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error) {
+	out := new(BlockTreeResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/BlockTreeBySlots", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *AssignmentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *beaconServiceClient) ChainStartStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ChainStartStatusResponse, error) {
+	out := new(ChainStartStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/ChainStartStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AssignmentRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.EpochStart != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.EpochStart))
+func (c *beaconServiceClient) StreamFinalizedChain(ctx context.Context, in *FinalizedChainRequest, opts ...grpc.CallOption) (BeaconService_StreamFinalizedChainClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconService_serviceDesc.Streams[1], "/ethereum.beacon.rpc.v1.BeaconService/StreamFinalizedChain", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+	x := &beaconServiceStreamFinalizedChainClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return x, nil
 }
 
-func (m *AssignmentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+type BeaconService_StreamFinalizedChainClient interface {
+	Recv() (*FinalizedChainUpdate, error)
+	grpc.ClientStream
+}
+
+type beaconServiceStreamFinalizedChainClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconServiceStreamFinalizedChainClient) Recv() (*FinalizedChainUpdate, error) {
+	m := new(FinalizedChainUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconServiceClient) ForkChoiceAudit(ctx context.Context, in *ForkChoiceAuditRequest, opts ...grpc.CallOption) (*ForkChoiceAuditResponse, error) {
+	out := new(ForkChoiceAuditResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/ForkChoiceAudit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAssignment) > 0 {
-		for _, msg := range m.ValidatorAssignment {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// BeaconServiceServer is the server API for BeaconService service.
+type BeaconServiceServer interface {
+	WaitForChainStart(*types.Empty, BeaconService_WaitForChainStartServer) error
+	CanonicalHead(context.Context, *types.Empty) (*v1alpha1.BeaconBlock, error)
+	BlockTree(context.Context, *types.Empty) (*BlockTreeResponse, error)
+	BlockTreeBySlots(context.Context, *TreeBlockSlotRequest) (*BlockTreeResponse, error)
+	ChainStartStatus(context.Context, *types.Empty) (*ChainStartStatusResponse, error)
+	StreamFinalizedChain(*FinalizedChainRequest, BeaconService_StreamFinalizedChainServer) error
+	ForkChoiceAudit(context.Context, *ForkChoiceAuditRequest) (*ForkChoiceAuditResponse, error)
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func RegisterBeaconServiceServer(s *grpc.Server, srv BeaconServiceServer) {
+	s.RegisterService(&_BeaconService_serviceDesc, srv)
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Committee) > 0 {
-		dAtA3 := make([]byte, len(m.Committee)*10)
-		var j2 int
-		for _, num := range m.Committee {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(j2))
-		i += copy(dAtA[i:], dAtA3[:j2])
-	}
-	if m.Shard != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Shard))
-	}
-	if m.Slot != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+func _BeaconService_WaitForChainStart_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if m.IsProposer {
-		dAtA[i] = 0x20
-		i++
-		if m.IsProposer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return srv.(BeaconServiceServer).WaitForChainStart(m, &beaconServiceWaitForChainStartServer{stream})
+}
+
+type BeaconService_WaitForChainStartServer interface {
+	Send(*ChainStartResponse) error
+	grpc.ServerStream
+}
+
+type beaconServiceWaitForChainStartServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconServiceWaitForChainStartServer) Send(m *ChainStartResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconService_CanonicalHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).CanonicalHead(ctx, in)
 	}
-	if m.Status != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Status))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).CanonicalHead(ctx, req.(*types.Empty))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValidatorStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _BeaconService_BlockTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Status))
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).BlockTree(ctx, in)
 	}
-	if m.Eth1DepositBlockNumber != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Eth1DepositBlockNumber))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BlockTree",
 	}
-	if m.DepositInclusionSlot != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.DepositInclusionSlot))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).BlockTree(ctx, req.(*types.Empty))
 	}
-	if m.ActivationEpoch != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.ActivationEpoch))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_BlockTreeBySlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TreeBlockSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PositionInActivationQueue != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.PositionInActivationQueue))
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).BlockTreeBySlots(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BlockTreeBySlots",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).BlockTreeBySlots(ctx, req.(*TreeBlockSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DomainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _BeaconService_ChainStartStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DomainRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).ChainStartStatus(ctx, in)
 	}
-	if len(m.Domain) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.Domain)))
-		i += copy(dAtA[i:], m.Domain)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/ChainStartStatus",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).ChainStartStatus(ctx, req.(*types.Empty))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DomainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func _BeaconService_StreamFinalizedChain_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FinalizedChainRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(BeaconServiceServer).StreamFinalizedChain(m, &beaconServiceStreamFinalizedChainServer{stream})
 }
 
-func (m *DomainResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.SignatureDomain != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.SignatureDomain))
+func _BeaconService_ForkChoiceAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkChoiceAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).ForkChoiceAudit(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/ForkChoiceAudit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).ForkChoiceAudit(ctx, req.(*ForkChoiceAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BlockTreeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type BeaconService_StreamFinalizedChainServer interface {
+	Send(*FinalizedChainUpdate) error
+	grpc.ServerStream
 }
 
-func (m *BlockTreeResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tree) > 0 {
-		for _, msg := range m.Tree {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type beaconServiceStreamFinalizedChainServer struct {
+	grpc.ServerStream
 }
 
-func (m *BlockTreeResponse_TreeNode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (x *beaconServiceStreamFinalizedChainServer) Send(m *FinalizedChainUpdate) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *BlockTreeResponse_TreeNode) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Block != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Block.Size()))
-		n4, err := m.Block.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
-	}
-	if len(m.BlockRoot) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
-		i += copy(dAtA[i:], m.BlockRoot)
-	}
-	if m.ParticipatedVotes != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.ParticipatedVotes))
-	}
-	if m.TotalVotes != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.TotalVotes))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+var _BeaconService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.BeaconService",
+	HandlerType: (*BeaconServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CanonicalHead",
+			Handler:    _BeaconService_CanonicalHead_Handler,
+		},
+		{
+			MethodName: "BlockTree",
+			Handler:    _BeaconService_BlockTree_Handler,
+		},
+		{
+			MethodName: "BlockTreeBySlots",
+			Handler:    _BeaconService_BlockTreeBySlots_Handler,
+		},
+		{
+			MethodName: "ChainStartStatus",
+			Handler:    _BeaconService_ChainStartStatus_Handler,
+		},
+		{
+			MethodName: "ForkChoiceAudit",
+			Handler:    _BeaconService_ForkChoiceAudit_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WaitForChainStart",
+			Handler:       _BeaconService_WaitForChainStart_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamFinalizedChain",
+			Handler:       _BeaconService_StreamFinalizedChain_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
 }
 
-func (m *TreeBlockSlotRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+// AttesterServiceClient is the client API for AttesterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AttesterServiceClient interface {
+	RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error)
+	SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error)
+}
+
+type attesterServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAttesterServiceClient(cc *grpc.ClientConn) AttesterServiceClient {
+	return &attesterServiceClient{cc}
+}
+
+func (c *attesterServiceClient) RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error) {
+	out := new(v1alpha1.AttestationData)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TreeBlockSlotRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.SlotFrom != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.SlotFrom))
-	}
-	if m.SlotTo != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.SlotTo))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *attesterServiceClient) SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error) {
+	out := new(AttestResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func encodeVarintServices(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *BlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
-	}
-	l = len(m.RandaoReveal)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+// AttesterServiceServer is the server API for AttesterService service.
+type AttesterServiceServer interface {
+	RequestAttestation(context.Context, *AttestationRequest) (*v1alpha1.AttestationData, error)
+	SubmitAttestation(context.Context, *v1alpha1.Attestation) (*AttestResponse, error)
 }
 
-func (m *ProposeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BlockRoot)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func RegisterAttesterServiceServer(s *grpc.Server, srv AttesterServiceServer) {
+	s.RegisterService(&_AttesterService_serviceDesc, srv)
 }
 
-func (m *AttestationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	l = len(m.PocBit)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+func _AttesterService_RequestAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
+	if interceptor == nil {
+		return srv.(AttesterServiceServer).RequestAttestation(ctx, in)
 	}
-	if m.Shard != 0 {
-		n += 1 + sovServices(uint64(m.Shard))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttesterServiceServer).RequestAttestation(ctx, req.(*AttestationRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AttestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _AttesterService_SubmitAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.Attestation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Root)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	if interceptor == nil {
+		return srv.(AttesterServiceServer).SubmitAttestation(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttesterServiceServer).SubmitAttestation(ctx, req.(*v1alpha1.Attestation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValidatorPerformanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
-	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+var _AttesterService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.AttesterService",
+	HandlerType: (*AttesterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestAttestation",
+			Handler:    _AttesterService_RequestAttestation_Handler,
+		},
+		{
+			MethodName: "SubmitAttestation",
+			Handler:    _AttesterService_SubmitAttestation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
 }
 
-func (m *ValidatorPerformanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Balance != 0 {
-		n += 1 + sovServices(uint64(m.Balance))
-	}
-	if m.TotalValidators != 0 {
-		n += 1 + sovServices(uint64(m.TotalValidators))
-	}
-	if m.TotalActiveValidators != 0 {
-		n += 1 + sovServices(uint64(m.TotalActiveValidators))
-	}
-	if m.AverageActiveValidatorBalance != 0 {
-		n += 5
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+// ProposerServiceClient is the client API for ProposerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProposerServiceClient interface {
+	RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
+	ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error)
+	Eth1DataVotes(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Eth1DataVotesResponse, error)
 }
 
-func (m *ValidatorActivationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type proposerServiceClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *ValidatorActivationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ActivatedPublicKeys) > 0 {
-		for _, b := range m.ActivatedPublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
-	}
-	if len(m.Statuses) > 0 {
-		for _, e := range m.Statuses {
-			l = e.Size()
-			n += 1 + l + sovServices(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func NewProposerServiceClient(cc *grpc.ClientConn) ProposerServiceClient {
+	return &proposerServiceClient{cc}
 }
 
-func (m *ValidatorActivationResponse_Status) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (c *proposerServiceClient) RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
+	out := new(v1alpha1.BeaconBlock)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ExitedValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (c *proposerServiceClient) ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error) {
+	out := new(ProposeResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ExitedValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (c *proposerServiceClient) Eth1DataVotes(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Eth1DataVotesResponse, error) {
+	out := new(Eth1DataVotesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/Eth1DataVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ChainStartResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Started {
-		n += 2
-	}
-	if m.GenesisTime != 0 {
-		n += 1 + sovServices(uint64(m.GenesisTime))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+// ProposerServiceServer is the server API for ProposerService service.
+type ProposerServiceServer interface {
+	RequestBlock(context.Context, *BlockRequest) (*v1alpha1.BeaconBlock, error)
+	ProposeBlock(context.Context, *v1alpha1.BeaconBlock) (*ProposeResponse, error)
+	Eth1DataVotes(context.Context, *types.Empty) (*Eth1DataVotesResponse, error)
 }
 
-func (m *ValidatorIndexRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func RegisterProposerServiceServer(s *grpc.Server, srv ProposerServiceServer) {
+	s.RegisterService(&_ProposerService_serviceDesc, srv)
+}
+
+func _ProposerService_RequestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	if interceptor == nil {
+		return srv.(ProposerServiceServer).RequestBlock(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposerServiceServer).RequestBlock(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValidatorIndexResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _ProposerService_ProposeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.BeaconBlock)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Index != 0 {
-		n += 1 + sovServices(uint64(m.Index))
+	if interceptor == nil {
+		return srv.(ProposerServiceServer).ProposeBlock(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposerServiceServer).ProposeBlock(ctx, req.(*v1alpha1.BeaconBlock))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AssignmentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _ProposerService_Eth1DataVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.EpochStart != 0 {
-		n += 1 + sovServices(uint64(m.EpochStart))
+	if interceptor == nil {
+		return srv.(ProposerServiceServer).Eth1DataVotes(ctx, in)
 	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/Eth1DataVotes",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposerServiceServer).Eth1DataVotes(ctx, req.(*types.Empty))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AssignmentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ValidatorAssignment) > 0 {
-		for _, e := range m.ValidatorAssignment {
-			l = e.Size()
-			n += 1 + l + sovServices(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var _ProposerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ProposerService",
+	HandlerType: (*ProposerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestBlock",
+			Handler:    _ProposerService_RequestBlock_Handler,
+		},
+		{
+			MethodName: "ProposeBlock",
+			Handler:    _ProposerService_ProposeBlock_Handler,
+		},
+		{
+			MethodName: "Eth1DataVotes",
+			Handler:    _ProposerService_Eth1DataVotes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// ValidatorServiceClient is the client API for ValidatorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ValidatorServiceClient interface {
+	DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error)
+	WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error)
+	ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error)
+	CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error)
+	ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error)
+	ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error)
+	ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error)
+	ValidatorQueue(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorQueueResponse, error)
+	CommitteeAssignmentProof(ctx context.Context, in *AssignmentProofRequest, opts ...grpc.CallOption) (*AssignmentProofResponse, error)
+	StreamDuties(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (ValidatorService_StreamDutiesClient, error)
+}
+
+type validatorServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewValidatorServiceClient(cc *grpc.ClientConn) ValidatorServiceClient {
+	return &validatorServiceClient{cc}
+}
+
+func (c *validatorServiceClient) DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error) {
+	out := new(DomainResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/DomainData", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *validatorServiceClient) WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ValidatorService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.ValidatorService/WaitForActivation", opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Committee) > 0 {
-		l = 0
-		for _, e := range m.Committee {
-			l += sovServices(uint64(e))
-		}
-		n += 1 + sovServices(uint64(l)) + l
+	x := &validatorServiceWaitForActivationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.Shard != 0 {
-		n += 1 + sovServices(uint64(m.Shard))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
+	return x, nil
+}
+
+type ValidatorService_WaitForActivationClient interface {
+	Recv() (*ValidatorActivationResponse, error)
+	grpc.ClientStream
+}
+
+type validatorServiceWaitForActivationClient struct {
+	grpc.ClientStream
+}
+
+func (x *validatorServiceWaitForActivationClient) Recv() (*ValidatorActivationResponse, error) {
+	m := new(ValidatorActivationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.IsProposer {
-		n += 2
+	return m, nil
+}
+
+func (c *validatorServiceClient) ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error) {
+	out := new(ValidatorIndexResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	return out, nil
+}
+
+func (c *validatorServiceClient) CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error) {
+	out := new(AssignmentResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Status != 0 {
-		n += 1 + sovServices(uint64(m.Status))
+	return out, nil
+}
+
+func (c *validatorServiceClient) ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error) {
+	out := new(ValidatorStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *validatorServiceClient) ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error) {
+	out := new(ValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ValidatorStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *validatorServiceClient) ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error) {
+	out := new(ExitedValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovServices(uint64(m.Status))
+	return out, nil
+}
+
+func (c *validatorServiceClient) ValidatorQueue(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorQueueResponse, error) {
+	out := new(ValidatorQueueResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Eth1DepositBlockNumber != 0 {
-		n += 1 + sovServices(uint64(m.Eth1DepositBlockNumber))
+	return out, nil
+}
+
+func (c *validatorServiceClient) CommitteeAssignmentProof(ctx context.Context, in *AssignmentProofRequest, opts ...grpc.CallOption) (*AssignmentProofResponse, error) {
+	out := new(AssignmentProofResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignmentProof", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.DepositInclusionSlot != 0 {
-		n += 1 + sovServices(uint64(m.DepositInclusionSlot))
+	return out, nil
+}
+
+func (c *validatorServiceClient) StreamDuties(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (ValidatorService_StreamDutiesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ValidatorService_serviceDesc.Streams[1], "/ethereum.beacon.rpc.v1.ValidatorService/StreamDuties", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ActivationEpoch != 0 {
-		n += 1 + sovServices(uint64(m.ActivationEpoch))
+	x := &validatorServiceStreamDutiesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.PositionInActivationQueue != 0 {
-		n += 1 + sovServices(uint64(m.PositionInActivationQueue))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return x, nil
+}
+
+type ValidatorService_StreamDutiesClient interface {
+	Recv() (*AssignmentResponse, error)
+	grpc.ClientStream
+}
+
+type validatorServiceStreamDutiesClient struct {
+	grpc.ClientStream
+}
+
+func (x *validatorServiceStreamDutiesClient) Recv() (*AssignmentResponse, error) {
+	m := new(AssignmentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return n
+	return m, nil
 }
 
-func (m *DomainRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// ValidatorServiceServer is the server API for ValidatorService service.
+type ValidatorServiceServer interface {
+	DomainData(context.Context, *DomainRequest) (*DomainResponse, error)
+	WaitForActivation(*ValidatorActivationRequest, ValidatorService_WaitForActivationServer) error
+	ValidatorIndex(context.Context, *ValidatorIndexRequest) (*ValidatorIndexResponse, error)
+	CommitteeAssignment(context.Context, *AssignmentRequest) (*AssignmentResponse, error)
+	ValidatorStatus(context.Context, *ValidatorIndexRequest) (*ValidatorStatusResponse, error)
+	ValidatorPerformance(context.Context, *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error)
+	ExitedValidators(context.Context, *ExitedValidatorsRequest) (*ExitedValidatorsResponse, error)
+	ValidatorQueue(context.Context, *ValidatorIndexRequest) (*ValidatorQueueResponse, error)
+	CommitteeAssignmentProof(context.Context, *AssignmentProofRequest) (*AssignmentProofResponse, error)
+	StreamDuties(*AssignmentRequest, ValidatorService_StreamDutiesServer) error
+}
+
+func RegisterValidatorServiceServer(s *grpc.Server, srv ValidatorServiceServer) {
+	s.RegisterService(&_ValidatorService_serviceDesc, srv)
+}
+
+func _ValidatorService_DomainData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovServices(uint64(m.Epoch))
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).DomainData(ctx, in)
 	}
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/DomainData",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).DomainData(ctx, req.(*DomainRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignatureDomain != 0 {
-		n += 1 + sovServices(uint64(m.SignatureDomain))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func _ValidatorService_WaitForActivation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ValidatorActivationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return n
+	return srv.(ValidatorServiceServer).WaitForActivation(m, &validatorServiceWaitForActivationServer{stream})
 }
 
-func (m *BlockTreeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Tree) > 0 {
-		for _, e := range m.Tree {
-			l = e.Size()
-			n += 1 + l + sovServices(uint64(l))
-		}
+type ValidatorService_WaitForActivationServer interface {
+	Send(*ValidatorActivationResponse) error
+	grpc.ServerStream
+}
+
+type validatorServiceWaitForActivationServer struct {
+	grpc.ServerStream
+}
+
+func (x *validatorServiceWaitForActivationServer) Send(m *ValidatorActivationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ValidatorService_ValidatorIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, req.(*ValidatorIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BlockTreeResponse_TreeNode) Size() (n int) {
-	if m == nil {
-		return 0
+func _ValidatorService_CommitteeAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovServices(uint64(l))
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, in)
 	}
-	l = len(m.BlockRoot)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment",
 	}
-	if m.ParticipatedVotes != 0 {
-		n += 1 + sovServices(uint64(m.ParticipatedVotes))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, req.(*AssignmentRequest))
 	}
-	if m.TotalVotes != 0 {
-		n += 1 + sovServices(uint64(m.TotalVotes))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_ValidatorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, req.(*ValidatorIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TreeBlockSlotRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _ValidatorService_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, req.(*ValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_ExitedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExitedValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ExitedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ExitedValidators(ctx, req.(*ExitedValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_ValidatorQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorQueue(ctx, req.(*ValidatorIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_CommitteeAssignmentProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).CommitteeAssignmentProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignmentProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).CommitteeAssignmentProof(ctx, req.(*AssignmentProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_StreamDuties_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AssignmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ValidatorServiceServer).StreamDuties(m, &validatorServiceStreamDutiesServer{stream})
+}
+
+type ValidatorService_StreamDutiesServer interface {
+	Send(*AssignmentResponse) error
+	grpc.ServerStream
+}
+
+type validatorServiceStreamDutiesServer struct {
+	grpc.ServerStream
+}
+
+func (x *validatorServiceStreamDutiesServer) Send(m *AssignmentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _ValidatorService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ValidatorService",
+	HandlerType: (*ValidatorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DomainData",
+			Handler:    _ValidatorService_DomainData_Handler,
+		},
+		{
+			MethodName: "ValidatorIndex",
+			Handler:    _ValidatorService_ValidatorIndex_Handler,
+		},
+		{
+			MethodName: "CommitteeAssignment",
+			Handler:    _ValidatorService_CommitteeAssignment_Handler,
+		},
+		{
+			MethodName: "ValidatorStatus",
+			Handler:    _ValidatorService_ValidatorStatus_Handler,
+		},
+		{
+			MethodName: "ValidatorPerformance",
+			Handler:    _ValidatorService_ValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "ExitedValidators",
+			Handler:    _ValidatorService_ExitedValidators_Handler,
+		},
+		{
+			MethodName: "ValidatorQueue",
+			Handler:    _ValidatorService_ValidatorQueue_Handler,
+		},
+		{
+			MethodName: "CommitteeAssignmentProof",
+			Handler:    _ValidatorService_CommitteeAssignmentProof_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WaitForActivation",
+			Handler:       _ValidatorService_WaitForActivation_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamDuties",
+			Handler:       _ValidatorService_StreamDuties_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.SlotFrom != 0 {
-		n += 1 + sovServices(uint64(m.SlotFrom))
+	if m.Slot != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
 	}
-	if m.SlotTo != 0 {
-		n += 1 + sovServices(uint64(m.SlotTo))
+	if len(m.RandaoReveal) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.RandaoReveal)))
+		i += copy(dAtA[i:], m.RandaoReveal)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ProposeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockRoot) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
+		i += copy(dAtA[i:], m.BlockRoot)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
+	}
+	if len(m.PocBit) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PocBit)))
+		i += copy(dAtA[i:], m.PocBit)
+	}
+	if m.Slot != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+	}
+	if m.Shard != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Shard))
+	}
+	if m.CommitteeIndex != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
+	}
+	if m.CommitteeLength != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeLength))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AttestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Root) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Root)))
+		i += copy(dAtA[i:], m.Root)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+	}
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Balance != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Balance))
+	}
+	if m.TotalValidators != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.TotalValidators))
+	}
+	if m.TotalActiveValidators != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.TotalActiveValidators))
+	}
+	if m.AverageActiveValidatorBalance != 0 {
+		dAtA[i] = 0x25
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.AverageActiveValidatorBalance))))
+		i += 4
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorActivationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorActivationRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorActivationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorActivationResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ActivatedPublicKeys) > 0 {
+		for _, b := range m.ActivatedPublicKeys {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.Statuses) > 0 {
+		for _, msg := range m.Statuses {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorActivationResponse_Status) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorActivationResponse_Status) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
+	}
+	if m.Status != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Status.Size()))
+		n1, err := m.Status.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ExitedValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitedValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ExitedValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitedValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ChainStartResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainStartResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Started {
+		dAtA[i] = 0x8
+		i++
+		if m.Started {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.GenesisTime != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.GenesisTime))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AssignmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.EpochStart != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.EpochStart))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AssignmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAssignment) > 0 {
+		for _, msg := range m.ValidatorAssignment {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Epoch != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AssignmentResponse_ValidatorAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentResponse_ValidatorAssignment) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Committee) > 0 {
+		dAtA3 := make([]byte, len(m.Committee)*10)
+		var j2 int
+		for _, num := range m.Committee {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(j2))
+		i += copy(dAtA[i:], dAtA3[:j2])
+	}
+	if m.Shard != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Shard))
+	}
+	if m.Slot != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+	}
+	if m.IsProposer {
+		dAtA[i] = 0x20
+		i++
+		if m.IsProposer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Status))
+	}
+	if m.CommitteeIndex != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
+	}
+	if len(m.NextEpochProposerSlots) > 0 {
+		dAtA7 := make([]byte, len(m.NextEpochProposerSlots)*10)
+		var j6 int
+		for _, num := range m.NextEpochProposerSlots {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(j6))
+		i += copy(dAtA[i:], dAtA7[:j6])
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Status))
+	}
+	if m.Eth1DepositBlockNumber != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Eth1DepositBlockNumber))
+	}
+	if m.DepositInclusionSlot != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.DepositInclusionSlot))
+	}
+	if m.ActivationEpoch != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.ActivationEpoch))
+	}
+	if m.PositionInActivationQueue != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.PositionInActivationQueue))
+	}
+	if m.ActivationEligibilityEpoch != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.ActivationEligibilityEpoch))
+	}
+	if m.ExitEpoch != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.ExitEpoch))
+	}
+	if m.WithdrawableEpoch != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.WithdrawableEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DomainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
+	}
+	if len(m.Domain) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Domain)))
+		i += copy(dAtA[i:], m.Domain)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SignatureDomain != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.SignatureDomain))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *BlockTreeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockTreeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tree) > 0 {
+		for _, msg := range m.Tree {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *BlockTreeResponse_TreeNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockTreeResponse_TreeNode) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Block != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Block.Size()))
+		n4, err := m.Block.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.BlockRoot) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
+		i += copy(dAtA[i:], m.BlockRoot)
+	}
+	if m.ParticipatedVotes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.ParticipatedVotes))
+	}
+	if m.TotalVotes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.TotalVotes))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TreeBlockSlotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreeBlockSlotRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SlotFrom != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.SlotFrom))
+	}
+	if m.SlotTo != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.SlotTo))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ChurnLimit != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.ChurnLimit))
+	}
+	if m.ActivationQueueLength != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.ActivationQueueLength))
+	}
+	if m.ExitQueueLength != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.ExitQueueLength))
+	}
+	if m.PositionInActivationQueue != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.PositionInActivationQueue))
+	}
+	if m.EpochsUntilActivation != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.EpochsUntilActivation))
+	}
+	if m.EpochsUntilExit != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.EpochsUntilExit))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AssignmentProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
+	}
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AssignmentProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
+	}
+	if m.ValidatorIndex != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.ValidatorIndex))
+	}
+	if m.ActiveValidatorCount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.ActiveValidatorCount))
+	}
+	if m.ActiveIndex != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.ActiveIndex))
+	}
+	if m.ShuffledIndex != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.ShuffledIndex))
+	}
+	if m.CommitteeCount != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeCount))
+	}
+	if m.StartShard != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.StartShard))
+	}
+	if m.CommitteeIndex != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
+	}
+	if m.Shard != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Shard))
+	}
+	if m.Slot != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+	}
+	if len(m.StateRoot) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.StateRoot)))
+		i += copy(dAtA[i:], m.StateRoot)
+	}
+	if len(m.Seed) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Seed)))
+		i += copy(dAtA[i:], m.Seed)
+	}
+	if len(m.RandaoMix) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.RandaoMix)))
+		i += copy(dAtA[i:], m.RandaoMix)
+	}
+	if len(m.ActiveIndexRoot) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.ActiveIndexRoot)))
+		i += copy(dAtA[i:], m.ActiveIndexRoot)
+	}
+	if len(m.Committee) > 0 {
+		dAtA5 := make([]byte, len(m.Committee)*10)
+		var j4 int
+		for _, num := range m.Committee {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(j4))
+		i += copy(dAtA[i:], dAtA5[:j4])
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ChainStartStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainStartStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Started {
+		dAtA[i] = 0x8
+		i++
+		if m.Started {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.GenesisTime != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.GenesisTime))
+	}
+	if m.DepositCount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.DepositCount))
+	}
+	if m.ActiveValidatorCount != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.ActiveValidatorCount))
+	}
+	if m.MinGenesisActiveValidatorCount != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.MinGenesisActiveValidatorCount))
+	}
+	if m.MinGenesisTime != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.MinGenesisTime))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Eth1DataVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Eth1DataVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+	}
+	if m.VotingPeriodStartSlot != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.VotingPeriodStartSlot))
+	}
+	if m.Eth1Data != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Eth1Data.Size()))
+		n5, err := m.Eth1Data.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.Eth1DepositIndex != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Eth1DepositIndex))
+	}
+	if m.VotesRequired != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.VotesRequired))
+	}
+	if len(m.Votes) > 0 {
+		for _, msg := range m.Votes {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.IntendedVote != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.IntendedVote.Size()))
+		n6, err := m.IntendedVote.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.Candidates) > 0 {
+		for _, msg := range m.Candidates {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Eth1DataVotesResponse_VoteTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Eth1DataVotesResponse_VoteTally) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Eth1Data != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Eth1Data.Size()))
+		n7, err := m.Eth1Data.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Eth1DataVotesResponse_Eth1Block) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Eth1DataVotesResponse_Eth1Block) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.BlockNumber != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.BlockNumber))
+	}
+	if len(m.BlockHash) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockHash)))
+		i += copy(dAtA[i:], m.BlockHash)
+	}
+	if m.DepositCount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.DepositCount))
+	}
+	if len(m.DepositRoot) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.DepositRoot)))
+		i += copy(dAtA[i:], m.DepositRoot)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *FinalizedChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizedChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.FromSlot != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.FromSlot))
+	}
+	if m.StateIntervalEpochs != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.StateIntervalEpochs))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *FinalizedChainUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizedChainUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Block != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Block.Size()))
+		n8, err := m.Block.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if len(m.State) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
+	}
+	if m.FinalizedSlot != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.FinalizedSlot))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ForkChoiceAuditRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkChoiceAuditRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ForkChoiceAuditResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkChoiceAuditResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Decisions) > 0 {
+		for _, msg := range m.Decisions {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ForkChoiceAuditResponse_Decision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkChoiceAuditResponse_Decision) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Timestamp))
+	}
+	if len(m.JustifiedRoot) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.JustifiedRoot)))
+		i += copy(dAtA[i:], m.JustifiedRoot)
+	}
+	if m.JustifiedSlot != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.JustifiedSlot))
+	}
+	if len(m.PreviousHead) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PreviousHead)))
+		i += copy(dAtA[i:], m.PreviousHead)
+	}
+	if m.PreviousSlot != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.PreviousSlot))
+	}
+	if len(m.NewHead) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.NewHead)))
+		i += copy(dAtA[i:], m.NewHead)
+	}
+	if m.NewSlot != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.NewSlot))
+	}
+	if m.Reorg {
+		dAtA[i] = 0x40
+		i++
+		if m.Reorg {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Steps) > 0 {
+		for _, msg := range m.Steps {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ForkChoiceAuditResponse_Step) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkChoiceAuditResponse_Step) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parent) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Parent)))
+		i += copy(dAtA[i:], m.Parent)
+	}
+	if len(m.Candidates) > 0 {
+		for _, msg := range m.Candidates {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Chosen) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Chosen)))
+		i += copy(dAtA[i:], m.Chosen)
+	}
+	if m.TieBreak {
+		dAtA[i] = 0x20
+		i++
+		if m.TieBreak {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ForkChoiceAuditResponse_Candidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkChoiceAuditResponse_Candidate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Root) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Root)))
+		i += copy(dAtA[i:], m.Root)
+	}
+	if m.Slot != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+	}
+	if m.Weight != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Weight))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintServices(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *BlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	l = len(m.RandaoReveal)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.PocBit)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.Shard != 0 {
+		n += 1 + sovServices(uint64(m.Shard))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovServices(uint64(m.CommitteeIndex))
+	}
+	if m.CommitteeLength != 0 {
+		n += 1 + sovServices(uint64(m.CommitteeLength))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Balance != 0 {
+		n += 1 + sovServices(uint64(m.Balance))
+	}
+	if m.TotalValidators != 0 {
+		n += 1 + sovServices(uint64(m.TotalValidators))
+	}
+	if m.TotalActiveValidators != 0 {
+		n += 1 + sovServices(uint64(m.TotalActiveValidators))
+	}
+	if m.AverageActiveValidatorBalance != 0 {
+		n += 5
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorActivationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorActivationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActivatedPublicKeys) > 0 {
+		for _, b := range m.ActivatedPublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorActivationResponse_Status) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExitedValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExitedValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChainStartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Started {
+		n += 2
+	}
+	if m.GenesisTime != 0 {
+		n += 1 + sovServices(uint64(m.GenesisTime))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovServices(uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochStart != 0 {
+		n += 1 + sovServices(uint64(m.EpochStart))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorAssignment) > 0 {
+		for _, e := range m.ValidatorAssignment {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovServices(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentResponse_ValidatorAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Committee) > 0 {
+		l = 0
+		for _, e := range m.Committee {
+			l += sovServices(uint64(e))
+		}
+		n += 1 + sovServices(uint64(l)) + l
+	}
+	if m.Shard != 0 {
+		n += 1 + sovServices(uint64(m.Shard))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.IsProposer {
+		n += 2
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovServices(uint64(m.Status))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovServices(uint64(m.CommitteeIndex))
+	}
+	if len(m.NextEpochProposerSlots) > 0 {
+		l = 0
+		for _, e := range m.NextEpochProposerSlots {
+			l += sovServices(uint64(e))
+		}
+		n += 1 + sovServices(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovServices(uint64(m.Status))
+	}
+	if m.Eth1DepositBlockNumber != 0 {
+		n += 1 + sovServices(uint64(m.Eth1DepositBlockNumber))
+	}
+	if m.DepositInclusionSlot != 0 {
+		n += 1 + sovServices(uint64(m.DepositInclusionSlot))
+	}
+	if m.ActivationEpoch != 0 {
+		n += 1 + sovServices(uint64(m.ActivationEpoch))
+	}
+	if m.PositionInActivationQueue != 0 {
+		n += 1 + sovServices(uint64(m.PositionInActivationQueue))
+	}
+	if m.ActivationEligibilityEpoch != 0 {
+		n += 1 + sovServices(uint64(m.ActivationEligibilityEpoch))
+	}
+	if m.ExitEpoch != 0 {
+		n += 1 + sovServices(uint64(m.ExitEpoch))
+	}
+	if m.WithdrawableEpoch != 0 {
+		n += 1 + sovServices(uint64(m.WithdrawableEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DomainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovServices(uint64(m.Epoch))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignatureDomain != 0 {
+		n += 1 + sovServices(uint64(m.SignatureDomain))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockTreeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tree) > 0 {
+		for _, e := range m.Tree {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockTreeResponse_TreeNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.ParticipatedVotes != 0 {
+		n += 1 + sovServices(uint64(m.ParticipatedVotes))
+	}
+	if m.TotalVotes != 0 {
+		n += 1 + sovServices(uint64(m.TotalVotes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TreeBlockSlotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlotFrom != 0 {
+		n += 1 + sovServices(uint64(m.SlotFrom))
+	}
+	if m.SlotTo != 0 {
+		n += 1 + sovServices(uint64(m.SlotTo))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChurnLimit != 0 {
+		n += 1 + sovServices(uint64(m.ChurnLimit))
+	}
+	if m.ActivationQueueLength != 0 {
+		n += 1 + sovServices(uint64(m.ActivationQueueLength))
+	}
+	if m.ExitQueueLength != 0 {
+		n += 1 + sovServices(uint64(m.ExitQueueLength))
+	}
+	if m.PositionInActivationQueue != 0 {
+		n += 1 + sovServices(uint64(m.PositionInActivationQueue))
+	}
+	if m.EpochsUntilActivation != 0 {
+		n += 1 + sovServices(uint64(m.EpochsUntilActivation))
+	}
+	if m.EpochsUntilExit != 0 {
+		n += 1 + sovServices(uint64(m.EpochsUntilExit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovServices(uint64(m.Epoch))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovServices(uint64(m.Epoch))
+	}
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovServices(uint64(m.ValidatorIndex))
+	}
+	if m.ActiveValidatorCount != 0 {
+		n += 1 + sovServices(uint64(m.ActiveValidatorCount))
+	}
+	if m.ActiveIndex != 0 {
+		n += 1 + sovServices(uint64(m.ActiveIndex))
+	}
+	if m.ShuffledIndex != 0 {
+		n += 1 + sovServices(uint64(m.ShuffledIndex))
+	}
+	if m.CommitteeCount != 0 {
+		n += 1 + sovServices(uint64(m.CommitteeCount))
+	}
+	if m.StartShard != 0 {
+		n += 1 + sovServices(uint64(m.StartShard))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovServices(uint64(m.CommitteeIndex))
+	}
+	if m.Shard != 0 {
+		n += 1 + sovServices(uint64(m.Shard))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.RandaoMix)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.ActiveIndexRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if len(m.Committee) > 0 {
+		l = 0
+		for _, e := range m.Committee {
+			l += sovServices(uint64(e))
+		}
+		n += 1 + sovServices(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChainStartStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Started {
+		n += 2
+	}
+	if m.GenesisTime != 0 {
+		n += 1 + sovServices(uint64(m.GenesisTime))
+	}
+	if m.DepositCount != 0 {
+		n += 1 + sovServices(uint64(m.DepositCount))
+	}
+	if m.ActiveValidatorCount != 0 {
+		n += 1 + sovServices(uint64(m.ActiveValidatorCount))
+	}
+	if m.MinGenesisActiveValidatorCount != 0 {
+		n += 1 + sovServices(uint64(m.MinGenesisActiveValidatorCount))
+	}
+	if m.MinGenesisTime != 0 {
+		n += 1 + sovServices(uint64(m.MinGenesisTime))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Eth1DataVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.VotingPeriodStartSlot != 0 {
+		n += 1 + sovServices(uint64(m.VotingPeriodStartSlot))
+	}
+	if m.Eth1Data != nil {
+		l = m.Eth1Data.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Eth1DepositIndex != 0 {
+		n += 1 + sovServices(uint64(m.Eth1DepositIndex))
+	}
+	if m.VotesRequired != 0 {
+		n += 1 + sovServices(uint64(m.VotesRequired))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.IntendedVote != nil {
+		l = m.IntendedVote.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if len(m.Candidates) > 0 {
+		for _, e := range m.Candidates {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Eth1DataVotesResponse_VoteTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Eth1Data != nil {
+		l = m.Eth1Data.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovServices(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Eth1DataVotesResponse_Eth1Block) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockNumber != 0 {
+		n += 1 + sovServices(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.DepositCount != 0 {
+		n += 1 + sovServices(uint64(m.DepositCount))
+	}
+	l = len(m.DepositRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FinalizedChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromSlot != 0 {
+		n += 1 + sovServices(uint64(m.FromSlot))
+	}
+	if m.StateIntervalEpochs != 0 {
+		n += 1 + sovServices(uint64(m.StateIntervalEpochs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FinalizedChainUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.FinalizedSlot != 0 {
+		n += 1 + sovServices(uint64(m.FinalizedSlot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForkChoiceAuditRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovServices(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForkChoiceAuditResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Decisions) > 0 {
+		for _, e := range m.Decisions {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForkChoiceAuditResponse_Decision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovServices(uint64(m.Timestamp))
+	}
+	l = len(m.JustifiedRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.JustifiedSlot != 0 {
+		n += 1 + sovServices(uint64(m.JustifiedSlot))
+	}
+	l = len(m.PreviousHead)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.PreviousSlot != 0 {
+		n += 1 + sovServices(uint64(m.PreviousSlot))
+	}
+	l = len(m.NewHead)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.NewSlot != 0 {
+		n += 1 + sovServices(uint64(m.NewSlot))
+	}
+	if m.Reorg {
+		n += 2
+	}
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForkChoiceAuditResponse_Step) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Parent)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if len(m.Candidates) > 0 {
+		for _, e := range m.Candidates {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	l = len(m.Chosen)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.TieBreak {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForkChoiceAuditResponse_Candidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovServices(uint64(m.Weight))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovServices(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozServices(x uint64) (n int) {
+	return sovServices(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandaoReveal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandaoReveal = append(m.RandaoReveal[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandaoReveal == nil {
+				m.RandaoReveal = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PocBit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PocBit = append(m.PocBit[:0], dAtA[iNdEx:postIndex]...)
+			if m.PocBit == nil {
+				m.PocBit = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeLength", wireType)
+			}
+			m.CommitteeLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidators", wireType)
+			}
+			m.TotalValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalActiveValidators", wireType)
+			}
+			m.TotalActiveValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalActiveValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageActiveValidatorBalance", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.AverageActiveValidatorBalance = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorActivationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorActivationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorActivationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorActivationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedPublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivatedPublicKeys = append(m.ActivatedPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ActivatedPublicKeys[len(m.ActivatedPublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statuses = append(m.Statuses, &ValidatorActivationResponse_Status{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &ValidatorStatusResponse{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExitedValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExitedValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExitedValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExitedValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExitedValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExitedValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainStartResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Started = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+			}
+			m.GenesisTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GenesisTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssignmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssignmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStart", wireType)
+			}
+			m.EpochStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssignmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssignmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAssignment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAssignment = append(m.ValidatorAssignment, &AssignmentResponse_ValidatorAssignment{})
+			if err := m.ValidatorAssignment[len(m.ValidatorAssignment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAssignment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Committee = append(m.Committee, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthServices
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthServices
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Committee) == 0 {
+					m.Committee = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServices
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Committee = append(m.Committee, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committee", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsProposer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsProposer = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ValidatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NextEpochProposerSlots = append(m.NextEpochProposerSlots, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthServices
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthServices
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.NextEpochProposerSlots) == 0 {
+					m.NextEpochProposerSlots = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServices
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NextEpochProposerSlots = append(m.NextEpochProposerSlots, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochProposerSlots", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ValidatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1DepositBlockNumber", wireType)
+			}
+			m.Eth1DepositBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eth1DepositBlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositInclusionSlot", wireType)
+			}
+			m.DepositInclusionSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositInclusionSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEpoch", wireType)
+			}
+			m.ActivationEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionInActivationQueue", wireType)
+			}
+			m.PositionInActivationQueue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionInActivationQueue |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEligibilityEpoch", wireType)
+			}
+			m.ActivationEligibilityEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationEligibilityEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitEpoch", wireType)
+			}
+			m.ExitEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawableEpoch", wireType)
+			}
+			m.WithdrawableEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawableEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DomainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = append(m.Domain[:0], dAtA[iNdEx:postIndex]...)
+			if m.Domain == nil {
+				m.Domain = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovServices(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozServices(x uint64) (n int) {
-	return sovServices(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BlockRequest) Unmarshal(dAtA []byte) error {
+func (m *DomainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3540,36 +8591,17 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DomainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
-			}
-			m.Slot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RandaoReveal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureDomain", wireType)
 			}
-			var byteLen int
+			m.SignatureDomain = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -3579,26 +8611,11 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.SignatureDomain |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RandaoReveal = append(m.RandaoReveal[:0], dAtA[iNdEx:postIndex]...)
-			if m.RandaoReveal == nil {
-				m.RandaoReveal = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -3624,7 +8641,7 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
+func (m *BlockTreeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3647,17 +8664,17 @@ func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProposeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockTreeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -3667,24 +8684,24 @@ func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockRoot == nil {
-				m.BlockRoot = []byte{}
+			m.Tree = append(m.Tree, &BlockTreeResponse_TreeNode{})
+			if err := m.Tree[len(m.Tree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3712,7 +8729,7 @@ func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
+func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3735,17 +8752,17 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttestationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreeNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreeNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -3755,29 +8772,31 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
+			if m.Block == nil {
+				m.Block = &v1alpha1.BeaconBlock{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PocBit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3804,16 +8823,16 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PocBit = append(m.PocBit[:0], dAtA[iNdEx:postIndex]...)
-			if m.PocBit == nil {
-				m.PocBit = []byte{}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipatedVotes", wireType)
 			}
-			m.Slot = 0
+			m.ParticipatedVotes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -3823,89 +8842,16 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				m.ParticipatedVotes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
-			}
-			m.Shard = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Shard |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AttestResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AttestResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotes", wireType)
 			}
-			var byteLen int
+			m.TotalVotes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -3915,26 +8861,11 @@ func (m *AttestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.TotalVotes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
-			if m.Root == nil {
-				m.Root = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -3960,7 +8891,7 @@ func (m *AttestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
+func (m *TreeBlockSlotRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3983,17 +8914,17 @@ func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorPerformanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreeBlockSlotRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreeBlockSlotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotFrom", wireType)
 			}
-			m.Slot = 0
+			m.SlotFrom = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4003,16 +8934,16 @@ func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				m.SlotFrom |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotTo", wireType)
 			}
-			var byteLen int
+			m.SlotTo = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4022,26 +8953,11 @@ func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.SlotTo |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -4067,7 +8983,7 @@ func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorQueueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4090,17 +9006,17 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorPerformanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorQueueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChurnLimit", wireType)
 			}
-			m.Balance = 0
+			m.ChurnLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4110,16 +9026,16 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Balance |= uint64(b&0x7F) << shift
+				m.ChurnLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationQueueLength", wireType)
 			}
-			m.TotalValidators = 0
+			m.ActivationQueueLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4129,16 +9045,16 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalValidators |= uint64(b&0x7F) << shift
+				m.ActivationQueueLength |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalActiveValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitQueueLength", wireType)
 			}
-			m.TotalActiveValidators = 0
+			m.ExitQueueLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4148,22 +9064,68 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalActiveValidators |= uint64(b&0x7F) << shift
+				m.ExitQueueLength |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageActiveValidatorBalance", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionInActivationQueue", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			m.PositionInActivationQueue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionInActivationQueue |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsUntilActivation", wireType)
+			}
+			m.EpochsUntilActivation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsUntilActivation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsUntilExit", wireType)
+			}
+			m.EpochsUntilExit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsUntilExit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.AverageActiveValidatorBalance = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -4189,7 +9151,7 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
+func (m *AssignmentProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4212,15 +9174,34 @@ func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorActivationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignmentProofRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorActivationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignmentProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4247,8 +9228,10 @@ func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4275,7 +9258,7 @@ func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
+func (m *AssignmentProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4298,17 +9281,17 @@ func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorActivationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignmentProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorActivationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignmentProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedPublicKeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var byteLen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4318,29 +9301,54 @@ func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveValidatorCount", wireType)
 			}
-			m.ActivatedPublicKeys = append(m.ActivatedPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.ActivatedPublicKeys[len(m.ActivatedPublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			m.ActiveValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveValidatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveIndex", wireType)
+			}
+			m.ActiveIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4350,83 +9358,128 @@ func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ActiveIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthServices
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShuffledIndex", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
+			m.ShuffledIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShuffledIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeCount", wireType)
 			}
-			m.Statuses = append(m.Statuses, &ValidatorActivationResponse_Status{})
-			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CommitteeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartShard", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthServices
+			m.StartShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartShard |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthServices
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Status: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4453,16 +9506,16 @@ func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4472,85 +9525,29 @@ func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &ValidatorStatusResponse{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seed == nil {
+				m.Seed = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExitedValidatorsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExitedValidatorsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExitedValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RandaoMix", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4577,66 +9574,14 @@ func (m *ExitedValidatorsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExitedValidatorsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RandaoMix = append(m.RandaoMix[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandaoMix == nil {
+				m.RandaoMix = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExitedValidatorsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExitedValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveIndexRoot", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4653,19 +9598,97 @@ func (m *ExitedValidatorsResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveIndexRoot = append(m.ActiveIndexRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ActiveIndexRoot == nil {
+				m.ActiveIndexRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Committee = append(m.Committee, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthServices
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthServices
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Committee) == 0 {
+					m.Committee = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServices
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Committee = append(m.Committee, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committee", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -4691,7 +9714,7 @@ func (m *ExitedValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
+func (m *ChainStartStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4714,10 +9737,10 @@ func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainStartResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainStartStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainStartStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4759,6 +9782,82 @@ func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCount", wireType)
+			}
+			m.DepositCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveValidatorCount", wireType)
+			}
+			m.ActiveValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveValidatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGenesisActiveValidatorCount", wireType)
+			}
+			m.MinGenesisActiveValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinGenesisActiveValidatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGenesisTime", wireType)
+			}
+			m.MinGenesisTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinGenesisTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -4784,7 +9883,7 @@ func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
+func (m *Eth1DataVotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4807,17 +9906,55 @@ func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorIndexRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Eth1DataVotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Eth1DataVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodStartSlot", wireType)
+			}
+			m.VotingPeriodStartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPeriodStartSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1Data", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4827,24 +9964,168 @@ func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Eth1Data == nil {
+				m.Eth1Data = &v1alpha1.Eth1Data{}
+			}
+			if err := m.Eth1Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1DepositIndex", wireType)
+			}
+			m.Eth1DepositIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eth1DepositIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotesRequired", wireType)
+			}
+			m.VotesRequired = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotesRequired |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, &Eth1DataVotesResponse_VoteTally{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntendedVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IntendedVote == nil {
+				m.IntendedVote = &v1alpha1.Eth1Data{}
+			}
+			if err := m.IntendedVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
+			m.Candidates = append(m.Candidates, &Eth1DataVotesResponse_Eth1Block{})
+			if err := m.Candidates[len(m.Candidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4872,7 +10153,7 @@ func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
+func (m *Eth1DataVotesResponse_VoteTally) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4895,17 +10176,53 @@ func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorIndexResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoteTally: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteTally: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Eth1Data == nil {
+				m.Eth1Data = &v1alpha1.Eth1Data{}
+			}
+			if err := m.Eth1Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.Index = 0
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4915,7 +10232,7 @@ func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4945,7 +10262,7 @@ func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
+func (m *Eth1DataVotesResponse_Eth1Block) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4968,17 +10285,17 @@ func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssignmentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Eth1Block: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssignmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Eth1Block: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochStart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
-			m.EpochStart = 0
+			m.BlockNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4988,14 +10305,14 @@ func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochStart |= uint64(b&0x7F) << shift
+				m.BlockNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5022,8 +10339,63 @@ func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCount", wireType)
+			}
+			m.DepositCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositRoot = append(m.DepositRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositRoot == nil {
+				m.DepositRoot = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5050,7 +10422,7 @@ func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
+func (m *FinalizedChainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5073,17 +10445,17 @@ func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssignmentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalizedChainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssignmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalizedChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAssignment", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSlot", wireType)
 			}
-			var msglen int
+			m.FromSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5093,26 +10465,30 @@ func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FromSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateIntervalEpochs", wireType)
 			}
-			m.ValidatorAssignment = append(m.ValidatorAssignment, &AssignmentResponse_ValidatorAssignment{})
-			if err := m.ValidatorAssignment[len(m.ValidatorAssignment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StateIntervalEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateIntervalEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -5138,7 +10514,7 @@ func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
+func (m *FinalizedChainUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5161,131 +10537,17 @@ func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorAssignment: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalizedChainUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalizedChainUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowServices
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Committee = append(m.Committee, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowServices
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthServices
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthServices
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Committee) == 0 {
-					m.Committee = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowServices
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Committee = append(m.Committee, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Committee", wireType)
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
-			}
-			m.Shard = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Shard |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
-			}
-			m.Slot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsProposer", wireType)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5295,15 +10557,31 @@ func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsProposer = bool(v != 0)
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &v1alpha1.BeaconBlock{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5330,16 +10608,16 @@ func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
+			m.State = append(m.State[:0], dAtA[iNdEx:postIndex]...)
+			if m.State == nil {
+				m.State = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedSlot", wireType)
 			}
-			m.Status = 0
+			m.FinalizedSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5349,7 +10627,7 @@ func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ValidatorStatus(b&0x7F) << shift
+				m.FinalizedSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5379,7 +10657,7 @@ func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *ForkChoiceAuditRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5402,17 +10680,17 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForkChoiceAuditRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForkChoiceAuditRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.Status = 0
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5422,16 +10700,70 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ValidatorStatus(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eth1DepositBlockNumber", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Eth1DepositBlockNumber = 0
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForkChoiceAuditResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkChoiceAuditResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkChoiceAuditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decisions", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5441,16 +10773,85 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Eth1DepositBlockNumber |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decisions = append(m.Decisions, &ForkChoiceAuditResponse_Decision{})
+			if err := m.Decisions[len(m.Decisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForkChoiceAuditResponse_Decision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Decision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Decision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositInclusionSlot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.DepositInclusionSlot = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5460,16 +10861,16 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DepositInclusionSlot |= uint64(b&0x7F) << shift
+				m.Timestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEpoch", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedRoot", wireType)
 			}
-			m.ActivationEpoch = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5479,16 +10880,31 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActivationEpoch |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JustifiedRoot = append(m.JustifiedRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.JustifiedRoot == nil {
+				m.JustifiedRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionInActivationQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedSlot", wireType)
 			}
-			m.PositionInActivationQueue = 0
+			m.JustifiedSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5498,70 +10914,50 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionInActivationQueue |= uint64(b&0x7F) << shift
+				m.JustifiedSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousHead", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthServices
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DomainRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DomainRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PreviousHead = append(m.PreviousHead[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousHead == nil {
+				m.PreviousHead = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSlot", wireType)
 			}
-			m.Epoch = 0
+			m.PreviousSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5571,14 +10967,14 @@ func (m *DomainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.PreviousSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHead", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5605,70 +11001,16 @@ func (m *DomainRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = append(m.Domain[:0], dAtA[iNdEx:postIndex]...)
-			if m.Domain == nil {
-				m.Domain = []byte{}
+			m.NewHead = append(m.NewHead[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewHead == nil {
+				m.NewHead = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DomainResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DomainResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignatureDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSlot", wireType)
 			}
-			m.SignatureDomain = 0
+			m.NewSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5678,68 +11020,34 @@ func (m *DomainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignatureDomain |= uint64(b&0x7F) << shift
+				m.NewSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockTreeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reorg", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BlockTreeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Reorg = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5766,8 +11074,8 @@ func (m *BlockTreeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tree = append(m.Tree, &BlockTreeResponse_TreeNode{})
-			if err := m.Tree[len(m.Tree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, &ForkChoiceAuditResponse_Step{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5796,7 +11104,7 @@ func (m *BlockTreeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
+func (m *ForkChoiceAuditResponse_Step) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5819,17 +11127,17 @@ func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TreeNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: Step: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TreeNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Step: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5839,33 +11147,31 @@ func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Block == nil {
-				m.Block = &v1alpha1.BeaconBlock{}
-			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Parent = append(m.Parent[:0], dAtA[iNdEx:postIndex]...)
+			if m.Parent == nil {
+				m.Parent = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5875,31 +11181,31 @@ func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockRoot == nil {
-				m.BlockRoot = []byte{}
+			m.Candidates = append(m.Candidates, &ForkChoiceAuditResponse_Candidate{})
+			if err := m.Candidates[len(m.Candidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipatedVotes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chosen", wireType)
 			}
-			m.ParticipatedVotes = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5909,16 +11215,31 @@ func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ParticipatedVotes |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chosen = append(m.Chosen[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chosen == nil {
+				m.Chosen = []byte{}
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TieBreak", wireType)
 			}
-			m.TotalVotes = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5928,11 +11249,12 @@ func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalVotes |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.TieBreak = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -5958,7 +11280,7 @@ func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TreeBlockSlotRequest) Unmarshal(dAtA []byte) error {
+func (m *ForkChoiceAuditResponse_Candidate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5981,17 +11303,17 @@ func (m *TreeBlockSlotRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TreeBlockSlotRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Candidate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TreeBlockSlotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Candidate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlotFrom", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
-			m.SlotFrom = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6001,16 +11323,31 @@ func (m *TreeBlockSlotRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlotFrom |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlotTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
-			m.SlotTo = 0
+			m.Slot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6020,7 +11357,26 @@ func (m *TreeBlockSlotRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlotTo |= uint64(b&0x7F) << shift
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
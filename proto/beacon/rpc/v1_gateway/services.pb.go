@@ -58,13 +58,14 @@ func (ValidatorRole) EnumDescriptor() ([]byte, []int) {
 type ValidatorStatus int32
 
 const (
-	ValidatorStatus_UNKNOWN_STATUS ValidatorStatus = 0
-	ValidatorStatus_PENDING_ACTIVE ValidatorStatus = 1
-	ValidatorStatus_ACTIVE         ValidatorStatus = 2
-	ValidatorStatus_INITIATED_EXIT ValidatorStatus = 3
-	ValidatorStatus_WITHDRAWABLE   ValidatorStatus = 4
-	ValidatorStatus_EXITED         ValidatorStatus = 5
-	ValidatorStatus_EXITED_SLASHED ValidatorStatus = 6
+	ValidatorStatus_UNKNOWN_STATUS   ValidatorStatus = 0
+	ValidatorStatus_PENDING_ACTIVE   ValidatorStatus = 1
+	ValidatorStatus_ACTIVE           ValidatorStatus = 2
+	ValidatorStatus_INITIATED_EXIT   ValidatorStatus = 3
+	ValidatorStatus_WITHDRAWABLE     ValidatorStatus = 4
+	ValidatorStatus_EXITED           ValidatorStatus = 5
+	ValidatorStatus_EXITED_SLASHED   ValidatorStatus = 6
+	ValidatorStatus_DEPOSIT_RECEIVED ValidatorStatus = 7
 )
 
 var ValidatorStatus_name = map[int32]string{
@@ -75,16 +76,18 @@ var ValidatorStatus_name = map[int32]string{
 	4: "WITHDRAWABLE",
 	5: "EXITED",
 	6: "EXITED_SLASHED",
+	7: "DEPOSIT_RECEIVED",
 }
 
 var ValidatorStatus_value = map[string]int32{
-	"UNKNOWN_STATUS": 0,
-	"PENDING_ACTIVE": 1,
-	"ACTIVE":         2,
-	"INITIATED_EXIT": 3,
-	"WITHDRAWABLE":   4,
-	"EXITED":         5,
-	"EXITED_SLASHED": 6,
+	"UNKNOWN_STATUS":   0,
+	"PENDING_ACTIVE":   1,
+	"ACTIVE":           2,
+	"INITIATED_EXIT":   3,
+	"WITHDRAWABLE":     4,
+	"EXITED":           5,
+	"EXITED_SLASHED":   6,
+	"DEPOSIT_RECEIVED": 7,
 }
 
 func (x ValidatorStatus) String() string {
@@ -898,14 +901,15 @@ func (m *AssignmentResponse_ValidatorAssignment) GetStatus() ValidatorStatus {
 }
 
 type ValidatorStatusResponse struct {
-	Status                    ValidatorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
-	Eth1DepositBlockNumber    uint64          `protobuf:"varint,2,opt,name=eth1_deposit_block_number,json=eth1DepositBlockNumber,proto3" json:"eth1_deposit_block_number,omitempty"`
-	DepositInclusionSlot      uint64          `protobuf:"varint,3,opt,name=deposit_inclusion_slot,json=depositInclusionSlot,proto3" json:"deposit_inclusion_slot,omitempty"`
-	ActivationEpoch           uint64          `protobuf:"varint,4,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
-	PositionInActivationQueue uint64          `protobuf:"varint,5,opt,name=position_in_activation_queue,json=positionInActivationQueue,proto3" json:"position_in_activation_queue,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{}        `json:"-"`
-	XXX_unrecognized          []byte          `json:"-"`
-	XXX_sizecache             int32           `json:"-"`
+	Status                     ValidatorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
+	Eth1DepositBlockNumber     uint64          `protobuf:"varint,2,opt,name=eth1_deposit_block_number,json=eth1DepositBlockNumber,proto3" json:"eth1_deposit_block_number,omitempty"`
+	DepositInclusionSlot       uint64          `protobuf:"varint,3,opt,name=deposit_inclusion_slot,json=depositInclusionSlot,proto3" json:"deposit_inclusion_slot,omitempty"`
+	ActivationEpoch            uint64          `protobuf:"varint,4,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
+	PositionInActivationQueue  uint64          `protobuf:"varint,5,opt,name=position_in_activation_queue,json=positionInActivationQueue,proto3" json:"position_in_activation_queue,omitempty"`
+	ActivationEligibilityEpoch uint64          `protobuf:"varint,6,opt,name=activation_eligibility_epoch,json=activationEligibilityEpoch,proto3" json:"activation_eligibility_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{}        `json:"-"`
+	XXX_unrecognized           []byte          `json:"-"`
+	XXX_sizecache              int32           `json:"-"`
 }
 
 func (m *ValidatorStatusResponse) Reset()         { *m = ValidatorStatusResponse{} }
@@ -968,6 +972,13 @@ func (m *ValidatorStatusResponse) GetPositionInActivationQueue() uint64 {
 	return 0
 }
 
+func (m *ValidatorStatusResponse) GetActivationEligibilityEpoch() uint64 {
+	if m != nil {
+		return m.ActivationEligibilityEpoch
+	}
+	return 0
+}
+
 type DomainRequest struct {
 	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	Domain               []byte   `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
@@ -1231,126 +1242,130 @@ func init() {
 	proto.RegisterType((*TreeBlockSlotRequest)(nil), "ethereum.beacon.rpc.v1.TreeBlockSlotRequest")
 }
 
-func init() { proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285) }
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285)
+}
 
 var fileDescriptor_9eb4e94b85965285 = []byte{
-	// 1843 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x5f, 0x73, 0xdb, 0xc6,
-	0x11, 0x0f, 0x28, 0x89, 0x91, 0x57, 0xb4, 0x44, 0x9f, 0x65, 0x59, 0xa6, 0xed, 0x31, 0x8a, 0x3a,
-	0xa9, 0xa4, 0x89, 0x40, 0x89, 0xce, 0x78, 0x52, 0x65, 0xdc, 0x94, 0x92, 0x68, 0x99, 0xb5, 0x86,
-	0x52, 0x40, 0xda, 0x4e, 0x27, 0x0f, 0xe8, 0x11, 0x3c, 0x93, 0x57, 0x13, 0x38, 0x18, 0x38, 0x32,
-	0x56, 0x1f, 0x3a, 0xd3, 0xbe, 0xf6, 0xa9, 0xe9, 0x07, 0xc8, 0x87, 0xe8, 0x43, 0x67, 0x3a, 0x9d,
-	0x3e, 0x76, 0xfa, 0xde, 0xc7, 0x76, 0xfa, 0x94, 0x87, 0x7e, 0x8c, 0xce, 0xfd, 0x01, 0x08, 0x91,
-	0xa2, 0x45, 0xe5, 0x89, 0xb8, 0xdd, 0xfd, 0xed, 0xee, 0xed, 0xed, 0xed, 0xee, 0x11, 0xac, 0x30,
-	0x62, 0x9c, 0x95, 0xdb, 0x04, 0x7b, 0x2c, 0x28, 0x47, 0xa1, 0x57, 0x1e, 0xee, 0x96, 0x63, 0x12,
-	0x0d, 0xa9, 0x47, 0x62, 0x5b, 0x32, 0xd1, 0x1a, 0xe1, 0x3d, 0x12, 0x91, 0x81, 0x6f, 0x2b, 0x31,
-	0x3b, 0x0a, 0x3d, 0x7b, 0xb8, 0x5b, 0xba, 0xdb, 0x65, 0xac, 0xdb, 0x27, 0x65, 0x29, 0xd5, 0x1e,
-	0xbc, 0x2e, 0x13, 0x3f, 0xe4, 0x67, 0x0a, 0x54, 0xfa, 0x48, 0x29, 0x26, 0xbc, 0x57, 0x1e, 0xee,
-	0xe2, 0x7e, 0xd8, 0xc3, 0xbb, 0xda, 0x8a, 0xdb, 0xee, 0x33, 0xef, 0x8d, 0x16, 0x7b, 0x78, 0x81,
-	0x18, 0xe6, 0x9c, 0xc4, 0x1c, 0x73, 0xca, 0x02, 0x2d, 0x75, 0x4f, 0x5b, 0xc2, 0x21, 0x2d, 0xe3,
-	0x20, 0x60, 0x8a, 0xa9, 0xfd, 0x2b, 0x7d, 0x22, 0x7f, 0xbc, 0xed, 0x2e, 0x09, 0xb6, 0xe3, 0x6f,
-	0x70, 0xb7, 0x4b, 0xa2, 0x32, 0x0b, 0xa5, 0xc4, 0xa4, 0xb4, 0x75, 0x04, 0x85, 0x7d, 0xe1, 0x80,
-	0x43, 0xde, 0x0e, 0x48, 0xcc, 0x11, 0x82, 0xf9, 0xb8, 0xcf, 0xf8, 0xba, 0x61, 0x1a, 0x1b, 0xf3,
-	0x8e, 0xfc, 0x46, 0x3f, 0x86, 0xeb, 0x11, 0x0e, 0x3a, 0x98, 0xb9, 0x11, 0x19, 0x12, 0xdc, 0x5f,
-	0xcf, 0x99, 0xc6, 0x46, 0xc1, 0x29, 0x28, 0xa2, 0x23, 0x69, 0xd6, 0x0e, 0xac, 0x9c, 0x46, 0x2c,
-	0x64, 0x31, 0x71, 0x48, 0x1c, 0xb2, 0x20, 0x26, 0xe8, 0x3e, 0x80, 0xdc, 0x9c, 0x1b, 0x31, 0xad,
-	0xb1, 0xe0, 0x5c, 0x93, 0x14, 0x87, 0x31, 0x6e, 0x0d, 0x01, 0x55, 0x47, 0x7b, 0x4b, 0x1c, 0xb8,
-	0x0f, 0x10, 0x0e, 0xda, 0x7d, 0xea, 0xb9, 0x6f, 0xc8, 0x59, 0x02, 0x52, 0x94, 0xe7, 0xe4, 0x0c,
-	0xdd, 0x86, 0x0f, 0x43, 0xe6, 0xb9, 0x6d, 0xca, 0xb5, 0x17, 0xf9, 0x90, 0x79, 0xfb, 0x74, 0xe4,
-	0xf8, 0x5c, 0xc6, 0xf1, 0x55, 0x58, 0x88, 0x7b, 0x38, 0xea, 0xac, 0xcf, 0x4b, 0xa2, 0x5a, 0x58,
-	0x0f, 0x61, 0x59, 0xd9, 0x4d, 0x1d, 0x45, 0x30, 0x9f, 0x71, 0x51, 0x7e, 0x5b, 0xa7, 0x70, 0xf7,
-	0x25, 0xee, 0xd3, 0x0e, 0xe6, 0x2c, 0x3a, 0x25, 0xd1, 0x6b, 0x16, 0xf9, 0x38, 0xf0, 0xc8, 0xfb,
-	0xe2, 0x74, 0xde, 0xf5, 0xdc, 0x98, 0xeb, 0xd6, 0xf7, 0x06, 0xdc, 0xbb, 0x58, 0xa5, 0x76, 0x63,
-	0x1d, 0x3e, 0x6c, 0xe3, 0xbe, 0x20, 0x69, 0xb5, 0xc9, 0x12, 0x6d, 0x42, 0x91, 0x33, 0x8e, 0xfb,
-	0xee, 0x30, 0xc1, 0xc7, 0x52, 0xff, 0xbc, 0xb3, 0x22, 0xe9, 0xa9, 0xda, 0x18, 0x3d, 0x86, 0xdb,
-	0x4a, 0x14, 0x7b, 0x9c, 0x0e, 0x49, 0x16, 0xa1, 0x42, 0x73, 0x4b, 0xb2, 0xab, 0x92, 0x9b, 0xc1,
-	0x1d, 0x81, 0x89, 0x87, 0x24, 0xc2, 0x5d, 0x32, 0x81, 0x74, 0x13, 0xaf, 0x44, 0x18, 0x73, 0xce,
-	0x7d, 0x2d, 0x37, 0xa6, 0x62, 0x5f, 0x09, 0x59, 0x4f, 0xa0, 0x94, 0xd2, 0xa4, 0xc8, 0xb9, 0xe3,
-	0x7d, 0x00, 0x4b, 0xa3, 0x18, 0xc5, 0xeb, 0x86, 0x39, 0xb7, 0x51, 0x70, 0x20, 0x0d, 0x52, 0x6c,
-	0x7d, 0x97, 0xcb, 0x04, 0x3e, 0x8b, 0xd7, 0x41, 0x7a, 0x0c, 0xb7, 0xb0, 0xa2, 0x92, 0x8e, 0x3b,
-	0xa1, 0x6a, 0x3f, 0xb7, 0x6e, 0x38, 0x37, 0x53, 0x81, 0xd3, 0x54, 0x2f, 0x7a, 0x09, 0x8b, 0x22,
-	0xd3, 0x06, 0x31, 0x11, 0xa1, 0x9b, 0xdb, 0x58, 0xaa, 0xec, 0xd9, 0x17, 0xdf, 0x64, 0xfb, 0x3d,
-	0xe6, 0xed, 0xa6, 0xd4, 0xe1, 0xa4, 0xba, 0x4a, 0x21, 0xe4, 0x15, 0xed, 0xb2, 0xcc, 0x3d, 0x82,
-	0xbc, 0x02, 0xc9, 0x93, 0x5b, 0xaa, 0x94, 0x2f, 0x35, 0xaf, 0x6d, 0x69, 0xd3, 0x8e, 0x86, 0x5b,
-	0x7b, 0x70, 0xbb, 0xf6, 0x8e, 0x72, 0xd2, 0x19, 0x9d, 0xde, 0xcc, 0xd1, 0xfd, 0x1c, 0xd6, 0x27,
-	0xb1, 0x3a, 0xb2, 0x97, 0x82, 0xbf, 0x04, 0x74, 0xd0, 0xc3, 0x34, 0x68, 0x72, 0x1c, 0xf1, 0x6c,
-	0xd6, 0xc6, 0x82, 0x40, 0x3a, 0x72, 0xcf, 0x8b, 0x4e, 0xb2, 0x44, 0x3f, 0x82, 0x42, 0x97, 0x04,
-	0x24, 0xa6, 0xb1, 0xcb, 0xa9, 0x4f, 0x74, 0xc6, 0x2e, 0x69, 0x5a, 0x8b, 0xfa, 0xc4, 0x7a, 0x0c,
-	0xb7, 0x52, 0x4f, 0xea, 0x41, 0x87, 0xbc, 0x9b, 0xad, 0x0c, 0x58, 0x36, 0xac, 0x8d, 0xe3, 0xb4,
-	0x3b, 0xab, 0xb0, 0x40, 0x05, 0x41, 0x5f, 0x21, 0xb5, 0xb0, 0x5e, 0xc0, 0x8d, 0x6a, 0x1c, 0xd3,
-	0x6e, 0xe0, 0x93, 0x80, 0x67, 0xa2, 0x45, 0x42, 0xe6, 0xf5, 0x5c, 0xe9, 0xb0, 0x06, 0x80, 0x24,
-	0xc9, 0x2d, 0x8e, 0x47, 0x24, 0x37, 0x11, 0x91, 0xff, 0xe5, 0x00, 0x65, 0xf5, 0x6a, 0x1f, 0xde,
-	0xc2, 0xea, 0xe8, 0xf2, 0xe0, 0x94, 0x2f, 0x43, 0xba, 0x54, 0xf9, 0xd9, 0xb4, 0x83, 0x9f, 0xd4,
-	0x94, 0x49, 0xc5, 0x11, 0xef, 0xe6, 0x70, 0x92, 0x58, 0xfa, 0xaf, 0x01, 0x37, 0x2f, 0x10, 0x46,
-	0xf7, 0xe0, 0x9a, 0xc7, 0x7c, 0x9f, 0x72, 0x4e, 0x88, 0xb4, 0x3f, 0xef, 0x8c, 0x08, 0xa3, 0x02,
-	0x99, 0xcb, 0x14, 0xc8, 0x0b, 0x4b, 0xe9, 0x03, 0x58, 0xa2, 0xb1, 0x1b, 0xaa, 0x0a, 0x1f, 0xc9,
-	0x4a, 0xb0, 0xe8, 0x00, 0x8d, 0x75, 0xcd, 0x8f, 0xc6, 0x0e, 0x6c, 0x61, 0x3c, 0xfb, 0xbf, 0x48,
-	0xb3, 0x3f, 0x6f, 0x1a, 0x1b, 0xcb, 0x95, 0x9f, 0xcc, 0x9a, 0xfd, 0x49, 0xd6, 0xff, 0x25, 0x07,
-	0xb7, 0xa7, 0xdc, 0x8c, 0x8c, 0x72, 0xe3, 0x07, 0x29, 0x47, 0x3f, 0x85, 0x3b, 0x84, 0xf7, 0x76,
-	0xdd, 0x0e, 0x09, 0x59, 0x4c, 0xb9, 0xea, 0xc9, 0x6e, 0x30, 0xf0, 0xdb, 0x24, 0xd2, 0xb1, 0x11,
-	0x6d, 0x7f, 0xf7, 0x50, 0xf1, 0x65, 0xc7, 0x6c, 0x48, 0x2e, 0xfa, 0x14, 0xd6, 0x12, 0x14, 0x0d,
-	0xbc, 0xfe, 0x20, 0xa6, 0x2c, 0x70, 0x33, 0xe1, 0x5b, 0xd5, 0xdc, 0x7a, 0xc2, 0x6c, 0x8a, 0x70,
-	0x6e, 0x42, 0x11, 0xa7, 0xc5, 0xc5, 0x95, 0x29, 0xa7, 0x9b, 0xd4, 0xca, 0x88, 0x5e, 0x13, 0x64,
-	0xf4, 0x05, 0xdc, 0x93, 0x0a, 0x84, 0x20, 0x0d, 0xdc, 0x0c, 0xec, 0xed, 0x80, 0x0c, 0x88, 0x0c,
-	0xf5, 0xbc, 0x73, 0x27, 0x91, 0xa9, 0x07, 0xa3, 0xaa, 0xf5, 0xa5, 0x10, 0xb0, 0x9e, 0xc0, 0xf5,
-	0x43, 0xe6, 0x63, 0x9a, 0xd6, 0xe0, 0x55, 0x58, 0x50, 0x16, 0xf5, 0x15, 0x91, 0x0b, 0xb4, 0x06,
-	0xf9, 0x8e, 0x14, 0x4b, 0x1a, 0xab, 0x5a, 0x59, 0x9f, 0xc3, 0x72, 0x02, 0xd7, 0xe1, 0xde, 0x84,
-	0xa2, 0xc8, 0x2f, 0xcc, 0x07, 0x11, 0x71, 0x35, 0x46, 0xa9, 0x5a, 0x49, 0xe9, 0x0a, 0x62, 0xfd,
-	0x31, 0x07, 0x37, 0x64, 0xb4, 0x5a, 0x11, 0x19, 0x35, 0xba, 0xa7, 0x30, 0xcf, 0x23, 0x9d, 0x8f,
-	0x4b, 0x95, 0xca, 0xb4, 0xd3, 0x9a, 0x00, 0xda, 0x62, 0xd1, 0x60, 0x1d, 0xe2, 0x48, 0x7c, 0xe9,
-	0xcf, 0x06, 0x2c, 0x26, 0x24, 0xf4, 0x19, 0x2c, 0xc8, 0x63, 0x93, 0xae, 0x2c, 0x55, 0xac, 0x91,
-	0x56, 0xc2, 0x7b, 0x76, 0x32, 0x4e, 0xd9, 0xfb, 0xd2, 0x84, 0x9a, 0x79, 0x14, 0x60, 0x6c, 0x4e,
-	0xc9, 0x8d, 0xcd, 0x29, 0x68, 0x1b, 0x50, 0x88, 0x23, 0x4e, 0x3d, 0x1a, 0xca, 0xa6, 0x33, 0x64,
-	0x9c, 0x24, 0xcd, 0xf4, 0x46, 0x96, 0xf3, 0x52, 0x30, 0xc4, 0x4d, 0xd1, 0xbd, 0x5a, 0xca, 0xa9,
-	0x53, 0x05, 0xd5, 0xa6, 0x05, 0xc5, 0x3a, 0x86, 0x55, 0xe1, 0xb4, 0x74, 0x41, 0x24, 0x43, 0x72,
-	0x2c, 0x77, 0xe1, 0x9a, 0xc8, 0x1b, 0xf7, 0x75, 0xc4, 0x7c, 0x1d, 0xcf, 0x45, 0x41, 0x78, 0x1a,
-	0x31, 0x5f, 0xcc, 0x3d, 0x92, 0xc9, 0x99, 0xce, 0xc7, 0xbc, 0x58, 0xb6, 0xd8, 0xd6, 0x67, 0x70,
-	0x3d, 0xcd, 0x6a, 0x87, 0xf5, 0x09, 0x5a, 0x82, 0x0f, 0x5f, 0x34, 0x9e, 0x37, 0x4e, 0x5e, 0x35,
-	0x8a, 0x1f, 0xa0, 0x02, 0x2c, 0x56, 0x5b, 0xad, 0x5a, 0xb3, 0x55, 0x73, 0x8a, 0x86, 0x58, 0x9d,
-	0x3a, 0x27, 0xa7, 0x27, 0xcd, 0x9a, 0x53, 0xcc, 0x6d, 0xfd, 0xc1, 0x80, 0x95, 0xb1, 0x0b, 0x81,
-	0x10, 0x2c, 0x6b, 0xb0, 0xdb, 0x6c, 0x55, 0x5b, 0x2f, 0x9a, 0xc5, 0x0f, 0x04, 0xed, 0xb4, 0xd6,
-	0x38, 0xac, 0x37, 0x8e, 0xdc, 0xea, 0x41, 0xab, 0xfe, 0xb2, 0x56, 0x34, 0x10, 0x40, 0x5e, 0x7f,
-	0xe7, 0x04, 0xbf, 0xde, 0xa8, 0xb7, 0xea, 0xd5, 0x56, 0xed, 0xd0, 0xad, 0x7d, 0x55, 0x6f, 0x15,
-	0xe7, 0x50, 0x11, 0x0a, 0xaf, 0xea, 0xad, 0x67, 0x87, 0x4e, 0xf5, 0x55, 0x75, 0xff, 0xb8, 0x56,
-	0x9c, 0x17, 0x08, 0xc1, 0xab, 0x1d, 0x16, 0x17, 0x04, 0x42, 0x7d, 0xbb, 0xcd, 0xe3, 0x6a, 0xf3,
-	0x59, 0xed, 0xb0, 0x98, 0xaf, 0xfc, 0x63, 0x0e, 0xae, 0xab, 0xb3, 0x69, 0xaa, 0x79, 0x1b, 0xfd,
-	0x12, 0x6e, 0xbc, 0xc2, 0x94, 0x3f, 0x65, 0xd1, 0xa8, 0xeb, 0xa0, 0x35, 0x5b, 0x0d, 0xbf, 0x76,
-	0x32, 0x66, 0xdb, 0x35, 0x31, 0x66, 0x97, 0xb6, 0xa6, 0x25, 0xd1, 0x64, 0xc7, 0xda, 0x31, 0xd0,
-	0x73, 0xb8, 0x7e, 0x80, 0x03, 0x16, 0x50, 0x0f, 0xf7, 0x9f, 0x11, 0xdc, 0x99, 0xaa, 0x76, 0x86,
-	0x2c, 0x42, 0xdf, 0x19, 0x70, 0x2d, 0x4d, 0xd5, 0xa9, 0x9a, 0x36, 0x67, 0xce, 0x72, 0xeb, 0xe4,
-	0xdb, 0xea, 0x0e, 0xb2, 0x9f, 0x12, 0xee, 0xf5, 0x48, 0x6c, 0xca, 0x44, 0x34, 0x45, 0xbe, 0x9b,
-	0x31, 0x0d, 0x3c, 0x62, 0xf6, 0x71, 0xcc, 0xcd, 0xd7, 0x34, 0xc0, 0x7d, 0xfa, 0x1b, 0xd2, 0x51,
-	0x7c, 0xfb, 0xf7, 0xff, 0xfa, 0xfe, 0x4f, 0xb9, 0x35, 0xb4, 0x2a, 0x1e, 0x2c, 0xfa, 0xf9, 0x22,
-	0x19, 0x02, 0x87, 0xde, 0x40, 0x31, 0xb5, 0xb2, 0x7f, 0x26, 0x72, 0x2e, 0x46, 0x9f, 0x4c, 0xf3,
-	0xe7, 0xa2, 0xdc, 0xbc, 0x82, 0xf7, 0x95, 0xff, 0x18, 0xb0, 0xa2, 0xe6, 0x6b, 0x12, 0x25, 0x47,
-	0xd9, 0x03, 0xa4, 0x35, 0x65, 0x26, 0x7e, 0x34, 0xf5, 0xcc, 0x26, 0x9f, 0x05, 0xa5, 0x8f, 0xa7,
-	0x1c, 0x44, 0x46, 0xf4, 0x10, 0x73, 0x8c, 0x5c, 0xb8, 0xd1, 0x1c, 0xb4, 0x7d, 0x7a, 0xce, 0x90,
-	0x75, 0x39, 0x38, 0x6b, 0xe0, 0x22, 0x67, 0xd2, 0xed, 0xfd, 0xd3, 0x48, 0x1f, 0x3a, 0xe9, 0xf6,
-	0xbe, 0x82, 0x82, 0xf6, 0x53, 0x65, 0xc4, 0xc3, 0xf7, 0x46, 0x2b, 0xd9, 0xd2, 0x2c, 0xb9, 0xf5,
-	0x35, 0x14, 0xb4, 0x31, 0xb5, 0x9e, 0x01, 0x53, 0x9a, 0xda, 0xfd, 0xc6, 0xde, 0x67, 0x95, 0xbf,
-	0xe5, 0xa1, 0x38, 0x2a, 0x00, 0x7a, 0x2f, 0x5f, 0x03, 0xa8, 0xda, 0x2d, 0xc3, 0xf9, 0xd1, 0x34,
-	0x5d, 0xe7, 0x3a, 0xca, 0xf4, 0xe0, 0x8d, 0x75, 0x8e, 0xdf, 0xa6, 0x57, 0x7a, 0xd4, 0xa4, 0x50,
-	0xe5, 0x4a, 0x73, 0xb8, 0x32, 0xf8, 0xe8, 0x07, 0xcc, 0xee, 0x3b, 0x06, 0x62, 0xb0, 0x7c, 0x7e,
-	0x6c, 0x44, 0xdb, 0x97, 0x2a, 0xca, 0x8e, 0xa5, 0x25, 0x7b, 0x56, 0x71, 0xbd, 0xe1, 0x3e, 0xdc,
-	0x3c, 0x48, 0xa6, 0xad, 0xcc, 0x54, 0xb6, 0x39, 0xcb, 0x08, 0xa8, 0x2c, 0x6e, 0xcd, 0x3e, 0x2d,
-	0xa2, 0xb7, 0x93, 0x05, 0xfd, 0x8a, 0xfb, 0xbb, 0xea, 0xa3, 0x04, 0xfd, 0xce, 0x80, 0xd5, 0x8b,
-	0x1e, 0xb5, 0xe8, 0xf2, 0x13, 0x9a, 0x7c, 0x55, 0x97, 0x3e, 0xbd, 0x1a, 0x48, 0xfb, 0x30, 0x80,
-	0xe2, 0xf8, 0xa3, 0x06, 0x4d, 0xdd, 0xc8, 0x94, 0xa7, 0x53, 0x69, 0x67, 0x76, 0x80, 0x32, 0xbb,
-	0xff, 0xf7, 0xb9, 0x6f, 0xab, 0x7f, 0x9d, 0x43, 0xff, 0x36, 0x60, 0xe1, 0x34, 0x3a, 0x8b, 0x7d,
-	0xf4, 0xf0, 0x17, 0xcd, 0x93, 0x86, 0xe9, 0x9c, 0x1e, 0x98, 0xc9, 0x5f, 0x46, 0x66, 0x18, 0xb1,
-	0x21, 0xed, 0x88, 0x2a, 0x7d, 0x66, 0x4a, 0x21, 0xdb, 0x3a, 0x80, 0x65, 0xf9, 0x85, 0x39, 0xf5,
-	0xcc, 0x63, 0xdc, 0x8e, 0xd1, 0x9d, 0x1e, 0xe7, 0x61, 0xbc, 0x57, 0x2e, 0x87, 0x09, 0xbd, 0x8f,
-	0xdb, 0xb1, 0xed, 0x31, 0xbf, 0xb4, 0xc6, 0x09, 0xf6, 0x7f, 0x3e, 0x41, 0xdf, 0xfa, 0x15, 0x3c,
-	0x38, 0x6a, 0xbc, 0x30, 0x8f, 0x48, 0x40, 0x22, 0xdc, 0x37, 0xd5, 0x3b, 0xd7, 0x3c, 0xa6, 0x1e,
-	0x09, 0x62, 0x62, 0x0e, 0x1f, 0xd9, 0x3b, 0xe8, 0x49, 0xa2, 0xb5, 0x4b, 0x79, 0x6f, 0xd0, 0x16,
-	0xb0, 0xf3, 0x06, 0xd4, 0x4a, 0xb4, 0x89, 0x76, 0xd9, 0xc7, 0xa2, 0x5c, 0x97, 0x8f, 0xeb, 0x07,
-	0xb5, 0x46, 0xb3, 0x66, 0xfb, 0x9d, 0xca, 0xc2, 0x8e, 0xbd, 0x63, 0xef, 0x94, 0x56, 0x70, 0x48,
-	0xed, 0x30, 0x3a, 0x93, 0x96, 0x03, 0xc2, 0xb7, 0x8c, 0x5c, 0xa5, 0x88, 0xc3, 0xb0, 0x4f, 0x3d,
-	0x79, 0xb9, 0xca, 0xbf, 0x8e, 0x59, 0x50, 0xb9, 0x93, 0xa5, 0x74, 0xa3, 0xd0, 0xdb, 0xfe, 0x86,
-	0xb4, 0xb7, 0x39, 0x79, 0xc7, 0xa7, 0xb0, 0xde, 0x83, 0x12, 0xac, 0xbd, 0x09, 0x13, 0x7b, 0xd3,
-	0x4d, 0x44, 0x8f, 0x45, 0x91, 0x3c, 0x8b, 0x7d, 0xf3, 0x48, 0xee, 0x14, 0x7d, 0x3c, 0xdb, 0xce,
-	0xdb, 0x79, 0xd9, 0xa2, 0x1f, 0xfd, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xed, 0xc9, 0x96, 0xa4, 0xf6,
-	0x13, 0x00, 0x00,
+	// 1887 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcf, 0x72, 0x1b, 0xc7,
+	0xd1, 0xf7, 0x82, 0x24, 0x44, 0x35, 0x29, 0x12, 0x1a, 0x51, 0x14, 0x05, 0x49, 0xa5, 0xfd, 0xf6,
+	0x93, 0x15, 0x89, 0x65, 0x2e, 0x48, 0xc8, 0xa5, 0x72, 0xe4, 0x52, 0x6c, 0x90, 0x80, 0x28, 0x44,
+	0x2c, 0x10, 0x5e, 0x40, 0x92, 0x53, 0x3e, 0x6c, 0x06, 0x8b, 0x11, 0x30, 0xd1, 0x62, 0x67, 0xb5,
+	0x3b, 0x80, 0xc5, 0x1c, 0x52, 0x95, 0xbc, 0x41, 0x9c, 0x07, 0x70, 0xe5, 0x19, 0x72, 0x4b, 0xa5,
+	0x72, 0x4c, 0xe5, 0x9e, 0x63, 0x52, 0x39, 0xf9, 0x90, 0x57, 0xc8, 0x2d, 0x35, 0x7f, 0x76, 0xb1,
+	0x04, 0x08, 0x11, 0xf4, 0x09, 0x3b, 0xdd, 0xfd, 0xeb, 0xee, 0xe9, 0xe9, 0xe9, 0xee, 0x01, 0x58,
+	0x61, 0xc4, 0x38, 0x2b, 0x75, 0x08, 0xf6, 0x58, 0x50, 0x8a, 0x42, 0xaf, 0x34, 0xda, 0x2b, 0xc5,
+	0x24, 0x1a, 0x51, 0x8f, 0xc4, 0xb6, 0x64, 0xa2, 0x4d, 0xc2, 0xfb, 0x24, 0x22, 0xc3, 0x81, 0xad,
+	0xc4, 0xec, 0x28, 0xf4, 0xec, 0xd1, 0x5e, 0xf1, 0x56, 0x8f, 0xb1, 0x9e, 0x4f, 0x4a, 0x52, 0xaa,
+	0x33, 0x7c, 0x53, 0x22, 0x83, 0x90, 0x9f, 0x28, 0x50, 0xf1, 0x63, 0xa5, 0x98, 0xf0, 0x7e, 0x69,
+	0xb4, 0x87, 0xfd, 0xb0, 0x8f, 0xf7, 0xb4, 0x15, 0xb7, 0xe3, 0x33, 0xef, 0xad, 0x16, 0xbb, 0x77,
+	0x86, 0x18, 0xe6, 0x9c, 0xc4, 0x1c, 0x73, 0xca, 0x02, 0x2d, 0x75, 0x5b, 0x5b, 0xc2, 0x21, 0x2d,
+	0xe1, 0x20, 0x60, 0x8a, 0xa9, 0xfd, 0x2b, 0x7e, 0x22, 0x7f, 0xbc, 0x9d, 0x1e, 0x09, 0x76, 0xe2,
+	0x6f, 0x71, 0xaf, 0x47, 0xa2, 0x12, 0x0b, 0xa5, 0xc4, 0xb4, 0xb4, 0x75, 0x08, 0xab, 0xfb, 0xc2,
+	0x01, 0x87, 0xbc, 0x1b, 0x92, 0x98, 0x23, 0x04, 0x8b, 0xb1, 0xcf, 0xf8, 0x96, 0x61, 0x1a, 0x0f,
+	0x16, 0x1d, 0xf9, 0x8d, 0xfe, 0x1f, 0xae, 0x44, 0x38, 0xe8, 0x62, 0xe6, 0x46, 0x64, 0x44, 0xb0,
+	0xbf, 0x95, 0x33, 0x8d, 0x07, 0xab, 0xce, 0xaa, 0x22, 0x3a, 0x92, 0x66, 0xed, 0xc2, 0x7a, 0x33,
+	0x62, 0x21, 0x8b, 0x89, 0x43, 0xe2, 0x90, 0x05, 0x31, 0x41, 0x77, 0x00, 0xe4, 0xe6, 0xdc, 0x88,
+	0x69, 0x8d, 0xab, 0xce, 0x65, 0x49, 0x71, 0x18, 0xe3, 0xd6, 0x08, 0x50, 0x65, 0xbc, 0xb7, 0xc4,
+	0x81, 0x3b, 0x00, 0xe1, 0xb0, 0xe3, 0x53, 0xcf, 0x7d, 0x4b, 0x4e, 0x12, 0x90, 0xa2, 0xbc, 0x20,
+	0x27, 0xe8, 0x06, 0x5c, 0x0a, 0x99, 0xe7, 0x76, 0x28, 0xd7, 0x5e, 0xe4, 0x43, 0xe6, 0xed, 0xd3,
+	0xb1, 0xe3, 0x0b, 0x19, 0xc7, 0x37, 0x60, 0x29, 0xee, 0xe3, 0xa8, 0xbb, 0xb5, 0x28, 0x89, 0x6a,
+	0x61, 0xdd, 0x83, 0x35, 0x65, 0x37, 0x75, 0x14, 0xc1, 0x62, 0xc6, 0x45, 0xf9, 0x6d, 0x35, 0xe1,
+	0xd6, 0x2b, 0xec, 0xd3, 0x2e, 0xe6, 0x2c, 0x6a, 0x92, 0xe8, 0x0d, 0x8b, 0x06, 0x38, 0xf0, 0xc8,
+	0x87, 0xe2, 0x74, 0xda, 0xf5, 0xdc, 0x84, 0xeb, 0xd6, 0x0f, 0x06, 0xdc, 0x3e, 0x5b, 0xa5, 0x76,
+	0x63, 0x0b, 0x2e, 0x75, 0xb0, 0x2f, 0x48, 0x5a, 0x6d, 0xb2, 0x44, 0x0f, 0xa1, 0xc0, 0x19, 0xc7,
+	0xbe, 0x3b, 0x4a, 0xf0, 0xb1, 0xd4, 0xbf, 0xe8, 0xac, 0x4b, 0x7a, 0xaa, 0x36, 0x46, 0x8f, 0xe1,
+	0x86, 0x12, 0xc5, 0x1e, 0xa7, 0x23, 0x92, 0x45, 0xa8, 0xd0, 0x5c, 0x97, 0xec, 0x8a, 0xe4, 0x66,
+	0x70, 0x87, 0x60, 0xe2, 0x11, 0x89, 0x70, 0x8f, 0x4c, 0x21, 0xdd, 0xc4, 0x2b, 0x11, 0xc6, 0x9c,
+	0x73, 0x47, 0xcb, 0x4d, 0xa8, 0xd8, 0x57, 0x42, 0xd6, 0x53, 0x28, 0xa6, 0x34, 0x29, 0x72, 0xea,
+	0x78, 0xef, 0xc2, 0xca, 0x38, 0x46, 0xf1, 0x96, 0x61, 0x2e, 0x3c, 0x58, 0x75, 0x20, 0x0d, 0x52,
+	0x6c, 0x7d, 0x9f, 0x83, 0x5b, 0x67, 0xe2, 0x75, 0x90, 0x1e, 0xc3, 0x75, 0xac, 0xa8, 0xa4, 0xeb,
+	0x4e, 0xa9, 0xda, 0xcf, 0x6d, 0x19, 0xce, 0xb5, 0x54, 0xa0, 0x99, 0xea, 0x45, 0xaf, 0x60, 0x59,
+	0x64, 0xda, 0x30, 0x26, 0x22, 0x74, 0x0b, 0x0f, 0x56, 0xca, 0x4f, 0xec, 0xb3, 0x6f, 0xb2, 0xfd,
+	0x01, 0xf3, 0x76, 0x4b, 0xea, 0x70, 0x52, 0x5d, 0xc5, 0x10, 0xf2, 0x8a, 0x76, 0x5e, 0xe6, 0x1e,
+	0x42, 0x5e, 0x81, 0xe4, 0xc9, 0xad, 0x94, 0x4b, 0xe7, 0x9a, 0xd7, 0xb6, 0xb4, 0x69, 0x47, 0xc3,
+	0xad, 0x27, 0x70, 0xa3, 0xf6, 0x9e, 0x72, 0xd2, 0x1d, 0x9f, 0xde, 0xdc, 0xd1, 0xfd, 0x1c, 0xb6,
+	0xa6, 0xb1, 0x3a, 0xb2, 0xe7, 0x82, 0xbf, 0x02, 0x74, 0xd0, 0xc7, 0x34, 0x68, 0x71, 0x1c, 0xf1,
+	0x6c, 0xd6, 0xc6, 0x82, 0x40, 0xba, 0x72, 0xcf, 0xcb, 0x4e, 0xb2, 0x44, 0xff, 0x07, 0xab, 0x3d,
+	0x12, 0x90, 0x98, 0xc6, 0x2e, 0xa7, 0x03, 0xa2, 0x33, 0x76, 0x45, 0xd3, 0xda, 0x74, 0x40, 0xac,
+	0xc7, 0x70, 0x3d, 0xf5, 0xa4, 0x1e, 0x74, 0xc9, 0xfb, 0xf9, 0xca, 0x80, 0x65, 0xc3, 0xe6, 0x24,
+	0x4e, 0xbb, 0xb3, 0x01, 0x4b, 0x54, 0x10, 0xf4, 0x15, 0x52, 0x0b, 0xeb, 0x25, 0x5c, 0xad, 0xc4,
+	0x31, 0xed, 0x05, 0x03, 0x12, 0xf0, 0x4c, 0xb4, 0x48, 0xc8, 0xbc, 0xbe, 0x2b, 0x1d, 0xd6, 0x00,
+	0x90, 0x24, 0xb9, 0xc5, 0xc9, 0x88, 0xe4, 0xa6, 0x22, 0xf2, 0x9f, 0x1c, 0xa0, 0xac, 0x5e, 0xed,
+	0xc3, 0x3b, 0xd8, 0x18, 0x5f, 0x1e, 0x9c, 0xf2, 0x65, 0x48, 0x57, 0xca, 0x3f, 0x9b, 0x75, 0xf0,
+	0xd3, 0x9a, 0x32, 0xa9, 0x38, 0xe6, 0x5d, 0x1b, 0x4d, 0x13, 0x8b, 0xff, 0x36, 0xe0, 0xda, 0x19,
+	0xc2, 0xe8, 0x36, 0x5c, 0xf6, 0xd8, 0x60, 0x40, 0x39, 0x27, 0x44, 0xda, 0x5f, 0x74, 0xc6, 0x84,
+	0x71, 0x81, 0xcc, 0x65, 0x0a, 0xe4, 0x99, 0xa5, 0xf4, 0x2e, 0xac, 0xd0, 0xd8, 0x0d, 0x55, 0x85,
+	0x8f, 0x64, 0x25, 0x58, 0x76, 0x80, 0xc6, 0xba, 0xe6, 0x47, 0x13, 0x07, 0xb6, 0x34, 0x99, 0xfd,
+	0x5f, 0xa4, 0xd9, 0x9f, 0x37, 0x8d, 0x07, 0x6b, 0xe5, 0x9f, 0xcc, 0x9b, 0xfd, 0x49, 0xd6, 0xff,
+	0x37, 0x07, 0x37, 0x66, 0xdc, 0x8c, 0x8c, 0x72, 0xe3, 0x47, 0x29, 0x47, 0x3f, 0x85, 0x9b, 0x84,
+	0xf7, 0xf7, 0xdc, 0x2e, 0x09, 0x59, 0x4c, 0xb9, 0xea, 0xc9, 0x6e, 0x30, 0x1c, 0x74, 0x48, 0xa4,
+	0x63, 0x23, 0xda, 0xfe, 0x5e, 0x55, 0xf1, 0x65, 0xc7, 0x6c, 0x48, 0x2e, 0xfa, 0x14, 0x36, 0x13,
+	0x14, 0x0d, 0x3c, 0x7f, 0x18, 0x53, 0x16, 0xb8, 0x99, 0xf0, 0x6d, 0x68, 0x6e, 0x3d, 0x61, 0xb6,
+	0x44, 0x38, 0x1f, 0x42, 0x01, 0xa7, 0xc5, 0xc5, 0x95, 0x29, 0xa7, 0x9b, 0xd4, 0xfa, 0x98, 0x5e,
+	0x13, 0x64, 0xf4, 0x05, 0xdc, 0x96, 0x0a, 0x84, 0x20, 0x0d, 0xdc, 0x0c, 0xec, 0xdd, 0x90, 0x0c,
+	0x89, 0x0c, 0xf5, 0xa2, 0x73, 0x33, 0x91, 0xa9, 0x07, 0xe3, 0xaa, 0xf5, 0x95, 0x10, 0x40, 0x5f,
+	0xc2, 0xed, 0xac, 0x2d, 0x9f, 0xf6, 0x68, 0x87, 0xfa, 0x94, 0x9f, 0x68, 0xbb, 0x79, 0xa9, 0xa0,
+	0x98, 0xb1, 0x3b, 0x16, 0x91, 0x2e, 0x58, 0x4f, 0xe1, 0x4a, 0x95, 0x0d, 0x30, 0x4d, 0xab, 0xf8,
+	0x06, 0x2c, 0x29, 0xac, 0xbe, 0x64, 0x72, 0x81, 0x36, 0x21, 0xdf, 0x95, 0x62, 0x49, 0x6b, 0x56,
+	0x2b, 0xeb, 0x73, 0x58, 0x4b, 0xe0, 0xfa, 0xc0, 0x1e, 0x42, 0x41, 0x64, 0x28, 0xe6, 0xc3, 0x88,
+	0xb8, 0x1a, 0xa3, 0x54, 0xad, 0xa7, 0x74, 0x05, 0xb1, 0x7e, 0x9f, 0x83, 0xab, 0x32, 0xde, 0xed,
+	0x88, 0x8c, 0x5b, 0xe5, 0x33, 0x58, 0xe4, 0x91, 0xce, 0xe8, 0x95, 0x72, 0x79, 0xd6, 0x79, 0x4f,
+	0x01, 0x6d, 0xb1, 0x68, 0xb0, 0x2e, 0x71, 0x24, 0xbe, 0xf8, 0x27, 0x03, 0x96, 0x13, 0x12, 0xfa,
+	0x0c, 0x96, 0xe4, 0xc1, 0x4b, 0x57, 0x56, 0xca, 0xd6, 0x58, 0x2b, 0xe1, 0x7d, 0x3b, 0x19, 0xc8,
+	0xec, 0x7d, 0x69, 0x42, 0x4d, 0x4d, 0x0a, 0x30, 0x31, 0xe9, 0xe4, 0x26, 0x26, 0x1d, 0xb4, 0x03,
+	0x28, 0xc4, 0x11, 0xa7, 0x1e, 0x0d, 0x65, 0xdb, 0x1a, 0x31, 0x4e, 0x92, 0x76, 0x7c, 0x35, 0xcb,
+	0x79, 0x25, 0x18, 0xe2, 0xae, 0xe9, 0x6e, 0x2f, 0xe5, 0x54, 0x5e, 0x80, 0x6a, 0xf4, 0x82, 0x62,
+	0x1d, 0xc1, 0x86, 0x70, 0x5a, 0xba, 0x20, 0xd2, 0x29, 0x39, 0x96, 0x5b, 0x70, 0x59, 0x64, 0x9e,
+	0xfb, 0x26, 0x62, 0x03, 0x1d, 0xcf, 0x65, 0x41, 0x78, 0x16, 0xb1, 0x81, 0x98, 0x9c, 0x24, 0x93,
+	0x33, 0x9d, 0xd1, 0x79, 0xb1, 0x6c, 0xb3, 0xed, 0xcf, 0xe0, 0x4a, 0x7a, 0x2f, 0x1c, 0xe6, 0x13,
+	0xb4, 0x02, 0x97, 0x5e, 0x36, 0x5e, 0x34, 0x8e, 0x5f, 0x37, 0x0a, 0x1f, 0xa1, 0x55, 0x58, 0xae,
+	0xb4, 0xdb, 0xb5, 0x56, 0xbb, 0xe6, 0x14, 0x0c, 0xb1, 0x6a, 0x3a, 0xc7, 0xcd, 0xe3, 0x56, 0xcd,
+	0x29, 0xe4, 0xb6, 0xff, 0x68, 0xc0, 0xfa, 0xc4, 0x95, 0x42, 0x08, 0xd6, 0x34, 0xd8, 0x6d, 0xb5,
+	0x2b, 0xed, 0x97, 0xad, 0xc2, 0x47, 0x82, 0xd6, 0xac, 0x35, 0xaa, 0xf5, 0xc6, 0xa1, 0x5b, 0x39,
+	0x68, 0xd7, 0x5f, 0xd5, 0x0a, 0x06, 0x02, 0xc8, 0xeb, 0xef, 0x9c, 0xe0, 0xd7, 0x1b, 0xf5, 0x76,
+	0xbd, 0xd2, 0xae, 0x55, 0xdd, 0xda, 0xd7, 0xf5, 0x76, 0x61, 0x01, 0x15, 0x60, 0xf5, 0x75, 0xbd,
+	0xfd, 0xbc, 0xea, 0x54, 0x5e, 0x57, 0xf6, 0x8f, 0x6a, 0x85, 0x45, 0x81, 0x10, 0xbc, 0x5a, 0xb5,
+	0xb0, 0x24, 0x10, 0xea, 0xdb, 0x6d, 0x1d, 0x55, 0x5a, 0xcf, 0x6b, 0xd5, 0x42, 0x1e, 0x6d, 0x40,
+	0xa1, 0x5a, 0x6b, 0x1e, 0xb7, 0xea, 0x6d, 0xd7, 0xa9, 0x1d, 0xd4, 0xea, 0xaf, 0x6a, 0xd5, 0xc2,
+	0xa5, 0xf2, 0xdf, 0x16, 0xe0, 0x8a, 0x3a, 0xb1, 0x96, 0x9a, 0xe3, 0xd1, 0x2f, 0xe0, 0xea, 0x6b,
+	0x4c, 0xf9, 0x33, 0x16, 0x8d, 0xbb, 0x19, 0xda, 0xb4, 0xd5, 0x50, 0x6d, 0x27, 0xe3, 0xbb, 0x5d,
+	0x13, 0xe3, 0x7b, 0x71, 0x7b, 0x56, 0x6a, 0x4d, 0x77, 0xc2, 0x5d, 0x03, 0xbd, 0x80, 0x2b, 0x07,
+	0x38, 0x60, 0x01, 0xf5, 0xb0, 0xff, 0x9c, 0xe0, 0xee, 0x4c, 0xb5, 0x73, 0xe4, 0x16, 0xfa, 0xde,
+	0x80, 0xcb, 0x69, 0x02, 0xcf, 0xd4, 0xf4, 0x70, 0xee, 0xdc, 0xb7, 0x8e, 0xbf, 0xab, 0xec, 0x22,
+	0xfb, 0x19, 0xe1, 0x5e, 0x9f, 0xc4, 0xa6, 0x4c, 0x4f, 0x53, 0xdc, 0x02, 0x33, 0xa6, 0x81, 0x47,
+	0x4c, 0x1f, 0xc7, 0xdc, 0x7c, 0x43, 0x03, 0xec, 0xd3, 0x5f, 0x93, 0xae, 0xe2, 0xdb, 0xbf, 0xfb,
+	0xc7, 0x0f, 0x7f, 0xc8, 0x6d, 0xa2, 0x0d, 0xf1, 0x10, 0xd2, 0xcf, 0x22, 0xc9, 0x10, 0x38, 0xf4,
+	0x16, 0x0a, 0xa9, 0x95, 0xfd, 0x13, 0x91, 0x89, 0x31, 0xfa, 0x64, 0x96, 0x3f, 0x67, 0x65, 0xec,
+	0x05, 0xbc, 0x2f, 0xff, 0xcb, 0x80, 0x75, 0x35, 0xb7, 0x93, 0x28, 0x39, 0xca, 0x3e, 0x20, 0xad,
+	0x29, 0xf3, 0x92, 0x40, 0x33, 0xcf, 0x6c, 0xfa, 0xb9, 0x51, 0xbc, 0x3f, 0xe3, 0x20, 0x32, 0xa2,
+	0x55, 0xcc, 0x31, 0x72, 0xe1, 0x6a, 0x6b, 0xd8, 0x19, 0xd0, 0x53, 0x86, 0xac, 0xf3, 0xc1, 0xc5,
+	0xfb, 0x1f, 0x76, 0x26, 0xdd, 0xde, 0xdf, 0x8d, 0xf4, 0x01, 0x95, 0x6e, 0xef, 0x6b, 0x58, 0xd5,
+	0x7e, 0xaa, 0x8c, 0xb8, 0xf7, 0xc1, 0x68, 0x25, 0x5b, 0x9a, 0x27, 0xb7, 0xbe, 0x81, 0x55, 0x6d,
+	0x4c, 0xad, 0xe7, 0xc0, 0x14, 0x67, 0x76, 0xd5, 0x89, 0x77, 0x5f, 0xf9, 0x2f, 0x79, 0x28, 0x8c,
+	0xcb, 0x82, 0xde, 0xcb, 0x37, 0x00, 0xaa, 0xa2, 0xcb, 0x70, 0x7e, 0x3c, 0x4b, 0xd7, 0xa9, 0x3e,
+	0x53, 0xbc, 0x7f, 0x9e, 0x98, 0x6e, 0x07, 0xbf, 0x49, 0xaf, 0xf4, 0xb8, 0xf9, 0xa1, 0xf2, 0x85,
+	0xe6, 0x7b, 0x65, 0xf0, 0xd1, 0x8f, 0x78, 0x13, 0xec, 0x1a, 0x88, 0xc1, 0xda, 0xe9, 0x71, 0x14,
+	0xed, 0x9c, 0xab, 0x28, 0x3b, 0xee, 0x16, 0xed, 0x79, 0xc5, 0xf5, 0x86, 0x7d, 0xb8, 0x76, 0x90,
+	0x4c, 0x71, 0x99, 0x69, 0xef, 0xe1, 0x3c, 0xa3, 0xa5, 0xb2, 0xb8, 0x3d, 0xff, 0x14, 0x8a, 0xde,
+	0x4d, 0x97, 0xf9, 0x0b, 0xee, 0xef, 0xa2, 0x8f, 0x1d, 0xf4, 0x5b, 0x03, 0x36, 0xce, 0x7a, 0x2c,
+	0xa3, 0xf3, 0x4f, 0x68, 0xfa, 0xb5, 0x5e, 0xfc, 0xf4, 0x62, 0x20, 0xed, 0xc3, 0x10, 0x0a, 0x93,
+	0x8f, 0x25, 0x34, 0x73, 0x23, 0x33, 0x9e, 0x64, 0xc5, 0xdd, 0xf9, 0x01, 0xca, 0xec, 0xfe, 0x5f,
+	0x17, 0xbe, 0xab, 0xfc, 0x79, 0x01, 0xfd, 0xd3, 0x80, 0xa5, 0x66, 0x74, 0x12, 0x0f, 0xd0, 0xbd,
+	0x9f, 0xb7, 0x8e, 0x1b, 0xa6, 0xd3, 0x3c, 0x30, 0x93, 0xbf, 0xa2, 0xcc, 0x30, 0x62, 0x23, 0xda,
+	0x15, 0x55, 0xfa, 0xc4, 0x94, 0x42, 0xb6, 0x75, 0x00, 0x6b, 0xf2, 0x0b, 0x73, 0xea, 0x99, 0x47,
+	0xb8, 0x13, 0xa3, 0x9b, 0x7d, 0xce, 0xc3, 0xf8, 0x49, 0xa9, 0x14, 0x26, 0x74, 0x1f, 0x77, 0x62,
+	0xdb, 0x63, 0x83, 0xe2, 0x26, 0x27, 0x78, 0xf0, 0xe5, 0x14, 0x7d, 0xfb, 0x97, 0x70, 0xf7, 0xb0,
+	0xf1, 0xd2, 0x3c, 0x24, 0x01, 0x89, 0xb0, 0x6f, 0xaa, 0xf7, 0xb3, 0x79, 0x44, 0x3d, 0x12, 0xc4,
+	0xc4, 0x1c, 0x3d, 0xb2, 0x77, 0xd1, 0xd3, 0x44, 0x6b, 0x8f, 0xf2, 0xfe, 0xb0, 0x23, 0x60, 0xa7,
+	0x0d, 0xa8, 0x95, 0x68, 0x13, 0x9d, 0xd2, 0x00, 0x8b, 0x72, 0x5d, 0x3a, 0xaa, 0x1f, 0xd4, 0x1a,
+	0xad, 0x9a, 0x3d, 0xe8, 0x96, 0x97, 0x76, 0xed, 0x5d, 0x7b, 0xb7, 0xb8, 0x8e, 0x43, 0x6a, 0x87,
+	0xd1, 0x89, 0xb4, 0x1c, 0x10, 0xbe, 0x6d, 0xe4, 0xca, 0x05, 0x1c, 0x86, 0x3e, 0xf5, 0xe4, 0xe5,
+	0x2a, 0xfd, 0x2a, 0x66, 0x41, 0xf9, 0x66, 0x96, 0xd2, 0x8b, 0x42, 0x6f, 0xe7, 0x5b, 0xd2, 0xd9,
+	0xe1, 0xe4, 0x3d, 0x9f, 0xc1, 0xfa, 0x00, 0x4a, 0xb0, 0x9e, 0x4c, 0x99, 0x78, 0x32, 0xdb, 0x44,
+	0xf4, 0x58, 0x14, 0xc9, 0x93, 0x78, 0x60, 0x1e, 0xca, 0x9d, 0xa2, 0xfb, 0xf3, 0xed, 0xbc, 0x93,
+	0x97, 0x2d, 0xfa, 0xd1, 0xff, 0x06, 0x00, 0xaf, 0x68, 0xd6, 0xd6, 0x4e, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
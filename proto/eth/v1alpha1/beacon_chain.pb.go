@@ -1624,1995 +1624,5498 @@ func (m *AttestationPoolResponse) GetAttestations() []*Attestation {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ListAttestationsRequest)(nil), "ethereum.eth.v1alpha1.ListAttestationsRequest")
-	proto.RegisterType((*ListAttestationsResponse)(nil), "ethereum.eth.v1alpha1.ListAttestationsResponse")
-	proto.RegisterType((*ListBlocksRequest)(nil), "ethereum.eth.v1alpha1.ListBlocksRequest")
-	proto.RegisterType((*ListBlocksResponse)(nil), "ethereum.eth.v1alpha1.ListBlocksResponse")
-	proto.RegisterType((*ChainHead)(nil), "ethereum.eth.v1alpha1.ChainHead")
-	proto.RegisterType((*GetValidatorBalancesRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorBalancesRequest")
-	proto.RegisterType((*ValidatorBalances)(nil), "ethereum.eth.v1alpha1.ValidatorBalances")
-	proto.RegisterType((*ValidatorBalances_Balance)(nil), "ethereum.eth.v1alpha1.ValidatorBalances.Balance")
-	proto.RegisterType((*GetValidatorsRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorsRequest")
-	proto.RegisterType((*Validators)(nil), "ethereum.eth.v1alpha1.Validators")
-	proto.RegisterType((*GetValidatorActiveSetChangesRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorActiveSetChangesRequest")
-	proto.RegisterType((*ActiveSetChanges)(nil), "ethereum.eth.v1alpha1.ActiveSetChanges")
-	proto.RegisterType((*ValidatorQueue)(nil), "ethereum.eth.v1alpha1.ValidatorQueue")
-	proto.RegisterType((*ListValidatorAssignmentsRequest)(nil), "ethereum.eth.v1alpha1.ListValidatorAssignmentsRequest")
-	proto.RegisterType((*ValidatorAssignments)(nil), "ethereum.eth.v1alpha1.ValidatorAssignments")
-	proto.RegisterType((*ValidatorAssignments_CommitteeAssignment)(nil), "ethereum.eth.v1alpha1.ValidatorAssignments.CommitteeAssignment")
-	proto.RegisterType((*GetValidatorParticipationRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorParticipationRequest")
-	proto.RegisterType((*ValidatorParticipation)(nil), "ethereum.eth.v1alpha1.ValidatorParticipation")
-	proto.RegisterType((*AttestationPoolResponse)(nil), "ethereum.eth.v1alpha1.AttestationPoolResponse")
+type ValidatorBalanceHistoryRequest struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PublicKey            []byte   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	StartEpoch           uint64   `protobuf:"varint,3,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch             uint64   `protobuf:"varint,4,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	MaxPoints            uint64   `protobuf:"varint,5,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("proto/eth/v1alpha1/beacon_chain.proto", fileDescriptor_678c88b69c3c78d4)
+func (m *ValidatorBalanceHistoryRequest) Reset()         { *m = ValidatorBalanceHistoryRequest{} }
+func (m *ValidatorBalanceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBalanceHistoryRequest) ProtoMessage()    {}
+func (*ValidatorBalanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{17}
 }
-
-var fileDescriptor_678c88b69c3c78d4 = []byte{
-	// 1528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x1b, 0xef, 0xc4, 0x4e, 0x93, 0x3c, 0xf9, 0x68, 0x33, 0xf9, 0x72, 0x9d, 0x26, 0x71, 0xb7, 0x4d,
-	0x5e, 0x57, 0x6d, 0xed, 0x26, 0xed, 0xdb, 0xb7, 0x4a, 0xf5, 0xaa, 0xd4, 0x51, 0x69, 0x80, 0x1e,
-	0xc2, 0xb6, 0xe2, 0xc0, 0xc5, 0x1a, 0x6f, 0x26, 0xf6, 0x34, 0xeb, 0x9d, 0xed, 0xce, 0x38, 0x6a,
-	0x72, 0x03, 0x24, 0x24, 0xce, 0x48, 0x20, 0x2e, 0x08, 0x89, 0x13, 0x42, 0x9c, 0x40, 0x5c, 0xb8,
-	0x20, 0xb8, 0x70, 0x42, 0x95, 0xb8, 0x57, 0xa8, 0xe2, 0x2f, 0xe8, 0x05, 0x71, 0x43, 0x3b, 0x3b,
-	0xfb, 0x61, 0xc7, 0x6b, 0x1b, 0x51, 0xc1, 0xcd, 0xf3, 0xcc, 0xf3, 0xf1, 0x7b, 0x7e, 0xfb, 0xcc,
-	0x33, 0xcf, 0x18, 0x56, 0x5d, 0x8f, 0x4b, 0x5e, 0xa6, 0xb2, 0x51, 0x3e, 0x58, 0x27, 0xb6, 0xdb,
-	0x20, 0xeb, 0xe5, 0x1a, 0x25, 0x16, 0x77, 0xaa, 0x56, 0x83, 0x30, 0xa7, 0xa4, 0xf6, 0xf1, 0x1c,
-	0x95, 0x0d, 0xea, 0xd1, 0x56, 0xb3, 0x44, 0x65, 0xa3, 0x14, 0x6a, 0xe6, 0xaf, 0xd4, 0x99, 0x6c,
-	0xb4, 0x6a, 0x25, 0x8b, 0x37, 0xcb, 0x75, 0x5e, 0xe7, 0x65, 0xa5, 0x5d, 0x6b, 0xed, 0xa9, 0x55,
-	0xe0, 0xda, 0xff, 0x15, 0x78, 0xc9, 0x9f, 0xad, 0x73, 0x5e, 0xb7, 0x69, 0x99, 0xb8, 0xac, 0x4c,
-	0x1c, 0x87, 0x4b, 0x22, 0x19, 0x77, 0x84, 0xde, 0x5d, 0xd4, 0xbb, 0x91, 0x0f, 0xda, 0x74, 0xe5,
-	0xa1, 0xde, 0xbc, 0xd0, 0x05, 0x27, 0x91, 0x92, 0x8a, 0xc0, 0x87, 0xd6, 0xea, 0x91, 0x4d, 0xcd,
-	0xe6, 0xd6, 0xbe, 0x56, 0x33, 0xba, 0xa8, 0x1d, 0x10, 0x9b, 0xed, 0x12, 0xc9, 0xbd, 0x40, 0xc7,
-	0xf8, 0x06, 0xc1, 0xc2, 0x7d, 0x26, 0xe4, 0x9d, 0x38, 0x88, 0x30, 0xe9, 0xe3, 0x16, 0x15, 0x12,
-	0xaf, 0x00, 0x28, 0x77, 0x55, 0x8f, 0x73, 0x99, 0x43, 0x05, 0x54, 0x9c, 0xd8, 0x3e, 0x61, 0x8e,
-	0x29, 0x99, 0xc9, 0xb9, 0xc4, 0xb3, 0x90, 0x15, 0x36, 0x97, 0xb9, 0xa1, 0x02, 0x2a, 0x66, 0xb7,
-	0x4f, 0x98, 0x6a, 0x85, 0xe7, 0x61, 0x98, 0xba, 0xdc, 0x6a, 0xe4, 0x32, 0x5a, 0x1c, 0x2c, 0xf1,
-	0x22, 0x8c, 0xb9, 0xa4, 0x4e, 0xab, 0x82, 0x1d, 0xd1, 0x5c, 0xb6, 0x80, 0x8a, 0xc3, 0xe6, 0xa8,
-	0x2f, 0x78, 0xc0, 0x8e, 0x28, 0x5e, 0x02, 0x50, 0x9b, 0x92, 0xef, 0x53, 0x27, 0x37, 0x5c, 0x40,
-	0xc5, 0x31, 0x53, 0xa9, 0x3f, 0xf4, 0x05, 0x95, 0x29, 0x98, 0x78, 0xdc, 0xa2, 0xde, 0x61, 0x75,
-	0x8f, 0xd9, 0x92, 0x7a, 0xc6, 0x17, 0x08, 0x72, 0xc7, 0x61, 0x0b, 0x97, 0x3b, 0x82, 0xe2, 0x57,
-	0x61, 0x22, 0xc1, 0x99, 0xc8, 0xa1, 0x42, 0xa6, 0x38, 0xbe, 0x61, 0x94, 0xba, 0x7e, 0xdc, 0x52,
-	0xc2, 0x85, 0xd9, 0x66, 0x87, 0xd7, 0xe0, 0x94, 0x43, 0x9f, 0xc8, 0x6a, 0x02, 0xd8, 0x90, 0x02,
-	0x36, 0xe9, 0x8b, 0x77, 0x42, 0x70, 0x3e, 0x76, 0xc9, 0x25, 0xb1, 0x83, 0xcc, 0x32, 0x2a, 0xb3,
-	0x31, 0x25, 0xf1, 0x53, 0x33, 0x3e, 0x47, 0x30, 0xed, 0x63, 0xad, 0xf8, 0xbc, 0x45, 0xe4, 0xce,
-	0x42, 0xb6, 0x8d, 0x56, 0xb5, 0xfa, 0x17, 0x19, 0xfd, 0x18, 0x01, 0x4e, 0xa2, 0xd4, 0x5c, 0x6e,
-	0xc2, 0x49, 0xf5, 0xbd, 0xfb, 0xb1, 0x58, 0x51, 0xe5, 0xa7, 0x8c, 0x4d, 0x6d, 0xf1, 0xb2, 0xf8,
-	0xfb, 0x21, 0x03, 0x63, 0x5b, 0xfe, 0x21, 0xdd, 0xa6, 0x64, 0x17, 0x5f, 0x3d, 0x5e, 0x94, 0x95,
-	0xe9, 0x17, 0xcf, 0x56, 0x26, 0x85, 0x38, 0xba, 0xe2, 0x3b, 0xd8, 0x34, 0xae, 0x6d, 0x18, 0xc9,
-	0x2a, 0x5d, 0x0a, 0x2d, 0x62, 0x66, 0xf5, 0xf6, 0x03, 0x9f, 0xdc, 0x55, 0x98, 0xda, 0x63, 0x0e,
-	0xb1, 0xd9, 0x11, 0xdd, 0x0d, 0x54, 0x14, 0xcb, 0xe6, 0x64, 0x24, 0x55, 0x6a, 0x5b, 0x30, 0x1b,
-	0xab, 0x25, 0x10, 0x64, 0xd3, 0x10, 0xe0, 0x48, 0xbd, 0x12, 0x41, 0x59, 0x85, 0xa9, 0x47, 0x2d,
-	0x21, 0xd9, 0x1e, 0x0b, 0x63, 0x0d, 0x07, 0xb1, 0x22, 0x69, 0x18, 0x2b, 0x56, 0x4b, 0xc4, 0x3a,
-	0x99, 0x1a, 0x2b, 0x52, 0x8f, 0x63, 0xdd, 0x80, 0x05, 0xd7, 0xa3, 0x07, 0x8c, 0xb7, 0x44, 0xb5,
-	0x23, 0xe8, 0x88, 0x0a, 0x3a, 0x17, 0x6e, 0xbf, 0xde, 0x16, 0xfc, 0x21, 0x2c, 0x75, 0xb1, 0x4b,
-	0xa0, 0x18, 0x4d, 0x43, 0x91, 0x3f, 0xe6, 0x30, 0x42, 0x63, 0xbc, 0x87, 0x60, 0xf1, 0x1e, 0x95,
-	0x6f, 0x85, 0xed, 0xa7, 0x42, 0x6c, 0xe2, 0x58, 0x34, 0x71, 0x1c, 0x74, 0x89, 0x23, 0x85, 0x4d,
-	0x17, 0xf8, 0x75, 0x18, 0x77, 0x5b, 0x35, 0x9b, 0x59, 0xd5, 0x7d, 0x7a, 0x28, 0x72, 0x43, 0x85,
-	0x4c, 0x71, 0xa2, 0x32, 0xf3, 0xe2, 0xd9, 0xca, 0xa9, 0x38, 0xf2, 0xed, 0xcb, 0xd7, 0x6f, 0x1a,
-	0x26, 0x04, 0x7a, 0x6f, 0xd0, 0x43, 0x81, 0x73, 0x30, 0xc2, 0x9c, 0x5d, 0x66, 0x51, 0x91, 0xcb,
-	0x14, 0x32, 0xc5, 0xac, 0x19, 0x2e, 0x8d, 0x9f, 0x11, 0x4c, 0x1f, 0x83, 0x80, 0xef, 0xc3, 0x68,
-	0x4d, 0xff, 0xd6, 0x55, 0x7e, 0x35, 0xa5, 0xca, 0x8f, 0xd9, 0x96, 0xf4, 0x0f, 0x33, 0xf2, 0x90,
-	0xdf, 0x87, 0x11, 0x2d, 0xf4, 0x6b, 0x35, 0x86, 0xdf, 0xbd, 0x56, 0x7d, 0xec, 0x63, 0x11, 0x76,
-	0x9f, 0x06, 0xe6, 0xec, 0xd2, 0x27, 0xba, 0x4c, 0x83, 0x85, 0x9f, 0x90, 0x76, 0xaf, 0x6b, 0x33,
-	0x5c, 0x1a, 0x1f, 0x21, 0x98, 0x4d, 0xd2, 0x1a, 0xf1, 0x39, 0xdf, 0xc6, 0x67, 0xdc, 0x32, 0xf2,
-	0x30, 0x52, 0xa7, 0x0e, 0x15, 0x4c, 0xa8, 0x10, 0xa3, 0xdb, 0x27, 0xcc, 0x50, 0xd0, 0xde, 0x4e,
-	0x32, 0x3d, 0xdb, 0x49, 0xb6, 0x5f, 0x3b, 0xf9, 0x12, 0x01, 0xc4, 0xa8, 0x52, 0x3e, 0xef, 0x2b,
-	0x00, 0xd1, 0x7d, 0x14, 0x7c, 0xdd, 0xf1, 0x8d, 0x42, 0x3f, 0xea, 0xcd, 0x84, 0x4d, 0xb7, 0x16,
-	0x93, 0xe9, 0xdf, 0x62, 0xb2, 0x9d, 0x2d, 0xe6, 0x16, 0x9c, 0x4f, 0xb2, 0x78, 0xc7, 0x92, 0xec,
-	0x80, 0x3e, 0xa0, 0x72, 0xab, 0x41, 0x9c, 0x7a, 0x9f, 0x22, 0x35, 0xfe, 0x40, 0x70, 0xba, 0xd3,
-	0x22, 0x25, 0xe1, 0x7b, 0x30, 0x47, 0x7c, 0x4d, 0x22, 0xe9, 0x6e, 0x75, 0xc0, 0xca, 0x9e, 0x89,
-	0x2c, 0x76, 0xe2, 0x12, 0xbf, 0x03, 0x98, 0x3e, 0x61, 0x9d, 0x5e, 0x32, 0xe9, 0x5e, 0x4e, 0x07,
-	0xea, 0x09, 0x17, 0x5b, 0x30, 0x43, 0x1f, 0x51, 0xab, 0xd3, 0x47, 0x36, 0xdd, 0xc7, 0xb4, 0xd6,
-	0x8f, 0x9d, 0x18, 0xdf, 0x21, 0x98, 0x8a, 0x68, 0x7b, 0xb3, 0x45, 0x5b, 0x14, 0xaf, 0xc0, 0xb8,
-	0xd5, 0x68, 0x79, 0x4e, 0xd5, 0x66, 0x4d, 0x26, 0x75, 0xfe, 0xa0, 0x44, 0xf7, 0x7d, 0x09, 0x7e,
-	0x0d, 0xe6, 0x75, 0x4a, 0x8c, 0x3b, 0x83, 0xb2, 0x30, 0x1b, 0x9b, 0x24, 0x72, 0xf8, 0x3f, 0xa8,
-	0xbc, 0x06, 0x25, 0x61, 0xca, 0x57, 0x4e, 0xa0, 0xff, 0x11, 0xc1, 0x8a, 0x7f, 0xe7, 0xc5, 0x1f,
-	0x5e, 0x08, 0x56, 0x77, 0x9a, 0xd4, 0x91, 0xff, 0x6c, 0x63, 0xfa, 0x3b, 0x37, 0xb9, 0xf1, 0x49,
-	0x06, 0x66, 0xbb, 0x65, 0x90, 0x02, 0x9d, 0xc0, 0x38, 0x89, 0x95, 0xf4, 0xa9, 0xbb, 0xdd, 0xef,
-	0xd4, 0x25, 0xfc, 0x96, 0xb6, 0x78, 0xb3, 0xc9, 0xa4, 0xa4, 0x34, 0x16, 0x9a, 0x49, 0x9f, 0x2f,
-	0xe9, 0x54, 0xe6, 0xbf, 0x47, 0x30, 0xd3, 0x25, 0x16, 0x5e, 0x87, 0x59, 0xcb, 0xe3, 0x42, 0xd8,
-	0xcc, 0xd9, 0xaf, 0x5a, 0xa1, 0x42, 0xd0, 0xbb, 0xb3, 0xe6, 0x4c, 0xb4, 0x17, 0xd9, 0x2a, 0x2a,
-	0x44, 0x83, 0x78, 0xbb, 0x61, 0x5f, 0x55, 0x0b, 0x8c, 0xf5, 0xb4, 0x15, 0x34, 0xd5, 0x60, 0xd6,
-	0xca, 0xc3, 0xa8, 0xeb, 0x71, 0x97, 0x0b, 0xea, 0x29, 0x44, 0xa3, 0x66, 0xb4, 0xee, 0xe8, 0xe7,
-	0xc3, 0xfd, 0xfb, 0xb9, 0x71, 0x13, 0x0a, 0xc9, 0xc6, 0xb2, 0x43, 0x3c, 0xc9, 0x2c, 0xe6, 0x06,
-	0xd3, 0x66, 0xcf, 0xae, 0xf2, 0x14, 0xc1, 0x7c, 0x77, 0xbb, 0x94, 0xef, 0x7a, 0x16, 0xc6, 0xa2,
-	0x89, 0x23, 0xe8, 0xed, 0x66, 0x2c, 0xc0, 0x9b, 0x70, 0xa6, 0x6e, 0xf3, 0x1a, 0xb1, 0xab, 0x6e,
-	0xd2, 0x57, 0xd5, 0x23, 0x32, 0xe8, 0xf5, 0x43, 0xe6, 0x42, 0xa0, 0xd0, 0x8e, 0x91, 0x48, 0x75,
-	0xa2, 0x0f, 0xb8, 0xdf, 0x27, 0x54, 0x8d, 0x28, 0x56, 0xb2, 0x26, 0x28, 0xd1, 0x5d, 0x5f, 0xe2,
-	0x8f, 0x35, 0xd4, 0x66, 0x75, 0x56, 0xb3, 0xa9, 0xd6, 0xd1, 0x63, 0x4d, 0x28, 0x55, 0x6a, 0x06,
-	0x81, 0x85, 0xc4, 0xb0, 0xbd, 0xc3, 0xb9, 0xfd, 0xb2, 0x47, 0xf6, 0x8d, 0xdf, 0xc7, 0x61, 0x3c,
-	0x18, 0x45, 0xd5, 0xc4, 0x88, 0x3f, 0x45, 0x70, 0xba, 0xf3, 0x9d, 0x80, 0x4b, 0x29, 0x6e, 0x53,
-	0xde, 0x41, 0xf9, 0xf2, 0xc0, 0xfa, 0x41, 0x36, 0xc6, 0xc5, 0x77, 0x7f, 0xf9, 0xed, 0xc3, 0xa1,
-	0xf3, 0xf8, 0x5c, 0xb7, 0x27, 0x5a, 0xb9, 0xed, 0x8d, 0xf1, 0x01, 0x82, 0x53, 0x1d, 0xa4, 0xe0,
-	0xf9, 0x52, 0xf0, 0x44, 0x2c, 0x85, 0x4f, 0xc4, 0xd2, 0x5d, 0xff, 0x89, 0x98, 0x2f, 0xf5, 0xa7,
-	0x23, 0x49, 0xaa, 0x51, 0x52, 0x30, 0x8a, 0x78, 0xad, 0x2f, 0x8c, 0xb2, 0xeb, 0xc7, 0x7d, 0x1f,
-	0x01, 0xc4, 0x4f, 0x00, 0x5c, 0xec, 0x91, 0x76, 0xdb, 0x5b, 0x26, 0x7f, 0x71, 0x00, 0x4d, 0x8d,
-	0xe9, 0xbc, 0xc2, 0xb4, 0x84, 0x17, 0xbb, 0x62, 0xd2, 0x0f, 0x07, 0x17, 0x26, 0xee, 0xa9, 0xab,
-	0x54, 0xcf, 0xfc, 0x69, 0x84, 0xa4, 0xcd, 0x0a, 0x91, 0xa5, 0xb1, 0xa6, 0xc2, 0x15, 0xf0, 0x72,
-	0xd7, 0x70, 0xea, 0xe9, 0xdf, 0xf0, 0x23, 0x7c, 0x86, 0x60, 0xae, 0xed, 0x26, 0x88, 0x86, 0xc3,
-	0x8d, 0x94, 0x18, 0x3d, 0x86, 0xd9, 0x7c, 0x71, 0xd0, 0xf1, 0x31, 0xad, 0x52, 0xe2, 0x09, 0xa7,
-	0x1c, 0xce, 0x95, 0xf8, 0x1d, 0x04, 0x93, 0x6d, 0xa3, 0x1e, 0xbe, 0x34, 0x00, 0xb4, 0x08, 0xd3,
-	0xb9, 0x7e, 0x98, 0x84, 0x51, 0x50, 0x60, 0xf2, 0x38, 0x97, 0x06, 0x06, 0x7f, 0x8b, 0xe0, 0x6c,
-	0xaf, 0x41, 0x09, 0x6f, 0x0e, 0x00, 0x29, 0x65, 0xba, 0xca, 0xff, 0x27, 0xad, 0xbc, 0x3b, 0xf4,
-	0x8d, 0x75, 0x85, 0xf3, 0x12, 0xbe, 0x98, 0x4a, 0x9a, 0x1a, 0x16, 0xa8, 0xa0, 0xd2, 0xd2, 0xb8,
-	0x8e, 0x60, 0x3a, 0x09, 0x21, 0x98, 0x54, 0xd2, 0xca, 0x6a, 0xb5, 0x1f, 0x55, 0xca, 0x3c, 0xad,
-	0xb6, 0x12, 0x30, 0x1e, 0xab, 0x30, 0x5f, 0xe9, 0xff, 0x2a, 0xba, 0xde, 0xd1, 0x37, 0x7a, 0x1c,
-	0x9d, 0x1e, 0x63, 0x49, 0xfe, 0xd2, 0x5f, 0xb8, 0xb0, 0x8d, 0xcb, 0x0a, 0xe9, 0x1a, 0xbe, 0x90,
-	0x4e, 0x58, 0x02, 0xd2, 0xd7, 0x08, 0xce, 0xa4, 0x5e, 0x5a, 0xf8, 0x7f, 0x03, 0x7c, 0xe1, 0x6e,
-	0xd7, 0x5c, 0xfe, 0x4a, 0x3f, 0xc4, 0x6d, 0x56, 0x69, 0xcd, 0x2b, 0x81, 0xb9, 0xed, 0x22, 0xab,
-	0x6c, 0xfd, 0xf4, 0x7c, 0x19, 0x3d, 0x7d, 0xbe, 0x8c, 0x7e, 0x7d, 0xbe, 0x8c, 0xde, 0xfe, 0x6f,
-	0xe2, 0x1f, 0x3b, 0xd7, 0x3b, 0x14, 0x4d, 0x22, 0x99, 0x65, 0x93, 0x9a, 0x08, 0x56, 0xe5, 0xe3,
-	0xff, 0x8c, 0xdd, 0xa2, 0xb2, 0x51, 0x3b, 0xa9, 0xe4, 0xd7, 0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff,
-	0x48, 0x72, 0x96, 0xde, 0x2f, 0x14, 0x00, 0x00,
+func (m *ValidatorBalanceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// BeaconChainClient is the client API for BeaconChain service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type BeaconChainClient interface {
-	ListAttestations(ctx context.Context, in *ListAttestationsRequest, opts ...grpc.CallOption) (*ListAttestationsResponse, error)
-	AttestationPool(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*AttestationPoolResponse, error)
-	ListBlocks(ctx context.Context, in *ListBlocksRequest, opts ...grpc.CallOption) (*ListBlocksResponse, error)
-	GetChainHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ChainHead, error)
-	ListValidatorBalances(ctx context.Context, in *GetValidatorBalancesRequest, opts ...grpc.CallOption) (*ValidatorBalances, error)
-	GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*Validators, error)
-	GetValidatorActiveSetChanges(ctx context.Context, in *GetValidatorActiveSetChangesRequest, opts ...grpc.CallOption) (*ActiveSetChanges, error)
-	GetValidatorQueue(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ValidatorQueue, error)
-	ListValidatorAssignments(ctx context.Context, in *ListValidatorAssignmentsRequest, opts ...grpc.CallOption) (*ValidatorAssignments, error)
-	GetValidatorParticipation(ctx context.Context, in *GetValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipation, error)
+func (m *ValidatorBalanceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBalanceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type beaconChainClient struct {
-	cc *grpc.ClientConn
+func (m *ValidatorBalanceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBalanceHistoryRequest.Merge(m, src)
 }
-
-func NewBeaconChainClient(cc *grpc.ClientConn) BeaconChainClient {
-	return &beaconChainClient{cc}
+func (m *ValidatorBalanceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBalanceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBalanceHistoryRequest.DiscardUnknown(m)
 }
 
-func (c *beaconChainClient) ListAttestations(ctx context.Context, in *ListAttestationsRequest, opts ...grpc.CallOption) (*ListAttestationsResponse, error) {
-	out := new(ListAttestationsResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListAttestations", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorBalanceHistoryRequest proto.InternalMessageInfo
+
+func (m *ValidatorBalanceHistoryRequest) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	return out, nil
+	return 0
 }
 
-func (c *beaconChainClient) AttestationPool(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*AttestationPoolResponse, error) {
-	out := new(AttestationPoolResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/AttestationPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorBalanceHistoryRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	return out, nil
+	return nil
 }
 
-func (c *beaconChainClient) ListBlocks(ctx context.Context, in *ListBlocksRequest, opts ...grpc.CallOption) (*ListBlocksResponse, error) {
-	out := new(ListBlocksResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListBlocks", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorBalanceHistoryRequest) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
 	}
-	return out, nil
+	return 0
 }
 
-func (c *beaconChainClient) GetChainHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ChainHead, error) {
-	out := new(ChainHead)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetChainHead", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorBalanceHistoryRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
 	}
-	return out, nil
+	return 0
 }
 
-func (c *beaconChainClient) ListValidatorBalances(ctx context.Context, in *GetValidatorBalancesRequest, opts ...grpc.CallOption) (*ValidatorBalances, error) {
-	out := new(ValidatorBalances)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorBalances", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorBalanceHistoryRequest) GetMaxPoints() uint64 {
+	if m != nil {
+		return m.MaxPoints
 	}
-	return out, nil
+	return 0
 }
 
-func (c *beaconChainClient) GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*Validators, error) {
-	out := new(Validators)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ValidatorBalanceHistory struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Epochs               []uint64 `protobuf:"varint,2,rep,name=epochs,packed,proto3" json:"epochs,omitempty"`
+	Balances             []uint64 `protobuf:"varint,3,rep,name=balances,packed,proto3" json:"balances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *beaconChainClient) GetValidatorActiveSetChanges(ctx context.Context, in *GetValidatorActiveSetChangesRequest, opts ...grpc.CallOption) (*ActiveSetChanges, error) {
-	out := new(ActiveSetChanges)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorActiveSetChanges", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorBalanceHistory) Reset()         { *m = ValidatorBalanceHistory{} }
+func (m *ValidatorBalanceHistory) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBalanceHistory) ProtoMessage()    {}
+func (*ValidatorBalanceHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{18}
+}
+func (m *ValidatorBalanceHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBalanceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBalanceHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ValidatorBalanceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBalanceHistory.Merge(m, src)
+}
+func (m *ValidatorBalanceHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBalanceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBalanceHistory.DiscardUnknown(m)
 }
 
-func (c *beaconChainClient) GetValidatorQueue(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ValidatorQueue, error) {
-	out := new(ValidatorQueue)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorBalanceHistory proto.InternalMessageInfo
+
+func (m *ValidatorBalanceHistory) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	return out, nil
+	return 0
 }
 
-func (c *beaconChainClient) ListValidatorAssignments(ctx context.Context, in *ListValidatorAssignmentsRequest, opts ...grpc.CallOption) (*ValidatorAssignments, error) {
-	out := new(ValidatorAssignments)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorBalanceHistory) GetEpochs() []uint64 {
+	if m != nil {
+		return m.Epochs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *beaconChainClient) GetValidatorParticipation(ctx context.Context, in *GetValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipation, error) {
-	out := new(ValidatorParticipation)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorParticipation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorBalanceHistory) GetBalances() []uint64 {
+	if m != nil {
+		return m.Balances
 	}
-	return out, nil
+	return nil
 }
 
-// BeaconChainServer is the server API for BeaconChain service.
-type BeaconChainServer interface {
-	ListAttestations(context.Context, *ListAttestationsRequest) (*ListAttestationsResponse, error)
-	AttestationPool(context.Context, *types.Empty) (*AttestationPoolResponse, error)
-	ListBlocks(context.Context, *ListBlocksRequest) (*ListBlocksResponse, error)
-	GetChainHead(context.Context, *types.Empty) (*ChainHead, error)
-	ListValidatorBalances(context.Context, *GetValidatorBalancesRequest) (*ValidatorBalances, error)
-	GetValidators(context.Context, *GetValidatorsRequest) (*Validators, error)
-	GetValidatorActiveSetChanges(context.Context, *GetValidatorActiveSetChangesRequest) (*ActiveSetChanges, error)
-	GetValidatorQueue(context.Context, *types.Empty) (*ValidatorQueue, error)
-	ListValidatorAssignments(context.Context, *ListValidatorAssignmentsRequest) (*ValidatorAssignments, error)
-	GetValidatorParticipation(context.Context, *GetValidatorParticipationRequest) (*ValidatorParticipation, error)
+type ListCommitteesRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterBeaconChainServer(s *grpc.Server, srv BeaconChainServer) {
-	s.RegisterService(&_BeaconChain_serviceDesc, srv)
+func (m *ListCommitteesRequest) Reset()         { *m = ListCommitteesRequest{} }
+func (m *ListCommitteesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCommitteesRequest) ProtoMessage()    {}
+func (*ListCommitteesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{19}
 }
-
-func _BeaconChain_ListAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAttestationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).ListAttestations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListAttestations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).ListAttestations(ctx, req.(*ListAttestationsRequest))
+func (m *ListCommitteesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCommitteesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCommitteesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ListCommitteesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCommitteesRequest.Merge(m, src)
+}
+func (m *ListCommitteesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCommitteesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCommitteesRequest.DiscardUnknown(m)
 }
 
-func _BeaconChain_AttestationPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).AttestationPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/AttestationPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).AttestationPool(ctx, req.(*types.Empty))
+var xxx_messageInfo_ListCommitteesRequest proto.InternalMessageInfo
+
+func (m *ListCommitteesRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _BeaconChain_ListBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBlocksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).ListBlocks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListBlocks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).ListBlocks(ctx, req.(*ListBlocksRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type Committees struct {
+	Epoch                uint64                  `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Committees           []*Committees_Committee `protobuf:"bytes,2,rep,name=committees,proto3" json:"committees,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func _BeaconChain_GetChainHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).GetChainHead(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetChainHead",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).GetChainHead(ctx, req.(*types.Empty))
+func (m *Committees) Reset()         { *m = Committees{} }
+func (m *Committees) String() string { return proto.CompactTextString(m) }
+func (*Committees) ProtoMessage()    {}
+func (*Committees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{20}
+}
+func (m *Committees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Committees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Committees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *Committees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Committees.Merge(m, src)
+}
+func (m *Committees) XXX_Size() int {
+	return m.Size()
+}
+func (m *Committees) XXX_DiscardUnknown() {
+	xxx_messageInfo_Committees.DiscardUnknown(m)
 }
 
-func _BeaconChain_ListValidatorBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetValidatorBalancesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).ListValidatorBalances(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorBalances",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).ListValidatorBalances(ctx, req.(*GetValidatorBalancesRequest))
+var xxx_messageInfo_Committees proto.InternalMessageInfo
+
+func (m *Committees) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _BeaconChain_GetValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).GetValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).GetValidators(ctx, req.(*GetValidatorsRequest))
+func (m *Committees) GetCommittees() []*Committees_Committee {
+	if m != nil {
+		return m.Committees
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _BeaconChain_GetValidatorActiveSetChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetValidatorActiveSetChangesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type Committees_Committee struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Shard                uint64   `protobuf:"varint,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	ValidatorIndices     []uint64 `protobuf:"varint,3,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Committees_Committee) Reset()         { *m = Committees_Committee{} }
+func (m *Committees_Committee) String() string { return proto.CompactTextString(m) }
+func (*Committees_Committee) ProtoMessage()    {}
+func (*Committees_Committee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{20, 0}
+}
+func (m *Committees_Committee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Committees_Committee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Committees_Committee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).GetValidatorActiveSetChanges(ctx, in)
+}
+func (m *Committees_Committee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Committees_Committee.Merge(m, src)
+}
+func (m *Committees_Committee) XXX_Size() int {
+	return m.Size()
+}
+func (m *Committees_Committee) XXX_DiscardUnknown() {
+	xxx_messageInfo_Committees_Committee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Committees_Committee proto.InternalMessageInfo
+
+func (m *Committees_Committee) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorActiveSetChanges",
+	return 0
+}
+
+func (m *Committees_Committee) GetShard() uint64 {
+	if m != nil {
+		return m.Shard
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).GetValidatorActiveSetChanges(ctx, req.(*GetValidatorActiveSetChangesRequest))
+	return 0
+}
+
+func (m *Committees_Committee) GetValidatorIndices() []uint64 {
+	if m != nil {
+		return m.ValidatorIndices
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _BeaconChain_GetValidatorQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+type ChainStats struct {
+	BlocksProcessed      uint64   `protobuf:"varint,1,opt,name=blocks_processed,json=blocksProcessed,proto3" json:"blocks_processed,omitempty"`
+	Reorgs               uint64   `protobuf:"varint,2,opt,name=reorgs,proto3" json:"reorgs,omitempty"`
+	InvalidBlocks        uint64   `protobuf:"varint,3,opt,name=invalid_blocks,json=invalidBlocks,proto3" json:"invalid_blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChainStats) Reset()         { *m = ChainStats{} }
+func (m *ChainStats) String() string { return proto.CompactTextString(m) }
+func (*ChainStats) ProtoMessage()    {}
+func (*ChainStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{21}
+}
+func (m *ChainStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).GetValidatorQueue(ctx, in)
+}
+func (m *ChainStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainStats.Merge(m, src)
+}
+func (m *ChainStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainStats proto.InternalMessageInfo
+
+func (m *ChainStats) GetBlocksProcessed() uint64 {
+	if m != nil {
+		return m.BlocksProcessed
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorQueue",
+	return 0
+}
+
+func (m *ChainStats) GetReorgs() uint64 {
+	if m != nil {
+		return m.Reorgs
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).GetValidatorQueue(ctx, req.(*types.Empty))
+	return 0
+}
+
+func (m *ChainStats) GetInvalidBlocks() uint64 {
+	if m != nil {
+		return m.InvalidBlocks
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _BeaconChain_ListValidatorAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListValidatorAssignmentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type ValidatorRegistryRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorRegistryRequest) Reset()         { *m = ValidatorRegistryRequest{} }
+func (m *ValidatorRegistryRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRegistryRequest) ProtoMessage()    {}
+func (*ValidatorRegistryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{22}
+}
+func (m *ValidatorRegistryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRegistryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRegistryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).ListValidatorAssignments(ctx, in)
+}
+func (m *ValidatorRegistryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRegistryRequest.Merge(m, src)
+}
+func (m *ValidatorRegistryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRegistryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRegistryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRegistryRequest proto.InternalMessageInfo
+
+func (m *ValidatorRegistryRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments",
+	return 0
+}
+
+type ValidatorRegistryEntry struct {
+	Epoch                uint64     `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Index                uint64     `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Validator            *Validator `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	Balance              uint64     `protobuf:"varint,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	Status               string     `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ValidatorRegistryEntry) Reset()         { *m = ValidatorRegistryEntry{} }
+func (m *ValidatorRegistryEntry) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRegistryEntry) ProtoMessage()    {}
+func (*ValidatorRegistryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{23}
+}
+func (m *ValidatorRegistryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRegistryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRegistryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).ListValidatorAssignments(ctx, req.(*ListValidatorAssignmentsRequest))
+}
+func (m *ValidatorRegistryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRegistryEntry.Merge(m, src)
+}
+func (m *ValidatorRegistryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRegistryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRegistryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRegistryEntry proto.InternalMessageInfo
+
+func (m *ValidatorRegistryEntry) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _BeaconChain_GetValidatorParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetValidatorParticipationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ValidatorRegistryEntry) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).GetValidatorParticipation(ctx, in)
+	return 0
+}
+
+func (m *ValidatorRegistryEntry) GetValidator() *Validator {
+	if m != nil {
+		return m.Validator
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorParticipation",
+	return nil
+}
+
+func (m *ValidatorRegistryEntry) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).GetValidatorParticipation(ctx, req.(*GetValidatorParticipationRequest))
+	return 0
+}
+
+func (m *ValidatorRegistryEntry) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _BeaconChain_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.eth.v1alpha1.BeaconChain",
-	HandlerType: (*BeaconChainServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ListAttestations",
-			Handler:    _BeaconChain_ListAttestations_Handler,
-		},
-		{
-			MethodName: "AttestationPool",
-			Handler:    _BeaconChain_AttestationPool_Handler,
-		},
-		{
-			MethodName: "ListBlocks",
-			Handler:    _BeaconChain_ListBlocks_Handler,
-		},
-		{
-			MethodName: "GetChainHead",
-			Handler:    _BeaconChain_GetChainHead_Handler,
-		},
-		{
-			MethodName: "ListValidatorBalances",
-			Handler:    _BeaconChain_ListValidatorBalances_Handler,
-		},
-		{
-			MethodName: "GetValidators",
-			Handler:    _BeaconChain_GetValidators_Handler,
-		},
-		{
-			MethodName: "GetValidatorActiveSetChanges",
-			Handler:    _BeaconChain_GetValidatorActiveSetChanges_Handler,
-		},
-		{
-			MethodName: "GetValidatorQueue",
-			Handler:    _BeaconChain_GetValidatorQueue_Handler,
-		},
-		{
-			MethodName: "ListValidatorAssignments",
-			Handler:    _BeaconChain_ListValidatorAssignments_Handler,
-		},
-		{
-			MethodName: "GetValidatorParticipation",
-			Handler:    _BeaconChain_GetValidatorParticipation_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/eth/v1alpha1/beacon_chain.proto",
+type StuckDeposits struct {
+	Eth1DepositIndex       uint64                   `protobuf:"varint,1,opt,name=eth1_deposit_index,json=eth1DepositIndex,proto3" json:"eth1_deposit_index,omitempty"`
+	InclusionWindowSeconds uint64                   `protobuf:"varint,2,opt,name=inclusion_window_seconds,json=inclusionWindowSeconds,proto3" json:"inclusion_window_seconds,omitempty"`
+	Deposits               []*StuckDeposits_Deposit `protobuf:"bytes,3,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                 `json:"-"`
+	XXX_unrecognized       []byte                   `json:"-"`
+	XXX_sizecache          int32                    `json:"-"`
 }
 
-func (m *ListAttestationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *StuckDeposits) Reset()         { *m = StuckDeposits{} }
+func (m *StuckDeposits) String() string { return proto.CompactTextString(m) }
+func (*StuckDeposits) ProtoMessage()    {}
+func (*StuckDeposits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{24}
 }
-
-func (m *ListAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.QueryFilter != nil {
-		nn1, err := m.QueryFilter.MarshalTo(dAtA[i:])
+func (m *StuckDeposits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StuckDeposits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StuckDeposits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += nn1
-	}
-	if m.PageSize != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PageSize))
-	}
-	if len(m.PageToken) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PageToken)))
-		i += copy(dAtA[i:], m.PageToken)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return i, nil
 }
-
-func (m *ListAttestationsRequest_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.BlockRoot != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.BlockRoot)))
-		i += copy(dAtA[i:], m.BlockRoot)
-	}
-	return i, nil
+func (m *StuckDeposits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StuckDeposits.Merge(m, src)
 }
-func (m *ListAttestationsRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
-	return i, nil
+func (m *StuckDeposits) XXX_Size() int {
+	return m.Size()
 }
-func (m *ListAttestationsRequest_Epoch) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
-	return i, nil
+func (m *StuckDeposits) XXX_DiscardUnknown() {
+	xxx_messageInfo_StuckDeposits.DiscardUnknown(m)
 }
-func (m *ListAttestationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+
+var xxx_messageInfo_StuckDeposits proto.InternalMessageInfo
+
+func (m *StuckDeposits) GetEth1DepositIndex() uint64 {
+	if m != nil {
+		return m.Eth1DepositIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ListAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Attestations) > 0 {
-		for _, msg := range m.Attestations {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.NextPageToken) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.NextPageToken)))
-		i += copy(dAtA[i:], m.NextPageToken)
-	}
-	if m.TotalSize != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalSize))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *StuckDeposits) GetInclusionWindowSeconds() uint64 {
+	if m != nil {
+		return m.InclusionWindowSeconds
 	}
-	return i, nil
+	return 0
 }
 
-func (m *ListBlocksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *StuckDeposits) GetDeposits() []*StuckDeposits_Deposit {
+	if m != nil {
+		return m.Deposits
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ListBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.QueryFilter != nil {
-		nn2, err := m.QueryFilter.MarshalTo(dAtA[i:])
+type StuckDeposits_Deposit struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PublicKey            []byte   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Eth1BlockNumber      uint64   `protobuf:"varint,3,opt,name=eth1_block_number,json=eth1BlockNumber,proto3" json:"eth1_block_number,omitempty"`
+	PendingSeconds       uint64   `protobuf:"varint,4,opt,name=pending_seconds,json=pendingSeconds,proto3" json:"pending_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StuckDeposits_Deposit) Reset()         { *m = StuckDeposits_Deposit{} }
+func (m *StuckDeposits_Deposit) String() string { return proto.CompactTextString(m) }
+func (*StuckDeposits_Deposit) ProtoMessage()    {}
+func (*StuckDeposits_Deposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{24, 0}
+}
+func (m *StuckDeposits_Deposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StuckDeposits_Deposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StuckDeposits_Deposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += nn2
+		return b[:n], nil
 	}
-	if m.PageSize != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PageSize))
+}
+func (m *StuckDeposits_Deposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StuckDeposits_Deposit.Merge(m, src)
+}
+func (m *StuckDeposits_Deposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *StuckDeposits_Deposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_StuckDeposits_Deposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StuckDeposits_Deposit proto.InternalMessageInfo
+
+func (m *StuckDeposits_Deposit) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	if len(m.PageToken) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PageToken)))
-		i += copy(dAtA[i:], m.PageToken)
+	return 0
+}
+
+func (m *StuckDeposits_Deposit) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return nil
+}
+
+func (m *StuckDeposits_Deposit) GetEth1BlockNumber() uint64 {
+	if m != nil {
+		return m.Eth1BlockNumber
 	}
-	return i, nil
+	return 0
 }
 
-func (m *ListBlocksRequest_Root) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Root != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.Root)))
-		i += copy(dAtA[i:], m.Root)
+func (m *StuckDeposits_Deposit) GetPendingSeconds() uint64 {
+	if m != nil {
+		return m.PendingSeconds
 	}
-	return i, nil
+	return 0
 }
-func (m *ListBlocksRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
-	return i, nil
+
+type CanonicalBlocksRequest struct {
+	StartSlot            uint64   `protobuf:"varint,1,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	EndSlot              uint64   `protobuf:"varint,2,opt,name=end_slot,json=endSlot,proto3" json:"end_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ListBlocksRequest_Epoch) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
-	return i, nil
+
+func (m *CanonicalBlocksRequest) Reset()         { *m = CanonicalBlocksRequest{} }
+func (m *CanonicalBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*CanonicalBlocksRequest) ProtoMessage()    {}
+func (*CanonicalBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{25}
 }
-func (m *ListBlocksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CanonicalBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanonicalBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanonicalBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *CanonicalBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalBlocksRequest.Merge(m, src)
+}
+func (m *CanonicalBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanonicalBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalBlocksRequest.DiscardUnknown(m)
 }
 
-func (m *ListBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Blocks) > 0 {
-		for _, msg := range m.Blocks {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.NextPageToken) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.NextPageToken)))
-		i += copy(dAtA[i:], m.NextPageToken)
-	}
-	if m.TotalSize != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalSize))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
-}
+var xxx_messageInfo_CanonicalBlocksRequest proto.InternalMessageInfo
 
-func (m *ChainHead) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CanonicalBlocksRequest) GetStartSlot() uint64 {
+	if m != nil {
+		return m.StartSlot
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ChainHead) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlockRoot) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.BlockRoot)))
-		i += copy(dAtA[i:], m.BlockRoot)
-	}
-	if m.BlockSlot != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.BlockSlot))
-	}
-	if m.FinalizedSlot != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.FinalizedSlot))
-	}
-	if len(m.FinalizedBlockRoot) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.FinalizedBlockRoot)))
-		i += copy(dAtA[i:], m.FinalizedBlockRoot)
-	}
-	if m.JustifiedSlot != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.JustifiedSlot))
-	}
-	if len(m.JustifiedBlockRoot) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.JustifiedBlockRoot)))
-		i += copy(dAtA[i:], m.JustifiedBlockRoot)
-	}
-	if m.PreviousJustifiedSlot != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PreviousJustifiedSlot))
-	}
-	if len(m.PreviousJustifiedBlockRoot) > 0 {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PreviousJustifiedBlockRoot)))
-		i += copy(dAtA[i:], m.PreviousJustifiedBlockRoot)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *CanonicalBlocksRequest) GetEndSlot() uint64 {
+	if m != nil {
+		return m.EndSlot
 	}
-	return i, nil
+	return 0
 }
 
-func (m *GetValidatorBalancesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type CanonicalBlockRecord struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Root                 []byte   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	ParentRoot           []byte   `protobuf:"bytes,3,opt,name=parent_root,json=parentRoot,proto3" json:"parent_root,omitempty"`
+	ProposerIndex        uint64   `protobuf:"varint,4,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	ProposerKnown        bool     `protobuf:"varint,5,opt,name=proposer_known,json=proposerKnown,proto3" json:"proposer_known,omitempty"`
+	AttestationCount     uint64   `protobuf:"varint,6,opt,name=attestation_count,json=attestationCount,proto3" json:"attestation_count,omitempty"`
+	DepositCount         uint64   `protobuf:"varint,7,opt,name=deposit_count,json=depositCount,proto3" json:"deposit_count,omitempty"`
+	Graffiti             []byte   `protobuf:"bytes,8,opt,name=graffiti,proto3" json:"graffiti,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetValidatorBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
-	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if len(m.Indices) > 0 {
-		dAtA4 := make([]byte, len(m.Indices)*10)
-		var j3 int
-		for _, num := range m.Indices {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
+func (m *CanonicalBlockRecord) Reset()         { *m = CanonicalBlockRecord{} }
+func (m *CanonicalBlockRecord) String() string { return proto.CompactTextString(m) }
+func (*CanonicalBlockRecord) ProtoMessage()    {}
+func (*CanonicalBlockRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{26}
+}
+func (m *CanonicalBlockRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanonicalBlockRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanonicalBlockRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(j3))
-		i += copy(dAtA[i:], dAtA4[:j3])
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *CanonicalBlockRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalBlockRecord.Merge(m, src)
+}
+func (m *CanonicalBlockRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanonicalBlockRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalBlockRecord.DiscardUnknown(m)
 }
 
-func (m *ValidatorBalances) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CanonicalBlockRecord proto.InternalMessageInfo
+
+func (m *CanonicalBlockRecord) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ValidatorBalances) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Balances) > 0 {
-		for _, msg := range m.Balances {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *CanonicalBlockRecord) GetRoot() []byte {
+	if m != nil {
+		return m.Root
 	}
-	return i, nil
+	return nil
 }
 
-func (m *ValidatorBalances_Balance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CanonicalBlockRecord) GetParentRoot() []byte {
+	if m != nil {
+		return m.ParentRoot
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ValidatorBalances_Balance) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
-	}
-	if m.Index != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Index))
+func (m *CanonicalBlockRecord) GetProposerIndex() uint64 {
+	if m != nil {
+		return m.ProposerIndex
 	}
-	if m.Balance != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Balance))
+	return 0
+}
+
+func (m *CanonicalBlockRecord) GetProposerKnown() bool {
+	if m != nil {
+		return m.ProposerKnown
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return false
+}
+
+func (m *CanonicalBlockRecord) GetAttestationCount() uint64 {
+	if m != nil {
+		return m.AttestationCount
 	}
-	return i, nil
+	return 0
 }
 
-func (m *GetValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CanonicalBlockRecord) GetDepositCount() uint64 {
+	if m != nil {
+		return m.DepositCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.QueryFilter != nil {
-		nn5, err := m.QueryFilter.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += nn5
+func (m *CanonicalBlockRecord) GetGraffiti() []byte {
+	if m != nil {
+		return m.Graffiti
 	}
-	if m.PageSize != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PageSize))
-	}
-	if len(m.PageToken) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PageToken)))
-		i += copy(dAtA[i:], m.PageToken)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+	return nil
 }
 
-func (m *GetValidatorsRequest_Epoch) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
-	return i, nil
+func init() {
+	proto.RegisterType((*ListAttestationsRequest)(nil), "ethereum.eth.v1alpha1.ListAttestationsRequest")
+	proto.RegisterType((*ListAttestationsResponse)(nil), "ethereum.eth.v1alpha1.ListAttestationsResponse")
+	proto.RegisterType((*ListBlocksRequest)(nil), "ethereum.eth.v1alpha1.ListBlocksRequest")
+	proto.RegisterType((*ListBlocksResponse)(nil), "ethereum.eth.v1alpha1.ListBlocksResponse")
+	proto.RegisterType((*ChainHead)(nil), "ethereum.eth.v1alpha1.ChainHead")
+	proto.RegisterType((*GetValidatorBalancesRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorBalancesRequest")
+	proto.RegisterType((*ValidatorBalances)(nil), "ethereum.eth.v1alpha1.ValidatorBalances")
+	proto.RegisterType((*ValidatorBalances_Balance)(nil), "ethereum.eth.v1alpha1.ValidatorBalances.Balance")
+	proto.RegisterType((*GetValidatorsRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorsRequest")
+	proto.RegisterType((*Validators)(nil), "ethereum.eth.v1alpha1.Validators")
+	proto.RegisterType((*GetValidatorActiveSetChangesRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorActiveSetChangesRequest")
+	proto.RegisterType((*ActiveSetChanges)(nil), "ethereum.eth.v1alpha1.ActiveSetChanges")
+	proto.RegisterType((*ValidatorQueue)(nil), "ethereum.eth.v1alpha1.ValidatorQueue")
+	proto.RegisterType((*ListValidatorAssignmentsRequest)(nil), "ethereum.eth.v1alpha1.ListValidatorAssignmentsRequest")
+	proto.RegisterType((*ValidatorAssignments)(nil), "ethereum.eth.v1alpha1.ValidatorAssignments")
+	proto.RegisterType((*ValidatorAssignments_CommitteeAssignment)(nil), "ethereum.eth.v1alpha1.ValidatorAssignments.CommitteeAssignment")
+	proto.RegisterType((*GetValidatorParticipationRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorParticipationRequest")
+	proto.RegisterType((*ValidatorParticipation)(nil), "ethereum.eth.v1alpha1.ValidatorParticipation")
+	proto.RegisterType((*AttestationPoolResponse)(nil), "ethereum.eth.v1alpha1.AttestationPoolResponse")
+	proto.RegisterType((*ValidatorBalanceHistoryRequest)(nil), "ethereum.eth.v1alpha1.ValidatorBalanceHistoryRequest")
+	proto.RegisterType((*ValidatorBalanceHistory)(nil), "ethereum.eth.v1alpha1.ValidatorBalanceHistory")
+	proto.RegisterType((*ListCommitteesRequest)(nil), "ethereum.eth.v1alpha1.ListCommitteesRequest")
+	proto.RegisterType((*Committees)(nil), "ethereum.eth.v1alpha1.Committees")
+	proto.RegisterType((*Committees_Committee)(nil), "ethereum.eth.v1alpha1.Committees.Committee")
+	proto.RegisterType((*ChainStats)(nil), "ethereum.eth.v1alpha1.ChainStats")
+	proto.RegisterType((*ValidatorRegistryRequest)(nil), "ethereum.eth.v1alpha1.ValidatorRegistryRequest")
+	proto.RegisterType((*ValidatorRegistryEntry)(nil), "ethereum.eth.v1alpha1.ValidatorRegistryEntry")
+	proto.RegisterType((*StuckDeposits)(nil), "ethereum.eth.v1alpha1.StuckDeposits")
+	proto.RegisterType((*StuckDeposits_Deposit)(nil), "ethereum.eth.v1alpha1.StuckDeposits.Deposit")
+	proto.RegisterType((*CanonicalBlocksRequest)(nil), "ethereum.eth.v1alpha1.CanonicalBlocksRequest")
+	proto.RegisterType((*CanonicalBlockRecord)(nil), "ethereum.eth.v1alpha1.CanonicalBlockRecord")
 }
-func (m *GetValidatorsRequest_Genesis) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x10
-	i++
-	if m.Genesis {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i++
-	return i, nil
+
+func init() {
+	proto.RegisterFile("proto/eth/v1alpha1/beacon_chain.proto", fileDescriptor_678c88b69c3c78d4)
 }
-func (m *Validators) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+
+var fileDescriptor_678c88b69c3c78d4 = []byte{
+	// 2240 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0x7b, 0x26, 0xb1, 0xfd, 0xfc, 0x5d, 0x71, 0xec, 0x49, 0x27, 0x8e, 0x27, 0x9d, 0x2f,
+	0x07, 0x27, 0x33, 0x89, 0xf7, 0x83, 0x28, 0x2b, 0x58, 0x62, 0x13, 0xe2, 0x90, 0x08, 0x99, 0xf6,
+	0x0a, 0x24, 0x2e, 0xa3, 0x9e, 0x9e, 0xf2, 0x4c, 0xad, 0x67, 0xba, 0x3a, 0x5d, 0x35, 0x4e, 0x1c,
+	0x24, 0x24, 0x40, 0x42, 0x82, 0xeb, 0x4a, 0x20, 0x84, 0x84, 0x40, 0x9c, 0x10, 0xe2, 0x02, 0x68,
+	0x2f, 0x5c, 0x10, 0x5c, 0x38, 0xa1, 0x95, 0xf6, 0xc0, 0x6d, 0x85, 0x22, 0xfe, 0x82, 0xbd, 0x71,
+	0x43, 0xf5, 0xd1, 0xdd, 0xd5, 0xe3, 0xee, 0x99, 0x59, 0x88, 0xe0, 0x36, 0xf5, 0xea, 0xbd, 0x7a,
+	0xbf, 0xf7, 0xfa, 0xbd, 0x57, 0xef, 0xd5, 0xc0, 0xb5, 0x30, 0xa2, 0x9c, 0xd6, 0x31, 0xef, 0xd4,
+	0x8f, 0xee, 0x7a, 0xdd, 0xb0, 0xe3, 0xdd, 0xad, 0x37, 0xb1, 0xe7, 0xd3, 0xa0, 0xe1, 0x77, 0x3c,
+	0x12, 0xd4, 0xe4, 0x3e, 0x3a, 0x87, 0x79, 0x07, 0x47, 0xb8, 0xdf, 0xab, 0x61, 0xde, 0xa9, 0xc5,
+	0x9c, 0xf6, 0xed, 0x36, 0xe1, 0x9d, 0x7e, 0xb3, 0xe6, 0xd3, 0x5e, 0xbd, 0x4d, 0xdb, 0xb4, 0x2e,
+	0xb9, 0x9b, 0xfd, 0x03, 0xb9, 0x52, 0x47, 0x8b, 0x5f, 0xea, 0x14, 0xfb, 0x62, 0x9b, 0xd2, 0x76,
+	0x17, 0xd7, 0xbd, 0x90, 0xd4, 0xbd, 0x20, 0xa0, 0xdc, 0xe3, 0x84, 0x06, 0x4c, 0xef, 0x5e, 0xd0,
+	0xbb, 0xc9, 0x19, 0xb8, 0x17, 0xf2, 0x63, 0xbd, 0x79, 0x35, 0x07, 0xa7, 0xc7, 0x39, 0x66, 0xea,
+	0x0c, 0xcd, 0x35, 0xc4, 0x9a, 0x66, 0x97, 0xfa, 0x87, 0x9a, 0xcd, 0xc9, 0x61, 0x3b, 0xf2, 0xba,
+	0xa4, 0xe5, 0x71, 0x1a, 0x29, 0x1e, 0xe7, 0x0f, 0x16, 0xac, 0x3e, 0x25, 0x8c, 0x3f, 0x48, 0x95,
+	0x30, 0x17, 0x3f, 0xeb, 0x63, 0xc6, 0xd1, 0x3a, 0x80, 0x3c, 0xae, 0x11, 0x51, 0xca, 0x2b, 0x56,
+	0xd5, 0xda, 0x98, 0xdd, 0x3d, 0xe5, 0x4e, 0x4b, 0x9a, 0x4b, 0x29, 0x47, 0xcb, 0x50, 0x66, 0x5d,
+	0xca, 0x2b, 0x13, 0x55, 0x6b, 0xa3, 0xbc, 0x7b, 0xca, 0x95, 0x2b, 0xb4, 0x02, 0xa7, 0x71, 0x48,
+	0xfd, 0x4e, 0xa5, 0xa4, 0xc9, 0x6a, 0x89, 0x2e, 0xc0, 0x74, 0xe8, 0xb5, 0x71, 0x83, 0x91, 0x97,
+	0xb8, 0x52, 0xae, 0x5a, 0x1b, 0xa7, 0xdd, 0x29, 0x41, 0xd8, 0x27, 0x2f, 0x31, 0x5a, 0x03, 0x90,
+	0x9b, 0x9c, 0x1e, 0xe2, 0xa0, 0x72, 0xba, 0x6a, 0x6d, 0x4c, 0xbb, 0x92, 0xfd, 0x3d, 0x41, 0xd8,
+	0x9e, 0x87, 0xd9, 0x67, 0x7d, 0x1c, 0x1d, 0x37, 0x0e, 0x48, 0x97, 0xe3, 0xc8, 0xf9, 0xb5, 0x05,
+	0x95, 0x93, 0xb0, 0x59, 0x48, 0x03, 0x86, 0xd1, 0x57, 0x60, 0xd6, 0xf0, 0x19, 0xab, 0x58, 0xd5,
+	0xd2, 0xc6, 0xcc, 0x96, 0x53, 0xcb, 0xfd, 0xb8, 0x35, 0xe3, 0x08, 0x37, 0x23, 0x87, 0xae, 0xc3,
+	0x42, 0x80, 0x5f, 0xf0, 0x86, 0x01, 0x6c, 0x42, 0x02, 0x9b, 0x13, 0xe4, 0xbd, 0x18, 0x9c, 0xc0,
+	0xce, 0x29, 0xf7, 0xba, 0xca, 0xb2, 0x92, 0xb4, 0x6c, 0x5a, 0x52, 0x84, 0x69, 0xce, 0xaf, 0x2c,
+	0x58, 0x12, 0x58, 0xb7, 0x85, 0xdf, 0x12, 0xe7, 0x2e, 0x43, 0x39, 0xe3, 0x56, 0xb9, 0xfa, 0x3f,
+	0x7a, 0xf4, 0x27, 0x16, 0x20, 0x13, 0xa5, 0xf6, 0xe5, 0x7d, 0x38, 0x23, 0xbf, 0xf7, 0x28, 0x2f,
+	0x6e, 0xcb, 0xf0, 0x93, 0xc2, 0xae, 0x96, 0x78, 0x5d, 0xfe, 0xfb, 0x73, 0x09, 0xa6, 0x77, 0x44,
+	0x92, 0xee, 0x62, 0xaf, 0x85, 0xee, 0x9c, 0x0c, 0xca, 0xed, 0xa5, 0x4f, 0x3f, 0x59, 0x9f, 0x63,
+	0xec, 0xe5, 0x6d, 0x71, 0xc0, 0x7d, 0xe7, 0x8d, 0x2d, 0xc7, 0x8c, 0xd2, 0xb5, 0x58, 0x22, 0xf5,
+	0xac, 0xde, 0xde, 0x17, 0xce, 0xbd, 0x06, 0xf3, 0x07, 0x24, 0xf0, 0xba, 0xe4, 0x25, 0x6e, 0x29,
+	0x16, 0xe9, 0x65, 0x77, 0x2e, 0xa1, 0x4a, 0xb6, 0x1d, 0x58, 0x4e, 0xd9, 0x0c, 0x04, 0xe5, 0x22,
+	0x04, 0x28, 0x61, 0xdf, 0x4e, 0xa0, 0x5c, 0x83, 0xf9, 0xf7, 0xfb, 0x8c, 0x93, 0x03, 0x12, 0xeb,
+	0x3a, 0xad, 0x74, 0x25, 0xd4, 0x58, 0x57, 0xca, 0x66, 0xe8, 0x3a, 0x53, 0xa8, 0x2b, 0x61, 0x4f,
+	0x75, 0xbd, 0x0d, 0xab, 0x61, 0x84, 0x8f, 0x08, 0xed, 0xb3, 0xc6, 0x80, 0xd2, 0x49, 0xa9, 0xf4,
+	0x5c, 0xbc, 0xfd, 0xd5, 0x8c, 0xf2, 0xf7, 0x60, 0x2d, 0x47, 0xce, 0x40, 0x31, 0x55, 0x84, 0xc2,
+	0x3e, 0x71, 0x60, 0x82, 0xc6, 0xf9, 0xbe, 0x05, 0x17, 0x1e, 0x61, 0xfe, 0x8d, 0xb8, 0xfc, 0x6c,
+	0x7b, 0x5d, 0x2f, 0xf0, 0xb1, 0x91, 0x0e, 0x3a, 0xc4, 0x2d, 0x89, 0x4d, 0x2d, 0xd0, 0x9b, 0x30,
+	0x13, 0xf6, 0x9b, 0x5d, 0xe2, 0x37, 0x0e, 0xf1, 0x31, 0xab, 0x4c, 0x54, 0x4b, 0x1b, 0xb3, 0xdb,
+	0x67, 0x3f, 0xfd, 0x64, 0x7d, 0x21, 0xd5, 0xfc, 0xee, 0xad, 0x37, 0xef, 0x39, 0x2e, 0x28, 0xbe,
+	0x27, 0xf8, 0x98, 0xa1, 0x0a, 0x4c, 0x92, 0xa0, 0x45, 0x7c, 0xcc, 0x2a, 0xa5, 0x6a, 0x69, 0xa3,
+	0xec, 0xc6, 0x4b, 0xe7, 0x6f, 0x16, 0x2c, 0x9d, 0x80, 0x80, 0x9e, 0xc2, 0x54, 0x53, 0xff, 0xd6,
+	0x51, 0x7e, 0xa7, 0x20, 0xca, 0x4f, 0xc8, 0xd6, 0xf4, 0x0f, 0x37, 0x39, 0xc1, 0x3e, 0x84, 0x49,
+	0x4d, 0x14, 0xb1, 0x9a, 0xc2, 0xcf, 0x8f, 0x55, 0x81, 0x7d, 0x3a, 0xc1, 0x2e, 0xdc, 0x40, 0x82,
+	0x16, 0x7e, 0xa1, 0xc3, 0x54, 0x2d, 0x84, 0x41, 0xfa, 0x78, 0x1d, 0x9b, 0xf1, 0xd2, 0xf9, 0xb1,
+	0x05, 0xcb, 0xa6, 0x5b, 0x13, 0x7f, 0xae, 0x64, 0xfc, 0x99, 0x96, 0x0c, 0x1b, 0x26, 0xdb, 0x38,
+	0xc0, 0x8c, 0x30, 0xa9, 0x62, 0x6a, 0xf7, 0x94, 0x1b, 0x13, 0xb2, 0xe5, 0xa4, 0x34, 0xb4, 0x9c,
+	0x94, 0x47, 0x95, 0x93, 0xdf, 0x58, 0x00, 0x29, 0xaa, 0x82, 0xcf, 0xfb, 0x25, 0x80, 0xe4, 0x3e,
+	0x52, 0x5f, 0x77, 0x66, 0xab, 0x3a, 0xca, 0xf5, 0xae, 0x21, 0x93, 0x57, 0x62, 0x4a, 0xa3, 0x4b,
+	0x4c, 0x79, 0xb0, 0xc4, 0xbc, 0x03, 0x57, 0x4c, 0x2f, 0x3e, 0xf0, 0x39, 0x39, 0xc2, 0xfb, 0x98,
+	0xef, 0x74, 0xbc, 0xa0, 0x3d, 0x22, 0x48, 0x9d, 0x7f, 0x59, 0xb0, 0x38, 0x28, 0x51, 0x60, 0xf0,
+	0x23, 0x38, 0xe7, 0x09, 0x4e, 0x8f, 0xe3, 0x56, 0x63, 0xcc, 0xc8, 0x3e, 0x9b, 0x48, 0xec, 0xa5,
+	0x21, 0xfe, 0x00, 0x10, 0x7e, 0x41, 0x06, 0x4f, 0x29, 0x15, 0x9f, 0xb2, 0xa8, 0xd8, 0x8d, 0x23,
+	0x76, 0xe0, 0x2c, 0x7e, 0x1f, 0xfb, 0x83, 0x67, 0x94, 0x8b, 0xcf, 0x58, 0xd2, 0xfc, 0xe9, 0x21,
+	0xce, 0x1f, 0x2d, 0x98, 0x4f, 0xdc, 0xf6, 0xf5, 0x3e, 0xee, 0x63, 0xb4, 0x0e, 0x33, 0x7e, 0xa7,
+	0x1f, 0x05, 0x8d, 0x2e, 0xe9, 0x11, 0xae, 0xed, 0x07, 0x49, 0x7a, 0x2a, 0x28, 0xe8, 0x31, 0xac,
+	0x68, 0x93, 0x08, 0x0d, 0xc6, 0xf5, 0xc2, 0x72, 0x2a, 0x62, 0xd8, 0xf0, 0x05, 0x90, 0x76, 0x8d,
+	0xeb, 0x84, 0x79, 0xc1, 0x6c, 0xa0, 0xff, 0x8b, 0x05, 0xeb, 0xe2, 0xce, 0x4b, 0x3f, 0x3c, 0x63,
+	0xa4, 0x1d, 0xf4, 0x70, 0xc0, 0xff, 0xb7, 0x85, 0xe9, 0xbf, 0xb9, 0xc9, 0x9d, 0x9f, 0x96, 0x60,
+	0x39, 0xcf, 0x82, 0x02, 0xe8, 0x1e, 0xcc, 0x78, 0x29, 0x93, 0xce, 0xba, 0x77, 0x47, 0x65, 0x9d,
+	0x71, 0x6e, 0x6d, 0x87, 0xf6, 0x7a, 0x84, 0x73, 0x8c, 0x53, 0xa2, 0x6b, 0x9e, 0xf9, 0x9a, 0xb2,
+	0xd2, 0xfe, 0x93, 0x05, 0x67, 0x73, 0x74, 0xa1, 0xbb, 0xb0, 0xec, 0x47, 0x94, 0xb1, 0x2e, 0x09,
+	0x0e, 0x1b, 0x7e, 0xcc, 0xa0, 0x6a, 0x77, 0xd9, 0x3d, 0x9b, 0xec, 0x25, 0xb2, 0xd2, 0x15, 0xac,
+	0xe3, 0x45, 0xad, 0xb8, 0xae, 0xca, 0x05, 0x42, 0xba, 0xdb, 0x52, 0x45, 0x55, 0xfe, 0x46, 0x36,
+	0x4c, 0x85, 0x11, 0x0d, 0x29, 0xc3, 0x91, 0x44, 0x34, 0xe5, 0x26, 0xeb, 0x81, 0x7a, 0x7e, 0x7a,
+	0x74, 0x3d, 0x77, 0xee, 0x41, 0xd5, 0x2c, 0x2c, 0x7b, 0x5e, 0xc4, 0x89, 0x4f, 0x42, 0xd5, 0x6d,
+	0x0e, 0xad, 0x2a, 0x1f, 0x59, 0xb0, 0x92, 0x2f, 0x57, 0xf0, 0x5d, 0x2f, 0xc2, 0x74, 0xd2, 0x71,
+	0xa8, 0xda, 0xee, 0xa6, 0x04, 0x74, 0x1f, 0xce, 0xb7, 0xbb, 0xb4, 0xe9, 0x75, 0x1b, 0xa1, 0x79,
+	0x56, 0x23, 0xf2, 0xb8, 0xaa, 0xf5, 0x13, 0xee, 0xaa, 0x62, 0xc8, 0x62, 0xf4, 0xb8, 0xcc, 0xe8,
+	0x23, 0x2a, 0xea, 0x84, 0x8c, 0x11, 0xe9, 0x95, 0xb2, 0x0b, 0x92, 0xf4, 0x50, 0x50, 0x44, 0x5b,
+	0x83, 0xbb, 0xa4, 0x4d, 0x9a, 0x5d, 0xac, 0x79, 0x74, 0x5b, 0x13, 0x53, 0x25, 0x9b, 0xe3, 0xc1,
+	0xaa, 0xd1, 0x6c, 0xef, 0x51, 0xda, 0x7d, 0xdd, 0x2d, 0xbb, 0xf3, 0x3b, 0x0b, 0x2e, 0x0d, 0x5e,
+	0xd2, 0xbb, 0x84, 0x71, 0x1a, 0x1d, 0x1b, 0xee, 0x56, 0x57, 0xac, 0x65, 0x5e, 0xb1, 0x6b, 0x99,
+	0x4f, 0x2b, 0xdc, 0x37, 0x6b, 0xde, 0xcb, 0xeb, 0x30, 0xc3, 0xb8, 0x17, 0xf1, 0x86, 0xd1, 0x87,
+	0xbb, 0x20, 0x49, 0x0f, 0xe3, 0x56, 0x1c, 0x07, 0x2d, 0xbd, 0xad, 0x3c, 0x34, 0x85, 0x83, 0x96,
+	0xda, 0x5c, 0x03, 0xe8, 0x79, 0x2f, 0x1a, 0x21, 0x25, 0x22, 0xe3, 0x94, 0x6f, 0xa6, 0x7b, 0xde,
+	0x8b, 0x3d, 0x49, 0x70, 0x7c, 0x58, 0x2d, 0xc0, 0x5c, 0x00, 0x76, 0x05, 0xce, 0x48, 0x45, 0x2a,
+	0x7b, 0xcb, 0xae, 0x5e, 0x89, 0xd8, 0x4d, 0x1a, 0x19, 0x55, 0x60, 0x92, 0xb5, 0x73, 0x1b, 0xce,
+	0x89, 0x52, 0x97, 0xe6, 0xc4, 0xf0, 0xf0, 0xfb, 0xd8, 0x02, 0xc8, 0xe6, 0x4f, 0x4e, 0xc8, 0x3d,
+	0x01, 0x30, 0xd2, 0x4f, 0x55, 0x92, 0xcd, 0x82, 0x6f, 0x96, 0x1e, 0x96, 0xfe, 0x74, 0x0d, 0x71,
+	0xbb, 0x09, 0xd3, 0xc9, 0x46, 0x92, 0x99, 0x96, 0x91, 0x99, 0xf9, 0x39, 0xbc, 0x09, 0x4b, 0x49,
+	0x3f, 0xd0, 0xc8, 0x56, 0xd7, 0xc5, 0x64, 0xe3, 0xb1, 0xa2, 0x3b, 0x47, 0x00, 0x72, 0x92, 0xd8,
+	0xe7, 0x1e, 0x67, 0xe8, 0x26, 0x2c, 0xaa, 0x49, 0xa5, 0x11, 0x46, 0xd4, 0xc7, 0x8c, 0xe1, 0x96,
+	0x56, 0xb8, 0xa0, 0xe8, 0x7b, 0x31, 0x59, 0x78, 0x3c, 0xc2, 0x34, 0x6a, 0x33, 0xad, 0x5c, 0xaf,
+	0x44, 0xe4, 0x93, 0x40, 0xaa, 0x51, 0x1d, 0x32, 0x8b, 0x87, 0x07, 0x4d, 0x55, 0xd3, 0x94, 0x73,
+	0x07, 0x2a, 0x69, 0xff, 0x82, 0xdb, 0x84, 0xf1, 0x4c, 0x3c, 0xe6, 0xf8, 0xff, 0x43, 0x33, 0xfd,
+	0x63, 0x91, 0x87, 0x01, 0x57, 0x31, 0x91, 0xf3, 0x2d, 0xf2, 0x3b, 0xc7, 0x2f, 0xc2, 0x74, 0xe2,
+	0x04, 0x09, 0x6d, 0x9c, 0x06, 0x2b, 0x15, 0x31, 0x3b, 0xcf, 0x72, 0xa6, 0xf3, 0x14, 0x1e, 0x11,
+	0x59, 0xd7, 0x67, 0xfa, 0x42, 0xd2, 0x2b, 0xe7, 0xef, 0x13, 0x30, 0xb7, 0xcf, 0xfb, 0xfe, 0xe1,
+	0x97, 0x71, 0x48, 0x19, 0xe1, 0x0c, 0xdd, 0x02, 0x84, 0x79, 0xe7, 0x6e, 0xa3, 0xa5, 0x08, 0x0d,
+	0x33, 0xa0, 0x17, 0xc5, 0x8e, 0xe6, 0x7c, 0x2c, 0x11, 0xdf, 0x83, 0x0a, 0x09, 0xfc, 0x6e, 0x9f,
+	0x89, 0xea, 0xf4, 0x9c, 0x04, 0x2d, 0xfa, 0xbc, 0xc1, 0xb0, 0x4f, 0x83, 0x56, 0xec, 0xfb, 0x95,
+	0x64, 0xff, 0x9b, 0x72, 0x7b, 0x5f, 0xed, 0xa2, 0x5d, 0x98, 0xd2, 0x2a, 0x54, 0x00, 0xcc, 0x6c,
+	0xdd, 0x2a, 0x30, 0x35, 0x83, 0xaf, 0xa6, 0x7f, 0xb8, 0x89, 0xb4, 0xfd, 0x81, 0x05, 0x93, 0x9a,
+	0xfa, 0x9f, 0x95, 0x8b, 0xcf, 0xc1, 0x92, 0x34, 0x59, 0x4d, 0x4d, 0x41, 0xbf, 0xd7, 0xc4, 0x91,
+	0x8e, 0x8c, 0x05, 0xb1, 0x21, 0xc3, 0xe2, 0x6b, 0x92, 0x8c, 0x6e, 0xc0, 0x42, 0x88, 0x83, 0x16,
+	0x09, 0xda, 0x89, 0x9d, 0xca, 0xd5, 0xf3, 0x9a, 0xac, 0xed, 0x73, 0x5c, 0x58, 0xd9, 0xf1, 0x02,
+	0x1a, 0x10, 0xdf, 0xeb, 0x66, 0xdf, 0x12, 0xd6, 0x40, 0x95, 0xa2, 0x86, 0x91, 0x33, 0xd3, 0x92,
+	0x22, 0x27, 0xba, 0xf3, 0x20, 0x4a, 0x91, 0x39, 0xfe, 0x4e, 0xe2, 0x40, 0x0e, 0x7b, 0xce, 0xcf,
+	0x26, 0x60, 0x39, 0x7b, 0xa8, 0x8b, 0x7d, 0x6a, 0x5c, 0x8d, 0x66, 0x02, 0x22, 0xfd, 0x64, 0xa1,
+	0xcc, 0x95, 0xbf, 0x45, 0x61, 0x0c, 0xbd, 0x08, 0x07, 0x5c, 0xcd, 0x86, 0x25, 0xb9, 0x05, 0x8a,
+	0x14, 0x8f, 0xbc, 0xf1, 0xfd, 0xa9, 0xbf, 0xbc, 0xb2, 0x6e, 0x2e, 0xa6, 0xaa, 0xcf, 0x6e, 0xb2,
+	0x1d, 0x06, 0xf4, 0xb9, 0xea, 0x73, 0xa6, 0x52, 0xb6, 0x27, 0x82, 0x28, 0xb2, 0xdd, 0xa8, 0xf7,
+	0x0d, 0x9f, 0xf6, 0x03, 0x35, 0x16, 0x97, 0xdd, 0x45, 0x63, 0x63, 0x47, 0xd0, 0xd1, 0x15, 0x98,
+	0x8b, 0x63, 0x4e, 0x31, 0xaa, 0xb9, 0x77, 0x56, 0x13, 0x15, 0x93, 0x0d, 0x53, 0xed, 0xc8, 0x3b,
+	0x38, 0x20, 0x9c, 0xa8, 0xc9, 0xd6, 0x4d, 0xd6, 0x5b, 0xbf, 0x44, 0x30, 0xa3, 0x1e, 0x36, 0x64,
+	0xd5, 0x40, 0x3f, 0xb7, 0x60, 0x71, 0xf0, 0xd5, 0x09, 0xd5, 0x0a, 0x82, 0xac, 0xe0, 0x55, 0xcd,
+	0xae, 0x8f, 0xcd, 0xaf, 0xee, 0x46, 0xe7, 0xe6, 0xf7, 0x3e, 0xfe, 0xe7, 0x07, 0x13, 0x57, 0xd0,
+	0xe5, 0xbc, 0x07, 0xbf, 0x7a, 0xe6, 0xc5, 0xea, 0x87, 0x16, 0x2c, 0x0c, 0x5c, 0xb1, 0x68, 0xa5,
+	0xa6, 0x1e, 0x1c, 0x6b, 0xf1, 0x83, 0x63, 0xed, 0xa1, 0x78, 0x70, 0xb4, 0x6b, 0xa3, 0x2f, 0x57,
+	0xf3, 0x8a, 0x76, 0x6a, 0x12, 0xc6, 0x06, 0xba, 0x3e, 0x12, 0x46, 0x3d, 0x14, 0x7a, 0x7f, 0x60,
+	0x01, 0xa4, 0x0f, 0x4a, 0x68, 0x63, 0x88, 0xd9, 0x99, 0x68, 0xb6, 0x6f, 0x8e, 0xc1, 0xa9, 0x31,
+	0x5d, 0x91, 0x98, 0xd6, 0xd0, 0x85, 0x5c, 0x4c, 0xfa, 0x19, 0x2a, 0x84, 0xd9, 0x47, 0x98, 0xa7,
+	0x2f, 0x48, 0x45, 0x0e, 0x29, 0x2a, 0x8c, 0x89, 0xa4, 0x73, 0x5d, 0xaa, 0xab, 0xa2, 0x4b, 0xb9,
+	0xea, 0xe4, 0x43, 0x72, 0x47, 0x68, 0xf8, 0x85, 0xa5, 0x2e, 0xdb, 0x93, 0x4f, 0x0d, 0x5b, 0x05,
+	0x3a, 0x86, 0x3c, 0x8d, 0xd8, 0x1b, 0xe3, 0x3e, 0x46, 0x14, 0x45, 0x4a, 0x3a, 0x2f, 0xd7, 0xe3,
+	0x76, 0x00, 0x7d, 0xd7, 0x82, 0x39, 0x53, 0x29, 0x43, 0x9b, 0x63, 0x40, 0x4b, 0x30, 0x5d, 0x1e,
+	0x85, 0x89, 0x39, 0x55, 0x09, 0xc6, 0x46, 0x95, 0x22, 0x30, 0xe8, 0x43, 0x0b, 0x2e, 0x0e, 0x1b,
+	0xbb, 0xd1, 0xfd, 0x31, 0x20, 0x15, 0xcc, 0xea, 0xf6, 0x8d, 0xa2, 0xf0, 0x1e, 0xe0, 0x77, 0xee,
+	0x4a, 0x9c, 0x9b, 0xe8, 0x66, 0xa1, 0xd3, 0xe4, 0xe8, 0x89, 0x19, 0xe6, 0xbe, 0xc6, 0xf5, 0x12,
+	0x96, 0x4c, 0x08, 0x6a, 0xee, 0x2d, 0x0a, 0xab, 0x6b, 0xa3, 0x5c, 0x25, 0xc5, 0x8b, 0x62, 0xcb,
+	0x80, 0xf1, 0x4c, 0xaa, 0xf9, 0xad, 0x7e, 0xf9, 0xce, 0x9d, 0xf8, 0xde, 0x1e, 0x92, 0x3a, 0x43,
+	0x86, 0x5c, 0x7b, 0xf3, 0x33, 0x8c, 0x7f, 0xce, 0x2d, 0x89, 0xf4, 0x3a, 0xba, 0x5a, 0xec, 0x30,
+	0x03, 0xd2, 0xef, 0x2d, 0x38, 0x5f, 0x38, 0x02, 0xa1, 0xcf, 0x8f, 0xf1, 0x85, 0xf3, 0x86, 0x26,
+	0xfb, 0xf6, 0x28, 0xc4, 0x19, 0xa9, 0xa2, 0xe2, 0x65, 0x60, 0xce, 0x8c, 0x45, 0x22, 0x34, 0xed,
+	0x9c, 0x9c, 0x8c, 0xdb, 0xf2, 0xb7, 0xc6, 0x4c, 0xc9, 0xec, 0xe8, 0x61, 0xd7, 0x3e, 0x9b, 0xd8,
+	0x18, 0xa1, 0x19, 0xe7, 0x73, 0xbd, 0xa3, 0x91, 0xfd, 0xc8, 0x82, 0xf9, 0x6c, 0x9f, 0x8f, 0x6e,
+	0x0d, 0x09, 0x8a, 0x13, 0xe3, 0x40, 0x61, 0x66, 0xa7, 0x9c, 0xce, 0x0d, 0x09, 0xeb, 0x32, 0x5a,
+	0xcf, 0x2f, 0x83, 0xa9, 0xe6, 0x40, 0xd6, 0x18, 0xa3, 0xe3, 0x2e, 0xca, 0x91, 0xcb, 0xc3, 0x4a,
+	0xaf, 0x14, 0x75, 0x1c, 0xa9, 0xf4, 0x22, 0xb2, 0x73, 0x95, 0x32, 0x79, 0xfc, 0xb7, 0x61, 0x75,
+	0x9f, 0x47, 0xd8, 0xeb, 0x9d, 0xe8, 0x9c, 0x51, 0x7d, 0x64, 0xd7, 0x9b, 0x6d, 0xcb, 0xed, 0xdb,
+	0xe3, 0x0a, 0xc8, 0xa6, 0xfc, 0x8e, 0x85, 0xbe, 0xa3, 0xfe, 0xe5, 0xc9, 0xf6, 0xbe, 0x45, 0x06,
+	0x5f, 0x1d, 0xa7, 0x33, 0x75, 0x36, 0xa5, 0xcd, 0xd7, 0xd0, 0x95, 0x5c, 0x9b, 0xe3, 0x76, 0xb5,
+	0xce, 0x84, 0x10, 0xea, 0xc3, 0x39, 0x65, 0xfc, 0x40, 0x93, 0x88, 0x8a, 0x2c, 0xc9, 0x6f, 0x26,
+	0xed, 0xcd, 0xb1, 0xd8, 0x55, 0x9b, 0x78, 0xc7, 0xda, 0xde, 0xf9, 0xd6, 0x5b, 0xc6, 0xbf, 0xa3,
+	0x61, 0x74, 0xcc, 0x7a, 0x1e, 0x27, 0x7e, 0xd7, 0x6b, 0x32, 0xb5, 0xaa, 0x9f, 0xfc, 0x17, 0xf2,
+	0x1d, 0xcc, 0x3b, 0x7f, 0x7d, 0x75, 0xc9, 0xfa, 0xe8, 0xd5, 0x25, 0xeb, 0x1f, 0xaf, 0x2e, 0x59,
+	0xcd, 0x33, 0x92, 0xe7, 0x8d, 0x7f, 0x0f, 0x00, 0x7c, 0xb6, 0x36, 0x0c, 0xa7, 0x1d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BeaconChainClient is the client API for BeaconChain service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BeaconChainClient interface {
+	ListAttestations(ctx context.Context, in *ListAttestationsRequest, opts ...grpc.CallOption) (*ListAttestationsResponse, error)
+	AttestationPool(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*AttestationPoolResponse, error)
+	ListBlocks(ctx context.Context, in *ListBlocksRequest, opts ...grpc.CallOption) (*ListBlocksResponse, error)
+	GetChainHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ChainHead, error)
+	ListValidatorBalances(ctx context.Context, in *GetValidatorBalancesRequest, opts ...grpc.CallOption) (*ValidatorBalances, error)
+	GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*Validators, error)
+	GetValidatorActiveSetChanges(ctx context.Context, in *GetValidatorActiveSetChangesRequest, opts ...grpc.CallOption) (*ActiveSetChanges, error)
+	GetValidatorQueue(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ValidatorQueue, error)
+	ListValidatorAssignments(ctx context.Context, in *ListValidatorAssignmentsRequest, opts ...grpc.CallOption) (*ValidatorAssignments, error)
+	GetValidatorParticipation(ctx context.Context, in *GetValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipation, error)
+	GetValidatorBalanceHistory(ctx context.Context, in *ValidatorBalanceHistoryRequest, opts ...grpc.CallOption) (*ValidatorBalanceHistory, error)
+	ListCommittees(ctx context.Context, in *ListCommitteesRequest, opts ...grpc.CallOption) (*Committees, error)
+	GetChainStats(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ChainStats, error)
+	StreamValidatorRegistry(ctx context.Context, in *ValidatorRegistryRequest, opts ...grpc.CallOption) (BeaconChain_StreamValidatorRegistryClient, error)
+	ListStuckDeposits(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*StuckDeposits, error)
+	StreamCanonicalBlocks(ctx context.Context, in *CanonicalBlocksRequest, opts ...grpc.CallOption) (BeaconChain_StreamCanonicalBlocksClient, error)
+}
+
+type beaconChainClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBeaconChainClient(cc *grpc.ClientConn) BeaconChainClient {
+	return &beaconChainClient{cc}
+}
+
+func (c *beaconChainClient) ListAttestations(ctx context.Context, in *ListAttestationsRequest, opts ...grpc.CallOption) (*ListAttestationsResponse, error) {
+	out := new(ListAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListAttestations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Validators) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
-	}
-	if len(m.Validators) > 0 {
-		for _, msg := range m.Validators {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.NextPageToken) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.NextPageToken)))
-		i += copy(dAtA[i:], m.NextPageToken)
-	}
-	if m.TotalSize != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalSize))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *beaconChainClient) AttestationPool(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*AttestationPoolResponse, error) {
+	out := new(AttestationPoolResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/AttestationPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *GetValidatorActiveSetChangesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *beaconChainClient) ListBlocks(ctx context.Context, in *ListBlocksRequest, opts ...grpc.CallOption) (*ListBlocksResponse, error) {
+	out := new(ListBlocksResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListBlocks", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetValidatorActiveSetChangesRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *beaconChainClient) GetChainHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ChainHead, error) {
+	out := new(ChainHead)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetChainHead", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ActiveSetChanges) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *beaconChainClient) ListValidatorBalances(ctx context.Context, in *GetValidatorBalancesRequest, opts ...grpc.CallOption) (*ValidatorBalances, error) {
+	out := new(ValidatorBalances)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorBalances", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ActiveSetChanges) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+func (c *beaconChainClient) GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*Validators, error) {
+	out := new(Validators)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ActivatedPublicKeys) > 0 {
-		for _, b := range m.ActivatedPublicKeys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+	return out, nil
+}
+
+func (c *beaconChainClient) GetValidatorActiveSetChanges(ctx context.Context, in *GetValidatorActiveSetChangesRequest, opts ...grpc.CallOption) (*ActiveSetChanges, error) {
+	out := new(ActiveSetChanges)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorActiveSetChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ExitedPublicKeys) > 0 {
-		for _, b := range m.ExitedPublicKeys {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+	return out, nil
+}
+
+func (c *beaconChainClient) GetValidatorQueue(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ValidatorQueue, error) {
+	out := new(ValidatorQueue)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.EjectedPublicKeys) > 0 {
-		for _, b := range m.EjectedPublicKeys {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+	return out, nil
+}
+
+func (c *beaconChainClient) ListValidatorAssignments(ctx context.Context, in *ListValidatorAssignmentsRequest, opts ...grpc.CallOption) (*ValidatorAssignments, error) {
+	out := new(ValidatorAssignments)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *beaconChainClient) GetValidatorParticipation(ctx context.Context, in *GetValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipation, error) {
+	out := new(ValidatorParticipation)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorParticipation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ValidatorQueue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *beaconChainClient) GetValidatorBalanceHistory(ctx context.Context, in *ValidatorBalanceHistoryRequest, opts ...grpc.CallOption) (*ValidatorBalanceHistory, error) {
+	out := new(ValidatorBalanceHistory)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorBalanceHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ValidatorQueue) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ChurnLimit != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.ChurnLimit))
-	}
-	if len(m.ActivationPublicKeys) > 0 {
-		for _, b := range m.ActivationPublicKeys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if len(m.ExitPublicKeys) > 0 {
-		for _, b := range m.ExitPublicKeys {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *beaconChainClient) ListCommittees(ctx context.Context, in *ListCommitteesRequest, opts ...grpc.CallOption) (*Committees, error) {
+	out := new(Committees)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListCommittees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ListValidatorAssignmentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *beaconChainClient) GetChainStats(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ChainStats, error) {
+	out := new(ChainStats)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetChainStats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListValidatorAssignmentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
-	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if len(m.Indices) > 0 {
-		dAtA7 := make([]byte, len(m.Indices)*10)
-		var j6 int
-		for _, num := range m.Indices {
-			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j6++
-			}
-			dAtA7[j6] = uint8(num)
-			j6++
-		}
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(j6))
-		i += copy(dAtA[i:], dAtA7[:j6])
-	}
-	if m.PageSize != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PageSize))
+func (c *beaconChainClient) StreamValidatorRegistry(ctx context.Context, in *ValidatorRegistryRequest, opts ...grpc.CallOption) (BeaconChain_StreamValidatorRegistryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconChain_serviceDesc.Streams[0], "/ethereum.eth.v1alpha1.BeaconChain/StreamValidatorRegistry", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PageToken) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PageToken)))
-		i += copy(dAtA[i:], m.PageToken)
+	x := &beaconChainStreamValidatorRegistryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return x, nil
 }
 
-func (m *ValidatorAssignments) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type BeaconChain_StreamValidatorRegistryClient interface {
+	Recv() (*ValidatorRegistryEntry, error)
+	grpc.ClientStream
 }
 
-func (m *ValidatorAssignments) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
-	}
-	if len(m.Assignments) > 0 {
-		for _, msg := range m.Assignments {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.NextPageToken) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.NextPageToken)))
-		i += copy(dAtA[i:], m.NextPageToken)
-	}
-	if m.TotalSize != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalSize))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+type beaconChainStreamValidatorRegistryClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconChainStreamValidatorRegistryClient) Recv() (*ValidatorRegistryEntry, error) {
+	m := new(ValidatorRegistryEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return m, nil
 }
 
-func (m *ValidatorAssignments_CommitteeAssignment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *beaconChainClient) ListStuckDeposits(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*StuckDeposits, error) {
+	out := new(StuckDeposits)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListStuckDeposits", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ValidatorAssignments_CommitteeAssignment) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.CrosslinkCommittees) > 0 {
-		dAtA9 := make([]byte, len(m.CrosslinkCommittees)*10)
-		var j8 int
-		for _, num := range m.CrosslinkCommittees {
-			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j8++
-			}
-			dAtA9[j8] = uint8(num)
-			j8++
-		}
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(j8))
-		i += copy(dAtA[i:], dAtA9[:j8])
-	}
-	if m.Shard != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Shard))
-	}
-	if m.Slot != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
-	}
-	if m.Proposer {
-		dAtA[i] = 0x20
-		i++
-		if m.Proposer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func (c *beaconChainClient) StreamCanonicalBlocks(ctx context.Context, in *CanonicalBlocksRequest, opts ...grpc.CallOption) (BeaconChain_StreamCanonicalBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconChain_serviceDesc.Streams[1], "/ethereum.eth.v1alpha1.BeaconChain/StreamCanonicalBlocks", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
+	x := &beaconChainStreamCanonicalBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return x, nil
 }
 
-func (m *GetValidatorParticipationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+type BeaconChain_StreamCanonicalBlocksClient interface {
+	Recv() (*CanonicalBlockRecord, error)
+	grpc.ClientStream
+}
+
+type beaconChainStreamCanonicalBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconChainStreamCanonicalBlocksClient) Recv() (*CanonicalBlockRecord, error) {
+	m := new(CanonicalBlockRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *GetValidatorParticipationRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// BeaconChainServer is the server API for BeaconChain service.
+type BeaconChainServer interface {
+	ListAttestations(context.Context, *ListAttestationsRequest) (*ListAttestationsResponse, error)
+	AttestationPool(context.Context, *types.Empty) (*AttestationPoolResponse, error)
+	ListBlocks(context.Context, *ListBlocksRequest) (*ListBlocksResponse, error)
+	GetChainHead(context.Context, *types.Empty) (*ChainHead, error)
+	ListValidatorBalances(context.Context, *GetValidatorBalancesRequest) (*ValidatorBalances, error)
+	GetValidators(context.Context, *GetValidatorsRequest) (*Validators, error)
+	GetValidatorActiveSetChanges(context.Context, *GetValidatorActiveSetChangesRequest) (*ActiveSetChanges, error)
+	GetValidatorQueue(context.Context, *types.Empty) (*ValidatorQueue, error)
+	ListValidatorAssignments(context.Context, *ListValidatorAssignmentsRequest) (*ValidatorAssignments, error)
+	GetValidatorParticipation(context.Context, *GetValidatorParticipationRequest) (*ValidatorParticipation, error)
+	GetValidatorBalanceHistory(context.Context, *ValidatorBalanceHistoryRequest) (*ValidatorBalanceHistory, error)
+	ListCommittees(context.Context, *ListCommitteesRequest) (*Committees, error)
+	GetChainStats(context.Context, *types.Empty) (*ChainStats, error)
+	StreamValidatorRegistry(*ValidatorRegistryRequest, BeaconChain_StreamValidatorRegistryServer) error
+	ListStuckDeposits(context.Context, *types.Empty) (*StuckDeposits, error)
+	StreamCanonicalBlocks(*CanonicalBlocksRequest, BeaconChain_StreamCanonicalBlocksServer) error
 }
 
-func (m *ValidatorParticipation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func RegisterBeaconChainServer(s *grpc.Server, srv BeaconChainServer) {
+	s.RegisterService(&_BeaconChain_serviceDesc, srv)
+}
+
+func _BeaconChain_ListAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttestationsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(BeaconChainServer).ListAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).ListAttestations(ctx, req.(*ListAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValidatorParticipation) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
-	}
-	if m.Finalized {
-		dAtA[i] = 0x10
-		i++
-		if m.Finalized {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.GlobalParticipationRate != 0 {
-		dAtA[i] = 0x1d
-		i++
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.GlobalParticipationRate))))
-		i += 4
+func _BeaconChain_AttestationPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.VotedEther != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.VotedEther))
+	if interceptor == nil {
+		return srv.(BeaconChainServer).AttestationPool(ctx, in)
 	}
-	if m.EligibleEther != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.EligibleEther))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/AttestationPool",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).AttestationPool(ctx, req.(*types.Empty))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AttestationPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _BeaconChain_ListBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlocksRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AttestationPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Attestations) > 0 {
-		for _, msg := range m.Attestations {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).ListBlocks(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListBlocks",
 	}
-	return i, nil
-}
-
-func encodeVarintBeaconChain(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).ListBlocks(ctx, req.(*ListBlocksRequest))
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return interceptor(ctx, in, info, handler)
 }
-func (m *ListAttestationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.QueryFilter != nil {
-		n += m.QueryFilter.Size()
+
+func _BeaconChain_GetChainHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PageSize != 0 {
-		n += 1 + sovBeaconChain(uint64(m.PageSize))
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetChainHead(ctx, in)
 	}
-	l = len(m.PageToken)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetChainHead",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetChainHead(ctx, req.(*types.Empty))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListAttestationsRequest_BlockRoot) Size() (n int) {
-	if m == nil {
-		return 0
+func _BeaconChain_ListValidatorBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.BlockRoot != nil {
-		l = len(m.BlockRoot)
-		n += 1 + l + sovBeaconChain(uint64(l))
+	if interceptor == nil {
+		return srv.(BeaconChainServer).ListValidatorBalances(ctx, in)
 	}
-	return n
-}
-func (m *ListAttestationsRequest_Slot) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorBalances",
 	}
-	var l int
-	_ = l
-	n += 1 + sovBeaconChain(uint64(m.Slot))
-	return n
-}
-func (m *ListAttestationsRequest_Epoch) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).ListValidatorBalances(ctx, req.(*GetValidatorBalancesRequest))
 	}
-	var l int
-	_ = l
-	n += 1 + sovBeaconChain(uint64(m.Epoch))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
-func (m *ListAttestationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Attestations) > 0 {
-		for _, e := range m.Attestations {
-			l = e.Size()
-			n += 1 + l + sovBeaconChain(uint64(l))
-		}
+
+func _BeaconChain_GetValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetValidators(ctx, in)
 	}
-	if m.TotalSize != 0 {
-		n += 1 + sovBeaconChain(uint64(m.TotalSize))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidators",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetValidators(ctx, req.(*GetValidatorsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListBlocksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.QueryFilter != nil {
-		n += m.QueryFilter.Size()
+func _BeaconChain_GetValidatorActiveSetChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorActiveSetChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PageSize != 0 {
-		n += 1 + sovBeaconChain(uint64(m.PageSize))
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetValidatorActiveSetChanges(ctx, in)
 	}
-	l = len(m.PageToken)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorActiveSetChanges",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetValidatorActiveSetChanges(ctx, req.(*GetValidatorActiveSetChangesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListBlocksRequest_Root) Size() (n int) {
-	if m == nil {
-		return 0
+func _BeaconChain_GetValidatorQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Root != nil {
-		l = len(m.Root)
-		n += 1 + l + sovBeaconChain(uint64(l))
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetValidatorQueue(ctx, in)
 	}
-	return n
-}
-func (m *ListBlocksRequest_Slot) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorQueue",
 	}
-	var l int
-	_ = l
-	n += 1 + sovBeaconChain(uint64(m.Slot))
-	return n
-}
-func (m *ListBlocksRequest_Epoch) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetValidatorQueue(ctx, req.(*types.Empty))
 	}
-	var l int
-	_ = l
-	n += 1 + sovBeaconChain(uint64(m.Epoch))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
-func (m *ListBlocksResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Blocks) > 0 {
-		for _, e := range m.Blocks {
-			l = e.Size()
-			n += 1 + l + sovBeaconChain(uint64(l))
-		}
+
+func _BeaconChain_ListValidatorAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValidatorAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
+	if interceptor == nil {
+		return srv.(BeaconChainServer).ListValidatorAssignments(ctx, in)
 	}
-	if m.TotalSize != 0 {
-		n += 1 + sovBeaconChain(uint64(m.TotalSize))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).ListValidatorAssignments(ctx, req.(*ListValidatorAssignmentsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ChainHead) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BlockRoot)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
-	}
-	if m.BlockSlot != 0 {
-		n += 1 + sovBeaconChain(uint64(m.BlockSlot))
-	}
-	if m.FinalizedSlot != 0 {
-		n += 1 + sovBeaconChain(uint64(m.FinalizedSlot))
-	}
-	l = len(m.FinalizedBlockRoot)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
-	}
-	if m.JustifiedSlot != 0 {
-		n += 1 + sovBeaconChain(uint64(m.JustifiedSlot))
-	}
-	l = len(m.JustifiedBlockRoot)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
+func _BeaconChain_GetValidatorParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PreviousJustifiedSlot != 0 {
-		n += 1 + sovBeaconChain(uint64(m.PreviousJustifiedSlot))
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetValidatorParticipation(ctx, in)
 	}
-	l = len(m.PreviousJustifiedBlockRoot)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorParticipation",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetValidatorParticipation(ctx, req.(*GetValidatorParticipationRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetValidatorBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Epoch))
+func _BeaconChain_GetValidatorBalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorBalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovBeaconChain(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetValidatorBalanceHistory(ctx, in)
 	}
-	if len(m.Indices) > 0 {
-		l = 0
-		for _, e := range m.Indices {
-			l += sovBeaconChain(uint64(e))
-		}
-		n += 1 + sovBeaconChain(uint64(l)) + l
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorBalanceHistory",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetValidatorBalanceHistory(ctx, req.(*ValidatorBalanceHistoryRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValidatorBalances) Size() (n int) {
-	if m == nil {
-		return 0
+func _BeaconChain_ListCommittees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommitteesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovBeaconChain(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).ListCommittees(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListCommittees",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).ListCommittees(ctx, req.(*ListCommitteesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValidatorBalances_Balance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
+func _BeaconChain_GetChainStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Index != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Index))
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetChainStats(ctx, in)
 	}
-	if m.Balance != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Balance))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetChainStats",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetChainStats(ctx, req.(*types.Empty))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _BeaconChain_StreamValidatorRegistry_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ValidatorRegistryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	var l int
-	_ = l
-	if m.QueryFilter != nil {
-		n += m.QueryFilter.Size()
+	return srv.(BeaconChainServer).StreamValidatorRegistry(m, &beaconChainStreamValidatorRegistryServer{stream})
+}
+
+func _BeaconChain_ListStuckDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PageSize != 0 {
-		n += 1 + sovBeaconChain(uint64(m.PageSize))
+	if interceptor == nil {
+		return srv.(BeaconChainServer).ListStuckDeposits(ctx, in)
 	}
-	l = len(m.PageToken)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListStuckDeposits",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).ListStuckDeposits(ctx, req.(*types.Empty))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetValidatorsRequest_Epoch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 1 + sovBeaconChain(uint64(m.Epoch))
-	return n
+type BeaconChain_StreamValidatorRegistryServer interface {
+	Send(*ValidatorRegistryEntry) error
+	grpc.ServerStream
 }
-func (m *GetValidatorsRequest_Genesis) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 2
-	return n
+
+type beaconChainStreamValidatorRegistryServer struct {
+	grpc.ServerStream
 }
-func (m *Validators) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Epoch))
-	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovBeaconChain(uint64(l))
-		}
-	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
-	}
-	if m.TotalSize != 0 {
-		n += 1 + sovBeaconChain(uint64(m.TotalSize))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (x *beaconChainStreamValidatorRegistryServer) Send(m *ValidatorRegistryEntry) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *GetValidatorActiveSetChangesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Epoch))
+func _BeaconChain_StreamCanonicalBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CanonicalBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return srv.(BeaconChainServer).StreamCanonicalBlocks(m, &beaconChainStreamCanonicalBlocksServer{stream})
 }
 
-func (m *ActiveSetChanges) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Epoch))
-	}
-	if len(m.ActivatedPublicKeys) > 0 {
-		for _, b := range m.ActivatedPublicKeys {
-			l = len(b)
-			n += 1 + l + sovBeaconChain(uint64(l))
-		}
-	}
-	if len(m.ExitedPublicKeys) > 0 {
-		for _, b := range m.ExitedPublicKeys {
-			l = len(b)
-			n += 1 + l + sovBeaconChain(uint64(l))
-		}
-	}
-	if len(m.EjectedPublicKeys) > 0 {
-		for _, b := range m.EjectedPublicKeys {
-			l = len(b)
-			n += 1 + l + sovBeaconChain(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type BeaconChain_StreamCanonicalBlocksServer interface {
+	Send(*CanonicalBlockRecord) error
+	grpc.ServerStream
 }
 
-func (m *ValidatorQueue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChurnLimit != 0 {
-		n += 1 + sovBeaconChain(uint64(m.ChurnLimit))
-	}
-	if len(m.ActivationPublicKeys) > 0 {
-		for _, b := range m.ActivationPublicKeys {
-			l = len(b)
-			n += 1 + l + sovBeaconChain(uint64(l))
-		}
-	}
-	if len(m.ExitPublicKeys) > 0 {
-		for _, b := range m.ExitPublicKeys {
-			l = len(b)
-			n += 1 + l + sovBeaconChain(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type beaconChainStreamCanonicalBlocksServer struct {
+	grpc.ServerStream
 }
 
-func (m *ListValidatorAssignmentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (x *beaconChainStreamCanonicalBlocksServer) Send(m *CanonicalBlockRecord) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _BeaconChain_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.eth.v1alpha1.BeaconChain",
+	HandlerType: (*BeaconChainServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAttestations",
+			Handler:    _BeaconChain_ListAttestations_Handler,
+		},
+		{
+			MethodName: "AttestationPool",
+			Handler:    _BeaconChain_AttestationPool_Handler,
+		},
+		{
+			MethodName: "ListBlocks",
+			Handler:    _BeaconChain_ListBlocks_Handler,
+		},
+		{
+			MethodName: "GetChainHead",
+			Handler:    _BeaconChain_GetChainHead_Handler,
+		},
+		{
+			MethodName: "ListValidatorBalances",
+			Handler:    _BeaconChain_ListValidatorBalances_Handler,
+		},
+		{
+			MethodName: "GetValidators",
+			Handler:    _BeaconChain_GetValidators_Handler,
+		},
+		{
+			MethodName: "GetValidatorActiveSetChanges",
+			Handler:    _BeaconChain_GetValidatorActiveSetChanges_Handler,
+		},
+		{
+			MethodName: "GetValidatorQueue",
+			Handler:    _BeaconChain_GetValidatorQueue_Handler,
+		},
+		{
+			MethodName: "ListValidatorAssignments",
+			Handler:    _BeaconChain_ListValidatorAssignments_Handler,
+		},
+		{
+			MethodName: "GetValidatorParticipation",
+			Handler:    _BeaconChain_GetValidatorParticipation_Handler,
+		},
+		{
+			MethodName: "GetValidatorBalanceHistory",
+			Handler:    _BeaconChain_GetValidatorBalanceHistory_Handler,
+		},
+		{
+			MethodName: "ListCommittees",
+			Handler:    _BeaconChain_ListCommittees_Handler,
+		},
+		{
+			MethodName: "GetChainStats",
+			Handler:    _BeaconChain_GetChainStats_Handler,
+		},
+		{
+			MethodName: "ListStuckDeposits",
+			Handler:    _BeaconChain_ListStuckDeposits_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamValidatorRegistry",
+			Handler:       _BeaconChain_StreamValidatorRegistry_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamCanonicalBlocks",
+			Handler:       _BeaconChain_StreamCanonicalBlocks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/eth/v1alpha1/beacon_chain.proto",
+}
+
+func (m *ListAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Epoch))
-	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovBeaconChain(uint64(l))
-		}
-	}
-	if len(m.Indices) > 0 {
-		l = 0
-		for _, e := range m.Indices {
-			l += sovBeaconChain(uint64(e))
+	if m.QueryFilter != nil {
+		nn1, err := m.QueryFilter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovBeaconChain(uint64(l)) + l
+		i += nn1
 	}
 	if m.PageSize != 0 {
-		n += 1 + sovBeaconChain(uint64(m.PageSize))
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PageSize))
 	}
-	l = len(m.PageToken)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
+	if len(m.PageToken) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PageToken)))
+		i += copy(dAtA[i:], m.PageToken)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ValidatorAssignments) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListAttestationsRequest_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlockRoot != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.BlockRoot)))
+		i += copy(dAtA[i:], m.BlockRoot)
+	}
+	return i, nil
+}
+func (m *ListAttestationsRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
+	return i, nil
+}
+func (m *ListAttestationsRequest_Epoch) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	return i, nil
+}
+func (m *ListAttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Epoch))
-	}
-	if len(m.Assignments) > 0 {
-		for _, e := range m.Assignments {
-			l = e.Size()
-			n += 1 + l + sovBeaconChain(uint64(l))
+	if len(m.Attestations) > 0 {
+		for _, msg := range m.Attestations {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
+	if len(m.NextPageToken) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.NextPageToken)))
+		i += copy(dAtA[i:], m.NextPageToken)
 	}
 	if m.TotalSize != 0 {
-		n += 1 + sovBeaconChain(uint64(m.TotalSize))
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalSize))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ValidatorAssignments_CommitteeAssignment) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.CrosslinkCommittees) > 0 {
-		l = 0
-		for _, e := range m.CrosslinkCommittees {
-			l += sovBeaconChain(uint64(e))
+	if m.QueryFilter != nil {
+		nn2, err := m.QueryFilter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovBeaconChain(uint64(l)) + l
+		i += nn2
 	}
-	if m.Shard != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Shard))
+	if m.PageSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PageSize))
 	}
-	if m.Slot != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Slot))
+	if len(m.PageToken) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PageToken)))
+		i += copy(dAtA[i:], m.PageToken)
 	}
-	if m.Proposer {
-		n += 2
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
+	return i, nil
+}
+
+func (m *ListBlocksRequest_Root) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Root != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.Root)))
+		i += copy(dAtA[i:], m.Root)
+	}
+	return i, nil
+}
+func (m *ListBlocksRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
+	return i, nil
+}
+func (m *ListBlocksRequest_Epoch) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	return i, nil
+}
+func (m *ListBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, msg := range m.Blocks {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.NextPageToken) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.NextPageToken)))
+		i += copy(dAtA[i:], m.NextPageToken)
+	}
+	if m.TotalSize != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalSize))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetValidatorParticipationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChainHead) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainHead) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	if len(m.BlockRoot) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.BlockRoot)))
+		i += copy(dAtA[i:], m.BlockRoot)
+	}
+	if m.BlockSlot != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.BlockSlot))
+	}
+	if m.FinalizedSlot != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.FinalizedSlot))
+	}
+	if len(m.FinalizedBlockRoot) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.FinalizedBlockRoot)))
+		i += copy(dAtA[i:], m.FinalizedBlockRoot)
+	}
+	if m.JustifiedSlot != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.JustifiedSlot))
+	}
+	if len(m.JustifiedBlockRoot) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.JustifiedBlockRoot)))
+		i += copy(dAtA[i:], m.JustifiedBlockRoot)
+	}
+	if m.PreviousJustifiedSlot != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PreviousJustifiedSlot))
+	}
+	if len(m.PreviousJustifiedBlockRoot) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PreviousJustifiedBlockRoot)))
+		i += copy(dAtA[i:], m.PreviousJustifiedBlockRoot)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ValidatorParticipation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetValidatorBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Epoch != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Epoch))
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
 	}
-	if m.Finalized {
-		n += 2
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
-	if m.GlobalParticipationRate != 0 {
-		n += 5
+	if len(m.Indices) > 0 {
+		dAtA4 := make([]byte, len(m.Indices)*10)
+		var j3 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(j3))
+		i += copy(dAtA[i:], dAtA4[:j3])
 	}
-	if m.VotedEther != 0 {
-		n += 1 + sovBeaconChain(uint64(m.VotedEther))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.EligibleEther != 0 {
-		n += 1 + sovBeaconChain(uint64(m.EligibleEther))
+	return i, nil
+}
+
+func (m *ValidatorBalances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBalances) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, msg := range m.Balances {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AttestationPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorBalances_Balance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBalances_Balance) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Attestations) > 0 {
-		for _, e := range m.Attestations {
-			l = e.Size()
-			n += 1 + l + sovBeaconChain(uint64(l))
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Index))
+	}
+	if m.Balance != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Balance))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		nn5, err := m.QueryFilter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn5
+	}
+	if m.PageSize != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PageSize))
+	}
+	if len(m.PageToken) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PageToken)))
+		i += copy(dAtA[i:], m.PageToken)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetValidatorsRequest_Epoch) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	return i, nil
+}
+func (m *GetValidatorsRequest_Genesis) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x10
+	i++
+	if m.Genesis {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
+}
+func (m *Validators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Validators) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	}
+	if len(m.Validators) > 0 {
+		for _, msg := range m.Validators {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.NextPageToken) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.NextPageToken)))
+		i += copy(dAtA[i:], m.NextPageToken)
+	}
+	if m.TotalSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetValidatorActiveSetChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorActiveSetChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ActiveSetChanges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveSetChanges) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	}
+	if len(m.ActivatedPublicKeys) > 0 {
+		for _, b := range m.ActivatedPublicKeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.ExitedPublicKeys) > 0 {
+		for _, b := range m.ExitedPublicKeys {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.EjectedPublicKeys) > 0 {
+		for _, b := range m.EjectedPublicKeys {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorQueue) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ChurnLimit != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.ChurnLimit))
+	}
+	if len(m.ActivationPublicKeys) > 0 {
+		for _, b := range m.ActivationPublicKeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.ExitPublicKeys) > 0 {
+		for _, b := range m.ExitPublicKeys {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListValidatorAssignmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListValidatorAssignmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.Indices) > 0 {
+		dAtA7 := make([]byte, len(m.Indices)*10)
+		var j6 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(j6))
+		i += copy(dAtA[i:], dAtA7[:j6])
+	}
+	if m.PageSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PageSize))
+	}
+	if len(m.PageToken) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PageToken)))
+		i += copy(dAtA[i:], m.PageToken)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorAssignments) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorAssignments) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	}
+	if len(m.Assignments) > 0 {
+		for _, msg := range m.Assignments {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.NextPageToken) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.NextPageToken)))
+		i += copy(dAtA[i:], m.NextPageToken)
+	}
+	if m.TotalSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorAssignments_CommitteeAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorAssignments_CommitteeAssignment) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.CrosslinkCommittees) > 0 {
+		dAtA9 := make([]byte, len(m.CrosslinkCommittees)*10)
+		var j8 int
+		for _, num := range m.CrosslinkCommittees {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(j8))
+		i += copy(dAtA[i:], dAtA9[:j8])
+	}
+	if m.Shard != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Shard))
+	}
+	if m.Slot != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
+	}
+	if m.Proposer {
+		dAtA[i] = 0x20
+		i++
+		if m.Proposer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetValidatorParticipationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorParticipationRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorParticipation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	}
+	if m.Finalized {
+		dAtA[i] = 0x10
+		i++
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.GlobalParticipationRate != 0 {
+		dAtA[i] = 0x1d
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.GlobalParticipationRate))))
+		i += 4
+	}
+	if m.VotedEther != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.VotedEther))
+	}
+	if m.EligibleEther != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.EligibleEther))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AttestationPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, msg := range m.Attestations {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorBalanceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBalanceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Index))
+	}
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
+	}
+	if m.StartEpoch != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.EndEpoch))
+	}
+	if m.MaxPoints != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.MaxPoints))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorBalanceHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBalanceHistory) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Index))
+	}
+	if len(m.Epochs) > 0 {
+		dAtA11 := make([]byte, len(m.Epochs)*10)
+		var j10 int
+		for _, num := range m.Epochs {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(j10))
+		i += copy(dAtA[i:], dAtA11[:j10])
+	}
+	if len(m.Balances) > 0 {
+		dAtA13 := make([]byte, len(m.Balances)*10)
+		var j12 int
+		for _, num := range m.Balances {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(j12))
+		i += copy(dAtA[i:], dAtA13[:j12])
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListCommitteesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCommitteesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Committees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Committees) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	}
+	if len(m.Committees) > 0 {
+		for _, msg := range m.Committees {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Committees_Committee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Committees_Committee) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
+	}
+	if m.Shard != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Shard))
+	}
+	if len(m.ValidatorIndices) > 0 {
+		dAtA15 := make([]byte, len(m.ValidatorIndices)*10)
+		var j14 int
+		for _, num := range m.ValidatorIndices {
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(j14))
+		i += copy(dAtA[i:], dAtA15[:j14])
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ChainStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.BlocksProcessed != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.BlocksProcessed))
+	}
+	if m.Reorgs != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Reorgs))
+	}
+	if m.InvalidBlocks != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.InvalidBlocks))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorRegistryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRegistryRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorRegistryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRegistryEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Index))
+	}
+	if m.Validator != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Validator.Size()))
+		n16, err := m.Validator.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.Balance != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Balance))
+	}
+	if len(m.Status) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.Status)))
+		i += copy(dAtA[i:], m.Status)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StuckDeposits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StuckDeposits) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Eth1DepositIndex != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Eth1DepositIndex))
+	}
+	if m.InclusionWindowSeconds != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.InclusionWindowSeconds))
+	}
+	if len(m.Deposits) > 0 {
+		for _, msg := range m.Deposits {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StuckDeposits_Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StuckDeposits_Deposit) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Index))
+	}
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
+	}
+	if m.Eth1BlockNumber != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Eth1BlockNumber))
+	}
+	if m.PendingSeconds != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PendingSeconds))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CanonicalBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanonicalBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.StartSlot != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.StartSlot))
+	}
+	if m.EndSlot != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.EndSlot))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CanonicalBlockRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanonicalBlockRecord) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
+	}
+	if len(m.Root) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.Root)))
+		i += copy(dAtA[i:], m.Root)
+	}
+	if len(m.ParentRoot) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.ParentRoot)))
+		i += copy(dAtA[i:], m.ParentRoot)
+	}
+	if m.ProposerIndex != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.ProposerIndex))
+	}
+	if m.ProposerKnown {
+		dAtA[i] = 0x28
+		i++
+		if m.ProposerKnown {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.AttestationCount != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.AttestationCount))
+	}
+	if m.DepositCount != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.DepositCount))
+	}
+	if len(m.Graffiti) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.Graffiti)))
+		i += copy(dAtA[i:], m.Graffiti)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintBeaconChain(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ListAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAttestationsRequest_BlockRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRoot != nil {
+		l = len(m.BlockRoot)
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	return n
+}
+func (m *ListAttestationsRequest_Slot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconChain(uint64(m.Slot))
+	return n
+}
+func (m *ListAttestationsRequest_Epoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconChain(uint64(m.Epoch))
+	return n
+}
+func (m *ListAttestationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListBlocksRequest_Root) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Root != nil {
+		l = len(m.Root)
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	return n
+}
+func (m *ListBlocksRequest_Slot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconChain(uint64(m.Slot))
+	return n
+}
+func (m *ListBlocksRequest_Epoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconChain(uint64(m.Epoch))
+	return n
+}
+func (m *ListBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChainHead) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.BlockSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.BlockSlot))
+	}
+	if m.FinalizedSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.FinalizedSlot))
+	}
+	l = len(m.FinalizedBlockRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.JustifiedSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.JustifiedSlot))
+	}
+	l = len(m.JustifiedBlockRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.PreviousJustifiedSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.PreviousJustifiedSlot))
+	}
+	l = len(m.PreviousJustifiedBlockRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetValidatorBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovBeaconChain(uint64(e))
+		}
+		n += 1 + sovBeaconChain(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorBalances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorBalances_Balance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Index))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Balance))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetValidatorsRequest_Epoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconChain(uint64(m.Epoch))
+	return n
+}
+func (m *GetValidatorsRequest_Genesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *Validators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetValidatorActiveSetChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActiveSetChanges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if len(m.ActivatedPublicKeys) > 0 {
+		for _, b := range m.ActivatedPublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.ExitedPublicKeys) > 0 {
+		for _, b := range m.ExitedPublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.EjectedPublicKeys) > 0 {
+		for _, b := range m.EjectedPublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChurnLimit != 0 {
+		n += 1 + sovBeaconChain(uint64(m.ChurnLimit))
+	}
+	if len(m.ActivationPublicKeys) > 0 {
+		for _, b := range m.ActivationPublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.ExitPublicKeys) > 0 {
+		for _, b := range m.ExitPublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListValidatorAssignmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovBeaconChain(uint64(e))
+		}
+		n += 1 + sovBeaconChain(uint64(l)) + l
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorAssignments) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if len(m.Assignments) > 0 {
+		for _, e := range m.Assignments {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorAssignments_CommitteeAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrosslinkCommittees) > 0 {
+		l = 0
+		for _, e := range m.CrosslinkCommittees {
+			l += sovBeaconChain(uint64(e))
+		}
+		n += 1 + sovBeaconChain(uint64(l)) + l
+	}
+	if m.Shard != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Shard))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Slot))
+	}
+	if m.Proposer {
+		n += 2
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetValidatorParticipationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	if m.GlobalParticipationRate != 0 {
+		n += 5
+	}
+	if m.VotedEther != 0 {
+		n += 1 + sovBeaconChain(uint64(m.VotedEther))
+	}
+	if m.EligibleEther != 0 {
+		n += 1 + sovBeaconChain(uint64(m.EligibleEther))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorBalanceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Index))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovServices(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovServices(uint64(m.EndEpoch))
+	}
+	if m.MaxPoints != 0 {
+		n += 1 + sovServices(uint64(m.MaxPoints))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorBalanceHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Index))
+	}
+	if len(m.Epochs) > 0 {
+		l = 0
+		for _, e := range m.Epochs {
+			l += sovServices(uint64(e))
+		}
+		n += 1 + sovServices(uint64(l)) + l
+	}
+	if len(m.Balances) > 0 {
+		l = 0
+		for _, e := range m.Balances {
+			l += sovServices(uint64(e))
+		}
+		n += 1 + sovServices(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListCommitteesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Committees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if len(m.Committees) > 0 {
+		for _, e := range m.Committees {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Committees_Committee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Slot))
+	}
+	if m.Shard != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Shard))
+	}
+	if len(m.ValidatorIndices) > 0 {
+		l = 0
+		for _, e := range m.ValidatorIndices {
+			l += sovBeaconChain(uint64(e))
+		}
+		n += 1 + sovBeaconChain(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChainStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlocksProcessed != 0 {
+		n += 1 + sovBeaconChain(uint64(m.BlocksProcessed))
+	}
+	if m.Reorgs != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Reorgs))
+	}
+	if m.InvalidBlocks != 0 {
+		n += 1 + sovBeaconChain(uint64(m.InvalidBlocks))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorRegistryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorRegistryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Index))
+	}
+	if m.Validator != nil {
+		l = m.Validator.Size()
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Balance))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StuckDeposits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Eth1DepositIndex != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Eth1DepositIndex))
+	}
+	if m.InclusionWindowSeconds != 0 {
+		n += 1 + sovBeaconChain(uint64(m.InclusionWindowSeconds))
+	}
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StuckDeposits_Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Index))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.Eth1BlockNumber != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Eth1BlockNumber))
+	}
+	if m.PendingSeconds != 0 {
+		n += 1 + sovBeaconChain(uint64(m.PendingSeconds))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CanonicalBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.StartSlot))
+	}
+	if m.EndSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.EndSlot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CanonicalBlockRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Slot))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	l = len(m.ParentRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.ProposerIndex != 0 {
+		n += 1 + sovBeaconChain(uint64(m.ProposerIndex))
+	}
+	if m.ProposerKnown {
+		n += 2
+	}
+	if m.AttestationCount != 0 {
+		n += 1 + sovBeaconChain(uint64(m.AttestationCount))
+	}
+	if m.DepositCount != 0 {
+		n += 1 + sovBeaconChain(uint64(m.DepositCount))
+	}
+	l = len(m.Graffiti)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovBeaconChain(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozBeaconChain(x uint64) (n int) {
+	return sovBeaconChain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAttestationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.QueryFilter = &ListAttestationsRequest_BlockRoot{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &ListAttestationsRequest_Slot{v}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &ListAttestationsRequest_Epoch{v}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAttestationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAttestationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.QueryFilter = &ListBlocksRequest_Root{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &ListBlocksRequest_Slot{v}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &ListBlocksRequest_Epoch{v}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, &BeaconBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainHead) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSlot", wireType)
+			}
+			m.BlockSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedSlot", wireType)
+			}
+			m.FinalizedSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedBlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedBlockRoot = append(m.FinalizedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.FinalizedBlockRoot == nil {
+				m.FinalizedBlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedSlot", wireType)
+			}
+			m.JustifiedSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedBlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JustifiedBlockRoot = append(m.JustifiedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.JustifiedBlockRoot == nil {
+				m.JustifiedBlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousJustifiedSlot", wireType)
+			}
+			m.PreviousJustifiedSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousJustifiedSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousJustifiedBlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousJustifiedBlockRoot = append(m.PreviousJustifiedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousJustifiedBlockRoot == nil {
+				m.PreviousJustifiedBlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetValidatorBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconChain
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorBalances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorBalances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, &ValidatorBalances_Balance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorBalances_Balance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &GetValidatorsRequest_Epoch{v}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Genesis", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.QueryFilter = &GetValidatorsRequest_Genesis{b}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func sovBeaconChain(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozBeaconChain(x uint64) (n int) {
-	return sovBeaconChain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
+func (m *GetValidatorActiveSetChangesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3635,17 +7138,17 @@ func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAttestationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetValidatorActiveSetChangesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetValidatorActiveSetChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var byteLen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3655,30 +7158,70 @@ func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.QueryFilter = &ListAttestationsRequest_BlockRoot{v}
-			iNdEx = postIndex
-		case 2:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveSetChanges: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveSetChanges: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var v uint64
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3688,17 +7231,16 @@ func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.QueryFilter = &ListAttestationsRequest_Slot{v}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedPublicKeys", wireType)
 			}
-			var v uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3708,17 +7250,29 @@ func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.QueryFilter = &ListAttestationsRequest_Epoch{v}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
 			}
-			m.PageSize = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivatedPublicKeys = append(m.ActivatedPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ActivatedPublicKeys[len(m.ActivatedPublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitedPublicKeys", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3728,16 +7282,29 @@ func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitedPublicKeys = append(m.ExitedPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ExitedPublicKeys[len(m.ExitedPublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EjectedPublicKeys", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3747,23 +7314,23 @@ func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PageToken = string(dAtA[iNdEx:postIndex])
+			m.EjectedPublicKeys = append(m.EjectedPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.EjectedPublicKeys[len(m.EjectedPublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3790,7 +7357,7 @@ func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAttestationsResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorQueue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3813,17 +7380,17 @@ func (m *ListAttestationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAttestationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorQueue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorQueue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChurnLimit", wireType)
 			}
-			var msglen int
+			m.ChurnLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3833,31 +7400,16 @@ func (m *ListAttestationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChurnLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attestations = append(m.Attestations, &Attestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationPublicKeys", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3867,29 +7419,29 @@ func (m *ListAttestationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			m.ActivationPublicKeys = append(m.ActivationPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ActivationPublicKeys[len(m.ActivationPublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitPublicKeys", wireType)
 			}
-			m.TotalSize = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3899,11 +7451,24 @@ func (m *ListAttestationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalSize |= int32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitPublicKeys = append(m.ExitPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ExitPublicKeys[len(m.ExitPublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -3929,7 +7494,7 @@ func (m *ListAttestationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListBlocksRequest) Unmarshal(dAtA []byte) error {
+func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3952,15 +7517,34 @@ func (m *ListBlocksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListBlocksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListValidatorAssignmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListValidatorAssignmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3987,50 +7571,85 @@ func (m *ListBlocksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.QueryFilter = &ListBlocksRequest_Root{v}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
-			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconChain
 				}
-			}
-			m.QueryFilter = &ListBlocksRequest_Slot{v}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconChain
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconChain
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
 			}
-			m.QueryFilter = &ListBlocksRequest_Epoch{v}
 		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
@@ -4107,7 +7726,7 @@ func (m *ListBlocksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListBlocksResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4130,15 +7749,34 @@ func (m *ListBlocksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListBlocksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorAssignments: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorAssignments: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4165,12 +7803,12 @@ func (m *ListBlocksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blocks = append(m.Blocks, &BeaconBlock{})
-			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Assignments = append(m.Assignments, &ValidatorAssignments_CommitteeAssignment{})
+			if err := m.Assignments[len(m.Assignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
@@ -4202,7 +7840,7 @@ func (m *ListBlocksResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.NextPageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
 			}
@@ -4246,7 +7884,7 @@ func (m *ListBlocksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainHead) Unmarshal(dAtA []byte) error {
+func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4269,51 +7907,93 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainHead: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitteeAssignment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainHead: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitteeAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.CrosslinkCommittees = append(m.CrosslinkCommittees, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.CrosslinkCommittees) == 0 {
+					m.CrosslinkCommittees = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconChain
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CrosslinkCommittees = append(m.CrosslinkCommittees, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrosslinkCommittees", wireType)
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockRoot == nil {
-				m.BlockRoot = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockSlot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
 			}
-			m.BlockSlot = 0
+			m.Shard = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4323,16 +8003,16 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockSlot |= uint64(b&0x7F) << shift
+				m.Shard |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedSlot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
-			m.FinalizedSlot = 0
+			m.Slot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4342,50 +8022,16 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinalizedSlot |= uint64(b&0x7F) << shift
+				m.Slot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedBlockRoot", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FinalizedBlockRoot = append(m.FinalizedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.FinalizedBlockRoot == nil {
-				m.FinalizedBlockRoot = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedSlot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
-			m.JustifiedSlot = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4395,14 +8041,15 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JustifiedSlot |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			m.Proposer = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedBlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4429,35 +8076,70 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JustifiedBlockRoot = append(m.JustifiedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.JustifiedBlockRoot == nil {
-				m.JustifiedBlockRoot = []byte{}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousJustifiedSlot", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.PreviousJustifiedSlot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PreviousJustifiedSlot |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
 			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousJustifiedBlockRoot", wireType)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
 			}
-			var byteLen int
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetValidatorParticipationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorParticipationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4467,26 +8149,11 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PreviousJustifiedBlockRoot = append(m.PreviousJustifiedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.PreviousJustifiedBlockRoot == nil {
-				m.PreviousJustifiedBlockRoot = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -4512,7 +8179,7 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetValidatorBalancesRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4535,10 +8202,10 @@ func (m *GetValidatorBalancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetValidatorBalancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorParticipation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetValidatorBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4561,10 +8228,10 @@ func (m *GetValidatorBalancesRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4574,99 +8241,60 @@ func (m *GetValidatorBalancesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
+			m.Finalized = bool(v != 0)
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalParticipationRate", wireType)
 			}
-			if postIndex > l {
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBeaconChain
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Indices = append(m.Indices, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBeaconChain
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthBeaconChain
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthBeaconChain
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.GlobalParticipationRate = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedEther", wireType)
+			}
+			m.VotedEther = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotedEther |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EligibleEther", wireType)
+			}
+			m.EligibleEther = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Indices) == 0 {
-					m.Indices = make([]uint64, 0, elementCount)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBeaconChain
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Indices = append(m.Indices, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EligibleEther |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -4693,7 +8321,7 @@ func (m *GetValidatorBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorBalances) Unmarshal(dAtA []byte) error {
+func (m *AttestationPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4716,15 +8344,15 @@ func (m *ValidatorBalances) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorBalances: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttestationPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttestationPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4751,8 +8379,8 @@ func (m *ValidatorBalances) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, &ValidatorBalances_Balance{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Attestations = append(m.Attestations, &Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4781,7 +8409,7 @@ func (m *ValidatorBalances) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorBalances_Balance) Unmarshal(dAtA []byte) error {
+func (m *ValidatorBalanceHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4804,20 +8432,39 @@ func (m *ValidatorBalances_Balance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorBalanceHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorBalanceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
+					return ErrIntOverflowServices
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
@@ -4830,11 +8477,11 @@ func (m *ValidatorBalances_Balance) Unmarshal(dAtA []byte) error {
 				}
 			}
 			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
+				return ErrInvalidLengthServices
 			}
 			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
+				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
@@ -4844,43 +8491,287 @@ func (m *ValidatorBalances_Balance) Unmarshal(dAtA []byte) error {
 				m.PublicKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoints", wireType)
+			}
+			m.MaxPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorBalanceHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorBalanceHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorBalanceHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Epochs = append(m.Epochs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthServices
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthServices
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Epochs) == 0 {
+					m.Epochs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServices
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Epochs = append(m.Epochs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Balances = append(m.Balances, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthServices
 				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
-			}
-			m.Balance = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthServices
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Balance |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Balances) == 0 {
+					m.Balances = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServices
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Balances = append(m.Balances, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -4907,7 +8798,7 @@ func (m *ValidatorBalances_Balance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *ListCommitteesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4930,77 +8821,17 @@ func (m *GetValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListCommitteesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListCommitteesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.QueryFilter = &GetValidatorsRequest_Epoch{v}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Genesis", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.QueryFilter = &GetValidatorsRequest_Genesis{b}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
-			}
-			m.PageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
-			}
-			var stringLen uint64
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5010,24 +8841,11 @@ func (m *GetValidatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PageToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -5053,7 +8871,7 @@ func (m *GetValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validators) Unmarshal(dAtA []byte) error {
+func (m *Committees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5076,10 +8894,10 @@ func (m *Validators) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validators: wiretype end group for non-group")
+			return fmt.Errorf("proto: Committees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validators: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Committees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5103,7 +8921,7 @@ func (m *Validators) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Committees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5130,62 +8948,11 @@ func (m *Validators) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Committees = append(m.Committees, &Committees_Committee{})
+			if err := m.Committees[len(m.Committees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
-			}
-			m.TotalSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -5211,7 +8978,7 @@ func (m *Validators) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetValidatorActiveSetChangesRequest) Unmarshal(dAtA []byte) error {
+func (m *Committees_Committee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5230,21 +8997,40 @@ func (m *GetValidatorActiveSetChangesRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetValidatorActiveSetChangesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetValidatorActiveSetChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Committee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Committee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
 			}
-			m.Epoch = 0
+			m.Shard = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5254,11 +9040,87 @@ func (m *GetValidatorActiveSetChangesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.Shard |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ValidatorIndices = append(m.ValidatorIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ValidatorIndices) == 0 {
+					m.ValidatorIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconChain
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ValidatorIndices = append(m.ValidatorIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndices", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -5284,7 +9146,7 @@ func (m *GetValidatorActiveSetChangesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
+func (m *ChainStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5307,17 +9169,17 @@ func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveSetChanges: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveSetChanges: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksProcessed", wireType)
 			}
-			m.Epoch = 0
+			m.BlocksProcessed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5327,16 +9189,16 @@ func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.BlocksProcessed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedPublicKeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reorgs", wireType)
 			}
-			var byteLen int
+			m.Reorgs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5346,61 +9208,16 @@ func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Reorgs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActivatedPublicKeys = append(m.ActivatedPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.ActivatedPublicKeys[len(m.ActivatedPublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitedPublicKeys", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExitedPublicKeys = append(m.ExitedPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.ExitedPublicKeys[len(m.ExitedPublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EjectedPublicKeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidBlocks", wireType)
 			}
-			var byteLen int
+			m.InvalidBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5410,24 +9227,11 @@ func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.InvalidBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EjectedPublicKeys = append(m.EjectedPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.EjectedPublicKeys[len(m.EjectedPublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -5453,7 +9257,7 @@ func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorQueue) Unmarshal(dAtA []byte) error {
+func (m *ValidatorRegistryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5476,68 +9280,17 @@ func (m *ValidatorQueue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorQueue: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorRegistryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorRegistryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChurnLimit", wireType)
-			}
-			m.ChurnLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChurnLimit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivationPublicKeys", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActivationPublicKeys = append(m.ActivationPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.ActivationPublicKeys[len(m.ActivationPublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitPublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var byteLen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5547,24 +9300,11 @@ func (m *ValidatorQueue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExitPublicKeys = append(m.ExitPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.ExitPublicKeys[len(m.ExitPublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -5590,7 +9330,7 @@ func (m *ValidatorQueue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorRegistryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5613,17 +9353,36 @@ func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListValidatorAssignmentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorRegistryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListValidatorAssignmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorRegistryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.Epoch = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5633,16 +9392,16 @@ func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5652,105 +9411,33 @@ func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBeaconChain
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Indices = append(m.Indices, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBeaconChain
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthBeaconChain
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthBeaconChain
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Indices) == 0 {
-					m.Indices = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBeaconChain
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Indices = append(m.Indices, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			if m.Validator == nil {
+				m.Validator = &Validator{}
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			m.PageSize = 0
+			m.Balance = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5760,14 +9447,14 @@ func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
+				m.Balance |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5795,7 +9482,7 @@ func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PageToken = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5822,7 +9509,7 @@ func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
+func (m *StuckDeposits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5845,17 +9532,17 @@ func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorAssignments: wiretype end group for non-group")
+			return fmt.Errorf("proto: StuckDeposits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorAssignments: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StuckDeposits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1DepositIndex", wireType)
 			}
-			m.Epoch = 0
+			m.Eth1DepositIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5865,16 +9552,16 @@ func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.Eth1DepositIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assignments", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionWindowSeconds", wireType)
 			}
-			var msglen int
+			m.InclusionWindowSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5884,31 +9571,16 @@ func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.InclusionWindowSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Assignments = append(m.Assignments, &ValidatorAssignments_CommitteeAssignment{})
-			if err := m.Assignments[len(m.Assignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5918,43 +9590,26 @@ func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
-			}
-			m.TotalSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Deposits = append(m.Deposits, &StuckDeposits_Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -5980,7 +9635,7 @@ func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error {
+func (m *StuckDeposits_Deposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5996,100 +9651,24 @@ func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error
 			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CommitteeAssignment: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitteeAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBeaconChain
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.CrosslinkCommittees = append(m.CrosslinkCommittees, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBeaconChain
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthBeaconChain
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthBeaconChain
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.CrosslinkCommittees) == 0 {
-					m.CrosslinkCommittees = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBeaconChain
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.CrosslinkCommittees = append(m.CrosslinkCommittees, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrosslinkCommittees", wireType)
+			if b < 0x80 {
+				break
 			}
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.Shard = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -6099,16 +9678,16 @@ func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Shard |= uint64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			m.Slot = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -6118,16 +9697,31 @@ func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1BlockNumber", wireType)
 			}
-			var v int
+			m.Eth1BlockNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -6137,17 +9731,16 @@ func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Eth1BlockNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Proposer = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSeconds", wireType)
 			}
-			var byteLen int
+			m.PendingSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -6157,26 +9750,11 @@ func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.PendingSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -6202,7 +9780,7 @@ func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *GetValidatorParticipationRequest) Unmarshal(dAtA []byte) error {
+func (m *CanonicalBlocksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6225,17 +9803,17 @@ func (m *GetValidatorParticipationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetValidatorParticipationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CanonicalBlocksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetValidatorParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CanonicalBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSlot", wireType)
 			}
-			m.Epoch = 0
+			m.StartSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -6245,7 +9823,26 @@ func (m *GetValidatorParticipationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.StartSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSlot", wireType)
+			}
+			m.EndSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6275,7 +9872,7 @@ func (m *GetValidatorParticipationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
+func (m *CanonicalBlockRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6298,17 +9895,17 @@ func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorParticipation: wiretype end group for non-group")
+			return fmt.Errorf("proto: CanonicalBlockRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CanonicalBlockRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
-			m.Epoch = 0
+			m.Slot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -6318,16 +9915,16 @@ func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.Slot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -6337,28 +9934,65 @@ func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Finalized = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalParticipationRate", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentRoot", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.GlobalParticipationRate = float32(math.Float32frombits(v))
+			m.ParentRoot = append(m.ParentRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParentRoot == nil {
+				m.ParentRoot = []byte{}
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotedEther", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerIndex", wireType)
 			}
-			m.VotedEther = 0
+			m.ProposerIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -6368,16 +10002,16 @@ func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotedEther |= uint64(b&0x7F) << shift
+				m.ProposerIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EligibleEther", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerKnown", wireType)
 			}
-			m.EligibleEther = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -6387,70 +10021,55 @@ func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EligibleEther |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBeaconChain(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.ProposerKnown = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationCount", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AttestationPoolResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBeaconChain
+			m.AttestationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DepositCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AttestationPoolResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestationPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Graffiti", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -6460,24 +10079,24 @@ func (m *AttestationPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attestations = append(m.Attestations, &Attestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Graffiti = append(m.Graffiti[:0], dAtA[iNdEx:postIndex]...)
+			if m.Graffiti == nil {
+				m.Graffiti = []byte{}
 			}
 			iNdEx = postIndex
 		default:
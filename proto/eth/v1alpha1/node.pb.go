@@ -230,46 +230,446 @@ func (m *ImplementedServices) GetServices() []string {
 	return nil
 }
 
+type PeerEvent struct {
+	Type                 string           `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	PeerId               string           `protobuf:"bytes,2,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Address              string           `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Direction            string           `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	HeadSlot             uint64           `protobuf:"varint,5,opt,name=head_slot,json=headSlot,proto3" json:"head_slot,omitempty"`
+	HeadRoot             []byte           `protobuf:"bytes,6,opt,name=head_root,json=headRoot,proto3" json:"head_root,omitempty"`
+	FinalizedEpoch       uint64           `protobuf:"varint,7,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	FinalizedRoot        []byte           `protobuf:"bytes,8,opt,name=finalized_root,json=finalizedRoot,proto3" json:"finalized_root,omitempty"`
+	Time                 *types.Timestamp `protobuf:"bytes,9,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PeerEvent) Reset()         { *m = PeerEvent{} }
+func (m *PeerEvent) String() string { return proto.CompactTextString(m) }
+func (*PeerEvent) ProtoMessage()    {}
+func (*PeerEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{4}
+}
+func (m *PeerEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeerEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerEvent.Merge(m, src)
+}
+func (m *PeerEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerEvent proto.InternalMessageInfo
+
+func (m *PeerEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *PeerEvent) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *PeerEvent) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PeerEvent) GetDirection() string {
+	if m != nil {
+		return m.Direction
+	}
+	return ""
+}
+
+func (m *PeerEvent) GetHeadSlot() uint64 {
+	if m != nil {
+		return m.HeadSlot
+	}
+	return 0
+}
+
+func (m *PeerEvent) GetHeadRoot() []byte {
+	if m != nil {
+		return m.HeadRoot
+	}
+	return nil
+}
+
+func (m *PeerEvent) GetFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
+func (m *PeerEvent) GetFinalizedRoot() []byte {
+	if m != nil {
+		return m.FinalizedRoot
+	}
+	return nil
+}
+
+func (m *PeerEvent) GetTime() *types.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+type CompetingHeads struct {
+	Heads                []*CompetingHeads_Head `protobuf:"bytes,1,rep,name=heads,proto3" json:"heads,omitempty"`
+	ContestedSlots       uint64                 `protobuf:"varint,2,opt,name=contested_slots,json=contestedSlots,proto3" json:"contested_slots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *CompetingHeads) Reset()         { *m = CompetingHeads{} }
+func (m *CompetingHeads) String() string { return proto.CompactTextString(m) }
+func (*CompetingHeads) ProtoMessage()    {}
+func (*CompetingHeads) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{5}
+}
+func (m *CompetingHeads) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompetingHeads) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompetingHeads.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompetingHeads) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompetingHeads.Merge(m, src)
+}
+func (m *CompetingHeads) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompetingHeads) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompetingHeads.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompetingHeads proto.InternalMessageInfo
+
+func (m *CompetingHeads) GetHeads() []*CompetingHeads_Head {
+	if m != nil {
+		return m.Heads
+	}
+	return nil
+}
+
+func (m *CompetingHeads) GetContestedSlots() uint64 {
+	if m != nil {
+		return m.ContestedSlots
+	}
+	return 0
+}
+
+type CompetingHeads_Head struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Root                 []byte   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	Weight               uint64   `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompetingHeads_Head) Reset()         { *m = CompetingHeads_Head{} }
+func (m *CompetingHeads_Head) String() string { return proto.CompactTextString(m) }
+func (*CompetingHeads_Head) ProtoMessage()    {}
+func (*CompetingHeads_Head) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{5, 0}
+}
+func (m *CompetingHeads_Head) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompetingHeads_Head) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompetingHeads_Head.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompetingHeads_Head) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompetingHeads_Head.Merge(m, src)
+}
+func (m *CompetingHeads_Head) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompetingHeads_Head) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompetingHeads_Head.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompetingHeads_Head proto.InternalMessageInfo
+
+func (m *CompetingHeads_Head) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *CompetingHeads_Head) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *CompetingHeads_Head) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+type PeerForks struct {
+	ForkVersion          []byte            `protobuf:"bytes,1,opt,name=fork_version,json=forkVersion,proto3" json:"fork_version,omitempty"`
+	FinalizedEpoch       uint64            `protobuf:"varint,2,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	Forks                []*PeerForks_Fork `protobuf:"bytes,3,rep,name=forks,proto3" json:"forks,omitempty"`
+	FinalizedRoot        []byte            `protobuf:"bytes,4,opt,name=finalized_root,json=finalizedRoot,proto3" json:"finalized_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PeerForks) Reset()         { *m = PeerForks{} }
+func (m *PeerForks) String() string { return proto.CompactTextString(m) }
+func (*PeerForks) ProtoMessage()    {}
+func (*PeerForks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{6}
+}
+func (m *PeerForks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerForks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerForks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeerForks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerForks.Merge(m, src)
+}
+func (m *PeerForks) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerForks) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerForks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerForks proto.InternalMessageInfo
+
+func (m *PeerForks) GetForkVersion() []byte {
+	if m != nil {
+		return m.ForkVersion
+	}
+	return nil
+}
+
+func (m *PeerForks) GetFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
+func (m *PeerForks) GetForks() []*PeerForks_Fork {
+	if m != nil {
+		return m.Forks
+	}
+	return nil
+}
+
+func (m *PeerForks) GetFinalizedRoot() []byte {
+	if m != nil {
+		return m.FinalizedRoot
+	}
+	return nil
+}
+
+type PeerForks_Fork struct {
+	ForkVersion          []byte   `protobuf:"bytes,1,opt,name=fork_version,json=forkVersion,proto3" json:"fork_version,omitempty"`
+	FinalizedEpoch       uint64   `protobuf:"varint,2,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	PeerCount            uint64   `protobuf:"varint,3,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
+	FinalizedRoot        []byte   `protobuf:"bytes,4,opt,name=finalized_root,json=finalizedRoot,proto3" json:"finalized_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerForks_Fork) Reset()         { *m = PeerForks_Fork{} }
+func (m *PeerForks_Fork) String() string { return proto.CompactTextString(m) }
+func (*PeerForks_Fork) ProtoMessage()    {}
+func (*PeerForks_Fork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{6, 0}
+}
+func (m *PeerForks_Fork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerForks_Fork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerForks_Fork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeerForks_Fork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerForks_Fork.Merge(m, src)
+}
+func (m *PeerForks_Fork) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerForks_Fork) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerForks_Fork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerForks_Fork proto.InternalMessageInfo
+
+func (m *PeerForks_Fork) GetForkVersion() []byte {
+	if m != nil {
+		return m.ForkVersion
+	}
+	return nil
+}
+
+func (m *PeerForks_Fork) GetFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
+func (m *PeerForks_Fork) GetPeerCount() uint64 {
+	if m != nil {
+		return m.PeerCount
+	}
+	return 0
+}
+
+func (m *PeerForks_Fork) GetFinalizedRoot() []byte {
+	if m != nil {
+		return m.FinalizedRoot
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SyncStatus)(nil), "ethereum.eth.v1alpha1.SyncStatus")
 	proto.RegisterType((*Genesis)(nil), "ethereum.eth.v1alpha1.Genesis")
 	proto.RegisterType((*Version)(nil), "ethereum.eth.v1alpha1.Version")
 	proto.RegisterType((*ImplementedServices)(nil), "ethereum.eth.v1alpha1.ImplementedServices")
+	proto.RegisterType((*PeerEvent)(nil), "ethereum.eth.v1alpha1.PeerEvent")
+	proto.RegisterType((*CompetingHeads)(nil), "ethereum.eth.v1alpha1.CompetingHeads")
+	proto.RegisterType((*CompetingHeads_Head)(nil), "ethereum.eth.v1alpha1.CompetingHeads.Head")
+	proto.RegisterType((*PeerForks)(nil), "ethereum.eth.v1alpha1.PeerForks")
+	proto.RegisterType((*PeerForks_Fork)(nil), "ethereum.eth.v1alpha1.PeerForks.Fork")
 }
 
 func init() { proto.RegisterFile("proto/eth/v1alpha1/node.proto", fileDescriptor_98054421e2cad574) }
 
 var fileDescriptor_98054421e2cad574 = []byte{
-	// 462 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x8b, 0x13, 0x31,
-	0x14, 0xc7, 0xc9, 0xae, 0xd8, 0xdd, 0xec, 0x7a, 0x89, 0xb8, 0x0e, 0xb3, 0xbb, 0xb5, 0x8e, 0x20,
-	0x8b, 0x87, 0x84, 0xae, 0x08, 0x82, 0x88, 0xe8, 0x22, 0x45, 0x10, 0x0f, 0x53, 0xf1, 0xe0, 0xa5,
-	0xa4, 0x33, 0xcf, 0x99, 0x40, 0x93, 0x0c, 0x93, 0xd7, 0x42, 0xaf, 0xfd, 0x0a, 0x7e, 0x29, 0x6f,
-	0x0a, 0x7e, 0x01, 0x29, 0x7e, 0x10, 0x99, 0x99, 0x44, 0xc5, 0xb6, 0x0b, 0xde, 0xf2, 0xf2, 0x7f,
-	0xff, 0xf7, 0x4b, 0xff, 0xaf, 0x43, 0xcf, 0xab, 0xda, 0xa2, 0x15, 0x80, 0xa5, 0x58, 0x0c, 0xe5,
-	0xac, 0x2a, 0xe5, 0x50, 0x18, 0x9b, 0x03, 0x6f, 0xef, 0xd9, 0x1d, 0xc0, 0x12, 0x6a, 0x98, 0x6b,
-	0x0e, 0x58, 0xf2, 0xd0, 0x11, 0x9f, 0x15, 0xd6, 0x16, 0x33, 0x10, 0xb2, 0x52, 0x42, 0x1a, 0x63,
-	0x51, 0xa2, 0xb2, 0xc6, 0x75, 0xa6, 0xf8, 0xd4, 0xab, 0x6d, 0x35, 0x9d, 0x7f, 0x12, 0xa0, 0x2b,
-	0x5c, 0x7a, 0xf1, 0xde, 0xbf, 0x22, 0x2a, 0x0d, 0x0e, 0xa5, 0xae, 0xba, 0x86, 0xe4, 0x21, 0xa5,
-	0xe3, 0xa5, 0xc9, 0xc6, 0x28, 0x71, 0xee, 0x58, 0x44, 0x7b, 0x6e, 0x69, 0x32, 0x65, 0x8a, 0x88,
-	0x0c, 0xc8, 0xc5, 0x41, 0x1a, 0xca, 0x64, 0x45, 0x68, 0x6f, 0x04, 0x06, 0x9c, 0x72, 0xec, 0x39,
-	0x3d, 0x2e, 0xba, 0xe3, 0xa4, 0x19, 0xd7, 0xb6, 0x1e, 0x5d, 0xc6, 0xbc, 0x63, 0xf1, 0xc0, 0xe2,
-	0xef, 0x03, 0x2b, 0x3d, 0xf2, 0xfd, 0xcd, 0x0d, 0x7b, 0x4a, 0xa3, 0x1c, 0x2a, 0xeb, 0x14, 0x4e,
-	0x32, 0x6b, 0xb0, 0x96, 0x19, 0x4e, 0x64, 0x9e, 0xd7, 0xe0, 0x5c, 0xb4, 0x37, 0x20, 0x17, 0xc7,
-	0xe9, 0x89, 0xd7, 0xaf, 0xbc, 0xfc, 0xb2, 0x53, 0x93, 0x17, 0xb4, 0xf7, 0x01, 0x6a, 0xa7, 0xac,
-	0x69, 0x5e, 0xba, 0xe8, 0x8e, 0x2d, 0xfe, 0x30, 0x0d, 0x25, 0x8b, 0xe9, 0x81, 0x06, 0x94, 0xb9,
-	0x44, 0xd9, 0x8e, 0x3b, 0x4c, 0x7f, 0xd7, 0xc9, 0x90, 0xde, 0x7e, 0xa3, 0xab, 0x19, 0x68, 0x30,
-	0x08, 0xf9, 0x18, 0xea, 0x85, 0xca, 0xc0, 0x35, 0x16, 0xe7, 0xcf, 0x11, 0x19, 0xec, 0x37, 0x96,
-	0x50, 0x5f, 0x7e, 0xdd, 0xa7, 0x37, 0xde, 0xd9, 0x1c, 0x98, 0xa1, 0xb7, 0x46, 0x80, 0x7f, 0x85,
-	0x75, 0xb2, 0xf1, 0x83, 0x5f, 0x37, 0xc9, 0xc7, 0xf7, 0xf9, 0xd6, 0x35, 0xf2, 0x3f, 0xd6, 0x24,
-	0x59, 0x7d, 0xff, 0xf9, 0x79, 0xef, 0x8c, 0xc5, 0x9b, 0x7f, 0x05, 0xe1, 0x13, 0x67, 0x25, 0xa5,
-	0x23, 0xc0, 0x90, 0xf9, 0x2e, 0x58, 0x7f, 0x07, 0xcc, 0xfb, 0xae, 0x25, 0xf9, 0xa5, 0x78, 0x52,
-	0x48, 0xf6, 0x7f, 0x49, 0xde, 0x77, 0x2d, 0x29, 0xec, 0x66, 0x45, 0xe8, 0xdd, 0xb7, 0xca, 0xe1,
-	0xb6, 0x25, 0xec, 0xe2, 0x3e, 0xda, 0xc1, 0xdd, 0x32, 0x23, 0x79, 0xd0, 0xbe, 0xe1, 0x9c, 0x9d,
-	0x6e, 0xcb, 0xd5, 0x37, 0xbd, 0xba, 0xfa, 0xb2, 0xee, 0x93, 0x6f, 0xeb, 0x3e, 0xf9, 0xb1, 0xee,
-	0x93, 0x8f, 0x4f, 0x0a, 0x85, 0xe5, 0x7c, 0xca, 0x33, 0xab, 0x45, 0x55, 0x2f, 0x9d, 0x96, 0xa8,
-	0xb2, 0x99, 0x9c, 0xba, 0xae, 0x12, 0x9b, 0x5f, 0xec, 0x33, 0xc0, 0x72, 0x7a, 0xb3, 0xbd, 0x7f,
-	0xfc, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x53, 0xd1, 0xfd, 0x57, 0xd2, 0x03, 0x00, 0x00,
+	// 844 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4b, 0x6f, 0xe4, 0x44,
+	0x10, 0x96, 0xb3, 0xce, 0x4c, 0xa6, 0xf2, 0x00, 0x35, 0x22, 0x6b, 0x39, 0xc9, 0x30, 0x6b, 0x94,
+	0x25, 0xe2, 0x60, 0x93, 0x20, 0x24, 0xa4, 0x15, 0xe2, 0x11, 0x65, 0xc3, 0x4a, 0x68, 0x85, 0x3c,
+	0x88, 0x03, 0x97, 0x51, 0xc7, 0xae, 0x8c, 0x5b, 0x3b, 0x76, 0x1b, 0x77, 0x4d, 0xd0, 0x70, 0xcc,
+	0x8d, 0x33, 0x17, 0x7e, 0x03, 0x7f, 0x83, 0x0b, 0x47, 0x24, 0x24, 0xce, 0x28, 0xe2, 0x87, 0xa0,
+	0xee, 0xb6, 0x67, 0x96, 0x8d, 0x1d, 0x16, 0xb4, 0x97, 0x51, 0xd7, 0xe3, 0xab, 0xaf, 0x5e, 0x2e,
+	0x0d, 0x1c, 0x94, 0x95, 0x24, 0x19, 0x21, 0x65, 0xd1, 0xd5, 0x31, 0x9f, 0x95, 0x19, 0x3f, 0x8e,
+	0x0a, 0x99, 0x62, 0x68, 0xf4, 0xec, 0x4d, 0xa4, 0x0c, 0x2b, 0x9c, 0xe7, 0x21, 0x52, 0x16, 0x36,
+	0x1e, 0xfe, 0xfe, 0x54, 0xca, 0xe9, 0x0c, 0x23, 0x5e, 0x8a, 0x88, 0x17, 0x85, 0x24, 0x4e, 0x42,
+	0x16, 0xca, 0x82, 0xfc, 0xbd, 0xda, 0x6a, 0xa4, 0x8b, 0xf9, 0x65, 0x84, 0x79, 0x49, 0x8b, 0xda,
+	0xf8, 0xd6, 0x8b, 0x46, 0x12, 0x39, 0x2a, 0xe2, 0x79, 0x69, 0x1d, 0x82, 0x87, 0x00, 0xe3, 0x45,
+	0x91, 0x8c, 0x89, 0xd3, 0x5c, 0x31, 0x0f, 0xfa, 0x6a, 0x51, 0x24, 0xa2, 0x98, 0x7a, 0xce, 0xc8,
+	0x39, 0xda, 0x88, 0x1b, 0x31, 0xb8, 0x76, 0xa0, 0x7f, 0x8e, 0x05, 0x2a, 0xa1, 0xd8, 0x47, 0xb0,
+	0x35, 0xb5, 0xcf, 0x89, 0x0e, 0x67, 0x5c, 0x37, 0x4f, 0xfc, 0xd0, 0x72, 0x85, 0x0d, 0x57, 0xf8,
+	0x55, 0xc3, 0x15, 0x6f, 0xd6, 0xfe, 0x5a, 0xc3, 0x3e, 0x04, 0x2f, 0xc5, 0x52, 0x2a, 0x41, 0x93,
+	0x44, 0x16, 0x54, 0xf1, 0x84, 0x26, 0x3c, 0x4d, 0x2b, 0x54, 0xca, 0x5b, 0x1b, 0x39, 0x47, 0x5b,
+	0xf1, 0x6e, 0x6d, 0x3f, 0xad, 0xcd, 0x9f, 0x5a, 0x6b, 0xf0, 0x31, 0xf4, 0xbf, 0xc6, 0x4a, 0x09,
+	0x59, 0xe8, 0x4c, 0xaf, 0xec, 0xd3, 0xd0, 0x0f, 0xe2, 0x46, 0x64, 0x3e, 0x6c, 0xe4, 0x48, 0x3c,
+	0xe5, 0xc4, 0x4d, 0xb8, 0x41, 0xbc, 0x94, 0x83, 0x63, 0x78, 0xe3, 0x49, 0x5e, 0xce, 0x30, 0xc7,
+	0x82, 0x30, 0x1d, 0x63, 0x75, 0x25, 0x12, 0x54, 0x1a, 0xa2, 0xea, 0xb7, 0xe7, 0x8c, 0xee, 0x69,
+	0x48, 0x23, 0x07, 0x3f, 0xaf, 0xc1, 0xe0, 0x4b, 0xc4, 0xea, 0xec, 0x0a, 0x0b, 0x62, 0x0c, 0x5c,
+	0x5a, 0x94, 0x58, 0x73, 0x9a, 0x37, 0xbb, 0x0f, 0xfd, 0x12, 0xb1, 0x9a, 0x88, 0xb4, 0xe6, 0xeb,
+	0x69, 0xf1, 0x49, 0xaa, 0x73, 0x6c, 0xea, 0xba, 0x67, 0x73, 0xac, 0x45, 0xb6, 0x0f, 0x83, 0x54,
+	0x54, 0x98, 0xe8, 0x39, 0x7a, 0xae, 0xb1, 0xad, 0x14, 0x6c, 0x0f, 0x06, 0x19, 0xf2, 0x74, 0xa2,
+	0x66, 0x92, 0xbc, 0xf5, 0x91, 0x73, 0xe4, 0xc6, 0x1b, 0x5a, 0x31, 0x9e, 0x49, 0x5a, 0x1a, 0x2b,
+	0x29, 0xc9, 0xeb, 0x99, 0x76, 0x19, 0x63, 0x2c, 0x25, 0xb1, 0x77, 0xe0, 0xb5, 0x4b, 0x51, 0xf0,
+	0x99, 0xf8, 0x1e, 0xd3, 0x09, 0x96, 0x32, 0xc9, 0xbc, 0xbe, 0xc1, 0xef, 0x2c, 0xd5, 0x67, 0x5a,
+	0xcb, 0x0e, 0x61, 0xa5, 0xb1, 0xa1, 0x36, 0x4c, 0xa8, 0xed, 0xa5, 0xd6, 0xc4, 0x0b, 0xc1, 0x35,
+	0x13, 0x1e, 0xfc, 0xeb, 0x84, 0x8d, 0x5f, 0xf0, 0x8b, 0x03, 0x3b, 0xa7, 0x32, 0x2f, 0x91, 0x44,
+	0x31, 0xfd, 0x1c, 0x79, 0xaa, 0xd8, 0x27, 0xb0, 0xae, 0xd3, 0xb3, 0x8d, 0xdd, 0x3c, 0x79, 0x37,
+	0x6c, 0xdd, 0xf1, 0xf0, 0x9f, 0xa8, 0x50, 0xff, 0xc6, 0x16, 0xa8, 0x8b, 0xd2, 0x7b, 0x82, 0x8a,
+	0xd0, 0xf6, 0xc4, 0xae, 0x89, 0x1b, 0xef, 0x2c, 0xd5, 0xba, 0x33, 0xca, 0x7f, 0x0c, 0xae, 0xc6,
+	0xe9, 0x21, 0x99, 0xd6, 0x39, 0xc6, 0xcb, 0xbc, 0xb5, 0xce, 0x94, 0x69, 0x17, 0xcc, 0xbc, 0xd9,
+	0x2e, 0xf4, 0xbe, 0x43, 0x31, 0xcd, 0xc8, 0x8c, 0xc7, 0x8d, 0x6b, 0x29, 0xf8, 0xa3, 0x1e, 0xf9,
+	0x63, 0x59, 0x3d, 0x53, 0xec, 0x01, 0x6c, 0x5d, 0xca, 0xea, 0xd9, 0xe4, 0xf9, 0x75, 0xdb, 0x8a,
+	0x37, 0xb5, 0xae, 0x59, 0xc6, 0x96, 0xb6, 0xaf, 0xb5, 0xb6, 0xfd, 0x11, 0xac, 0x6b, 0x9c, 0xde,
+	0x07, 0xdd, 0x8c, 0xc3, 0x8e, 0x66, 0x2c, 0xc9, 0x43, 0xfd, 0x1b, 0x5b, 0x4c, 0xcb, 0xcc, 0xdc,
+	0x96, 0x99, 0xf9, 0x3f, 0x39, 0xe0, 0x6a, 0xd8, 0x2b, 0x4d, 0xfc, 0x00, 0xc0, 0xec, 0x78, 0x22,
+	0xe7, 0x45, 0xd3, 0xae, 0x81, 0xd6, 0x9c, 0x6a, 0xc5, 0x4b, 0xa6, 0x76, 0xf2, 0x43, 0x0f, 0xdc,
+	0xa7, 0x32, 0x45, 0x56, 0xc0, 0xf6, 0x39, 0xd2, 0x73, 0x87, 0x67, 0xf7, 0xd6, 0x6a, 0x9d, 0xe9,
+	0x2b, 0xe6, 0x3f, 0xe8, 0xe8, 0xd0, 0x0a, 0x1a, 0x04, 0xd7, 0xbf, 0xff, 0xf5, 0xe3, 0xda, 0x3e,
+	0xf3, 0x6f, 0x9f, 0xd5, 0xa8, 0xbe, 0x5e, 0x2c, 0x03, 0x38, 0x47, 0x6a, 0xee, 0x57, 0x17, 0xd9,
+	0xb0, 0x83, 0xac, 0xc6, 0xdd, 0xc9, 0x54, 0x1f, 0xb8, 0x9a, 0xa9, 0xe9, 0xef, 0x7f, 0x65, 0xaa,
+	0x71, 0x77, 0x32, 0x35, 0x77, 0xee, 0xda, 0x81, 0xfb, 0x5f, 0x08, 0x45, 0x6d, 0x07, 0xad, 0x8b,
+	0xb7, 0xeb, 0xeb, 0x6b, 0x89, 0x11, 0xbc, 0x6d, 0x72, 0x38, 0x60, 0x7b, 0x6d, 0x7d, 0x6d, 0x88,
+	0x9e, 0xc2, 0xeb, 0x63, 0xaa, 0x90, 0xe7, 0xcb, 0x13, 0xd9, 0x4d, 0x3e, 0xba, 0x63, 0xdb, 0x0d,
+	0xf4, 0x3d, 0x87, 0xcd, 0x81, 0xe9, 0x9a, 0x5e, 0xb8, 0x21, 0x5d, 0x11, 0x0f, 0x5f, 0xea, 0x98,
+	0x04, 0x23, 0x53, 0x89, 0xcf, 0xbc, 0x96, 0x4a, 0xec, 0xa7, 0xf5, 0x2d, 0x6c, 0x6b, 0xda, 0xd5,
+	0x47, 0xff, 0x7f, 0x6a, 0x30, 0xc8, 0xe0, 0xa1, 0x21, 0x1b, 0xb1, 0x61, 0x0b, 0x99, 0xfe, 0x60,
+	0x94, 0xa5, 0xfc, 0xec, 0xf4, 0x9b, 0x0f, 0xa6, 0x82, 0xb2, 0xf9, 0x45, 0x98, 0xc8, 0x3c, 0x2a,
+	0xab, 0x85, 0xca, 0x39, 0x89, 0x64, 0xc6, 0x2f, 0x94, 0x95, 0xa2, 0xdb, 0xff, 0x15, 0x1e, 0x21,
+	0x65, 0xbf, 0xde, 0x0c, 0x9d, 0xdf, 0x6e, 0x86, 0xce, 0x9f, 0x37, 0x43, 0xe7, 0xa2, 0x67, 0x7c,
+	0xde, 0xff, 0x7b, 0x00, 0x34, 0x11, 0xdf, 0x2c, 0x58, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -288,6 +688,9 @@ type NodeClient interface {
 	GetGenesis(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Genesis, error)
 	GetVersion(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Version, error)
 	ListImplementedServices(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ImplementedServices, error)
+	StreamPeerEvents(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Node_StreamPeerEventsClient, error)
+	ListCompetingHeads(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*CompetingHeads, error)
+	ListPeerForks(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PeerForks, error)
 }
 
 type nodeClient struct {
@@ -334,12 +737,65 @@ func (c *nodeClient) ListImplementedServices(ctx context.Context, in *types.Empt
 	return out, nil
 }
 
+func (c *nodeClient) StreamPeerEvents(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Node_StreamPeerEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Node_serviceDesc.Streams[0], "/ethereum.eth.v1alpha1.Node/StreamPeerEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nodeStreamPeerEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Node_StreamPeerEventsClient interface {
+	Recv() (*PeerEvent, error)
+	grpc.ClientStream
+}
+
+type nodeStreamPeerEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodeStreamPeerEventsClient) Recv() (*PeerEvent, error) {
+	m := new(PeerEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nodeClient) ListCompetingHeads(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*CompetingHeads, error) {
+	out := new(CompetingHeads)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/ListCompetingHeads", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) ListPeerForks(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PeerForks, error) {
+	out := new(PeerForks)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/ListPeerForks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodeServer is the server API for Node service.
 type NodeServer interface {
 	GetSyncStatus(context.Context, *types.Empty) (*SyncStatus, error)
 	GetGenesis(context.Context, *types.Empty) (*Genesis, error)
 	GetVersion(context.Context, *types.Empty) (*Version, error)
 	ListImplementedServices(context.Context, *types.Empty) (*ImplementedServices, error)
+	StreamPeerEvents(*types.Empty, Node_StreamPeerEventsServer) error
+	ListCompetingHeads(context.Context, *types.Empty) (*CompetingHeads, error)
+	ListPeerForks(context.Context, *types.Empty) (*PeerForks, error)
 }
 
 func RegisterNodeServer(s *grpc.Server, srv NodeServer) {
@@ -418,28 +874,99 @@ func _Node_ListImplementedServices_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Node_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.eth.v1alpha1.Node",
-	HandlerType: (*NodeServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetSyncStatus",
-			Handler:    _Node_GetSyncStatus_Handler,
-		},
-		{
-			MethodName: "GetGenesis",
-			Handler:    _Node_GetGenesis_Handler,
-		},
-		{
-			MethodName: "GetVersion",
+func _Node_StreamPeerEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodeServer).StreamPeerEvents(m, &nodeStreamPeerEventsServer{stream})
+}
+
+func _Node_ListCompetingHeads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).ListCompetingHeads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/ListCompetingHeads",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).ListCompetingHeads(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_ListPeerForks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).ListPeerForks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/ListPeerForks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).ListPeerForks(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+type Node_StreamPeerEventsServer interface {
+	Send(*PeerEvent) error
+	grpc.ServerStream
+}
+
+type nodeStreamPeerEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodeStreamPeerEventsServer) Send(m *PeerEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Node_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.eth.v1alpha1.Node",
+	HandlerType: (*NodeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSyncStatus",
+			Handler:    _Node_GetSyncStatus_Handler,
+		},
+		{
+			MethodName: "GetGenesis",
+			Handler:    _Node_GetGenesis_Handler,
+		},
+		{
+			MethodName: "GetVersion",
 			Handler:    _Node_GetVersion_Handler,
 		},
 		{
 			MethodName: "ListImplementedServices",
 			Handler:    _Node_ListImplementedServices_Handler,
 		},
+		{
+			MethodName: "ListCompetingHeads",
+			Handler:    _Node_ListCompetingHeads_Handler,
+		},
+		{
+			MethodName: "ListPeerForks",
+			Handler:    _Node_ListPeerForks_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPeerEvents",
+			Handler:       _Node_StreamPeerEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/eth/v1alpha1/node.proto",
 }
 
@@ -580,6 +1107,251 @@ func (m *ImplementedServices) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *PeerEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.PeerId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.PeerId)))
+		i += copy(dAtA[i:], m.PeerId)
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if len(m.Direction) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Direction)))
+		i += copy(dAtA[i:], m.Direction)
+	}
+	if m.HeadSlot != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.HeadSlot))
+	}
+	if len(m.HeadRoot) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.HeadRoot)))
+		i += copy(dAtA[i:], m.HeadRoot)
+	}
+	if m.FinalizedEpoch != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.FinalizedEpoch))
+	}
+	if len(m.FinalizedRoot) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.FinalizedRoot)))
+		i += copy(dAtA[i:], m.FinalizedRoot)
+	}
+	if m.Time != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.Time.Size()))
+		n2, err := m.Time.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CompetingHeads) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompetingHeads) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Heads) > 0 {
+		for _, msg := range m.Heads {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintNode(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ContestedSlots != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.ContestedSlots))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CompetingHeads_Head) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompetingHeads_Head) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.Slot))
+	}
+	if len(m.Root) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Root)))
+		i += copy(dAtA[i:], m.Root)
+	}
+	if m.Weight != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.Weight))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PeerForks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerForks) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ForkVersion) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.ForkVersion)))
+		i += copy(dAtA[i:], m.ForkVersion)
+	}
+	if m.FinalizedEpoch != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.FinalizedEpoch))
+	}
+	if len(m.Forks) > 0 {
+		for _, msg := range m.Forks {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintNode(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.FinalizedRoot) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.FinalizedRoot)))
+		i += copy(dAtA[i:], m.FinalizedRoot)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PeerForks_Fork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerForks_Fork) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ForkVersion) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.ForkVersion)))
+		i += copy(dAtA[i:], m.ForkVersion)
+	}
+	if m.FinalizedEpoch != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.FinalizedEpoch))
+	}
+	if m.PeerCount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.PeerCount))
+	}
+	if len(m.FinalizedRoot) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.FinalizedRoot)))
+		i += copy(dAtA[i:], m.FinalizedRoot)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func encodeVarintNode(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -656,26 +1428,896 @@ func (m *ImplementedServices) Size() (n int) {
 			n += 1 + l + sovNode(uint64(l))
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.Direction)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.HeadSlot != 0 {
+		n += 1 + sovNode(uint64(m.HeadSlot))
+	}
+	l = len(m.HeadRoot)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovNode(uint64(m.FinalizedEpoch))
+	}
+	l = len(m.FinalizedRoot)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Time != nil {
+		l = m.Time.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompetingHeads) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Heads) > 0 {
+		for _, e := range m.Heads {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if m.ContestedSlots != 0 {
+		n += 1 + sovNode(uint64(m.ContestedSlots))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompetingHeads_Head) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovNode(uint64(m.Slot))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovNode(uint64(m.Weight))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerForks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ForkVersion)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovNode(uint64(m.FinalizedEpoch))
+	}
+	if len(m.Forks) > 0 {
+		for _, e := range m.Forks {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	l = len(m.FinalizedRoot)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerForks_Fork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ForkVersion)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovNode(uint64(m.FinalizedEpoch))
+	}
+	if m.PeerCount != 0 {
+		n += 1 + sovNode(uint64(m.PeerCount))
+	}
+	l = len(m.FinalizedRoot)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovNode(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozNode(x uint64) (n int) {
+	return sovNode(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SyncStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Syncing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Syncing = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Genesis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Genesis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Genesis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GenesisTime == nil {
+				m.GenesisTime = &types.Timestamp{}
+			}
+			if err := m.GenesisTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositContractAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositContractAddress = append(m.DepositContractAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositContractAddress == nil {
+				m.DepositContractAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Version) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Version: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImplementedServices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImplementedServices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImplementedServices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Direction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadSlot", wireType)
+			}
+			m.HeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeadRoot = append(m.HeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeadRoot == nil {
+				m.HeadRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedRoot = append(m.FinalizedRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.FinalizedRoot == nil {
+				m.FinalizedRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = &types.Timestamp{}
+			}
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovNode(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozNode(x uint64) (n int) {
-	return sovNode(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *SyncStatus) Unmarshal(dAtA []byte) error {
+func (m *CompetingHeads) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -698,17 +2340,51 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompetingHeads: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompetingHeads: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Heads = append(m.Heads, &CompetingHeads_Head{})
+			if err := m.Heads[len(m.Heads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Syncing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContestedSlots", wireType)
 			}
-			var v int
+			m.ContestedSlots = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNode
@@ -718,12 +2394,11 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ContestedSlots |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Syncing = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNode(dAtA[iNdEx:])
@@ -749,7 +2424,7 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Genesis) Unmarshal(dAtA []byte) error {
+func (m *CompetingHeads_Head) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -772,17 +2447,17 @@ func (m *Genesis) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Genesis: wiretype end group for non-group")
+			return fmt.Errorf("proto: Head: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Genesis: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Head: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
-			var msglen int
+			m.Slot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNode
@@ -792,31 +2467,14 @@ func (m *Genesis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Slot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthNode
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthNode
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.GenesisTime == nil {
-				m.GenesisTime = &types.Timestamp{}
-			}
-			if err := m.GenesisTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -843,11 +2501,30 @@ func (m *Genesis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositContractAddress = append(m.DepositContractAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.DepositContractAddress == nil {
-				m.DepositContractAddress = []byte{}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNode(dAtA[iNdEx:])
@@ -873,7 +2550,7 @@ func (m *Genesis) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Version) Unmarshal(dAtA []byte) error {
+func (m *PeerForks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -896,17 +2573,17 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Version: wiretype end group for non-group")
+			return fmt.Errorf("proto: PeerForks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PeerForks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkVersion", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNode
@@ -916,29 +2593,50 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthNode
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNode
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.ForkVersion = append(m.ForkVersion[:0], dAtA[iNdEx:postIndex]...)
+			if m.ForkVersion == nil {
+				m.ForkVersion = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Forks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNode
@@ -948,23 +2646,59 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNode
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNode
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Forks = append(m.Forks, &PeerForks_Fork{})
+			if err := m.Forks[len(m.Forks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedRoot = append(m.FinalizedRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.FinalizedRoot == nil {
+				m.FinalizedRoot = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -991,7 +2725,7 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImplementedServices) Unmarshal(dAtA []byte) error {
+func (m *PeerForks_Fork) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1014,17 +2748,17 @@ func (m *ImplementedServices) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImplementedServices: wiretype end group for non-group")
+			return fmt.Errorf("proto: Fork: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImplementedServices: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Fork: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkVersion", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNode
@@ -1034,23 +2768,97 @@ func (m *ImplementedServices) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthNode
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNode
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Services = append(m.Services, string(dAtA[iNdEx:postIndex]))
+			m.ForkVersion = append(m.ForkVersion[:0], dAtA[iNdEx:postIndex]...)
+			if m.ForkVersion == nil {
+				m.ForkVersion = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerCount", wireType)
+			}
+			m.PeerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedRoot = append(m.FinalizedRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.FinalizedRoot == nil {
+				m.FinalizedRoot = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -107,6 +107,14 @@ var (
 			"would whitelist connections to peers on your local network only. The default " +
 			"is to accept all connections.",
 	}
+	// P2PIPPreference defines the IP versions used by libp2p to listen and dial peers.
+	P2PIPPreference = cli.StringFlag{
+		Name: "p2p-ip-preference",
+		Usage: "The IP version to listen and run discovery on, and to dial peers with first: ipv4, ipv6 or auto. " +
+			"With auto, the node listens on both IPv4 and IPv6 when the host has addresses of both. " +
+			"Ignored when --p2p-host-ip is set. Requires --experimental-p2p.",
+		Value: "auto",
+	}
 	// P2PEncoding defines the encoding format for p2p messages.
 	P2PEncoding = cli.StringFlag{
 		Name:  "p2p-encoding",
@@ -16,6 +16,8 @@ const (
 
 	RepPenalityInvalidProtobuf    = -1000
 	RepPenalityInitialSyncFailure = -500
+	RepPenalityOperationLimit     = -500
+	RepPenalityMessageTooLarge    = -500
 	RepPenalityInvalidBlock       = -10
 	RepPenalityInvalidAttestation = -5
 )
@@ -48,3 +50,8 @@ func (s *Server) Disconnect(peer peer.ID) error {
 	}
 	return nil
 }
+
+// Peers returns the peers with an open connection.
+func (s *Server) Peers() []peer.ID {
+	return s.host.Network().Peers()
+}
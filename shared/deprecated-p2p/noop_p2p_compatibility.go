@@ -1,11 +1,14 @@
 package p2p
 
 import (
+	"context"
+
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	ethpb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/event"
 )
 
 // This file exists to enable interop/compatibility between this deprecated library and the new
@@ -21,6 +24,17 @@ func (s *Server) Handshakes() map[peer.ID]*ethpb.Hello {
 	return nil
 }
 
+// PeerEventFeed returns a feed on which no peer event is ever sent, as peer events are only
+// reported by the new p2p service.
+func (s *Server) PeerEventFeed() *event.Feed {
+	return &s.peerFeed
+}
+
+// AdvertiseSubnet does nothing, as the attestation subnets are only subscribed to with the new
+// p2p service.
+func (s *Server) AdvertiseSubnet(_ context.Context, _ uint64) {
+}
+
 // Encoding not implemented.
 func (s *Server) Encoding() encoder.NetworkEncoding {
 	return nil
@@ -26,10 +26,14 @@ import (
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	rhost "github.com/libp2p/go-libp2p/p2p/host/routed"
 	"github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/gossipfault"
 	"github.com/prysmaticlabs/prysm/shared/iputils"
+	"github.com/prysmaticlabs/prysm/shared/publishutil"
+	"github.com/prysmaticlabs/prysm/shared/tracing"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 	"go.opencensus.io/trace/propagation"
@@ -49,18 +53,23 @@ type Sender interface {
 
 // Server is a placeholder for a p2p service. To be designed.
 type Server struct {
-	ctx           context.Context
-	cancel        context.CancelFunc
-	mutex         *sync.Mutex
-	feeds         map[reflect.Type]Feed
-	host          host.Host
-	dht           *kaddht.IpfsDHT
-	gsub          *pubsub.PubSub
-	topicMapping  map[reflect.Type]string
-	bootstrapNode string
-	relayNodeAddr string
-	noDiscovery   bool
-	staticPeers   []string
+	ctx            context.Context
+	cancel         context.CancelFunc
+	mutex          *sync.Mutex
+	feeds          map[reflect.Type]Feed
+	host           host.Host
+	dht            *kaddht.IpfsDHT
+	gsub           *pubsub.PubSub
+	topicMapping   map[reflect.Type]string
+	bootstrapNode  string
+	relayNodeAddr  string
+	noDiscovery    bool
+	staticPeers    []string
+	peerFeed       event.Feed
+	disconnectFeed event.Feed
+	publishes      publishutil.Tracker
+	gracePeriod    time.Duration
+	gossipFaults   *gossipfault.Config
 }
 
 // ServerConfig for peer to peer networking.
@@ -76,6 +85,14 @@ type ServerConfig struct {
 	DepositContractAddress string
 	WhitelistCIDR          string
 	EnableUPnP             bool
+
+	// ShutdownGracePeriod bounds how long the server waits at shutdown for the blocks,
+	// slashings and voluntary exits being broadcast to be sent to peers. Zero disables the wait.
+	ShutdownGracePeriod time.Duration
+
+	// GossipFaults are the delays and drops injected into the gossip of block announces and
+	// attestations on research nodes. Nil injects no fault.
+	GossipFaults *gossipfault.Config
 }
 
 // NewServer creates a new p2p server instance.
@@ -154,7 +171,7 @@ func NewServer(cfg *ServerConfig) (*Server, error) {
 	setupPeerNegotiation(h, cfg.DepositContractAddress, exclusions)
 	setHandshakeHandler(h, cfg.DepositContractAddress)
 
-	return &Server{
+	s := &Server{
 		ctx:           ctx,
 		cancel:        cancel,
 		feeds:         make(map[reflect.Type]Feed),
@@ -167,7 +184,22 @@ func NewServer(cfg *ServerConfig) (*Server, error) {
 		relayNodeAddr: cfg.RelayNodeAddr,
 		noDiscovery:   cfg.NoDiscovery,
 		staticPeers:   cfg.StaticPeers,
-	}, nil
+		gracePeriod:   cfg.ShutdownGracePeriod,
+		gossipFaults:  cfg.GossipFaults,
+	}
+	h.Network().Notify(&libp2pnet.NotifyBundle{
+		DisconnectedF: func(_ libp2pnet.Network, conn libp2pnet.Conn) {
+			// Must be handled in a goroutine as this callback cannot be blocking.
+			go s.disconnectFeed.Send(conn.RemotePeer())
+		},
+	})
+	return s, nil
+}
+
+// PeerDisconnectFeed returns the feed on which the ID of a peer is sent when the connection to
+// it is closed.
+func (s *Server) PeerDisconnectFeed() *event.Feed {
+	return &s.disconnectFeed
 }
 
 // Started always returns true as this library starts in the constructor.
@@ -244,10 +276,19 @@ func (s *Server) Start() {
 func (s *Server) Stop() error {
 	log.Info("Stopping service")
 
+	// Give the critical messages being broadcast a chance to reach peers before pubsub stops.
+	if s.gracePeriod > 0 && !s.publishes.Flush(s.gracePeriod) {
+		log.WithField("gracePeriod", s.gracePeriod).Warn("Stopping before all blocks, slashings and exits were sent to peers")
+	}
 	s.cancel()
 	return nil
 }
 
+// PublishTracker returns the tracker of the critical messages flushed at shutdown.
+func (s *Server) PublishTracker() *publishutil.Tracker {
+	return &s.publishes
+}
+
 // Status returns an error if the p2p service does not have sufficient peers.
 func (s *Server) Status() error {
 	if peerCount(s.host) < 3 {
@@ -270,6 +311,10 @@ func (s *Server) RegisterTopic(topic string, message proto.Message, adapters ...
 	msgType := messageType(message)
 	s.topicMapping[msgType] = topic
 
+	if err := s.gossipFaults.Register(s.gsub, topic, message); err != nil {
+		log.Errorf("Failed to inject faults into topic: %v", err)
+	}
+
 	sub, err := s.gsub.Subscribe(topic)
 	if err != nil {
 		log.Errorf("Failed to subscribe to topic: %v", err)
@@ -315,10 +360,21 @@ func (s *Server) RegisterTopic(topic string, message proto.Message, adapters ...
 			}
 		}
 
+		if max := encoder.MaxMessageSize(message); uint64(len(msg.Payload)) > max {
+			log.WithField("size", len(msg.Payload)).Debugf("Rejecting payload larger than %d bytes", max)
+			s.Reputation(peerID, RepPenalityMessageTooLarge)
+			return
+		}
 		data := proto.Clone(message)
 		if err := proto.Unmarshal(msg.Payload, data); err != nil {
 			log.Error("Could not unmarshal payload")
 			s.Reputation(peerID, RepPenalityInvalidProtobuf)
+			return
+		}
+		if err := encoder.CheckOperationLimits(data); err != nil {
+			log.WithError(err).Debug("Rejecting payload")
+			s.Reputation(peerID, RepPenalityOperationLimit)
+			return
 		}
 		pMsg := Message{Ctx: ctx, Data: data, Peer: peerID}
 		for _, adapter := range adapters {
@@ -494,6 +550,9 @@ func (s *Server) Broadcast(ctx context.Context, msg proto.Message) error {
 
 	topic := s.topicMapping[messageType(msg)]
 	span.AddAttributes(trace.StringAttribute("topic", topic))
+	if dutyID := tracing.DutyID(ctx); dutyID != "" {
+		span.AddAttributes(trace.StringAttribute("dutyID", dutyID))
+	}
 
 	// Shorten message if it is too long to avoid
 	// polluting the logs, but only marshal to string if we are going to log.
@@ -536,6 +595,10 @@ func (s *Server) Broadcast(ctx context.Context, msg proto.Message) error {
 		return err
 	}
 
+	if publishutil.Critical(msg) {
+		done := s.publishes.Begin()
+		defer done()
+	}
 	if err := s.gsub.Publish(topic, data); err != nil {
 		log.Errorf("Failed to publish to gossipsub topic: %v", err)
 	}
@@ -18,10 +18,12 @@ import (
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	swarmt "github.com/libp2p/go-libp2p-swarm/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	shardpb "github.com/prysmaticlabs/prysm/proto/sharding/p2p/v1"
 	testpb "github.com/prysmaticlabs/prysm/proto/testing"
 	"github.com/prysmaticlabs/prysm/shared"
 	p2pmock "github.com/prysmaticlabs/prysm/shared/deprecated-p2p/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -184,6 +186,67 @@ func TestSubscribeToTopic_directMessaging_OK(t *testing.T) {
 	}
 }
 
+func TestSubscribeToTopic_RejectsBlockOverOperationLimit(t *testing.T) {
+	prev := params.BeaconConfig()
+	defer params.OverrideBeaconConfig(prev)
+	cfg := *prev
+	cfg.MaxVoluntaryExits = 0
+	params.OverrideBeaconConfig(&cfg)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 1*time.Second)
+	defer cancel()
+	h := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+
+	gsub, err := pubsub.NewFloodSub(ctx, h)
+	if err != nil {
+		t.Errorf("Failed to create pubsub: %v", err)
+	}
+
+	s := Server{
+		ctx:          ctx,
+		gsub:         gsub,
+		host:         h,
+		feeds:        make(map[reflect.Type]Feed),
+		mutex:        &sync.Mutex{},
+		topicMapping: make(map[reflect.Type]string),
+	}
+
+	ch := make(chan Message)
+	sub := s.Subscribe(&ethpb.BeaconBlock{}, ch)
+	defer sub.Unsubscribe()
+	s.RegisterTopic(testTopic, &ethpb.BeaconBlock{})
+
+	h2 := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+	if err := h2.Connect(ctx, pstore.PeerInfo{ID: h.ID(), Addrs: h.Addrs()}); err != nil {
+		t.Fatal(err)
+	}
+	stream, err := h2.NewStream(ctx, h.ID(), protocol.ID(prysmProtocolPrefix+"/"+testTopic))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := ggio.NewDelimitedWriter(stream)
+	defer w.Close()
+	invalid := &ethpb.BeaconBlock{
+		Slot: 1,
+		Body: &ethpb.BeaconBlockBody{VoluntaryExits: []*ethpb.VoluntaryExit{{}}},
+	}
+	if err := w.WriteMsg(createEnvelope(t, invalid)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteMsg(createEnvelope(t, &ethpb.BeaconBlock{Slot: 2})); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case msg := <-ch:
+		if slot := msg.Data.(*ethpb.BeaconBlock).Slot; slot != 2 {
+			t.Errorf("Wanted the block over the operation limit to be rejected, received block at slot %d", slot)
+		}
+	case <-ctx.Done():
+		t.Error("Context timed out before a message was received!")
+	}
+}
+
 func TestSubscribe_OK(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.TODO(), 1*time.Second)
 	defer cancel()
@@ -25,14 +25,13 @@ var log = logrus.WithField("prefix", "flags")
 
 // FeatureFlagConfig is a struct to represent what features the client will perform on runtime.
 type FeatureFlagConfig struct {
-	DisableHistoricalStatePruning bool // DisableHistoricalStatePruning when updating finalized states.
-	DisableGossipSub              bool // DisableGossipSub in p2p messaging.
-	EnableExcessDeposits          bool // EnableExcessDeposits in validator balances.
-	NoGenesisDelay                bool // NoGenesisDelay when processing a chain start genesis event.
-	UseNewP2P                     bool // UseNewP2P service.
-	UseNewSync                    bool // UseNewSync services.
-	UseNewDatabase                bool // UseNewDatabase service.
-	UseNewBlockChainService       bool // UseNewBlockChainService service.
+	DisableGossipSub        bool // DisableGossipSub in p2p messaging.
+	EnableExcessDeposits    bool // EnableExcessDeposits in validator balances.
+	NoGenesisDelay          bool // NoGenesisDelay when processing a chain start genesis event.
+	UseNewP2P               bool // UseNewP2P service.
+	UseNewSync              bool // UseNewSync services.
+	UseNewDatabase          bool // UseNewDatabase service.
+	UseNewBlockChainService bool // UseNewBlockChainService service.
 
 	// Cache toggles.
 	EnableActiveBalanceCache bool // EnableActiveBalanceCache; see https://github.com/prysmaticlabs/prysm/issues/3106.
@@ -63,10 +62,6 @@ func InitFeatureConfig(c *FeatureFlagConfig) {
 // on what flags are enabled for the beacon-chain client.
 func ConfigureBeaconFeatures(ctx *cli.Context) {
 	cfg := &FeatureFlagConfig{}
-	if ctx.GlobalBool(DisableHistoricalStatePruningFlag.Name) {
-		log.Info("Enabled historical state pruning")
-		cfg.DisableHistoricalStatePruning = true
-	}
 	if ctx.GlobalBool(DisableGossipSubFlag.Name) {
 		log.Info("Disabled gossipsub, using floodsub")
 		cfg.DisableGossipSub = true
@@ -10,10 +10,10 @@ var (
 		Name:  "enable-canonical-attestation-filter",
 		Usage: "Enable filtering and sending canonical attestations to RPC request, default is disabled.",
 	}
-	// DisableHistoricalStatePruningFlag allows the database to keep old historical states.
+	// DisableHistoricalStatePruningFlag is a deprecated alias of --historical-state-retention=keep-all.
 	DisableHistoricalStatePruningFlag = cli.BoolFlag{
 		Name:  "disable-historical-state-pruning",
-		Usage: "Disable database pruning of historical states after finalized epochs.",
+		Usage: "DEPRECATED: use --historical-state-retention=keep-all",
 	}
 	// DisableGossipSubFlag uses floodsub in place of gossipsub.
 	DisableGossipSubFlag = cli.BoolFlag{
@@ -0,0 +1,131 @@
+// Package gossipfault injects artificial delays and drops into the gossip of blocks and
+// attestations, so that researchers can study propagation effects with the real networking stack
+// of a node in a controlled testnet. It must never be enabled on a node serving validators.
+package gossipfault
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "gossipfault")
+
+// validateMargin is added to the delay of a rule for the pubsub validation timeout, so that
+// delayed messages are not rejected by pubsub for taking too long to validate.
+const validateMargin = time.Second
+
+var (
+	delayedMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gossipfault_delayed_messages_total",
+		Help: "The number of gossip messages artificially delayed before being relayed and processed, by kind.",
+	}, []string{"kind"})
+	droppedMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gossipfault_dropped_messages_total",
+		Help: "The number of gossip messages artificially dropped, neither relayed nor processed, by kind.",
+	}, []string{"kind"})
+)
+
+// Rule is the fault injected into the gossip of a kind of message.
+type Rule struct {
+	// Delay holds every message back before it is relayed to peers and processed by the node.
+	Delay time.Duration
+	// DropRate is the probability, between 0 and 1, of a message being dropped.
+	DropRate float64
+}
+
+// Enabled returns true if the rule injects any fault.
+func (r Rule) Enabled() bool {
+	return r.Delay > 0 || r.DropRate > 0
+}
+
+// Config holds the faults injected per kind of message. The zero value injects no fault.
+type Config struct {
+	Blocks       Rule
+	Attestations Rule
+}
+
+// Enabled returns true if any fault is injected. A nil config injects no fault.
+func (c *Config) Enabled() bool {
+	return c != nil && (c.Blocks.Enabled() || c.Attestations.Enabled())
+}
+
+// RuleFor returns the kind and the rule of the gossip messages of the given type. Blocks are
+// either gossiped whole or announced, depending on the p2p service.
+func (c *Config) RuleFor(msg proto.Message) (string, Rule, bool) {
+	if c == nil {
+		return "", Rule{}, false
+	}
+	switch msg.(type) {
+	case *ethpb.BeaconBlock, *pb.BeaconBlockAnnounce:
+		return "block", c.Blocks, c.Blocks.Enabled()
+	case *ethpb.Attestation:
+		return "attestation", c.Attestations, c.Attestations.Enabled()
+	default:
+		return "", Rule{}, false
+	}
+}
+
+// Register injects the faults of the messages of the given type into a gossip topic, with a
+// topic validator. Validation happens before a message is relayed or delivered to the
+// subscribers of the node, so a delayed message reaches both peers and the node late, and a
+// dropped message reaches neither. Topics of messages without faults are left untouched.
+func (c *Config) Register(ps *pubsub.PubSub, topic string, msg proto.Message) error {
+	if _, _, ok := c.RuleFor(msg); !ok {
+		return nil
+	}
+	validate, opts := c.Wrap(topic, msg, nil)
+	return ps.RegisterTopicValidator(topic, validate, opts...)
+}
+
+// Wrap injects the faults of the messages of the given type into the validator of a gossip
+// topic, for the services registering their own validator, since pubsub only accepts one per
+// topic. Faults are injected once next, if any, accepts the message. Validators of messages
+// without faults are returned unchanged, without options.
+func (c *Config) Wrap(topic string, msg proto.Message, next pubsub.Validator) (pubsub.Validator, []pubsub.ValidatorOpt) {
+	kind, rule, ok := c.RuleFor(msg)
+	if !ok {
+		return next, nil
+	}
+	log.WithFields(logrus.Fields{
+		"topic":    topic,
+		"delay":    rule.Delay,
+		"dropRate": rule.DropRate,
+	}).Warn("Injecting faults into gossip topic, this node is for research only")
+	fault := validator(kind, rule)
+	validate := fault
+	if next != nil {
+		validate = func(ctx context.Context, pid peer.ID, m *pubsub.Message) bool {
+			return next(ctx, pid, m) && fault(ctx, pid, m)
+		}
+	}
+	return validate, []pubsub.ValidatorOpt{pubsub.WithValidatorTimeout(rule.Delay + validateMargin)}
+}
+
+func validator(kind string, rule Rule) pubsub.Validator {
+	return func(ctx context.Context, _ peer.ID, _ *pubsub.Message) bool {
+		if rule.DropRate > 0 && rand.Float64() < rule.DropRate {
+			droppedMessages.WithLabelValues(kind).Inc()
+			return false
+		}
+		if rule.Delay <= 0 {
+			return true
+		}
+		select {
+		case <-time.After(rule.Delay):
+			delayedMessages.WithLabelValues(kind).Inc()
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
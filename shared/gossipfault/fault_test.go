@@ -0,0 +1,74 @@
+package gossipfault
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+func TestConfig_RuleFor(t *testing.T) {
+	cfg := &Config{Blocks: Rule{Delay: time.Second}}
+	if kind, _, ok := cfg.RuleFor(&pb.BeaconBlockAnnounce{}); !ok || kind != "block" {
+		t.Errorf("Wanted block announces to have the block rule, got %s %v", kind, ok)
+	}
+	if _, _, ok := cfg.RuleFor(&ethpb.Attestation{}); ok {
+		t.Error("Wanted attestations without faults to be left untouched")
+	}
+	if _, _, ok := cfg.RuleFor(&ethpb.VoluntaryExit{}); ok {
+		t.Error("Wanted voluntary exits to be left untouched")
+	}
+	var nilCfg *Config
+	if nilCfg.Enabled() {
+		t.Error("Wanted nil config to inject no fault")
+	}
+}
+
+func TestValidator_Drops(t *testing.T) {
+	validate := validator("block", Rule{DropRate: 1})
+	if validate(context.Background(), "", nil) {
+		t.Error("Wanted message to be dropped")
+	}
+}
+
+func TestValidator_Delays(t *testing.T) {
+	delay := 50 * time.Millisecond
+	validate := validator("attestation", Rule{Delay: delay})
+	start := time.Now()
+	if !validate(context.Background(), "", nil) {
+		t.Error("Wanted delayed message to be accepted")
+	}
+	if elapsed := time.Since(start); elapsed < delay {
+		t.Errorf("Wanted message delayed by at least %v, got %v", delay, elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	validate = validator("attestation", Rule{Delay: time.Hour})
+	if validate(ctx, "", nil) {
+		t.Error("Wanted message to be rejected once its validation is canceled")
+	}
+}
+
+func TestConfig_Wrap(t *testing.T) {
+	rejectAll := func(context.Context, peer.ID, *pubsub.Message) bool { return false }
+	cfg := &Config{Blocks: Rule{Delay: time.Hour}}
+
+	validate, opts := cfg.Wrap("/eth2/beacon_attestation", &ethpb.Attestation{}, rejectAll)
+	if len(opts) != 0 || validate(context.Background(), "", nil) {
+		t.Error("Wanted the validator of messages without faults to be left untouched")
+	}
+
+	validate, opts = cfg.Wrap("/eth2/beacon_block", &ethpb.BeaconBlock{}, rejectAll)
+	if len(opts) != 1 {
+		t.Errorf("Wanted a validation timeout covering the delay, got %d options", len(opts))
+	}
+	start := time.Now()
+	if validate(context.Background(), "", nil) || time.Since(start) > time.Second {
+		t.Error("Wanted messages rejected by the wrapped validator to be rejected without delay")
+	}
+}
@@ -6,10 +6,40 @@ import (
 
 // ExternalIPv4 returns the first IPv4 available.
 func ExternalIPv4() (string, error) {
-	ifaces, err := net.Interfaces()
+	ip, err := externalIP(func(ip net.IP) bool {
+		return ip.To4() != nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if ip == nil {
+		return "127.0.0.1", nil
+	}
+	return ip.To4().String(), nil
+}
+
+// ExternalIPv6 returns the first global unicast IPv6 available. Link-local addresses are
+// skipped, as peers can't reach them.
+func ExternalIPv6() (string, error) {
+	ip, err := externalIP(func(ip net.IP) bool {
+		return ip.To4() == nil && ip.IsGlobalUnicast()
+	})
 	if err != nil {
 		return "", err
 	}
+	if ip == nil {
+		return "::1", nil
+	}
+	return ip.String(), nil
+}
+
+// externalIP returns the first address of an interface that is up, which is not a loopback
+// address and matches the filter, or nil if there is none.
+func externalIP(match func(net.IP) bool) (net.IP, error) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
 
 	for _, iface := range ifaces {
 		if iface.Flags&net.FlagUp == 0 {
@@ -20,7 +50,7 @@ func ExternalIPv4() (string, error) {
 		}
 		addrs, err := iface.Addrs()
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		for _, addr := range addrs {
 			var ip net.IP
@@ -33,12 +63,11 @@ func ExternalIPv4() (string, error) {
 			if ip == nil || ip.IsLoopback() {
 				continue
 			}
-			ip = ip.To4()
-			if ip == nil {
-				continue // not an ipv4 address
+			if !match(ip) {
+				continue
 			}
-			return ip.String(), nil
+			return ip, nil
 		}
 	}
-	return "127.0.0.1", nil
+	return nil, nil
 }
@@ -1,6 +1,7 @@
 package iputils
 
 import (
+	"net"
 	"regexp"
 	"testing"
 )
@@ -20,3 +21,18 @@ func TestExternalIPv4(t *testing.T) {
 		t.Errorf("Wanted: %v, got: %v", IPv4Format, test)
 	}
 }
+
+func TestExternalIPv6(t *testing.T) {
+	test, err := ExternalIPv6()
+	if err != nil {
+		t.Errorf("Test check external ipv6 failed with %v", err)
+	}
+
+	ip := net.ParseIP(test)
+	if ip == nil || ip.To4() != nil {
+		t.Errorf("Wanted an IPv6 address, got: %v", test)
+	}
+	if !ip.IsLoopback() && !ip.IsGlobalUnicast() {
+		t.Errorf("Wanted a loopback or global unicast address, got: %v", test)
+	}
+}
@@ -0,0 +1,37 @@
+package keystore
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/minio/sha256-simd"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+// curveOrder is the order of the BLS12-381 curve, secret keys are scalars modulo this order.
+var curveOrder, _ = new(big.Int).SetString("52435875175126190479447740508185965837690552500527637822603658699938581184513", 10)
+
+// DeterministicKey returns the key of the validator at the given index, derived from the index
+// alone. Devnets depositing the deterministic keys of a range of indices at genesis can then be
+// validated by clients generating the same keys, without sharing any keystore.
+func DeterministicKey(index uint64) (*Key, error) {
+	enc := make([]byte, 32)
+	binary.LittleEndian.PutUint64(enc, index)
+	hash := sha256.Sum256(enc)
+	// The hash is read as a little endian integer, reduced modulo the curve order.
+	be := make([]byte, len(hash))
+	for i := range hash {
+		be[len(hash)-1-i] = hash[i]
+	}
+	num := new(big.Int).SetBytes(be)
+	num.Mod(num, curveOrder)
+	priv := make([]byte, 32)
+	b := num.Bytes()
+	copy(priv[32-len(b):], b)
+	secretKey, err := bls.SecretKeyFromBytes(priv)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not derive key of validator %d", index)
+	}
+	return newKeyFromBLS(secretKey)
+}
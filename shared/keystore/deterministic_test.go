@@ -0,0 +1,27 @@
+package keystore
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDeterministicKey(t *testing.T) {
+	first, err := DeterministicKey(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := DeterministicKey(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(first.SecretKey.Marshal(), again.SecretKey.Marshal()) {
+		t.Error("Wanted the same key for the same index")
+	}
+	other, err := DeterministicKey(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(first.PublicKey.Marshal(), other.PublicKey.Marshal()) {
+		t.Error("Wanted different keys for different indices")
+	}
+}
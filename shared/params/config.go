@@ -27,6 +27,7 @@ type BeaconChainConfig struct {
 	ShuffleRoundCount              uint64 `yaml:"SHUFFLE_ROUND_COUNT"`                // ShuffleRoundCount is used for retrieving the permuted index.
 	MinGenesisActiveValidatorCount uint64 `yaml:"MIN_GENESIS_ACTIVE_VALIDATOR_COUNT"` // MinGenesisActiveValidatorCount defines how many validator deposits needed to kick off beacon chain.
 	MinGenesisTime                 uint64 `yaml:"MIN_GENESIS_TIME"`                   // MinGenesisTime is the time that needed to pass before kicking off beacon chain. Currently set to Jan/3/2020.
+	GenesisDelay                   uint64 `yaml:"GENESIS_DELAY"`                      // GenesisDelay is the number of seconds from the eth1 block triggering ChainStart to genesis, rounded down to the start of a day if it is at least a day.
 
 	// Gwei value constants.
 	MinDepositAmount          uint64 `yaml:"MIN_DEPOSIT_AMOUNT"`          // MinDepositAmount is the maximal amount of Gwei a validator can send to the deposit contract at once.
@@ -81,6 +82,11 @@ type BeaconChainConfig struct {
 	DomainVoluntaryExit  []byte `yaml:"DOMAIN_VOLUNTARY_EXIT"`  // DomainVoluntaryExit defines the BLS signature domain for exit verification.
 	DomainTransfer       []byte `yaml:"DOMAIN_TRANSFER"`        // DomainTransfer defines the BLS signature domain for transfer verification.
 
+	// Networking constants.
+	AttestationSubnetCount            uint64 `yaml:"ATTESTATION_SUBNET_COUNT"`              // AttestationSubnetCount is the number of gossip subnets attestations are propagated on.
+	RandomSubnetsPerValidator         uint64 `yaml:"RANDOM_SUBNETS_PER_VALIDATOR"`          // RandomSubnetsPerValidator is the number of long lived random subnets a node subscribes to.
+	EpochsPerRandomSubnetSubscription uint64 `yaml:"EPOCHS_PER_RANDOM_SUBNET_SUBSCRIPTION"` // EpochsPerRandomSubnetSubscription is the minimum number of epochs a random subnet subscription is kept.
+
 	// Prysm constants.
 	GweiPerEth                uint64        // GweiPerEth is the amount of gwei corresponding to 1 eth.
 	SyncPollingInterval       int64         // SyncPollingInterval queries network nodes for sync status.
@@ -96,6 +102,7 @@ type BeaconChainConfig struct {
 	EmptySignature            [96]byte      // EmptySignature is used to represent a zeroed out BLS Signature.
 	DefaultPageSize           int           // DefaultPageSize defines the default page size for RPC server request.
 	MaxPageSize               int           // MaxPageSize defines the max page size for RPC server respond.
+	MaxSkipSlotsPerBlock      uint64        // MaxSkipSlotsPerBlock is the max number of empty slots processed in one go for an incoming block, 0 for no limit.
 }
 
 // DepositContractConfig contains the deposits for
@@ -128,6 +135,7 @@ var defaultBeaconConfig = &BeaconChainConfig{
 	ShuffleRoundCount:              90,
 	MinGenesisActiveValidatorCount: 65536,
 	MinGenesisTime:                 1578009600,
+	GenesisDelay:                   172800,
 
 	// Gwei value constants.
 	MinDepositAmount:          1 * 1e9,
@@ -182,6 +190,11 @@ var defaultBeaconConfig = &BeaconChainConfig{
 	DomainVoluntaryExit:  bytesutil.Bytes4(4),
 	DomainTransfer:       bytesutil.Bytes4(5),
 
+	// Networking constants.
+	AttestationSubnetCount:            64,
+	RandomSubnetsPerValidator:         1,
+	EpochsPerRandomSubnetSubscription: 256,
+
 	// Prysm constants.
 	GweiPerEth:                1000000000,
 	LogBlockDelay:             2,
@@ -195,6 +208,7 @@ var defaultBeaconConfig = &BeaconChainConfig{
 	EmptySignature:            [96]byte{},
 	DefaultPageSize:           250,
 	MaxPageSize:               500,
+	MaxSkipSlotsPerBlock:      1024,
 
 	// Testnet misc values.
 	TestnetContractEndpoint: "https://beta.prylabs.net/contract", // defines an http endpoint to fetch the testnet contract addr.
@@ -0,0 +1,100 @@
+// Package publishutil tracks the critical messages a node publishes to its peers, so that the
+// p2p services can flush them before closing at shutdown.
+package publishutil
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+// drainDelay is the time given to pubsub to write a published message to the streams of its
+// peers, once the message was handed to pubsub.
+const drainDelay = 500 * time.Millisecond
+
+// Critical returns true for the messages which must not be lost at shutdown: blocks, including
+// the blocks proposed by the validators of the node, slashings and voluntary exits.
+func Critical(msg proto.Message) bool {
+	switch msg.(type) {
+	case *ethpb.BeaconBlock, *pb.BeaconBlockAnnounce, *ethpb.ProposerSlashing, *ethpb.AttesterSlashing, *ethpb.VoluntaryExit:
+		return true
+	default:
+		return false
+	}
+}
+
+// Tracker records the critical messages being published.
+type Tracker struct {
+	lock        sync.Mutex
+	inFlight    int
+	flushing    bool
+	published   chan struct{} // Closed once no message is in flight, while flushing.
+	lastPublish time.Time
+}
+
+// Begin records the start of the publishing of a critical message. The returned function must
+// be called once the message was handed to pubsub. Messages published once Flush was called are
+// not waited for.
+func (t *Tracker) Begin() func() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.flushing {
+		return func() {}
+	}
+	t.inFlight++
+	var once sync.Once
+	return func() {
+		once.Do(t.end)
+	}
+}
+
+func (t *Tracker) end() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.lastPublish = time.Now()
+	t.inFlight--
+	if t.inFlight == 0 && t.published != nil {
+		close(t.published)
+		t.published = nil
+	}
+}
+
+// Flush waits for the critical messages being published to be handed to pubsub, and for pubsub
+// to send them to peers, for at most the grace period. Returns false if the grace period elapsed
+// before the messages were flushed.
+func (t *Tracker) Flush(grace time.Duration) bool {
+	deadline := time.Now().Add(grace)
+
+	t.lock.Lock()
+	t.flushing = true
+	var published chan struct{}
+	if t.inFlight > 0 {
+		if t.published == nil {
+			t.published = make(chan struct{})
+		}
+		published = t.published
+	}
+	t.lock.Unlock()
+	if published != nil {
+		timer := time.NewTimer(grace)
+		defer timer.Stop()
+		select {
+		case <-published:
+		case <-timer.C:
+			return false
+		}
+	}
+
+	t.lock.Lock()
+	drained := t.lastPublish.Add(drainDelay)
+	t.lock.Unlock()
+	if drained.After(deadline) {
+		time.Sleep(time.Until(deadline))
+		return false
+	}
+	time.Sleep(time.Until(drained))
+	return true
+}
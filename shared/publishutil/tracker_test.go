@@ -0,0 +1,73 @@
+package publishutil
+
+import (
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+func TestCritical(t *testing.T) {
+	if !Critical(&ethpb.BeaconBlock{}) {
+		t.Error("Wanted blocks to be critical")
+	}
+	if Critical(&ethpb.Attestation{}) {
+		t.Error("Wanted attestations not to be critical")
+	}
+}
+
+func TestTracker_FlushWithoutMessages(t *testing.T) {
+	tracker := &Tracker{}
+	start := time.Now()
+	if !tracker.Flush(time.Second) {
+		t.Error("Wanted flush to succeed")
+	}
+	if elapsed := time.Since(start); elapsed > drainDelay {
+		t.Errorf("Wanted flush without messages to return immediately, took %v", elapsed)
+	}
+}
+
+func TestTracker_FlushWaitsForPublish(t *testing.T) {
+	tracker := &Tracker{}
+	done := tracker.Begin()
+	time.AfterFunc(100*time.Millisecond, done)
+
+	start := time.Now()
+	if !tracker.Flush(2 * time.Second) {
+		t.Error("Wanted flush to succeed")
+	}
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond+drainDelay {
+		t.Errorf("Wanted flush to wait for the message to drain, took %v", elapsed)
+	}
+}
+
+func TestTracker_FlushIsBounded(t *testing.T) {
+	tracker := &Tracker{}
+	tracker.Begin()
+
+	start := time.Now()
+	if tracker.Flush(100 * time.Millisecond) {
+		t.Error("Wanted flush to time out")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Wanted flush to be bounded by the grace period, took %v", elapsed)
+	}
+}
+
+func TestTracker_BeginAfterFlush(t *testing.T) {
+	tracker := &Tracker{}
+	if !tracker.Flush(time.Second) {
+		t.Error("Wanted flush to succeed")
+	}
+	done := tracker.Begin()
+
+	start := time.Now()
+	if !tracker.Flush(time.Second) {
+		t.Error("Wanted messages published after the flush not to be waited for")
+	}
+	if elapsed := time.Since(start); elapsed > drainDelay {
+		t.Errorf("Wanted flush to return immediately, took %v", elapsed)
+	}
+	done()
+	done()
+}
@@ -0,0 +1,45 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// DutyIDMetadataKey is the gRPC metadata key carrying the correlation ID of a validator duty
+// from the validator client to the beacon node.
+const DutyIDMetadataKey = "x-prysm-duty-id"
+
+type dutyIDKey struct{}
+
+// NewDutyID returns the correlation ID of the duty of a validator at a slot, such as
+// "attester-1234-0xa1b2c3d4e5f6". The ID is derived from the duty rather than random, so that
+// it can be looked up in the logs of both the validator client and the beacon node.
+func NewDutyID(role string, slot uint64, pubKey []byte) string {
+	if len(pubKey) > 6 {
+		pubKey = pubKey[:6]
+	}
+	return fmt.Sprintf("%s-%d-%#x", role, slot, pubKey)
+}
+
+// WithDutyID returns a copy of the context carrying the duty ID, which is also sent as gRPC
+// metadata with the requests made using the returned context.
+func WithDutyID(ctx context.Context, id string) context.Context {
+	ctx = context.WithValue(ctx, dutyIDKey{}, id)
+	return metadata.AppendToOutgoingContext(ctx, DutyIDMetadataKey, id)
+}
+
+// DutyID returns the duty ID carried by the context, or received with the gRPC request served
+// with the context. It returns an empty string if the context is not part of a duty.
+func DutyID(ctx context.Context) string {
+	if id, ok := ctx.Value(dutyIDKey{}).(string); ok {
+		return id
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(DutyIDMetadataKey); len(ids) > 0 {
+			return ids[0]
+		}
+	}
+	return ""
+}
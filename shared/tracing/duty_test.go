@@ -0,0 +1,40 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestNewDutyID(t *testing.T) {
+	id := NewDutyID("attester", 1234, []byte{0xa1, 0xb2, 0xc3, 0xd4, 0xe5, 0xf6, 0x07, 0x08})
+	if id != "attester-1234-0xa1b2c3d4e5f6" {
+		t.Errorf("Wanted attester-1234-0xa1b2c3d4e5f6, got %s", id)
+	}
+}
+
+func TestDutyID_FromContext(t *testing.T) {
+	if id := DutyID(context.Background()); id != "" {
+		t.Errorf("Wanted no duty ID, got %s", id)
+	}
+
+	ctx := WithDutyID(context.Background(), "attester-1-0x01")
+	if id := DutyID(ctx); id != "attester-1-0x01" {
+		t.Errorf("Wanted attester-1-0x01, got %s", id)
+	}
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		t.Fatal("Wanted duty ID in outgoing metadata")
+	}
+	if ids := md.Get(DutyIDMetadataKey); len(ids) != 1 || ids[0] != "attester-1-0x01" {
+		t.Errorf("Wanted duty ID in outgoing metadata, got %v", ids)
+	}
+}
+
+func TestDutyID_FromIncomingMetadata(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(DutyIDMetadataKey, "attester-1-0x01"))
+	if id := DutyID(ctx); id != "attester-1-0x01" {
+		t.Errorf("Wanted attester-1-0x01, got %s", id)
+	}
+}
@@ -0,0 +1,120 @@
+// Canonical chain export tool
+//
+// Usage: bazel run //tools/chain-export -- --start-slot=0 --end-slot=1000 --format=csv > blocks.csv
+//
+// This tool streams a summary of the canonical blocks of a range of slots from a beacon node, and
+// writes it as CSV or newline delimited JSON, so that chain data can be loaded into a data
+// warehouse without parsing SSZ encoded blocks.
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"log"
+	"strconv"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/tools/exportutil"
+	_ "go.uber.org/automaxprocs"
+)
+
+var (
+	startSlot = flag.Uint64("start-slot", 0, "The first slot of the exported range")
+	endSlot   = flag.Uint64("end-slot", 0, "The last slot of the exported range, inclusive")
+)
+
+var csvHeader = []string{
+	"slot",
+	"root",
+	"parent_root",
+	"proposer_index",
+	"attestation_count",
+	"deposit_count",
+	"graffiti",
+}
+
+// jsonRecord is a block record as written in the JSON output. The proposer index is null when
+// the beacon node could not compute it.
+type jsonRecord struct {
+	Slot             uint64  `json:"slot"`
+	Root             string  `json:"root"`
+	ParentRoot       string  `json:"parent_root"`
+	ProposerIndex    *uint64 `json:"proposer_index"`
+	AttestationCount uint64  `json:"attestation_count"`
+	DepositCount     uint64  `json:"deposit_count"`
+	Graffiti         string  `json:"graffiti"`
+}
+
+func main() {
+	flag.Parse()
+
+	w, err := exportutil.NewWriter(csvHeader)
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx := context.Background()
+	conn, err := exportutil.Dial(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer conn.Close()
+
+	stream, err := ethpb.NewBeaconChainClient(conn).StreamCanonicalBlocks(ctx, &ethpb.CanonicalBlocksRequest{
+		StartSlot: *startSlot,
+		EndSlot:   *endSlot,
+	})
+	if err != nil {
+		log.Fatalf("Could not stream canonical blocks: %v", err)
+	}
+	count := 0
+	for {
+		record, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("Could not receive canonical blocks: %v", err)
+		}
+		if err := w.Write(csvRow(record), jsonValue(record)); err != nil {
+			log.Fatalf("Could not write block: %v", err)
+		}
+		count++
+	}
+	if err := w.Close(); err != nil {
+		log.Fatalf("Could not write canonical blocks: %v", err)
+	}
+	log.Printf("Exported %d blocks", count)
+}
+
+// csvRow returns the row of a block record, with an empty proposer index if it is unknown.
+func csvRow(record *ethpb.CanonicalBlockRecord) []string {
+	proposer := ""
+	if record.ProposerKnown {
+		proposer = strconv.FormatUint(record.ProposerIndex, 10)
+	}
+	return []string{
+		strconv.FormatUint(record.Slot, 10),
+		exportutil.Hex(record.Root),
+		exportutil.Hex(record.ParentRoot),
+		proposer,
+		strconv.FormatUint(record.AttestationCount, 10),
+		strconv.FormatUint(record.DepositCount, 10),
+		exportutil.Hex(record.Graffiti),
+	}
+}
+
+func jsonValue(record *ethpb.CanonicalBlockRecord) *jsonRecord {
+	r := &jsonRecord{
+		Slot:             record.Slot,
+		Root:             exportutil.Hex(record.Root),
+		ParentRoot:       exportutil.Hex(record.ParentRoot),
+		AttestationCount: record.AttestationCount,
+		DepositCount:     record.DepositCount,
+		Graffiti:         exportutil.Hex(record.Graffiti),
+	}
+	if record.ProposerKnown {
+		r.ProposerIndex = &record.ProposerIndex
+	}
+	return r
+}
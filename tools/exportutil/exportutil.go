@@ -0,0 +1,103 @@
+// Package exportutil holds the plumbing shared by the tools exporting chain data from a beacon
+// node: the connection flags, the output file and the CSV or JSON writer.
+package exportutil
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	beaconRPC = flag.String("beacon-rpc-provider", "localhost:4000", "The address of the gRPC server of the beacon node")
+	certPath  = flag.String("tls-cert", "", "Certificate of the gRPC server of the beacon node, for a secure connection")
+	format    = flag.String("format", "csv", "The output format: csv or json, one object per line")
+	output    = flag.String("output", "", "The file the export is written to, standard output if empty")
+)
+
+// Writer writes the exported records as CSV rows, after a header row, or as newline delimited
+// JSON objects, depending on the format flag.
+type Writer struct {
+	out  io.WriteCloser
+	csv  *csv.Writer
+	json *json.Encoder
+}
+
+// NewWriter creates the output file, standard output if the output flag is empty, and a writer
+// of records in the format chosen with the format flag.
+func NewWriter(csvHeader []string) (*Writer, error) {
+	var out io.WriteCloser = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return nil, fmt.Errorf("could not create output file: %v", err)
+		}
+		out = f
+	}
+	w := &Writer{out: out}
+	switch *format {
+	case "csv":
+		w.csv = csv.NewWriter(out)
+		if err := w.csv.Write(csvHeader); err != nil {
+			return nil, err
+		}
+	case "json":
+		w.json = json.NewEncoder(out)
+	default:
+		return nil, fmt.Errorf("unknown format %q, expected csv or json", *format)
+	}
+	return w, nil
+}
+
+// Write a record, given as its CSV row and as the value encoded in JSON.
+func (w *Writer) Write(csvRow []string, jsonValue interface{}) error {
+	if w.csv != nil {
+		return w.csv.Write(csvRow)
+	}
+	return w.json.Encode(jsonValue)
+}
+
+// Close flushes the records written and closes the output file.
+func (w *Writer) Close() error {
+	if w.csv != nil {
+		w.csv.Flush()
+		if err := w.csv.Error(); err != nil {
+			return err
+		}
+	}
+	if w.out == os.Stdout {
+		return nil
+	}
+	return w.out.Close()
+}
+
+// Dial connects to the gRPC server of the beacon node set with the flags, with TLS if a
+// certificate is given.
+func Dial(ctx context.Context) (*grpc.ClientConn, error) {
+	dialOpt := grpc.WithInsecure()
+	if *certPath != "" {
+		creds, err := credentials.NewClientTLSFromFile(*certPath, "")
+		if err != nil {
+			return nil, fmt.Errorf("could not get valid credentials: %v", err)
+		}
+		dialOpt = grpc.WithTransportCredentials(creds)
+	}
+	conn, err := grpc.DialContext(ctx, *beaconRPC, dialOpt)
+	if err != nil {
+		return nil, fmt.Errorf("could not dial beacon node: %v", err)
+	}
+	return conn, nil
+}
+
+// Hex encodes bytes as a 0x prefixed hexadecimal string.
+func Hex(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
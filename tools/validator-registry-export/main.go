@@ -0,0 +1,126 @@
+// Validator registry export tool
+//
+// Usage: bazel run //tools/validator-registry-export -- --epoch=100 --format=csv > registry.csv
+//
+// This tool streams the validator registry of a beacon node at a given epoch, read from the
+// historical states the node archived, and writes it as CSV or newline delimited JSON, so that
+// the registry can be analyzed without parsing raw beacon states.
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"log"
+	"strconv"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/tools/exportutil"
+	_ "go.uber.org/automaxprocs"
+)
+
+var epoch = flag.Uint64("epoch", 0, "The epoch of the exported validator registry")
+
+var csvHeader = []string{
+	"epoch",
+	"index",
+	"public_key",
+	"status",
+	"balance",
+	"effective_balance",
+	"slashed",
+	"activation_eligibility_epoch",
+	"activation_epoch",
+	"exit_epoch",
+	"withdrawable_epoch",
+}
+
+// jsonEntry is a registry entry as written in the JSON output.
+type jsonEntry struct {
+	Epoch                      uint64 `json:"epoch"`
+	Index                      uint64 `json:"index"`
+	PublicKey                  string `json:"public_key"`
+	Status                     string `json:"status"`
+	Balance                    uint64 `json:"balance"`
+	EffectiveBalance           uint64 `json:"effective_balance"`
+	Slashed                    bool   `json:"slashed"`
+	ActivationEligibilityEpoch uint64 `json:"activation_eligibility_epoch"`
+	ActivationEpoch            uint64 `json:"activation_epoch"`
+	ExitEpoch                  uint64 `json:"exit_epoch"`
+	WithdrawableEpoch          uint64 `json:"withdrawable_epoch"`
+}
+
+func main() {
+	flag.Parse()
+
+	w, err := exportutil.NewWriter(csvHeader)
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx := context.Background()
+	conn, err := exportutil.Dial(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer conn.Close()
+
+	stream, err := ethpb.NewBeaconChainClient(conn).StreamValidatorRegistry(ctx, &ethpb.ValidatorRegistryRequest{Epoch: *epoch})
+	if err != nil {
+		log.Fatalf("Could not stream validator registry: %v", err)
+	}
+	count := 0
+	for {
+		entry, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("Could not receive validator registry: %v", err)
+		}
+		if entry.Epoch != *epoch {
+			log.Fatalf("Wanted the validator registry of epoch %d, got the registry of epoch %d", *epoch, entry.Epoch)
+		}
+		if err := w.Write(csvRow(entry), jsonValue(entry)); err != nil {
+			log.Fatalf("Could not write validator: %v", err)
+		}
+		count++
+	}
+	if err := w.Close(); err != nil {
+		log.Fatalf("Could not write validator registry: %v", err)
+	}
+	log.Printf("Exported %d validators", count)
+}
+
+func csvRow(entry *ethpb.ValidatorRegistryEntry) []string {
+	v := entry.Validator
+	return []string{
+		strconv.FormatUint(entry.Epoch, 10),
+		strconv.FormatUint(entry.Index, 10),
+		exportutil.Hex(v.PublicKey),
+		entry.Status,
+		strconv.FormatUint(entry.Balance, 10),
+		strconv.FormatUint(v.EffectiveBalance, 10),
+		strconv.FormatBool(v.Slashed),
+		strconv.FormatUint(v.ActivationEligibilityEpoch, 10),
+		strconv.FormatUint(v.ActivationEpoch, 10),
+		strconv.FormatUint(v.ExitEpoch, 10),
+		strconv.FormatUint(v.WithdrawableEpoch, 10),
+	}
+}
+
+func jsonValue(entry *ethpb.ValidatorRegistryEntry) *jsonEntry {
+	v := entry.Validator
+	return &jsonEntry{
+		Epoch:                      entry.Epoch,
+		Index:                      entry.Index,
+		PublicKey:                  exportutil.Hex(v.PublicKey),
+		Status:                     entry.Status,
+		Balance:                    entry.Balance,
+		EffectiveBalance:           v.EffectiveBalance,
+		Slashed:                    v.Slashed,
+		ActivationEligibilityEpoch: v.ActivationEligibilityEpoch,
+		ActivationEpoch:            v.ActivationEpoch,
+		ExitEpoch:                  v.ExitEpoch,
+		WithdrawableEpoch:          v.WithdrawableEpoch,
+	}
+}
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// nonFinalityChaos withholds the duties of a fraction of the simulated validators for a window of
+// epochs, to push a devnet into non-finality, then asserts that the chain did not finalize during
+// the window and finalizes again once they participate. Withholding more than a third of the validators stalls finality, exercising
+// the pruning, memory and fork choice code of the beacon node under non-finality.
+type nonFinalityChaos struct {
+	withheld map[string]bool
+	// startEpoch is the first epoch whose duties are withheld, endEpoch the first epoch where
+	// participation resumes.
+	startEpoch uint64
+	endEpoch   uint64
+	// deadlineEpoch is the epoch by which the chain must have finalized an epoch from endEpoch on.
+	deadlineEpoch uint64
+	lock          sync.Mutex
+	// stalled is set once the chain is seen not finalizing during the window.
+	stalled   bool
+	recovered bool
+	failure   error
+}
+
+// newNonFinalityChaos withholds the duties of the given fraction of the keys, from startEpoch for
+// the given number of epochs. The chain must recover finality within recoveryEpochs of the
+// validators participating again.
+func newNonFinalityChaos(keys map[string]*keystore.Key, fraction float64, startEpoch uint64, epochs uint64, recoveryEpochs uint64) (*nonFinalityChaos, error) {
+	if fraction <= 0 || fraction > 1 {
+		return nil, fmt.Errorf("withheld fraction %f must be greater than 0 and at most 1", fraction)
+	}
+	pubkeys := make([]string, 0, len(keys))
+	for pk := range keys {
+		pubkeys = append(pubkeys, pk)
+	}
+	sort.Strings(pubkeys)
+	count := int(math.Ceil(fraction * float64(len(pubkeys))))
+	withheld := make(map[string]bool, count)
+	for _, pk := range pubkeys[:count] {
+		withheld[pk] = true
+	}
+	return &nonFinalityChaos{
+		withheld:      withheld,
+		startEpoch:    startEpoch,
+		endEpoch:      startEpoch + epochs,
+		deadlineEpoch: startEpoch + epochs + recoveryEpochs,
+	}, nil
+}
+
+// withholds returns true if the duty of the validator at the slot is withheld. A nil chaos
+// withholds nothing.
+func (c *nonFinalityChaos) withholds(pubkey string, slot uint64) bool {
+	if c == nil {
+		return false
+	}
+	epoch := slot / params.BeaconConfig().SlotsPerEpoch
+	return epoch >= c.startEpoch && epoch < c.endEpoch && c.withheld[pubkey]
+}
+
+// observe checks the finalized epoch of the chain at a slot from startEpoch on. No epoch of the
+// window may be finalized while duties are withheld: withholding a third of the validators or
+// less, for instance when other validators run on the devnet, does not stall finality and fails
+// the test. Once participation resumed, it returns true the first time the recovery is observed,
+// and an error the first time the deadline passes without the chain recovering.
+func (c *nonFinalityChaos) observe(slot uint64, finalizedEpoch uint64) (bool, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	epoch := slot / params.BeaconConfig().SlotsPerEpoch
+	if c.recovered || c.failure != nil || epoch < c.startEpoch {
+		return false, nil
+	}
+	if epoch < c.endEpoch {
+		if finalizedEpoch >= c.startEpoch {
+			c.failure = fmt.Errorf("chain finalized epoch %d while duties were withheld from epoch %d to %d",
+				finalizedEpoch, c.startEpoch, c.endEpoch)
+			return false, c.failure
+		}
+		c.stalled = true
+		return false, nil
+	}
+	if finalizedEpoch >= c.endEpoch {
+		if !c.stalled {
+			c.failure = fmt.Errorf("chain was not observed without finality from epoch %d to %d", c.startEpoch, c.endEpoch)
+			return false, c.failure
+		}
+		c.recovered = true
+		return true, nil
+	}
+	if epoch >= c.deadlineEpoch {
+		c.failure = fmt.Errorf("chain did not finalize epoch %d by epoch %d, last finalized epoch is %d",
+			c.endEpoch, c.deadlineEpoch, finalizedEpoch)
+		return false, c.failure
+	}
+	return false, nil
+}
+
+// concluded returns true once the chain recovered or the deadline passed.
+func (c *nonFinalityChaos) concluded() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.recovered || c.failure != nil
+}
+
+// err returns the failure of the recovery assertion, if any.
+func (c *nonFinalityChaos) err() error {
+	if c == nil {
+		return nil
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.failure
+}
+
+// CheckFinalityRecovery asserts that the chain does not finalize while duties are withheld, and
+// finalizes again after the withheld validators resumed their duties, when running a non-finality
+// chaos test. The client exits with a non-zero code if either assertion fails, so that the test
+// fails in CI.
+func (v *validator) CheckFinalityRecovery(ctx context.Context, slot uint64) {
+	if v.chaos == nil || slot/params.BeaconConfig().SlotsPerEpoch < v.chaos.startEpoch || v.chaos.concluded() {
+		return
+	}
+	ctx, span := trace.StartSpan(ctx, "validator.CheckFinalityRecovery")
+	defer span.End()
+
+	head, err := v.chainClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		log.WithError(err).Error("Could not get chain head to check finality recovery")
+		return
+	}
+	finalizedEpoch := head.FinalizedSlot / params.BeaconConfig().SlotsPerEpoch
+	recovered, err := v.chaos.observe(slot, finalizedEpoch)
+	if err != nil {
+		log.WithError(err).Fatal("Non-finality chaos test failed")
+	}
+	if recovered {
+		log.WithFields(logrus.Fields{
+			"finalizedEpoch": finalizedEpoch,
+			"slot":           slot,
+		}).Info("Chain recovered finality after the withheld validators resumed their duties")
+	}
+}
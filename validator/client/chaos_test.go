@@ -0,0 +1,183 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/sirupsen/logrus"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+)
+
+type fakeChainHeadClient struct {
+	ethpb.BeaconChainClient
+	head *ethpb.ChainHead
+}
+
+func (f *fakeChainHeadClient) GetChainHead(_ context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	return f.head, nil
+}
+
+func TestNewNonFinalityChaos_WithholdsFraction(t *testing.T) {
+	keys, err := generateSimulatedKeys(0, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chaos, err := newNonFinalityChaos(keys, 0.4, 2, 3, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chaos.withheld) != 4 {
+		t.Errorf("Wanted 4 withheld keys, got %d", len(chaos.withheld))
+	}
+	if chaos.endEpoch != 5 || chaos.deadlineEpoch != 9 {
+		t.Errorf("Wanted end epoch 5 and deadline epoch 9, got %d and %d", chaos.endEpoch, chaos.deadlineEpoch)
+	}
+	if _, err := newNonFinalityChaos(keys, 0, 2, 3, 4); err == nil {
+		t.Error("Expected an error for a zero fraction")
+	}
+	if _, err := newNonFinalityChaos(keys, 1.5, 2, 3, 4); err == nil {
+		t.Error("Expected an error for a fraction above 1")
+	}
+}
+
+func TestRolesAt_Withheld(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	pk1 := hex.EncodeToString([]byte("pk1"))
+	pk2 := hex.EncodeToString([]byte("pk2"))
+	v := validator{
+		assignments: &pb.AssignmentResponse{
+			ValidatorAssignment: []*pb.AssignmentResponse_ValidatorAssignment{
+				{Slot: slotsPerEpoch, IsProposer: true, PublicKey: []byte("pk1")},
+				{Slot: slotsPerEpoch, PublicKey: []byte("pk2")},
+			},
+		},
+		chaos: &nonFinalityChaos{
+			withheld:   map[string]bool{pk1: true},
+			startEpoch: 1,
+			endEpoch:   2,
+		},
+	}
+	roleMap := v.RolesAt(slotsPerEpoch)
+	if roleMap[pk1] != pb.ValidatorRole_UNKNOWN {
+		t.Errorf("Wanted withheld proposer to have role UNKNOWN, got %v", roleMap[pk1])
+	}
+	if roleMap[pk2] != pb.ValidatorRole_ATTESTER {
+		t.Errorf("Wanted attester to keep its role, got %v", roleMap[pk2])
+	}
+
+	// Duties resume at the end epoch.
+	for _, assignment := range v.assignments.ValidatorAssignment {
+		assignment.Slot = 2 * slotsPerEpoch
+	}
+	if role := v.RolesAt(2 * slotsPerEpoch)[pk1]; role != pb.ValidatorRole_PROPOSER {
+		t.Errorf("Wanted proposer once participation resumes, got %v", role)
+	}
+}
+
+func TestCheckFinalityRecovery_Recovered(t *testing.T) {
+	hook := logTest.NewGlobal()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	chainClient := &fakeChainHeadClient{head: &ethpb.ChainHead{FinalizedSlot: 0}}
+	v := &validator{
+		chainClient: chainClient,
+		chaos:       &nonFinalityChaos{startEpoch: 1, endEpoch: 3, deadlineEpoch: 6},
+	}
+
+	v.CheckFinalityRecovery(context.Background(), 2*slotsPerEpoch)
+	v.CheckFinalityRecovery(context.Background(), 4*slotsPerEpoch)
+	chainClient.head.FinalizedSlot = 3 * slotsPerEpoch
+	v.CheckFinalityRecovery(context.Background(), 5*slotsPerEpoch)
+	testutil.AssertLogsContain(t, hook, "Chain recovered finality")
+	if err := v.chaos.err(); err != nil {
+		t.Errorf("Wanted no failure, got %v", err)
+	}
+}
+
+func TestCheckFinalityRecovery_Failed(t *testing.T) {
+	hook := logTest.NewGlobal()
+	exitCode := 0
+	exitFunc := logrus.StandardLogger().ExitFunc
+	logrus.StandardLogger().ExitFunc = func(code int) { exitCode = code }
+	defer func() { logrus.StandardLogger().ExitFunc = exitFunc }()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	v := &validator{
+		chainClient: &fakeChainHeadClient{head: &ethpb.ChainHead{FinalizedSlot: 2 * slotsPerEpoch}},
+		chaos:       &nonFinalityChaos{startEpoch: 1, endEpoch: 3, deadlineEpoch: 6},
+	}
+
+	v.CheckFinalityRecovery(context.Background(), 5*slotsPerEpoch)
+	if err := v.chaos.err(); err != nil {
+		t.Fatalf("Wanted no failure before the deadline, got %v", err)
+	}
+	v.CheckFinalityRecovery(context.Background(), 6*slotsPerEpoch)
+	testutil.AssertLogsContain(t, hook, "Non-finality chaos test failed")
+	if exitCode != 1 {
+		t.Errorf("Wanted the client to exit with code 1, got %d", exitCode)
+	}
+	if err := v.chaos.err(); err == nil {
+		t.Error("Expected a failure once the deadline passed")
+	}
+	service := &ValidatorService{conn: &grpc.ClientConn{}, chaos: v.chaos}
+	if err := service.Status(); err == nil {
+		t.Error("Expected the service status to report the failure")
+	}
+}
+
+func TestCheckFinalityRecovery_FinalizedWhileWithheld(t *testing.T) {
+	hook := logTest.NewGlobal()
+	exitCode := 0
+	exitFunc := logrus.StandardLogger().ExitFunc
+	logrus.StandardLogger().ExitFunc = func(code int) { exitCode = code }
+	defer func() { logrus.StandardLogger().ExitFunc = exitFunc }()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	v := &validator{
+		chainClient: &fakeChainHeadClient{head: &ethpb.ChainHead{FinalizedSlot: slotsPerEpoch}},
+		chaos:       &nonFinalityChaos{startEpoch: 1, endEpoch: 3, deadlineEpoch: 6},
+	}
+
+	// Finality is not checked before duties are withheld.
+	v.CheckFinalityRecovery(context.Background(), 0)
+	if err := v.chaos.err(); err != nil {
+		t.Fatalf("Wanted no failure before the window, got %v", err)
+	}
+	v.CheckFinalityRecovery(context.Background(), 2*slotsPerEpoch)
+	testutil.AssertLogsContain(t, hook, "Non-finality chaos test failed")
+	if exitCode != 1 {
+		t.Errorf("Wanted the client to exit with code 1, got %d", exitCode)
+	}
+	if err := v.chaos.err(); err == nil {
+		t.Error("Expected a failure when the chain finalizes while duties are withheld")
+	}
+}
+
+func TestCheckFinalityRecovery_StallNotObserved(t *testing.T) {
+	exitFunc := logrus.StandardLogger().ExitFunc
+	logrus.StandardLogger().ExitFunc = func(int) {}
+	defer func() { logrus.StandardLogger().ExitFunc = exitFunc }()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	v := &validator{
+		chainClient: &fakeChainHeadClient{head: &ethpb.ChainHead{FinalizedSlot: 3 * slotsPerEpoch}},
+		chaos:       &nonFinalityChaos{startEpoch: 1, endEpoch: 3, deadlineEpoch: 6},
+	}
+
+	v.CheckFinalityRecovery(context.Background(), 4*slotsPerEpoch)
+	if err := v.chaos.err(); err == nil {
+		t.Error("Expected a failure when the chain was never seen without finality")
+	}
+}
+
+func TestNewValidatorService_WithholdRequiresSimulate(t *testing.T) {
+	if _, err := NewValidatorService(context.Background(), &Config{
+		WithholdFraction: 0.5,
+		WithholdEpochs:   2,
+	}); err == nil {
+		t.Error("Expected an error when withholding duties outside of simulation mode")
+	}
+}
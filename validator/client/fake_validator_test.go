@@ -28,6 +28,8 @@ type fakeValidator struct {
 	ProposeBlockArg1                 uint64
 	LogValidatorGainsAndLossesCalled bool
 	SlotDeadlineCalled               bool
+	CheckMissedProposalsCalled       bool
+	CheckFinalityRecoveryCalled      bool
 	PublicKey                        string
 }
 
@@ -66,6 +68,9 @@ func (fv *fakeValidator) UpdateAssignments(_ context.Context, slot uint64) error
 	return fv.UpdateAssignmentsRet
 }
 
+func (fv *fakeValidator) StreamDuties(_ context.Context) {
+}
+
 func (fv *fakeValidator) LogValidatorGainsAndLosses(_ context.Context, slot uint64) error {
 	fv.LogValidatorGainsAndLossesCalled = true
 	return nil
@@ -88,3 +93,11 @@ func (fv *fakeValidator) ProposeBlock(_ context.Context, slot uint64, idx string
 	fv.ProposeBlockCalled = true
 	fv.ProposeBlockArg1 = slot
 }
+
+func (fv *fakeValidator) CheckMissedProposals(_ context.Context, slot uint64) {
+	fv.CheckMissedProposalsCalled = true
+}
+
+func (fv *fakeValidator) CheckFinalityRecovery(_ context.Context, slot uint64) {
+	fv.CheckFinalityRecoveryCalled = true
+}
@@ -0,0 +1,303 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// The stages of a block proposal, the stage of a missed proposal is the one it failed at.
+const (
+	proposalStageNotAttempted = "not_attempted"
+	proposalStageRandao       = "randao"
+	proposalStageRequestBlock = "request_block"
+	proposalStageSign         = "sign"
+	proposalStagePropose      = "propose_block"
+	proposalStageProposed     = "proposed"
+)
+
+// missedProposalCheckDelay is the number of slots after a proposal slot before looking for the
+// block on chain, which leaves time for a late block to reach the beacon node.
+const missedProposalCheckDelay = 2
+
+// webhookTimeout bounds the time spent posting an alert to the webhook.
+var webhookTimeout = 10 * time.Second
+
+// maxPendingAlerts is the number of alerts posted to the webhook at the same time. Alerts
+// missed while the webhook is that slow are only logged.
+const maxPendingAlerts = 4
+
+var missedProposals = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "validator_missed_proposals_total",
+	Help: "The number of slots a validator key was the proposer of, without its block on chain, by the stage the proposal reached.",
+}, []string{"stage"})
+
+// proposalRecord is a proposal duty of a validator key, awaiting its block on chain.
+type proposalRecord struct {
+	pk    string // Key of the validator keys map.
+	slot  uint64
+	stage string
+	err   error
+}
+
+// proposalWatchdog tracks the proposal duties of the validator keys until their blocks are
+// found on chain, and alerts on the ones which never made it.
+type proposalWatchdog struct {
+	lock    sync.Mutex
+	pending map[uint64]map[string]*proposalRecord // Slot -> key -> record.
+	webhook string
+	alerts  chan struct{} // Semaphore of the alerts being posted.
+}
+
+// missedProposalAlert is the JSON body posted to the webhook. Text makes the alert readable by
+// chat webhooks expecting a message.
+type missedProposalAlert struct {
+	Text      string `json:"text"`
+	PublicKey string `json:"publicKey"`
+	Slot      uint64 `json:"slot"`
+	Stage     string `json:"stage"`
+	Error     string `json:"error,omitempty"`
+}
+
+func newProposalWatchdog(webhook string) *proposalWatchdog {
+	return &proposalWatchdog{
+		pending: make(map[uint64]map[string]*proposalRecord),
+		webhook: webhook,
+		alerts:  make(chan struct{}, maxPendingAlerts),
+	}
+}
+
+// expect records a proposal duty the validator client has yet to perform. A nil watchdog
+// ignores every record.
+func (w *proposalWatchdog) expect(pk string, slot uint64) {
+	if w == nil {
+		return
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if _, ok := w.pending[slot][pk]; ok {
+		return
+	}
+	w.add(&proposalRecord{pk: pk, slot: slot, stage: proposalStageNotAttempted})
+}
+
+// record the stage a proposal duty reached, and the error which stopped it if any.
+func (w *proposalWatchdog) record(pk string, slot uint64, stage string, err error) {
+	if w == nil {
+		return
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.add(&proposalRecord{pk: pk, slot: slot, stage: stage, err: err})
+}
+
+// due removes and returns the records old enough for their blocks to be looked up at the
+// given slot.
+func (w *proposalWatchdog) due(slot uint64) []*proposalRecord {
+	if w == nil {
+		return nil
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	var records []*proposalRecord
+	for s, bySlot := range w.pending {
+		if s+missedProposalCheckDelay > slot {
+			continue
+		}
+		for _, r := range bySlot {
+			records = append(records, r)
+		}
+		delete(w.pending, s)
+	}
+	return records
+}
+
+// retry puts back a record which could not be checked, unless a newer record replaced it.
+func (w *proposalWatchdog) retry(r *proposalRecord) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if _, ok := w.pending[r.slot][r.pk]; ok {
+		return
+	}
+	w.add(r)
+}
+
+func (w *proposalWatchdog) add(r *proposalRecord) {
+	if w.pending[r.slot] == nil {
+		w.pending[r.slot] = make(map[string]*proposalRecord)
+	}
+	w.pending[r.slot][r.pk] = r
+}
+
+// alert posts a missed proposal to the webhook, if one is configured.
+func (w *proposalWatchdog) alert(alert *missedProposalAlert) error {
+	if w.webhook == "" {
+		return nil
+	}
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	client := &http.Client{Timeout: webhookTimeout}
+	resp, err := client.Post(w.webhook, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %s", resp.Status)
+	}
+	return nil
+}
+
+// expectProposals records the upcoming proposal duties of the assignments with the watchdog,
+// so that a proposal the client never attempted is reported too.
+func (v *validator) expectProposals(resp *pb.AssignmentResponse, slot uint64) {
+	for _, assignment := range resp.ValidatorAssignment {
+		// The genesis block is never proposed.
+		if !assignment.IsProposer || assignment.Status != pb.ValidatorStatus_ACTIVE || assignment.Slot < slot || assignment.Slot == 0 {
+			continue
+		}
+		v.proposals.expect(hex.EncodeToString(assignment.PublicKey), assignment.Slot)
+	}
+}
+
+// CheckMissedProposals looks up the blocks of the proposal duties old enough to be on chain
+// at the given slot, and alerts for each duty without a block of its validator key. Missed
+// proposals are logged with the stage the duty failed at, counted in a metric and posted to
+// the webhook of the validator client.
+func (v *validator) CheckMissedProposals(ctx context.Context, slot uint64) {
+	ctx, span := trace.StartSpan(ctx, "validator.CheckMissedProposals")
+	defer span.End()
+
+	for _, r := range v.proposals.due(slot) {
+		proposed, err := v.proposedBlockAt(ctx, r.pk, r.slot)
+		if err != nil {
+			log.WithError(err).WithField("slot", r.slot).Warn("Could not check the block of a proposal duty, retrying next slot")
+			v.proposals.retry(r)
+			continue
+		}
+		if proposed {
+			continue
+		}
+		v.reportMissedProposal(r)
+	}
+}
+
+// proposedBlockAt checks whether the canonical block of the slot is a block of the validator
+// key, so that a block which lost fork choice is reported as missed. Randao reveals are
+// deterministic signatures, so the block of the key is the one carrying the reveal the key
+// produces for the epoch.
+func (v *validator) proposedBlockAt(ctx context.Context, pk string, slot uint64) (bool, error) {
+	root, err := v.canonicalBlockRoot(ctx, slot)
+	if err != nil {
+		return false, err
+	}
+	if root == nil {
+		return false, nil
+	}
+	resp, err := v.chainClient.ListBlocks(ctx, &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Root{Root: root},
+	})
+	v.status.recordRPC(err)
+	if err != nil {
+		return false, errors.Wrap(err, "could not list blocks")
+	}
+	if len(resp.Blocks) == 0 {
+		return false, nil
+	}
+	reveal, err := v.randaoReveal(ctx, pk, slot/params.BeaconConfig().SlotsPerEpoch)
+	if err != nil {
+		return false, errors.Wrap(err, "could not compute randao reveal")
+	}
+	return bytes.Equal(resp.Blocks[0].RandaoReveal, reveal), nil
+}
+
+// canonicalBlockRoot returns the root of the canonical block of the slot, or nil if the slot is
+// empty on the canonical chain.
+func (v *validator) canonicalBlockRoot(ctx context.Context, slot uint64) ([]byte, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := v.chainClient.StreamCanonicalBlocks(ctx, &ethpb.CanonicalBlocksRequest{
+		StartSlot: slot,
+		EndSlot:   slot,
+	})
+	v.status.recordRPC(err)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not stream canonical blocks")
+	}
+	record, err := stream.Recv()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not receive canonical block")
+	}
+	if record.Slot != slot {
+		return nil, nil
+	}
+	return record.Root, nil
+}
+
+func (v *validator) reportMissedProposal(r *proposalRecord) {
+	pubKey := "0x" + r.pk
+	missedProposals.WithLabelValues(r.stage).Inc()
+	alert := &missedProposalAlert{
+		Text:      fmt.Sprintf("Validator %s missed its block proposal at slot %d, stage reached: %s", pubKey, r.slot, r.stage),
+		PublicKey: pubKey,
+		Slot:      r.slot,
+		Stage:     r.stage,
+	}
+	lFields := logrus.Fields{
+		"publicKey": pubKey,
+		"slot":      r.slot,
+		"stage":     r.stage,
+	}
+	if r.err != nil {
+		alert.Error = r.err.Error()
+		lFields["error"] = r.err
+	}
+	log.WithFields(lFields).Error("Missed block proposal")
+	if v.proposals.webhook == "" {
+		return
+	}
+	select {
+	case v.proposals.alerts <- struct{}{}:
+	default:
+		log.WithFields(lFields).Warn("Too many alerts pending, not posting missed block proposal to webhook")
+		return
+	}
+	go func() {
+		defer func() { <-v.proposals.alerts }()
+		if err := v.proposals.alert(alert); err != nil {
+			log.WithError(err).Error("Could not post missed block proposal to webhook")
+		}
+	}()
+}
+
+// randaoReveal signs the epoch with the validator key, as the randao reveal of its blocks.
+func (v *validator) randaoReveal(ctx context.Context, pk string, epoch uint64) ([]byte, error) {
+	domain, err := v.domainData(ctx, epoch, params.BeaconConfig().DomainRandao)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 32)
+	binary.LittleEndian.PutUint64(buf, epoch)
+	return v.keys[pk].SecretKey.Sign(buf, domain.SignatureDomain).Marshal(), nil
+}
@@ -0,0 +1,221 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+)
+
+// fakeBeaconChainClient serves its blocks by root, the canonical one being the last block of
+// each slot.
+type fakeBeaconChainClient struct {
+	ethpb.BeaconChainClient
+	blocks []*ethpb.BeaconBlock
+	err    error
+}
+
+func (f *fakeBeaconChainClient) ListBlocks(_ context.Context, req *ethpb.ListBlocksRequest, _ ...grpc.CallOption) (*ethpb.ListBlocksResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	var blocks []*ethpb.BeaconBlock
+	for _, b := range f.blocks {
+		root, err := ssz.SigningRoot(b)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(root[:], req.GetRoot()) {
+			blocks = append(blocks, b)
+		}
+	}
+	return &ethpb.ListBlocksResponse{Blocks: blocks}, nil
+}
+
+func (f *fakeBeaconChainClient) StreamCanonicalBlocks(_ context.Context, req *ethpb.CanonicalBlocksRequest, _ ...grpc.CallOption) (ethpb.BeaconChain_StreamCanonicalBlocksClient, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	stream := &fakeCanonicalBlocksStream{}
+	for _, b := range f.blocks {
+		if b.Slot < req.StartSlot || b.Slot > req.EndSlot {
+			continue
+		}
+		root, err := ssz.SigningRoot(b)
+		if err != nil {
+			return nil, err
+		}
+		record := &ethpb.CanonicalBlockRecord{Slot: b.Slot, Root: root[:]}
+		if n := len(stream.records); n > 0 && stream.records[n-1].Slot == b.Slot {
+			stream.records[n-1] = record
+			continue
+		}
+		stream.records = append(stream.records, record)
+	}
+	return stream, nil
+}
+
+type fakeCanonicalBlocksStream struct {
+	grpc.ClientStream
+	records []*ethpb.CanonicalBlockRecord
+}
+
+func (s *fakeCanonicalBlocksStream) Recv() (*ethpb.CanonicalBlockRecord, error) {
+	if len(s.records) == 0 {
+		return nil, io.EOF
+	}
+	record := s.records[0]
+	s.records = s.records[1:]
+	return record, nil
+}
+
+func TestCheckMissedProposals_ReportsStage(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, finish := setup(t)
+	defer finish()
+	alerts := make(chan *missedProposalAlert, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		alert := &missedProposalAlert{}
+		if err := json.NewDecoder(r.Body).Decode(alert); err != nil {
+			t.Error(err)
+		}
+		alerts <- alert
+	}))
+	defer server.Close()
+	pk := hex.EncodeToString(validatorKey.PublicKey.Marshal())
+	validator.proposals = newProposalWatchdog(server.URL)
+	validator.chainClient = &fakeBeaconChainClient{}
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&pb.DomainResponse{}, nil /*err*/)
+	m.proposerClient.EXPECT().RequestBlock(
+		gomock.Any(), // ctx
+		gomock.Any(), // block request
+	).Return(nil /*response*/, errors.New("uh oh"))
+	validator.ProposeBlock(context.Background(), 1, pk)
+
+	// Too early to look for the block.
+	validator.CheckMissedProposals(context.Background(), 2)
+	testutil.AssertLogsDoNotContain(t, hook, "Missed block proposal")
+
+	validator.CheckMissedProposals(context.Background(), 3)
+	testutil.AssertLogsContain(t, hook, "Missed block proposal")
+	alert := <-alerts
+	if alert.Slot != 1 || alert.Stage != proposalStageRequestBlock || alert.Error != "uh oh" {
+		t.Errorf("Wanted missed proposal at slot 1 in stage %s, got %v", proposalStageRequestBlock, alert)
+	}
+}
+
+func TestCheckMissedProposals_BlockOnChain(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, finish := setup(t)
+	defer finish()
+	pk := hex.EncodeToString(validatorKey.PublicKey.Marshal())
+	validator.proposals = newProposalWatchdog("")
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&pb.DomainResponse{}, nil /*err*/).AnyTimes()
+	reveal, err := validator.randaoReveal(context.Background(), pk, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator.chainClient = &fakeBeaconChainClient{blocks: []*ethpb.BeaconBlock{
+		{Slot: 1, RandaoReveal: []byte("someone else")},
+		{Slot: 1, RandaoReveal: reveal},
+	}}
+	validator.proposals.record(pk, 1, proposalStageProposed, nil)
+
+	validator.CheckMissedProposals(context.Background(), 3)
+	testutil.AssertLogsDoNotContain(t, hook, "Missed block proposal")
+}
+
+func TestCheckMissedProposals_BlockOnFork(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, finish := setup(t)
+	defer finish()
+	pk := hex.EncodeToString(validatorKey.PublicKey.Marshal())
+	validator.proposals = newProposalWatchdog("")
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&pb.DomainResponse{}, nil /*err*/).AnyTimes()
+	reveal, err := validator.randaoReveal(context.Background(), pk, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The block of the key lost fork choice to another block of the slot.
+	validator.chainClient = &fakeBeaconChainClient{blocks: []*ethpb.BeaconBlock{
+		{Slot: 1, RandaoReveal: reveal},
+		{Slot: 1, RandaoReveal: []byte("someone else")},
+	}}
+	validator.proposals.record(pk, 1, proposalStageProposed, nil)
+
+	validator.CheckMissedProposals(context.Background(), 3)
+	testutil.AssertLogsContain(t, hook, "Missed block proposal")
+}
+
+func TestReportMissedProposal_BoundsPendingAlerts(t *testing.T) {
+	hook := logTest.NewGlobal()
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+	v := &validator{proposals: newProposalWatchdog(server.URL)}
+
+	for slot := uint64(1); slot <= maxPendingAlerts+1; slot++ {
+		v.reportMissedProposal(&proposalRecord{pk: "aa", slot: slot, stage: proposalStageNotAttempted})
+	}
+	testutil.AssertLogsContain(t, hook, "Too many alerts pending")
+	if n := len(v.proposals.alerts); n != maxPendingAlerts {
+		t.Errorf("Wanted %d alerts pending, got %d", maxPendingAlerts, n)
+	}
+}
+
+func TestCheckMissedProposals_NotAttempted(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, _, finish := setup(t)
+	defer finish()
+	validator.proposals = newProposalWatchdog("")
+	validator.chainClient = &fakeBeaconChainClient{err: errors.New("unavailable")}
+
+	validator.expectProposals(&pb.AssignmentResponse{
+		ValidatorAssignment: []*pb.AssignmentResponse_ValidatorAssignment{
+			{
+				PublicKey:  validatorKey.PublicKey.Marshal(),
+				Slot:       5,
+				IsProposer: true,
+				Status:     pb.ValidatorStatus_ACTIVE,
+			},
+		},
+	}, 4)
+
+	// The check is retried while the beacon node can't be queried.
+	validator.CheckMissedProposals(context.Background(), 7)
+	testutil.AssertLogsContain(t, hook, "Could not check the block of a proposal duty")
+	testutil.AssertLogsDoNotContain(t, hook, "Missed block proposal")
+
+	validator.chainClient = &fakeBeaconChainClient{}
+	validator.CheckMissedProposals(context.Background(), 8)
+	testutil.AssertLogsContain(t, hook, "Missed block proposal")
+	testutil.AssertLogsContain(t, hook, proposalStageNotAttempted)
+}
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// nonIdempotentMethods are the beacon node endpoints whose requests are never retried: a request
+// which timed out may have been processed, and sending it again could broadcast the block or the
+// attestation twice.
+var nonIdempotentMethods = map[string]bool{
+	"/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock":      true,
+	"/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation": true,
+}
+
+// retryPolicy configures how requests to the beacon node are retried when it is temporarily
+// unavailable, and when an endpoint failing repeatedly is considered degraded.
+type retryPolicy struct {
+	// maxAttempts is the number of times a request is sent before giving up.
+	maxAttempts int
+	// baseDelay is the backoff before the first retry, doubled after each attempt.
+	baseDelay time.Duration
+	// maxDelay caps the backoff between two attempts.
+	maxDelay time.Duration
+	// breakerThreshold is the number of consecutive failed requests after which the circuit
+	// of an endpoint opens, failing its requests without contacting the beacon node.
+	breakerThreshold int
+	// breakerCooldown is how long the circuit of an endpoint stays open before a request is
+	// let through again to probe the beacon node.
+	breakerCooldown time.Duration
+}
+
+func defaultRetryPolicy() *retryPolicy {
+	return &retryPolicy{
+		maxAttempts:      4,
+		baseDelay:        100 * time.Millisecond,
+		maxDelay:         2 * time.Second,
+		breakerThreshold: 5,
+		breakerCooldown:  30 * time.Second,
+	}
+}
+
+// backoff returns the delay before the given retry, exponential in the attempt and jittered over
+// its upper half so that validators sharing a beacon node do not retry in lockstep.
+func (p *retryPolicy) backoff(attempt int) time.Duration {
+	delay := p.baseDelay << uint(attempt)
+	if delay <= 0 || delay > p.maxDelay {
+		delay = p.maxDelay
+	}
+	half := int64(delay / 2)
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// retryInterceptor retries the requests failing because the beacon node is unavailable, except
+// those of the non idempotent endpoints. Other errors are not retried: a request rejected by the
+// quotas of the beacon node with ResourceExhausted would only use up more of them.
+func (p *retryPolicy) retryInterceptor() grpc.UnaryClientInterceptor {
+	interceptor := retry.UnaryClientInterceptor(
+		retry.WithMax(uint(p.maxAttempts)),
+		retry.WithCodes(codes.Unavailable),
+		retry.WithBackoff(func(attempt uint) time.Duration {
+			// The middleware counts the first request as attempt 0.
+			return p.backoff(int(attempt) - 1)
+		}),
+	)
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if nonIdempotentMethods[method] {
+			opts = append(opts, retry.Disable())
+		}
+		return interceptor(ctx, method, req, reply, cc, invoker, opts...)
+	}
+}
+
+// degraded returns true if the error shows the beacon node failed to serve the request.
+func degraded(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// circuitBreaker tracks the consecutive failures of a beacon node endpoint.
+type circuitBreaker struct {
+	failures int
+	openedAt time.Time
+	open     bool
+}
+
+// circuitBreakers fail the unary requests of the validator to a beacon node endpoint which
+// failed repeatedly, with a circuit per endpoint.
+type circuitBreakers struct {
+	policy   *retryPolicy
+	lock     sync.Mutex
+	breakers map[string]*circuitBreaker
+	now      func() time.Time
+}
+
+func newCircuitBreakers(policy *retryPolicy) *circuitBreakers {
+	return &circuitBreakers{
+		policy:   policy,
+		breakers: make(map[string]*circuitBreaker),
+		now:      time.Now,
+	}
+}
+
+// allow returns false if the circuit of the endpoint is open. Once the cooldown elapsed, a
+// request is let through to probe whether the beacon node recovered.
+func (c *circuitBreakers) allow(method string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	b, ok := c.breakers[method]
+	if !ok || !b.open {
+		return true
+	}
+	if c.now().Sub(b.openedAt) < c.policy.breakerCooldown {
+		return false
+	}
+	// Half open, the failure of the probe opens the circuit for another cooldown.
+	b.openedAt = c.now()
+	return true
+}
+
+// record updates the circuit of the endpoint with the outcome of a request.
+func (c *circuitBreakers) record(method string, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	b, ok := c.breakers[method]
+	if !ok {
+		b = &circuitBreaker{}
+		c.breakers[method] = b
+	}
+	if !degraded(err) {
+		if b.open {
+			log.WithField("endpoint", method).Info("Beacon node endpoint recovered")
+		}
+		b.failures = 0
+		b.open = false
+		return
+	}
+	b.failures++
+	if !b.open && b.failures >= c.policy.breakerThreshold {
+		b.open = true
+		b.openedAt = c.now()
+		log.WithFields(logrus.Fields{
+			"endpoint":            method,
+			"consecutiveFailures": b.failures,
+			"cooldown":            c.policy.breakerCooldown,
+		}).WithError(err).Warn("Beacon node endpoint degraded, failing requests until it recovers")
+	}
+}
+
+// unaryInterceptor fails the requests to the endpoints with an open circuit, and records the
+// outcome of the others, retries included.
+func (c *circuitBreakers) unaryInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	if !c.allow(method) {
+		return status.Errorf(codes.Unavailable, "beacon node endpoint %s is degraded, circuit open", method)
+	}
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	// Requests abandoned by the validator say nothing about the health of the beacon node.
+	if ctx.Err() != context.Canceled {
+		c.record(method, err)
+	}
+	return err
+}
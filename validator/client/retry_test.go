@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testMethod = "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation"
+
+// failingInvoker fails the first failures requests with the given code, then succeeds.
+type failingInvoker struct {
+	failures int
+	code     codes.Code
+	calls    int
+}
+
+func (f *failingInvoker) invoke(_ context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+	f.calls++
+	if f.calls <= f.failures {
+		return status.Error(f.code, "beacon node hiccup")
+	}
+	return nil
+}
+
+// testRetryPolicy returns the default retry policy with backoffs short enough for tests.
+func testRetryPolicy() *retryPolicy {
+	policy := defaultRetryPolicy()
+	policy.baseDelay = time.Millisecond
+	policy.maxDelay = time.Millisecond
+	return policy
+}
+
+func TestRetryInterceptor_RetriesUnavailable(t *testing.T) {
+	interceptor := testRetryPolicy().retryInterceptor()
+	invoker := &failingInvoker{failures: 2, code: codes.Unavailable}
+
+	if err := interceptor(context.Background(), testMethod, nil, nil, nil, invoker.invoke); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if invoker.calls != 3 {
+		t.Errorf("Wanted 3 calls, got %d", invoker.calls)
+	}
+}
+
+func TestRetryInterceptor_DoesNotRetryOtherErrors(t *testing.T) {
+	interceptor := testRetryPolicy().retryInterceptor()
+	for _, code := range []codes.Code{codes.InvalidArgument, codes.ResourceExhausted, codes.DeadlineExceeded} {
+		invoker := &failingInvoker{failures: 1, code: code}
+		err := interceptor(context.Background(), testMethod, nil, nil, nil, invoker.invoke)
+		if status.Code(err) != code {
+			t.Errorf("Wanted code %v, got %v", code, status.Code(err))
+		}
+		if invoker.calls != 1 {
+			t.Errorf("Wanted 1 call on %v, got %d", code, invoker.calls)
+		}
+	}
+}
+
+func TestRetryInterceptor_DoesNotRetrySubmissions(t *testing.T) {
+	interceptor := testRetryPolicy().retryInterceptor()
+	for method := range nonIdempotentMethods {
+		invoker := &failingInvoker{failures: 1, code: codes.Unavailable}
+		if err := interceptor(context.Background(), method, nil, nil, nil, invoker.invoke); status.Code(err) != codes.Unavailable {
+			t.Errorf("Wanted code %v, got %v", codes.Unavailable, status.Code(err))
+		}
+		if invoker.calls != 1 {
+			t.Errorf("Wanted 1 call to %s, got %d", method, invoker.calls)
+		}
+	}
+}
+
+func TestRetryInterceptor_GivesUpAfterMaxAttempts(t *testing.T) {
+	policy := testRetryPolicy()
+	interceptor := policy.retryInterceptor()
+	invoker := &failingInvoker{failures: 100, code: codes.Unavailable}
+
+	if err := interceptor(context.Background(), testMethod, nil, nil, nil, invoker.invoke); err == nil {
+		t.Fatal("Expected an error")
+	}
+	if invoker.calls != policy.maxAttempts {
+		t.Errorf("Wanted %d calls, got %d", policy.maxAttempts, invoker.calls)
+	}
+}
+
+func TestCircuitBreakers(t *testing.T) {
+	hook := logTest.NewGlobal()
+	policy := defaultRetryPolicy()
+	c := newCircuitBreakers(policy)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	invoker := &failingInvoker{failures: policy.breakerThreshold, code: codes.Unavailable}
+	for i := 0; i < policy.breakerThreshold; i++ {
+		if err := c.unaryInterceptor(context.Background(), testMethod, nil, nil, nil, invoker.invoke); err == nil {
+			t.Fatal("Expected an error")
+		}
+	}
+	testutil.AssertLogsContain(t, hook, "Beacon node endpoint degraded")
+
+	// The circuit is open, requests fail without reaching the beacon node.
+	err := c.unaryInterceptor(context.Background(), testMethod, nil, nil, nil, invoker.invoke)
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("Wanted code %v, got %v", codes.Unavailable, status.Code(err))
+	}
+	if invoker.calls != policy.breakerThreshold {
+		t.Errorf("Wanted %d calls, got %d", policy.breakerThreshold, invoker.calls)
+	}
+	// Other endpoints are not affected.
+	other := &failingInvoker{}
+	if err := c.unaryInterceptor(context.Background(), "/other", nil, nil, nil, other.invoke); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	// After the cooldown, a probe is let through and closes the circuit.
+	now = now.Add(policy.breakerCooldown)
+	if err := c.unaryInterceptor(context.Background(), testMethod, nil, nil, nil, invoker.invoke); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	testutil.AssertLogsContain(t, hook, "Beacon node endpoint recovered")
+	if !c.allow(testMethod) {
+		t.Error("Expected the circuit to be closed")
+	}
+}
+
+func TestCircuitBreakers_IgnoresQuotaErrors(t *testing.T) {
+	policy := defaultRetryPolicy()
+	c := newCircuitBreakers(policy)
+	invoker := &failingInvoker{failures: 100, code: codes.ResourceExhausted}
+	for i := 0; i < policy.breakerThreshold; i++ {
+		c.unaryInterceptor(context.Background(), testMethod, nil, nil, nil, invoker.invoke)
+	}
+	// Requests rejected by the quotas of the beacon node do not open the circuit.
+	if !c.allow(testMethod) {
+		t.Error("Expected the circuit to be closed")
+	}
+}
+
+func TestRetryPolicy_BackoffIsCapped(t *testing.T) {
+	policy := defaultRetryPolicy()
+	for attempt := 0; attempt < 64; attempt++ {
+		delay := policy.backoff(attempt)
+		if delay > policy.maxDelay || delay < 0 {
+			t.Errorf("Backoff %v of attempt %d is not within [0, %v]", delay, attempt, policy.maxDelay)
+		}
+	}
+}
@@ -22,9 +22,12 @@ type Validator interface {
 	SlotDeadline(slot uint64) time.Time
 	LogValidatorGainsAndLosses(ctx context.Context, slot uint64) error
 	UpdateAssignments(ctx context.Context, slot uint64) error
+	StreamDuties(ctx context.Context)
 	RolesAt(slot uint64) map[string]pb.ValidatorRole // validatorIndex -> role
 	AttestToBlockHead(ctx context.Context, slot uint64, idx string)
 	ProposeBlock(ctx context.Context, slot uint64, idx string)
+	CheckMissedProposals(ctx context.Context, slot uint64)
+	CheckFinalityRecovery(ctx context.Context, slot uint64)
 }
 
 // Run the main validator routine. This routine exits if the context is
@@ -32,11 +35,13 @@ type Validator interface {
 //
 // Order of operations:
 // 1 - Initialize validator data
-// 2 - Wait for validator activation
+// 2 - Wait for validator activation, then receive the duties streamed by the beacon node
 // 3 - Wait for the next slot start
 // 4 - Update assignments
 // 5 - Determine role at current slot
 // 6 - Perform assigned role, if any
+// 7 - Check the blocks of past proposals made it on chain
+// 8 - Check the chain recovers finality, when running a non-finality chaos test
 func run(ctx context.Context, v Validator) {
 	defer v.Done()
 	if err := v.WaitForChainStart(ctx); err != nil {
@@ -45,6 +50,7 @@ func run(ctx context.Context, v Validator) {
 	if err := v.WaitForActivation(ctx); err != nil {
 		log.Fatalf("Could not wait for validator activation: %v", err)
 	}
+	go v.StreamDuties(ctx)
 	headSlot, err := v.CanonicalHeadSlot(ctx)
 	if err != nil {
 		log.Fatalf("Could not get current canonical head slot: %v", err)
@@ -100,6 +106,8 @@ func run(ctx context.Context, v Validator) {
 
 				}(role, id)
 			}
+			go v.CheckMissedProposals(slotCtx, slot)
+			go v.CheckFinalityRecovery(slotCtx, slot)
 		}
 	}
 }
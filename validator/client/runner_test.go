@@ -129,6 +129,9 @@ func TestAttests_NextSlot(t *testing.T) {
 	if v.AttestToBlockHeadArg1 != slot {
 		t.Errorf("AttestToBlockHead was called with wrong arg. Want=%d, got=%d", slot, v.AttestToBlockHeadArg1)
 	}
+	if !v.CheckFinalityRecoveryCalled {
+		t.Error("CheckFinalityRecovery was not called")
+	}
 }
 
 func TestProposes_NextSlot(t *testing.T) {
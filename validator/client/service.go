@@ -3,9 +3,12 @@ package client
 import (
 	"context"
 	"fmt"
+	"sync"
 
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/pkg/errors"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
@@ -16,6 +19,9 @@ import (
 
 var log = logrus.WithField("prefix", "validator")
 
+// apiKeyMetadataKey is the gRPC metadata key a beacon node enforcing quotas reads the API key from.
+const apiKeyMetadataKey = "x-api-key"
+
 // ValidatorService represents a service to manage the validator client
 // routine.
 type ValidatorService struct {
@@ -23,33 +29,90 @@ type ValidatorService struct {
 	cancel               context.CancelFunc
 	validator            Validator
 	conn                 *grpc.ClientConn
+	connLock             sync.RWMutex // Guards conn, set by Start while the status is reported.
 	endpoint             string
 	withCert             string
+	apiKey               string
 	key                  *keystore.Key
 	keys                 map[string]*keystore.Key
 	logValidatorBalances bool
+	sharedSignature      []byte
+	status               *statusTracker
+	proposals            *proposalWatchdog
+	chaos                *nonFinalityChaos
 }
 
 // Config for the validator service.
 type Config struct {
 	Endpoint             string
 	CertFlag             string
+	APIKey               string
 	KeystorePath         string
 	Password             string
 	LogValidatorBalances bool
+	// Simulate replaces the keystore with the SimulatedKeys deterministic keys of the validators
+	// from index SimulatedKeyOffset.
+	Simulate           bool
+	SimulatedKeys      uint64
+	SimulatedKeyOffset uint64
+	SharedSignatures   bool
+	// MissedProposalWebhook is the URL missed block proposals are posted to, if set.
+	MissedProposalWebhook string
+	// WithholdFraction of the simulated keys skip their duties for WithholdEpochs epochs from
+	// WithholdStartEpoch, and the chain must finalize again within RecoveryEpochs afterwards.
+	WithholdFraction   float64
+	WithholdStartEpoch uint64
+	WithholdEpochs     uint64
+	RecoveryEpochs     uint64
 }
 
 // NewValidatorService creates a new validator service for the service
 // registry.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
 	ctx, cancel := context.WithCancel(ctx)
-	validatorFolder := cfg.KeystorePath
-	validatorPrefix := params.BeaconConfig().ValidatorPrivkeyFileName
-	ks := keystore.NewKeystore(cfg.KeystorePath)
-	keys, err := ks.GetKeys(validatorFolder, validatorPrefix, cfg.Password)
-	if err != nil {
+	var keys map[string]*keystore.Key
+	var sharedSignature []byte
+	var chaos *nonFinalityChaos
+	var err error
+	if cfg.WithholdEpochs > 0 && !cfg.Simulate {
 		cancel()
-		return nil, errors.Wrap(err, "could not get private key")
+		return nil, errors.New("withholding validator duties is only supported in simulation mode")
+	}
+	if cfg.Simulate {
+		keys, err = generateSimulatedKeys(cfg.SimulatedKeyOffset, cfg.SimulatedKeys)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		if cfg.SharedSignatures {
+			sharedSignature = simulatedSignature(keys)
+		}
+		log.WithFields(logrus.Fields{
+			"numKeys":   len(keys),
+			"keyOffset": cfg.SimulatedKeyOffset,
+		}).Warn("Running in simulation mode with deterministic devnet keys")
+		if cfg.WithholdEpochs > 0 {
+			chaos, err = newNonFinalityChaos(keys, cfg.WithholdFraction, cfg.WithholdStartEpoch, cfg.WithholdEpochs, cfg.RecoveryEpochs)
+			if err != nil {
+				cancel()
+				return nil, errors.Wrap(err, "could not set up withheld validators")
+			}
+			log.WithFields(logrus.Fields{
+				"numWithheld":    len(chaos.withheld),
+				"startEpoch":     chaos.startEpoch,
+				"endEpoch":       chaos.endEpoch,
+				"recoveryEpochs": cfg.RecoveryEpochs,
+			}).Warn("Withholding the duties of simulated validators to stall finality")
+		}
+	} else {
+		validatorFolder := cfg.KeystorePath
+		validatorPrefix := params.BeaconConfig().ValidatorPrivkeyFileName
+		ks := keystore.NewKeystore(cfg.KeystorePath)
+		keys, err = ks.GetKeys(validatorFolder, validatorPrefix, cfg.Password)
+		if err != nil {
+			cancel()
+			return nil, errors.Wrap(err, "could not get private key")
+		}
 	}
 	var key *keystore.Key
 	for _, v := range keys {
@@ -61,9 +124,14 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		cancel:               cancel,
 		endpoint:             cfg.Endpoint,
 		withCert:             cfg.CertFlag,
+		apiKey:               cfg.APIKey,
 		keys:                 keys,
 		key:                  key,
 		logValidatorBalances: cfg.LogValidatorBalances,
+		sharedSignature:      sharedSignature,
+		status:               newStatusTracker(),
+		proposals:            newProposalWatchdog(cfg.MissedProposalWebhook),
+		chaos:                chaos,
 	}, nil
 }
 
@@ -89,22 +157,45 @@ func (v *ValidatorService) Start() {
 		dialOpt = grpc.WithInsecure()
 		log.Warn("You are using an insecure gRPC connection! Please provide a certificate and key to use a secure connection.")
 	}
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpt, grpc.WithStatsHandler(&ocgrpc.ClientHandler{}))
+	policy := defaultRetryPolicy()
+	opts := []grpc.DialOption{
+		dialOpt,
+		grpc.WithStatsHandler(&ocgrpc.ClientHandler{}),
+		// The circuit breakers wrap the retries, a request counts as failed once it is not retried.
+		grpc.WithUnaryInterceptor(middleware.ChainUnaryClient(
+			newCircuitBreakers(policy).unaryInterceptor,
+			policy.retryInterceptor(),
+		)),
+	}
+	if v.apiKey != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(&apiKeyCredentials{
+			key:     v.apiKey,
+			withTLS: v.withCert != "",
+		}))
+	}
+	conn, err := grpc.DialContext(v.ctx, v.endpoint, opts...)
 	if err != nil {
 		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
 		return
 	}
 	log.Info("Successfully started gRPC connection")
+	v.connLock.Lock()
 	v.conn = conn
+	v.connLock.Unlock()
 	v.validator = &validator{
-		beaconClient:         pb.NewBeaconServiceClient(v.conn),
-		validatorClient:      pb.NewValidatorServiceClient(v.conn),
-		attesterClient:       pb.NewAttesterServiceClient(v.conn),
-		proposerClient:       pb.NewProposerServiceClient(v.conn),
+		beaconClient:         pb.NewBeaconServiceClient(conn),
+		validatorClient:      pb.NewValidatorServiceClient(conn),
+		attesterClient:       pb.NewAttesterServiceClient(conn),
+		proposerClient:       pb.NewProposerServiceClient(conn),
 		keys:                 v.keys,
 		pubkeys:              pubkeys,
 		logValidatorBalances: v.logValidatorBalances,
 		prevBalance:          make(map[[48]byte]uint64),
+		sharedSignature:      v.sharedSignature,
+		status:               v.status,
+		chainClient:          ethpb.NewBeaconChainClient(conn),
+		proposals:            v.proposals,
+		chaos:                v.chaos,
 	}
 	go run(v.ctx, v.validator)
 }
@@ -113,8 +204,8 @@ func (v *ValidatorService) Start() {
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
-	if v.conn != nil {
-		return v.conn.Close()
+	if conn := v.connection(); conn != nil {
+		return conn.Close()
 	}
 	return nil
 }
@@ -123,8 +214,52 @@ func (v *ValidatorService) Stop() error {
 //
 // WIP - not done.
 func (v *ValidatorService) Status() error {
-	if v.conn == nil {
+	if v.connection() == nil {
 		return errors.New("no connection to beacon RPC")
 	}
+	if err := v.chaos.err(); err != nil {
+		return errors.Wrap(err, "non-finality chaos test failed")
+	}
 	return nil
 }
+
+// StatusReport summarizes the status of every key managed by the validator service
+// and of its connection to the beacon node.
+func (v *ValidatorService) StatusReport() *StatusReport {
+	pubkeys := make([][]byte, 0, len(v.keys))
+	for _, key := range v.keys {
+		pubkeys = append(pubkeys, key.PublicKey.Marshal())
+	}
+	report := v.status.report(pubkeys)
+	report.Connection.Endpoint = v.endpoint
+	report.Connection.State = "not connected"
+	if conn := v.connection(); conn != nil {
+		report.Connection.State = conn.GetState().String()
+	}
+	return report
+}
+
+// connection returns the connection to the beacon node, nil until the service started.
+func (v *ValidatorService) connection() *grpc.ClientConn {
+	v.connLock.RLock()
+	defer v.connLock.RUnlock()
+	return v.conn
+}
+
+// apiKeyCredentials authenticates the requests to a beacon node shared between several
+// validator clients, which enforces a quota per API key.
+type apiKeyCredentials struct {
+	key     string
+	withTLS bool
+}
+
+// GetRequestMetadata attaches the API key to every request.
+func (c *apiKeyCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{apiKeyMetadataKey: c.key}, nil
+}
+
+// RequireTransportSecurity returns true when the connection uses TLS, so the key is never sent
+// in clear over a connection meant to be secure.
+func (c *apiKeyCredentials) RequireTransportSecurity() bool {
+	return c.withTLS
+}
@@ -104,3 +104,29 @@ func TestStatus_NoConnectionError(t *testing.T) {
 		t.Errorf("Expected status check to fail if no connection is found, received: %v", err)
 	}
 }
+
+func TestStatusReport_WhileStarting(t *testing.T) {
+	// Use canceled context so that the run function exits immediately.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	validatorService := &ValidatorService{
+		ctx:      ctx,
+		cancel:   cancel,
+		endpoint: "merkle tries",
+		keys:     keyMap,
+		status:   newStatusTracker(),
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		validatorService.Start()
+	}()
+	// The report is read while the connection is being set, which the race detector checks.
+	if report := validatorService.StatusReport(); report.Connection.Endpoint != "merkle tries" {
+		t.Errorf("Wanted the endpoint in the report, got %q", report.Connection.Endpoint)
+	}
+	<-done
+	if err := validatorService.Stop(); err != nil {
+		t.Fatalf("Could not stop service: %v", err)
+	}
+}
@@ -0,0 +1,41 @@
+package client
+
+import (
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+)
+
+// simulatedSignatureMessage is the message signed once to produce the
+// signature shared across every simulated attestation.
+var simulatedSignatureMessage = []byte("prysm validator simulation")
+
+// generateSimulatedKeys derives the deterministic keys of the n validators from index offset,
+// held only in memory. These keys are never written to disk and are only meant to be used with
+// the --simulate flag against a devnet beacon node whose genesis deposited the same keys.
+func generateSimulatedKeys(offset uint64, n uint64) (map[string]*keystore.Key, error) {
+	if n == 0 {
+		return nil, errors.New("simulation requires at least one key")
+	}
+	keys := make(map[string]*keystore.Key, n)
+	for i := offset; i < offset+n; i++ {
+		key, err := keystore.DeterministicKey(i)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not generate simulated key")
+		}
+		keys[hex.EncodeToString(key.PublicKey.Marshal())] = key
+	}
+	return keys, nil
+}
+
+// simulatedSignature returns a single signature, computed with one of the given keys,
+// which is reused in place of real attestation signatures. The beacon node does
+// not verify signatures on submitted attestations, so this lets thousands of
+// simulated validators participate without paying the BLS signing cost.
+func simulatedSignature(keys map[string]*keystore.Key) []byte {
+	for _, key := range keys {
+		return key.SecretKey.Sign(simulatedSignatureMessage, 0).Marshal()
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGenerateSimulatedKeys(t *testing.T) {
+	keys, err := generateSimulatedKeys(0, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 16 {
+		t.Errorf("Wanted %d keys, received %d", 16, len(keys))
+	}
+	if _, err := generateSimulatedKeys(0, 0); err == nil {
+		t.Error("Expected error when generating zero keys")
+	}
+	// Clients with an offset validate the following genesis validators, sharing no key.
+	next, err := generateSimulatedKeys(8, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shared := 0
+	for pk := range next {
+		if _, ok := keys[pk]; ok {
+			shared++
+		}
+	}
+	if shared != 8 {
+		t.Errorf("Wanted 8 keys shared by overlapping ranges, got %d", shared)
+	}
+}
+
+func TestNewValidatorService_Simulate(t *testing.T) {
+	v, err := NewValidatorService(context.Background(), &Config{
+		Simulate:         true,
+		SimulatedKeys:    4,
+		SharedSignatures: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(v.keys) != 4 {
+		t.Errorf("Wanted %d keys, received %d", 4, len(v.keys))
+	}
+	if v.sharedSignature == nil {
+		t.Fatal("Expected a shared signature in simulation mode")
+	}
+	if len(v.sharedSignature) != 96 {
+		t.Errorf("Unexpected shared signature length %d", len(v.sharedSignature))
+	}
+}
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+// maxRecentDuties is the number of duty results kept per key for the status page.
+const maxRecentDuties = 10
+
+// StatusReport summarizes the state of the validator client for operators.
+type StatusReport struct {
+	GeneratedAt time.Time         `json:"generatedAt"`
+	CurrentSlot uint64            `json:"currentSlot"`
+	Connection  *ConnectionStatus `json:"connection"`
+	Keys        []*KeyStatus      `json:"keys"`
+}
+
+// ConnectionStatus describes the health of the connection to the beacon node.
+type ConnectionStatus struct {
+	Endpoint      string    `json:"endpoint"`
+	State         string    `json:"state"`
+	LastSuccess   time.Time `json:"lastSuccess"`
+	LastError     string    `json:"lastError,omitempty"`
+	LastErrorTime time.Time `json:"lastErrorTime"`
+}
+
+// KeyStatus describes the status, next duty and recent duty results of a validator key.
+type KeyStatus struct {
+	PublicKey    string        `json:"publicKey"`
+	Status       string        `json:"status"`
+	NextDuty     *Duty         `json:"nextDuty,omitempty"`
+	RecentDuties []*DutyResult `json:"recentDuties"`
+}
+
+// Duty is an upcoming assignment of a validator key.
+type Duty struct {
+	Slot  uint64 `json:"slot"`
+	Role  string `json:"role"`
+	Shard uint64 `json:"shard"`
+}
+
+// DutyResult is the outcome of a duty performed by a validator key. Error is empty
+// when the beacon node accepted the attestation or block.
+type DutyResult struct {
+	Slot  uint64    `json:"slot"`
+	Role  string    `json:"role"`
+	Time  time.Time `json:"time"`
+	Error string    `json:"error,omitempty"`
+}
+
+// statusTracker collects what the validator observes while running, for the status page.
+// A nil tracker ignores every record.
+type statusTracker struct {
+	lock          sync.RWMutex
+	currentSlot   uint64
+	statuses      map[string]pb.ValidatorStatus
+	assignments   map[string]*pb.AssignmentResponse_ValidatorAssignment
+	recentDuties  map[string][]*DutyResult
+	lastSuccess   time.Time
+	lastError     string
+	lastErrorTime time.Time
+}
+
+func newStatusTracker() *statusTracker {
+	return &statusTracker{
+		statuses:     make(map[string]pb.ValidatorStatus),
+		assignments:  make(map[string]*pb.AssignmentResponse_ValidatorAssignment),
+		recentDuties: make(map[string][]*DutyResult),
+	}
+}
+
+// recordRPC records the outcome of a request to the beacon node.
+func (t *statusTracker) recordRPC(err error) {
+	if t == nil {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if err != nil {
+		t.lastError = err.Error()
+		t.lastErrorTime = time.Now()
+		return
+	}
+	t.lastSuccess = time.Now()
+}
+
+func (t *statusTracker) recordSlot(slot uint64) {
+	if t == nil {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.currentSlot = slot
+}
+
+func (t *statusTracker) recordStatuses(statuses []*pb.ValidatorActivationResponse_Status) {
+	if t == nil {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, s := range statuses {
+		t.statuses[fmt.Sprintf("%#x", s.PublicKey)] = s.Status.Status
+	}
+}
+
+func (t *statusTracker) recordAssignments(resp *pb.AssignmentResponse) {
+	if t == nil {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, assignment := range resp.ValidatorAssignment {
+		key := fmt.Sprintf("%#x", assignment.PublicKey)
+		t.assignments[key] = assignment
+		t.statuses[key] = assignment.Status
+	}
+}
+
+func (t *statusTracker) recordDuty(pubKey []byte, slot uint64, role pb.ValidatorRole, err error) {
+	if t == nil {
+		return
+	}
+	result := &DutyResult{
+		Slot: slot,
+		Role: role.String(),
+		Time: time.Now(),
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	key := fmt.Sprintf("%#x", pubKey)
+	duties := append(t.recentDuties[key], result)
+	if len(duties) > maxRecentDuties {
+		duties = duties[len(duties)-maxRecentDuties:]
+	}
+	t.recentDuties[key] = duties
+}
+
+// report returns the status of every key the validator client manages.
+func (t *statusTracker) report(pubkeys [][]byte) *StatusReport {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	report := &StatusReport{
+		GeneratedAt: time.Now(),
+		CurrentSlot: t.currentSlot,
+		Connection: &ConnectionStatus{
+			LastSuccess:   t.lastSuccess,
+			LastError:     t.lastError,
+			LastErrorTime: t.lastErrorTime,
+		},
+	}
+	for _, pubKey := range pubkeys {
+		key := fmt.Sprintf("%#x", pubKey)
+		status := &KeyStatus{
+			PublicKey:    key,
+			Status:       pb.ValidatorStatus_UNKNOWN_STATUS.String(),
+			RecentDuties: append([]*DutyResult{}, t.recentDuties[key]...),
+		}
+		if s, ok := t.statuses[key]; ok {
+			status.Status = s.String()
+		}
+		if assignment, ok := t.assignments[key]; ok && assignment.Slot >= t.currentSlot && assignment.Status == pb.ValidatorStatus_ACTIVE {
+			role := pb.ValidatorRole_ATTESTER
+			if assignment.IsProposer {
+				role = pb.ValidatorRole_PROPOSER
+			}
+			status.NextDuty = &Duty{
+				Slot:  assignment.Slot,
+				Role:  role.String(),
+				Shard: assignment.Shard,
+			}
+		}
+		report.Keys = append(report.Keys, status)
+	}
+	sort.Slice(report.Keys, func(i, j int) bool {
+		return report.Keys[i].PublicKey < report.Keys[j].PublicKey
+	})
+	return report
+}
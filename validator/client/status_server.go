@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"time"
+)
+
+var statusPage = template.Must(template.New("status").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Validator status</title><meta http-equiv="refresh" content="6"></head>
+<body>
+<h1>Validator status</h1>
+<p>Generated at {{.GeneratedAt.Format "2006-01-02 15:04:05"}}, current slot {{.CurrentSlot}}</p>
+<h2>Beacon node</h2>
+<table border="1">
+<tr><th>Endpoint</th><td>{{.Connection.Endpoint}}</td></tr>
+<tr><th>Connection</th><td>{{.Connection.State}}</td></tr>
+<tr><th>Last successful request</th><td>{{if not .Connection.LastSuccess.IsZero}}{{.Connection.LastSuccess.Format "15:04:05"}}{{else}}never{{end}}</td></tr>
+<tr><th>Last error</th><td>{{if .Connection.LastError}}{{.Connection.LastErrorTime.Format "15:04:05"}}: {{.Connection.LastError}}{{else}}none{{end}}</td></tr>
+</table>
+<h2>Keys</h2>
+<table border="1">
+<tr><th>Public key</th><th>Status</th><th>Next duty</th><th>Recent duties</th></tr>
+{{range .Keys}}<tr>
+<td><code>{{.PublicKey}}</code></td>
+<td>{{.Status}}</td>
+<td>{{with .NextDuty}}{{.Role}} at slot {{.Slot}}, shard {{.Shard}}{{else}}none{{end}}</td>
+<td>{{range .RecentDuties}}{{.Role}} at slot {{.Slot}}: {{if .Error}}failed, {{.Error}}{{else}}ok{{end}}<br>{{else}}none{{end}}</td>
+</tr>{{end}}
+</table>
+<p>Also available as <a href="/status.json">JSON</a>.</p>
+</body>
+</html>
+`))
+
+// StatusServer serves a status page, and its JSON equivalent, summarizing the state of
+// the validator client for operators without a monitoring setup.
+type StatusServer struct {
+	server    *http.Server
+	validator *ValidatorService
+}
+
+// NewStatusServer sets up a status page for the validator service on the given host:port.
+func NewStatusServer(addr string, validator *ValidatorService) *StatusServer {
+	s := &StatusServer{validator: validator}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", s.statusPageHandler)
+	mux.HandleFunc("/status.json", s.statusJSONHandler)
+
+	s.server = &http.Server{Addr: addr, Handler: mux}
+	return s
+}
+
+func (s *StatusServer) statusPageHandler(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := statusPage.Execute(w, s.validator.StatusReport()); err != nil {
+		log.WithError(err).Error("Could not render status page")
+	}
+}
+
+func (s *StatusServer) statusJSONHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(s.validator.StatusReport()); err != nil {
+		log.WithError(err).Error("Could not write status report")
+	}
+}
+
+// Start the status page server.
+func (s *StatusServer) Start() {
+	go func() {
+		log.WithField("address", s.server.Addr).Info("Starting status page server")
+		if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.WithError(err).Error("Could not serve status page")
+		}
+	}()
+}
+
+// Stop the status page server.
+func (s *StatusServer) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	return s.server.Shutdown(ctx)
+}
+
+// Status always returns nil, the status page is best effort.
+func (s *StatusServer) Status() error {
+	return nil
+}
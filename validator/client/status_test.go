@@ -0,0 +1,93 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+func TestStatusTracker_Report(t *testing.T) {
+	tracker := newStatusTracker()
+	pubKey := validatorKey.PublicKey.Marshal()
+
+	tracker.recordSlot(5)
+	tracker.recordAssignments(&pb.AssignmentResponse{
+		ValidatorAssignment: []*pb.AssignmentResponse_ValidatorAssignment{
+			{
+				PublicKey:  pubKey,
+				Status:     pb.ValidatorStatus_ACTIVE,
+				Slot:       7,
+				Shard:      3,
+				IsProposer: true,
+			},
+		},
+	})
+	for slot := uint64(0); slot < maxRecentDuties+2; slot++ {
+		tracker.recordDuty(pubKey, slot, pb.ValidatorRole_ATTESTER, nil)
+	}
+	tracker.recordRPC(errors.New("connection refused"))
+
+	report := tracker.report([][]byte{pubKey})
+	if len(report.Keys) != 1 {
+		t.Fatalf("Expected 1 key in report, received %d", len(report.Keys))
+	}
+	key := report.Keys[0]
+	if key.Status != pb.ValidatorStatus_ACTIVE.String() {
+		t.Errorf("Wanted status %s, got %s", pb.ValidatorStatus_ACTIVE, key.Status)
+	}
+	wantDuty := &Duty{Slot: 7, Role: pb.ValidatorRole_PROPOSER.String(), Shard: 3}
+	if key.NextDuty == nil || *key.NextDuty != *wantDuty {
+		t.Errorf("Wanted next duty %v, got %v", wantDuty, key.NextDuty)
+	}
+	if len(key.RecentDuties) != maxRecentDuties {
+		t.Errorf("Wanted %d recent duties, got %d", maxRecentDuties, len(key.RecentDuties))
+	}
+	if key.RecentDuties[0].Slot != 2 {
+		t.Errorf("Expected the oldest duties to be dropped, first duty at slot %d", key.RecentDuties[0].Slot)
+	}
+	if report.Connection.LastError != "connection refused" {
+		t.Errorf("Wanted last error %q, got %q", "connection refused", report.Connection.LastError)
+	}
+
+	// The assignment is behind us once its slot has passed.
+	tracker.recordSlot(8)
+	if duty := tracker.report([][]byte{pubKey}).Keys[0].NextDuty; duty != nil {
+		t.Errorf("Expected no next duty, got %v", duty)
+	}
+}
+
+func TestStatusServer_ServesJSON(t *testing.T) {
+	v := &ValidatorService{
+		endpoint: "localhost:4000",
+		keys:     keyMap,
+		status:   newStatusTracker(),
+	}
+	s := NewStatusServer("127.0.0.1:0", v)
+
+	rec := httptest.NewRecorder()
+	s.server.Handler.ServeHTTP(rec, httptest.NewRequest("GET", "/status.json", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Wanted status code %d, got %d", http.StatusOK, rec.Code)
+	}
+	report := &StatusReport{}
+	if err := json.NewDecoder(rec.Body).Decode(report); err != nil {
+		t.Fatal(err)
+	}
+	if report.Connection.Endpoint != "localhost:4000" {
+		t.Errorf("Wanted endpoint %s, got %s", "localhost:4000", report.Connection.Endpoint)
+	}
+	if len(report.Keys) != 1 || report.Keys[0].PublicKey != fmt.Sprintf("%#x", validatorKey.PublicKey.Marshal()) {
+		t.Errorf("Expected report for the validator key, got %v", report.Keys)
+	}
+
+	rec = httptest.NewRecorder()
+	s.server.Handler.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("Wanted status code %d, got %d", http.StatusOK, rec.Code)
+	}
+}
@@ -6,11 +6,13 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
+	"sync"
 	"time"
 
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -31,6 +33,15 @@ type validator struct {
 	pubkeys              [][]byte
 	prevBalance          map[[48]byte]uint64
 	logValidatorBalances bool
+	sharedSignature      []byte // Reused for every attestation in simulation mode, if set.
+	domainDataCache      map[domainKey]*pb.DomainResponse
+	domainDataLock       sync.Mutex
+	status               *statusTracker
+	chainClient          ethpb.BeaconChainClient
+	proposals            *proposalWatchdog
+	chaos                *nonFinalityChaos      // Withholds the duties of some keys in simulation mode, if set.
+	streamedDuties       *pb.AssignmentResponse // The latest final assignments received on the duty stream.
+	streamedDutiesLock   sync.RWMutex
 }
 
 // Done cleans up the validator.
@@ -120,6 +131,7 @@ func (v *validator) WaitForActivation(ctx context.Context) error {
 }
 
 func (v *validator) checkAndLogValidatorStatus(validatorStatuses []*pb.ValidatorActivationResponse_Status) [][]byte {
+	v.status.recordStatuses(validatorStatuses)
 	var activatedKeys [][]byte
 	for _, status := range validatorStatuses {
 		if status.Status.Status == pb.ValidatorStatus_ACTIVE {
@@ -132,11 +144,22 @@ func (v *validator) checkAndLogValidatorStatus(validatorStatuses []*pb.Validator
 		}
 		if status.Status.Status == pb.ValidatorStatus_EXITED {
 			log.WithFields(logrus.Fields{
-				"publicKey": fmt.Sprintf("%#x", bytesutil.Trunc(status.PublicKey)),
-				"status":    status.Status.Status.String(),
+				"publicKey":         fmt.Sprintf("%#x", bytesutil.Trunc(status.PublicKey)),
+				"status":            status.Status.Status.String(),
+				"exitEpoch":         status.Status.ExitEpoch,
+				"withdrawableEpoch": status.Status.WithdrawableEpoch,
 			}).Info("Validator has been ejected")
 			continue
 		}
+		if status.Status.Status == pb.ValidatorStatus_DEPOSIT_RECEIVED {
+			log.WithFields(logrus.Fields{
+				"publicKey":                fmt.Sprintf("%#x", bytesutil.Trunc(status.PublicKey)),
+				"status":                   status.Status.Status.String(),
+				"eth1DepositBlockNumber":   status.Status.Eth1DepositBlockNumber,
+				"expectedEligibilityEpoch": status.Status.ActivationEligibilityEpoch,
+			}).Info("Deposit seen, waiting for inclusion")
+			continue
+		}
 		if status.Status.DepositInclusionSlot == 0 {
 			log.WithFields(logrus.Fields{
 				"publicKey": fmt.Sprintf("%#x", bytesutil.Trunc(status.PublicKey)),
@@ -170,6 +193,7 @@ func (v *validator) CanonicalHeadSlot(ctx context.Context) (uint64, error) {
 	ctx, span := trace.StartSpan(ctx, "validator.CanonicalHeadSlot")
 	defer span.End()
 	head, err := v.beaconClient.CanonicalHead(ctx, &ptypes.Empty{})
+	v.status.recordRPC(err)
 	if err != nil {
 		return 0, err
 	}
@@ -191,6 +215,7 @@ func (v *validator) SlotDeadline(slot uint64) time.Time {
 // list of upcoming assignments needs to be updated. For example, at the
 // beginning of a new epoch.
 func (v *validator) UpdateAssignments(ctx context.Context, slot uint64) error {
+	v.status.recordSlot(slot)
 	if slot%params.BeaconConfig().SlotsPerEpoch != 0 && v.assignments != nil {
 		// Do nothing if not epoch start AND assignments already exist.
 		return nil
@@ -203,14 +228,22 @@ func (v *validator) UpdateAssignments(ctx context.Context, slot uint64) error {
 		PublicKeys: v.pubkeys,
 	}
 
-	resp, err := v.validatorClient.CommitteeAssignment(ctx, req)
-	if err != nil {
-		v.assignments = nil // Clear assignments so we know to retry the request.
-		log.Error(err)
-		return err
+	// The assignments are only requested when the duty stream did not deliver them yet.
+	resp := v.streamedAssignments(req.EpochStart)
+	if resp == nil {
+		var err error
+		resp, err = v.validatorClient.CommitteeAssignment(ctx, req)
+		v.status.recordRPC(err)
+		if err != nil {
+			v.assignments = nil // Clear assignments so we know to retry the request.
+			log.Error(err)
+			return err
+		}
 	}
 
 	v.assignments = resp
+	v.status.recordAssignments(resp)
+	v.expectProposals(resp, slot)
 	// Only log the full assignments output on epoch start to be less verbose.
 	if slot%params.BeaconConfig().SlotsPerEpoch == 0 {
 		for _, assignment := range v.assignments.ValidatorAssignment {
@@ -238,9 +271,15 @@ func (v *validator) UpdateAssignments(ctx context.Context, slot uint64) error {
 			if assignment.IsProposer {
 				lFields["proposerSlot"] = proposerSlot
 			}
+			// The proposals of the next epoch are announced ahead, so that the operator can
+			// make sure the node is ready for them.
+			if len(assignment.NextEpochProposerSlots) > 0 {
+				lFields["nextEpochProposerSlots"] = assignment.NextEpochProposerSlots
+			}
 			log.WithFields(lFields).Info("New assignment")
 
 		}
+		v.logExitCountdown(ctx, slot/params.BeaconConfig().SlotsPerEpoch)
 	}
 
 	log.WithFields(logrus.Fields{
@@ -250,6 +289,45 @@ func (v *validator) UpdateAssignments(ctx context.Context, slot uint64) error {
 	return nil
 }
 
+// logExitCountdown logs, for the keys with an initiated exit, the epochs left until the validator
+// exits and until its balance becomes withdrawable.
+func (v *validator) logExitCountdown(ctx context.Context, epoch uint64) {
+	for _, assignment := range v.assignments.ValidatorAssignment {
+		switch assignment.Status {
+		case pb.ValidatorStatus_INITIATED_EXIT, pb.ValidatorStatus_EXITED, pb.ValidatorStatus_EXITED_SLASHED,
+			pb.ValidatorStatus_WITHDRAWABLE:
+		default:
+			continue
+		}
+		status, err := v.validatorClient.ValidatorStatus(ctx, &pb.ValidatorIndexRequest{PublicKey: assignment.PublicKey})
+		v.status.recordRPC(err)
+		if err != nil {
+			log.WithError(err).Error("Could not fetch validator status")
+			continue
+		}
+		if status.WithdrawableEpoch == 0 {
+			continue
+		}
+		lFields := logrus.Fields{
+			"publicKey":         fmt.Sprintf("%#x", bytesutil.Trunc(assignment.PublicKey)),
+			"exitEpoch":         status.ExitEpoch,
+			"withdrawableEpoch": status.WithdrawableEpoch,
+		}
+		if status.ExitEpoch > epoch {
+			lFields["epochsUntilExit"] = status.ExitEpoch - epoch
+		}
+		if status.WithdrawableEpoch <= epoch {
+			log.WithFields(lFields).Info("Validator balance is withdrawable")
+			continue
+		}
+		epochsLeft := status.WithdrawableEpoch - epoch
+		secondsLeft := epochsLeft * params.BeaconConfig().SlotsPerEpoch * params.BeaconConfig().SecondsPerSlot
+		lFields["epochsUntilWithdrawable"] = epochsLeft
+		lFields["timeUntilWithdrawable"] = time.Duration(secondsLeft) * time.Second
+		log.WithFields(lFields).Info("Validator exit in progress")
+	}
+}
+
 // RolesAt slot returns the validator roles at the given slot. Returns nil if the
 // validator is known to not have a roles at the at slot. Returns UNKNOWN if the
 // validator assignments are unknown. Otherwise returns a valid ValidatorRole map.
@@ -270,7 +348,11 @@ func (v *validator) RolesAt(slot uint64) map[string]pb.ValidatorRole {
 		} else {
 			role = pb.ValidatorRole_UNKNOWN
 		}
-		rolesAt[hex.EncodeToString(assignment.PublicKey)] = role
+		pubkey := hex.EncodeToString(assignment.PublicKey)
+		if v.chaos.withholds(pubkey, slot) {
+			role = pb.ValidatorRole_UNKNOWN
+		}
+		rolesAt[pubkey] = role
 	}
 	return rolesAt
 }
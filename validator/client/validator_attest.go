@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -15,8 +16,11 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/tracing"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var delay = params.BeaconConfig().SecondsPerSlot / 2
@@ -30,19 +34,31 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, pk strin
 	defer span.End()
 
 	tpk := hex.EncodeToString(v.keys[pk].PublicKey.Marshal())[:12]
+	pubKey := v.keys[pk].PublicKey.Marshal()
+
+	// The duty ID is sent along with the requests of the duty, so that the beacon node can
+	// report the time spent in each stage of the attestation under the same ID.
+	dutyID := tracing.NewDutyID("attester", slot, pubKey)
+	ctx = tracing.WithDutyID(ctx, dutyID)
 
 	span.AddAttributes(
 		trace.StringAttribute("validator", tpk),
+		trace.StringAttribute("dutyID", dutyID),
 	)
 
+	var dutyErr error
+	defer func() {
+		v.status.recordDuty(pubKey, slot, pb.ValidatorRole_ATTESTER, dutyErr)
+	}()
+
 	v.waitToSlotMidpoint(ctx, slot)
 
 	// We fetch the validator index as it is necessary to generate the aggregation
 	// bitfield of the attestation itself.
-	pubKey := v.keys[pk].PublicKey.Marshal()
 	var assignment *pb.AssignmentResponse_ValidatorAssignment
 	if v.assignments == nil {
 		log.Errorf("No assignments for validators")
+		dutyErr = errors.New("no assignments for validators")
 		return
 	}
 	for _, assign := range v.assignments.ValidatorAssignment {
@@ -57,16 +73,24 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, pk strin
 	validatorIndexRes, err := v.validatorClient.ValidatorIndex(ctx, idxReq)
 	if err != nil {
 		log.Errorf("Could not fetch validator index: %v", err)
+		dutyErr = err
 		return
 	}
 	req := &pb.AttestationRequest{
-		Slot:  slot,
-		Shard: assignment.Shard,
+		PublicKey:       pubKey,
+		Slot:            slot,
+		Shard:           assignment.Shard,
+		CommitteeIndex:  assignment.CommitteeIndex,
+		CommitteeLength: uint64(len(assignment.Committee)),
 	}
+	requestStart := time.Now()
 	data, err := v.attesterClient.RequestAttestation(ctx, req)
+	requestDuration := time.Since(requestStart)
+	v.status.recordRPC(err)
 	if err != nil {
 		log.Errorf("Could not request attestation to sign at slot %d: %v",
 			slot, err)
+		dutyErr = err
 		return
 	}
 	committeeLength := mathutil.CeilDiv8(len(assignment.Committee))
@@ -88,9 +112,10 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, pk strin
 	aggregationBitfield := bitfield.NewBitlist(uint64(len(assignment.Committee)))
 	aggregationBitfield.SetBitAt(indexInCommittee, true)
 
-	domain, err := v.validatorClient.DomainData(ctx, &pb.DomainRequest{Epoch: data.Target.Epoch, Domain: params.BeaconConfig().DomainAttestation})
+	domain, err := v.domainData(ctx, data.Target.Epoch, params.BeaconConfig().DomainAttestation)
 	if err != nil {
 		log.WithError(err).Error("Failed to get domain data from beacon node")
+		dutyErr = err
 		return
 	}
 	attDataAndCustodyBit := &pbp2p.AttestationDataAndCustodyBit{
@@ -104,9 +129,15 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, pk strin
 		log.WithError(err).WithFields(logrus.Fields{
 			"pubKey": tpk,
 		}).Error("Failed to sign attestation data and custody bit")
+		dutyErr = err
 		return
 	}
-	sig := v.keys[pk].SecretKey.Sign(root[:], domain.SignatureDomain).Marshal()
+	var sig []byte
+	if v.sharedSignature != nil {
+		sig = v.sharedSignature
+	} else {
+		sig = v.keys[pk].SecretKey.Sign(root[:], domain.SignatureDomain).Marshal()
+	}
 
 	attestation := &ethpb.Attestation{
 		Data:            data,
@@ -115,11 +146,24 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, pk strin
 		Signature:       sig,
 	}
 
+	submitStart := time.Now()
 	attResp, err := v.attesterClient.SubmitAttestation(ctx, attestation)
+	submitDuration := time.Since(submitStart)
 	if err != nil {
+		// The beacon node already holds an aggregate containing this vote, nothing left to do.
+		if errCode, ok := status.FromError(err); ok && errCode.Code() == codes.AlreadyExists {
+			log.WithField("pubKey", tpk).Debug("Attestation already aggregated by beacon node")
+			return
+		}
 		log.Errorf("Could not submit attestation to beacon node: %v", err)
+		dutyErr = err
 		return
 	}
+	log.WithFields(logrus.Fields{
+		"dutyID":          dutyID,
+		"requestDuration": requestDuration,
+		"submitDuration":  submitDuration,
+	}).Debug("Attestation duty latency")
 
 	log.WithFields(logrus.Fields{
 		"headRoot":    fmt.Sprintf("%#x", bytesutil.Trunc(data.BeaconBlockRoot)),
@@ -127,6 +171,7 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, pk strin
 		"sourceEpoch": data.Source.Epoch,
 		"targetEpoch": data.Target.Epoch,
 		"pubKey":      tpk,
+		"dutyID":      dutyID,
 	}).Info("Attested latest head")
 
 	span.AddAttributes(
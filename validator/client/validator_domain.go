@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+type domainKey struct {
+	epoch      uint64
+	domainType [4]byte
+}
+
+// domainData returns the signature domain for the given epoch and domain type. The
+// domain only depends on the fork at that epoch, so it is requested from the beacon
+// node once per epoch and domain type. Keying the cache by epoch guarantees a fork
+// scheduled at an epoch boundary is picked up as soon as that epoch starts.
+func (v *validator) domainData(ctx context.Context, epoch uint64, domainType []byte) (*pb.DomainResponse, error) {
+	key := domainKey{epoch: epoch, domainType: bytesutil.ToBytes4(domainType)}
+
+	v.domainDataLock.Lock()
+	res, ok := v.domainDataCache[key]
+	v.domainDataLock.Unlock()
+	if ok {
+		return res, nil
+	}
+
+	// The lock isn't held during the request, so a slow beacon node doesn't hold back the
+	// callers whose domain is cached. Concurrent misses may request the same domain twice.
+	res, err := v.validatorClient.DomainData(ctx, &pb.DomainRequest{Epoch: epoch, Domain: domainType})
+	if err != nil {
+		return nil, err
+	}
+	v.domainDataLock.Lock()
+	defer v.domainDataLock.Unlock()
+	if v.domainDataCache == nil {
+		v.domainDataCache = make(map[domainKey]*pb.DomainResponse)
+	}
+	// Domains of past epochs are never needed again once a new epoch starts.
+	for k := range v.domainDataCache {
+		if k.epoch+1 < epoch {
+			delete(v.domainDataCache, k)
+		}
+	}
+	v.domainDataCache[key] = res
+	return res, nil
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestDomainData_CachesPerEpochAndDomain(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		&pb.DomainRequest{Epoch: 1, Domain: params.BeaconConfig().DomainAttestation},
+	).Return(&pb.DomainResponse{SignatureDomain: 1}, nil).Times(1)
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		&pb.DomainRequest{Epoch: 2, Domain: params.BeaconConfig().DomainAttestation},
+	).Return(&pb.DomainResponse{SignatureDomain: 2}, nil).Times(1)
+
+	for i := 0; i < 3; i++ {
+		res, err := validator.domainData(context.Background(), 1, params.BeaconConfig().DomainAttestation)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.SignatureDomain != 1 {
+			t.Errorf("Wanted domain %d, received %d", 1, res.SignatureDomain)
+		}
+	}
+	res, err := validator.domainData(context.Background(), 2, params.BeaconConfig().DomainAttestation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.SignatureDomain != 2 {
+		t.Errorf("Wanted domain %d, received %d", 2, res.SignatureDomain)
+	}
+}
+
+func TestDomainData_CachedWhileRequesting(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		&pb.DomainRequest{Epoch: 1, Domain: params.BeaconConfig().DomainAttestation},
+	).Return(&pb.DomainResponse{SignatureDomain: 1}, nil)
+	if _, err := validator.domainData(context.Background(), 1, params.BeaconConfig().DomainAttestation); err != nil {
+		t.Fatal(err)
+	}
+
+	requesting := make(chan struct{})
+	release := make(chan struct{})
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		&pb.DomainRequest{Epoch: 1, Domain: params.BeaconConfig().DomainBeaconProposer},
+	).Return(&pb.DomainResponse{SignatureDomain: 2}, nil).Do(func(arg0, arg1 interface{}) {
+		close(requesting)
+		<-release
+	})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if _, err := validator.domainData(context.Background(), 1, params.BeaconConfig().DomainBeaconProposer); err != nil {
+			t.Error(err)
+		}
+	}()
+	<-requesting
+
+	// A slow request doesn't hold back the domains already cached.
+	res, err := validator.domainData(context.Background(), 1, params.BeaconConfig().DomainAttestation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.SignatureDomain != 1 {
+		t.Errorf("Wanted domain %d, received %d", 1, res.SignatureDomain)
+	}
+	close(release)
+	<-done
+}
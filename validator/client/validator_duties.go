@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// StreamDuties receives the assignments of the current and the next epoch from the beacon node
+// until the context is canceled, opening the stream again a slot after it fails. The assignments
+// of an epoch received once the epoch started are final and used by UpdateAssignments instead of
+// requesting them, while the proposals of the next epoch are logged as soon as they are known.
+func (v *validator) StreamDuties(ctx context.Context) {
+	for {
+		if err := v.receiveDuties(ctx); err != nil && ctx.Err() == nil {
+			log.WithError(err).Warn("Duty stream closed, assignments are requested at every epoch instead")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second):
+		}
+	}
+}
+
+func (v *validator) receiveDuties(ctx context.Context) error {
+	stream, err := v.validatorClient.StreamDuties(ctx, &pb.AssignmentRequest{PublicKeys: v.pubkeys})
+	if err != nil {
+		return errors.Wrap(err, "could not setup duty streaming client")
+	}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "could not receive duties from stream")
+		}
+		v.recordStreamedDuties(res, time.Now())
+	}
+}
+
+// recordStreamedDuties keeps the assignments received on the duty stream at the given time. The
+// assignments of an epoch received before its start are computed with empty slots from the head,
+// so they are only logged, until they are sent again once the epoch started.
+func (v *validator) recordStreamedDuties(res *pb.AssignmentResponse, received time.Time) {
+	epochStart := time.Unix(int64(v.genesisTime), 0).Add(
+		time.Duration(res.Epoch*params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	if received.Before(epochStart) {
+		for _, assignment := range res.ValidatorAssignment {
+			if !assignment.IsProposer {
+				continue
+			}
+			log.WithFields(logrus.Fields{
+				"validator":    hex.EncodeToString(assignment.PublicKey)[:12],
+				"epoch":        res.Epoch,
+				"proposerSlot": assignment.Slot,
+			}).Info("Upcoming proposal")
+		}
+		return
+	}
+
+	v.streamedDutiesLock.Lock()
+	defer v.streamedDutiesLock.Unlock()
+	if v.streamedDuties == nil || v.streamedDuties.Epoch < res.Epoch {
+		v.streamedDuties = res
+	}
+}
+
+// streamedAssignments returns the final assignments of the epoch received on the duty stream, if
+// any.
+func (v *validator) streamedAssignments(epoch uint64) *pb.AssignmentResponse {
+	v.streamedDutiesLock.RLock()
+	defer v.streamedDutiesLock.RUnlock()
+	if v.streamedDuties == nil || v.streamedDuties.Epoch != epoch {
+		return nil
+	}
+	return v.streamedDuties
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/validator/internal"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestUpdateAssignments_UsesStreamedDuties(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockValidatorServiceClient(ctrl)
+
+	genesis := time.Now().Add(-time.Hour)
+	secondsPerEpoch := params.BeaconConfig().SlotsPerEpoch * params.BeaconConfig().SecondsPerSlot
+	epochStart := func(epoch uint64) time.Time {
+		return genesis.Add(time.Duration(epoch*secondsPerEpoch) * time.Second)
+	}
+	v := validator{
+		genesisTime:     uint64(genesis.Unix()),
+		keys:            keyMap,
+		validatorClient: client,
+	}
+	current := &pb.AssignmentResponse{
+		Epoch: 2,
+		ValidatorAssignment: []*pb.AssignmentResponse_ValidatorAssignment{
+			{PublicKey: []byte("testPubKey_1"), Status: pb.ValidatorStatus_ACTIVE, Slot: 70},
+		},
+	}
+	next := &pb.AssignmentResponse{
+		Epoch: 3,
+		ValidatorAssignment: []*pb.AssignmentResponse_ValidatorAssignment{
+			{PublicKey: []byte("testPubKey_1"), Status: pb.ValidatorStatus_ACTIVE, Slot: 100, IsProposer: true},
+		},
+	}
+	v.recordStreamedDuties(current, epochStart(2))
+	v.recordStreamedDuties(next, epochStart(2))
+	testutil.AssertLogsContain(t, hook, "Upcoming proposal")
+
+	// The assignments of the current epoch were streamed, they are not requested.
+	client.EXPECT().CommitteeAssignment(gomock.Any(), gomock.Any()).Times(0)
+	if err := v.UpdateAssignments(context.Background(), 2*params.BeaconConfig().SlotsPerEpoch); err != nil {
+		t.Fatalf("Could not update assignments: %v", err)
+	}
+	if v.assignments != current {
+		t.Errorf("Wanted the streamed assignments of epoch 2, got %v", v.assignments)
+	}
+	if v.streamedAssignments(3) != nil {
+		t.Error("Wanted the assignments of the next epoch sent ahead of it not to be used")
+	}
+}
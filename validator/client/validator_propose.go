@@ -3,13 +3,14 @@ package client
 // Validator client proposer functions.
 import (
 	"context"
-	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"time"
 
 	"github.com/prysmaticlabs/go-ssz"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/tracing"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -30,28 +31,49 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pk string) {
 	epoch := slot / params.BeaconConfig().SlotsPerEpoch
 	tpk := hex.EncodeToString(v.keys[pk].PublicKey.Marshal())[:12]
 
-	domain, err := v.validatorClient.DomainData(ctx, &pb.DomainRequest{Epoch: epoch, Domain: params.BeaconConfig().DomainRandao})
+	// The duty ID is sent along with the requests of the duty, so that the beacon node can
+	// report the processing of the proposal under the same ID.
+	dutyID := tracing.NewDutyID("proposer", slot, v.keys[pk].PublicKey.Marshal())
+	ctx = tracing.WithDutyID(ctx, dutyID)
+	span.AddAttributes(trace.StringAttribute("dutyID", dutyID))
+	var dutyErr error
+	stage := proposalStageRandao
+	defer func() {
+		v.status.recordDuty(v.keys[pk].PublicKey.Marshal(), slot, pb.ValidatorRole_PROPOSER, dutyErr)
+		v.proposals.record(pk, slot, stage, dutyErr)
+		span.AddAttributes(trace.StringAttribute("stage", stage))
+	}()
+
+	randaoReveal, err := v.randaoReveal(ctx, pk, epoch)
 	if err != nil {
 		log.WithError(err).Error("Failed to get domain data from beacon node")
+		dutyErr = err
 		return
 	}
-	buf := make([]byte, 32)
-	binary.LittleEndian.PutUint64(buf, epoch)
-	randaoReveal := v.keys[pk].SecretKey.Sign(buf, domain.SignatureDomain)
 
+	stage = proposalStageRequestBlock
+	requestStart := time.Now()
 	b, err := v.proposerClient.RequestBlock(ctx, &pb.BlockRequest{
 		Slot:         slot,
-		RandaoReveal: randaoReveal.Marshal(),
+		RandaoReveal: randaoReveal,
 	})
+	v.status.recordRPC(err)
 	if err != nil {
 		log.WithError(err).Error("Failed to request block from beacon node")
+		dutyErr = err
 		return
 	}
+	requestDuration := time.Since(requestStart)
 	span.AddAttributes(trace.StringAttribute("validator", tpk))
 
-	domain, err = v.validatorClient.DomainData(ctx, &pb.DomainRequest{Epoch: epoch, Domain: params.BeaconConfig().DomainBeaconProposer})
+	// The signature is the last stage of the block production, timed as the beacon node times
+	// the stages of building the block.
+	stage = proposalStageSign
+	signingStart := time.Now()
+	domain, err := v.domainData(ctx, epoch, params.BeaconConfig().DomainBeaconProposer)
 	if err != nil {
 		log.WithError(err).Error("Failed to get domain data from beacon node")
+		dutyErr = err
 		return
 	}
 	root, err := ssz.SigningRoot(b)
@@ -59,24 +81,31 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pk string) {
 		log.WithError(err).WithFields(logrus.Fields{
 			"pubKey": tpk,
 		}).Error("Failed to sign block")
+		dutyErr = err
 		return
 	}
 	signature := v.keys[pk].SecretKey.Sign(root[:], domain.SignatureDomain)
 	b.Signature = signature.Marshal()
+	signingDuration := time.Since(signingStart)
 
 	// Broadcast network the signed block via beacon chain node.
+	stage = proposalStagePropose
 	blkResp, err := v.proposerClient.ProposeBlock(ctx, b)
 	if err != nil {
 		log.WithError(err).WithFields(logrus.Fields{
 			"pubKey": tpk,
 		}).Error("Failed to propose block")
+		dutyErr = err
 		return
 	}
+	stage = proposalStageProposed
 
 	span.AddAttributes(
 		trace.StringAttribute("blockRoot", fmt.Sprintf("%#x", blkResp.BlockRoot)),
 		trace.Int64Attribute("numDeposits", int64(len(b.Body.Deposits))),
 		trace.Int64Attribute("numAttestations", int64(len(b.Body.Attestations))),
+		trace.Int64Attribute("requestBlockMillis", int64(requestDuration/time.Millisecond)),
+		trace.Int64Attribute("signingMillis", int64(signingDuration/time.Millisecond)),
 	)
 
 	log.WithFields(logrus.Fields{
@@ -85,5 +114,8 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pk string) {
 		"blockRoot":       fmt.Sprintf("%#x", blkResp.BlockRoot),
 		"numAttestations": len(b.Body.Attestations),
 		"numDeposits":     len(b.Body.Deposits),
+		"requestBlock":    requestDuration,
+		"signing":         signingDuration,
+		"dutyID":          dutyID,
 	}).Info("Proposed new beacon block")
 }
@@ -10,6 +10,7 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/tracing"
 	"github.com/prysmaticlabs/prysm/validator/internal"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -137,3 +138,30 @@ func TestProposeBlock_BroadcastsBlock(t *testing.T) {
 
 	validator.ProposeBlock(context.Background(), 1, hex.EncodeToString(validatorKey.PublicKey.Marshal()))
 }
+
+func TestProposeBlock_SendsDutyID(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), //epoch
+	).Return(&pb.DomainResponse{}, nil /*err*/).Times(2)
+
+	m.proposerClient.EXPECT().RequestBlock(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).Return(&ethpb.BeaconBlock{Body: &ethpb.BeaconBlockBody{}}, nil /*err*/)
+
+	wanted := tracing.NewDutyID("proposer", 1, validatorKey.PublicKey.Marshal())
+	m.proposerClient.EXPECT().ProposeBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.BeaconBlock{}),
+	).Return(&pb.ProposeResponse{}, nil /*error*/).Do(func(ctx, _ interface{}) {
+		if id := tracing.DutyID(ctx.(context.Context)); id != wanted {
+			t.Errorf("Wanted the proposal sent with duty ID %s, got %q", wanted, id)
+		}
+	})
+
+	validator.ProposeBlock(context.Background(), 1, hex.EncodeToString(validatorKey.PublicKey.Marshal()))
+}
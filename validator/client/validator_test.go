@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"strings"
 	"testing"
@@ -482,6 +483,119 @@ func TestUpdateAssignments_OK(t *testing.T) {
 	}
 }
 
+func TestUpdateAssignments_LogsExitCountdown(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockValidatorServiceClient(ctrl)
+
+	slot := 10 * params.BeaconConfig().SlotsPerEpoch
+	resp := &pb.AssignmentResponse{
+		ValidatorAssignment: []*pb.AssignmentResponse_ValidatorAssignment{
+			{
+				PublicKey: []byte("testPubKey_1"),
+				Status:    pb.ValidatorStatus_INITIATED_EXIT,
+			},
+			{
+				PublicKey: []byte("testPubKey_2"),
+				Status:    pb.ValidatorStatus_ACTIVE,
+			},
+		},
+	}
+	v := validator{
+		keys:            keyMap,
+		validatorClient: client,
+	}
+	client.EXPECT().CommitteeAssignment(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(resp, nil)
+	client.EXPECT().ValidatorStatus(
+		gomock.Any(),
+		&pb.ValidatorIndexRequest{PublicKey: []byte("testPubKey_1")},
+	).Return(&pb.ValidatorStatusResponse{
+		Status:            pb.ValidatorStatus_INITIATED_EXIT,
+		ExitEpoch:         15,
+		WithdrawableEpoch: 20,
+	}, nil)
+
+	if err := v.UpdateAssignments(context.Background(), slot); err != nil {
+		t.Fatalf("Could not update assignments: %v", err)
+	}
+	testutil.AssertLogsContain(t, hook, "Validator exit in progress")
+	testutil.AssertLogsContain(t, hook, "epochsUntilWithdrawable=10")
+}
+
+func TestUpdateAssignments_LogsWithdrawable(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockValidatorServiceClient(ctrl)
+
+	slot := 30 * params.BeaconConfig().SlotsPerEpoch
+	resp := &pb.AssignmentResponse{
+		ValidatorAssignment: []*pb.AssignmentResponse_ValidatorAssignment{
+			{
+				PublicKey: []byte("testPubKey_1"),
+				Status:    pb.ValidatorStatus_WITHDRAWABLE,
+			},
+		},
+	}
+	v := validator{
+		keys:            keyMap,
+		validatorClient: client,
+	}
+	client.EXPECT().CommitteeAssignment(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(resp, nil)
+	client.EXPECT().ValidatorStatus(
+		gomock.Any(),
+		&pb.ValidatorIndexRequest{PublicKey: []byte("testPubKey_1")},
+	).Return(&pb.ValidatorStatusResponse{
+		Status:            pb.ValidatorStatus_WITHDRAWABLE,
+		ExitEpoch:         15,
+		WithdrawableEpoch: 20,
+	}, nil)
+
+	if err := v.UpdateAssignments(context.Background(), slot); err != nil {
+		t.Fatalf("Could not update assignments: %v", err)
+	}
+	testutil.AssertLogsContain(t, hook, "Validator balance is withdrawable")
+}
+
+func TestUpdateAssignments_LogsNextEpochProposals(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockValidatorServiceClient(ctrl)
+
+	slot := params.BeaconConfig().SlotsPerEpoch
+	resp := &pb.AssignmentResponse{
+		ValidatorAssignment: []*pb.AssignmentResponse_ValidatorAssignment{
+			{
+				Slot:                   slot,
+				PublicKey:              []byte("testPubKey_1"),
+				Status:                 pb.ValidatorStatus_ACTIVE,
+				NextEpochProposerSlots: []uint64{2*slot + 1, 2*slot + 5},
+			},
+		},
+	}
+	v := validator{
+		keys:            keyMap,
+		validatorClient: client,
+	}
+	client.EXPECT().CommitteeAssignment(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(resp, nil)
+
+	if err := v.UpdateAssignments(context.Background(), slot); err != nil {
+		t.Fatalf("Could not update assignments: %v", err)
+	}
+	testutil.AssertLogsContain(t, hook, fmt.Sprintf("nextEpochProposerSlots=\"[%d %d]\"", 2*slot+1, 2*slot+5))
+}
+
 func TestRolesAt_OK(t *testing.T) {
 
 	v := validator{
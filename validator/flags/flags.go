@@ -27,6 +27,11 @@ var (
 		Name:  "tls-cert",
 		Usage: "Certificate for secure gRPC. Pass this and the tls-key flag in order to use gRPC securely.",
 	}
+	// BeaconRPCAPIKeyFlag defines the API key sent to a beacon node enforcing per client quotas.
+	BeaconRPCAPIKeyFlag = cli.StringFlag{
+		Name:  "beacon-rpc-api-key",
+		Usage: "API key sent to the beacon node in the x-api-key gRPC metadata, for beacon nodes started with --rpc-quotas-file",
+	}
 	// KeystorePathFlag defines the location of the keystore directory for a validator's account.
 	KeystorePathFlag = cmd.DirectoryFlag{
 		Name:  "keystore-path",
@@ -43,6 +48,71 @@ var (
 		Name:  "disable-rewards-penalties-logging",
 		Usage: "Disable reward/penalty logging during cluster deployment",
 	}
+	// SimulateFlag runs the validator client with deterministic devnet keys against a devnet beacon
+	// node in order to capacity test the beacon node's RPC and operations pool.
+	SimulateFlag = cli.BoolFlag{
+		Name:  "simulate",
+		Usage: "Sign and submit duties with in-memory deterministic devnet keys. Only use against a devnet beacon node!",
+	}
+	// SimulateKeysFlag defines how many deterministic keys are generated in simulation mode.
+	SimulateKeysFlag = cli.Uint64Flag{
+		Name:  "simulate-keys",
+		Usage: "Number of deterministic validator keys to generate when running with --simulate",
+		Value: 64,
+	}
+	// SimulateKeyOffsetFlag defines the index of the first deterministic key generated in
+	// simulation mode, so that several simulated clients validate distinct genesis validators.
+	SimulateKeyOffsetFlag = cli.Uint64Flag{
+		Name:  "simulate-key-offset",
+		Usage: "Index of the first deterministic validator key used with --simulate, the devnet genesis must have deposited the keys",
+	}
+	// SimulateSharedSignatureFlag reuses a single signature for every attestation in simulation mode.
+	SimulateSharedSignatureFlag = cli.BoolFlag{
+		Name:  "simulate-shared-signature",
+		Usage: "Reuse one fake signature for all attestations when running with --simulate, avoids BLS signing costs",
+	}
+	// SimulateWithholdFractionFlag defines the fraction of the simulated keys whose duties are
+	// withheld in a non-finality chaos test.
+	SimulateWithholdFractionFlag = cli.Float64Flag{
+		Name:  "simulate-withhold-fraction",
+		Usage: "Fraction of the simulated keys which skip their duties when running with --simulate-withhold-epochs, more than 1/3 stalls finality",
+		Value: 0.5,
+	}
+	// SimulateWithholdStartEpochFlag defines the first epoch whose duties are withheld.
+	SimulateWithholdStartEpochFlag = cli.Uint64Flag{
+		Name:  "simulate-withhold-start-epoch",
+		Usage: "First epoch at which the withheld simulated keys skip their duties",
+		Value: 2,
+	}
+	// SimulateWithholdEpochsFlag runs a non-finality chaos test in simulation mode, by withholding
+	// the duties of a fraction of the simulated keys for a number of epochs.
+	SimulateWithholdEpochsFlag = cli.Uint64Flag{
+		Name:  "simulate-withhold-epochs",
+		Usage: "Number of epochs a fraction of the simulated keys skip their duties to stall finality when running with --simulate. The client exits with an error if the chain finalizes meanwhile. Disabled when 0",
+	}
+	// SimulateRecoveryEpochsFlag defines how many epochs the chain has to finalize again once the
+	// withheld keys resume their duties.
+	SimulateRecoveryEpochsFlag = cli.Uint64Flag{
+		Name:  "simulate-recovery-epochs",
+		Usage: "Number of epochs after the withheld keys resume their duties within which the chain must finalize again, or the client exits with an error",
+		Value: 4,
+	}
+	// StatusPortFlag defines the port of the local status page, the page is disabled when unset.
+	StatusPortFlag = cli.IntFlag{
+		Name:  "status-port",
+		Usage: "Port of a local HTTP status page summarizing validator keys, duties and beacon node connection. Disabled when 0",
+	}
+	// StatusHostFlag defines the host the local status page listens on.
+	StatusHostFlag = cli.StringFlag{
+		Name:  "status-host",
+		Usage: "Host the status page listens on",
+		Value: "127.0.0.1",
+	}
+	// MissedProposalWebhookFlag defines the URL alerts for missed block proposals are posted to.
+	MissedProposalWebhookFlag = cli.StringFlag{
+		Name:  "missed-proposal-webhook",
+		Usage: "URL a JSON alert is posted to whenever the block of a proposal duty doesn't appear on chain",
+	}
 )
 
 func homeDir() string {
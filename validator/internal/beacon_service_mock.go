@@ -99,6 +99,66 @@ func (mr *MockBeaconServiceClientMockRecorder) CanonicalHead(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanonicalHead", reflect.TypeOf((*MockBeaconServiceClient)(nil).CanonicalHead), varargs...)
 }
 
+// ChainStartStatus mocks base method
+func (m *MockBeaconServiceClient) ChainStartStatus(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v1.ChainStartStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChainStartStatus", varargs...)
+	ret0, _ := ret[0].(*v1.ChainStartStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChainStartStatus indicates an expected call of ChainStartStatus
+func (mr *MockBeaconServiceClientMockRecorder) ChainStartStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainStartStatus", reflect.TypeOf((*MockBeaconServiceClient)(nil).ChainStartStatus), varargs...)
+}
+
+// ForkChoiceAudit mocks base method
+func (m *MockBeaconServiceClient) ForkChoiceAudit(arg0 context.Context, arg1 *v1.ForkChoiceAuditRequest, arg2 ...grpc.CallOption) (*v1.ForkChoiceAuditResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ForkChoiceAudit", varargs...)
+	ret0, _ := ret[0].(*v1.ForkChoiceAuditResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkChoiceAudit indicates an expected call of ForkChoiceAudit
+func (mr *MockBeaconServiceClientMockRecorder) ForkChoiceAudit(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkChoiceAudit", reflect.TypeOf((*MockBeaconServiceClient)(nil).ForkChoiceAudit), varargs...)
+}
+
+// StreamFinalizedChain mocks base method
+func (m *MockBeaconServiceClient) StreamFinalizedChain(arg0 context.Context, arg1 *v1.FinalizedChainRequest, arg2 ...grpc.CallOption) (v1.BeaconService_StreamFinalizedChainClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamFinalizedChain", varargs...)
+	ret0, _ := ret[0].(v1.BeaconService_StreamFinalizedChainClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamFinalizedChain indicates an expected call of StreamFinalizedChain
+func (mr *MockBeaconServiceClientMockRecorder) StreamFinalizedChain(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamFinalizedChain", reflect.TypeOf((*MockBeaconServiceClient)(nil).StreamFinalizedChain), varargs...)
+}
+
 // WaitForChainStart mocks base method
 func (m *MockBeaconServiceClient) WaitForChainStart(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (v1.BeaconService_WaitForChainStartClient, error) {
 	m.ctrl.T.Helper()
@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	types "github.com/gogo/protobuf/types"
 	gomock "github.com/golang/mock/gomock"
 	v1 "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	v1alpha1 "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
@@ -37,6 +38,26 @@ func (m *MockProposerServiceClient) EXPECT() *MockProposerServiceClientMockRecor
 	return m.recorder
 }
 
+// Eth1DataVotes mocks base method
+func (m *MockProposerServiceClient) Eth1DataVotes(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v1.Eth1DataVotesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Eth1DataVotes", varargs...)
+	ret0, _ := ret[0].(*v1.Eth1DataVotesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Eth1DataVotes indicates an expected call of Eth1DataVotes
+func (mr *MockProposerServiceClientMockRecorder) Eth1DataVotes(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eth1DataVotes", reflect.TypeOf((*MockProposerServiceClient)(nil).Eth1DataVotes), varargs...)
+}
+
 // ProposeBlock mocks base method
 func (m *MockProposerServiceClient) ProposeBlock(arg0 context.Context, arg1 *v1alpha1.BeaconBlock, arg2 ...grpc.CallOption) (*v1.ProposeResponse, error) {
 	m.ctrl.T.Helper()
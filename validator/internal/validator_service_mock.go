@@ -57,6 +57,26 @@ func (mr *MockValidatorServiceClientMockRecorder) CommitteeAssignment(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitteeAssignment", reflect.TypeOf((*MockValidatorServiceClient)(nil).CommitteeAssignment), varargs...)
 }
 
+// CommitteeAssignmentProof mocks base method
+func (m *MockValidatorServiceClient) CommitteeAssignmentProof(arg0 context.Context, arg1 *v1.AssignmentProofRequest, arg2 ...grpc.CallOption) (*v1.AssignmentProofResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CommitteeAssignmentProof", varargs...)
+	ret0, _ := ret[0].(*v1.AssignmentProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitteeAssignmentProof indicates an expected call of CommitteeAssignmentProof
+func (mr *MockValidatorServiceClientMockRecorder) CommitteeAssignmentProof(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitteeAssignmentProof", reflect.TypeOf((*MockValidatorServiceClient)(nil).CommitteeAssignmentProof), varargs...)
+}
+
 // DomainData mocks base method
 func (m *MockValidatorServiceClient) DomainData(arg0 context.Context, arg1 *v1.DomainRequest, arg2 ...grpc.CallOption) (*v1.DomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -97,6 +117,26 @@ func (mr *MockValidatorServiceClientMockRecorder) ExitedValidators(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExitedValidators", reflect.TypeOf((*MockValidatorServiceClient)(nil).ExitedValidators), varargs...)
 }
 
+// StreamDuties mocks base method
+func (m *MockValidatorServiceClient) StreamDuties(arg0 context.Context, arg1 *v1.AssignmentRequest, arg2 ...grpc.CallOption) (v1.ValidatorService_StreamDutiesClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamDuties", varargs...)
+	ret0, _ := ret[0].(v1.ValidatorService_StreamDutiesClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamDuties indicates an expected call of StreamDuties
+func (mr *MockValidatorServiceClientMockRecorder) StreamDuties(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamDuties", reflect.TypeOf((*MockValidatorServiceClient)(nil).StreamDuties), varargs...)
+}
+
 // ValidatorIndex mocks base method
 func (m *MockValidatorServiceClient) ValidatorIndex(arg0 context.Context, arg1 *v1.ValidatorIndexRequest, arg2 ...grpc.CallOption) (*v1.ValidatorIndexResponse, error) {
 	m.ctrl.T.Helper()
@@ -137,6 +177,26 @@ func (mr *MockValidatorServiceClientMockRecorder) ValidatorPerformance(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorPerformance", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatorPerformance), varargs...)
 }
 
+// ValidatorQueue mocks base method
+func (m *MockValidatorServiceClient) ValidatorQueue(arg0 context.Context, arg1 *v1.ValidatorIndexRequest, arg2 ...grpc.CallOption) (*v1.ValidatorQueueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidatorQueue", varargs...)
+	ret0, _ := ret[0].(*v1.ValidatorQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorQueue indicates an expected call of ValidatorQueue
+func (mr *MockValidatorServiceClientMockRecorder) ValidatorQueue(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorQueue", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatorQueue), varargs...)
+}
+
 // ValidatorStatus mocks base method
 func (m *MockValidatorServiceClient) ValidatorStatus(arg0 context.Context, arg1 *v1.ValidatorIndexRequest, arg2 ...grpc.CallOption) (*v1.ValidatorStatusResponse, error) {
 	m.ctrl.T.Helper()
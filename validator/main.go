@@ -33,7 +33,10 @@ func startNode(ctx *cli.Context) error {
 	if err != nil {
 		logrus.Fatal(err)
 	}
-	if !exists {
+	if ctx.GlobalBool(flags.SimulateFlag.Name) {
+		// Simulation mode uses deterministic in-memory keys, so no account is needed.
+		logrus.Warn("Simulation mode enabled, skipping validator account setup")
+	} else if !exists {
 		// If an account does not exist, we create a new one and start the node.
 		keystoreDirectory, keystorePassword, err = createValidatorAccount(ctx)
 		if err != nil {
@@ -137,9 +140,21 @@ contract in order to activate the validator client`,
 		flags.NoCustomConfigFlag,
 		flags.BeaconRPCProviderFlag,
 		flags.CertFlag,
+		flags.BeaconRPCAPIKeyFlag,
 		flags.KeystorePathFlag,
 		flags.PasswordFlag,
 		flags.DisablePenaltyRewardLogFlag,
+		flags.SimulateFlag,
+		flags.SimulateKeysFlag,
+		flags.SimulateKeyOffsetFlag,
+		flags.SimulateSharedSignatureFlag,
+		flags.SimulateWithholdFractionFlag,
+		flags.SimulateWithholdStartEpochFlag,
+		flags.SimulateWithholdEpochsFlag,
+		flags.SimulateRecoveryEpochsFlag,
+		flags.StatusPortFlag,
+		flags.StatusHostFlag,
+		flags.MissedProposalWebhookFlag,
 		cmd.VerbosityFlag,
 		cmd.DataDirFlag,
 		cmd.EnableTracingFlag,
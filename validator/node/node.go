@@ -71,6 +71,10 @@ func NewValidatorClient(ctx *cli.Context, password string) (*ValidatorClient, er
 		return nil, err
 	}
 
+	if err := ValidatorClient.registerStatusService(ctx); err != nil {
+		return nil, err
+	}
+
 	return ValidatorClient, nil
 }
 
@@ -134,14 +138,37 @@ func (s *ValidatorClient) registerClientService(ctx *cli.Context, password strin
 	logValidatorBalances := !ctx.GlobalBool(flags.DisablePenaltyRewardLogFlag.Name)
 	cert := ctx.GlobalString(flags.CertFlag.Name)
 	v, err := client.NewValidatorService(context.Background(), &client.Config{
-		Endpoint:             endpoint,
-		KeystorePath:         keystoreDirectory,
-		Password:             password,
-		LogValidatorBalances: logValidatorBalances,
-		CertFlag:             cert,
+		Endpoint:              endpoint,
+		KeystorePath:          keystoreDirectory,
+		Password:              password,
+		LogValidatorBalances:  logValidatorBalances,
+		CertFlag:              cert,
+		APIKey:                ctx.GlobalString(flags.BeaconRPCAPIKeyFlag.Name),
+		Simulate:              ctx.GlobalBool(flags.SimulateFlag.Name),
+		SimulatedKeys:         ctx.GlobalUint64(flags.SimulateKeysFlag.Name),
+		SimulatedKeyOffset:    ctx.GlobalUint64(flags.SimulateKeyOffsetFlag.Name),
+		SharedSignatures:      ctx.GlobalBool(flags.SimulateSharedSignatureFlag.Name),
+		MissedProposalWebhook: ctx.GlobalString(flags.MissedProposalWebhookFlag.Name),
+		WithholdFraction:      ctx.GlobalFloat64(flags.SimulateWithholdFractionFlag.Name),
+		WithholdStartEpoch:    ctx.GlobalUint64(flags.SimulateWithholdStartEpochFlag.Name),
+		WithholdEpochs:        ctx.GlobalUint64(flags.SimulateWithholdEpochsFlag.Name),
+		RecoveryEpochs:        ctx.GlobalUint64(flags.SimulateRecoveryEpochsFlag.Name),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize client service")
 	}
 	return s.services.RegisterService(v)
 }
+
+func (s *ValidatorClient) registerStatusService(ctx *cli.Context) error {
+	port := ctx.GlobalInt(flags.StatusPortFlag.Name)
+	if port == 0 {
+		return nil
+	}
+	var v *client.ValidatorService
+	if err := s.services.FetchService(&v); err != nil {
+		return err
+	}
+	addr := fmt.Sprintf("%s:%d", ctx.GlobalString(flags.StatusHostFlag.Name), port)
+	return s.services.RegisterService(client.NewStatusServer(addr, v))
+}
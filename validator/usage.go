@@ -71,9 +71,21 @@ var appHelpFlagGroups = []flagGroup{
 			flags.NoCustomConfigFlag,
 			flags.BeaconRPCProviderFlag,
 			flags.CertFlag,
+			flags.BeaconRPCAPIKeyFlag,
 			flags.KeystorePathFlag,
 			flags.PasswordFlag,
 			flags.DisablePenaltyRewardLogFlag,
+			flags.SimulateFlag,
+			flags.SimulateKeysFlag,
+			flags.SimulateKeyOffsetFlag,
+			flags.SimulateSharedSignatureFlag,
+			flags.SimulateWithholdFractionFlag,
+			flags.SimulateWithholdStartEpochFlag,
+			flags.SimulateWithholdEpochsFlag,
+			flags.SimulateRecoveryEpochsFlag,
+			flags.StatusPortFlag,
+			flags.StatusHostFlag,
+			flags.MissedProposalWebhookFlag,
 		},
 	},
 	{